@@ -37,7 +37,6 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
-	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -66,17 +65,15 @@ import (
 )
 
 const (
-	// Rather than importing the whole argocd-notifications controller, just copying the const here
-	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/subscriptions.go#L12
-	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
-	NotifiedAnnotationKey             = "notified.notifications.argoproj.io"
 	ReconcileRequeueOnValidationError = time.Minute * 3
 	ReverseDeletionOrder              = "Reverse"
 	AllAtOnceDeletionOrder            = "AllAtOnce"
+	// RolloutAnalysisPollInterval is the interval at which the result of a running rollout analysis is checked
+	RolloutAnalysisPollInterval = time.Second * 5
 )
 
 var defaultPreservedAnnotations = []string{
-	NotifiedAnnotationKey,
+	utils.NotifiedAnnotationKey,
 	argov1alpha1.AnnotationKeyRefresh,
 }
 
@@ -104,6 +101,8 @@ type ApplicationSetReconciler struct {
 	GlobalPreservedLabels      []string
 	Metrics                    *metrics.ApplicationsetMetrics
 	MaxResourcesStatusCount    int
+	// RolloutAnalysisRunner runs the analyses of RollingSync steps; analyses are not allowed if it is nil
+	RolloutAnalysisRunner *utils.RolloutAnalysisRunner
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -242,6 +241,16 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	// rolloutRequeueAfter is the duration after which the gates of the rollout steps have to be evaluated again
+	var rolloutRequeueAfter time.Duration
+	// rolloutRevision identifies the generated Applications, to apply the failure policy of the rollout
+	var rolloutRevision string
+	if r.EnableProgressiveSyncs && getRolloutFailurePolicy(&applicationSetInfo) != "" {
+		rolloutRevision, err = getRolloutRevision(generatedApplications)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get rollout revision for application set: %w", err)
+		}
+	}
 
 	if r.EnableProgressiveSyncs {
 		if !isRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
@@ -253,7 +262,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet application statuses for %v: %w", applicationSetInfo.Name, err)
 			}
 		} else if isRollingSyncStrategy(&applicationSetInfo) {
-			appSyncMap, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
+			appSyncMap, rolloutRequeueAfter, err = r.performProgressiveSyncs(ctx, logCtx, &applicationSetInfo, currentApplications, generatedApplications, rolloutRevision)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
@@ -270,6 +279,22 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	if (!r.EnableProgressiveSyncs || !isRollingSyncStrategy(&applicationSetInfo)) && len(applicationSetInfo.Status.RolloutSteps) > 0 {
+		// clean up the rollout step statuses of an appset which is no longer syncing with a `RollingSync` strategy
+		err := r.setAppSetRolloutStepStatus(ctx, logCtx, &applicationSetInfo, nil)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to clear AppSet rollout step statuses for %v: %w", applicationSetInfo.Name, err)
+		}
+	}
+
+	if (!r.EnableProgressiveSyncs || !isRollingSyncStrategy(&applicationSetInfo)) && applicationSetInfo.Status.RolloutFailure != nil {
+		// clean up the rollout failure of an appset which is no longer syncing with a `RollingSync` strategy
+		err := r.setAppSetRolloutFailure(ctx, logCtx, &applicationSetInfo, nil)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to clear AppSet rollout failure for %v: %w", applicationSetInfo.Name, err)
+		}
+	}
+
 	if len(validateErrors) > 0 {
 		errorApps := make([]string, 0, len(validateErrors))
 		for key := range validateErrors {
@@ -308,6 +333,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// trigger appropriate application syncs if RollingSync strategy is enabled
 		if progressiveSyncsRollingSyncStrategyEnabled(&applicationSetInfo) {
 			validApps = r.syncDesiredApplications(logCtx, &applicationSetInfo, appSyncMap, validApps)
+			if getRolloutFailurePolicy(&applicationSetInfo) == argov1alpha1.RolloutFailurePolicyRollback {
+				validApps = r.setPreviousApplicationSpecs(logCtx, &applicationSetInfo, currentApplications, rolloutRevision, validApps)
+			}
 		}
 	}
 
@@ -382,6 +410,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
 
 	if len(validateErrors) == 0 {
 		if err := r.setApplicationSetStatusCondition(ctx,
@@ -954,12 +985,22 @@ func (r *ApplicationSetReconciler) removeOwnerReferencesOnDeleteAppSet(ctx conte
 	return nil
 }
 
-func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, error) {
-	appDependencyList, appStepMap := r.buildAppDependencyList(logCtx, appset, desiredApplications)
+func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, rolloutRevision string) (map[string]bool, time.Duration, error) {
+	appDependencyList, appStepMap := r.buildAppDependencyList(logCtx, *appset, desiredApplications)
 
-	_, err := r.updateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, appStepMap)
+	_, err := r.updateApplicationSetApplicationStatus(ctx, logCtx, appset, applications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
+	rollbackApps, err := r.updateApplicationSetRolloutFailure(ctx, logCtx, appset, applications, appStepMap, rolloutRevision)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout failure: %w", err)
+	}
+
+	requeueAfter, err := r.updateApplicationSetRolloutStepStatus(ctx, logCtx, appset, appDependencyList, applications)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout step status: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -967,17 +1008,22 @@ func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context,
 		logCtx.Infof("step %v: %+v", stepIndex+1, applicationNames)
 	}
 
-	appsToSync := r.getAppsToSync(appset, appDependencyList, applications)
+	appsToSync := r.getAppsToSync(*appset, appDependencyList, applications)
 	logCtx.Infof("Application allowed to sync before maxUpdate?: %+v", appsToSync)
 
-	_, err = r.updateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appsToSync, appStepMap)
+	_, err = r.updateApplicationSetApplicationStatusProgress(ctx, logCtx, appset, appsToSync, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
-	_ = r.updateApplicationSetApplicationStatusConditions(ctx, &appset)
+	_ = r.updateApplicationSetApplicationStatusConditions(ctx, appset)
+
+	// the Applications rolled back by a failed rollout are synced again
+	for appName := range rollbackApps {
+		appsToSync[appName] = true
+	}
 
-	return appsToSync, nil
+	return appsToSync, requeueAfter, nil
 }
 
 // this list tracks which Applications belong to each RollingUpdate step
@@ -1056,6 +1102,11 @@ func (r *ApplicationSetReconciler) getAppsToSync(applicationSet argov1alpha1.App
 	appSyncMap := map[string]bool{}
 	currentAppsMap := map[string]bool{}
 
+	if applicationSet.Status.RolloutFailure != nil {
+		// The rollout failed. We cannot proceed with any wave until the failure is cleared
+		return appSyncMap
+	}
+
 	for _, app := range currentApplications {
 		currentAppsMap[app.Name] = true
 	}
@@ -1090,6 +1141,10 @@ func (r *ApplicationSetReconciler) getAppsToSync(applicationSet argov1alpha1.App
 				break
 			}
 		}
+		if syncNextWave && !isRolloutStepCompleted(&applicationSet, stepIndex) {
+			// The gates of this wave did not pass yet. We cannot proceed to the next wave
+			syncNextWave = false
+		}
 		if !syncNextWave {
 			break
 		}
@@ -1104,8 +1159,7 @@ func isRollingSyncStrategy(appset *argov1alpha1.ApplicationSet) bool {
 }
 
 func progressiveSyncsRollingSyncStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
-	// ProgressiveSync is enabled if the strategy is set to `RollingSync` + steps slice is not empty
-	return isRollingSyncStrategy(appset) && len(appset.Spec.Strategy.RollingSync.Steps) > 0
+	return utils.IsRollingSyncStrategyEnabled(appset)
 }
 
 func isApplicationWithError(app argov1alpha1.Application) bool {
@@ -1325,6 +1379,14 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress
 				}).Info("Progressive sync application changed status")
 
 				updateCountMap[appStepMap[appStatus.Application]]++
+			} else if appStatus.Status == argov1alpha1.ProgressiveSyncWaiting && !appsToSync[appStatus.Application] {
+				if gate := getPendingRolloutStepGate(applicationSet, getAppStep(appStatus.Application, appStepMap)-1); gate != nil {
+					message := fmt.Sprintf("Application is waiting for the gates of step %s: %s", gate.Step, gate.Message)
+					if appStatus.Message != message {
+						appStatus.LastTransitionTime = &now
+						appStatus.Message = message
+					}
+				}
 			}
 
 			appStatuses = append(appStatuses, appStatus)
@@ -1344,6 +1406,23 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 		return applicationSet.Status.Conditions
 	}
 
+	if failure := applicationSet.Status.RolloutFailure; failure != nil {
+		message := fmt.Sprintf("ApplicationSet rollout failed in step %s: %s", failure.Step, failure.Message)
+		if len(failure.RolledBack) > 0 {
+			message += fmt.Sprintf(". Rolled back %d Applications to their previous generated spec", len(failure.RolledBack))
+		}
+		_ = r.setApplicationSetStatusCondition(ctx,
+			applicationSet,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+				Message: message,
+				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutFailed,
+				Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+			}, true,
+		)
+		return applicationSet.Status.Conditions
+	}
+
 	completedWaves := map[string]bool{}
 	for _, appStatus := range applicationSet.Status.ApplicationStatus {
 		if v, ok := completedWaves[appStatus.Step]; !ok {
@@ -1354,7 +1433,7 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 	}
 
 	isProgressing := false
-	progressingStep := ""
+	progressingMessage := ""
 	for i := range applicationSet.Spec.Strategy.RollingSync.Steps {
 		step := strconv.Itoa(i + 1)
 		isCompleted, ok := completedWaves[step]
@@ -1364,7 +1443,15 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 		}
 		if !isCompleted {
 			isProgressing = true
-			progressingStep = step
+			progressingMessage = "ApplicationSet is performing rollout of step " + step
+			break
+		}
+		if !isRolloutStepCompleted(applicationSet, i) {
+			isProgressing = true
+			progressingMessage = "ApplicationSet is waiting for the gates of step " + step
+			if gate := getPendingRolloutStepGate(applicationSet, i+1); gate != nil {
+				progressingMessage += ": " + gate.Message
+			}
 			break
 		}
 	}
@@ -1374,7 +1461,7 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 			applicationSet,
 			argov1alpha1.ApplicationSetCondition{
 				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
-				Message: "ApplicationSet is performing rollout of step " + progressingStep,
+				Message: progressingMessage,
 				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
 				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
 			}, true,
@@ -1393,6 +1480,187 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 	return applicationSet.Status.Conditions
 }
 
+// updateApplicationSetRolloutStepStatus evaluates the gates of the RollingSync steps with gates, and returns the
+// duration after which the gates have to be evaluated again
+func (r *ApplicationSetReconciler) updateApplicationSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, applications []argov1alpha1.Application) (time.Duration, error) {
+	now := metav1.Now()
+	appsByName := make(map[string]*argov1alpha1.Application, len(applications))
+	for i := range applications {
+		appsByName[applications[i].Name] = &applications[i]
+	}
+
+	var requeueAfter time.Duration
+	promoted := false
+	stepStatuses := []argov1alpha1.ApplicationSetRolloutStepStatus{}
+	if progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
+		for i, step := range applicationSet.Spec.Strategy.RollingSync.Steps {
+			if !step.HasGates() {
+				continue
+			}
+			stepStatus := argov1alpha1.ApplicationSetRolloutStepStatus{Step: strconv.Itoa(i + 1), Status: argov1alpha1.RolloutStepProgressing, LastTransitionTime: &now}
+			if idx := findRolloutStepStatusIndex(applicationSet.Status.RolloutSteps, stepStatus.Step); idx > -1 {
+				stepStatus = *applicationSet.Status.RolloutSteps[idx].DeepCopy()
+			}
+			var appNames []string
+			if i < len(appDependencyList) {
+				appNames = appDependencyList[i]
+			}
+
+			stepRequeueAfter, approved := r.evaluateRolloutStepGates(applicationSet, step, &stepStatus, appNames, appsByName, now)
+			if stepRequeueAfter > 0 && (requeueAfter == 0 || stepRequeueAfter < requeueAfter) {
+				requeueAfter = stepRequeueAfter
+			}
+			promoted = promoted || approved
+			stepStatuses = append(stepStatuses, stepStatus)
+		}
+	}
+
+	if err := r.setAppSetRolloutStepStatus(ctx, logCtx, applicationSet, stepStatuses); err != nil {
+		return 0, err
+	}
+	if promoted {
+		// the promotion was consumed, so remove it
+		patch := client.MergeFrom(applicationSet.DeepCopy())
+		delete(applicationSet.Annotations, common.AnnotationApplicationSetRolloutPromote)
+		if err := r.Patch(ctx, applicationSet, patch); err != nil {
+			return 0, fmt.Errorf("failed to remove rollout promote annotation: %w", err)
+		}
+	}
+	return requeueAfter, nil
+}
+
+// evaluateRolloutStepGates evaluates the gates of a RollingSync step in order: the bake time, the approval and the
+// analysis. The analysis runs in the background, and its result is picked up by a later evaluation. It returns the
+// duration after which the gates have to be evaluated again, and whether the promotion of the step was consumed.
+func (r *ApplicationSetReconciler) evaluateRolloutStepGates(applicationSet *argov1alpha1.ApplicationSet, step argov1alpha1.ApplicationSetRolloutStep, stepStatus *argov1alpha1.ApplicationSetRolloutStepStatus, appNames []string, appsByName map[string]*argov1alpha1.Application, now metav1.Time) (time.Duration, bool) {
+	transition := func(status argov1alpha1.ApplicationSetRolloutStepStatusCode, message string) {
+		if stepStatus.Status != status || stepStatus.Message != message {
+			stepStatus.LastTransitionTime = &now
+		}
+		stepStatus.Status = status
+		stepStatus.Message = message
+	}
+
+	for _, appName := range appNames {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 || applicationSet.Status.ApplicationStatus[idx].Status != argov1alpha1.ProgressiveSyncHealthy {
+			// the step is (re)started, so the gates have to pass again
+			stepStatus.HealthyAt = nil
+			stepStatus.ApprovedAt = nil
+			stepStatus.Analysis = nil
+			transition(argov1alpha1.RolloutStepProgressing, "Waiting for the Applications of the step to become Healthy")
+			return 0, false
+		}
+	}
+	if stepStatus.Status == argov1alpha1.RolloutStepCompleted {
+		return 0, false
+	}
+	if len(appNames) == 0 {
+		transition(argov1alpha1.RolloutStepCompleted, "Step has no Applications")
+		return 0, false
+	}
+
+	for _, appName := range appNames {
+		app := appsByName[appName]
+		if app == nil || app.Status.Sync.Status != argov1alpha1.SyncStatusCodeSynced || app.Status.Health.Status != health.HealthStatusHealthy {
+			stepStatus.HealthyAt = nil
+			transition(argov1alpha1.RolloutStepProgressing, fmt.Sprintf("Waiting for Application %s to become Synced and Healthy", appName))
+			return 0, false
+		}
+	}
+	if stepStatus.HealthyAt == nil {
+		stepStatus.HealthyAt = &now
+	}
+
+	bakeTime, err := step.GetBakeTime()
+	if err != nil {
+		transition(argov1alpha1.RolloutStepProgressing, err.Error())
+		return 0, false
+	}
+	if bakedAt := stepStatus.HealthyAt.Add(bakeTime); now.Time.Before(bakedAt) {
+		transition(argov1alpha1.RolloutStepBaking, "Baking until "+bakedAt.UTC().Format(time.RFC3339))
+		return bakedAt.Sub(now.Time), false
+	}
+
+	approved := false
+	if step.Approval && stepStatus.ApprovedAt == nil {
+		if applicationSet.Annotations[common.AnnotationApplicationSetRolloutPromote] != stepStatus.Step {
+			transition(argov1alpha1.RolloutStepWaitingForApproval, fmt.Sprintf("Waiting for approval, promote with 'argocd appset rollout promote %s --step %s'", applicationSet.QualifiedName(), stepStatus.Step))
+			return 0, false
+		}
+		stepStatus.ApprovedAt = &now
+		approved = true
+	}
+
+	if step.Analysis != nil && (stepStatus.Analysis == nil || !stepStatus.Analysis.Passed) {
+		interval, err := step.Analysis.GetInterval()
+		if err != nil {
+			transition(argov1alpha1.RolloutStepAnalysisFailed, err.Error())
+			return 0, approved
+		}
+		if stepStatus.Analysis != nil {
+			if nextAnalysisAt := stepStatus.Analysis.CheckedAt.Add(interval); now.Time.Before(nextAnalysisAt) {
+				return nextAnalysisAt.Sub(now.Time), approved
+			}
+		}
+		passed, message := false, "Analysis failed: analyses are not allowed by the ApplicationSet controller"
+		if r.RolloutAnalysisRunner != nil {
+			var done bool
+			done, passed, message = r.RolloutAnalysisRunner.Run(applicationSet.QualifiedName()+"/"+stepStatus.Step, step.Analysis)
+			if !done {
+				if stepStatus.Analysis == nil {
+					transition(argov1alpha1.RolloutStepProgressing, "Running analysis")
+				}
+				return RolloutAnalysisPollInterval, approved
+			}
+		}
+		stepStatus.Analysis = &argov1alpha1.ApplicationSetRolloutAnalysisResult{Passed: passed, Message: message, CheckedAt: now}
+		if !passed {
+			transition(argov1alpha1.RolloutStepAnalysisFailed, message)
+			return interval, approved
+		}
+	}
+
+	transition(argov1alpha1.RolloutStepCompleted, "Gates of the step passed")
+	return 0, approved
+}
+
+// isRolloutStepCompleted returns whether the gates of the RollingSync step with the given index passed, which is always
+// the case for steps without gates
+func isRolloutStepCompleted(applicationSet *argov1alpha1.ApplicationSet, stepIndex int) bool {
+	if !progressiveSyncsRollingSyncStrategyEnabled(applicationSet) || stepIndex >= len(applicationSet.Spec.Strategy.RollingSync.Steps) ||
+		!applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex].HasGates() {
+		return true
+	}
+	idx := findRolloutStepStatusIndex(applicationSet.Status.RolloutSteps, strconv.Itoa(stepIndex+1))
+	return idx > -1 && applicationSet.Status.RolloutSteps[idx].Status == argov1alpha1.RolloutStepCompleted
+}
+
+// getPendingRolloutStepGate returns the status of the first RollingSync step before the step with the given index
+// whose Applications are healthy, but whose gates did not pass yet
+func getPendingRolloutStepGate(applicationSet *argov1alpha1.ApplicationSet, stepIndex int) *argov1alpha1.ApplicationSetRolloutStepStatus {
+	for i := 0; i < stepIndex; i++ {
+		idx := findRolloutStepStatusIndex(applicationSet.Status.RolloutSteps, strconv.Itoa(i+1))
+		if idx == -1 {
+			continue
+		}
+		switch applicationSet.Status.RolloutSteps[idx].Status {
+		case argov1alpha1.RolloutStepBaking, argov1alpha1.RolloutStepWaitingForApproval, argov1alpha1.RolloutStepAnalysisFailed:
+			return &applicationSet.Status.RolloutSteps[idx]
+		}
+	}
+	return nil
+}
+
+func findRolloutStepStatusIndex(stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus, step string) int {
+	for i := range stepStatuses {
+		if stepStatuses[i].Step == step {
+			return i
+		}
+	}
+	return -1
+}
+
 func findApplicationStatusIndex(appStatuses []argov1alpha1.ApplicationSetApplicationStatus, application string) int {
 	for i := range appStatuses {
 		if appStatuses[i].Application == application {
@@ -1571,16 +1839,43 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 	return nil
 }
 
+// setAppSetRolloutStepStatus updates the rollout step statuses of the ApplicationSet, if they changed
+func (r *ApplicationSetReconciler) setAppSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus) error {
+	if cmp.Equal(applicationSet.Status.RolloutSteps, stepStatuses, cmpopts.EquateEmpty()) {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}, updatedAppset); err != nil {
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.RolloutSteps = stepStatuses
+		if err := r.Client.Status().Update(ctx, updatedAppset); err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set rollout step status: %v", err)
+		return fmt.Errorf("unable to set application set rollout step status: %w", err)
+	}
+	return nil
+}
+
 func (r *ApplicationSetReconciler) syncDesiredApplications(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appsToSync map[string]bool, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
 	rolloutApps := []argov1alpha1.Application{}
 	for i := range desiredApplications {
 		pruneEnabled := false
 
-		// ensure that Applications generated with RollingSync do not have an automated sync policy, since the AppSet controller will handle triggering the sync operation instead
 		if desiredApplications[i].Spec.SyncPolicy != nil && desiredApplications[i].Spec.SyncPolicy.IsAutomatedSyncEnabled() {
 			pruneEnabled = desiredApplications[i].Spec.SyncPolicy.Automated.Prune
-			desiredApplications[i].Spec.SyncPolicy.Automated.Enabled = ptr.To(false)
 		}
+		// ensure that Applications generated with RollingSync do not have an automated sync policy, since the AppSet controller will handle triggering the sync operation instead
+		utils.DisableAutomatedSync(&desiredApplications[i])
 
 		appSetStatusPending := false
 		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, desiredApplications[i].Name)
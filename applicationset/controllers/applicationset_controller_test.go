@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -547,7 +551,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Labels:          map[string]string{"label-key": "label-value"},
 						Annotations: map[string]string{
 							"annot-key":                   "annot-value",
-							NotifiedAnnotationKey:         `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
+							utils.NotifiedAnnotationKey:   `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
 							v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal),
 						},
 					},
@@ -578,7 +582,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "3",
 						Annotations: map[string]string{
-							NotifiedAnnotationKey:         `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
+							utils.NotifiedAnnotationKey:   `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
 							v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal),
 						},
 					},
@@ -5961,6 +5965,149 @@ func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 	}
 }
 
+func TestUpdateApplicationSetRolloutStepStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	var analysisPassed atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !analysisPassed.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	healthyApp := func(name string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+			},
+		}
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{
+						{BakeTime: "1h"},
+						{Approval: true, Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{HTTP: &v1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL}}},
+						{},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+		},
+	}
+	appDependencyList := [][]string{{"app1"}, {"app2"}, {"app3"}}
+	apps := []v1alpha1.Application{healthyApp("app1"), healthyApp("app2"), healthyApp("app3")}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).Build()
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(1),
+		Metrics:  appsetmetrics.NewFakeAppsetMetrics(),
+
+		RolloutAnalysisRunner: utils.NewRolloutAnalysisRunner([]string{serverURL.Host}),
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+	stepStatus := func(step int) v1alpha1.ApplicationSetRolloutStepStatus {
+		return appSet.Status.RolloutSteps[step-1]
+	}
+	// waitForAnalysis evaluates the gates until the analysis running in the background is done
+	waitForAnalysis := func(t *testing.T) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			requeueAfter, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+			require.NoError(t, err)
+			return requeueAfter != RolloutAnalysisPollInterval
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("Bake time", func(t *testing.T) {
+		requeueAfter, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		require.Len(t, appSet.Status.RolloutSteps, 2)
+		assert.Equal(t, v1alpha1.RolloutStepBaking, stepStatus(1).Status)
+		assert.InDelta(t, time.Hour, requeueAfter, float64(time.Minute))
+		assert.Equal(t, map[string]bool{"app1": true}, r.getAppsToSync(appSet, appDependencyList, apps))
+
+		appSet.Status.RolloutSteps[0].HealthyAt = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		_, err = r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.RolloutStepCompleted, stepStatus(1).Status)
+	})
+
+	t.Run("Approval", func(t *testing.T) {
+		assert.Equal(t, v1alpha1.RolloutStepWaitingForApproval, stepStatus(2).Status)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true}, r.getAppsToSync(appSet, appDependencyList, apps))
+
+		appSet.Annotations = map[string]string{argocommon.AnnotationApplicationSetRolloutPromote: "2"}
+		require.NoError(t, client.Update(t.Context(), &appSet))
+		_, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		assert.NotNil(t, stepStatus(2).ApprovedAt)
+		assert.NotContains(t, appSet.Annotations, argocommon.AnnotationApplicationSetRolloutPromote)
+	})
+
+	t.Run("Analysis", func(t *testing.T) {
+		// the analysis runs in the background
+		assert.Equal(t, v1alpha1.RolloutStepProgressing, stepStatus(2).Status)
+		assert.Equal(t, "Running analysis", stepStatus(2).Message)
+
+		waitForAnalysis(t)
+		assert.Equal(t, v1alpha1.RolloutStepAnalysisFailed, stepStatus(2).Status)
+		assert.Equal(t, "Analysis failed: "+server.URL+" returned status code 503", stepStatus(2).Message)
+
+		analysisPassed.Store(true)
+		requeueAfter, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, requeueAfter, float64(time.Second))
+		assert.Equal(t, v1alpha1.RolloutStepAnalysisFailed, stepStatus(2).Status)
+
+		appSet.Status.RolloutSteps[1].Analysis.CheckedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+		waitForAnalysis(t)
+		assert.Equal(t, v1alpha1.RolloutStepCompleted, stepStatus(2).Status)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": true}, r.getAppsToSync(appSet, appDependencyList, apps))
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		appSet.Status.ApplicationStatus[0].Status = v1alpha1.ProgressiveSyncWaiting
+		_, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, &appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.RolloutStepProgressing, stepStatus(1).Status)
+		assert.Nil(t, stepStatus(1).HealthyAt)
+	})
+
+	t.Run("Analysis not allowed", func(t *testing.T) {
+		r := r
+		r.RolloutAnalysisRunner = nil
+		appSet := appSet.DeepCopy()
+		appSet.Status.ApplicationStatus[0].Status = v1alpha1.ProgressiveSyncHealthy
+		appSet.Status.RolloutSteps = []v1alpha1.ApplicationSetRolloutStepStatus{
+			{Step: "1", Status: v1alpha1.RolloutStepCompleted},
+			{Step: "2", Status: v1alpha1.RolloutStepProgressing, HealthyAt: &metav1.Time{Time: time.Now()}, ApprovedAt: &metav1.Time{Time: time.Now()}},
+		}
+		_, err := r.updateApplicationSetRolloutStepStatus(t.Context(), logCtx, appSet, appDependencyList, apps)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.RolloutStepAnalysisFailed, appSet.Status.RolloutSteps[1].Status)
+		assert.Equal(t, "Analysis failed: analyses are not allowed by the ApplicationSet controller", appSet.Status.RolloutSteps[1].Message)
+	})
+}
+
 func TestUpdateResourceStatus(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -0,0 +1,281 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/google/go-cmp/cmp"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	argoutil "github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/hash"
+)
+
+// maxPreviousApplicationSpecSize is the maximum size of the previous-spec annotation. The annotations of a resource are
+// limited to 256KiB in total, so larger previous specs are not recorded, and the Application is not rolled back.
+const maxPreviousApplicationSpecSize = 64 * 1024
+
+// previousApplicationSpec is the value of the previous-spec annotation of the Applications updated by a RollingSync
+// rollout with the Rollback failure policy
+type previousApplicationSpec struct {
+	// Revision is the rollout revision of the generated Applications which updated the Application
+	Revision string `json:"revision"`
+	// Spec is the generated spec of the Application before the update
+	Spec argov1alpha1.ApplicationSpec `json:"spec"`
+}
+
+// getRolloutFailurePolicy returns the failure policy of the RollingSync strategy of the ApplicationSet
+func getRolloutFailurePolicy(appset *argov1alpha1.ApplicationSet) argov1alpha1.ApplicationSetRolloutFailurePolicy {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) {
+		return ""
+	}
+	return appset.Spec.Strategy.RollingSync.FailurePolicy
+}
+
+// getRolloutRevision returns a revision identifying the generated Applications of an ApplicationSet, which changes
+// whenever the generated Applications change
+func getRolloutRevision(desiredApplications []argov1alpha1.Application) (string, error) {
+	specs := make(map[string]*argov1alpha1.ApplicationSpec, len(desiredApplications))
+	for i := range desiredApplications {
+		specs[desiredApplications[i].QualifiedName()] = argoutil.NormalizeApplicationSpec(&desiredApplications[i].Spec)
+	}
+	data, err := json.Marshal(specs)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling generated Applications: %w", err)
+	}
+	return strconv.FormatUint(uint64(hash.FNVa(string(data))), 16), nil
+}
+
+// getPreviousApplicationSpec returns the previous generated spec recorded in the previous-spec annotation of the
+// Application, or nil if the Application has no previous generated spec
+func getPreviousApplicationSpec(app *argov1alpha1.Application) (*previousApplicationSpec, error) {
+	value, ok := app.Annotations[common.AnnotationApplicationSetPreviousSpec]
+	if !ok {
+		return nil, nil
+	}
+	var previous previousApplicationSpec
+	if err := json.Unmarshal([]byte(value), &previous); err != nil {
+		return nil, fmt.Errorf("error unmarshaling previous spec of Application %s: %w", app.Name, err)
+	}
+	return &previous, nil
+}
+
+// getRolloutFailureMessage returns why an Application synced by a RollingSync rollout failed the rollout, or an empty
+// string if the Application did not fail
+func getRolloutFailureMessage(app *argov1alpha1.Application) string {
+	if app.Status.Health.Status == health.HealthStatusDegraded {
+		return fmt.Sprintf("Application %s is Degraded", app.Name)
+	}
+	// a failed sync is only final once the retries of the operation are exhausted
+	if app.Operation == nil && app.Status.OperationState != nil &&
+		(app.Status.OperationState.Phase == synccommon.OperationFailed || app.Status.OperationState.Phase == synccommon.OperationError) {
+		return fmt.Sprintf("Sync of Application %s failed: %s", app.Name, app.Status.OperationState.Message)
+	}
+	return ""
+}
+
+// updateApplicationSetRolloutFailure applies the failure policy of the RollingSync strategy: it marks the rollout
+// failed once an Application synced by the rollout fails, and clears the failure once the generated Applications
+// change. With the Rollback failure policy, it also returns the Applications which have to be synced again to roll them
+// back to their previous generated spec.
+func (r *ApplicationSetReconciler) updateApplicationSetRolloutFailure(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appStepMap map[string]int, rolloutRevision string) (map[string]bool, error) {
+	failurePolicy := getRolloutFailurePolicy(applicationSet)
+	if failure := applicationSet.Status.RolloutFailure; failure != nil {
+		switch {
+		case failurePolicy == "":
+			logCtx.Info("Clearing the rollout failure, since the ApplicationSet has no failure policy")
+		case failure.Revision != rolloutRevision:
+			logCtx.Infof("Clearing the rollout failure of step %s, since the generated Applications changed", failure.Step)
+		case failurePolicy == argov1alpha1.RolloutFailurePolicyHalt && isApplicationStatusHealthy(applicationSet, failure.Application):
+			logCtx.Infof("Clearing the rollout failure of step %s, since Application %s became Healthy", failure.Step, failure.Application)
+		default:
+			return nil, nil
+		}
+		return nil, r.setAppSetRolloutFailure(ctx, logCtx, applicationSet, nil)
+	}
+	if failurePolicy == "" {
+		return nil, nil
+	}
+
+	// Applications are evaluated in the order of their names, so that the same Application fails the rollout if several
+	// Applications failed
+	sortedApps := make([]*argov1alpha1.Application, 0, len(applications))
+	for i := range applications {
+		sortedApps = append(sortedApps, &applications[i])
+	}
+	sort.Slice(sortedApps, func(i, j int) bool {
+		return sortedApps[i].Name < sortedApps[j].Name
+	})
+
+	var failure *argov1alpha1.ApplicationSetRolloutFailure
+	for _, app := range sortedApps {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, app.Name)
+		if idx == -1 || applicationSet.Status.ApplicationStatus[idx].Status != argov1alpha1.ProgressiveSyncProgressing {
+			// only Applications whose sync was triggered by the rollout can fail the rollout
+			continue
+		}
+		if message := getRolloutFailureMessage(app); message != "" {
+			failure = &argov1alpha1.ApplicationSetRolloutFailure{
+				Application: app.Name,
+				Step:        strconv.Itoa(getAppStep(app.Name, appStepMap)),
+				Message:     message,
+				FailedAt:    metav1.Now(),
+				Revision:    rolloutRevision,
+			}
+			break
+		}
+	}
+	if failure == nil {
+		return nil, nil
+	}
+	logCtx.WithFields(log.Fields{"application": failure.Application, "step": failure.Step}).Warnf("Rollout failed: %s", failure.Message)
+	r.Recorder.Eventf(applicationSet, corev1.EventTypeWarning, "RolloutFailed", "Rollout failed in step %s: %s", failure.Step, failure.Message)
+
+	appsToSync := map[string]bool{}
+	if failurePolicy == argov1alpha1.RolloutFailurePolicyRollback {
+		now := metav1.Now()
+		appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, len(applicationSet.Status.ApplicationStatus))
+		copy(appStatuses, applicationSet.Status.ApplicationStatus)
+		for _, app := range sortedApps {
+			previous, err := getPreviousApplicationSpec(app)
+			if err != nil {
+				logCtx.WithFields(applog.GetAppLogFields(app)).Warnf("Not rolling back Application: %v", err)
+				continue
+			}
+			if previous == nil || previous.Revision != rolloutRevision {
+				// the Application was not updated by the failed rollout
+				continue
+			}
+			failure.RolledBack = append(failure.RolledBack, app.Name)
+
+			idx := findApplicationStatusIndex(appStatuses, app.Name)
+			if idx == -1 || appStatuses[idx].Status == argov1alpha1.ProgressiveSyncWaiting {
+				// the Application was not synced by the failed rollout, so rolling back its spec is enough
+				continue
+			}
+			appStatuses[idx].LastTransitionTime = &now
+			appStatuses[idx].Status = argov1alpha1.ProgressiveSyncPending
+			appStatuses[idx].Message = "Application is rolled back to its previous generated spec, since the rollout failed in step " + failure.Step
+			appsToSync[app.Name] = true
+		}
+		if len(failure.RolledBack) > 0 {
+			r.Recorder.Eventf(applicationSet, corev1.EventTypeNormal, "RolledBack", "Rolled back Applications %s to their previous generated spec", strings.Join(failure.RolledBack, ", "))
+		}
+		if err := r.setAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses); err != nil {
+			return nil, fmt.Errorf("failed to set AppSet application statuses: %w", err)
+		}
+	}
+
+	if err := r.setAppSetRolloutFailure(ctx, logCtx, applicationSet, failure); err != nil {
+		return nil, err
+	}
+	return appsToSync, nil
+}
+
+// isApplicationStatusHealthy returns whether the progressive sync status of the Application is Healthy
+func isApplicationStatusHealthy(applicationSet *argov1alpha1.ApplicationSet, appName string) bool {
+	idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+	return idx != -1 && applicationSet.Status.ApplicationStatus[idx].Status == argov1alpha1.ProgressiveSyncHealthy
+}
+
+// setPreviousApplicationSpecs records the previous generated spec of the Applications updated by the rollout in their
+// previous-spec annotation, and keeps the Applications rolled back by a failed rollout at their previous generated spec.
+// Applications whose previous generated spec is too large to be recorded are not rolled back, as with the Halt failure
+// policy.
+func (r *ApplicationSetReconciler) setPreviousApplicationSpecs(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, rolloutRevision string, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
+	currentAppsByName := make(map[string]*argov1alpha1.Application, len(currentApplications))
+	for i := range currentApplications {
+		currentAppsByName[currentApplications[i].Name] = &currentApplications[i]
+	}
+	rolledBack := map[string]bool{}
+	if failure := applicationSet.Status.RolloutFailure; failure != nil && failure.Revision == rolloutRevision {
+		for _, appName := range failure.RolledBack {
+			rolledBack[appName] = true
+		}
+	}
+
+	for i := range desiredApplications {
+		app := &desiredApplications[i]
+		current, ok := currentAppsByName[app.Name]
+		if !ok {
+			continue
+		}
+		appLog := logCtx.WithFields(applog.GetAppLogFields(app))
+		previousSpec, hasPreviousSpec := current.Annotations[common.AnnotationApplicationSetPreviousSpec]
+
+		if rolledBack[app.Name] {
+			previous, err := getPreviousApplicationSpec(current)
+			if err != nil || previous == nil {
+				appLog.Warnf("Failed to keep Application at its previous generated spec: %v", err)
+			} else {
+				app.Spec = previous.Spec
+			}
+		} else {
+			changed, err := utils.IsApplicationSpecChanged(applicationSet.Spec.IgnoreApplicationDifferences, current, app)
+			if err != nil {
+				appLog.Warnf("Failed to compare the generated spec of the Application: %v", err)
+			} else if changed {
+				data, err := json.Marshal(previousApplicationSpec{Revision: rolloutRevision, Spec: current.Spec})
+				switch {
+				case err != nil:
+					appLog.Warnf("Failed to record the previous generated spec of the Application: %v", err)
+				case len(data) > maxPreviousApplicationSpecSize:
+					// a previous spec of an earlier rollout must not be kept, as the Application would be rolled back to it
+					appLog.Warnf("Not recording the previous generated spec of the Application, since it exceeds %d bytes: the Application will not be rolled back if the rollout fails", maxPreviousApplicationSpecSize)
+					hasPreviousSpec = false
+				default:
+					previousSpec, hasPreviousSpec = string(data), true
+				}
+			}
+		}
+
+		if hasPreviousSpec {
+			if app.Annotations == nil {
+				app.Annotations = map[string]string{}
+			}
+			app.Annotations[common.AnnotationApplicationSetPreviousSpec] = previousSpec
+		}
+	}
+	return desiredApplications
+}
+
+// setAppSetRolloutFailure updates the rollout failure of the ApplicationSet, if it changed
+func (r *ApplicationSetReconciler) setAppSetRolloutFailure(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, failure *argov1alpha1.ApplicationSetRolloutFailure) error {
+	if cmp.Equal(applicationSet.Status.RolloutFailure, failure) {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}, updatedAppset); err != nil {
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.RolloutFailure = failure
+		if err := r.Client.Status().Update(ctx, updatedAppset); err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set rollout failure: %v", err)
+		return fmt.Errorf("unable to set application set rollout failure: %w", err)
+	}
+	return nil
+}
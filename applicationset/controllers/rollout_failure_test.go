@@ -0,0 +1,237 @@
+package controllers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newRolloutFailureApp(name string, targetRevision string, previous *previousApplicationSpec) v1alpha1.Application {
+	app := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: name, TargetRevision: targetRevision},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+			Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+		},
+	}
+	if previous != nil {
+		data, _ := json.Marshal(previous)
+		app.Annotations = map[string]string{common.AnnotationApplicationSetPreviousSpec: string(data)}
+	}
+	return app
+}
+
+func TestGetRolloutRevision(t *testing.T) {
+	apps := []v1alpha1.Application{newRolloutFailureApp("app1", "v1", nil), newRolloutFailureApp("app2", "v1", nil)}
+	revision, err := getRolloutRevision(apps)
+	require.NoError(t, err)
+
+	reordered, err := getRolloutRevision([]v1alpha1.Application{apps[1], apps[0]})
+	require.NoError(t, err)
+	assert.Equal(t, revision, reordered)
+
+	apps[1].Spec.Source.TargetRevision = "v2"
+	changed, err := getRolloutRevision(apps)
+	require.NoError(t, err)
+	assert.NotEqual(t, revision, changed)
+}
+
+func TestGetRolloutFailureMessage(t *testing.T) {
+	app := newRolloutFailureApp("app1", "v1", nil)
+	assert.Empty(t, getRolloutFailureMessage(&app))
+
+	app.Status.Health.Status = health.HealthStatusDegraded
+	assert.Equal(t, "Application app1 is Degraded", getRolloutFailureMessage(&app))
+
+	app.Status.Health.Status = health.HealthStatusProgressing
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationFailed, Message: "one or more objects failed to apply"}
+	assert.Equal(t, "Sync of Application app1 failed: one or more objects failed to apply", getRolloutFailureMessage(&app))
+
+	// the operation is retried
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	assert.Empty(t, getRolloutFailureMessage(&app))
+}
+
+func TestSetPreviousApplicationSpecs(t *testing.T) {
+	oldSpec := newRolloutFailureApp("app", "v0", nil).Spec
+	appSet := v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"}}
+	currentApps := []v1alpha1.Application{
+		newRolloutFailureApp("changed", "v1", nil),
+		newRolloutFailureApp("unchanged", "v1", &previousApplicationSpec{Revision: "old", Spec: oldSpec}),
+		newRolloutFailureApp("rolled-back", "v0", &previousApplicationSpec{Revision: "failed", Spec: oldSpec}),
+	}
+	desiredApps := []v1alpha1.Application{
+		newRolloutFailureApp("changed", "v2", nil),
+		newRolloutFailureApp("unchanged", "v1", nil),
+		newRolloutFailureApp("rolled-back", "v1", nil),
+		newRolloutFailureApp("new", "v1", nil),
+	}
+	r := ApplicationSetReconciler{}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	t.Run("Rollout in progress", func(t *testing.T) {
+		apps := r.setPreviousApplicationSpecs(logCtx, &appSet, currentApps, "failed", []v1alpha1.Application{*desiredApps[0].DeepCopy(), *desiredApps[1].DeepCopy(), *desiredApps[2].DeepCopy(), *desiredApps[3].DeepCopy()})
+
+		previous, err := getPreviousApplicationSpec(&apps[0])
+		require.NoError(t, err)
+		assert.Equal(t, &previousApplicationSpec{Revision: "failed", Spec: currentApps[0].Spec}, previous)
+		assert.Equal(t, currentApps[1].Annotations, apps[1].Annotations)
+		assert.Equal(t, "v2", apps[0].Spec.Source.TargetRevision)
+		assert.Equal(t, "v1", apps[2].Spec.Source.TargetRevision)
+		assert.NotContains(t, apps[3].Annotations, common.AnnotationApplicationSetPreviousSpec)
+	})
+
+	t.Run("Rollout failed", func(t *testing.T) {
+		appSet.Status.RolloutFailure = &v1alpha1.ApplicationSetRolloutFailure{Revision: "failed", RolledBack: []string{"rolled-back"}}
+		apps := r.setPreviousApplicationSpecs(logCtx, &appSet, currentApps, "failed", []v1alpha1.Application{*desiredApps[0].DeepCopy(), *desiredApps[1].DeepCopy(), *desiredApps[2].DeepCopy(), *desiredApps[3].DeepCopy()})
+
+		assert.Equal(t, oldSpec, apps[2].Spec)
+		assert.Equal(t, currentApps[2].Annotations, apps[2].Annotations)
+	})
+
+	t.Run("Previous spec too large", func(t *testing.T) {
+		current := newRolloutFailureApp("large", "v1", &previousApplicationSpec{Revision: "old", Spec: oldSpec})
+		current.Spec.Source.Path = strings.Repeat("a", maxPreviousApplicationSpecSize)
+		desired := *current.DeepCopy()
+		desired.Annotations = nil
+		desired.Spec.Source.TargetRevision = "v2"
+		apps := r.setPreviousApplicationSpecs(logCtx, &appSet, []v1alpha1.Application{current}, "failed", []v1alpha1.Application{desired})
+
+		assert.NotContains(t, apps[0].Annotations, common.AnnotationApplicationSetPreviousSpec)
+	})
+}
+
+func TestUpdateApplicationSetRolloutFailure(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	oldSpec := newRolloutFailureApp("app", "v0", nil).Spec
+	newAppSet := func(failurePolicy v1alpha1.ApplicationSetRolloutFailurePolicy) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps:         []v1alpha1.ApplicationSetRolloutStep{{}, {}},
+						FailurePolicy: failurePolicy,
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+					{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+					{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+					{Application: "app4", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				},
+			},
+		}
+	}
+	apps := []v1alpha1.Application{
+		newRolloutFailureApp("app1", "v1", &previousApplicationSpec{Revision: "rev", Spec: oldSpec}),
+		newRolloutFailureApp("app2", "v1", &previousApplicationSpec{Revision: "rev", Spec: oldSpec}),
+		newRolloutFailureApp("app3", "v1", &previousApplicationSpec{Revision: "rev", Spec: oldSpec}),
+		newRolloutFailureApp("app4", "v1", &previousApplicationSpec{Revision: "old", Spec: oldSpec}),
+	}
+	apps[1].Status.Health.Status = health.HealthStatusDegraded
+	appStepMap := map[string]int{"app1": 0, "app2": 1, "app3": 1, "app4": 0}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	newReconciler := func(appSet *v1alpha1.ApplicationSet) (ApplicationSetReconciler, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(10)
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithStatusSubresource(appSet).Build()
+		return ApplicationSetReconciler{
+			Client:   client,
+			Scheme:   scheme,
+			Recorder: recorder,
+			Metrics:  appsetmetrics.NewFakeAppsetMetrics(),
+		}, recorder
+	}
+
+	t.Run("No failure policy", func(t *testing.T) {
+		appSet := newAppSet("")
+		r, _ := newReconciler(&appSet)
+		appsToSync, err := r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "rev")
+		require.NoError(t, err)
+		assert.Empty(t, appsToSync)
+		assert.Nil(t, appSet.Status.RolloutFailure)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		appSet := newAppSet(v1alpha1.RolloutFailurePolicyRollback)
+		r, recorder := newReconciler(&appSet)
+		appsToSync, err := r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "rev")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true}, appsToSync)
+		failure := appSet.Status.RolloutFailure
+		require.NotNil(t, failure)
+		assert.Equal(t, "app2", failure.Application)
+		assert.Equal(t, "2", failure.Step)
+		assert.Equal(t, "Application app2 is Degraded", failure.Message)
+		assert.Equal(t, "rev", failure.Revision)
+		assert.Equal(t, []string{"app1", "app2", "app3"}, failure.RolledBack)
+		assert.Equal(t, v1alpha1.ProgressiveSyncPending, appSet.Status.ApplicationStatus[0].Status)
+		assert.Equal(t, "Application is rolled back to its previous generated spec, since the rollout failed in step 2", appSet.Status.ApplicationStatus[0].Message)
+		assert.Equal(t, v1alpha1.ProgressiveSyncWaiting, appSet.Status.ApplicationStatus[2].Status)
+		assert.Equal(t, v1alpha1.ProgressiveSyncHealthy, appSet.Status.ApplicationStatus[3].Status)
+		assert.Equal(t, "Warning RolloutFailed Rollout failed in step 2: Application app2 is Degraded", <-recorder.Events)
+		assert.Equal(t, "Normal RolledBack Rolled back Applications app1, app2, app3 to their previous generated spec", <-recorder.Events)
+
+		assert.Empty(t, r.getAppsToSync(appSet, [][]string{{"app1", "app4"}, {"app2", "app3"}}, apps))
+		conditions := r.updateApplicationSetApplicationStatusConditions(t.Context(), &appSet)
+		require.Len(t, conditions, 2)
+		assert.Equal(t, v1alpha1.ApplicationSetConditionRolloutProgressing, conditions[1].Type)
+		assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, conditions[1].Status)
+		assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationSetRolloutFailed, conditions[1].Reason)
+		assert.Equal(t, "ApplicationSet rollout failed in step 2: Application app2 is Degraded. Rolled back 3 Applications to their previous generated spec", conditions[1].Message)
+
+		// the failure is kept until the generated Applications change
+		appsToSync, err = r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "rev")
+		require.NoError(t, err)
+		assert.Empty(t, appsToSync)
+		assert.NotNil(t, appSet.Status.RolloutFailure)
+
+		_, err = r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "fixed")
+		require.NoError(t, err)
+		assert.Nil(t, appSet.Status.RolloutFailure)
+	})
+
+	t.Run("Halt", func(t *testing.T) {
+		appSet := newAppSet(v1alpha1.RolloutFailurePolicyHalt)
+		r, _ := newReconciler(&appSet)
+		appsToSync, err := r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "rev")
+		require.NoError(t, err)
+
+		assert.Empty(t, appsToSync)
+		require.NotNil(t, appSet.Status.RolloutFailure)
+		assert.Empty(t, appSet.Status.RolloutFailure.RolledBack)
+		assert.Equal(t, v1alpha1.ProgressiveSyncHealthy, appSet.Status.ApplicationStatus[0].Status)
+
+		// the failure is cleared once the Application which failed the rollout becomes Healthy
+		appSet.Status.ApplicationStatus[1].Status = v1alpha1.ProgressiveSyncHealthy
+		_, err = r.updateApplicationSetRolloutFailure(t.Context(), logCtx, &appSet, apps, appStepMap, "rev")
+		require.NoError(t, err)
+		assert.Nil(t, appSet.Status.RolloutFailure)
+	})
+}
@@ -0,0 +1,151 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*ArtifactGenerator)(nil)
+
+// versionSlugInvalidChars matches the characters of a version which are replaced in its slug, so that it can be used in
+// DNS labels, e.g. in the names of namespaces.
+var versionSlugInvalidChars = regexp.MustCompile("[^a-z0-9]+")
+
+// ArtifactGenerator generates Applications for the tags of an OCI repository, or the versions of a chart within a Helm
+// repository.
+type ArtifactGenerator struct {
+	repos services.Repos
+}
+
+// artifactFilter is the compiled form of an ArtifactGeneratorFilter.
+type artifactFilter struct {
+	versionConstraint *semver.Constraints
+	versionMatch      *regexp.Regexp
+}
+
+func NewArtifactGenerator(repos services.Repos) Generator {
+	g := &ArtifactGenerator{
+		repos: repos,
+	}
+	return g
+}
+
+func (g *ArtifactGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 3 minutes, if no override is specified.
+
+	if appSetGenerator.Artifact.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.Artifact.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *ArtifactGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.Artifact.Template
+}
+
+func (g *ArtifactGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.Artifact == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	filters, err := compileArtifactFilters(appSetGenerator.Artifact.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for the Git generator, only globally-scoped repo credentials are used if the project is templated
+	project := resolveProjectName(appSet.Spec.Template.Spec.Project)
+
+	versions, err := g.repos.GetTags(context.TODO(), appSetGenerator.Artifact.RepoURL, appSetGenerator.Artifact.Chart, project)
+	if err != nil {
+		return nil, fmt.Errorf("error listing versions of %s: %w", appSetGenerator.Artifact.RepoURL, err)
+	}
+
+	res := []map[string]any{}
+	for _, version := range versions {
+		// By convention, Helm stores "+" in versions as "_" in OCI tags
+		semVersion, _ := semver.NewVersion(strings.ReplaceAll(version, "_", "+"))
+		if !matchArtifactFilters(version, semVersion, filters) {
+			continue
+		}
+
+		params := map[string]any{
+			"repoURL":      appSetGenerator.Artifact.RepoURL,
+			"chart":        appSetGenerator.Artifact.Chart,
+			"version":      version,
+			"version_slug": versionSlugInvalidChars.ReplaceAllString(strings.ToLower(version), "-"),
+			"major":        "",
+			"minor":        "",
+			"patch":        "",
+			"prerelease":   "",
+		}
+		if semVersion != nil {
+			params["major"] = strconv.FormatUint(semVersion.Major(), 10)
+			params["minor"] = strconv.FormatUint(semVersion.Minor(), 10)
+			params["patch"] = strconv.FormatUint(semVersion.Patch(), 10)
+			params["prerelease"] = semVersion.Prerelease()
+		}
+
+		err := appendTemplatedValues(appSetGenerator.Artifact.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+func compileArtifactFilters(filters []argoprojiov1alpha1.ArtifactGeneratorFilter) ([]*artifactFilter, error) {
+	outFilters := make([]*artifactFilter, 0, len(filters))
+	for _, filter := range filters {
+		outFilter := &artifactFilter{}
+		var err error
+		if filter.VersionConstraint != nil {
+			outFilter.versionConstraint, err = semver.NewConstraint(*filter.VersionConstraint)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing VersionConstraint %q: %w", *filter.VersionConstraint, err)
+			}
+		}
+		if filter.VersionMatch != nil {
+			outFilter.versionMatch, err = regexp.Compile(*filter.VersionMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling VersionMatch regexp %q: %w", *filter.VersionMatch, err)
+			}
+		}
+		outFilters = append(outFilters, outFilter)
+	}
+	return outFilters, nil
+}
+
+// matchArtifactFilters returns true if the version matches any of the filters, or if there are no filters.
+func matchArtifactFilters(version string, semVersion *semver.Version, filters []*artifactFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		if filter.versionConstraint != nil && (semVersion == nil || !filter.versionConstraint.Check(semVersion)) {
+			continue
+		}
+		if filter.versionMatch != nil && !filter.versionMatch.MatchString(version) {
+			continue
+		}
+		return true
+	}
+	return false
+}
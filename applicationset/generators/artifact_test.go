@@ -0,0 +1,125 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestArtifactGenerateParams(t *testing.T) {
+	versions := []string{"2.0.0", "1.2.0", "1.2.0-rc.1", "1.1.0_build.1", "latest"}
+
+	testCases := []struct {
+		name          string
+		filters       []argoprojiov1alpha1.ArtifactGeneratorFilter
+		values        map[string]string
+		repoError     error
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name: "all versions",
+			expected: []map[string]any{
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "2.0.0", "version_slug": "2-0-0", "major": "2", "minor": "0", "patch": "0", "prerelease": ""},
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "1.2.0", "version_slug": "1-2-0", "major": "1", "minor": "2", "patch": "0", "prerelease": ""},
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "1.2.0-rc.1", "version_slug": "1-2-0-rc-1", "major": "1", "minor": "2", "patch": "0", "prerelease": "rc.1"},
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "1.1.0_build.1", "version_slug": "1-1-0-build-1", "major": "1", "minor": "1", "patch": "0", "prerelease": ""},
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "latest", "version_slug": "latest", "major": "", "minor": "", "patch": "", "prerelease": ""},
+			},
+		},
+		{
+			name: "version constraint and regex",
+			filters: []argoprojiov1alpha1.ArtifactGeneratorFilter{{
+				VersionConstraint: ptr.To(">=1.2.0-0 <2.0.0"),
+				VersionMatch:      ptr.To("-rc\\."),
+			}},
+			values: map[string]string{"name": "guestbook-{{version_slug}}"},
+			expected: []map[string]any{
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "1.2.0-rc.1", "version_slug": "1-2-0-rc-1", "major": "1", "minor": "2", "patch": "0", "prerelease": "rc.1", "values.name": "guestbook-1-2-0-rc-1"},
+			},
+		},
+		{
+			name: "any of the filters",
+			filters: []argoprojiov1alpha1.ArtifactGeneratorFilter{
+				{VersionConstraint: ptr.To("~1.1.0")},
+				{VersionMatch: ptr.To("^latest$")},
+			},
+			expected: []map[string]any{
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "1.1.0_build.1", "version_slug": "1-1-0-build-1", "major": "1", "minor": "1", "patch": "0", "prerelease": ""},
+				{"repoURL": "https://charts.example.com", "chart": "guestbook", "version": "latest", "version_slug": "latest", "major": "", "minor": "", "patch": "", "prerelease": ""},
+			},
+		},
+		{
+			name:          "invalid version constraint",
+			filters:       []argoprojiov1alpha1.ArtifactGeneratorFilter{{VersionConstraint: ptr.To("not a constraint")}},
+			expectedError: "error parsing VersionConstraint \"not a constraint\"",
+		},
+		{
+			name:          "invalid regex",
+			filters:       []argoprojiov1alpha1.ArtifactGeneratorFilter{{VersionMatch: ptr.To("[")}},
+			expectedError: "error compiling VersionMatch regexp \"[\"",
+		},
+		{
+			name:          "error listing versions",
+			repoError:     errors.New("chart 'guestbook' not found in index"),
+			expectedError: "error listing versions of https://charts.example.com: chart 'guestbook' not found in index",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.NewRepos(t)
+			if testCase.repoError != nil || testCase.expected != nil {
+				argoCDServiceMock.EXPECT().GetTags(mock.Anything, "https://charts.example.com", "guestbook", "default").Return(versions, testCase.repoError)
+			}
+
+			artifactGenerator := NewArtifactGenerator(argoCDServiceMock)
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					Template: argoprojiov1alpha1.ApplicationSetTemplate{
+						Spec: argoprojiov1alpha1.ApplicationSpec{Project: "default"},
+					},
+				},
+			}
+
+			got, err := artifactGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				Artifact: &argoprojiov1alpha1.ArtifactGenerator{
+					RepoURL: "https://charts.example.com",
+					Chart:   "guestbook",
+					Filters: testCase.filters,
+					Values:  testCase.values,
+				},
+			}, &applicationSetInfo, nil)
+
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestArtifactGetRequeueAfter(t *testing.T) {
+	artifactGenerator := NewArtifactGenerator(nil)
+
+	assert.Equal(t, getDefaultRequeueAfter(), artifactGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Artifact: &argoprojiov1alpha1.ArtifactGenerator{},
+	}))
+	assert.Equal(t, 10*time.Minute, artifactGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Artifact: &argoprojiov1alpha1.ArtifactGenerator{RequeueAfterSeconds: ptr.To(int64(600))},
+	}))
+}
@@ -0,0 +1,229 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*ClusterProfileGenerator)(nil)
+
+// ClusterProfileGVR is the resource of the SIG-Multicluster ClusterProfile API.
+var ClusterProfileGVR = schema.GroupVersionResource{Group: "multicluster.x-k8s.io", Version: "v1alpha1", Resource: "clusterprofiles"}
+
+// ClusterProfileGenerator generates Applications for the clusters of a fleet inventory, described by ClusterProfiles,
+// which are registered with Argo CD.
+type ClusterProfileGenerator struct {
+	ctx            context.Context
+	dynClient      dynamic.Interface
+	clientset      kubernetes.Interface
+	namespace      string // namespace is the Argo CD namespace
+	fleetNamespace string // fleetNamespace is the namespace of the fleet inventory configured by the admin, if any
+}
+
+func NewClusterProfileGenerator(ctx context.Context, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string, fleetNamespace string) Generator {
+	g := &ClusterProfileGenerator{
+		ctx:            ctx,
+		dynClient:      dynClient,
+		clientset:      clientset,
+		namespace:      namespace,
+		fleetNamespace: fleetNamespace,
+	}
+	return g
+}
+
+func (g *ClusterProfileGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 3 minutes, if no override is specified.
+
+	if appSetGenerator.ClusterProfile.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.ClusterProfile.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *ClusterProfileGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.ClusterProfile.Template
+}
+
+func (g *ClusterProfileGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	logCtx := log.WithField("applicationset", appSet.GetName()).WithField("namespace", appSet.GetNamespace())
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.ClusterProfile == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	// ListCluster will include the local cluster in the list of clusters
+	clustersFromArgoCD, err := utils.ListClusters(g.ctx, g.clientset, g.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+
+	if clustersFromArgoCD == nil {
+		return nil, nil
+	}
+
+	clusters := map[string]utils.ClusterSpecifier{}
+	for _, cluster := range clustersFromArgoCD {
+		clusters[cluster.Name] = cluster
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&appSetGenerator.ClusterProfile.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting label selector: %w", err)
+	}
+
+	// ClusterProfiles are only read from the Argo CD namespace and the fleet namespace configured by the admin, as the
+	// namespace is set by the author of the ApplicationSet
+	namespace := appSetGenerator.ClusterProfile.Namespace
+	if namespace == "" {
+		namespace = g.namespace
+	}
+	if namespace != g.namespace && (g.fleetNamespace == "" || namespace != g.fleetNamespace) {
+		return nil, fmt.Errorf("cluster profiles can not be read from namespace %s, only from the Argo CD namespace or the configured fleet namespace", namespace)
+	}
+
+	clusterProfiles, err := g.dynClient.Resource(ClusterProfileGVR).Namespace(namespace).List(g.ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster profiles: %w", err)
+	}
+	logCtx.Debugf("cluster profiles matching labels: %d", len(clusterProfiles.Items))
+
+	res := []map[string]any{}
+	for _, clusterProfile := range clusterProfiles.Items {
+		profileLogCtx := logCtx.WithField("clusterProfile", clusterProfile.GetName())
+
+		properties := getClusterProfileProperties(&clusterProfile)
+		conditions := getClusterProfileConditions(&clusterProfile)
+
+		if missing := getMissingConditions(conditions, appSetGenerator.ClusterProfile.RequiredConditions); len(missing) > 0 {
+			profileLogCtx.Debugf("cluster profile is missing required conditions %v", missing)
+			continue
+		}
+
+		clusterName := clusterProfile.GetName()
+		if appSetGenerator.ClusterProfile.ClusterNameProperty != "" {
+			clusterName = properties[appSetGenerator.ClusterProfile.ClusterNameProperty]
+		}
+
+		cluster, ok := clusters[clusterName]
+		if !ok || clusterName == "" {
+			profileLogCtx.WithField("cluster", clusterName).Warning("unmatched cluster in Argo CD")
+			continue
+		}
+
+		params := getClusterProfileParameters(&clusterProfile, cluster, properties, conditions, appSet.Spec.GoTemplate)
+
+		err = appendTemplatedValues(appSetGenerator.ClusterProfile.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error appending templated values for cluster profile %s: %w", clusterProfile.GetName(), err)
+		}
+
+		res = append(res, params)
+		profileLogCtx.WithField("cluster", cluster.Name).Debug("matched cluster profile")
+	}
+
+	return res, nil
+}
+
+func getClusterProfileParameters(clusterProfile *unstructured.Unstructured, cluster utils.ClusterSpecifier, properties map[string]string, conditions map[string]string, useGoTemplate bool) map[string]any {
+	params := map[string]any{}
+
+	params["name"] = cluster.Name
+	params["nameNormalized"] = utils.SanitizeName(cluster.Name)
+	params["server"] = cluster.Server
+
+	displayName, _, _ := unstructured.NestedString(clusterProfile.Object, "spec", "displayName")
+	version, _, _ := unstructured.NestedString(clusterProfile.Object, "status", "version", "kubernetes")
+
+	if useGoTemplate {
+		params["clusterProfile"] = map[string]any{
+			"name":        clusterProfile.GetName(),
+			"namespace":   clusterProfile.GetNamespace(),
+			"displayName": displayName,
+			"version":     version,
+			"labels":      clusterProfile.GetLabels(),
+			"properties":  properties,
+			"conditions":  conditions,
+		}
+	} else {
+		params["clusterProfile.name"] = clusterProfile.GetName()
+		params["clusterProfile.namespace"] = clusterProfile.GetNamespace()
+		params["clusterProfile.displayName"] = displayName
+		params["clusterProfile.version"] = version
+
+		for key, value := range clusterProfile.GetLabels() {
+			params["clusterProfile.labels."+key] = value
+		}
+
+		for key, value := range properties {
+			params["clusterProfile.properties."+key] = value
+		}
+
+		for key, value := range conditions {
+			params["clusterProfile.conditions."+key] = value
+		}
+	}
+	return params
+}
+
+// getClusterProfileProperties returns the properties of the ClusterProfile status, keyed by their name.
+func getClusterProfileProperties(clusterProfile *unstructured.Unstructured) map[string]string {
+	res := map[string]string{}
+	properties, _, _ := unstructured.NestedSlice(clusterProfile.Object, "status", "properties")
+	for _, property := range properties {
+		p, ok := property.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(p, "name")
+		value, _, _ := unstructured.NestedString(p, "value")
+		if name != "" {
+			res[name] = value
+		}
+	}
+	return res
+}
+
+// getClusterProfileConditions returns the statuses of the conditions of the ClusterProfile status, keyed by their type.
+func getClusterProfileConditions(clusterProfile *unstructured.Unstructured) map[string]string {
+	res := map[string]string{}
+	conditions, _, _ := unstructured.NestedSlice(clusterProfile.Object, "status", "conditions")
+	for _, condition := range conditions {
+		c, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(c, "type")
+		status, _, _ := unstructured.NestedString(c, "status")
+		if conditionType != "" {
+			res[conditionType] = status
+		}
+	}
+	return res
+}
+
+func getMissingConditions(conditions map[string]string, requiredConditions []string) []string {
+	missing := []string{}
+	for _, conditionType := range requiredConditions {
+		if conditions[conditionType] != string(metav1.ConditionTrue) {
+			missing = append(missing, conditionType)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,257 @@
+package generators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newClusterProfile(name string, namespace string, labels map[string]any, properties map[string]string, conditions map[string]string) *unstructured.Unstructured {
+	statusProperties := []any{}
+	for key, value := range properties {
+		statusProperties = append(statusProperties, map[string]any{"name": key, "value": value})
+	}
+	statusConditions := []any{}
+	for key, value := range conditions {
+		statusConditions = append(statusConditions, map[string]any{"type": key, "status": value})
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+			"kind":       "ClusterProfile",
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+				"labels":    labels,
+			},
+			"spec": map[string]any{
+				"displayName": name,
+			},
+			"status": map[string]any{
+				"version":    map[string]any{"kubernetes": "1.33.1"},
+				"properties": statusProperties,
+				"conditions": statusConditions,
+			},
+		},
+	}
+}
+
+func TestGenerateParamsForClusterProfile(t *testing.T) {
+	clusterSecrets := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "staging-01",
+				Namespace: "namespace",
+				Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+			},
+			Data: map[string][]byte{
+				"config": []byte("{}"),
+				"name":   []byte("staging-01"),
+				"server": []byte("https://staging-01.example.com"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "production-01",
+				Namespace: "namespace",
+				Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+			},
+			Data: map[string][]byte{
+				"config": []byte("{}"),
+				"name":   []byte("production_01"),
+				"server": []byte("https://production-01.example.com"),
+			},
+		},
+	}
+
+	clusterProfiles := []runtime.Object{
+		newClusterProfile("staging-01", "namespace", map[string]any{"environment": "staging"},
+			map[string]string{"region": "eu-west-1"},
+			map[string]string{"ControlPlaneHealthy": "True", "Joined": "True"}),
+		newClusterProfile("production-01", "namespace", map[string]any{"environment": "production"},
+			map[string]string{"region": "eu-central-1", "argocd.argoproj.io/cluster-name": "production_01"},
+			map[string]string{"ControlPlaneHealthy": "False", "Joined": "True"}),
+		newClusterProfile("unregistered", "namespace", map[string]any{"environment": "staging"}, nil, nil),
+		newClusterProfile("staging-01", "fleet", map[string]any{"environment": "staging"}, nil, nil),
+		newClusterProfile("staging-01", "other", map[string]any{"environment": "staging"}, nil, nil),
+	}
+
+	testCases := []struct {
+		name          string
+		generator     argoprojiov1alpha1.ClusterProfileGenerator
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name:      "all cluster profiles",
+			generator: argoprojiov1alpha1.ClusterProfileGenerator{},
+			expected: []map[string]any{
+				{
+					"name": "staging-01", "nameNormalized": "staging-01", "server": "https://staging-01.example.com",
+					"clusterProfile.name": "staging-01", "clusterProfile.namespace": "namespace", "clusterProfile.displayName": "staging-01",
+					"clusterProfile.version": "1.33.1", "clusterProfile.labels.environment": "staging",
+					"clusterProfile.properties.region":              "eu-west-1",
+					"clusterProfile.conditions.ControlPlaneHealthy": "True", "clusterProfile.conditions.Joined": "True",
+				},
+			},
+		},
+		{
+			name: "cluster name property",
+			generator: argoprojiov1alpha1.ClusterProfileGenerator{
+				ClusterNameProperty: "argocd.argoproj.io/cluster-name",
+				Selector:            metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}},
+				Values:              map[string]string{"region": "{{clusterProfile.properties.region}}"},
+			},
+			expected: []map[string]any{
+				{
+					"name": "production_01", "nameNormalized": "production-01", "server": "https://production-01.example.com",
+					"clusterProfile.name": "production-01", "clusterProfile.namespace": "namespace", "clusterProfile.displayName": "production-01",
+					"clusterProfile.version": "1.33.1", "clusterProfile.labels.environment": "production",
+					"clusterProfile.properties.region": "eu-central-1", "clusterProfile.properties.argocd.argoproj.io/cluster-name": "production_01",
+					"clusterProfile.conditions.ControlPlaneHealthy": "False", "clusterProfile.conditions.Joined": "True",
+					"values.region": "eu-central-1",
+				},
+			},
+		},
+		{
+			name: "required conditions",
+			generator: argoprojiov1alpha1.ClusterProfileGenerator{
+				ClusterNameProperty: "argocd.argoproj.io/cluster-name",
+				RequiredConditions:  []string{"ControlPlaneHealthy"},
+			},
+			expected: []map[string]any{},
+		},
+		{
+			name: "namespace",
+			generator: argoprojiov1alpha1.ClusterProfileGenerator{
+				Namespace: "fleet",
+			},
+			expected: []map[string]any{
+				{
+					"name": "staging-01", "nameNormalized": "staging-01", "server": "https://staging-01.example.com",
+					"clusterProfile.name": "staging-01", "clusterProfile.namespace": "fleet", "clusterProfile.displayName": "staging-01",
+					"clusterProfile.version": "1.33.1", "clusterProfile.labels.environment": "staging",
+				},
+			},
+		},
+		{
+			name: "namespace which is not allowed",
+			generator: argoprojiov1alpha1.ClusterProfileGenerator{
+				Namespace: "other",
+			},
+			expectedError: "cluster profiles can not be read from namespace other, only from the Argo CD namespace or the configured fleet namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			appClientset := kubefake.NewSimpleClientset(clusterSecrets...)
+			fakeDynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				ClusterProfileGVR: "ClusterProfileList",
+			}, clusterProfiles...)
+
+			clusterProfileGenerator := NewClusterProfileGenerator(t.Context(), fakeDynClient, appClientset, "namespace", "fleet")
+
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{},
+			}
+
+			got, err := clusterProfileGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				ClusterProfile: &testCase.generator,
+			}, &applicationSetInfo, nil)
+
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestGenerateParamsForClusterProfileGoTemplate(t *testing.T) {
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "staging-01",
+			Namespace: "namespace",
+			Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+		},
+		Data: map[string][]byte{
+			"config": []byte("{}"),
+			"name":   []byte("staging-01"),
+			"server": []byte("https://staging-01.example.com"),
+		},
+	}
+	clusterProfile := newClusterProfile("staging-01", "namespace", map[string]any{"environment": "staging"},
+		map[string]string{"region": "eu-west-1"},
+		map[string]string{"ControlPlaneHealthy": "True"})
+
+	appClientset := kubefake.NewSimpleClientset(clusterSecret)
+	fakeDynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		ClusterProfileGVR: "ClusterProfileList",
+	}, clusterProfile)
+
+	clusterProfileGenerator := NewClusterProfileGenerator(t.Context(), fakeDynClient, appClientset, "namespace", "")
+
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	got, err := clusterProfileGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		ClusterProfile: &argoprojiov1alpha1.ClusterProfileGenerator{
+			RequiredConditions: []string{"ControlPlaneHealthy"},
+			Values:             map[string]string{"region": "{{ .clusterProfile.properties.region }}"},
+		},
+	}, &applicationSetInfo, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{
+			"name":           "staging-01",
+			"nameNormalized": "staging-01",
+			"server":         "https://staging-01.example.com",
+			"clusterProfile": map[string]any{
+				"name":        "staging-01",
+				"namespace":   "namespace",
+				"displayName": "staging-01",
+				"version":     "1.33.1",
+				"labels":      map[string]string{"environment": "staging"},
+				"properties":  map[string]string{"region": "eu-west-1"},
+				"conditions":  map[string]string{"ControlPlaneHealthy": "True"},
+			},
+			"values": map[string]string{"region": "eu-west-1"},
+		},
+	}, got)
+}
+
+func TestClusterProfileGetRequeueAfter(t *testing.T) {
+	clusterProfileGenerator := NewClusterProfileGenerator(t.Context(), nil, nil, "namespace", "")
+
+	assert.Equal(t, getDefaultRequeueAfter(), clusterProfileGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		ClusterProfile: &argoprojiov1alpha1.ClusterProfileGenerator{},
+	}))
+	assert.Equal(t, 30*time.Second, clusterProfileGenerator.GetRequeueAfter(&argoprojiov1alpha1.ApplicationSetGenerator{
+		ClusterProfile: &argoprojiov1alpha1.ClusterProfileGenerator{RequeueAfterSeconds: ptr.To(int64(30))},
+	}))
+}
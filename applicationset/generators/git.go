@@ -86,7 +86,7 @@ func (g *GitGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Applic
 			return nil, fmt.Errorf("error getting project %s: %w", project, err)
 		}
 		// we need to verify the signature on the Git revision if GPG is enabled
-		verifyCommit = len(appProject.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()
+		verifyCommit = appProject.HasSignatureKeys() && gpg.IsGPGEnabled()
 	}
 
 	// If the project field is templated, we cannot resolve the project name, so we pass an empty string to the repo-server.
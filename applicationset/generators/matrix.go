@@ -98,6 +98,8 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			ClusterProfile:          appSetBaseGenerator.ClusterProfile,
+			Artifact:                appSetBaseGenerator.Artifact,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -137,6 +139,8 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			ClusterProfile:          r.ClusterProfile,
+			Artifact:                r.Artifact,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
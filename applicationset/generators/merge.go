@@ -153,6 +153,8 @@ func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Applic
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			ClusterProfile:          appSetBaseGenerator.ClusterProfile,
+			Artifact:                appSetBaseGenerator.Artifact,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
@@ -189,6 +191,8 @@ func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.App
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			ClusterProfile:          r.ClusterProfile,
+			Artifact:                r.Artifact,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
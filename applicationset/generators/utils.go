@@ -10,7 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, clusterProfileNamespace string) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
 		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, controllerNamespace),
@@ -19,6 +19,8 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, controllerNamespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
 		"Plugin":                  NewPluginGenerator(c, controllerNamespace),
+		"ClusterProfile":          NewClusterProfileGenerator(ctx, dynamicClient, k8sClient, controllerNamespace, clusterProfileNamespace),
+		"Artifact":                NewArtifactGenerator(argoCDService),
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,6 +31,8 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"ClusterProfile":          terminalGenerators["ClusterProfile"],
+		"Artifact":                terminalGenerators["Artifact"],
 		"Matrix":                  NewMatrixGenerator(terminalGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
@@ -41,6 +45,8 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"ClusterProfile":          terminalGenerators["ClusterProfile"],
+		"Artifact":                terminalGenerators["Artifact"],
 		"Matrix":                  NewMatrixGenerator(nestedGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
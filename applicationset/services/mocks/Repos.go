@@ -226,3 +226,83 @@ func (_c *Repos_GetFiles_Call) RunAndReturn(run func(ctx context.Context, repoUR
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetTags provides a mock function for the type Repos
+func (_mock *Repos) GetTags(ctx context.Context, repoURL string, chart string, project string) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, chart, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, chart, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []string); ok {
+		r0 = returnFunc(ctx, repoURL, chart, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, chart, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type Repos_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - chart string
+//   - project string
+func (_e *Repos_Expecter) GetTags(ctx interface{}, repoURL interface{}, chart interface{}, project interface{}) *Repos_GetTags_Call {
+	return &Repos_GetTags_Call{Call: _e.mock.On("GetTags", ctx, repoURL, chart, project)}
+}
+
+func (_c *Repos_GetTags_Call) Run(run func(ctx context.Context, repoURL string, chart string, project string)) *Repos_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetTags_Call) Return(strings []string, err error) *Repos_GetTags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repos_GetTags_Call) RunAndReturn(run func(ctx context.Context, repoURL string, chart string, project string) ([]string, error)) *Repos_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,7 +2,9 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
@@ -41,6 +43,7 @@ type AzureDevOpsService struct {
 
 var (
 	_ PullRequestService       = (*AzureDevOpsService)(nil)
+	_ PullRequestUpserter      = (*AzureDevOpsService)(nil)
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
@@ -121,6 +124,140 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// Upsert opens or updates a pull request in the configured repository.
+func (a *AzureDevOpsService) Upsert(ctx context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	sourceRefName := "refs/heads/" + opts.Branch
+	targetRefName := "refs/heads/" + opts.TargetBranch
+	existing, err := client.GetPullRequests(ctx, git.GetPullRequestsArgs{
+		Project:      &a.project,
+		RepositoryId: &a.repo,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Status:        &git.PullRequestStatusValues.Active,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests for repository %s/%s: %w", a.project, a.repo, err)
+	}
+
+	var pr *git.GitPullRequest
+	if existing != nil && len(*existing) > 0 && (*existing)[0].PullRequestId != nil {
+		pr, err = client.UpdatePullRequest(ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				Title:       &opts.Title,
+				Description: &opts.Body,
+			},
+			RepositoryId:  &a.repo,
+			PullRequestId: (*existing)[0].PullRequestId,
+			Project:       &a.project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update pull request %d: %w", *(*existing)[0].PullRequestId, err)
+		}
+		// Labels cannot be set when updating a pull request, so the missing ones are added one by one.
+		labels := convertLabels((*existing)[0].Labels)
+		for i := range a.labels {
+			if slices.Contains(labels, a.labels[i]) {
+				continue
+			}
+			_, err = client.CreatePullRequestLabel(ctx, git.CreatePullRequestLabelArgs{
+				Label:         &core.WebApiCreateTagRequestData{Name: &a.labels[i]},
+				RepositoryId:  &a.repo,
+				PullRequestId: (*existing)[0].PullRequestId,
+				Project:       &a.project,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add label %q to pull request %d: %w", a.labels[i], *(*existing)[0].PullRequestId, err)
+			}
+			labels = append(labels, a.labels[i])
+		}
+		if pr != nil {
+			pr.Labels = toWebAPITagDefinitions(labels)
+		}
+	} else {
+		pr, err = client.CreatePullRequest(ctx, git.CreatePullRequestArgs{
+			GitPullRequestToCreate: &git.GitPullRequest{
+				Title:         &opts.Title,
+				Description:   &opts.Body,
+				SourceRefName: &sourceRefName,
+				TargetRefName: &targetRefName,
+				Labels:        toWebAPITagDefinitions(a.labels),
+			},
+			RepositoryId: &a.repo,
+			Project:      &a.project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pull request: %w", err)
+		}
+	}
+	if pr == nil || pr.PullRequestId == nil {
+		return nil, errors.New("received an empty pull request from Azure DevOps")
+	}
+
+	pullRequest := &PullRequest{
+		Number:       int64(*pr.PullRequestId),
+		Title:        opts.Title,
+		Branch:       opts.Branch,
+		TargetBranch: opts.TargetBranch,
+		Labels:       convertLabels(pr.Labels),
+	}
+	if pr.LastMergeSourceCommit != nil && pr.LastMergeSourceCommit.CommitId != nil {
+		pullRequest.HeadSHA = *pr.LastMergeSourceCommit.CommitId
+	}
+	if pr.CreatedBy != nil && pr.CreatedBy.UniqueName != nil {
+		pullRequest.Author = strings.Split(*pr.CreatedBy.UniqueName, "@")[0]
+	}
+	if pr.Repository != nil && pr.Repository.WebUrl != nil {
+		pullRequest.URL = fmt.Sprintf("%s/pullrequest/%d", *pr.Repository.WebUrl, *pr.PullRequestId)
+	}
+	return pullRequest, nil
+}
+
+// GetState returns the state of the pull request with the given number.
+func (a *AzureDevOpsService) GetState(ctx context.Context, number int64) (PullRequestState, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+	id := int(number)
+	pr, err := client.GetPullRequestById(ctx, git.GetPullRequestByIdArgs{
+		PullRequestId: &id,
+		Project:       &a.project,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pull request %d: %w", number, err)
+	}
+	if pr == nil || pr.Status == nil {
+		return "", fmt.Errorf("received pull request %d without status from Azure DevOps", number)
+	}
+	switch *pr.Status {
+	case git.PullRequestStatusValues.Completed:
+		return PullRequestStateMerged, nil
+	case git.PullRequestStatusValues.Abandoned:
+		return PullRequestStateClosed, nil
+	default:
+		return PullRequestStateOpen, nil
+	}
+}
+
+// toWebAPITagDefinitions converts strings to WebApiTagDefinitions, or nil if there are none
+func toWebAPITagDefinitions(labels []string) *[]core.WebApiTagDefinition {
+	if len(labels) == 0 {
+		return nil
+	}
+	tags := make([]core.WebApiTagDefinition, len(labels))
+	for i := range labels {
+		tags[i] = core.WebApiTagDefinition{Name: &labels[i]}
+	}
+	return &tags
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
@@ -246,3 +246,99 @@ func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestAzureDevOpsUpsert(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	webURL := "https://dev.azure.com/myorg/myorg_project/_git/myorg_project_repo"
+
+	t.Run("creates pull request", func(t *testing.T) {
+		gitClientMock := azureMock.NewClient(t)
+		clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+		clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+		gitClientMock.EXPECT().GetPullRequests(mock.Anything, mock.MatchedBy(func(args git.GetPullRequestsArgs) bool {
+			return *args.SearchCriteria.SourceRefName == "refs/heads/environments/dev-next" &&
+				*args.SearchCriteria.TargetRefName == "refs/heads/environments/dev" &&
+				*args.SearchCriteria.Status == git.PullRequestStatusValues.Active
+		})).Return(&[]git.GitPullRequest{}, nil)
+		gitClientMock.EXPECT().CreatePullRequest(mock.Anything, mock.MatchedBy(func(args git.CreatePullRequestArgs) bool {
+			return *args.GitPullRequestToCreate.Title == "Promote" && convertLabels(args.GitPullRequestToCreate.Labels)[0] == "hydrator"
+		})).Return(&git.GitPullRequest{
+			PullRequestId: createIntPtr(42),
+			Labels:        createLabelsPtr([]core.WebApiTagDefinition{{Name: createStringPtr("hydrator")}}),
+			Repository:    &git.GitRepository{WebUrl: createStringPtr(webURL)},
+		}, nil)
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repo: repoName, labels: []string{"hydrator"}}
+		pr, err := provider.Upsert(t.Context(), &UpsertOptions{Title: "Promote", Body: "body", Branch: "environments/dev-next", TargetBranch: "environments/dev"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), pr.Number)
+		assert.Equal(t, webURL+"/pullrequest/42", pr.URL)
+		assert.Equal(t, []string{"hydrator"}, pr.Labels)
+	})
+
+	t.Run("updates existing pull request and adds missing labels", func(t *testing.T) {
+		gitClientMock := azureMock.NewClient(t)
+		clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+		clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+		gitClientMock.EXPECT().GetPullRequests(mock.Anything, mock.Anything).Return(&[]git.GitPullRequest{{
+			PullRequestId: createIntPtr(42),
+			Labels:        createLabelsPtr([]core.WebApiTagDefinition{{Name: createStringPtr("existing")}}),
+		}}, nil)
+		gitClientMock.EXPECT().UpdatePullRequest(mock.Anything, mock.MatchedBy(func(args git.UpdatePullRequestArgs) bool {
+			return *args.PullRequestId == 42 && *args.GitPullRequestToUpdate.Title == "Promote"
+		})).Return(&git.GitPullRequest{
+			PullRequestId: createIntPtr(42),
+			Labels:        createLabelsPtr([]core.WebApiTagDefinition{{Name: createStringPtr("existing")}}),
+			Repository:    &git.GitRepository{WebUrl: createStringPtr(webURL)},
+		}, nil)
+		gitClientMock.EXPECT().CreatePullRequestLabel(mock.Anything, mock.MatchedBy(func(args git.CreatePullRequestLabelArgs) bool {
+			return *args.PullRequestId == 42 && *args.Label.Name == "hydrator"
+		})).Return(&core.WebApiTagDefinition{Name: createStringPtr("hydrator")}, nil).Once()
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repo: repoName, labels: []string{"existing", "hydrator"}}
+		pr, err := provider.Upsert(t.Context(), &UpsertOptions{Title: "Promote", Body: "body", Branch: "environments/dev-next", TargetBranch: "environments/dev"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), pr.Number)
+		assert.Equal(t, []string{"existing", "hydrator"}, pr.Labels)
+	})
+
+	t.Run("fails to add label", func(t *testing.T) {
+		gitClientMock := azureMock.NewClient(t)
+		clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+		clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+		gitClientMock.EXPECT().GetPullRequests(mock.Anything, mock.Anything).Return(&[]git.GitPullRequest{{PullRequestId: createIntPtr(42)}}, nil)
+		gitClientMock.EXPECT().UpdatePullRequest(mock.Anything, mock.Anything).Return(&git.GitPullRequest{PullRequestId: createIntPtr(42)}, nil)
+		gitClientMock.EXPECT().CreatePullRequestLabel(mock.Anything, mock.Anything).Return(nil, errors.New("forbidden"))
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: teamProject, repo: repoName, labels: []string{"hydrator"}}
+		_, err := provider.Upsert(t.Context(), &UpsertOptions{Title: "Promote", Body: "body", Branch: "environments/dev-next", TargetBranch: "environments/dev"})
+		require.ErrorContains(t, err, `failed to add label "hydrator" to pull request 42: forbidden`)
+	})
+}
+
+func TestAzureDevOpsGetState(t *testing.T) {
+	tests := []struct {
+		status   git.PullRequestStatus
+		expected PullRequestState
+	}{
+		{status: git.PullRequestStatusValues.Active, expected: PullRequestStateOpen},
+		{status: git.PullRequestStatusValues.Completed, expected: PullRequestStateMerged},
+		{status: git.PullRequestStatusValues.Abandoned, expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(string(test.status), func(t *testing.T) {
+			gitClientMock := azureMock.NewClient(t)
+			clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+			clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+			gitClientMock.EXPECT().GetPullRequestById(mock.Anything, mock.MatchedBy(func(args git.GetPullRequestByIdArgs) bool {
+				return *args.PullRequestId == 42 && *args.Project == "myorg_project"
+			})).Return(&git.GitPullRequest{PullRequestId: createIntPtr(42), Status: &test.status}, nil)
+
+			provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: "myorg_project", repo: "myorg_project_repo"}
+			state, err := provider.GetState(t.Context(), 42)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
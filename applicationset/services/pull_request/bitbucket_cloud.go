@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/ktrysmt/go-bitbucket"
@@ -23,6 +24,16 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Links       BitbucketCloudPullRequestLinks       `json:"links"`
+	State       string                               `json:"state"`
+}
+
+type BitbucketCloudPullRequestLinks struct {
+	HTML BitbucketCloudPullRequestLink `json:"html"`
+}
+
+type BitbucketCloudPullRequestLink struct {
+	Href string `json:"href"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -60,7 +71,10 @@ type PullRequestResponse struct {
 	Items    []PullRequest `json:"values"`
 }
 
-var _ PullRequestService = (*BitbucketCloudService)(nil)
+var (
+	_ PullRequestService  = (*BitbucketCloudService)(nil)
+	_ PullRequestUpserter = (*BitbucketCloudService)(nil)
+)
 
 func parseURL(uri string) (*url.URL, error) {
 	if uri == "" {
@@ -167,3 +181,88 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 
 	return pullRequests, nil
 }
+
+// Upsert opens or updates a pull request. Labels are not supported by Bitbucket Cloud.
+func (b *BitbucketCloudService) Upsert(_ context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	response, err := b.client.Repositories.PullRequests.Gets(&bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		States:   []string{"OPEN"},
+		Query:    fmt.Sprintf("source.branch.name = %q AND destination.branch.name = %q", opts.Branch, opts.TargetBranch),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.owner, b.repositorySlug, err)
+	}
+	resp, ok := response.(map[string]any)
+	if !ok {
+		return nil, errors.New("unknown type returned from bitbucket pull requests")
+	}
+	var pulls []BitbucketCloudPullRequest
+	if err := convertBitbucketCloudResponse(resp["values"], &pulls); err != nil {
+		return nil, err
+	}
+
+	pullRequestOpts := &bitbucket.PullRequestsOptions{
+		Owner:             b.owner,
+		RepoSlug:          b.repositorySlug,
+		Title:             opts.Title,
+		Description:       opts.Body,
+		SourceBranch:      opts.Branch,
+		DestinationBranch: opts.TargetBranch,
+	}
+	if len(pulls) > 0 {
+		pullRequestOpts.ID = strconv.Itoa(pulls[0].ID)
+		response, err = b.client.Repositories.PullRequests.Update(pullRequestOpts)
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request %d for %s/%s: %w", pulls[0].ID, b.owner, b.repositorySlug, err)
+		}
+	} else {
+		response, err = b.client.Repositories.PullRequests.Create(pullRequestOpts)
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.owner, b.repositorySlug, err)
+		}
+	}
+
+	var pull BitbucketCloudPullRequest
+	if err := convertBitbucketCloudResponse(response, &pull); err != nil {
+		return nil, err
+	}
+	return &PullRequest{
+		Number:       int64(pull.ID),
+		Title:        pull.Title,
+		Branch:       pull.Source.Branch.Name,
+		TargetBranch: pull.Destination.Branch.Name,
+		HeadSHA:      pull.Source.Commit.Hash,
+		Author:       pull.Author.Nickname,
+		URL:          pull.Links.HTML.Href,
+	}, nil
+}
+
+// GetState returns the state of the pull request with the given ID.
+func (b *BitbucketCloudService) GetState(_ context.Context, number int64) (PullRequestState, error) {
+	response, err := b.client.Repositories.PullRequests.Get(&bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		ID:       strconv.FormatInt(number, 10),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting pull request %d for %s/%s: %w", number, b.owner, b.repositorySlug, err)
+	}
+	var pull BitbucketCloudPullRequest
+	if err := convertBitbucketCloudResponse(response, &pull); err != nil {
+		return "", err
+	}
+	return bitbucketPullRequestState(pull.State), nil
+}
+
+// convertBitbucketCloudResponse converts the untyped response of the Bitbucket Cloud client into the given type.
+func convertBitbucketCloudResponse(response any, out any) error {
+	jsonStr, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+	if err := json.Unmarshal(jsonStr, out); err != nil {
+		return fmt.Errorf("error unmarshalling json to type '%T': %w", out, err)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -518,3 +519,106 @@ func TestBitbucketCloudListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestBitbucketCloudUpsert(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{
+			name:           "creates pull request",
+			existing:       `{"size": 0, "values": []}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/repositories/OWNER/REPO/pullrequests",
+		},
+		{
+			name:           "updates existing pull request",
+			existing:       `{"size": 1, "values": [{"id": 101}]}`,
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/repositories/OWNER/REPO/pullrequests/101",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var written bool
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				path := strings.TrimSuffix(r.URL.Path, "/")
+				switch {
+				case r.Method == http.MethodGet && path == "/repositories/OWNER/REPO/pullrequests":
+					assert.Equal(t, "OPEN", r.URL.Query().Get("state"))
+					assert.Equal(t, `source.branch.name = "environments/dev-next" AND destination.branch.name = "environments/dev"`, r.URL.Query().Get("q"))
+					_, _ = io.WriteString(w, test.existing)
+				case r.Method == test.expectedMethod && path == test.expectedPath:
+					written = true
+					_, _ = io.WriteString(w, `{
+						"id": 101,
+						"title": "Promote",
+						"state": "OPEN",
+						"source": {"branch": {"name": "environments/dev-next"}, "commit": {"hash": "abc"}},
+						"destination": {"branch": {"name": "environments/dev"}},
+						"author": {"nickname": "argocd"},
+						"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/101"}}
+					}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+			require.NoError(t, err)
+
+			pr, err := svc.(PullRequestUpserter).Upsert(t.Context(), &UpsertOptions{
+				Title:        "Promote",
+				Body:         "body",
+				Branch:       "environments/dev-next",
+				TargetBranch: "environments/dev",
+			})
+			require.NoError(t, err)
+			assert.True(t, written)
+			assert.Equal(t, int64(101), pr.Number)
+			assert.Equal(t, "https://bitbucket.org/OWNER/REPO/pull-requests/101", pr.URL)
+			assert.Equal(t, "environments/dev-next", pr.Branch)
+			assert.Equal(t, "environments/dev", pr.TargetBranch)
+			assert.Equal(t, "abc", pr.HeadSHA)
+			assert.Equal(t, "argocd", pr.Author)
+		})
+	}
+}
+
+func TestBitbucketCloudGetState(t *testing.T) {
+	tests := []struct {
+		state    string
+		expected PullRequestState
+	}{
+		{state: "OPEN", expected: PullRequestStateOpen},
+		{state: "MERGED", expected: PullRequestStateMerged},
+		{state: "DECLINED", expected: PullRequestStateClosed},
+		{state: "SUPERSEDED", expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(test.state, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method != http.MethodGet || strings.TrimSuffix(r.URL.Path, "/") != "/repositories/OWNER/REPO/pullrequests/101" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, `{"id": 101, "state": "`+test.state+`"}`)
+			}))
+			defer ts.Close()
+
+			svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+			require.NoError(t, err)
+
+			state, err := svc.(PullRequestUpserter).GetState(t.Context(), 101)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
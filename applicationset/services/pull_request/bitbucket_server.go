@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -19,7 +20,10 @@ type BitbucketService struct {
 	// labels         []string
 }
 
-var _ PullRequestService = (*BitbucketService)(nil)
+var (
+	_ PullRequestService  = (*BitbucketService)(nil)
+	_ PullRequestUpserter = (*BitbucketService)(nil)
+)
 
 func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	bitbucketConfig := bitbucketv1.NewConfiguration(url)
@@ -100,3 +104,109 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// Upsert opens or updates a pull request. Labels are not supported by Bitbucket Server.
+func (b *BitbucketService) Upsert(_ context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	response, err := b.client.DefaultApi.GetPullRequestsPage(b.projectKey, b.repositorySlug, map[string]any{
+		"state":     "OPEN",
+		"direction": "OUTGOING",
+		"at":        "refs/heads/" + opts.Branch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pulls, err := bitbucketv1.GetPullRequestsResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+
+	var existing *bitbucketv1.PullRequest
+	for i := range pulls {
+		if pulls[i].ToRef.DisplayID == opts.TargetBranch {
+			existing = &pulls[i]
+			break
+		}
+	}
+
+	if existing != nil {
+		response, err = b.client.DefaultApi.UpdatePullRequest(b.projectKey, b.repositorySlug, &bitbucketv1.EditPullRequestOptions{
+			ID:              int64(existing.ID),
+			Version:         strconv.Itoa(int(existing.Version)),
+			Title:           opts.Title,
+			Description:     opts.Body,
+			TargetBranchRef: existing.ToRef,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request %d for %s/%s: %w", existing.ID, b.projectKey, b.repositorySlug, err)
+		}
+	} else {
+		response, err = b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+			Title:       opts.Title,
+			Description: opts.Body,
+			State:       "OPEN",
+			Open:        true,
+			FromRef:     b.branchRef(opts.Branch),
+			ToRef:       b.branchRef(opts.TargetBranch),
+			Reviewers:   []bitbucketv1.UserWithMetadata{},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+		}
+	}
+
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pullRequest := &PullRequest{
+		Number:       int64(pull.ID),
+		Title:        pull.Title,
+		Branch:       pull.FromRef.DisplayID,
+		TargetBranch: pull.ToRef.DisplayID,
+		HeadSHA:      pull.FromRef.LatestCommit,
+		Labels:       []string{},
+	}
+	if pull.Author != nil {
+		pullRequest.Author = pull.Author.User.Name
+	}
+	if len(pull.Links.Self) > 0 {
+		pullRequest.URL = pull.Links.Self[0].Href
+	}
+	return pullRequest, nil
+}
+
+// GetState returns the state of the pull request with the given ID.
+func (b *BitbucketService) GetState(_ context.Context, number int64) (PullRequestState, error) {
+	response, err := b.client.DefaultApi.GetPullRequest(b.projectKey, b.repositorySlug, int(number))
+	if err != nil {
+		return "", fmt.Errorf("error getting pull request %d for %s/%s: %w", number, b.projectKey, b.repositorySlug, err)
+	}
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return "", fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	return bitbucketPullRequestState(pull.State), nil
+}
+
+// bitbucketPullRequestState converts the state of a Bitbucket Server or Bitbucket Cloud pull request.
+func bitbucketPullRequestState(state string) PullRequestState {
+	switch state {
+	case "MERGED":
+		return PullRequestStateMerged
+	case "DECLINED", "SUPERSEDED":
+		return PullRequestStateClosed
+	default:
+		return PullRequestStateOpen
+	}
+}
+
+// branchRef returns a reference to the given branch of the repository, as expected by the pull request endpoints.
+func (b *BitbucketService) branchRef(branch string) bitbucketv1.PullRequestRef {
+	return bitbucketv1.PullRequestRef{
+		ID: "refs/heads/" + branch,
+		Repository: bitbucketv1.Repository{
+			Slug:    b.repositorySlug,
+			Project: &bitbucketv1.Project{Key: b.projectKey},
+		},
+	}
+}
@@ -536,3 +536,103 @@ func TestBitbucketServerListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestBitbucketServerUpsert(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{
+			name:           "creates pull request",
+			existing:       `{"size": 1, "isLastPage": true, "values": [{"id": 100, "version": 1, "toRef": {"displayId": "main"}}]}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests",
+		},
+		{
+			name:           "updates existing pull request",
+			existing:       `{"size": 1, "isLastPage": true, "values": [{"id": 101, "version": 3, "toRef": {"id": "refs/heads/environments/dev", "displayId": "environments/dev"}}]}`,
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var written bool
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests":
+					assert.Equal(t, "OPEN", r.URL.Query().Get("state"))
+					assert.Equal(t, "refs/heads/environments/dev-next", r.URL.Query().Get("at"))
+					_, _ = io.WriteString(w, test.existing)
+				case r.Method == test.expectedMethod && r.URL.Path == test.expectedPath:
+					written = true
+					_, _ = io.WriteString(w, `{
+						"id": 101,
+						"title": "Promote",
+						"fromRef": {"displayId": "environments/dev-next", "latestCommit": "abc"},
+						"toRef": {"displayId": "environments/dev"},
+						"author": {"user": {"name": "argocd"}},
+						"links": {"self": [{"href": "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/101"}]}
+					}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer ts.Close()
+
+			svc, err := NewBitbucketServiceBearerToken(t.Context(), "token", ts.URL, "PROJECT", "REPO", "", false, nil)
+			require.NoError(t, err)
+
+			pr, err := svc.(PullRequestUpserter).Upsert(t.Context(), &UpsertOptions{
+				Title:        "Promote",
+				Body:         "body",
+				Branch:       "environments/dev-next",
+				TargetBranch: "environments/dev",
+			})
+			require.NoError(t, err)
+			assert.True(t, written)
+			assert.Equal(t, int64(101), pr.Number)
+			assert.Equal(t, "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/101", pr.URL)
+			assert.Equal(t, "environments/dev-next", pr.Branch)
+			assert.Equal(t, "environments/dev", pr.TargetBranch)
+			assert.Equal(t, "abc", pr.HeadSHA)
+			assert.Equal(t, "argocd", pr.Author)
+		})
+	}
+}
+
+func TestBitbucketServerGetState(t *testing.T) {
+	tests := []struct {
+		state    string
+		expected PullRequestState
+	}{
+		{state: "OPEN", expected: PullRequestStateOpen},
+		{state: "MERGED", expected: PullRequestStateMerged},
+		{state: "DECLINED", expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(test.state, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method != http.MethodGet || r.URL.Path != "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/101" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, `{"id": 101, "state": "`+test.state+`"}`)
+			}))
+			defer ts.Close()
+
+			svc, err := NewBitbucketServiceBearerToken(t.Context(), "token", ts.URL, "PROJECT", "REPO", "", false, nil)
+			require.NoError(t, err)
+
+			state, err := svc.(PullRequestUpserter).GetState(t.Context(), 101)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
@@ -17,7 +18,10 @@ type GiteaService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService  = (*GiteaService)(nil)
+	_ PullRequestUpserter = (*GiteaService)(nil)
+)
 
 func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool) (PullRequestService, error) {
 	if token == "" {
@@ -48,12 +52,9 @@ func NewGiteaService(token, url, owner, repo string, labels []string, insecure b
 }
 
 func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
-	opts := gitea.ListPullRequestsOptions{
-		State: gitea.StateOpen,
-	}
 	g.client.SetContext(ctx)
 	list := []*PullRequest{}
-	prs, resp, err := g.client.ListRepoPullRequests(g.owner, g.repo, opts)
+	prs, resp, err := g.listOpenPullRequests()
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			// return a custom error indicating that the repository is not found,
@@ -80,6 +81,96 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	return list, nil
 }
 
+// Upsert opens or updates a pull request. Labels are not applied, since Gitea only accepts label IDs when creating a
+// pull request.
+func (g *GiteaService) Upsert(ctx context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	g.client.SetContext(ctx)
+	prs, _, err := g.listOpenPullRequests()
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+
+	var pr *gitea.PullRequest
+	for _, existing := range prs {
+		if existing.Head != nil && existing.Head.Ref == opts.Branch && existing.Base != nil && existing.Base.Ref == opts.TargetBranch {
+			pr = existing
+			break
+		}
+	}
+
+	if pr != nil {
+		pr, _, err = g.client.EditPullRequest(g.owner, g.repo, pr.Index, gitea.EditPullRequestOption{
+			Title: opts.Title,
+			Body:  &opts.Body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request #%d for %s/%s: %w", pr.Index, g.owner, g.repo, err)
+		}
+	} else {
+		pr, _, err = g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+			Head:  opts.Branch,
+			Base:  opts.TargetBranch,
+			Title: opts.Title,
+			Body:  opts.Body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+		}
+	}
+
+	pullRequest := &PullRequest{
+		Number:       pr.Index,
+		Title:        pr.Title,
+		Branch:       opts.Branch,
+		TargetBranch: opts.TargetBranch,
+		Labels:       getGiteaPRLabelNames(pr.Labels),
+		URL:          pr.HTMLURL,
+	}
+	if pr.Head != nil {
+		pullRequest.HeadSHA = pr.Head.Sha
+	}
+	if pr.Poster != nil {
+		pullRequest.Author = pr.Poster.UserName
+	}
+	return pullRequest, nil
+}
+
+// GetState returns the state of the pull request with the given number.
+func (g *GiteaService) GetState(ctx context.Context, number int64) (PullRequestState, error) {
+	g.client.SetContext(ctx)
+	pr, _, err := g.client.GetPullRequest(g.owner, g.repo, number)
+	if err != nil {
+		return "", fmt.Errorf("error getting pull request #%d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	switch {
+	case pr.HasMerged:
+		return PullRequestStateMerged, nil
+	case pr.State == gitea.StateClosed:
+		return PullRequestStateClosed, nil
+	default:
+		return PullRequestStateOpen, nil
+	}
+}
+
+// listOpenPullRequests lists the open pull requests of the repository, following all result pages.
+func (g *GiteaService) listOpenPullRequests() ([]*gitea.PullRequest, *gitea.Response, error) {
+	opts := gitea.ListPullRequestsOptions{
+		State: gitea.StateOpen,
+	}
+	var prs []*gitea.PullRequest
+	for {
+		page, resp, err := g.client.ListRepoPullRequests(g.owner, g.repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		prs = append(prs, page...)
+		if resp == nil || resp.NextPage == 0 {
+			return prs, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
@@ -371,3 +371,106 @@ func TestGiteaListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGiteaUpsert(t *testing.T) {
+	tests := []struct {
+		name           string
+		secondPage     string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{
+			name:           "creates pull request",
+			secondPage:     `[]`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/api/v1/repos/test-argocd/pr-test/pulls",
+		},
+		{
+			name:           "updates existing pull request on a later page",
+			secondPage:     `[{"number": 7, "head": {"ref": "environments/dev-next"}, "base": {"ref": "environments/dev"}}]`,
+			expectedMethod: http.MethodPatch,
+			expectedPath:   "/api/v1/repos/test-argocd/pr-test/pulls/7",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var written bool
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/api/v1/version":
+					_, _ = io.WriteString(w, `{"version":"1.17.0"}`)
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/test-argocd/pr-test/pulls":
+					assert.Equal(t, "open", r.URL.Query().Get("state"))
+					if r.URL.Query().Get("page") == "2" {
+						_, _ = io.WriteString(w, test.secondPage)
+						return
+					}
+					w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/repos/test-argocd/pr-test/pulls?page=2&state=open>; rel="next"`, server.URL))
+					_, _ = io.WriteString(w, `[{"number": 1, "head": {"ref": "feature"}, "base": {"ref": "environments/dev"}}]`)
+				case r.Method == test.expectedMethod && r.URL.Path == test.expectedPath:
+					written = true
+					_, _ = io.WriteString(w, `{"number": 7, "title": "Promote", "html_url": "https://gitea.com/test-argocd/pr-test/pulls/7", "head": {"ref": "environments/dev-next", "sha": "abc"}, "base": {"ref": "environments/dev"}, "user": {"login": "argocd"}}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			svc, err := NewGiteaService("token", server.URL, "test-argocd", "pr-test", nil, false)
+			require.NoError(t, err)
+
+			pr, err := svc.(PullRequestUpserter).Upsert(t.Context(), &UpsertOptions{
+				Title:        "Promote",
+				Body:         "body",
+				Branch:       "environments/dev-next",
+				TargetBranch: "environments/dev",
+			})
+			require.NoError(t, err)
+			assert.True(t, written)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "https://gitea.com/test-argocd/pr-test/pulls/7", pr.URL)
+			assert.Equal(t, "environments/dev-next", pr.Branch)
+			assert.Equal(t, "environments/dev", pr.TargetBranch)
+			assert.Equal(t, "abc", pr.HeadSHA)
+		})
+	}
+}
+
+func TestGiteaGetState(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected PullRequestState
+	}{
+		{name: "open", response: `{"number": 7, "state": "open"}`, expected: PullRequestStateOpen},
+		{name: "merged", response: `{"number": 7, "state": "closed", "merged": true}`, expected: PullRequestStateMerged},
+		{name: "closed", response: `{"number": 7, "state": "closed", "merged": false}`, expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v1/version":
+					_, _ = io.WriteString(w, `{"version":"1.17.0"}`)
+				case "/api/v1/repos/test-argocd/pr-test/pulls/7":
+					_, _ = io.WriteString(w, test.response)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			svc, err := NewGiteaService("token", server.URL, "test-argocd", "pr-test", nil, false)
+			require.NoError(t, err)
+
+			state, err := svc.(PullRequestUpserter).GetState(t.Context(), 7)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
@@ -18,7 +18,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService  = (*GithubService)(nil)
+	_ PullRequestUpserter = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -93,6 +96,75 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// Upsert opens a pull request from the given branch, or updates the open one if it already exists.
+func (g *GithubService) Upsert(ctx context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + opts.Branch,
+		Base:  opts.TargetBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+
+	var pull *github.PullRequest
+	if len(pulls) > 0 {
+		pull, _, err = g.client.PullRequests.Edit(ctx, g.owner, g.repo, pulls[0].GetNumber(), &github.PullRequest{
+			Title: &opts.Title,
+			Body:  &opts.Body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request #%d for %s/%s: %w", pulls[0].GetNumber(), g.owner, g.repo, err)
+		}
+	} else {
+		pull, _, err = g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+			Title: &opts.Title,
+			Body:  &opts.Body,
+			Head:  &opts.Branch,
+			Base:  &opts.TargetBranch,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+		}
+	}
+
+	labels := getGithubPRLabelNames(pull.Labels)
+	if len(g.labels) > 0 && !containLabels(g.labels, pull.Labels) {
+		added, _, err := g.client.Issues.AddLabelsToIssue(ctx, g.owner, g.repo, pull.GetNumber(), g.labels)
+		if err != nil {
+			return nil, fmt.Errorf("error adding labels to pull request #%d for %s/%s: %w", pull.GetNumber(), g.owner, g.repo, err)
+		}
+		labels = getGithubPRLabelNames(added)
+	}
+
+	return &PullRequest{
+		Number:       int64(pull.GetNumber()),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       labels,
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}, nil
+}
+
+// GetState returns the state of the pull request with the given number.
+func (g *GithubService) GetState(ctx context.Context, number int64) (PullRequestState, error) {
+	pull, _, err := g.client.PullRequests.Get(ctx, g.owner, g.repo, int(number))
+	if err != nil {
+		return "", fmt.Errorf("error getting pull request #%d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	switch {
+	case pull.GetMerged():
+		return PullRequestStateMerged, nil
+	case pull.GetState() == "closed":
+		return PullRequestStateClosed, nil
+	default:
+		return PullRequestStateOpen, nil
+	}
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
@@ -115,3 +115,97 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubUpsert(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{
+			name:           "creates pull request",
+			existing:       `[]`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/api/v3/repos/argoproj/argo-cd/pulls",
+		},
+		{
+			name:           "updates existing pull request",
+			existing:       `[{"number": 42}]`,
+			expectedMethod: http.MethodPatch,
+			expectedPath:   "/api/v3/repos/argoproj/argo-cd/pulls/42",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var written bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v3/repos/argoproj/argo-cd/pulls":
+					assert.Equal(t, "argoproj:environments/dev-next", r.URL.Query().Get("head"))
+					assert.Equal(t, "environments/dev", r.URL.Query().Get("base"))
+					assert.Equal(t, "open", r.URL.Query().Get("state"))
+					_, _ = w.Write([]byte(test.existing))
+				case r.Method == test.expectedMethod && r.URL.Path == test.expectedPath:
+					written = true
+					_, _ = w.Write([]byte(`{"number": 42, "title": "Promote", "html_url": "https://github.com/argoproj/argo-cd/pull/42", "labels": [{"name": "hydrator"}], "head": {"ref": "environments/dev-next", "sha": "abc"}, "base": {"ref": "environments/dev"}}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			svc, err := NewGithubService("token", server.URL, "argoproj", "argo-cd", []string{"hydrator"})
+			require.NoError(t, err)
+
+			pr, err := svc.(PullRequestUpserter).Upsert(t.Context(), &UpsertOptions{
+				Title:        "Promote",
+				Body:         "body",
+				Branch:       "environments/dev-next",
+				TargetBranch: "environments/dev",
+			})
+			require.NoError(t, err)
+			assert.True(t, written)
+			assert.Equal(t, int64(42), pr.Number)
+			assert.Equal(t, "https://github.com/argoproj/argo-cd/pull/42", pr.URL)
+			assert.Equal(t, "environments/dev-next", pr.Branch)
+			assert.Equal(t, "environments/dev", pr.TargetBranch)
+			assert.Equal(t, []string{"hydrator"}, pr.Labels)
+		})
+	}
+}
+
+func TestGitHubGetState(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected PullRequestState
+	}{
+		{name: "open", response: `{"number": 42, "state": "open"}`, expected: PullRequestStateOpen},
+		{name: "merged", response: `{"number": 42, "state": "closed", "merged": true}`, expected: PullRequestStateMerged},
+		{name: "closed", response: `{"number": 42, "state": "closed", "merged": false}`, expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method != http.MethodGet || r.URL.Path != "/api/v3/repos/argoproj/argo-cd/pulls/42" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			svc, err := NewGithubService("token", server.URL, "argoproj", "argo-cd", nil)
+			require.NoError(t, err)
+
+			state, err := svc.(PullRequestUpserter).GetState(t.Context(), 42)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
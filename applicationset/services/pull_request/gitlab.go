@@ -19,7 +19,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService  = (*GitLabService)(nil)
+	_ PullRequestUpserter = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -105,3 +108,74 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// Upsert opens a merge request from the given branch, or updates the open one if it already exists.
+func (g *GitLabService) Upsert(ctx context.Context, opts *UpsertOptions) (*PullRequest, error) {
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("opened"),
+		SourceBranch: &opts.Branch,
+		TargetBranch: &opts.TargetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+
+	var labels *gitlab.LabelOptions
+	if len(g.labels) > 0 {
+		var labelsList gitlab.LabelOptions = g.labels
+		labels = &labelsList
+	}
+
+	var mr *gitlab.MergeRequest
+	if len(mrs) > 0 {
+		mr, _, err = g.client.MergeRequests.UpdateMergeRequest(g.project, mrs[0].IID, &gitlab.UpdateMergeRequestOptions{
+			Title:       &opts.Title,
+			Description: &opts.Body,
+			AddLabels:   labels,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error updating merge request !%d for project '%s': %w", mrs[0].IID, g.project, err)
+		}
+	} else {
+		mr, _, err = g.client.MergeRequests.CreateMergeRequest(g.project, &gitlab.CreateMergeRequestOptions{
+			Title:        &opts.Title,
+			Description:  &opts.Body,
+			SourceBranch: &opts.Branch,
+			TargetBranch: &opts.TargetBranch,
+			Labels:       labels,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+		}
+	}
+
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest, nil
+}
+
+// GetState returns the state of the merge request with the given IID.
+func (g *GitLabService) GetState(ctx context.Context, number int64) (PullRequestState, error) {
+	mr, _, err := g.client.MergeRequests.GetMergeRequest(g.project, number, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("error getting merge request !%d for project '%s': %w", number, g.project, err)
+	}
+	switch mr.State {
+	case "merged":
+		return PullRequestStateMerged, nil
+	case "closed":
+		return PullRequestStateClosed, nil
+	default:
+		return PullRequestStateOpen, nil
+	}
+}
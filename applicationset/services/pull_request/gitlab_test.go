@@ -217,3 +217,94 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabUpsert(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       string
+		expectedMethod string
+		expectedPath   string
+	}{
+		{
+			name:           "creates merge request",
+			existing:       `[]`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/api/v4/projects/278964/merge_requests",
+		},
+		{
+			name:           "updates existing merge request",
+			existing:       `[{"iid": 7}]`,
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/api/v4/projects/278964/merge_requests/7",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var written bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/278964/merge_requests":
+					assert.Equal(t, "environments/dev-next", r.URL.Query().Get("source_branch"))
+					assert.Equal(t, "environments/dev", r.URL.Query().Get("target_branch"))
+					assert.Equal(t, "opened", r.URL.Query().Get("state"))
+					_, _ = io.WriteString(w, test.existing)
+				case r.Method == test.expectedMethod && r.URL.Path == test.expectedPath:
+					written = true
+					_, _ = io.WriteString(w, `{"iid": 7, "title": "Promote", "web_url": "https://gitlab.com/group/project/-/merge_requests/7", "source_branch": "environments/dev-next", "target_branch": "environments/dev", "author": {"username": "argocd"}}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+			require.NoError(t, err)
+
+			pr, err := svc.(PullRequestUpserter).Upsert(t.Context(), &UpsertOptions{
+				Title:        "Promote",
+				Body:         "body",
+				Branch:       "environments/dev-next",
+				TargetBranch: "environments/dev",
+			})
+			require.NoError(t, err)
+			assert.True(t, written)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "https://gitlab.com/group/project/-/merge_requests/7", pr.URL)
+			assert.Equal(t, "argocd", pr.Author)
+		})
+	}
+}
+
+func TestGitLabGetState(t *testing.T) {
+	tests := []struct {
+		state    string
+		expected PullRequestState
+	}{
+		{state: "opened", expected: PullRequestStateOpen},
+		{state: "merged", expected: PullRequestStateMerged},
+		{state: "closed", expected: PullRequestStateClosed},
+	}
+	for _, test := range tests {
+		t.Run(test.state, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method != http.MethodGet || r.URL.Path != "/api/v4/projects/278964/merge_requests/7" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, `{"iid": 7, "state": "`+test.state+`"}`)
+			}))
+			defer server.Close()
+
+			svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil)
+			require.NoError(t, err)
+
+			state, err := svc.(PullRequestUpserter).GetState(t.Context(), 7)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, state)
+		})
+	}
+}
@@ -21,6 +21,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the link to the pull request in the SCM provider's web UI. It is only populated by Upsert.
+	URL string
 }
 
 type PullRequestService interface {
@@ -28,6 +30,39 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// UpsertOptions describes the pull request to open or update.
+type UpsertOptions struct {
+	// Title of the pull request.
+	Title string
+	// Body is the description of the pull request.
+	Body string
+	// Branch is the name of the branch from which the pull request originates.
+	Branch string
+	// TargetBranch is the name of the branch the pull request should be merged into.
+	TargetBranch string
+}
+
+// PullRequestState is the state of a pull request opened by a PullRequestUpserter.
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen is the state of pull requests which are neither merged nor closed.
+	PullRequestStateOpen PullRequestState = "open"
+	// PullRequestStateMerged is the state of pull requests which were merged.
+	PullRequestStateMerged PullRequestState = "merged"
+	// PullRequestStateClosed is the state of pull requests which were closed, or declined, without being merged.
+	PullRequestStateClosed PullRequestState = "closed"
+)
+
+// PullRequestUpserter is implemented by the services which are able to open pull requests.
+type PullRequestUpserter interface {
+	// Upsert opens a pull request from opts.Branch into opts.TargetBranch. If a pull request between those branches
+	// is already open, its title and body are updated instead.
+	Upsert(ctx context.Context, opts *UpsertOptions) (*PullRequest, error)
+	// GetState returns the state of the pull request with the given number.
+	GetState(ctx context.Context, number int64) (PullRequestState, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
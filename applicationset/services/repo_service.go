@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -16,6 +17,8 @@ type argoCDService struct {
 	newFileGlobbingEnabled          bool
 	getGitFilesFromRepoServer       func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
 	getGitDirectoriesFromRepoServer func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	listOCITagsFromRepoServer       func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error)
+	getHelmChartsFromRepoServer     func(ctx context.Context, req *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error)
 }
 
 type Repos interface {
@@ -24,6 +27,9 @@ type Repos interface {
 
 	// GetDirectories returns a list of directories (not files) within the target repo
 	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error)
+
+	// GetTags returns the tags of an OCI repository, or the versions of a chart within a Helm repository
+	GetTags(ctx context.Context, repoURL, chart, project string) ([]string, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -47,6 +53,22 @@ func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclie
 			defer utilio.Close(closer)
 			return client.GetGitDirectories(ctx, dirRequest)
 		},
+		listOCITagsFromRepoServer: func(ctx context.Context, refsRequest *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ListOCITags(ctx, refsRequest)
+		},
+		getHelmChartsFromRepoServer: func(ctx context.Context, chartsRequest *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.GetHelmCharts(ctx, chartsRequest)
+		},
 	}
 }
 
@@ -92,3 +114,43 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+func (a *argoCDService) GetTags(ctx context.Context, repoURL, chart, project string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	isOCI := repo.Type == "oci" || strings.HasPrefix(repo.Repo, "oci://")
+	if !isOCI && repo.EnableOCI {
+		// Charts of OCI Helm repositories are stored as OCI repositories below the Helm repository URL
+		if chart == "" {
+			return nil, fmt.Errorf("chart is required to list the versions of OCI Helm repository %s", repoURL)
+		}
+		repo = repo.DeepCopy()
+		repo.Repo = "oci://" + strings.TrimSuffix(repo.Repo, "/") + "/" + chart
+		isOCI = true
+	}
+
+	if isOCI {
+		refs, err := a.listOCITagsFromRepoServer(ctx, &apiclient.ListRefsRequest{Repo: repo})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving OCI tags: %w", err)
+		}
+		return refs.GetTags(), nil
+	}
+
+	if chart == "" {
+		return nil, fmt.Errorf("chart is required to list the versions of Helm repository %s", repoURL)
+	}
+	charts, err := a.getHelmChartsFromRepoServer(ctx, &apiclient.HelmChartsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Helm charts: %w", err)
+	}
+	for _, helmChart := range charts.GetItems() {
+		if helmChart.Name == chart {
+			return helmChart.Versions, nil
+		}
+	}
+	return nil, fmt.Errorf("chart '%s' not found in index", chart)
+}
@@ -168,6 +168,53 @@ func TestGetFiles(t *testing.T) {
 	}
 }
 
+func TestGetTags(t *testing.T) {
+	listOCITags := func(_ context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+		return &apiclient.Refs{Tags: []string{req.Repo.Repo + ":1.0.0"}}, nil
+	}
+	getHelmCharts := func(_ context.Context, _ *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
+		return &apiclient.HelmChartsResponse{Items: []*apiclient.HelmChart{
+			{Name: "other", Versions: []string{"0.1.0"}},
+			{Name: "guestbook", Versions: []string{"1.1.0", "1.0.0"}},
+		}}, nil
+	}
+	tests := []struct {
+		name    string
+		repo    *v1alpha1.Repository
+		chart   string
+		want    []string
+		wantErr string
+	}{
+		{name: "OCIRepository", repo: &v1alpha1.Repository{Repo: "oci://registry.example.com/guestbook"}, want: []string{"oci://registry.example.com/guestbook:1.0.0"}},
+		{name: "OCIHelmRepository", repo: &v1alpha1.Repository{Repo: "registry.example.com/charts", Type: "helm", EnableOCI: true}, chart: "guestbook", want: []string{"oci://registry.example.com/charts/guestbook:1.0.0"}},
+		{name: "OCIHelmRepositoryWithoutChart", repo: &v1alpha1.Repository{Repo: "registry.example.com/charts", Type: "helm", EnableOCI: true}, wantErr: "chart is required"},
+		{name: "HelmRepository", repo: &v1alpha1.Repository{Repo: "https://charts.example.com", Type: "helm"}, chart: "guestbook", want: []string{"1.1.0", "1.0.0"}},
+		{name: "HelmRepositoryWithoutChart", repo: &v1alpha1.Repository{Repo: "https://charts.example.com", Type: "helm"}, wantErr: "chart is required"},
+		{name: "ChartNotFound", repo: &v1alpha1.Repository{Repo: "https://charts.example.com", Type: "helm"}, chart: "missing", wantErr: "chart 'missing' not found in index"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &argoCDService{
+				getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+					return tt.repo, nil
+				},
+				listOCITagsFromRepoServer:   listOCITags,
+				getHelmChartsFromRepoServer: getHelmCharts,
+			}
+			repoURL := tt.repo.Repo
+			got, err := a.GetTags(t.Context(), repoURL, tt.chart, "")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			// the repository returned by the database is not modified
+			assert.Equal(t, repoURL, tt.repo.Repo)
+		})
+	}
+}
+
 func TestNewArgoCDService(t *testing.T) {
 	testNamespace := "test"
 	clientset := fake.NewClientset()
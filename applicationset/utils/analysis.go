@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// rolloutAnalysisTimeout is the timeout of a single rollout analysis
+const rolloutAnalysisTimeout = 30 * time.Second
+
+var rolloutAnalysisClient = &http.Client{
+	Timeout: rolloutAnalysisTimeout,
+	// redirects are not followed, as they could point to a host which is not allowed
+	CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+type rolloutAnalysisRun struct {
+	analysis argov1alpha1.ApplicationSetRolloutAnalysis
+	done     bool
+	passed   bool
+	message  string
+}
+
+// RolloutAnalysisRunner runs the analyses of RollingSync steps in the background, so that the reconciliation of an
+// ApplicationSet is not blocked by slow analysis endpoints. Analyses may only query the hosts allowed by the admin.
+type RolloutAnalysisRunner struct {
+	allowedHosts []string
+	lock         sync.Mutex
+	runs         map[string]*rolloutAnalysisRun
+}
+
+// NewRolloutAnalysisRunner returns a RolloutAnalysisRunner which only allows analyses querying the given hosts
+func NewRolloutAnalysisRunner(allowedHosts []string) *RolloutAnalysisRunner {
+	return &RolloutAnalysisRunner{
+		allowedHosts: allowedHosts,
+		runs:         map[string]*rolloutAnalysisRun{},
+	}
+}
+
+// Run starts the analysis identified by the given key in the background, unless it is already running. It returns
+// whether the analysis is done and, if so, whether it passed and a message describing the result. The result of a
+// done analysis is only returned once; the next call starts a new run.
+func (r *RolloutAnalysisRunner) Run(key string, analysis *argov1alpha1.ApplicationSetRolloutAnalysis) (bool, bool, string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if run, ok := r.runs[key]; ok && reflect.DeepEqual(run.analysis, *analysis) {
+		if !run.done {
+			return false, false, ""
+		}
+		delete(r.runs, key)
+		return true, run.passed, run.message
+	}
+
+	// the analysis is not running yet, or its spec was changed while it was running
+	run := &rolloutAnalysisRun{analysis: *analysis.DeepCopy()}
+	r.runs[key] = run
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), rolloutAnalysisTimeout)
+		defer cancel()
+		passed, message := RunRolloutAnalysis(ctx, &run.analysis, r.allowedHosts)
+
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		run.done = true
+		run.passed = passed
+		run.message = message
+	}()
+	return false, false, ""
+}
+
+// RunRolloutAnalysis runs the analysis of a RollingSync step, and returns whether it passed and a message describing
+// the result. The analysis may only query the given hosts.
+func RunRolloutAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutAnalysis, allowedHosts []string) (bool, string) {
+	var err error
+	switch {
+	case analysis.HTTP != nil:
+		err = runHTTPAnalysis(ctx, analysis.HTTP, allowedHosts)
+	case analysis.Prometheus != nil:
+		err = runPrometheusAnalysis(ctx, analysis.Prometheus, allowedHosts)
+	default:
+		err = errors.New("analysis has neither http nor prometheus set")
+	}
+	if err != nil {
+		return false, "Analysis failed: " + err.Error()
+	}
+	return true, "Analysis passed"
+}
+
+// checkRolloutAnalysisURL returns an error if the given URL is not an HTTP(S) URL of one of the allowed hosts. A host
+// is allowed if it matches an entry either with or without the port.
+func checkRolloutAnalysisURL(rawURL string, allowedHosts []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s is not a valid HTTP(S) URL", rawURL)
+	}
+	for _, allowedHost := range allowedHosts {
+		if strings.EqualFold(allowedHost, u.Host) || strings.EqualFold(allowedHost, u.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %s is not allowed for analyses", u.Host)
+}
+
+func runHTTPAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutHTTPAnalysis, allowedHosts []string) error {
+	if err := checkRolloutAnalysisURL(analysis.URL, allowedHosts); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, analysis.URL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := rolloutAnalysisClient.Do(req)
+	if err != nil {
+		log.WithField("url", analysis.URL).Warnf("Rollout analysis request failed: %v", err)
+		return fmt.Errorf("error requesting %s", analysis.URL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned status code %d", analysis.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// runPrometheusAnalysis runs the query of the analysis. The response of Prometheus is not included in the returned
+// errors, as they end up in the status of the ApplicationSet; it is logged instead.
+func runPrometheusAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutPrometheusAnalysis, allowedHosts []string) error {
+	if err := checkRolloutAnalysisURL(analysis.Address, allowedHosts); err != nil {
+		return err
+	}
+	logCtx := log.WithFields(log.Fields{"address": analysis.Address, "query": analysis.Query})
+	queryURL := strings.TrimSuffix(analysis.Address, "/") + "/api/v1/query?" + url.Values{"query": {analysis.Query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := rolloutAnalysisClient.Do(req)
+	if err != nil {
+		logCtx.Warnf("Rollout analysis query failed: %v", err)
+		return errors.New("error querying Prometheus")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logCtx.Warnf("Failed to read the response of the rollout analysis query: %v", err)
+		return errors.New("error reading Prometheus response")
+	}
+
+	var result prometheusQueryResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		logCtx.Warnf("Failed to parse the response of the rollout analysis query: %v", err)
+		return fmt.Errorf("error parsing Prometheus response with status code %d", resp.StatusCode)
+	}
+	if result.Status != "success" {
+		logCtx.Warnf("Rollout analysis query failed: %s", result.Error)
+		return fmt.Errorf("query %q failed with status code %d", analysis.Query, resp.StatusCode)
+	}
+	switch result.Data.ResultType {
+	case "vector", "matrix":
+		var samples []json.RawMessage
+		if err := json.Unmarshal(result.Data.Result, &samples); err != nil {
+			logCtx.Warnf("Failed to parse the result of the rollout analysis query: %v", err)
+			return errors.New("error parsing Prometheus result")
+		}
+		if len(samples) == 0 {
+			return fmt.Errorf("query %q returned an empty result", analysis.Query)
+		}
+		return nil
+	default:
+		logCtx.Warnf("Rollout analysis query returned a %s", result.Data.ResultType)
+		return fmt.Errorf("query %q did not return a vector", analysis.Query)
+	}
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRunRolloutAnalysis(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthy":
+		case "/unhealthy":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/redirect":
+			http.Redirect(w, r, "/healthy", http.StatusFound)
+		case "/api/v1/query":
+			switch r.URL.Query().Get("query") {
+			case "up == 1":
+				_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,"1"]}]}}`))
+			case "up == 0":
+				_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"status":"error","error":"parse error"}`))
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		analysis        argov1alpha1.ApplicationSetRolloutAnalysis
+		allowedHosts    []string
+		expectedPassed  bool
+		expectedMessage string
+	}{
+		{
+			name:            "http analysis passes",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL + "/healthy"}},
+			expectedPassed:  true,
+			expectedMessage: "Analysis passed",
+		},
+		{
+			name:            "http analysis fails",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL + "/unhealthy"}},
+			expectedMessage: "Analysis failed: " + server.URL + "/unhealthy returned status code 500",
+		},
+		{
+			name:            "prometheus analysis passes",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: server.URL + "/", Query: "up == 1"}},
+			expectedPassed:  true,
+			expectedMessage: "Analysis passed",
+		},
+		{
+			name:            "prometheus analysis returns an empty result",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: server.URL, Query: "up == 0"}},
+			expectedMessage: `Analysis failed: query "up == 0" returned an empty result`,
+		},
+		{
+			name:            "prometheus query fails",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: server.URL, Query: "up =="}},
+			expectedMessage: `Analysis failed: query "up ==" failed with status code 400`,
+		},
+		{
+			name:            "http analysis does not follow redirects",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL + "/redirect"}},
+			expectedMessage: "Analysis failed: " + server.URL + "/redirect returned status code 302",
+		},
+		{
+			name:            "http analysis of a host which is not allowed",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL + "/healthy"}},
+			allowedHosts:    []string{"prometheus.monitoring.svc"},
+			expectedMessage: "Analysis failed: host " + serverURL.Host + " is not allowed for analyses",
+		},
+		{
+			name:            "prometheus analysis without allowed hosts",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{Prometheus: &argov1alpha1.ApplicationSetRolloutPrometheusAnalysis{Address: server.URL, Query: "up == 1"}},
+			allowedHosts:    []string{},
+			expectedMessage: "Analysis failed: host " + serverURL.Host + " is not allowed for analyses",
+		},
+		{
+			name:            "http analysis of a host allowed without the port",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL + "/healthy"}},
+			allowedHosts:    []string{serverURL.Hostname()},
+			expectedPassed:  true,
+			expectedMessage: "Analysis passed",
+		},
+		{
+			name:            "http analysis of a URL which is not HTTP(S)",
+			analysis:        argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: "file:///etc/passwd"}},
+			expectedMessage: "Analysis failed: file:///etc/passwd is not a valid HTTP(S) URL",
+		},
+		{
+			name:            "no analysis",
+			expectedMessage: "Analysis failed: analysis has neither http nor prometheus set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowedHosts := tc.allowedHosts
+			if allowedHosts == nil {
+				allowedHosts = []string{serverURL.Host}
+			}
+			passed, message := RunRolloutAnalysis(t.Context(), &tc.analysis, allowedHosts)
+			assert.Equal(t, tc.expectedPassed, passed)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestRolloutAnalysisRunner(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	runner := NewRolloutAnalysisRunner([]string{serverURL.Host})
+	analysis := &argov1alpha1.ApplicationSetRolloutAnalysis{HTTP: &argov1alpha1.ApplicationSetRolloutHTTPAnalysis{URL: server.URL}}
+
+	// the analysis runs in the background, so the first calls return immediately
+	done, _, _ := runner.Run("argocd/appset/1", analysis)
+	assert.False(t, done)
+	done, _, _ = runner.Run("argocd/appset/1", analysis)
+	assert.False(t, done)
+
+	close(release)
+	var passed bool
+	var message string
+	require.Eventually(t, func() bool {
+		done, passed, message = runner.Run("argocd/appset/1", analysis)
+		return done
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.True(t, passed)
+	assert.Equal(t, "Analysis passed", message)
+
+	// the result was consumed, so the next call starts a new run
+	done, _, _ = runner.Run("argocd/appset/1", analysis)
+	assert.False(t, done)
+}
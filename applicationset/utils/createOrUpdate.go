@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/r3labs/diff/v3"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -24,6 +27,37 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 )
 
+// Rather than importing the whole argocd-notifications controller, just copying the const here
+//
+//	https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/subscriptions.go#L12
+//	https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
+const NotifiedAnnotationKey = "notified.notifications.argoproj.io"
+
+var applicationEquality = conversion.EqualitiesOrDie(
+	func(a, b resource.Quantity) bool {
+		// Ignore formatting, only care that numeric value stayed the same.
+		// TODO: if we decide it's important, it should be safe to start comparing the format.
+		//
+		// Uninitialized quantities are equivalent to 0 quantities.
+		return a.Cmp(b) == 0
+	},
+	func(a, b metav1.MicroTime) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b metav1.Time) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b labels.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b fields.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b argov1alpha1.ApplicationDestination) bool {
+		return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
+	},
+)
+
 // CreateOrUpdate overrides "sigs.k8s.io/controller-runtime" function
 // in sigs.k8s.io/controller-runtime/pkg/controller/controllerutil/controllerutil.go
 // to add equality for argov1alpha1.ApplicationDestination
@@ -70,32 +104,7 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
 	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
 
-	equality := conversion.EqualitiesOrDie(
-		func(a, b resource.Quantity) bool {
-			// Ignore formatting, only care that numeric value stayed the same.
-			// TODO: if we decide it's important, it should be safe to start comparing the format.
-			//
-			// Uninitialized quantities are equivalent to 0 quantities.
-			return a.Cmp(b) == 0
-		},
-		func(a, b metav1.MicroTime) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b metav1.Time) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b labels.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b fields.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b argov1alpha1.ApplicationDestination) bool {
-			return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
-		},
-	)
-
-	if equality.DeepEqual(normalizedLive, obj) {
+	if applicationEquality.DeepEqual(normalizedLive, obj) {
 		return controllerutil.OperationResultNone, nil
 	}
 
@@ -109,6 +118,97 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	return controllerutil.OperationResultUpdated, nil
 }
 
+// IsApplicationSpecChanged returns whether the spec of the generated Application differs from the spec of the live
+// Application, apart from the differences ignored by ignoreApplicationDifferences
+func IsApplicationSpecChanged(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, live *argov1alpha1.Application, generated *argov1alpha1.Application) (bool, error) {
+	normalizedLive := live.DeepCopy()
+	normalizedGenerated := generated.DeepCopy()
+	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, normalizedGenerated, normalizers.IgnoreNormalizerOpts{})
+	if err != nil {
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	return !applicationEquality.DeepEqual(argo.NormalizeApplicationSpec(&normalizedLive.Spec), argo.NormalizeApplicationSpec(&normalizedGenerated.Spec)), nil
+}
+
+// ApplicationFieldDifference is a difference of a field between a live and a generated Application
+type ApplicationFieldDifference struct {
+	// Path is the path of the field, e.g. spec.source.targetRevision
+	Path string
+	// Live is the value of the field in the live Application, or nil if the field is not set
+	Live any
+	// Generated is the value of the field in the generated Application, or nil if the field is not set
+	Generated any
+}
+
+// GetApplicationDifferences returns the differences of the spec, labels, annotations and finalizers between the live
+// and the generated Application, apart from the differences ignored by ignoreApplicationDifferences
+func GetApplicationDifferences(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, live *argov1alpha1.Application, generated *argov1alpha1.Application) ([]ApplicationFieldDifference, error) {
+	normalizedLive := live.DeepCopy()
+	normalizedGenerated := generated.DeepCopy()
+	// the ignore differences rules match the Applications by their kind
+	typeMeta := metav1.TypeMeta{
+		APIVersion: argov1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+		Kind:       argov1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+	}
+	normalizedLive.TypeMeta = typeMeta
+	normalizedGenerated.TypeMeta = typeMeta
+	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, normalizedGenerated, normalizers.IgnoreNormalizerOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	liveFields, err := getComparedApplicationFields(normalizedLive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields of live application: %w", err)
+	}
+	generatedFields, err := getComparedApplicationFields(normalizedGenerated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields of generated application: %w", err)
+	}
+	changelog, err := diff.Diff(liveFields, generatedFields)
+	if err != nil {
+		return nil, fmt.Errorf("error during diff: %w", err)
+	}
+	differences := make([]ApplicationFieldDifference, 0, len(changelog))
+	for _, change := range changelog {
+		differences = append(differences, ApplicationFieldDifference{
+			Path:      strings.Join(change.Path, "."),
+			Live:      change.From,
+			Generated: change.To,
+		})
+	}
+	sort.Slice(differences, func(i, j int) bool {
+		return differences[i].Path < differences[j].Path
+	})
+	return differences, nil
+}
+
+// getComparedApplicationFields returns the fields of the Application which are compared by GetApplicationDifferences,
+// as a plain object
+func getComparedApplicationFields(app *argov1alpha1.Application) (map[string]any, error) {
+	fields := struct {
+		Metadata struct {
+			Labels      map[string]string `json:"labels,omitempty"`
+			Annotations map[string]string `json:"annotations,omitempty"`
+			Finalizers  []string          `json:"finalizers,omitempty"`
+		} `json:"metadata"`
+		Spec *argov1alpha1.ApplicationSpec `json:"spec"`
+	}{}
+	fields.Metadata.Labels = app.Labels
+	fields.Metadata.Annotations = app.Annotations
+	fields.Metadata.Finalizers = app.Finalizers
+	fields.Spec = argo.NormalizeApplicationSpec(&app.Spec)
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application: %w", err)
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal application: %w", err)
+	}
+	return obj, nil
+}
+
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
 	patchBytes, err := patch.Data(obj)
 	if err != nil {
@@ -235,3 +235,60 @@ spec:
 		})
 	}
 }
+
+func TestIsApplicationSpecChanged(t *testing.T) {
+	newApp := func(targetRevision string, project string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+				Kind:       v1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: project,
+				Source:  &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/test-org/test-repo", TargetRevision: targetRevision},
+			},
+		}
+	}
+	ignoreTargetRevision := v1alpha1.ApplicationSetIgnoreDifferences{{JQPathExpressions: []string{".spec.source.targetRevision"}}}
+
+	changed, err := IsApplicationSpecChanged(nil, newApp("foo", "default"), newApp("foo", ""))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = IsApplicationSpecChanged(nil, newApp("foo", "default"), newApp("bar", "default"))
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = IsApplicationSpecChanged(ignoreTargetRevision, newApp("foo", "default"), newApp("bar", "default"))
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestGetApplicationDifferences(t *testing.T) {
+	newApp := func(targetRevision string, labels map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Labels: labels},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "default",
+				Source:  &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/test-org/test-repo", TargetRevision: targetRevision},
+			},
+		}
+	}
+
+	differences, err := GetApplicationDifferences(nil, newApp("foo", nil), newApp("foo", nil))
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+
+	differences, err = GetApplicationDifferences(nil, newApp("foo", map[string]string{"env": "dev"}), newApp("bar", map[string]string{"env": "prod", "team": "a"}))
+	require.NoError(t, err)
+	assert.Equal(t, []ApplicationFieldDifference{
+		{Path: "metadata.labels.env", Live: "dev", Generated: "prod"},
+		{Path: "metadata.labels.team", Live: nil, Generated: "a"},
+		{Path: "spec.source.targetRevision", Live: "foo", Generated: "bar"},
+	}, differences)
+
+	ignoreTargetRevision := v1alpha1.ApplicationSetIgnoreDifferences{{JQPathExpressions: []string{".spec.source.targetRevision"}}}
+	differences, err = GetApplicationDifferences(ignoreTargetRevision, newApp("foo", nil), newApp("bar", nil))
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"k8s.io/utils/ptr"
+
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -20,3 +22,18 @@ func DefaultPolicy(appSetSyncPolicy *argov1alpha1.ApplicationSetSyncPolicy, cont
 	}
 	return *appSetSyncPolicy.ApplicationsSync
 }
+
+// IsRollingSyncStrategyEnabled returns whether the Applications of the ApplicationSet are synced by a RollingSync
+// rollout, which is the case if the strategy is set to `RollingSync` and has steps
+func IsRollingSyncStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
+	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "RollingSync" && appset.Spec.Strategy.RollingSync != nil &&
+		len(appset.Spec.Strategy.RollingSync.Steps) > 0
+}
+
+// DisableAutomatedSync disables the automated sync policy of an Application generated with the RollingSync strategy,
+// since the ApplicationSet controller triggers the syncs of these Applications instead
+func DisableAutomatedSync(app *argov1alpha1.Application) {
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
+		app.Spec.SyncPolicy.Automated.Enabled = ptr.To(false)
+	}
+}
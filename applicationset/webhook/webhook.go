@@ -476,6 +476,8 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		ClusterProfile:          g0.ClusterProfile,
+		Artifact:                g0.Artifact,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -531,6 +533,8 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		ClusterProfile:          g1.ClusterProfile,
+		Artifact:                g1.Artifact,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
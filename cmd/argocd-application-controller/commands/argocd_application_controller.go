@@ -23,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	"github.com/argoproj/argo-cd/v3/controller/warmup"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/pkg/ratelimiter"
@@ -97,6 +98,9 @@ func NewCommand() *cobra.Command {
 		// argocd k8s event logging flag
 		enableK8sEvent  []string
 		hydratorEnabled bool
+
+		repoWarmUpEnabled bool
+		repoWarmUpOpts    warmup.Options
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -188,6 +192,10 @@ func NewCommand() *cobra.Command {
 					Cap:      time.Duration(selfHealBackoffCapSeconds) * time.Second,
 				}
 			}
+			var warmUpOpts *warmup.Options
+			if repoWarmUpEnabled {
+				warmUpOpts = &repoWarmUpOpts
+			}
 			appController, err = controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -220,6 +228,7 @@ func NewCommand() *cobra.Command {
 				ignoreNormalizerOpts,
 				enableK8sEvent,
 				hydratorEnabled,
+				warmUpOpts,
 			)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer(), nil)
@@ -304,6 +313,10 @@ func NewCommand() *cobra.Command {
 	// argocd k8s event logging flag
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
+	command.Flags().BoolVar(&repoWarmUpEnabled, "repo-warmup-enabled", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_WARMUP_ENABLED", false), "Make the repo server pre-fetch the Git repositories and revisions referenced by applications on startup and periodically afterwards. Applications are only reconciled after the initial warm-up.")
+	command.Flags().DurationVar(&repoWarmUpOpts.Interval, "repo-warmup-interval", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_WARMUP_INTERVAL", time.Hour, 0, math.MaxInt64), "Interval in which the repositories are pre-fetched again after the initial warm-up. 0 only warms up on startup.")
+	command.Flags().IntVar(&repoWarmUpOpts.HostParallelismLimit, "repo-warmup-host-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_WARMUP_HOST_PARALLELISM_LIMIT", 2, 0, math.MaxInt32), "Limit on number of revisions pre-fetched concurrently from a single Git host by each application controller shard. Any value less the 1 means no limit.")
+	command.Flags().DurationVar(&repoWarmUpOpts.Timeout, "repo-warmup-timeout", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_WARMUP_TIMEOUT", 5*time.Minute, 0, math.MaxInt64), "Time after which applications are reconciled even if the initial warm-up has not completed. 0 waits for the warm-up to complete.")
 	cacheSource = appstatecache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
@@ -16,6 +16,7 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/commitserver"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/askpass"
@@ -33,6 +34,9 @@ func NewCommand() *cobra.Command {
 		listenPort  int
 		metricsPort int
 		metricsHost string
+
+		signingKeyPath   string
+		signingKeyFormat string
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
@@ -57,7 +61,13 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			signingKey, err := commit.LoadSigningKey(signingKeyFormat, signingKeyPath)
+			errors.CheckError(err)
+			if signingKey != nil {
+				log.Infof("Signing hydrated commits with %s key %s", signingKey.Format, signingKey.ID)
+			}
+
+			server := commitserver.NewServer(askPassServer, metricsServer, signingKey)
 			grpc := server.CreateGRPC()
 			ctx := cmd.Context()
 
@@ -114,6 +124,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", common.DefaultCommitSigningKeyPath), "Path to the private key to sign hydrated commits with. Commits are not signed if the file does not exist")
+	command.Flags().StringVar(&signingKeyFormat, "signing-key-format", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_FORMAT", commit.SigningKeyFormatGPG), "Format of the signing key. One of: gpg|ssh")
 
 	return command
 }
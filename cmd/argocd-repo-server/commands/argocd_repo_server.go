@@ -81,6 +81,7 @@ func NewCommand() *cobra.Command {
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
 		enableBuiltinGitConfig             bool
+		contentAddressedManifestCache      bool
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -157,6 +158,7 @@ func NewCommand() *cobra.Command {
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
 				OCIMediaTypes:                                ociMediaTypes,
 				EnableBuiltinGitConfig:                       enableBuiltinGitConfig,
+				ContentAddressedManifestCache:                contentAddressedManifestCache,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -195,6 +197,8 @@ func NewCommand() *cobra.Command {
 					}
 					return nil
 				}
+				// The readiness does not wait for the repository warm-up, since the application controller could not
+				// reach a repo server that is not ready to warm it up. The controller waits for the warm-up instead.
 				return nil
 			})
 			http.Handle("/metrics", metricsServer.GetHandler())
@@ -268,6 +272,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
 	command.Flags().BoolVar(&enableBuiltinGitConfig, "enable-builtin-git-config", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_BUILTIN_GIT_CONFIG", true), "Enable builtin git configuration options that are required for correct argocd-repo-server operation.")
+	command.Flags().BoolVar(&contentAddressedManifestCache, "content-addressed-manifest-cache", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_CONTENT_ADDRESSED_MANIFEST_CACHE", false), "Also cache generated manifests by the contents of the files they were generated from, so that commits which do not change those files reuse the cached manifests.")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
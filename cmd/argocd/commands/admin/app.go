@@ -12,11 +12,16 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	kubecache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
@@ -59,6 +64,9 @@ argocd admin app generate-spec APPNAME
 
 # Reconcile all applications and store reconciliation summary in the specified file
 argocd admin app get-reconcile-results APPNAME
+
+# Migrate the resources of all applications to another resource tracking method
+argocd admin app migrate-tracking-method --to annotation+applyset
 `,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
@@ -68,6 +76,7 @@ argocd admin app get-reconcile-results APPNAME
 	command.AddCommand(NewGenAppSpecCommand())
 	command.AddCommand(NewReconcileCommand(clientOpts))
 	command.AddCommand(NewDiffReconcileResults())
+	command.AddCommand(NewMigrateTrackingMethodCommand())
 	return command
 }
 
@@ -480,3 +489,142 @@ func reconcileApplications(
 func newLiveStateCache(argoDB db.ArgoDB, appInformer kubecache.SharedIndexInformer, settingsMgr *settings.SettingsManager, server *metrics.MetricsServer) cache.LiveStateCache {
 	return cache.NewLiveStateCache(argoDB, appInformer, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking())
 }
+
+// NewMigrateTrackingMethodCommand migrates the live resources of applications to another resource tracking method
+func NewMigrateTrackingMethodCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		selector     string
+		from         string
+		to           string
+		dryRun       bool
+	)
+
+	command := &cobra.Command{
+		Use:   "migrate-tracking-method",
+		Short: "Migrate the live resources of applications to another resource tracking method",
+		Long: "Migrate the live resources of applications to another resource tracking method, by replacing the tracking labels and annotations of the resources. " +
+			"Run it after changing application.resourceTrackingMethod in argocd-cm, so that resources are not reported as out of sync or orphaned until the next sync. " +
+			"ApplySet parents are created by the next sync of the applications.",
+		Example: `
+# Migrate the resources of all applications from the configured tracking method to annotation+applyset
+argocd admin app migrate-tracking-method --to annotation+applyset
+
+# Show the resources of the applications with a label which would be migrated from label to annotation tracking
+argocd admin app migrate-tracking-method --from label --to annotation -l team=my-team --dry-run
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if to == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			errors.CheckError(os.Setenv(v1alpha1.EnvVarFakeInClusterConfig, "true"))
+			cfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			kubeClientset := kubernetes.NewForConfigOrDie(cfg)
+			appClientset := appclientset.NewForConfigOrDie(cfg)
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClientset)
+
+			if from == "" {
+				from, err = settingsMgr.GetTrackingMethod()
+				errors.CheckError(err)
+			}
+			appLabelKey, err := settingsMgr.GetAppInstanceLabelKey()
+			errors.CheckError(err)
+			installationID, err := settingsMgr.GetInstallationID()
+			errors.CheckError(err)
+
+			apps, err := appClientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+			errors.CheckError(err)
+			for i := range apps.Items {
+				app := &apps.Items[i]
+				cluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, argoDB)
+				errors.CheckError(err)
+				restConfig, err := cluster.RESTConfig()
+				errors.CheckError(err)
+				disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
+				errors.CheckError(err)
+				dynamicIf, err := dynamic.NewForConfig(restConfig)
+				errors.CheckError(err)
+
+				migrated, err := migrateAppTrackingMethod(ctx, disco, dynamicIf, app, namespace, appLabelKey, installationID, v1alpha1.TrackingMethod(from), v1alpha1.TrackingMethod(to), dryRun)
+				errors.CheckError(err)
+				printLine("Migrated %d resources of application %s", migrated, app.Name)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector of the applications")
+	command.Flags().StringVar(&from, "from", "", "Tracking method to migrate from. Defaults to the tracking method configured in argocd-cm")
+	command.Flags().StringVar(&to, "to", "", "Tracking method to migrate to (label|annotation|annotation+label|annotation+applyset)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the resources which would be migrated without updating them")
+	return command
+}
+
+// migrateAppTrackingMethod replaces the tracking labels and annotations of the live resources of the application, which
+// are tracked with the from tracking method, by the ones of the to tracking method. It returns the number of migrated
+// resources.
+func migrateAppTrackingMethod(ctx context.Context, disco discovery.DiscoveryInterface, dynamicIf dynamic.Interface, app *v1alpha1.Application, controllerNamespace, appLabelKey, installationID string, from, to v1alpha1.TrackingMethod, dryRun bool) (int, error) {
+	resourceTracking := argo.NewResourceTracking()
+	appInstanceName := app.InstanceName(controllerNamespace)
+	migrated := 0
+	for i := range app.Status.Resources {
+		res := &app.Status.Resources[i]
+		gvk := res.GroupVersionKind()
+		apiResource, err := kube.ServerResourceForGroupVersionKind(disco, gvk, "patch")
+		if err != nil {
+			return migrated, fmt.Errorf("error getting API resource of %s: %w", gvk, err)
+		}
+		resourceIf := kube.ToResourceInterface(dynamicIf, apiResource, kube.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource), res.Namespace)
+		live, err := resourceIf.Get(ctx, res.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return migrated, fmt.Errorf("error getting %s %s: %w", res.Kind, res.Name, err)
+		}
+		// resources tracked by other applications, such as resources shared by several applications, are left as is
+		if resourceTracking.GetAppName(live, appLabelKey, from, installationID) != appInstanceName {
+			continue
+		}
+
+		target := live.DeepCopy()
+		if err := resourceTracking.RemoveAppInstance(target, string(from)); err != nil {
+			return migrated, fmt.Errorf("error removing tracking of %s %s: %w", res.Kind, res.Name, err)
+		}
+		if err := resourceTracking.SetAppInstance(target, appLabelKey, appInstanceName, app.Spec.Destination.Namespace, to, installationID); err != nil {
+			return migrated, fmt.Errorf("error setting tracking of %s %s: %w", res.Kind, res.Name, err)
+		}
+		liveBytes, err := json.Marshal(live)
+		if err != nil {
+			return migrated, fmt.Errorf("error marshaling %s %s: %w", res.Kind, res.Name, err)
+		}
+		targetBytes, err := json.Marshal(target)
+		if err != nil {
+			return migrated, fmt.Errorf("error marshaling %s %s: %w", res.Kind, res.Name, err)
+		}
+		patch, err := jsonpatch.CreateMergePatch(liveBytes, targetBytes)
+		if err != nil {
+			return migrated, fmt.Errorf("error creating patch of %s %s: %w", res.Kind, res.Name, err)
+		}
+		if string(patch) == "{}" {
+			continue
+		}
+
+		printLine("%s %s/%s/%s: %s", app.Name, res.Kind, res.Namespace, res.Name, patch)
+		if !dryRun {
+			if _, err := resourceIf.Patch(ctx, res.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return migrated, fmt.Errorf("error patching %s %s: %w", res.Kind, res.Name, err)
+			}
+		}
+		migrated++
+	}
+	return migrated, nil
+}
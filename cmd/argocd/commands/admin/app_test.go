@@ -5,6 +5,7 @@ import (
 
 	clustermocks "github.com/argoproj/gitops-engine/pkg/cache/mocks"
 	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync/applyset"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +13,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
@@ -24,6 +28,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -198,3 +203,73 @@ app3
 >   status: OutOfSync
 `, logs)
 }
+
+func TestMigrateAppTrackingMethod(t *testing.T) {
+	newConfigMap := func(name, appInstance string) *unstructured.Unstructured {
+		cm := &unstructured.Unstructured{}
+		cm.SetAPIVersion("v1")
+		cm.SetKind("ConfigMap")
+		cm.SetName(name)
+		cm.SetNamespace(test.FakeDestNamespace)
+		cm.SetAnnotations(map[string]string{common.AnnotationKeyAppInstance: appInstance + ":/ConfigMap:" + test.FakeDestNamespace + "/" + name})
+		return cm
+	}
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: test.FakeArgoCDNamespace},
+		Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: test.FakeDestNamespace}},
+		Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+			{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "tracked"},
+			{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "shared"},
+			{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "missing"},
+		}},
+	}
+
+	newClients := func() (*fakediscovery.FakeDiscovery, *dynamicfake.FakeDynamicClient) {
+		disco := kubefake.NewClientset().Discovery().(*fakediscovery.FakeDiscovery)
+		disco.Resources = []*metav1.APIResourceList{{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"get", "patch"}}},
+		}}
+		dynamicIf := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newConfigMap("tracked", "my-app"), newConfigMap("shared", "other-app"))
+		return disco, dynamicIf
+	}
+	getConfigMap := func(t *testing.T, dynamicIf *dynamicfake.FakeDynamicClient, name string) *unstructured.Unstructured {
+		t.Helper()
+		cm, err := dynamicIf.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace(test.FakeDestNamespace).Get(t.Context(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return cm
+	}
+
+	t.Run("Migrate", func(t *testing.T) {
+		disco, dynamicIf := newClients()
+		migrated, err := migrateAppTrackingMethod(t.Context(), disco, dynamicIf, app, test.FakeArgoCDNamespace, common.LabelKeyAppInstance, "", v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndApplySet, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, migrated)
+
+		tracked := getConfigMap(t, dynamicIf, "tracked")
+		assert.Equal(t, argo.ApplySetID("my-app", test.FakeDestNamespace), tracked.GetLabels()[applyset.LabelKeyPartOf])
+		assert.Equal(t, newConfigMap("tracked", "my-app").GetAnnotations(), tracked.GetAnnotations())
+		assert.Empty(t, getConfigMap(t, dynamicIf, "shared").GetLabels())
+	})
+
+	t.Run("Migrate back", func(t *testing.T) {
+		disco, dynamicIf := newClients()
+		_, err := migrateAppTrackingMethod(t.Context(), disco, dynamicIf, app, test.FakeArgoCDNamespace, common.LabelKeyAppInstance, "", v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndApplySet, false)
+		require.NoError(t, err)
+		migrated, err := migrateAppTrackingMethod(t.Context(), disco, dynamicIf, app, test.FakeArgoCDNamespace, common.LabelKeyAppInstance, "", v1alpha1.TrackingMethodAnnotationAndApplySet, v1alpha1.TrackingMethodLabel, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, migrated)
+
+		tracked := getConfigMap(t, dynamicIf, "tracked")
+		assert.Equal(t, map[string]string{common.LabelKeyAppInstance: "my-app"}, tracked.GetLabels())
+		assert.Empty(t, tracked.GetAnnotations())
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		disco, dynamicIf := newClients()
+		migrated, err := migrateAppTrackingMethod(t.Context(), disco, dynamicIf, app, test.FakeArgoCDNamespace, common.LabelKeyAppInstance, "", v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndApplySet, true)
+		require.NoError(t, err)
+		assert.Equal(t, 1, migrated)
+		assert.Empty(t, getConfigMap(t, dynamicIf, "tracked").GetLabels())
+	})
+}
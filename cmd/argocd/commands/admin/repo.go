@@ -140,6 +140,10 @@ func NewGenRepoSpecCommand() *cobra.Command {
 			repoOpts.Repo.EnableOCI = repoOpts.EnableOci
 			repoOpts.Repo.UseAzureWorkloadIdentity = repoOpts.UseAzureWorkloadIdentity
 			repoOpts.Repo.InsecureOCIForceHttp = repoOpts.InsecureOCIForceHTTP
+			repoOpts.Repo.SparseCheckout = repoOpts.SparseCheckout
+			if repoOpts.MirrorMaxStaleness > 0 {
+				repoOpts.Repo.MirrorMaxStaleness = repoOpts.MirrorMaxStaleness.String()
+			}
 
 			if repoOpts.Repo.Type == "helm" && repoOpts.Repo.Name == "" {
 				errors.CheckError(stderrors.New("must specify --name for repos of type 'helm'"))
@@ -49,6 +49,7 @@ var resourceMap = map[string]string{
 	"repo":            rbac.ResourceRepositories,
 	"repos":           rbac.ResourceRepositories,
 	"repository":      rbac.ResourceRepositories,
+	"sshsigningkey":   rbac.ResourceSSHSigningKeys,
 }
 
 // List of allowed RBAC resources
@@ -63,7 +64,8 @@ var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceLogs:            logsActions,
 	rbac.ResourceExec:            execActions,
 	rbac.ResourceProjects:        defaultCRUDActions,
-	rbac.ResourceRepositories:    defaultCRUDActions,
+	rbac.ResourceRepositories:    repositoriesActions,
+	rbac.ResourceSSHSigningKeys:  defaultCRDActions,
 }
 
 // List of allowed RBAC actions
@@ -90,6 +92,14 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionSync:     rbacTrait{},
 }
 
+var repositoriesActions = actionTraitMap{
+	rbac.ActionCreate:   rbacTrait{},
+	rbac.ActionGet:      rbacTrait{},
+	rbac.ActionUpdate:   rbacTrait{},
+	rbac.ActionDelete:   rbacTrait{},
+	rbac.ActionOverride: rbacTrait{},
+}
+
 var accountsActions = actionTraitMap{
 	rbac.ActionCreate: rbacTrait{},
 	rbac.ActionUpdate: rbacTrait{},
@@ -96,6 +96,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
@@ -820,6 +821,9 @@ func printParams(app *argoappv1.Application, sourcePosition int) {
 	if source.Helm != nil {
 		printHelmParams(source.Helm)
 	}
+	if source.CUE != nil {
+		printCUETags(source.CUE)
+	}
 }
 
 func printHelmParams(helm *argoappv1.ApplicationSourceHelm) {
@@ -836,6 +840,17 @@ func printHelmParams(helm *argoappv1.ApplicationSourceHelm) {
 	_ = w.Flush()
 }
 
+func printCUETags(cue *argoappv1.ApplicationSourceCUE) {
+	paramLenLimit := 80
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tVALUE\n")
+	for _, t := range cue.Tags {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", t.Name, truncateString(t.Value, paramLenLimit))
+	}
+	_ = w.Flush()
+}
+
 func getServer(app *argoappv1.Application) string {
 	if app.Spec.Destination.Server == "" {
 		return app.Spec.Destination.Name
@@ -1186,6 +1201,17 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			}
 		}
 	}
+
+	if source.CUE != nil {
+		if len(opts.parameters) == 0 {
+			return updated, !needToUnsetRef
+		}
+		for _, name := range opts.parameters {
+			if source.CUE.RemoveTag(name) {
+				updated = true
+			}
+		}
+	}
 	return updated, false
 }
 
@@ -2358,9 +2384,14 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				case "apply":
 					syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
 					syncReq.Strategy.Apply.Force = force
-				case "", "hook":
+				case "hook":
 					syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
 					syncReq.Strategy.Hook.Force = force
+				case "":
+					// syncs without a sync strategy use the sync strategy of the application, which defaults to hook
+					if force {
+						syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{SyncStrategyApply: argoappv1.SyncStrategyApply{Force: true}}}
+					}
 				default:
 					log.Fatalf("Unknown sync strategy: '%s'", strategy)
 				}
@@ -2440,7 +2471,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
-	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
+	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook), defaults to the sync strategy of the application")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
 	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
@@ -2913,8 +2944,20 @@ func setParameterOverrides(app *argoappv1.Application, parameters []string, sour
 			}
 			source.Helm.AddParameter(*newParam)
 		}
+	case argoappv1.ApplicationSourceTypeCUE:
+		if source.CUE == nil {
+			source.CUE = &argoappv1.ApplicationSourceCUE{}
+		}
+		for _, p := range parameters {
+			tag, err := argoappv1.NewCUETag(p)
+			if err != nil {
+				log.Error(err)
+				continue
+			}
+			source.CUE.AddTag(*tag)
+		}
 	default:
-		log.Fatalf("Parameters can only be set against Helm applications")
+		log.Fatalf("Parameters can only be set against Helm or CUE applications")
 	}
 }
 
@@ -2981,11 +3024,29 @@ func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	_ = w.Flush()
 }
 
+// Print a list of hydrate history ids for an application.
+func printHydrateHistoryIDs(hydrateHistory []argoappv1.HydrateHistory) {
+	for _, info := range hydrateHistory {
+		fmt.Println(info.ID)
+	}
+}
+
+// Print a hydrate history table for an application.
+func printHydrateHistoryTable(hydrateHistory []argoappv1.HydrateHistory) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ID\tDATE\tDRY REVISION\tHYDRATED REVISION\tMANUAL\n")
+	for _, info := range hydrateHistory {
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%t\n", info.ID, info.HydratedAt.String(), info.DrySHA, info.HydratedSHA, info.Manual)
+	}
+	_ = w.Flush()
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		output       string
 		appNamespace string
+		hydrate      bool
 	)
 	command := &cobra.Command{
 		Use:   "history APPNAME",
@@ -3006,14 +3067,20 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			})
 			errors.CheckError(err)
 
-			if output == "id" {
+			switch {
+			case hydrate && output == "id":
+				printHydrateHistoryIDs(app.Status.SourceHydrator.HydrateHistory)
+			case hydrate:
+				printHydrateHistoryTable(app.Status.SourceHydrator.HydrateHistory)
+			case output == "id":
 				printApplicationHistoryIDs(app.Status.History)
-			} else {
+			default:
 				printApplicationHistoryTable(app.Status.History)
 			}
 		},
 	}
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show application deployment history in namespace")
+	command.Flags().BoolVar(&hydrate, "hydrate", false, "Show the hydrate history of an application using a source hydrator instead of the deployment history")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
 	return command
 }
@@ -3134,6 +3201,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		sourceNames     []string
 		local           string
 		localRepoRoot   string
+		explain         bool
 	)
 	command := &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -3150,6 +3218,9 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Get manifests for an application and print to stderr how the repo server generated them
+  argocd app manifests my-app --explain
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -3177,6 +3248,10 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				}
 			}
 
+			if explain && (source != "git" || local != "") {
+				errors.Fatal(errors.ErrorGeneric, "--explain can only be used with manifests generated by the repo server.")
+			}
+
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
@@ -3207,6 +3282,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			errors.CheckError(err)
 
 			var unstructureds []*unstructured.Unstructured
+			var traces []*repoapiclient.ManifestGenerationTrace
 			switch source {
 			case "git":
 				switch {
@@ -3231,6 +3307,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						Revision:        ptr.To(revision),
 						Revisions:       revisions,
 						SourcePositions: sourcePositions,
+						Explain:         ptr.To(explain),
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -3240,11 +3317,13 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						errors.CheckError(err)
 						unstructureds = append(unstructureds, obj)
 					}
-				case revision != "":
+					traces = res.Traces
+				case revision != "" || explain:
 					q := application.ApplicationManifestQuery{
 						Name:         &appName,
 						AppNamespace: &appNs,
 						Revision:     ptr.To(revision),
+						Explain:      ptr.To(explain),
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -3254,6 +3333,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						errors.CheckError(err)
 						unstructureds = append(unstructureds, obj)
 					}
+					traces = res.Traces
 				default:
 					targetObjs, err := targetObjects(resources.Items)
 					errors.CheckError(err)
@@ -3273,6 +3353,10 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				errors.CheckError(err)
 				fmt.Printf("%s\n", yamlBytes)
 			}
+
+			if explain {
+				printManifestGenerationTraces(os.Stderr, traces)
+			}
 		},
 	}
 	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
@@ -3282,6 +3366,120 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().BoolVar(&explain, "explain", false, "Print to stderr how the repo server generated the manifests of each source: the source type, the commands, value files, environment and referenced sources used, and whether the manifests were cached")
+	return command
+}
+
+// printManifestGenerationTraces prints the traces of the manifest generation of an application's sources
+func printManifestGenerationTraces(out io.Writer, traces []*repoapiclient.ManifestGenerationTrace) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for i, trace := range traces {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		_, _ = fmt.Fprintf(w, "Repo URL:\t%s\n", trace.RepoURL)
+		if trace.Chart != "" {
+			_, _ = fmt.Fprintf(w, "Chart:\t%s\n", trace.Chart)
+		} else {
+			_, _ = fmt.Fprintf(w, "Path:\t%s\n", trace.Path)
+		}
+		_, _ = fmt.Fprintf(w, "Revision:\t%s\n", trace.Revision)
+		_, _ = fmt.Fprintf(w, "Source Type:\t%s\n", trace.SourceType)
+		cacheStatus := "miss"
+		if trace.CacheHit {
+			cacheStatus = "hit"
+		}
+		_, _ = fmt.Fprintf(w, "Cache:\t%s\n", cacheStatus)
+		for _, ref := range trace.RefSources {
+			_, _ = fmt.Fprintf(w, "Ref Source:\t%s %s (%s resolved to %s)\n", ref.Ref, ref.RepoURL, ref.TargetRevision, ref.Revision)
+		}
+		for _, valueFile := range trace.ValueFiles {
+			_, _ = fmt.Fprintf(w, "Value File:\t%s\n", valueFile)
+		}
+		for _, env := range trace.Env {
+			_, _ = fmt.Fprintf(w, "Env:\t%s\n", env)
+		}
+		for _, command := range trace.Commands {
+			_, _ = fmt.Fprintf(w, "Command:\t%s\n", command)
+		}
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		dryRun       bool
+		dryRevision  string
+		historyID    int64
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Hydrate the manifests of an application using a source hydrator",
+		Example: templates.Examples(`
+  # Hydrate the target revision of the dry source, even if it was already hydrated
+  argocd app hydrate my-app
+
+  # Hydrate a specific dry revision
+  argocd app hydrate my-app --dry-revision 0123456789abcdef0123456789abcdef01234567
+
+  # Hydrate the dry revision of an entry of the hydrate history, e.g. to roll the hydrated branch back
+  argocd app hydrate my-app --id 3
+
+  # Show the changes hydrating the dry source target revision would make to the sync branch
+  argocd app hydrate my-app --dry-run
+
+  # Show the changes hydrating a specific dry revision would make to the sync branch
+  argocd app hydrate my-app --dry-run --dry-revision 0123456789abcdef0123456789abcdef01234567
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var id *int64
+			if c.Flags().Changed("id") {
+				if dryRevision != "" {
+					errors.Fatal(errors.ErrorGeneric, "Only one of --dry-revision and --id can be specified.")
+				}
+				id = &historyID
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			if !dryRun {
+				_, err := appIf.Hydrate(ctx, &application.ApplicationHydrateRequest{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					DryRevision:  &dryRevision,
+					Id:           id,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Application '%s' hydration requested\n", appName)
+				return
+			}
+			resp, err := appIf.HydrateDryRun(ctx, &application.ApplicationHydrateDryRunRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				DryRevision:  &dryRevision,
+				Id:           id,
+			})
+			errors.CheckError(err)
+			if resp.GetDiff() == "" {
+				fmt.Printf("Hydrating revision %s would not change the manifests of application '%s'\n", resp.GetDrySha(), appName)
+				return
+			}
+			fmt.Print(resp.GetDiff())
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only hydrate an application in namespace")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Render the hydrated manifests and show the diff against the sync branch without committing them")
+	command.Flags().StringVar(&dryRevision, "dry-revision", "", "Revision of the dry source to hydrate. Defaults to the target revision of the dry source")
+	command.Flags().Int64Var(&historyID, "id", 0, "ID of the hydrate history entry whose dry revision to hydrate")
 	return command
 }
 
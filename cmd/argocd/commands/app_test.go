@@ -42,6 +42,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsigningkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigningkey"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -875,6 +876,49 @@ func TestPrintAppConditions(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print app conditions output %q, should be %q", output, expectation)
 }
 
+func TestPrintManifestGenerationTraces(t *testing.T) {
+	output, _ := captureOutput(func() error {
+		printManifestGenerationTraces(os.Stdout, []*apiclient.ManifestGenerationTrace{
+			{
+				RepoURL:    "https://github.com/argoproj/argocd-example-apps.git",
+				Path:       "helm-guestbook",
+				Revision:   "abc123",
+				SourceType: "Helm",
+				ValueFiles: []string{"./helm-guestbook/values-production.yaml", "./values/guestbook.yaml"},
+				Env:        []string{"ARGOCD_APP_NAME=guestbook"},
+				Commands:   []string{"helm template . --name-template guestbook --values ./helm-guestbook/values-production.yaml"},
+				RefSources: []*apiclient.ResolvedRefSource{{Ref: "$values", RepoURL: "https://github.com/argoproj/values.git", TargetRevision: "main", Revision: "def456"}},
+			},
+			{
+				RepoURL:    "https://charts.example.com",
+				Chart:      "nginx",
+				Revision:   "1.0.0",
+				SourceType: "Helm",
+				CacheHit:   true,
+			},
+		})
+		return nil
+	})
+	expectation := `Repo URL:     https://github.com/argoproj/argocd-example-apps.git
+Path:         helm-guestbook
+Revision:     abc123
+Source Type:  Helm
+Cache:        miss
+Ref Source:   $values https://github.com/argoproj/values.git (main resolved to def456)
+Value File:   ./helm-guestbook/values-production.yaml
+Value File:   ./values/guestbook.yaml
+Env:          ARGOCD_APP_NAME=guestbook
+Command:      helm template . --name-template guestbook --values ./helm-guestbook/values-production.yaml
+
+Repo URL:     https://charts.example.com
+Chart:        nginx
+Revision:     1.0.0
+Source Type:  Helm
+Cache:        hit
+`
+	assert.Equal(t, expectation, output)
+}
+
 func TestPrintParams(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -1215,6 +1259,17 @@ func Test_unset(t *testing.T) {
 	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
+
+	cueSource := &v1alpha1.ApplicationSource{
+		CUE: &v1alpha1.ApplicationSourceCUE{Tags: []v1alpha1.CUETag{{Name: "env", Value: "prod"}, {Name: "replicas", Value: "3"}}},
+	}
+	updated, nothingToUnset = unset(cueSource, unsetOpts{parameters: []string{"env"}})
+	assert.Equal(t, []v1alpha1.CUETag{{Name: "replicas", Value: "3"}}, cueSource.CUE.Tags)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+	updated, nothingToUnset = unset(cueSource, unsetOpts{parameters: []string{"env"}})
+	assert.False(t, updated)
+	assert.False(t, nothingToUnset)
 }
 
 func Test_unset_nothingToUnset(t *testing.T) {
@@ -1227,6 +1282,7 @@ func Test_unset_nothingToUnset(t *testing.T) {
 		{"kustomize", v1alpha1.ApplicationSource{Kustomize: &v1alpha1.ApplicationSourceKustomize{}}},
 		{"helm", v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{}}},
 		{"plugin", v1alpha1.ApplicationSource{Plugin: &v1alpha1.ApplicationSourcePlugin{}}},
+		{"cue", v1alpha1.ApplicationSource{CUE: &v1alpha1.ApplicationSourceCUE{}}},
 	}
 
 	for _, testCase := range testCases {
@@ -2172,6 +2228,14 @@ func (c *fakeAppServiceClient) GetManifests(_ context.Context, _ *applicationpkg
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) Hydrate(_ context.Context, _ *applicationpkg.ApplicationHydrateRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) HydrateDryRun(_ context.Context, _ *applicationpkg.ApplicationHydrateDryRunRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydrateDryRunResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetManifestsWithFiles(_ context.Context, _ ...grpc.CallOption) (applicationpkg.ApplicationService_GetManifestsWithFilesClient, error) {
 	return nil, nil
 }
@@ -2309,6 +2373,14 @@ func (c *fakeAcdClient) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServi
 	return nil, nil
 }
 
+func (c *fakeAcdClient) NewSSHSigningKeyClient() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient, error) {
+	return nil, nil, nil
+}
+
+func (c *fakeAcdClient) NewSSHSigningKeyClientOrDie() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient) {
+	return nil, nil
+}
+
 func (c *fakeAcdClient) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	return nil, nil, nil
 }
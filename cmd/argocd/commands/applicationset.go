@@ -55,6 +55,7 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutCommand(clientOpts))
 	return command
 }
 
@@ -100,6 +101,12 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 					_ = w.Flush()
 					fmt.Println()
 				}
+				if len(appSet.Status.RolloutSteps) > 0 {
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppSetRolloutSteps(w, appSet)
+					_ = w.Flush()
+					fmt.Println()
+				}
 				if showParams {
 					printHelmParams(appSet.Spec.Template.Spec.GetSource().Helm)
 				}
@@ -211,13 +218,20 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 
 // NewApplicationSetGenerateCommand returns a new instance of an `argocd appset generate` command
 func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output   string
+		diff     bool
+		exitCode bool
+	)
 	command := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate apps of ApplicationSet rendered templates",
 		Example: templates.Examples(`
 	# Generate apps of ApplicationSet rendered templates
 	argocd appset generate <filename or URL> (<filename or URL>...)
+
+	# Show the apps which would be created, updated or deleted compared to the live apps of the ApplicationSet
+	argocd appset generate <filename or URL> --diff
 `),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -249,6 +263,25 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 			resp, err := appIf.Generate(ctx, &req)
 			errors.CheckError(err)
 
+			if diff {
+				switch output {
+				case "yaml", "json":
+					resources := make([]any, 0, len(resp.Diffs))
+					for _, appDiff := range resp.Diffs {
+						resources = append(resources, appDiff)
+					}
+					cobra.CheckErr(admin.PrintResources(output, os.Stdout, resources...))
+				case "wide", "":
+					printApplicationSetDiffs(os.Stdout, resp.Diffs)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+				if exitCode && len(resp.Diffs) > 0 {
+					os.Exit(1)
+				}
+				return
+			}
+
 			var appsList []arogappsetv1.Application
 			for i := range resp.Applications {
 				appsList = append(appsList, *resp.Applications[i])
@@ -274,6 +307,8 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&diff, "diff", false, "Show the apps which would be created, updated or deleted compared to the live apps owned by the ApplicationSet")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when --diff is set and there is a diff")
 	return command
 }
 
@@ -384,6 +419,57 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetRolloutCommand returns a new instance of an `argocd appset rollout` command
+func NewApplicationSetRolloutCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage the RollingSync rollout of an ApplicationSet",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationSetRolloutPromoteCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSetRolloutPromoteCommand returns a new instance of an `argocd appset rollout promote` command
+func NewApplicationSetRolloutPromoteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var step string
+	command := &cobra.Command{
+		Use:   "promote APPSETNAME",
+		Short: "Promote a RollingSync step of an ApplicationSet waiting for approval",
+		Example: templates.Examples(`
+	# Promote the step waiting for approval
+	argocd appset rollout promote APPSETNAME
+
+	# Promote the second step
+	argocd appset rollout promote APPSETNAME --step 2
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			appSet, err := appIf.RolloutPromote(ctx, &applicationset.ApplicationSetRolloutPromoteRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Step:            step,
+			})
+			errors.CheckError(err)
+			fmt.Printf("applicationset '%s' rollout promoted\n", appSet.QualifiedName())
+		},
+	}
+	command.Flags().StringVar(&step, "step", "", "The number of the step to promote. Defaults to the step waiting for approval")
+	return command
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -476,6 +562,39 @@ func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
 	}
 }
 
+func printAppSetRolloutSteps(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	_, _ = fmt.Fprintf(w, "STEP\tGATES\tMESSAGE\tLAST TRANSITION\n")
+	for _, item := range appSet.Status.RolloutSteps {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Step, item.Status, item.Message, item.LastTransitionTime)
+	}
+}
+
+func printApplicationSetDiffs(out io.Writer, diffs []*applicationset.ApplicationSetApplicationDiff) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tACTION\tBLOCKED\n")
+	for _, appDiff := range diffs {
+		_, _ = fmt.Fprintf(w, "%s/%s\t%s\t%t\n", appDiff.Namespace, appDiff.Name, appDiff.Action, appDiff.Blocked)
+	}
+	_ = w.Flush()
+
+	for _, appDiff := range diffs {
+		if len(appDiff.Fields) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "\n====== %s/%s ======\n", appDiff.Namespace, appDiff.Name)
+		for _, field := range appDiff.Fields {
+			_, _ = fmt.Fprintf(out, "%s: %s -> %s\n", field.Path, formatFieldValue(field.LiveValue), formatFieldValue(field.TargetValue))
+		}
+	}
+}
+
+func formatFieldValue(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
 func hasAppSetChanged(appReq, appRes *arogappsetv1.ApplicationSet, upsert bool) bool {
 	// upsert==false, no change occurred from create command
 	if !upsert {
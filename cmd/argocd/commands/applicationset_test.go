@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -282,3 +283,33 @@ SyncPolicy:         <none>
 		})
 	}
 }
+
+func TestPrintApplicationSetDiffs(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printApplicationSetDiffs(os.Stdout, []*applicationset.ApplicationSetApplicationDiff{
+			{Name: "app1", Namespace: "argocd", Action: "create"},
+			{
+				Name:      "app2",
+				Namespace: "argocd",
+				Action:    "update",
+				Fields: []*applicationset.ApplicationSetFieldDiff{
+					{Path: "metadata.labels.env", TargetValue: `"prod"`},
+					{Path: "spec.source.targetRevision", LiveValue: `"v1"`, TargetValue: `"v2"`},
+				},
+			},
+			{Name: "app3", Namespace: "argocd", Action: "delete", Blocked: true},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `NAME         ACTION  BLOCKED
+argocd/app1  create  false
+argocd/app2  update  false
+argocd/app3  delete  true
+
+====== argocd/app2 ======
+metadata.labels.env: <none> -> "prod"
+spec.source.targetRevision: "v1" -> "v2"
+`
+	assert.Equal(t, expectation, output)
+}
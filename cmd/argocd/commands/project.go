@@ -28,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 )
 
@@ -71,6 +72,8 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectEditCommand(clientOpts))
 	command.AddCommand(NewProjectAddSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSignatureKeyCommand(clientOpts))
+	command.AddCommand(NewProjectAddSSHSignatureKeyCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveSSHSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
@@ -267,6 +270,92 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewProjectAddSSHSignatureKeyCommand returns a new instance of an `argocd proj add-ssh-signature-key` command
+func NewProjectAddSSHSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "add-ssh-signature-key PROJECT FINGERPRINT",
+		Short: "Add SSH signature key to project",
+		Example: templates.Examples(`
+			# Add SSH signature key with the SHA256 fingerprint FINGERPRINT to project PROJECT
+			argocd proj add-ssh-signature-key PROJECT SHA256:FINGERPRINT
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			fingerprint := args[1]
+
+			if !sshsigning.IsFingerprint(fingerprint) {
+				log.Fatalf("%s is not a valid SSH key fingerprint", fingerprint)
+			}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, key := range proj.Spec.SSHSignatureKeys {
+				if key.Fingerprint == fingerprint {
+					log.Fatal("Specified SSH signature key is already defined in project")
+				}
+			}
+			proj.Spec.SSHSignatureKeys = append(proj.Spec.SSHSignatureKeys, v1alpha1.SSHSignatureKey{Fingerprint: fingerprint})
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveSSHSignatureKeyCommand returns a new instance of an `argocd proj remove-ssh-signature-key` command
+func NewProjectRemoveSSHSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "remove-ssh-signature-key PROJECT FINGERPRINT",
+		Short: "Remove SSH signature key from project",
+		Example: templates.Examples(`
+			# Remove SSH signature key with the SHA256 fingerprint FINGERPRINT from project PROJECT
+			argocd proj remove-ssh-signature-key PROJECT SHA256:FINGERPRINT
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			fingerprint := args[1]
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := -1
+			for i, key := range proj.Spec.SSHSignatureKeys {
+				if key.Fingerprint == fingerprint {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				log.Fatal("Specified SSH signature key is not configured for project")
+			}
+			proj.Spec.SSHSignatureKeys = append(proj.Spec.SSHSignatureKeys[:index], proj.Spec.SSHSignatureKeys[index+1:]...)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+
+	return command
+}
+
 // NewProjectAddDestinationCommand returns a new instance of an `argocd proj add-destination` command
 func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var nameInsteadServer bool
@@ -963,11 +1052,11 @@ func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	default:
 		namespaceBlacklist = fmt.Sprintf("%d resources", len(p.Spec.NamespaceResourceBlacklist))
 	}
-	switch len(p.Spec.SignatureKeys) {
+	switch len(p.Spec.SignatureKeys) + len(p.Spec.SSHSignatureKeys) {
 	case 0:
 		signatureKeys = "<none>"
 	default:
-		signatureKeys = fmt.Sprintf("%d key(s)", len(p.Spec.SignatureKeys))
+		signatureKeys = fmt.Sprintf("%d key(s)", len(p.Spec.SignatureKeys)+len(p.Spec.SSHSignatureKeys))
 	}
 	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, destinations, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
 }
@@ -1059,6 +1148,17 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	}
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
 
+	// Print required SSH signature keys
+	sshSignatureKeysStr := "<none>"
+	if len(p.Spec.SSHSignatureKeys) > 0 {
+		fingerprints := make([]string, 0)
+		for _, key := range p.Spec.SSHSignatureKeys {
+			fingerprints = append(fingerprints, key.Fingerprint)
+		}
+		sshSignatureKeysStr = strings.Join(fingerprints, ", ")
+	}
+	fmt.Printf(printProjFmtStr, "SSH signature keys:", sshSignatureKeysStr)
+
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -60,6 +61,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 		tlsClientCertKeyPath     string
 		githubAppPrivateKeyPath  string
 		gcpServiceAccountKeyPath string
+		mirrorMaxStaleness       time.Duration
 	)
 
 	// For better readability and easier formatting
@@ -158,6 +160,10 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 				}
 			}
 
+			if mirrorMaxStaleness > 0 {
+				repo.MirrorMaxStaleness = mirrorMaxStaleness.String()
+			}
+
 			conn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoCredsClientOrDie()
 			defer utilio.Close(conn)
 
@@ -201,6 +207,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().BoolVar(&repo.ForceHttpBasicAuth, "force-http-basic-auth", false, "whether to force basic auth when connecting via HTTP")
 	command.Flags().BoolVar(&repo.UseAzureWorkloadIdentity, "use-azure-workload-identity", false, "whether to use azure workload identity for authentication")
 	command.Flags().StringVar(&repo.Proxy, "proxy-url", "", "If provided, this URL will be used to connect via proxy")
+	command.Flags().StringVar(&repo.MirrorURL, "mirror-url", "", "URL of read-only mirrors git objects are fetched from anonymously before they are fetched from the repositories, the part of the repository URL following the credentials URL is appended (requires the override permission on repositories)")
+	command.Flags().StringVar(&repo.BundleURI, "bundle-uri", "", "HTTP(S) URI of git bundles the local clones of the repositories are initialized from, the part of the repository URL following the credentials URL is appended (requires the override permission on repositories)")
+	command.Flags().DurationVar(&mirrorMaxStaleness, "mirror-max-staleness", 0, "duration for which git branches may be resolved from the mirrors after the refs were last listed from the repositories, e.g. 5m (branches are always resolved from the repositories if zero, requires the override permission on repositories)")
 	return command
 }
 
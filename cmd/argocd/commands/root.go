@@ -66,6 +66,7 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(NewLogoutCommand(&clientOpts))
 	command.AddCommand(initialize.InitCommand(NewCertCommand(&clientOpts)))
 	command.AddCommand(initialize.InitCommand(NewGPGCommand(&clientOpts)))
+	command.AddCommand(initialize.InitCommand(NewSSHSigningKeyCommand(&clientOpts)))
 	command.AddCommand(admin.NewAdminCommand(&clientOpts))
 	command.AddCommand(initialize.InitCommand(NewConfigureCommand(&clientOpts)))
 
@@ -0,0 +1,202 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	sshsigningkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigningkey"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewSSHSigningKeyCommand returns a new instance of an `argocd ssh-signing-key` command
+func NewSSHSigningKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "ssh-signing-key",
+		Short: "Manage SSH keys used for signature verification",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: ``,
+	}
+	command.AddCommand(NewSSHSigningKeyListCommand(clientOpts))
+	command.AddCommand(NewSSHSigningKeyGetCommand(clientOpts))
+	command.AddCommand(NewSSHSigningKeyAddCommand(clientOpts))
+	command.AddCommand(NewSSHSigningKeyDeleteCommand(clientOpts))
+	return command
+}
+
+// NewSSHSigningKeyListCommand lists all configured SSH signing keys from the server
+func NewSSHSigningKeyListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List configured SSH signing keys",
+		Example: templates.Examples(`
+  # List all configured SSH signing keys in wide format (default).
+  argocd ssh-signing-key list
+
+  # List all configured SSH signing keys in JSON format.
+  argocd ssh-signing-key list -o json
+
+  # List all configured SSH signing keys in YAML format.
+  argocd ssh-signing-key list -o yaml
+  		`),
+
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			conn, sshSigningKeyIf := headless.NewClientOrDie(clientOpts, c).NewSSHSigningKeyClientOrDie()
+			defer utilio.Close(conn)
+			keys, err := sshSigningKeyIf.List(ctx, &sshsigningkeypkg.SSHSigningKeyQuery{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(keys.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSSHSigningKeyTable(keys.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSSHSigningKeyGetCommand retrieves a single SSH signing key from the server
+func NewSSHSigningKeyGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get FINGERPRINT",
+		Short: "Get the SSH signing key with SHA256 fingerprint <FINGERPRINT> from the server",
+		Example: templates.Examples(`
+  # Get an SSH signing key with the specified fingerprint in wide format (default).
+  argocd ssh-signing-key get SHA256:FINGERPRINT
+
+  # Get an SSH signing key with the specified fingerprint in JSON format.
+  argocd ssh-signing-key get SHA256:FINGERPRINT -o json
+
+  # Get an SSH signing key with the specified fingerprint in YAML format.
+  argocd ssh-signing-key get SHA256:FINGERPRINT -o yaml
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing FINGERPRINT argument")
+			}
+			conn, sshSigningKeyIf := headless.NewClientOrDie(clientOpts, c).NewSSHSigningKeyClientOrDie()
+			defer utilio.Close(conn)
+			// Fingerprints may contain slashes, so they are sent in the URL safe form
+			key, err := sshSigningKeyIf.Get(ctx, &sshsigningkeypkg.SSHSigningKeyQuery{Fingerprint: sshsigning.ConfigMapKey(sshsigning.Fingerprint(args[0]))})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(key, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				fmt.Printf("Key fingerprint: %s\n", key.Fingerprint)
+				fmt.Printf("Key type:        %s\n", key.KeyType)
+				fmt.Printf("Key comment:     %s\n", key.Comment)
+				fmt.Printf("Key data:        %s\n", key.KeyData)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSSHSigningKeyAddCommand adds an SSH signing key to the server's configuration
+func NewSSHSigningKeyAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var fromFile string
+	command := &cobra.Command{
+		Use:   "add",
+		Short: "Adds an SSH signing key to the server's configuration",
+		Example: templates.Examples(`
+  # Add an SSH signing key to the server's configuration from a public key file.
+  argocd ssh-signing-key add --from ~/.ssh/id_ed25519.pub
+  		`),
+
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			if fromFile == "" {
+				errors.CheckError(stderrors.New("--from is mandatory"))
+			}
+			keyData, err := os.ReadFile(fromFile)
+			if err != nil {
+				errors.CheckError(err)
+			}
+			conn, sshSigningKeyIf := headless.NewClientOrDie(clientOpts, c).NewSSHSigningKeyClientOrDie()
+			defer utilio.Close(conn)
+			resp, err := sshSigningKeyIf.Create(ctx, &sshsigningkeypkg.SSHSigningKeyCreateRequest{Publickey: &appsv1.SSHSigningKey{KeyData: string(keyData)}})
+			errors.CheckError(err)
+			for _, k := range resp.Created.Items {
+				fmt.Printf("Created key with fingerprint %s\n", k.Fingerprint)
+			}
+			for _, fingerprint := range resp.Skipped {
+				fmt.Printf("Skipped key with fingerprint %s because it exists already\n", fingerprint)
+			}
+		},
+	}
+	command.Flags().StringVarP(&fromFile, "from", "f", "", "Path to the file that contains the SSH public key to import")
+	return command
+}
+
+// NewSSHSigningKeyDeleteCommand removes an SSH signing key from the server's configuration
+func NewSSHSigningKeyDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rm FINGERPRINT",
+		Short: "Removes an SSH signing key from the server's configuration",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing FINGERPRINT argument")
+			}
+
+			fingerprint := args[0]
+
+			conn, sshSigningKeyIf := headless.NewClientOrDie(clientOpts, c).NewSSHSigningKeyClientOrDie()
+			defer utilio.Close(conn)
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to remove '%s'? [y/n] ", fingerprint))
+			if canDelete {
+				_, err := sshSigningKeyIf.Delete(ctx, &sshsigningkeypkg.SSHSigningKeyQuery{Fingerprint: fingerprint})
+				errors.CheckError(err)
+				fmt.Printf("Deleted key with fingerprint %s\n", fingerprint)
+			} else {
+				fmt.Printf("The command to delete key with fingerprint '%s' was cancelled.\n", fingerprint)
+			}
+		},
+	}
+	return command
+}
+
+// Print table of SSH signing key info
+func printSSHSigningKeyTable(keys []appsv1.SSHSigningKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "FINGERPRINT\tTYPE\tCOMMENT\n")
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", k.Fingerprint, k.KeyType, k.Comment)
+	}
+	_ = w.Flush()
+}
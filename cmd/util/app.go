@@ -83,6 +83,9 @@ type AppOptions struct {
 	kustomizeApiVersions            []string //nolint:revive //FIXME(var-naming)
 	ignoreMissingComponents         bool
 	pluginEnvs                      []string
+	cuePackage                      string
+	cueExpression                   string
+	cueTags                         []string
 	Validate                        bool
 	directoryExclude                string
 	directoryInclude                string
@@ -153,6 +156,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replicas (e.g. --kustomize-replica my-development=2 --kustomize-replica my-statefulset=4)")
 	command.Flags().BoolVar(&opts.ignoreMissingComponents, "ignore-missing-components", false, "Ignore locally missing component directories when setting Kustomize components")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Additional plugin envs")
+	command.Flags().StringVar(&opts.cuePackage, "cue-package", "", "CUE package to evaluate (e.g. ./config:prod)")
+	command.Flags().StringVar(&opts.cueExpression, "cue-expression", "", "CUE expression selecting the manifests (e.g. objects)")
+	command.Flags().StringArrayVar(&opts.cueTags, "cue-tag", []string{}, "CUE tags (e.g. --cue-tag env=prod --cue-tag replicas=3)")
 	command.Flags().BoolVar(&opts.Validate, "validate", true, "Validation of repo and cluster")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonLabels, "kustomize-common-label", []string{}, "Set common labels in Kustomize")
 	command.Flags().StringArrayVar(&opts.kustomizeCommonAnnotations, "kustomize-common-annotation", []string{}, "Set common labels in Kustomize")
@@ -398,6 +404,31 @@ func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 	}
 }
 
+type cueOpts struct {
+	pkg        string
+	expression string
+	tags       []string
+}
+
+func setCUEOpt(src *argoappv1.ApplicationSource, opts cueOpts) {
+	if src.CUE == nil {
+		src.CUE = &argoappv1.ApplicationSourceCUE{}
+	}
+	if opts.pkg != "" {
+		src.CUE.Package = opts.pkg
+	}
+	if opts.expression != "" {
+		src.CUE.Expression = opts.expression
+	}
+	for _, text := range opts.tags {
+		tag, err := argoappv1.NewCUETag(text)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src.CUE.AddTag(*tag)
+	}
+}
+
 type helmOpts struct {
 	valueFiles              []string
 	ignoreMissingValueFiles bool
@@ -791,6 +822,12 @@ func ConstructSource(source *argoappv1.ApplicationSource, appOpts AppOptions, fl
 			setJsonnetOptLibs(source, appOpts.jsonnetLibs)
 		case "plugin-env":
 			setPluginOptEnvs(source, appOpts.pluginEnvs)
+		case "cue-package":
+			setCUEOpt(source, cueOpts{pkg: appOpts.cuePackage})
+		case "cue-expression":
+			setCUEOpt(source, cueOpts{expression: appOpts.cueExpression})
+		case "cue-tag":
+			setCUEOpt(source, cueOpts{tags: appOpts.cueTags})
 		case "ref":
 			source.Ref = appOpts.ref
 		case "source-name":
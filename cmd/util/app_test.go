@@ -194,6 +194,17 @@ func Test_setJsonnetOpt(t *testing.T) {
 	})
 }
 
+func Test_setCUEOpt(t *testing.T) {
+	src := v1alpha1.ApplicationSource{}
+	setCUEOpt(&src, cueOpts{pkg: "./config:prod", expression: "objects"})
+	assert.Equal(t, "./config:prod", src.CUE.Package)
+	assert.Equal(t, "objects", src.CUE.Expression)
+	setCUEOpt(&src, cueOpts{tags: []string{"env=dev", "replicas=3"}})
+	setCUEOpt(&src, cueOpts{tags: []string{"env=prod"}})
+	assert.Equal(t, []v1alpha1.CUETag{{Name: "env", Value: "prod"}, {Name: "replicas", Value: "3"}}, src.CUE.Tags)
+	assert.Equal(t, "./config:prod", src.CUE.Package)
+}
+
 func Test_setPluginOptEnvs(t *testing.T) {
 	t.Run("PluginEnvs", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
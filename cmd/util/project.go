@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/config"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
 )
 
 type ProjectOpts struct {
@@ -25,6 +26,7 @@ type ProjectOpts struct {
 	destinationServiceAccounts []string
 	Sources                    []string
 	SignatureKeys              []string
+	SSHSignatureKeys           []string
 	SourceNamespaces           []string
 
 	orphanedResourcesEnabled   bool
@@ -41,6 +43,7 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
 	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
+	command.Flags().StringSliceVar(&opts.SSHSignatureKeys, "ssh-signature-keys", []string{}, "SHA256 fingerprints of SSH public keys for commit signature verification")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources, optionally with group and name (e.g. ClusterRole, apiextensions.k8s.io/CustomResourceDefinition, /Namespace/team1-*)")
@@ -137,6 +140,18 @@ func (opts *ProjectOpts) GetSignatureKeys() []v1alpha1.SignatureKey {
 	return signatureKeys
 }
 
+// GetSSHSignatureKeys returns the SSH signing keys given by their SHA256 fingerprints
+func (opts *ProjectOpts) GetSSHSignatureKeys() []v1alpha1.SSHSignatureKey {
+	signatureKeys := make([]v1alpha1.SSHSignatureKey, 0)
+	for _, keyStr := range opts.SSHSignatureKeys {
+		if !sshsigning.IsFingerprint(keyStr) {
+			log.Fatalf("'%s' is not a valid SSH key fingerprint", keyStr)
+		}
+		signatureKeys = append(signatureKeys, v1alpha1.SSHSignatureKey{Fingerprint: keyStr})
+	}
+	return signatureKeys
+}
+
 func (opts *ProjectOpts) GetSourceNamespaces() []string {
 	return opts.SourceNamespaces
 }
@@ -188,6 +203,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceRepos = projOpts.Sources
 		case "signature-keys":
 			spec.SignatureKeys = projOpts.GetSignatureKeys()
+		case "ssh-signature-keys":
+			spec.SSHSignatureKeys = projOpts.GetSSHSignatureKeys()
 		case "allow-cluster-resource":
 			spec.ClusterResourceWhitelist = projOpts.GetAllowedClusterResources()
 		case "deny-cluster-resource":
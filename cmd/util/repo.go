@@ -1,6 +1,8 @@
 package util
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -28,6 +30,8 @@ type RepoOptions struct {
 	ForceHttpBasicAuth             bool //nolint:revive //FIXME(var-naming)
 	UseAzureWorkloadIdentity       bool
 	Depth                          int64
+	SparseCheckout                 bool
+	MirrorMaxStaleness             time.Duration
 }
 
 func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
@@ -55,4 +59,8 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().BoolVar(&opts.UseAzureWorkloadIdentity, "use-azure-workload-identity", false, "whether to use azure workload identity for authentication")
 	command.Flags().BoolVar(&opts.InsecureOCIForceHTTP, "insecure-oci-force-http", false, "Use http when accessing an OCI repository")
 	command.Flags().Int64Var(&opts.Depth, "depth", 0, "Specify a custom depth for git clone operations. Unless specified, a full clone is performed using the depth of 0")
+	command.Flags().BoolVar(&opts.SparseCheckout, "sparse-checkout", false, "fetch git repositories without file contents and check out only the directories needed to generate the manifests of an application")
+	command.Flags().StringVar(&opts.Repo.MirrorURL, "mirror-url", "", "URL of a read-only mirror git objects are fetched from anonymously before they are fetched from the repository (requires the override permission on repositories)")
+	command.Flags().StringVar(&opts.Repo.BundleURI, "bundle-uri", "", "HTTP(S) URI of a git bundle the local clone of the repository is initialized from (requires the override permission on repositories)")
+	command.Flags().DurationVar(&opts.MirrorMaxStaleness, "mirror-max-staleness", 0, "duration for which git branches may be resolved from the mirror after the refs were last listed from the repository, e.g. 5m (branches are always resolved from the repository if zero, requires the override permission on repositories)")
 }
@@ -43,10 +43,13 @@ type CommitHydratedManifestsRequest struct {
 	// Paths contains the paths to write hydrated manifests to, along with the manifests and commands to execute.
 	Paths []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
 	// DryCommitMetadata contains metadata about the DRY commit, such as the author and committer.
-	DryCommitMetadata    *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	DryCommitMetadata *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
+	// DryRepoURL is the URL of the repository of the dry source, if it is not the repository the hydrated manifests are
+	// pushed to, i.e. if Repo is an OCI repository.
+	DryRepoURL           string   `protobuf:"bytes,8,opt,name=dryRepoURL,proto3" json:"dryRepoURL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -131,6 +134,13 @@ func (m *CommitHydratedManifestsRequest) GetDryCommitMetadata() *v1alpha1.Revisi
 	return nil
 }
 
+func (m *CommitHydratedManifestsRequest) GetDryRepoURL() string {
+	if m != nil {
+		return m.DryRepoURL
+	}
+	return ""
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -139,10 +149,12 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Layout specifies how the manifests are laid out in files within the path.
+	Layout               *v1alpha1.HydrateLayout `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *PathDetails) Reset()         { *m = PathDetails{} }
@@ -199,6 +211,13 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetLayout() *v1alpha1.HydrateLayout {
+	if m != nil {
+		return m.Layout
+	}
+	return nil
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -307,37 +326,40 @@ func init() {
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xeb, 0x34, 0x90, 0x49, 0x7b, 0x60, 0x0f, 0xd4, 0xca, 0xc1, 0xb5, 0x2c, 0x0e, 0xb9,
-	0xb0, 0x56, 0x13, 0xc1, 0x8d, 0x4b, 0xc3, 0xa1, 0x42, 0xb4, 0x20, 0xe7, 0x86, 0x2a, 0xa1, 0xa9,
-	0xbd, 0xd8, 0x4b, 0x63, 0xef, 0xb2, 0xbb, 0xb1, 0x64, 0x89, 0xaf, 0xe1, 0x6b, 0x38, 0xf2, 0x09,
-	0x28, 0x5f, 0x82, 0xbc, 0xb6, 0x69, 0x02, 0x0a, 0x39, 0x70, 0xf2, 0xce, 0xbc, 0xf1, 0x7b, 0xb3,
-	0xf3, 0x76, 0x20, 0x48, 0x44, 0x51, 0x70, 0xa3, 0x99, 0xaa, 0x98, 0x8a, 0xda, 0xa0, 0xfb, 0x50,
-	0xa9, 0x84, 0x11, 0x93, 0xb7, 0x19, 0x37, 0xf9, 0xfa, 0x8e, 0x26, 0xa2, 0x88, 0x50, 0x65, 0x42,
-	0x2a, 0xf1, 0xd9, 0x1e, 0x9e, 0x27, 0x69, 0x54, 0xcd, 0x23, 0x79, 0x9f, 0x45, 0x28, 0xb9, 0x8e,
-	0x50, 0xca, 0x15, 0x4f, 0xd0, 0x70, 0x51, 0x46, 0xd5, 0x05, 0xae, 0x64, 0x8e, 0x17, 0x51, 0xc6,
-	0x4a, 0xa6, 0xd0, 0xb0, 0xb4, 0x65, 0x0b, 0xbf, 0xb9, 0xe0, 0x2f, 0x2c, 0xfd, 0x55, 0x9d, 0x5a,
-	0xe0, 0x1a, 0x4b, 0xfe, 0x89, 0x69, 0xa3, 0x63, 0xf6, 0x65, 0xcd, 0xb4, 0x21, 0xb7, 0x30, 0x50,
-	0x4c, 0x0a, 0xcf, 0x09, 0x9c, 0xe9, 0x78, 0x76, 0x45, 0x1f, 0xf4, 0x69, 0xaf, 0x6f, 0x0f, 0x1f,
-	0x93, 0x94, 0x56, 0x73, 0x2a, 0xef, 0x33, 0xda, 0xe8, 0xd3, 0x2d, 0x7d, 0xda, 0xeb, 0xd3, 0x98,
-	0x49, 0xa1, 0xb9, 0x11, 0xaa, 0x8e, 0x2d, 0x2b, 0xf1, 0x01, 0x74, 0x5d, 0x26, 0x97, 0x0a, 0xcb,
-	0x24, 0xf7, 0x8e, 0x02, 0x67, 0x3a, 0x8a, 0xb7, 0x32, 0x24, 0x84, 0x13, 0x83, 0x2a, 0x63, 0xa6,
-	0xab, 0x70, 0x6d, 0xc5, 0x4e, 0x8e, 0x3c, 0x85, 0x61, 0xaa, 0xea, 0x65, 0x8e, 0xde, 0xc0, 0xa2,
-	0x5d, 0x44, 0x9e, 0xc1, 0x69, 0x3b, 0xba, 0x6b, 0xa6, 0x35, 0x66, 0xcc, 0x3b, 0xb6, 0xf0, 0x6e,
-	0x92, 0x84, 0x70, 0x2c, 0xd1, 0xe4, 0xda, 0x1b, 0x06, 0xee, 0x74, 0x3c, 0x3b, 0xa1, 0xef, 0xd1,
-	0xe4, 0xaf, 0x99, 0x41, 0xbe, 0xd2, 0x71, 0x0b, 0x91, 0xaf, 0xf0, 0x24, 0x55, 0xf5, 0xa2, 0xfb,
-	0xcf, 0x60, 0x8a, 0x06, 0xbd, 0x47, 0x76, 0x20, 0x37, 0xff, 0x3b, 0x90, 0x8a, 0x6b, 0x2e, 0xca,
-	0x9e, 0x35, 0xfe, 0x5b, 0x28, 0x5c, 0xc3, 0x78, 0xab, 0x27, 0x42, 0x60, 0xd0, 0x74, 0x65, 0x0d,
-	0x19, 0xc5, 0xf6, 0x4c, 0x5e, 0xc2, 0xa8, 0xe8, 0x8d, 0xf3, 0x8e, 0xec, 0x45, 0x3c, 0xfa, 0xa7,
-	0xa5, 0xfd, 0xa5, 0x1e, 0x4a, 0xc9, 0x04, 0x1e, 0x37, 0xd3, 0xc0, 0x32, 0xd5, 0x9e, 0x1b, 0xb8,
-	0xd3, 0x51, 0xfc, 0x3b, 0x0e, 0x5f, 0xc1, 0xd9, 0x1e, 0x86, 0xc6, 0x95, 0x9e, 0xe3, 0xcd, 0xf2,
-	0xdd, 0x4d, 0xd7, 0xca, 0x4e, 0x2e, 0x5c, 0xc0, 0xf9, 0xde, 0x97, 0xa5, 0xa5, 0x28, 0x35, 0x23,
-	0x01, 0x8c, 0xf3, 0x0e, 0x6c, 0xdc, 0x6b, 0x59, 0xb6, 0x53, 0xb3, 0x02, 0x4e, 0x5b, 0x92, 0x25,
-	0x53, 0x15, 0x4f, 0x18, 0xb9, 0x85, 0xb3, 0x3d, 0xac, 0xe4, 0x9c, 0xfe, 0xfb, 0x25, 0x4f, 0x02,
-	0x7a, 0xa0, 0xa1, 0xcb, 0xc5, 0xf7, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0xcf, 0x8d, 0xef, 0x7c,
-	0x78, 0x71, 0x60, 0xd5, 0x76, 0x76, 0x15, 0x25, 0x4f, 0x56, 0x9c, 0x95, 0xe6, 0x6e, 0x68, 0x57,
-	0x6b, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21, 0x3b, 0xa8, 0x3c, 0xcc, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x96, 0x9b, 0x34, 0x34, 0x2f, 0xed, 0xc0, 0x0d, 0xd4, 0xca, 0x90, 0x5a, 0x16, 0x43, 0x16,
+	0xce, 0x6a, 0x22, 0xd8, 0x58, 0x1a, 0x86, 0x0a, 0xd2, 0x82, 0x1c, 0xb1, 0xa0, 0x4a, 0xe8, 0xd5,
+	0x3e, 0xec, 0xa3, 0xb1, 0xef, 0xb8, 0xbb, 0x58, 0xb2, 0xc4, 0xaf, 0x63, 0x62, 0x64, 0x66, 0x42,
+	0xf9, 0x25, 0xc8, 0x67, 0x9b, 0x24, 0xa0, 0xd0, 0xa1, 0x53, 0xee, 0xbe, 0x77, 0xf9, 0xbe, 0xef,
+	0xbe, 0x7b, 0xcf, 0xe0, 0x45, 0x22, 0xcb, 0xb8, 0xd1, 0x4c, 0x15, 0x4c, 0x05, 0xf5, 0xa6, 0xf9,
+	0xa1, 0x52, 0x09, 0x23, 0x86, 0xf3, 0x84, 0x9b, 0x74, 0x75, 0x4b, 0x23, 0x91, 0x05, 0xa8, 0x12,
+	0x21, 0x95, 0xf8, 0x6c, 0x17, 0xcf, 0xa2, 0x38, 0x28, 0xa6, 0x81, 0xbc, 0x4b, 0x02, 0x94, 0x5c,
+	0x07, 0x28, 0xe5, 0x92, 0x47, 0x68, 0xb8, 0xc8, 0x83, 0xe2, 0x1c, 0x97, 0x32, 0xc5, 0xf3, 0x20,
+	0x61, 0x39, 0x53, 0x68, 0x58, 0x5c, 0xb3, 0xf9, 0xdf, 0x3a, 0x30, 0x9a, 0x59, 0xfa, 0xcb, 0x32,
+	0xb6, 0x85, 0x2b, 0xcc, 0xf9, 0x27, 0xa6, 0x8d, 0x0e, 0xd9, 0x97, 0x15, 0xd3, 0x86, 0xdc, 0x40,
+	0x57, 0x31, 0x29, 0x5c, 0xc7, 0x73, 0xc6, 0x83, 0xc9, 0x25, 0xdd, 0xe8, 0xd3, 0x56, 0xdf, 0x2e,
+	0x3e, 0x46, 0x31, 0x2d, 0xa6, 0x54, 0xde, 0x25, 0xb4, 0xd2, 0xa7, 0x5b, 0xfa, 0xb4, 0xd5, 0xa7,
+	0x21, 0x93, 0x42, 0x73, 0x23, 0x54, 0x19, 0x5a, 0x56, 0x32, 0x02, 0xd0, 0x65, 0x1e, 0x5d, 0x28,
+	0xcc, 0xa3, 0xd4, 0x3d, 0xf0, 0x9c, 0x71, 0x3f, 0xdc, 0x42, 0x88, 0x0f, 0xc7, 0x06, 0x55, 0xc2,
+	0x4c, 0x73, 0xa2, 0x63, 0x4f, 0xec, 0x60, 0xe4, 0x09, 0xf4, 0x62, 0x55, 0x2e, 0x52, 0x74, 0xbb,
+	0xb6, 0xda, 0xec, 0xc8, 0x53, 0x38, 0xa9, 0xa3, 0xbb, 0x62, 0x5a, 0x63, 0xc2, 0xdc, 0x43, 0x5b,
+	0xde, 0x05, 0x89, 0x0f, 0x87, 0x12, 0x4d, 0xaa, 0xdd, 0x9e, 0xd7, 0x19, 0x0f, 0x26, 0xc7, 0xf4,
+	0x1d, 0x9a, 0xf4, 0x15, 0x33, 0xc8, 0x97, 0x3a, 0xac, 0x4b, 0xe4, 0x2b, 0x3c, 0x8e, 0x55, 0x39,
+	0x6b, 0xfe, 0x67, 0x30, 0x46, 0x83, 0xee, 0x23, 0x1b, 0xc8, 0xf5, 0x43, 0x03, 0x29, 0xb8, 0xe6,
+	0x22, 0x6f, 0x59, 0xc3, 0x7f, 0x85, 0xaa, 0x8c, 0x62, 0x55, 0x56, 0xd1, 0xbd, 0x0f, 0xe7, 0xee,
+	0x51, 0x9d, 0xd1, 0x06, 0xf1, 0x7f, 0x3a, 0x30, 0xd8, 0x32, 0x4d, 0x08, 0x74, 0x2b, 0xdb, 0xf6,
+	0xc5, 0xfa, 0xa1, 0x5d, 0x93, 0x17, 0xd0, 0xcf, 0xda, 0x97, 0x75, 0x0f, 0xec, 0x4d, 0x5d, 0xfa,
+	0xf7, 0x9b, 0xb7, 0xb7, 0xde, 0x1c, 0x25, 0x43, 0x38, 0xaa, 0xe2, 0xc2, 0x3c, 0xd6, 0x6e, 0xc7,
+	0xeb, 0x8c, 0xfb, 0xe1, 0x9f, 0x3d, 0x89, 0xa0, 0xb7, 0xc4, 0x52, 0xac, 0x8c, 0xcd, 0x7d, 0x30,
+	0x79, 0xf3, 0xb0, 0x28, 0x1a, 0x37, 0x73, 0x4b, 0x19, 0x36, 0xd4, 0xfe, 0x4b, 0x38, 0xdd, 0x63,
+	0xb3, 0xea, 0x8d, 0xd6, 0xe8, 0xeb, 0xc5, 0xdb, 0xeb, 0xe6, 0xbe, 0x3b, 0x98, 0x3f, 0x83, 0xb3,
+	0xbd, 0xfd, 0xad, 0xa5, 0xc8, 0x35, 0x23, 0x1e, 0x0c, 0xd2, 0xa6, 0x58, 0xf5, 0x50, 0xcd, 0xb2,
+	0x0d, 0x4d, 0x32, 0x38, 0xa9, 0x49, 0x16, 0x4c, 0x15, 0x3c, 0x62, 0xe4, 0x06, 0x4e, 0xf7, 0xb0,
+	0x92, 0x33, 0xfa, 0xff, 0x79, 0x1a, 0x7a, 0xf4, 0x1e, 0x43, 0x17, 0xb3, 0xef, 0xeb, 0x91, 0xf3,
+	0x63, 0x3d, 0x72, 0x7e, 0xad, 0x47, 0xce, 0x87, 0xe7, 0xf7, 0x0c, 0xfc, 0xce, 0x17, 0x03, 0x25,
+	0x8f, 0x96, 0x9c, 0xe5, 0xe6, 0xb6, 0x67, 0x07, 0x7c, 0xfa, 0x7b, 0x00, 0x2d, 0x29, 0x86, 0xa6,
+	0x52, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +468,13 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DryRepoURL) > 0 {
+		i -= len(m.DryRepoURL)
+		copy(dAtA[i:], m.DryRepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRepoURL)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.DryCommitMetadata != nil {
 		{
 			size, err := m.DryCommitMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -539,6 +568,18 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -687,6 +728,10 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 		l = m.DryCommitMetadata.Size()
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	l = len(m.DryRepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -715,6 +760,10 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1022,6 +1071,38 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1171,6 +1252,42 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Layout == nil {
+				m.Layout = &v1alpha1.HydrateLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
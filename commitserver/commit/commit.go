@@ -23,13 +23,16 @@ import (
 type Service struct {
 	metricsServer     *metrics.Server
 	repoClientFactory RepoClientFactory
+	// signingKey is the key hydrated commits are signed with. If nil, commits are not signed.
+	signingKey *SigningKey
 }
 
 // NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingKey *SigningKey) *Service {
 	return &Service{
 		metricsServer:     metricsServer,
 		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		signingKey:        signingKey,
 	}
 }
 
@@ -43,8 +46,9 @@ type hydratorMetadataFile struct {
 	Subject string `json:"subject,omitempty"`
 	// Body is the body of the DRY commit message, excluding the subject line, i.e. `git show --format=%b`.
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
-	Body       string                       `json:"body,omitempty"`
-	References []v1alpha1.RevisionReference `json:"references,omitempty"`
+	Body         string                       `json:"body,omitempty"`
+	References   []v1alpha1.RevisionReference `json:"references,omitempty"`
+	SigningKeyID string                       `json:"signingKeyID,omitempty"`
 }
 
 // TODO: make this configurable via ConfigMap.
@@ -73,7 +77,7 @@ git checkout {{ .DrySHA }}
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
 // pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -99,7 +103,7 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	out, sha, err := s.handleCommitRequest(ctx, logCtx, r)
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -115,8 +119,9 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
 // target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// the changes. It returns the output of the git commands and an error if one occurred. If the repository is an OCI
+// repository, the manifests are pushed as an OCI artifact instead.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
 	if r.Repo == nil {
 		return "", "", errors.New("repo is required")
 	}
@@ -131,6 +136,11 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	if isOCIRepo(r.Repo) {
+		digest, err := s.handleOCIPushRequest(ctx, logCtx, r)
+		return "", digest, err
+	}
+
 	logCtx.Debug("Initiating git client")
 	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
 	if err != nil {
@@ -179,7 +189,11 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	}
 
 	logCtx.Debug("Writing manifests")
-	err = WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths)
+	var signingKeyID string
+	if s.signingKey != nil {
+		signingKeyID = s.signingKey.ID
+	}
+	err = WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, signingKeyID, r.Paths)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to write manifests: %w", err)
 	}
@@ -262,5 +276,14 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		return nil, "", nil, fmt.Errorf("failed to set author: %w", err)
 	}
 
+	if s.signingKey != nil {
+		logCtx.Debugf("Setting %s signing key %s", s.signingKey.Format, s.signingKey.ID)
+		_, err = gitClient.SetSigningKey(s.signingKey.gitSigningConfig())
+		if err != nil {
+			cleanupOrLog()
+			return nil, "", nil, fmt.Errorf("failed to set signing key: %w", err)
+		}
+	}
+
 	return gitClient, dirPath, cleanupOrLog, nil
 }
@@ -109,6 +109,45 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		assert.Equal(t, "it-worked!", resp.HydratedSha)
 	})
 
+	t.Run("signing key", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.signingKey = &SigningKey{Format: SigningKeyFormatSSH, Path: "/app/config/signing-key/signing.key", ID: "SHA256:abc"}
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().SetSigningKey("ssh", "/app/config/signing-key/signing.key", "").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "test commit message").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("it-worked!", nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+	})
+
+	t.Run("failed to set signing key", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.signingKey = &SigningKey{Format: SigningKeyFormatGPG, ID: "4AEE18F83AFDEB23", GnuPGHome: "/tmp/gnupg"}
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().SetSigningKey("openpgp", "4AEE18F83AFDEB23", "/tmp/gnupg").Return("", assert.AnError).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), validRequest)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to set signing key")
+	})
+
 	t.Run("root path with dot and blank - no directory removal", func(t *testing.T) {
 		t.Parallel()
 
@@ -287,7 +326,7 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, nil)
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
 
@@ -9,7 +9,6 @@ import (
 
 	"github.com/Masterminds/sprig/v3"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
@@ -32,12 +31,14 @@ func init() {
 }
 
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
-func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. If the hydrated commit is signed,
+// signingKeyID identifies the signing key and is recorded in each hydrator.metadata file.
+func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, signingKeyID string, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
 	hydratorMetadata, err := hydrator.GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
 	}
+	hydratorMetadata.SigningKeyID = signingKeyID
 
 	// Write the top-level readme.
 	err = writeMetadata(root, "", hydratorMetadata)
@@ -66,16 +67,17 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 		}
 
 		// Write the manifests
-		err = writeManifests(root, hydratePath, p.Manifests)
+		err = writeManifests(root, hydratePath, p.Manifests, p.Layout)
 		if err != nil {
 			return fmt.Errorf("failed to write manifests: %w", err)
 		}
 
 		// Write hydrator.metadata containing information about the hydration process.
 		hydratorMetadata := hydrator.HydratorCommitMetadata{
-			Commands: p.Commands,
-			DrySHA:   drySha,
-			RepoURL:  repoUrl,
+			Commands:     p.Commands,
+			DrySHA:       drySha,
+			RepoURL:      repoUrl,
+			SigningKeyID: signingKeyID,
 		}
 		err = writeMetadata(root, hydratePath, hydratorMetadata)
 		if err != nil {
@@ -158,13 +160,37 @@ func writeGitAttributes(root *os.Root) error {
 	return nil
 }
 
-// writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
-// manifests in the order they are provided.
-func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
-	// If the file exists, truncate it.
-	// No need to use SecureJoin here, as the path is already sanitized.
-	manifestPath := filepath.Join(dirPath, "manifest.yaml")
+// writeManifests writes the manifests to the files of the given layout, truncating the files if they exist and appending
+// the manifests in the order they are provided. If requested by the layout, a kustomization.yaml file listing the
+// manifest files is written as well.
+func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails, layout *appv1.HydrateLayout) error {
+	objs := make([]*unstructured.Unstructured, len(manifests))
+	for i, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		objs[i] = obj
+	}
+
+	files, err := hydrator.RenderManifests(objs, layout)
+	if err != nil {
+		return err
+	}
+	for name, data := range files {
+		// No need to use SecureJoin here, as the path is already sanitized and file names are sanitized by
+		// RenderManifests.
+		err = writeManifestFile(root, filepath.Join(dirPath, name), data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// writeManifestFile writes the given data to the file at manifestPath, truncating the file if it exists.
+func writeManifestFile(root *os.Root, manifestPath string, data []byte) error {
 	file, err := root.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to open manifest file: %w", err)
@@ -176,26 +202,9 @@ func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.Hydrat
 		}
 	}()
 
-	enc := yaml.NewEncoder(file)
-	defer func() {
-		err := enc.Close()
-		if err != nil {
-			log.WithError(err).Error("failed to close yaml encoder")
-		}
-	}()
-	enc.SetIndent(2)
-
-	for _, m := range manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(m.ManifestJSON), obj)
-		if err != nil {
-			return fmt.Errorf("failed to unmarshal manifest: %w", err)
-		}
-		err = enc.Encode(&obj.Object)
-		if err != nil {
-			return fmt.Errorf("failed to encode manifest: %w", err)
-		}
+	_, err = file.Write(data)
+	if err != nil {
+		return fmt.Errorf("failed to write manifest file: %w", err)
 	}
-
 	return nil
 }
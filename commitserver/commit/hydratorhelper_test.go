@@ -93,7 +93,7 @@ Argocd-reference-commit-sha: abc123
 		},
 	}
 
-	err := WriteForPaths(root, repoURL, drySha, metadata, paths)
+	err := WriteForPaths(root, repoURL, drySha, metadata, "", paths)
 	require.NoError(t, err)
 
 	// Check if the top-level hydrator.metadata exists and contains the repo URL and dry SHA
@@ -142,6 +142,32 @@ Argocd-reference-commit-sha: abc123
 	}
 }
 
+func TestWriteForPaths_SigningKeyID(t *testing.T) {
+	root := tempRoot(t)
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+			},
+		},
+	}
+
+	err := WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, "SHA256:abc", paths)
+	require.NoError(t, err)
+
+	for _, dir := range []string{"", "path1"} {
+		metadataBytes, err := os.ReadFile(filepath.Join(root.Name(), dir, "hydrator.metadata"))
+		require.NoError(t, err)
+
+		var readMetadata hydratorMetadataFile
+		err = json.Unmarshal(metadataBytes, &readMetadata)
+		require.NoError(t, err)
+		assert.Equal(t, "SHA256:abc", readMetadata.SigningKeyID)
+	}
+}
+
 func TestWriteMetadata(t *testing.T) {
 	root := tempRoot(t)
 
@@ -216,7 +242,7 @@ func TestWriteManifests(t *testing.T) {
 		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
 	}
 
-	err := writeManifests(root, "", manifests)
+	err := writeManifests(root, "", manifests, nil)
 	require.NoError(t, err)
 
 	manifestPath := path.Join(root.Name(), "manifest.yaml")
@@ -225,6 +251,58 @@ func TestWriteManifests(t *testing.T) {
 	assert.Contains(t, string(manifestBytes), "kind")
 }
 
+func TestWriteManifests_FilePerResourceWithKustomization(t *testing.T) {
+	root := tempRoot(t)
+
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"guestbook"}}`},
+		{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"guestbook"}}`},
+	}
+	layout := &appsv1.HydrateLayout{Type: appsv1.HydrateLayoutTypeFilePerResource, Kustomization: true}
+
+	err := writeManifests(root, "", manifests, layout)
+	require.NoError(t, err)
+
+	serviceBytes, err := os.ReadFile(filepath.Join(root.Name(), "service-guestbook.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1\nkind: Service\nmetadata:\n  name: guestbook\n", string(serviceBytes))
+	assert.FileExists(t, filepath.Join(root.Name(), "deployment-guestbook.yaml"))
+	assert.NoFileExists(t, filepath.Join(root.Name(), "manifest.yaml"))
+
+	kustomizationBytes, err := os.ReadFile(filepath.Join(root.Name(), "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment-guestbook.yaml
+  - service-guestbook.yaml
+`, string(kustomizationBytes))
+}
+
+func TestWriteManifests_Namespace(t *testing.T) {
+	root := tempRoot(t)
+
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"guestbook"}}`},
+		{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"guestbook","namespace":"guestbook"}}`},
+		{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"guestbook","namespace":"guestbook"}}`},
+	}
+
+	err := writeManifests(root, "", manifests, &appsv1.HydrateLayout{Type: appsv1.HydrateLayoutTypeNamespace})
+	require.NoError(t, err)
+
+	clusterBytes, err := os.ReadFile(filepath.Join(root.Name(), "_cluster.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(clusterBytes), "kind: Namespace")
+
+	namespaceBytes, err := os.ReadFile(filepath.Join(root.Name(), "guestbook.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(namespaceBytes), "kind: Service\n")
+	assert.Contains(t, string(namespaceBytes), "---\n")
+	assert.Contains(t, string(namespaceBytes), "kind: Deployment\n")
+	assert.NoFileExists(t, filepath.Join(root.Name(), "kustomization.yaml"))
+}
+
 func TestWriteGitAttributes(t *testing.T) {
 	root := tempRoot(t)
 
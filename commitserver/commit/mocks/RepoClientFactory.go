@@ -7,6 +7,7 @@ package mocks
 import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -104,3 +105,65 @@ func (_c *RepoClientFactory_NewClient_Call) RunAndReturn(run func(repo *v1alpha1
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewOCIPusher provides a mock function for the type RepoClientFactory
+func (_mock *RepoClientFactory) NewOCIPusher(repo *v1alpha1.Repository) (oci.Pusher, error) {
+	ret := _mock.Called(repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewOCIPusher")
+	}
+
+	var r0 oci.Pusher
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository) (oci.Pusher, error)); ok {
+		return returnFunc(repo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository) oci.Pusher); ok {
+		r0 = returnFunc(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(oci.Pusher)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*v1alpha1.Repository) error); ok {
+		r1 = returnFunc(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoClientFactory_NewOCIPusher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewOCIPusher'
+type RepoClientFactory_NewOCIPusher_Call struct {
+	*mock.Call
+}
+
+// NewOCIPusher is a helper method to define mock.On call
+//   - repo *v1alpha1.Repository
+func (_e *RepoClientFactory_Expecter) NewOCIPusher(repo interface{}) *RepoClientFactory_NewOCIPusher_Call {
+	return &RepoClientFactory_NewOCIPusher_Call{Call: _e.mock.On("NewOCIPusher", repo)}
+}
+
+func (_c *RepoClientFactory_NewOCIPusher_Call) Run(run func(repo *v1alpha1.Repository)) *RepoClientFactory_NewOCIPusher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Repository
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Repository)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoClientFactory_NewOCIPusher_Call) Return(pusher oci.Pusher, err error) *RepoClientFactory_NewOCIPusher_Call {
+	_c.Call.Return(pusher, err)
+	return _c
+}
+
+func (_c *RepoClientFactory_NewOCIPusher_Call) RunAndReturn(run func(repo *v1alpha1.Repository) (oci.Pusher, error)) *RepoClientFactory_NewOCIPusher_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,97 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// isOCIRepo returns true if the hydrated manifests are pushed to the repository as an OCI artifact.
+func isOCIRepo(repo *v1alpha1.Repository) bool {
+	return repo.Type == "oci" || strings.HasPrefix(repo.Repo, "oci://")
+}
+
+// handleOCIPushRequest handles a commit request for an OCI repository. It extracts the artifact tagged with the target
+// branch, clears the hydrated paths, writes the manifests, and pushes the result as a new artifact tagged with the
+// target branch and the dry SHA. Other paths in the artifact are kept, just like other paths of a hydrated branch. It
+// returns the digest of the pushed artifact.
+func (s *Service) handleOCIPushRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	if r.TargetBranch != r.SyncBranch {
+		return "", fmt.Errorf("target tag %q must be the same as the sync tag %q when pushing to an OCI repository", r.TargetBranch, r.SyncBranch)
+	}
+
+	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(dirPath)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	pusher, err := s.repoClientFactory.NewOCIPusher(r.Repo)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI client: %w", err)
+	}
+
+	logCtx.Debugf("Pulling tag %s", r.TargetBranch)
+	found, err := pusher.Pull(ctx, r.TargetBranch, dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull tag %s: %w", r.TargetBranch, err)
+	}
+	if !found {
+		logCtx.Debugf("Tag %s does not exist, pushing a new artifact", r.TargetBranch)
+	}
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	logCtx.Debug("Clearing paths")
+	for _, p := range r.Paths {
+		if hydrator.IsRootPath(p.Path) {
+			logCtx.Debugf("Path %s is referencing root directory, ignoring the path", p.Path)
+			continue
+		}
+		err = root.RemoveAll(p.Path)
+		if err != nil {
+			return "", fmt.Errorf("failed to clear path %s: %w", p.Path, err)
+		}
+	}
+
+	dryRepoURL := r.DryRepoURL
+	if dryRepoURL == "" {
+		dryRepoURL = r.Repo.Repo
+	}
+
+	logCtx.Debug("Writing manifests")
+	err = WriteForPaths(root, dryRepoURL, r.DrySha, r.DryCommitMetadata, "", r.Paths)
+	if err != nil {
+		return "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	logCtx.Debug("Pushing artifact")
+	annotations := map[string]string{
+		imagev1.AnnotationSource:   dryRepoURL,
+		imagev1.AnnotationRevision: r.DrySha,
+	}
+	digest, err := pusher.Push(ctx, dirPath, []string{r.TargetBranch, r.DrySha}, annotations)
+	if err != nil {
+		return "", fmt.Errorf("failed to push artifact: %w", err)
+	}
+	return digest, nil
+}
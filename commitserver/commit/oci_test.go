@@ -0,0 +1,116 @@
+package commit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+func Test_CommitHydratedManifests_OCI(t *testing.T) {
+	t.Parallel()
+
+	request := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "oci://registry.example.com/hydrated",
+			Type: "oci",
+		},
+		TargetBranch: "env-dev",
+		SyncBranch:   "env-dev",
+		DrySha:       "abc123",
+		DryRepoURL:   "https://github.com/argoproj/argocd-example-apps.git",
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"}}`},
+				},
+			},
+		},
+	}
+
+	t.Run("keeps other paths of the artifact", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockPusher := ocimocks.NewPusher(t)
+		mockRepoClientFactory.EXPECT().NewOCIPusher(request.Repo).Return(mockPusher, nil).Once()
+		mockPusher.EXPECT().Pull(mock.Anything, "env-dev", mock.Anything).RunAndReturn(func(_ context.Context, _ string, dir string) (bool, error) {
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "guestbook"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "guestbook", "stale.yaml"), []byte("kind: Stale\n"), 0o644))
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "other"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "other", "manifest.yaml"), []byte("kind: Other\n"), 0o644))
+			return true, nil
+		}).Once()
+		expectedAnnotations := map[string]string{
+			imagev1.AnnotationSource:   "https://github.com/argoproj/argocd-example-apps.git",
+			imagev1.AnnotationRevision: "abc123",
+		}
+		mockPusher.EXPECT().Push(mock.Anything, mock.Anything, []string{"env-dev", "abc123"}, expectedAnnotations).RunAndReturn(func(_ context.Context, dir string, _ []string, _ map[string]string) (string, error) {
+			assert.FileExists(t, filepath.Join(dir, "other", "manifest.yaml"))
+			assert.NoFileExists(t, filepath.Join(dir, "guestbook", "stale.yaml"))
+			data, err := os.ReadFile(filepath.Join(dir, "guestbook", "manifest.yaml"))
+			require.NoError(t, err)
+			assert.Contains(t, string(data), "name: guestbook")
+			metadata, err := os.ReadFile(filepath.Join(dir, "guestbook", "hydrator.metadata"))
+			require.NoError(t, err)
+			assert.Contains(t, string(metadata), `"repoURL": "https://github.com/argoproj/argocd-example-apps.git"`)
+			return "sha256:0123", nil
+		}).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:0123", resp.HydratedSha)
+	})
+
+	t.Run("new tag", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockPusher := ocimocks.NewPusher(t)
+		mockRepoClientFactory.EXPECT().NewOCIPusher(request.Repo).Return(mockPusher, nil).Once()
+		mockPusher.EXPECT().Pull(mock.Anything, "env-dev", mock.Anything).Return(false, nil).Once()
+		mockPusher.EXPECT().Push(mock.Anything, mock.Anything, []string{"env-dev", "abc123"}, mock.Anything).Return("sha256:0123", nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:0123", resp.HydratedSha)
+	})
+
+	t.Run("push fails", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockPusher := ocimocks.NewPusher(t)
+		mockRepoClientFactory.EXPECT().NewOCIPusher(request.Repo).Return(mockPusher, nil).Once()
+		mockPusher.EXPECT().Pull(mock.Anything, "env-dev", mock.Anything).Return(false, nil).Once()
+		mockPusher.EXPECT().Push(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", assert.AnError).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorContains(t, err, "failed to push artifact")
+	})
+
+	t.Run("different target tag", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		requestWithTargetTag := &apiclient.CommitHydratedManifestsRequest{
+			Repo:         request.Repo,
+			TargetBranch: "env-dev-next",
+			SyncBranch:   "env-dev",
+			DrySha:       "abc123",
+		}
+
+		_, err := service.CommitHydratedManifests(t.Context(), requestWithTargetTag)
+		require.ErrorContains(t, err, "must be the same as the sync tag")
+	})
+}
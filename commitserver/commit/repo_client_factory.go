@@ -4,11 +4,14 @@ import (
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 )
 
-// RepoClientFactory is a factory for creating git clients for a repository.
+// RepoClientFactory is a factory for creating git clients for a repository, and OCI clients for pushing hydrated
+// manifests to an OCI repository.
 type RepoClientFactory interface {
 	NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error)
+	NewOCIPusher(repo *v1alpha1.Repository) (oci.Pusher, error)
 }
 
 type repoClientFactory struct {
@@ -30,3 +33,8 @@ func (r *repoClientFactory) NewClient(repo *v1alpha1.Repository, rootPath string
 	opts := git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))
 	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts)
 }
+
+// NewOCIPusher creates a new OCI client for pushing to the repository.
+func (r *repoClientFactory) NewOCIPusher(repo *v1alpha1.Repository) (oci.Pusher, error) {
+	return oci.NewPusher(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy)
+}
@@ -0,0 +1,130 @@
+package commit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+)
+
+const (
+	// SigningKeyFormatGPG is the format of ASCII-armored GPG private keys.
+	SigningKeyFormatGPG = "gpg"
+	// SigningKeyFormatSSH is the format of SSH private keys.
+	SigningKeyFormatSSH = "ssh"
+)
+
+// SigningKey is the key the commit server signs hydrated commits with.
+type SigningKey struct {
+	// Format is the format of the key, either gpg or ssh.
+	Format string
+	// Path is the path to the private key file.
+	Path string
+	// ID identifies the key. For GPG keys it is the long key ID, for SSH keys the SHA256 fingerprint of the public key.
+	ID string
+	// GnuPGHome is the dedicated GnuPG home directory GPG keys are imported into, so that they are not mixed with the
+	// keys of the GnuPG keyring used to verify commits.
+	GnuPGHome string
+}
+
+// LoadSigningKey loads the private key at the given path and prepares it for signing commits. GPG keys are imported
+// into a dedicated GnuPG home directory. Keys protected by a passphrase are not supported. If there is no file at the
+// given path, nil is returned and commits are not signed.
+func LoadSigningKey(format, path string) (*SigningKey, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	id, err := getSigningKeyID(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{Format: format, Path: path, ID: id}
+	if format == SigningKeyFormatGPG {
+		key.GnuPGHome, err = importGPGSigningKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import GPG signing key: %w", err)
+		}
+	}
+	return key, nil
+}
+
+// importGPGSigningKey imports the GPG key at the given path into a new GnuPG home directory and returns its path.
+func importGPGSigningKey(path string) (string, error) {
+	// MkdirTemp creates the directory with mode 0700, as required by GnuPG
+	home, err := os.MkdirTemp("", "argocd-commit-signing-gnupg")
+	if err != nil {
+		return "", fmt.Errorf("failed to create GnuPG home directory: %w", err)
+	}
+	cmd := exec.CommandContext(context.Background(), "gpg", "--batch", "--no-permission-warning", "--import", path)
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+home, "LANG=C")
+	_, err = executil.Run(cmd)
+	if err != nil {
+		_ = os.RemoveAll(home)
+		return "", err
+	}
+	return home, nil
+}
+
+// getSigningKeyID parses the given private key and returns its ID.
+func getSigningKeyID(format string, data []byte) (string, error) {
+	switch format {
+	case SigningKeyFormatGPG:
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+		if err != nil {
+			return "", fmt.Errorf("failed to parse GPG signing key: %w", err)
+		}
+		if len(entities) != 1 {
+			return "", fmt.Errorf("expected exactly one GPG signing key, found %d", len(entities))
+		}
+		if entities[0].PrivateKey == nil {
+			return "", errors.New("GPG signing key does not contain a private key")
+		}
+		if entities[0].PrivateKey.Encrypted {
+			return "", errors.New("GPG signing key is protected by a passphrase, which is not supported")
+		}
+		for _, subkey := range entities[0].Subkeys {
+			if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+				return "", errors.New("GPG signing key is protected by a passphrase, which is not supported")
+			}
+		}
+		return entities[0].PrimaryKey.KeyIdString(), nil
+	case SigningKeyFormatSSH:
+		signer, err := ssh.ParsePrivateKey(data)
+		var passphraseErr *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseErr) {
+			return "", errors.New("SSH signing key is protected by a passphrase, which is not supported")
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse SSH signing key: %w", err)
+		}
+		return ssh.FingerprintSHA256(signer.PublicKey()), nil
+	default:
+		return "", fmt.Errorf("unsupported signing key format %q, must be one of: %s|%s", format, SigningKeyFormatGPG, SigningKeyFormatSSH)
+	}
+}
+
+// gitSigningConfig returns the git gpg.format and user.signingkey configuration values for the key, and the GnuPG home
+// directory to sign commits with.
+func (k *SigningKey) gitSigningConfig() (string, string, string) {
+	if k.Format == SigningKeyFormatSSH {
+		// For SSH keys, git expects the path to the key file.
+		return "ssh", k.Path, ""
+	}
+	return "openpgp", k.ID, k.GnuPGHome
+}
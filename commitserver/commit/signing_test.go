@@ -0,0 +1,166 @@
+package commit
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func generateSSHKey(t *testing.T) ([]byte, ssh.PublicKey) {
+	t.Helper()
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(block), signer.PublicKey()
+}
+
+func generateGPGKey(t *testing.T, private bool) ([]byte, *openpgp.Entity) {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Argo CD", "", "argo-cd@example.com", nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	blockType := openpgp.PublicKeyType
+	if private {
+		blockType = openpgp.PrivateKeyType
+	}
+	w, err := armor.Encode(&buf, blockType, nil)
+	require.NoError(t, err)
+	if private {
+		require.NoError(t, entity.SerializePrivate(w, nil))
+	} else {
+		require.NoError(t, entity.Serialize(w))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes(), entity
+}
+
+func TestGetSigningKeyID(t *testing.T) {
+	t.Run("SSH key", func(t *testing.T) {
+		key, publicKey := generateSSHKey(t)
+		id, err := getSigningKeyID(SigningKeyFormatSSH, key)
+		require.NoError(t, err)
+		assert.Equal(t, ssh.FingerprintSHA256(publicKey), id)
+	})
+
+	t.Run("GPG key", func(t *testing.T) {
+		key, entity := generateGPGKey(t, true)
+		id, err := getSigningKeyID(SigningKeyFormatGPG, key)
+		require.NoError(t, err)
+		assert.Equal(t, entity.PrimaryKey.KeyIdString(), id)
+	})
+
+	t.Run("GPG public key", func(t *testing.T) {
+		key, _ := generateGPGKey(t, false)
+		_, err := getSigningKeyID(SigningKeyFormatGPG, key)
+		require.ErrorContains(t, err, "does not contain a private key")
+	})
+
+	t.Run("passphrase protected SSH key", func(t *testing.T) {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		block, err := ssh.MarshalPrivateKeyWithPassphrase(privateKey, "", []byte("secret"))
+		require.NoError(t, err)
+		_, err = getSigningKeyID(SigningKeyFormatSSH, pem.EncodeToMemory(block))
+		require.ErrorContains(t, err, "protected by a passphrase")
+	})
+
+	t.Run("passphrase protected GPG key", func(t *testing.T) {
+		entity, err := openpgp.NewEntity("Argo CD", "", "argo-cd@example.com", nil)
+		require.NoError(t, err)
+		require.NoError(t, entity.EncryptPrivateKeys([]byte("secret"), nil))
+		var buf bytes.Buffer
+		w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+		require.NoError(t, err)
+		require.NoError(t, entity.SerializePrivateWithoutSigning(w, nil))
+		require.NoError(t, w.Close())
+		_, err = getSigningKeyID(SigningKeyFormatGPG, buf.Bytes())
+		require.ErrorContains(t, err, "protected by a passphrase")
+	})
+
+	t.Run("format mismatch", func(t *testing.T) {
+		key, _ := generateSSHKey(t)
+		_, err := getSigningKeyID(SigningKeyFormatGPG, key)
+		require.ErrorContains(t, err, "failed to parse GPG signing key")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		key, _ := generateSSHKey(t)
+		_, err := getSigningKeyID("x509", key)
+		require.ErrorContains(t, err, `unsupported signing key format "x509"`)
+	})
+}
+
+func TestLoadSigningKey(t *testing.T) {
+	t.Run("no path", func(t *testing.T) {
+		key, err := LoadSigningKey(SigningKeyFormatSSH, "")
+		require.NoError(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		key, err := LoadSigningKey(SigningKeyFormatSSH, filepath.Join(t.TempDir(), "signing.key"))
+		require.NoError(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("SSH key", func(t *testing.T) {
+		data, publicKey := generateSSHKey(t)
+		path := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		key, err := LoadSigningKey(SigningKeyFormatSSH, path)
+		require.NoError(t, err)
+		require.NotNil(t, key)
+		assert.Equal(t, &SigningKey{Format: SigningKeyFormatSSH, Path: path, ID: ssh.FingerprintSHA256(publicKey)}, key)
+
+		format, signingKey, gnupgHome := key.gitSigningConfig()
+		assert.Equal(t, "ssh", format)
+		assert.Equal(t, path, signingKey)
+		assert.Empty(t, gnupgHome)
+	})
+
+	t.Run("GPG key", func(t *testing.T) {
+		if _, err := exec.LookPath("gpg"); err != nil {
+			t.Skip("gpg is not installed")
+		}
+		data, entity := generateGPGKey(t, true)
+		path := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		key, err := LoadSigningKey(SigningKeyFormatGPG, path)
+		require.NoError(t, err)
+		require.NotNil(t, key)
+		t.Cleanup(func() { _ = os.RemoveAll(key.GnuPGHome) })
+		assert.Equal(t, entity.PrimaryKey.KeyIdString(), key.ID)
+
+		// The key is imported into a dedicated GnuPG home directory
+		assert.NotEqual(t, common.GetGnuPGHomePath(), key.GnuPGHome)
+		cmd := exec.CommandContext(t.Context(), "gpg", "--list-secret-keys", key.ID)
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+key.GnuPGHome)
+		require.NoError(t, cmd.Run())
+	})
+}
+
+func TestSigningKey_gitSigningConfig(t *testing.T) {
+	key := &SigningKey{Format: SigningKeyFormatGPG, Path: "/app/config/signing-key/signing.key", ID: "4AEE18F83AFDEB23", GnuPGHome: "/tmp/gnupg"}
+	format, signingKey, gnupgHome := key.gitSigningConfig()
+	assert.Equal(t, "openpgp", format)
+	assert.Equal(t, "4AEE18F83AFDEB23", signingKey)
+	assert.Equal(t, "/tmp/gnupg", gnupgHome)
+}
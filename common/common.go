@@ -47,6 +47,8 @@ const (
 	// ArgoCDTLSCertsConfigMapName contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// ArgoCDSSHSigningKeysConfigMapName contains the SSH public keys trusted to sign commits. Will get mounted as volume to pods
+	ArgoCDSSHSigningKeysConfigMapName = "argocd-ssh-signing-keys-cm"
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
@@ -90,6 +92,10 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// DefaultSSHSigningKeysPath is the Default path where the SSH public keys trusted to sign commits are stored
+	DefaultSSHSigningKeysPath = "/app/config/ssh-signing-keys"
+	// DefaultCommitSigningKeyPath is the Default path to the private key the commit server signs hydrated commits with
+	DefaultCommitSigningKeyPath = "/app/config/signing-key/signing.key"
 	// DefaultAppConfigPath is the Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// DefaultPluginSockFilePath is the Default path to cmp server plugin socket file
@@ -308,6 +314,8 @@ const (
 	EnvCMPWorkDir = "ARGOCD_CMP_WORKDIR"
 	// EnvGPGDataPath overrides the location where GPG keyring for signature verification is stored
 	EnvGPGDataPath = "ARGOCD_GPG_DATA_PATH"
+	// EnvSSHSigningKeysPath overrides the location where the SSH public keys trusted to sign commits are stored
+	EnvSSHSigningKeysPath = "ARGOCD_SSH_SIGNING_KEYS_PATH"
 	// EnvServer is the server address of the Argo CD API server.
 	EnvServer = "ARGOCD_SERVER"
 	// EnvServerName is the name of the Argo CD server component, as specified by the value under the LabelKeyAppName label key.
@@ -384,6 +392,16 @@ func GetGnuPGHomePath() string {
 	return gnuPgHome
 }
 
+// GetSSHSigningKeysPath retrieves the path where the SSH public keys trusted to sign commits are stored, which is either
+// taken from the ARGOCD_SSH_SIGNING_KEYS_PATH environment or a default value
+func GetSSHSigningKeysPath() string {
+	path := os.Getenv(EnvSSHSigningKeysPath)
+	if path == "" {
+		return DefaultSSHSigningKeysPath
+	}
+	return path
+}
+
 // GetPluginSockFilePath retrieves the path of plugin sock file, which is either taken from PluginSockFilePath environment or a default value
 func GetPluginSockFilePath() string {
 	pluginSockFilePath := os.Getenv(EnvPluginSockFilePath)
@@ -419,6 +437,10 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetRolloutPromote is an annotation that is added when a RollingSync step of an ApplicationSet waiting for approval is promoted. Its value is the number of the step. The ApplicationSet controller will remove this annotation once the step is approved.
+	AnnotationApplicationSetRolloutPromote = "argocd.argoproj.io/application-set-rollout-promote"
+	// AnnotationApplicationSetPreviousSpec is an annotation that is added to the Applications updated by a RollingSync rollout of an ApplicationSet with the Rollback failure policy. Its value contains the previous generated spec of the Application, which is restored if the rollout fails.
+	AnnotationApplicationSetPreviousSpec = "argocd.argoproj.io/application-set-previous-spec"
 )
 
 // gRPC settings
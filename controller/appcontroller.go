@@ -51,6 +51,7 @@ import (
 	hydratortypes "github.com/argoproj/argo-cd/v3/controller/hydrator/types"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	"github.com/argoproj/argo-cd/v3/controller/warmup"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -147,6 +148,9 @@ type ApplicationController struct {
 	deploymentInformer                informerv1.DeploymentInformer
 
 	hydrator *hydrator.Hydrator
+
+	// repoWarmUp pre-fetches the repositories of the applications into the repo server. It is nil if disabled.
+	repoWarmUp *warmup.Scheduler
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -182,6 +186,7 @@ func NewApplicationController(
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	repoWarmUpOpts *warmup.Options,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -333,6 +338,9 @@ func NewApplicationController(
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
+	if repoWarmUpOpts != nil {
+		ctrl.repoWarmUp = warmup.NewScheduler(appLister, func(app *appv1.Application) bool { return ctrl.canProcessApp(app) }, db, repoClientset, *repoWarmUpOpts)
+	}
 	ctrl.projInformer = projInformer
 	ctrl.deploymentInformer = deploymentInformer
 	ctrl.appStateManager = appStateManager
@@ -927,6 +935,13 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
+	// Applications are only reconciled once the repo server fetched the repositories they refer to, so that the first
+	// reconciliation does not make it clone all repositories at once
+	if ctrl.repoWarmUp != nil {
+		go ctrl.repoWarmUp.Run(ctx)
+		ctrl.repoWarmUp.WaitForInitialWarmUp(ctx)
+	}
+
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
 			for ctrl.processAppRefreshQueueItem() {
@@ -1507,8 +1522,21 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	terminating := state.Phase == synccommon.OperationTerminating
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
-		// Start or resume the sync
-		ctrl.appStateManager.SyncAppState(app, project, state)
+		// Syncs which did not start yet are held until the dependencies of the application are synced and healthy
+		waitingMsg := ""
+		if !terminating && state.Operation.Sync != nil && state.SyncResult == nil {
+			waitingMsg, err = ctrl.waitingForDependencies(app)
+		}
+		switch {
+		case err != nil:
+			state.Phase = synccommon.OperationError
+			state.Message = fmt.Sprintf("Invalid dependencies: %v", err)
+		case waitingMsg != "":
+			state.Message = waitingMsg
+		default:
+			// Start or resume the sync
+			ctrl.appStateManager.SyncAppState(app, project, state)
+		}
 	} else {
 		state.Phase = synccommon.OperationError
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
@@ -1529,6 +1557,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// cleanup (e.g. delete jobs, workflows, etc...)
 			}
 		}
+		if resumeAfter := syncBatchResumeAfter(app, state); resumeAfter != nil {
+			// resume the sync once the pause before the next batch elapsed
+			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), *resumeAfter)
+		}
 	case synccommon.OperationFailed, synccommon.OperationError:
 		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
 			now := metav1.Now()
@@ -1847,8 +1879,23 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	dependenciesCond := ctrl.dependenciesCondition(app)
+	if dependenciesCond != nil {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{*dependenciesCond},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionWaitingForDependencies: true},
+		)
+	} else {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionWaitingForDependencies: true},
+		)
+	}
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
-	if canSync {
+	if dependenciesCond != nil {
+		logCtx.Info("Automated sync held until dependencies are synced and healthy")
+	} else if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
 		setOpDuration = opDuration
 		if syncErrCond != nil {
@@ -2136,6 +2183,8 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		}
 		delete(newAnnotations, appv1.AnnotationKeyRefresh)
 		delete(newAnnotations, appv1.AnnotationKeyHydrate)
+		delete(newAnnotations, appv1.AnnotationKeyHydrateRevision)
+		delete(newAnnotations, appv1.AnnotationKeyHydrateDryRevisions)
 	}
 	patch, modified, err := createMergePatch(
 		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: orig.Status},
@@ -2553,6 +2602,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 						compareWith = CompareWithLatest.Pointer()
 					}
+					if isDependencyReady(oldApp) != isDependencyReady(newApp) {
+						ctrl.requestDependentAppsRefresh(newApp)
+					}
 					if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
 						// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
 						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// isDependencyReady returns whether the application is synced and healthy at its target revisions, so that the
+// applications depending on it can be synced
+func isDependencyReady(app *appv1.Application) bool {
+	return app.Operation == nil &&
+		app.Status.Sync.Status == appv1.SyncStatusCodeSynced &&
+		app.Status.Health.Status == health.HealthStatusHealthy &&
+		currentSourceEqualsSyncedSource(app) &&
+		reflect.DeepEqual(app.Spec.Destination, app.Status.Sync.ComparedTo.Destination)
+}
+
+// dependsOn returns whether the application depends on the other application
+func dependsOn(app, other *appv1.Application) bool {
+	if app.Namespace != other.Namespace || app.Name == other.Name {
+		return false
+	}
+	for _, dependency := range app.Spec.DependsOn {
+		if dependency.Name == other.Name {
+			return true
+		}
+		if dependency.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(dependency.Selector)
+			if err == nil && selector.Matches(labels.Set(other.Labels)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getAppDependencies returns the applications the application depends on, and the names of the applications it depends
+// on by name which do not exist
+func (ctrl *ApplicationController) getAppDependencies(app *appv1.Application) ([]*appv1.Application, []string, error) {
+	dependencies := map[string]*appv1.Application{}
+	var missing []string
+	for _, dependency := range app.Spec.DependsOn {
+		if dependency.Name != "" {
+			dependencyApp, err := ctrl.appLister.Applications(app.Namespace).Get(dependency.Name)
+			if apierrors.IsNotFound(err) {
+				missing = append(missing, dependency.Name)
+				continue
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting application %s: %w", dependency.Name, err)
+			}
+			dependencies[dependencyApp.Name] = dependencyApp
+		}
+		if dependency.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(dependency.Selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid dependency selector: %w", err)
+			}
+			apps, err := ctrl.appLister.Applications(app.Namespace).List(selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error listing applications: %w", err)
+			}
+			for _, dependencyApp := range apps {
+				// applications selecting themselves do not depend on themselves
+				if dependencyApp.Name != app.Name {
+					dependencies[dependencyApp.Name] = dependencyApp
+				}
+			}
+		}
+	}
+
+	result := make([]*appv1.Application, 0, len(dependencies))
+	for _, dependencyApp := range dependencies {
+		result = append(result, dependencyApp)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, missing, nil
+}
+
+// findDependencyCycle returns the names of the applications forming a cycle of dependencies reachable from the
+// application, starting and ending with the same application, or nil if there is none
+func (ctrl *ApplicationController) findDependencyCycle(app *appv1.Application) ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var path []string
+	var visit func(app *appv1.Application) ([]string, error)
+	visit = func(app *appv1.Application) ([]string, error) {
+		state[app.Name] = visiting
+		path = append(path, app.Name)
+		dependencies, _, err := ctrl.getAppDependencies(app)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range dependencies {
+			switch state[dependency.Name] {
+			case visiting:
+				for i, name := range path {
+					if name == dependency.Name {
+						return append(append([]string{}, path[i:]...), dependency.Name), nil
+					}
+				}
+			case visited:
+				continue
+			default:
+				if cycle, err := visit(dependency); cycle != nil || err != nil {
+					return cycle, err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[app.Name] = visited
+		return nil, nil
+	}
+	return visit(app)
+}
+
+// waitingForDependencies returns a message listing the dependencies of the application which are not synced and
+// healthy at their target revisions yet, or an empty message if the application can be synced. An error is returned
+// if the dependencies of the application are invalid, such as dependencies forming a cycle.
+func (ctrl *ApplicationController) waitingForDependencies(app *appv1.Application) (string, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return "", nil
+	}
+	cycle, err := ctrl.findDependencyCycle(app)
+	if err != nil {
+		return "", err
+	}
+	if cycle != nil {
+		return "", fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	dependencies, missing, err := ctrl.getAppDependencies(app)
+	if err != nil {
+		return "", err
+	}
+	var waiting []string
+	for _, name := range missing {
+		waiting = append(waiting, name+" (not found)")
+	}
+	for _, dependency := range dependencies {
+		if !isDependencyReady(dependency) {
+			status := fmt.Sprintf("%s (%s, %s", dependency.Name, dependency.Status.Sync.Status, dependency.Status.Health.Status)
+			if dependency.Operation != nil {
+				status += ", operation in progress"
+			}
+			waiting = append(waiting, status+")")
+		}
+	}
+	if len(waiting) == 0 {
+		return "", nil
+	}
+	return "Waiting for dependencies: " + strings.Join(waiting, ", "), nil
+}
+
+// dependenciesCondition returns the WaitingForDependencies condition of the application, or nil if the application can
+// be synced
+func (ctrl *ApplicationController) dependenciesCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	message, err := ctrl.waitingForDependencies(app)
+	if err != nil {
+		message = "Invalid dependencies: " + err.Error()
+	}
+	if message == "" {
+		return nil
+	}
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionWaitingForDependencies, Message: message}
+}
+
+// requestDependentAppsRefresh requests a refresh of the applications depending on the application, and resumes their
+// operations waiting for dependencies
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	apps, err := ctrl.appLister.Applications(app.Namespace).List(labels.Everything())
+	if err != nil {
+		return
+	}
+	for _, dependent := range apps {
+		if !dependsOn(dependent, app) || !ctrl.canProcessApp(dependent) {
+			continue
+		}
+		ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		if dependent.Operation != nil {
+			ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(dependent.QualifiedName()))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+func newDependencyApp(name string, ready bool, dependencies ...v1alpha1.ApplicationDependency) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.UID = "uid-" + app.UID
+	app.Labels = map[string]string{"tier": "infra"}
+	app.Spec.DependsOn = dependencies
+	app.Status.Sync.ComparedTo = v1alpha1.ComparedTo{Source: app.Spec.GetSource(), Destination: app.Spec.Destination}
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	app.Status.Health.Status = health.HealthStatusProgressing
+	if ready {
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+		app.Status.Health.Status = health.HealthStatusHealthy
+	}
+	return app
+}
+
+func TestIsDependencyReady(t *testing.T) {
+	assert.True(t, isDependencyReady(newDependencyApp("ready", true)))
+	assert.False(t, isDependencyReady(newDependencyApp("not-ready", false)))
+
+	app := newDependencyApp("operation", true)
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	assert.False(t, isDependencyReady(app))
+
+	app = newDependencyApp("new-revision", true)
+	app.Spec.Source.TargetRevision = "v2"
+	assert.False(t, isDependencyReady(app))
+}
+
+func TestWaitingForDependencies(t *testing.T) {
+	byName := func(name string) v1alpha1.ApplicationDependency {
+		return v1alpha1.ApplicationDependency{Name: name}
+	}
+	bySelector := v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}}
+
+	t.Run("No dependencies", func(t *testing.T) {
+		app := newDependencyApp("app", false)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+
+	t.Run("Dependencies by name", func(t *testing.T) {
+		app := newDependencyApp("app", false, byName("ready"), byName("not-ready"), byName("missing"))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, newDependencyApp("ready", true), newDependencyApp("not-ready", false)}}, nil)
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Equal(t, "Waiting for dependencies: missing (not found), not-ready (OutOfSync, Progressing)", message)
+	})
+
+	t.Run("Dependencies by selector", func(t *testing.T) {
+		// the application is selected by its own selector, but does not depend on itself
+		app := newDependencyApp("app", false, bySelector)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, newDependencyApp("ready", true), newDependencyApp("other-ready", true)}}, nil)
+		message, err := ctrl.waitingForDependencies(app)
+		require.NoError(t, err)
+		assert.Empty(t, message)
+	})
+
+	t.Run("Dependency cycle", func(t *testing.T) {
+		app := newDependencyApp("app", false, byName("a"))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{
+			app,
+			newDependencyApp("a", true, byName("b")),
+			newDependencyApp("b", true, byName("a")),
+		}}, nil)
+		_, err := ctrl.waitingForDependencies(app)
+		require.EqualError(t, err, "dependency cycle detected: a -> b -> a")
+
+		condition := ctrl.dependenciesCondition(app)
+		require.NotNil(t, condition)
+		assert.Equal(t, v1alpha1.ApplicationConditionWaitingForDependencies, condition.Type)
+		assert.Equal(t, "Invalid dependencies: dependency cycle detected: a -> b -> a", condition.Message)
+	})
+}
+
+func TestDependsOn(t *testing.T) {
+	dependency := newDependencyApp("dependency", true)
+	assert.True(t, dependsOn(newDependencyApp("app", false, v1alpha1.ApplicationDependency{Name: "dependency"}), dependency))
+	assert.True(t, dependsOn(newDependencyApp("app", false, v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "infra"}}}), dependency))
+	assert.False(t, dependsOn(newDependencyApp("app", false, v1alpha1.ApplicationDependency{Name: "other"}), dependency))
+	assert.False(t, dependsOn(newDependencyApp("app", false), dependency))
+}
+
+func TestProcessRequestedAppOperation_WaitingForDependencies(t *testing.T) {
+	app := newDependencyApp("app", false, v1alpha1.ApplicationDependency{Name: "dependency"})
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, newDependencyApp("dependency", false), &defaultProj}}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	func() {
+		fakeAppCs.Lock()
+		defer fakeAppCs.Unlock()
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+	}()
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	assert.Equal(t, "Waiting for dependencies: dependency (OutOfSync, Progressing)", message)
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"maps"
 	"path/filepath"
+	"reflect"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/types"
@@ -24,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/hydrator"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 // RepoGetter is an interface that defines methods for getting repository objects. It's a subset of the DB interface to
@@ -48,9 +52,9 @@ type Dependencies interface {
 	// GetProcessableApps returns a list of applications that are processable by the controller.
 	GetProcessableApps() (*appv1.ApplicationList, error)
 
-	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
-	// server and gets the manifests (objects).
-	GetRepoObjs(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+	// GetRepoObjs returns the repository objects for the given application, sources, and revisions. It calls the repo-
+	// server and gets the manifests (objects), along with one manifest response per source.
+	GetRepoObjs(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)
 
 	// GetWriteCredentials returns the repository credentials for the given repository URL and project. These are to be
 	// sent to the commit server to write the hydrated manifests.
@@ -69,6 +73,21 @@ type Dependencies interface {
 
 	// GetHydratorCommitMessageTemplate gets the configured template for rendering commit messages.
 	GetHydratorCommitMessageTemplate() (string, error)
+
+	// GetHydratorValidation gets the configured checks to run on the hydrated manifests before committing them.
+	GetHydratorValidation() (*settings.SourceHydratorValidation, error)
+
+	// IsNamespaced returns whether resources of the given group kind are namespaced in the destination cluster of the
+	// application. It returns an error if the cluster does not know the group kind.
+	IsNamespaced(app *appv1.Application, gk schema.GroupKind) (bool, error)
+
+	// IsDestinationPermitted returns whether the project permits deploying resources to the given namespace of the
+	// destination cluster of the application.
+	IsDestinationPermitted(app *appv1.Application, proj *appv1.AppProject, namespace string) (bool, error)
+
+	// PersistAppHydrationConditions persists the conditions reported by the hydrator for the application, replacing the
+	// ones reported previously.
+	PersistAppHydrationConditions(orig *appv1.Application, conditions []appv1.ApplicationCondition)
 }
 
 // Hydrator is the main struct that implements the hydration logic. It uses the Dependencies interface to access the
@@ -79,6 +98,10 @@ type Hydrator struct {
 	commitClientset      commitclient.Clientset
 	repoClientset        apiclient.Clientset
 	repoGetter           RepoGetter
+
+	// pullRequestUpserterFactory is used to open pull requests when hydrateTo.pullRequest is configured. Defaults to
+	// NewPullRequestUpserter.
+	pullRequestUpserterFactory PullRequestUpserterFactory
 }
 
 // NewHydrator creates a new Hydrator instance with the given dependencies, status refresh timeout, commit clientset,
@@ -111,12 +134,30 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 
 	needsHydration, reason := appNeedsHydration(app)
 	if needsHydration {
-		app.Status.SourceHydrator.CurrentOperation = &appv1.HydrateOperation{
+		operation := &appv1.HydrateOperation{
 			StartedAt:      metav1.Now(),
 			FinishedAt:     nil,
 			Phase:          appv1.HydrateOperationPhaseHydrating,
 			SourceHydrator: *app.Spec.SourceHydrator,
 		}
+		previous := app.Status.SourceHydrator.CurrentOperation
+		hydrateType, hydrateRequested := app.IsHydrateRequested()
+		switch {
+		case hydrateRequested && hydrateType == appv1.HydrateTypeManual:
+			operation.Manual = true
+			operation.TargetRevision = app.GetAnnotation(appv1.AnnotationKeyHydrateRevision)
+			if dryRevisions := app.GetAnnotation(appv1.AnnotationKeyHydrateDryRevisions); dryRevisions != "" {
+				operation.TargetDryRevisions = strings.Split(dryRevisions, ",")
+			}
+		case !hydrateRequested && previous != nil && previous.Phase == appv1.HydrateOperationPhaseFailed && previous.SourceHydrator.DeepEquals(*app.Spec.SourceHydrator):
+			// Retry a failed manual hydration as it was requested, so that a hydration pinned to an earlier dry
+			// revision does not hydrate the latest revision instead. Once the spec changed, e.g. to fix the cause of
+			// the failure, the latest revision is hydrated again.
+			operation.Manual = previous.Manual
+			operation.TargetRevision = previous.TargetRevision
+			operation.TargetDryRevisions = previous.TargetDryRevisions
+		}
+		app.Status.SourceHydrator.CurrentOperation = operation
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 	}
 
@@ -126,6 +167,9 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 		h.dependencies.AddHydrationQueueItem(getHydrationQueueKey(app))
 	} else {
 		logCtx.WithField("reason", reason).Debug("Skipping hydration")
+		if pullRequestRefreshDue(app, h.statusRefreshTimeout) {
+			h.refreshPullRequest(logCtx, origApp)
+		}
 	}
 
 	logCtx.Debug("Successfully processed app hydrate queue item")
@@ -136,6 +180,7 @@ func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 		SourceRepoURL:        git.NormalizeGitURLAllowInvalid(app.Spec.SourceHydrator.DrySource.RepoURL),
 		SourceTargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
 		DestinationBranch:    app.Spec.GetHydrateToSource().TargetRevision,
+		DestinationRepoURL:   app.Spec.SourceHydrator.SyncSource.RepoURL,
 	}
 	return key
 }
@@ -188,8 +233,10 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			if err, ok := validationErrors[app.QualifiedName()]; ok {
 				logCtx = logCtx.WithFields(applog.GetAppLogFields(app))
 				logCtx.Errorf("failed to validate hydration app: %v", err)
+				h.setHydrationPolicyConditions(app, err)
 				h.setAppHydratorError(app, err)
 			} else {
+				h.setHydrationPolicyConditions(app, genericError)
 				h.setAppHydratorError(app, genericError)
 			}
 		}
@@ -197,7 +244,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 
 	// Hydrate all the apps
-	drySHA, hydratedSHA, appErrors, err := h.hydrate(logCtx, apps, projects)
+	drySHA, dryRevisions, hydratedSHA, appErrors, err := h.hydrate(logCtx, apps, projects)
 	if err != nil {
 		// If there is a single error, it affects each applications
 		for i := range apps {
@@ -219,8 +266,10 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			if err, ok := appErrors[app.QualifiedName()]; ok {
 				logCtx = logCtx.WithFields(applog.GetAppLogFields(app))
 				logCtx.Errorf("failed to hydrate app: %v", err)
+				h.setHydrationPolicyConditions(app, err)
 				h.setAppHydratorError(app, err)
 			} else {
+				h.setHydrationPolicyConditions(app, genericError)
 				h.setAppHydratorError(app, genericError)
 			}
 		}
@@ -228,6 +277,13 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 
 	logCtx.Debug("Successfully hydrated apps")
+
+	// All the apps share the same hydrateTo branch, so a single pull request promotes all of them.
+	var pullRequest *appv1.HydratePullRequestStatus
+	if hydrateTo := apps[0].Spec.SourceHydrator.HydrateTo; hydrateTo != nil && hydrateTo.PullRequest != nil {
+		pullRequest = h.upsertPullRequest(logCtx, apps, projects, drySHA, hydratedSHA)
+	}
+
 	finishedAt := metav1.Now()
 	for _, app := range apps {
 		origApp := app.DeepCopy()
@@ -239,14 +295,23 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DryRevisions:   dryRevisions,
+			Manual:         app.Status.SourceHydrator.CurrentOperation.Manual,
+			TargetRevision: app.Status.SourceHydrator.CurrentOperation.TargetRevision,
+			PullRequest:    pullRequest.DeepCopy(),
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DryRevisions:   dryRevisions,
 		}
+		app.Status.SourceHydrator.PullRequest = pullRequest
+		addHydrateHistory(app, operation)
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		// The hydrated manifests no longer violate the policies.
+		h.setHydrationPolicyConditions(origApp, nil)
 
 		// Request a refresh since we pushed a new commit.
 		err := h.dependencies.RequestAppRefresh(app.Name, app.Namespace)
@@ -256,6 +321,32 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 }
 
+// addHydrateHistory adds the completed hydrate operation to the hydrate history of the application, unless its hydrated
+// commit is already the most recent entry, e.g. because the dry revision had already been hydrated.
+func addHydrateHistory(app *appv1.Application, operation *appv1.HydrateOperation) {
+	history := app.Status.SourceHydrator.HydrateHistory
+	var nextID int64
+	if len(history) > 0 {
+		last := history.LastHydrateHistory()
+		if last.HydratedSHA == operation.HydratedSHA {
+			return
+		}
+		nextID = last.ID + 1
+	}
+	startedAt := operation.StartedAt
+	history = append(history, appv1.HydrateHistory{
+		ID:             nextID,
+		DrySHA:         operation.DrySHA,
+		HydratedSHA:    operation.HydratedSHA,
+		DryRevisions:   operation.DryRevisions,
+		SourceHydrator: operation.SourceHydrator,
+		StartedAt:      &startedAt,
+		HydratedAt:     *operation.FinishedAt,
+		Manual:         operation.Manual,
+	})
+	app.Status.SourceHydrator.HydrateHistory = history.Trunc(app.Spec.GetRevisionHistoryLimit())
+}
+
 // setAppHydratorError updates the CurrentOperation with the error information.
 func (h *Hydrator) setAppHydratorError(app *appv1.Application, err error) {
 	// if the operation is not in progress, we do not update the status
@@ -313,6 +404,10 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 			errors[app.QualifiedName()] = fmt.Errorf("application repo %s is not permitted in project '%s'", app.Spec.GetSource().RepoURL, proj.Name)
 			continue
 		}
+		if err := validateDrySources(app, proj); err != nil {
+			errors[app.QualifiedName()] = err
+			continue
+		}
 		projects[app.Spec.Project] = proj
 
 		// Disallow hydrating to the repository root.
@@ -343,14 +438,17 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 	return projects, errors
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, map[string]error, error) {
+// hydrate hydrates the given applications and commits the hydrated manifests. It returns the dry SHA, the revisions of
+// each of the dry sources if the applications have multiple dry sources, and the hydrated SHA.
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, []string, string, map[string]error, error) {
 	errors := make(map[string]error)
 	if len(apps) == 0 {
-		return "", "", nil, nil
+		return "", nil, "", nil, nil
 	}
 
 	// These values are the same for all apps being hydrated together, so just get them from the first app.
 	repoURL := apps[0].Spec.GetHydrateToSource().RepoURL
+	dryRepoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
 	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
 	// FIXME: As a convenience, the commit server will create the syncBranch if it does not exist. If the
 	// targetBranch does not exist, it will create it based on the syncBranch. On the next line, we take
@@ -359,19 +457,27 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// create the targetBranch as an orphan since we can't reliable determine a reasonable base.
 	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
 
+	requestedRevision, manual, err := hydrateRequest(apps)
+	if err != nil {
+		return "", nil, "", errors, err
+	}
+
 	// Get a static SHA revision from the first app so that all apps are hydrated from the same revision.
-	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], "", projects[apps[0].Spec.Project])
+	targetRevision, dryRevisions, pathDetails, err := h.getManifests(context.Background(), apps[0], requestedRevision, projects[apps[0].Spec.Project])
 	if err != nil {
 		errors[apps[0].QualifiedName()] = fmt.Errorf("failed to get manifests: %w", err)
-		return "", "", errors, nil
+		return "", nil, "", errors, nil
 	}
 	paths := []*commitclient.PathDetails{pathDetails}
 	logCtx = logCtx.WithFields(log.Fields{"drySha": targetRevision})
 	// De-dupe, if the drySha was already hydrated log a debug and return using the data from the last successful hydration run.
 	// We only inspect one app. If apps have been added/removed, that will be handled on the next DRY commit.
-	if apps[0].Status.SourceHydrator.LastSuccessfulOperation != nil && targetRevision == apps[0].Status.SourceHydrator.LastSuccessfulOperation.DrySHA {
+	// With multiple dry sources, the revisions of the other sources (e.g. a Helm chart version) must not have changed either.
+	// Neither must the layout of the hydrated manifests, since changing it rewrites the hydrated files.
+	// Manually requested hydrations are never skipped, e.g. to restore the hydrated branch after it was changed.
+	if lastSuccessfulOperation := apps[0].Status.SourceHydrator.LastSuccessfulOperation; !manual && lastSuccessfulOperation != nil && targetRevision == lastSuccessfulOperation.DrySHA && slices.Equal(dryRevisions, lastSuccessfulOperation.DryRevisions) && !layoutChanged(apps) {
 		logCtx.Debug("Skipping hydration since the DRY commit was already hydrated")
-		return targetRevision, apps[0].Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA, nil, nil
+		return targetRevision, dryRevisions, lastSuccessfulOperation.HydratedSHA, nil, nil
 	}
 
 	eg, ctx := errgroup.WithContext(context.Background())
@@ -380,7 +486,7 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	for _, app := range apps[1:] {
 		app := app
 		eg.Go(func() error {
-			_, pathDetails, err = h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
+			_, _, pathDetails, err := h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
@@ -392,28 +498,22 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		})
 	}
 	if err := eg.Wait(); err != nil {
-		return targetRevision, "", errors, nil
+		return targetRevision, dryRevisions, "", errors, nil
 	}
 
 	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
 	// need global creds.
-	project := ""
-	if len(projects) == 1 {
-		for p := range projects {
-			project = p
-			break
-		}
-	}
+	project := hydrationProject(projects)
 
 	// Get the commit metadata for the target revision.
-	revisionMetadata, err := h.getRevisionMetadata(context.Background(), repoURL, project, targetRevision)
+	revisionMetadata, err := h.getRevisionMetadata(context.Background(), dryRepoURL, project, targetRevision)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
 	}
 
 	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
 	}
 	if repo == nil {
 		// Try without credentials.
@@ -425,11 +525,11 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// get the commit message template
 	commitMessageTemplate, err := h.dependencies.GetHydratorCommitMessageTemplate()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
 	}
-	commitMessage, errMsg := getTemplatedCommitMessage(repoURL, targetRevision, commitMessageTemplate, revisionMetadata)
+	commitMessage, errMsg := getTemplatedCommitMessage(dryRepoURL, targetRevision, commitMessageTemplate, revisionMetadata)
 	if errMsg != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
 	}
 
 	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
@@ -441,41 +541,115 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		Paths:             paths,
 		DryCommitMetadata: revisionMetadata,
 	}
+	if repoURL != dryRepoURL {
+		manifestsRequest.DryRepoURL = dryRepoURL
+	}
 
 	closer, commitService, err := h.commitClientset.NewCommitServerClient()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to create commit service: %w", err)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to create commit service: %w", err)
 	}
 	defer utilio.Close(closer)
 	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+		return targetRevision, dryRevisions, "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	return targetRevision, dryRevisions, resp.HydratedSha, errors, nil
+}
+
+// hydrateRequest returns the dry revision the hydration of the given applications was requested for, or an empty string
+// for the target revision of the dry source, and whether the hydration was requested manually by any of the
+// applications. All the applications are hydrated from the same revision, so requests for different revisions conflict.
+func hydrateRequest(apps []*appv1.Application) (revision string, manual bool, err error) {
+	for _, app := range apps {
+		operation := app.Status.SourceHydrator.CurrentOperation
+		if operation == nil || operation.Phase != appv1.HydrateOperationPhaseHydrating {
+			continue
+		}
+		manual = manual || operation.Manual
+		if operation.TargetRevision == "" {
+			continue
+		}
+		if revision != "" && revision != operation.TargetRevision {
+			return "", false, fmt.Errorf("conflicting dry revisions %q and %q requested for hydration", revision, operation.TargetRevision)
+		}
+		revision = operation.TargetRevision
 	}
-	return targetRevision, resp.HydratedSha, errors, nil
+	return revision, manual, nil
 }
 
 // getManifests gets the manifests for the given application and target revision. It returns the resolved revision
-// (a git SHA), and path details for the commit server.
+// (a git SHA), the resolved revisions of each of the dry sources if the application has multiple dry sources, and path
+// details for the commit server.
 //
 // If the given target revision is empty, it uses the target revision from the app dry source spec.
-func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
-	drySource := appv1.ApplicationSource{
-		RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
-		Path:           app.Spec.SourceHydrator.DrySource.Path,
-		TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
-		Helm:           app.Spec.SourceHydrator.DrySource.Helm,
-		Kustomize:      app.Spec.SourceHydrator.DrySource.Kustomize,
-		Directory:      app.Spec.SourceHydrator.DrySource.Directory,
-		Plugin:         app.Spec.SourceHydrator.DrySource.Plugin,
-	}
+func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, dryRevisions []string, pathDetails *commitclient.PathDetails, err error) {
+	drySource := app.Spec.SourceHydrator.DrySource
 	if targetRevision == "" {
-		targetRevision = app.Spec.SourceHydrator.DrySource.TargetRevision
+		targetRevision = drySource.TargetRevision
+	}
+
+	var sources []appv1.ApplicationSource
+	var revisions []string
+	if drySource.HasMultipleSources() {
+		sources = drySource.Sources
+		// Only the sources using the dry repository are pinned to the target revision. The other sources are resolved
+		// from their own target revision, unless the hydration was requested for the revisions of an earlier hydration.
+		var pinnedRevisions []string
+		if operation := app.Status.SourceHydrator.CurrentOperation; operation != nil && len(operation.TargetDryRevisions) == len(sources) {
+			pinnedRevisions = operation.TargetDryRevisions
+		}
+		revisions = make([]string, len(sources))
+		for i, source := range sources {
+			switch {
+			case drySource.IsDryRepoSource(source):
+				revisions[i] = targetRevision
+			case pinnedRevisions != nil:
+				revisions[i] = pinnedRevisions[i]
+			}
+		}
+	} else {
+		sources = []appv1.ApplicationSource{{
+			RepoURL:        drySource.RepoURL,
+			Path:           drySource.Path,
+			TargetRevision: drySource.TargetRevision,
+			Helm:           drySource.Helm,
+			Kustomize:      drySource.Kustomize,
+			Directory:      drySource.Directory,
+			Plugin:         drySource.Plugin,
+		}}
+		revisions = []string{targetRevision}
 	}
 
 	// TODO: enable signature verification
-	objs, resp, err := h.dependencies.GetRepoObjs(ctx, app, drySource, targetRevision, project)
+	objs, resps, err := h.dependencies.GetRepoObjs(ctx, app, sources, revisions, project)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
+		return "", nil, nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
+	}
+	if len(resps) != len(sources) {
+		return "", nil, nil, fmt.Errorf("expected %d manifest responses for app %q, got %d", len(sources), app.QualifiedName(), len(resps))
+	}
+
+	err = h.validateManifests(app, project, objs)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	revision = resps[0].Revision
+	var commands []string
+	for _, resp := range resps {
+		commands = append(commands, resp.Commands...)
+	}
+	if drySource.HasMultipleSources() {
+		dryRevisions = make([]string, len(resps))
+		for i, resp := range resps {
+			dryRevisions[i] = resp.Revision
+		}
+		index := slices.IndexFunc(sources, drySource.IsDryRepoSource)
+		if index < 0 {
+			return "", nil, nil, fmt.Errorf("none of the dry sources of app %q use repository %q with revision %q", app.QualifiedName(), drySource.RepoURL, drySource.TargetRevision)
+		}
+		revision = resps[index].Revision
 	}
 
 	// Set up a ManifestsRequest
@@ -483,18 +657,49 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 	for i, obj := range objs {
 		objJSON, err := json.Marshal(obj)
 		if err != nil {
-			return "", nil, fmt.Errorf("failed to marshal object: %w", err)
+			return "", nil, nil, fmt.Errorf("failed to marshal object: %w", err)
 		}
 		manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 	}
 
-	return resp.Revision, &commitclient.PathDetails{
+	return revision, dryRevisions, &commitclient.PathDetails{
 		Path:      app.Spec.SourceHydrator.SyncSource.Path,
 		Manifests: manifestDetails,
-		Commands:  resp.Commands,
+		Commands:  commands,
+		Layout:    app.Spec.SourceHydrator.SyncSource.Layout,
 	}, nil
 }
 
+// layoutChanged returns true if the layout of the hydrated manifests of any of the applications differs from the layout
+// used by the application's last successful hydration.
+func layoutChanged(apps []*appv1.Application) bool {
+	for _, app := range apps {
+		last := app.Status.SourceHydrator.LastSuccessfulOperation
+		if last != nil && !reflect.DeepEqual(app.Spec.SourceHydrator.SyncSource.Layout, last.SourceHydrator.SyncSource.Layout) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDrySources checks that the dry sources of the application are permitted by the project, and that at least one
+// of them uses the dry repository when the application has multiple dry sources.
+func validateDrySources(app *appv1.Application, proj *appv1.AppProject) error {
+	drySource := app.Spec.SourceHydrator.DrySource
+	if !drySource.HasMultipleSources() {
+		return nil
+	}
+	for _, source := range drySource.Sources {
+		if !proj.IsSourcePermitted(source) {
+			return fmt.Errorf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name)
+		}
+	}
+	if !slices.ContainsFunc(drySource.Sources, drySource.IsDryRepoSource) {
+		return fmt.Errorf("none of the dry sources use repository %q with revision %q", drySource.RepoURL, drySource.TargetRevision)
+	}
+	return nil
+}
+
 func (h *Hydrator) getRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*appv1.RevisionMetadata, error) {
 	repo, err := h.repoGetter.GetRepository(ctx, repoURL, project)
 	if err != nil {
@@ -519,6 +724,7 @@ func (h *Hydrator) getRevisionMetadata(ctx context.Context, repoURL, project, re
 
 // appNeedsHydration answers if application needs manifests hydrated.
 func appNeedsHydration(app *appv1.Application) (needsHydration bool, reason string) {
+	_, hydrateRequested := app.IsHydrateRequested()
 	switch {
 	case app.Spec.SourceHydrator == nil:
 		return false, "source hydrator not configured"
@@ -526,17 +732,32 @@ func appNeedsHydration(app *appv1.Application) (needsHydration bool, reason stri
 		return true, "no previous hydrate operation"
 	case app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseHydrating:
 		return false, "hydration operation already in progress"
-	case app.IsHydrateRequested():
+	case hydrateRequested:
 		return true, "hydrate requested"
 	case !app.Spec.SourceHydrator.DeepEquals(app.Status.SourceHydrator.CurrentOperation.SourceHydrator):
 		return true, "spec.sourceHydrator differs"
+	case scheduledHydrationDue(app, time.Now()):
+		return true, "scheduled hydration is due"
 	case app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseFailed && metav1.Now().Sub(app.Status.SourceHydrator.CurrentOperation.FinishedAt.Time) > 2*time.Minute:
 		return true, "previous hydrate operation failed more than 2 minutes ago"
+	case pullRequestFailedSince(app, 2*time.Minute):
+		return true, "previous pull request update failed more than 2 minutes ago"
 	}
 
 	return false, "hydration not needed"
 }
 
+// scheduledHydrationDue returns true if the hydration schedule of the application has a scheduled time between the start
+// of the current hydrate operation and now.
+func scheduledHydrationDue(app *appv1.Application, now time.Time) bool {
+	schedule, err := app.Spec.SourceHydrator.GetSchedule()
+	if err != nil || schedule == nil {
+		// An invalid schedule is reported as a condition by the application validation.
+		return false
+	}
+	return !schedule.Next(app.Status.SourceHydrator.CurrentOperation.StartedAt.Time).After(now)
+}
+
 // getTemplatedCommitMessage gets the multi-line commit message based on the template defined in the configmap. It is a two step process:
 // 1. Get the metadata template engine would use to render the template
 // 2. Pass the output of Step 1 and Step 2 to template Render
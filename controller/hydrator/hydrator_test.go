@@ -100,6 +100,49 @@ func Test_appNeedsHydration(t *testing.T) {
 			expectedNeedsHydration: true,
 			expectedMessage:        "previous hydrate operation failed more than 2 minutes ago",
 		},
+		{
+			name: "pull request failed more than two minutes ago",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{HydrateTo: &v1alpha1.HydrateTo{PullRequest: &v1alpha1.HydratePullRequest{}}}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{
+					CurrentOperation: &v1alpha1.HydrateOperation{
+						Phase:          v1alpha1.HydrateOperationPhaseHydrated,
+						SourceHydrator: v1alpha1.SourceHydrator{HydrateTo: &v1alpha1.HydrateTo{PullRequest: &v1alpha1.HydratePullRequest{}}},
+					},
+					PullRequest: &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseFailed, UpdatedAt: oneHourAgo},
+				}},
+			},
+			expectedNeedsHydration: true,
+			expectedMessage:        "previous pull request update failed more than 2 minutes ago",
+		},
+		{
+			name: "manual hydrate requested",
+			app: &v1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1alpha1.AnnotationKeyHydrate: "manual"}},
+				Spec:       v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{}},
+				Status:     v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated}}},
+			},
+			expectedNeedsHydration: true,
+			expectedMessage:        "hydrate requested",
+		},
+		{
+			name: "scheduled hydration is due",
+			app: &v1alpha1.Application{
+				Spec:   v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{Schedule: "*/30 * * * *"}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{StartedAt: oneHourAgo, FinishedAt: &oneHourAgo, Phase: v1alpha1.HydrateOperationPhaseHydrated, SourceHydrator: v1alpha1.SourceHydrator{Schedule: "*/30 * * * *"}}}},
+			},
+			expectedNeedsHydration: true,
+			expectedMessage:        "scheduled hydration is due",
+		},
+		{
+			name: "scheduled hydration is not due",
+			app: &v1alpha1.Application{
+				Spec:   v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{Schedule: "0 0 1 1 *"}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{StartedAt: now, FinishedAt: &now, Phase: v1alpha1.HydrateOperationPhaseHydrated, SourceHydrator: v1alpha1.SourceHydrator{Schedule: "0 0 1 1 *"}}}},
+			},
+			expectedNeedsHydration: false,
+			expectedMessage:        "hydration not needed",
+		},
 		{
 			name: "hydrate not needed",
 			app: &v1alpha1.Application{
@@ -417,6 +460,91 @@ func TestProcessAppHydrateQueueItem_HydrationNeeded(t *testing.T) {
 	assert.Equal(t, *app.Spec.SourceHydrator, persistedStatus.CurrentOperation.SourceHydrator)
 }
 
+func TestProcessAppHydrateQueueItem_ManualHydrationRequested(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrated)
+	app.Annotations = map[string]string{
+		v1alpha1.AnnotationKeyHydrate:         "manual",
+		v1alpha1.AnnotationKeyHydrateRevision: "abc123",
+	}
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().AddHydrationQueueItem(mock.Anything).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+
+	h.ProcessAppHydrateQueueItem(app)
+
+	require.NotNil(t, persistedStatus)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, persistedStatus.CurrentOperation.Phase)
+	assert.True(t, persistedStatus.CurrentOperation.Manual)
+	assert.Equal(t, "abc123", persistedStatus.CurrentOperation.TargetRevision)
+}
+
+func TestProcessAppHydrateQueueItem_RetryFailedManualHydration(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseFailed)
+	app.Status.SourceHydrator.CurrentOperation.FinishedAt = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+	app.Status.SourceHydrator.CurrentOperation.Manual = true
+	app.Status.SourceHydrator.CurrentOperation.TargetRevision = "abc123"
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().AddHydrationQueueItem(mock.Anything).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+
+	h.ProcessAppHydrateQueueItem(app)
+
+	// The failed hydration is retried for the same pinned revision.
+	require.NotNil(t, persistedStatus)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, persistedStatus.CurrentOperation.Phase)
+	assert.True(t, persistedStatus.CurrentOperation.Manual)
+	assert.Equal(t, "abc123", persistedStatus.CurrentOperation.TargetRevision)
+}
+
+func TestProcessAppHydrateQueueItem_FailedManualHydrationSpecChanged(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseFailed)
+	app.Status.SourceHydrator.CurrentOperation.FinishedAt = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+	app.Status.SourceHydrator.CurrentOperation.Manual = true
+	app.Status.SourceHydrator.CurrentOperation.TargetRevision = "abc123"
+	app.Spec.SourceHydrator.DrySource.Path = "other-path"
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().AddHydrationQueueItem(mock.Anything).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+
+	h.ProcessAppHydrateQueueItem(app)
+
+	// The spec changed since the failed hydration, so the target revision of the dry source is hydrated again.
+	require.NotNil(t, persistedStatus)
+	assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, persistedStatus.CurrentOperation.Phase)
+	assert.False(t, persistedStatus.CurrentOperation.Manual)
+	assert.Empty(t, persistedStatus.CurrentOperation.TargetRevision)
+}
+
 func TestProcessAppHydrateQueueItem_HydrationPassedTimeout(t *testing.T) {
 	t.Parallel()
 	d := mocks.NewDependencies(t)
@@ -586,9 +714,9 @@ func TestProcessHydrationQueueItem_HydrateFails_CommonError(t *testing.T) {
 	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
 	h := &Hydrator{dependencies: d, repoGetter: r}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil)
+	}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("repo error"))
 
 	// Expect setAppHydratorError to be called
@@ -638,9 +766,9 @@ func TestProcessHydrationQueueItem_SuccessfulHydration(t *testing.T) {
 		persistedStatus = newStatus
 	}).Return().Once()
 	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil).Once()
+	}}, nil).Once()
 	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
 	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
@@ -693,6 +821,42 @@ func TestValidateApplications_SourceNotPermitted(t *testing.T) {
 	require.ErrorContains(t, errs[app.QualifiedName()], "application repo https://example.com/repo is not permitted in project 'test-project'")
 }
 
+func TestValidateApplications_DrySourcesWithoutDryRepo(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "https://example.com/repo", TargetRevision: "release", Ref: "values"},
+	}
+	proj := newTestProject()
+	d.EXPECT().GetProcessableAppProj(app).Return(proj, nil).Once()
+	h := &Hydrator{dependencies: d}
+
+	projects, errs := h.validateApplications([]*v1alpha1.Application{app})
+	require.Nil(t, projects)
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[app.QualifiedName()], `none of the dry sources use repository "https://example.com/repo" with revision "main"`)
+}
+
+func TestValidateApplications_DrySourceNotPermitted(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "oci://example.com/charts", Chart: "umbrella", TargetRevision: "1.0.0"},
+		{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"},
+	}
+	proj := newTestProject()
+	proj.Spec.SourceRepos = []string{"https://example.com/repo"}
+	d.EXPECT().GetProcessableAppProj(app).Return(proj, nil).Once()
+	h := &Hydrator{dependencies: d}
+
+	projects, errs := h.validateApplications([]*v1alpha1.Application{app})
+	require.Nil(t, projects)
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[app.QualifiedName()], "application repo oci://example.com/charts is not permitted in project 'test-project'")
+}
+
 func TestValidateApplications_RootPath(t *testing.T) {
 	t.Parallel()
 	d := mocks.NewDependencies(t)
@@ -795,8 +959,8 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
-	d.EXPECT().GetRepoObjs(mock.Anything, app2, app2.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app2, []v1alpha1.ApplicationSource{app2.Spec.SourceHydrator.GetDrySource()}, []string{"sha123"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil).Run(func(_ context.Context, in *repoclient.RepoServerRevisionMetadataRequest, _ ...grpc.CallOption) {
 		assert.Equal(t, readRepo, in.Repo)
@@ -817,7 +981,7 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	})
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -825,6 +989,66 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	assert.Empty(t, errs)
 }
 
+func TestHydrator_hydrate_OCISyncSource(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.HydrateTo = nil
+	app.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+	app.Spec.SourceHydrator.SyncSource.TargetBranch = "env-dev"
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	writeRepo := &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated", Type: "oci"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	// The revision metadata is read from the dry repository, and the write credentials of the OCI repository are used.
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, writeRepo.Repo, proj.Name).Return(writeRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "sha256:0123"}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, writeRepo, in.Repo)
+		assert.Equal(t, "https://example.com/repo", in.DryRepoURL)
+		assert.Equal(t, "env-dev", in.SyncBranch)
+		assert.Equal(t, "env-dev", in.TargetBranch)
+		assert.Equal(t, "sha123", in.DrySha)
+	})
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "sha256:0123", hydratedSha)
+	assert.Empty(t, errs)
+}
+
+func TestGetHydrationQueueKey_OCISyncSource(t *testing.T) {
+	t.Parallel()
+
+	app1 := newTestApp("app1")
+	app1.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+	app2 := newTestApp("app2")
+	app2.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/other"
+
+	// Apps pushing to different OCI repositories are not hydrated together.
+	assert.Equal(t, "oci://registry.example.com/hydrated", getHydrationQueueKey(app1).DestinationRepoURL)
+	assert.NotEqual(t, getHydrationQueueKey(app1), getHydrationQueueKey(app2))
+	assert.Empty(t, getHydrationQueueKey(newTestApp("app3")).DestinationRepoURL)
+}
+
 func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	t.Parallel()
 
@@ -846,7 +1070,7 @@ func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, nil, errors.New("manifests error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -873,12 +1097,12 @@ func TestHydrator_hydrate_RevisionMetadataError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, errors.New("metadata error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -905,13 +1129,13 @@ func TestHydrator_hydrate_GetWriteCredentialsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("creds error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -938,14 +1162,14 @@ func TestHydrator_hydrate_CommitMessageTemplateError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("", errors.New("template error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -972,14 +1196,14 @@ func TestHydrator_hydrate_TemplatedCommitMessageError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("{{ notAFunction }} template", nil)
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1006,7 +1230,7 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
@@ -1014,7 +1238,7 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("commit error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1029,7 +1253,7 @@ func TestHydrator_hydrate_EmptyApps(t *testing.T) {
 	logCtx := log.NewEntry(log.StandardLogger())
 	h := &Hydrator{dependencies: d}
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -1042,6 +1266,7 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 	d := mocks.NewDependencies(t)
 	h := &Hydrator{dependencies: d}
 	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.SyncSource.Layout = &v1alpha1.HydrateLayout{Type: v1alpha1.HydrateLayoutTypeNamespace, Kustomization: true}
 	proj := newTestProject()
 
 	cm := kube.MustToUnstructured(&corev1.ConfigMap{
@@ -1050,20 +1275,74 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 		},
 	})
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return([]*unstructured.Unstructured{cm}, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, []string{"sha123"}, proj).Return([]*unstructured.Unstructured{cm}, []*repoclient.ManifestResponse{{
 		Revision: "sha123",
 		Commands: []string{"cmd1", "cmd2"},
-	}, nil)
+	}}, nil)
+	d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{}, nil)
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
+	rev, _, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", rev)
 	assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Path, pathDetails.Path)
 	assert.Equal(t, []string{"cmd1", "cmd2"}, pathDetails.Commands)
+	assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Layout, pathDetails.Layout)
 	assert.Len(t, pathDetails.Manifests, 1)
 	assert.JSONEq(t, `{"metadata":{"name":"test"}}`, pathDetails.Manifests[0].ManifestJSON)
 }
 
+func TestHydrator_getManifests_MultipleSources(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "oci://example.com/charts", Chart: "umbrella", TargetRevision: "1.x", Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"$values/values.yaml"}}},
+		{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"},
+	}
+	proj := newTestProject()
+
+	// Only the source using the dry repository is pinned to the dry SHA.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource(app.Spec.SourceHydrator.DrySource.Sources), []string{"", "sha123"}, proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{
+		{Revision: "1.2.3", Commands: []string{"helm template"}},
+		{Revision: "sha123"},
+	}, nil)
+
+	rev, dryRevisions, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", rev)
+	assert.Equal(t, []string{"1.2.3", "sha123"}, dryRevisions)
+	assert.Equal(t, []string{"helm template"}, pathDetails.Commands)
+}
+
+func TestHydrator_getManifests_MultipleSourcesPinned(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "oci://example.com/charts", Chart: "umbrella", TargetRevision: "1.x"},
+		{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"},
+	}
+	app.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{
+		Phase:              v1alpha1.HydrateOperationPhaseHydrating,
+		TargetRevision:     "sha123",
+		TargetDryRevisions: []string{"1.1.0", "sha123"},
+	}
+	proj := newTestProject()
+
+	// The other sources are pinned to the revisions of the earlier hydration, e.g. when rolling back.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource(app.Spec.SourceHydrator.DrySource.Sources), []string{"1.1.0", "sha123"}, proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{
+		{Revision: "1.1.0"},
+		{Revision: "sha123"},
+	}, nil)
+
+	rev, dryRevisions, _, err := h.getManifests(t.Context(), app, "sha123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", rev)
+	assert.Equal(t, []string{"1.1.0", "sha123"}, dryRevisions)
+}
+
 func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	t.Parallel()
 	d := mocks.NewDependencies(t)
@@ -1071,9 +1350,9 @@ func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	app := newTestApp("test-app")
 	proj := newTestProject()
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return([]*unstructured.Unstructured{}, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, []string{"main"}, proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "", proj)
+	rev, _, pathDetails, err := h.getManifests(t.Context(), app, "", proj)
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", rev)
 	assert.NotNil(t, pathDetails)
@@ -1086,15 +1365,157 @@ func TestHydrator_getManifests_GetRepoObjsError(t *testing.T) {
 	app := newTestApp("test-app")
 	proj := newTestProject()
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return(nil, nil, errors.New("repo error"))
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, []string{"main"}, proj).Return(nil, nil, errors.New("repo error"))
 
-	rev, pathDetails, err := h.getManifests(t.Context(), app, "main", proj)
+	rev, _, pathDetails, err := h.getManifests(t.Context(), app, "main", proj)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "repo error")
 	assert.Empty(t, rev)
 	assert.Nil(t, pathDetails)
 }
 
+func TestProcessHydrationQueueItem_SuccessfulHydrationAddsHistory(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Status.SourceHydrator.CurrentOperation.Manual = true
+	app.Status.SourceHydrator.CurrentOperation.TargetRevision = "abc123"
+	app.Status.SourceHydrator.HydrateHistory = v1alpha1.HydrateHistories{{ID: 3, DrySHA: "def123", HydratedSHA: "fed456"}}
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+	// The pinned revision is hydrated instead of the target revision of the dry source.
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, []string{"abc123"}, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
+		Revision: "abc123",
+	}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil).Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+
+	require.NotNil(t, persistedStatus)
+	assert.True(t, persistedStatus.CurrentOperation.Manual)
+	assert.Equal(t, "abc123", persistedStatus.CurrentOperation.TargetRevision)
+	require.Len(t, persistedStatus.HydrateHistory, 2)
+	last := persistedStatus.HydrateHistory.LastHydrateHistory()
+	assert.Equal(t, int64(4), last.ID)
+	assert.Equal(t, "abc123", last.DrySHA)
+	assert.Equal(t, "def456", last.HydratedSHA)
+	assert.True(t, last.Manual)
+	assert.Equal(t, app.Status.SourceHydrator.CurrentOperation.StartedAt, *last.StartedAt)
+	assert.Equal(t, *persistedStatus.CurrentOperation.FinishedAt, last.HydratedAt)
+}
+
+func TestAddHydrateHistory(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	newOperation := func(drySHA, hydratedSHA string) *v1alpha1.HydrateOperation {
+		return &v1alpha1.HydrateOperation{StartedAt: now, FinishedAt: &now, DrySHA: drySHA, HydratedSHA: hydratedSHA}
+	}
+
+	t.Run("first hydration", func(t *testing.T) {
+		t.Parallel()
+		app := newTestApp("test-app")
+		addHydrateHistory(app, newOperation("abc", "def"))
+		require.Len(t, app.Status.SourceHydrator.HydrateHistory, 1)
+		assert.Equal(t, int64(0), app.Status.SourceHydrator.HydrateHistory[0].ID)
+		assert.Equal(t, "abc", app.Status.SourceHydrator.HydrateHistory[0].DrySHA)
+	})
+
+	t.Run("hydrated commit unchanged", func(t *testing.T) {
+		t.Parallel()
+		app := newTestApp("test-app")
+		app.Status.SourceHydrator.HydrateHistory = v1alpha1.HydrateHistories{{ID: 0, DrySHA: "abc", HydratedSHA: "def"}}
+		addHydrateHistory(app, newOperation("abc", "def"))
+		assert.Len(t, app.Status.SourceHydrator.HydrateHistory, 1)
+	})
+
+	t.Run("history limit", func(t *testing.T) {
+		t.Parallel()
+		app := newTestApp("test-app")
+		app.Spec.RevisionHistoryLimit = ptr.To(int64(2))
+		app.Status.SourceHydrator.HydrateHistory = v1alpha1.HydrateHistories{{ID: 0, HydratedSHA: "a"}, {ID: 1, HydratedSHA: "b"}}
+		addHydrateHistory(app, newOperation("abc", "c"))
+		require.Len(t, app.Status.SourceHydrator.HydrateHistory, 2)
+		assert.Equal(t, int64(1), app.Status.SourceHydrator.HydrateHistory[0].ID)
+		assert.Equal(t, int64(2), app.Status.SourceHydrator.HydrateHistory[1].ID)
+	})
+}
+
+func TestHydrateRequest(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(name string, phase v1alpha1.HydrateOperationPhase, manual bool, revision string) *v1alpha1.Application {
+		app := setTestAppPhase(newTestApp(name), phase)
+		app.Status.SourceHydrator.CurrentOperation.Manual = manual
+		app.Status.SourceHydrator.CurrentOperation.TargetRevision = revision
+		return app
+	}
+
+	revision, manual, err := hydrateRequest([]*v1alpha1.Application{
+		newApp("app1", v1alpha1.HydrateOperationPhaseHydrating, false, ""),
+		newApp("app2", v1alpha1.HydrateOperationPhaseHydrating, true, "abc123"),
+		newApp("app3", v1alpha1.HydrateOperationPhaseHydrated, true, "def456"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", revision)
+	assert.True(t, manual)
+
+	revision, manual, err = hydrateRequest([]*v1alpha1.Application{newApp("app1", v1alpha1.HydrateOperationPhaseHydrating, false, "")})
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+	assert.False(t, manual)
+
+	_, _, err = hydrateRequest([]*v1alpha1.Application{
+		newApp("app1", v1alpha1.HydrateOperationPhaseHydrating, true, "abc123"),
+		newApp("app2", v1alpha1.HydrateOperationPhaseHydrating, true, "def456"),
+	})
+	require.ErrorContains(t, err, "conflicting dry revisions")
+}
+
+func TestHydrator_hydrate_DeDupe_ManualHydration(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	h := &Hydrator{dependencies: d, repoGetter: r}
+
+	app := setTestAppPhase(newTestApp("app1"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Status.SourceHydrator.CurrentOperation.Manual = true
+	app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{
+		DrySHA:      "sha123",
+		HydratedSHA: "hydrated123",
+	}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+
+	// The dry SHA did not change, but a manual hydration commits the hydrated manifests anyway.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", proj.Name).Return(nil, errors.New("repo error")).Once()
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.ErrorContains(t, err, "repo error")
+	assert.Equal(t, "sha123", sha)
+	assert.Empty(t, hydratedSha)
+	assert.Empty(t, errs)
+}
+
 func TestHydrator_hydrate_DeDupe_Success(t *testing.T) {
 	t.Parallel()
 
@@ -1117,13 +1538,80 @@ func TestHydrator_hydrate_DeDupe_Success(t *testing.T) {
 
 	// Asserting .Once() confirms that we only make one call to repo-server to get the last hydrated DRY
 	// sha, and then we quit early.
-	d.On("GetRepoObjs", mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil).Once()
+	d.On("GetRepoObjs", mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, []string{"main"}, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
 	assert.Equal(t, "hydrated123", hydratedSha)
 	assert.Empty(t, errs)
 }
+
+func TestHydrator_hydrate_DeDupe_LayoutChanged(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	h := &Hydrator{dependencies: d, repoGetter: r}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.SyncSource.Layout = &v1alpha1.HydrateLayout{Type: v1alpha1.HydrateLayoutTypeFilePerResource}
+	app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+		LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:      "sha123",
+			HydratedSHA: "hydrated123",
+		},
+	}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+
+	// The dry SHA did not change, but the hydrated files must be rewritten with the new layout.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", proj.Name).Return(nil, errors.New("repo error")).Once()
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, _, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.ErrorContains(t, err, "repo error")
+	assert.Equal(t, "sha123", sha)
+	assert.Empty(t, hydratedSha)
+	assert.Empty(t, errs)
+}
+
+func TestHydrator_hydrate_DeDupe_DryRevisionsChanged(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	h := &Hydrator{dependencies: d, repoGetter: r}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.DrySource.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "oci://example.com/charts", Chart: "umbrella", TargetRevision: "1.x"},
+		{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"},
+	}
+	app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+		LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:       "sha123",
+			HydratedSHA:  "hydrated123",
+			DryRevisions: []string{"1.2.3", "sha123"},
+		},
+	}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+
+	// The dry SHA did not change, but a newer chart version was resolved, so hydration must not be skipped.
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "1.3.0"}, {Revision: "sha123"}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", proj.Name).Return(nil, errors.New("repo error")).Once()
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, dryRevisions, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.ErrorContains(t, err, "repo error")
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, []string{"1.3.0", "sha123"}, dryRevisions)
+	assert.Empty(t, hydratedSha)
+	assert.Empty(t, errs)
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/types"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 	mock "github.com/stretchr/testify/mock"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // NewDependencies creates a new instance of Dependencies. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -134,6 +136,61 @@ func (_c *Dependencies_GetHydratorCommitMessageTemplate_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetHydratorValidation provides a mock function for the type Dependencies
+func (_mock *Dependencies) GetHydratorValidation() (*settings.SourceHydratorValidation, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHydratorValidation")
+	}
+
+	var r0 *settings.SourceHydratorValidation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*settings.SourceHydratorValidation, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *settings.SourceHydratorValidation); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settings.SourceHydratorValidation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_GetHydratorValidation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratorValidation'
+type Dependencies_GetHydratorValidation_Call struct {
+	*mock.Call
+}
+
+// GetHydratorValidation is a helper method to define mock.On call
+func (_e *Dependencies_Expecter) GetHydratorValidation() *Dependencies_GetHydratorValidation_Call {
+	return &Dependencies_GetHydratorValidation_Call{Call: _e.mock.On("GetHydratorValidation")}
+}
+
+func (_c *Dependencies_GetHydratorValidation_Call) Run(run func()) *Dependencies_GetHydratorValidation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Dependencies_GetHydratorValidation_Call) Return(sourceHydratorValidation *settings.SourceHydratorValidation, err error) *Dependencies_GetHydratorValidation_Call {
+	_c.Call.Return(sourceHydratorValidation, err)
+	return _c
+}
+
+func (_c *Dependencies_GetHydratorValidation_Call) RunAndReturn(run func() (*settings.SourceHydratorValidation, error)) *Dependencies_GetHydratorValidation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProcessableAppProj provides a mock function for the type Dependencies
 func (_mock *Dependencies) GetProcessableAppProj(app *v1alpha1.Application) (*v1alpha1.AppProject, error) {
 	ret := _mock.Called(app)
@@ -252,35 +309,35 @@ func (_c *Dependencies_GetProcessableApps_Call) RunAndReturn(run func() (*v1alph
 }
 
 // GetRepoObjs provides a mock function for the type Dependencies
-func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	ret := _mock.Called(ctx, app, source, revision, project)
+func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	ret := _mock.Called(ctx, app, sources, revisions, project)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRepoObjs")
 	}
 
 	var r0 []*unstructured.Unstructured
-	var r1 *apiclient.ManifestResponse
+	var r1 []*apiclient.ManifestResponse
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)); ok {
-		return returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)); ok {
+		return returnFunc(ctx, app, sources, revisions, project)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
-		r0 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
+		r0 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*unstructured.Unstructured)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) *apiclient.ManifestResponse); ok {
-		r1 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) []*apiclient.ManifestResponse); ok {
+		r1 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*apiclient.ManifestResponse)
+			r1 = ret.Get(1).([]*apiclient.ManifestResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) error); ok {
-		r2 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, []string, *v1alpha1.AppProject) error); ok {
+		r2 = returnFunc(ctx, app, sources, revisions, project)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -295,14 +352,14 @@ type Dependencies_GetRepoObjs_Call struct {
 // GetRepoObjs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - app *v1alpha1.Application
-//   - source v1alpha1.ApplicationSource
-//   - revision string
+//   - sources []v1alpha1.ApplicationSource
+//   - revisions []string
 //   - project *v1alpha1.AppProject
-func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, source interface{}, revision interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
-	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, source, revision, project)}
+func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, sources interface{}, revisions interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
+	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, sources, revisions, project)}
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -312,13 +369,13 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 		if args[1] != nil {
 			arg1 = args[1].(*v1alpha1.Application)
 		}
-		var arg2 v1alpha1.ApplicationSource
+		var arg2 []v1alpha1.ApplicationSource
 		if args[2] != nil {
-			arg2 = args[2].(v1alpha1.ApplicationSource)
+			arg2 = args[2].([]v1alpha1.ApplicationSource)
 		}
-		var arg3 string
+		var arg3 []string
 		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg3 = args[3].([]string)
 		}
 		var arg4 *v1alpha1.AppProject
 		if args[4] != nil {
@@ -335,12 +392,12 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponse *apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
-	_c.Call.Return(unstructureds, manifestResponse, err)
+func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponses []*apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
+	_c.Call.Return(unstructureds, manifestResponses, err)
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -419,6 +476,190 @@ func (_c *Dependencies_GetWriteCredentials_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// IsDestinationPermitted provides a mock function for the type Dependencies
+func (_mock *Dependencies) IsDestinationPermitted(app *v1alpha1.Application, proj *v1alpha1.AppProject, namespace string) (bool, error) {
+	ret := _mock.Called(app, proj, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsDestinationPermitted")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.AppProject, string) (bool, error)); ok {
+		return returnFunc(app, proj, namespace)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Application, *v1alpha1.AppProject, string) bool); ok {
+		r0 = returnFunc(app, proj, namespace)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*v1alpha1.Application, *v1alpha1.AppProject, string) error); ok {
+		r1 = returnFunc(app, proj, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_IsDestinationPermitted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsDestinationPermitted'
+type Dependencies_IsDestinationPermitted_Call struct {
+	*mock.Call
+}
+
+// IsDestinationPermitted is a helper method to define mock.On call
+//   - app *v1alpha1.Application
+//   - proj *v1alpha1.AppProject
+//   - namespace string
+func (_e *Dependencies_Expecter) IsDestinationPermitted(app interface{}, proj interface{}, namespace interface{}) *Dependencies_IsDestinationPermitted_Call {
+	return &Dependencies_IsDestinationPermitted_Call{Call: _e.mock.On("IsDestinationPermitted", app, proj, namespace)}
+}
+
+func (_c *Dependencies_IsDestinationPermitted_Call) Run(run func(app *v1alpha1.Application, proj *v1alpha1.AppProject, namespace string)) *Dependencies_IsDestinationPermitted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Application
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Application)
+		}
+		var arg1 *v1alpha1.AppProject
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.AppProject)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_IsDestinationPermitted_Call) Return(b bool, err error) *Dependencies_IsDestinationPermitted_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Dependencies_IsDestinationPermitted_Call) RunAndReturn(run func(app *v1alpha1.Application, proj *v1alpha1.AppProject, namespace string) (bool, error)) *Dependencies_IsDestinationPermitted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsNamespaced provides a mock function for the type Dependencies
+func (_mock *Dependencies) IsNamespaced(app *v1alpha1.Application, gk schema.GroupKind) (bool, error) {
+	ret := _mock.Called(app, gk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsNamespaced")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Application, schema.GroupKind) (bool, error)); ok {
+		return returnFunc(app, gk)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Application, schema.GroupKind) bool); ok {
+		r0 = returnFunc(app, gk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*v1alpha1.Application, schema.GroupKind) error); ok {
+		r1 = returnFunc(app, gk)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_IsNamespaced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsNamespaced'
+type Dependencies_IsNamespaced_Call struct {
+	*mock.Call
+}
+
+// IsNamespaced is a helper method to define mock.On call
+//   - app *v1alpha1.Application
+//   - gk schema.GroupKind
+func (_e *Dependencies_Expecter) IsNamespaced(app interface{}, gk interface{}) *Dependencies_IsNamespaced_Call {
+	return &Dependencies_IsNamespaced_Call{Call: _e.mock.On("IsNamespaced", app, gk)}
+}
+
+func (_c *Dependencies_IsNamespaced_Call) Run(run func(app *v1alpha1.Application, gk schema.GroupKind)) *Dependencies_IsNamespaced_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Application
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Application)
+		}
+		var arg1 schema.GroupKind
+		if args[1] != nil {
+			arg1 = args[1].(schema.GroupKind)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_IsNamespaced_Call) Return(b bool, err error) *Dependencies_IsNamespaced_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Dependencies_IsNamespaced_Call) RunAndReturn(run func(app *v1alpha1.Application, gk schema.GroupKind) (bool, error)) *Dependencies_IsNamespaced_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersistAppHydrationConditions provides a mock function for the type Dependencies
+func (_mock *Dependencies) PersistAppHydrationConditions(orig *v1alpha1.Application, conditions []v1alpha1.ApplicationCondition) {
+	_mock.Called(orig, conditions)
+	return
+}
+
+// Dependencies_PersistAppHydrationConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistAppHydrationConditions'
+type Dependencies_PersistAppHydrationConditions_Call struct {
+	*mock.Call
+}
+
+// PersistAppHydrationConditions is a helper method to define mock.On call
+//   - orig *v1alpha1.Application
+//   - conditions []v1alpha1.ApplicationCondition
+func (_e *Dependencies_Expecter) PersistAppHydrationConditions(orig interface{}, conditions interface{}) *Dependencies_PersistAppHydrationConditions_Call {
+	return &Dependencies_PersistAppHydrationConditions_Call{Call: _e.mock.On("PersistAppHydrationConditions", orig, conditions)}
+}
+
+func (_c *Dependencies_PersistAppHydrationConditions_Call) Run(run func(orig *v1alpha1.Application, conditions []v1alpha1.ApplicationCondition)) *Dependencies_PersistAppHydrationConditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Application
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Application)
+		}
+		var arg1 []v1alpha1.ApplicationCondition
+		if args[1] != nil {
+			arg1 = args[1].([]v1alpha1.ApplicationCondition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydrationConditions_Call) Return() *Dependencies_PersistAppHydrationConditions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Dependencies_PersistAppHydrationConditions_Call) RunAndReturn(run func(orig *v1alpha1.Application, conditions []v1alpha1.ApplicationCondition)) *Dependencies_PersistAppHydrationConditions_Call {
+	_c.Run(run)
+	return _c
+}
+
 // PersistAppHydratorStatus provides a mock function for the type Dependencies
 func (_mock *Dependencies) PersistAppHydratorStatus(orig *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
 	_mock.Called(orig, newStatus)
@@ -0,0 +1,315 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestUpserterFactory builds a client able to open pull requests against the given repository, using the
+// repository's write credentials.
+type PullRequestUpserterFactory func(ctx context.Context, repo *appv1.Repository, config *appv1.HydratePullRequest) (pullrequest.PullRequestUpserter, error)
+
+// upsertPullRequest opens, or updates, the pull request promoting the hydrated manifests from the hydrateTo branch to
+// the sync branch. Errors are not returned but recorded in the returned status, since the hydrated commit has already
+// been pushed at this point.
+//
+// If the previously opened pull request was merged or closed in the meantime, a fresh pull request is opened for a
+// new hydrated commit. If the hydrated commit did not change, there is nothing left to promote and the final state of
+// the previous pull request is recorded instead.
+func (h *Hydrator) upsertPullRequest(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject, drySHA, hydratedSHA string) *appv1.HydratePullRequestStatus {
+	sourceHydrator := apps[0].Spec.SourceHydrator
+	previous := apps[0].Status.SourceHydrator.PullRequest
+	status := &appv1.HydratePullRequestStatus{
+		HydratedSHA: hydratedSHA,
+		UpdatedAt:   metav1.Now(),
+	}
+	if previous != nil && previous.HydratedSHA == hydratedSHA && (previous.Phase == appv1.HydratePullRequestPhaseMerged || previous.Phase == appv1.HydratePullRequestPhaseClosed) {
+		return previous.DeepCopy()
+	}
+
+	ctx := context.Background()
+	upserter, err := h.getPullRequestUpserter(ctx, apps, projects)
+	if err == nil && previous != nil && previous.Phase == appv1.HydratePullRequestPhaseOpen && previous.Number > 0 {
+		var state pullrequest.PullRequestState
+		state, err = upserter.GetState(ctx, previous.Number)
+		if err != nil {
+			err = fmt.Errorf("failed to get state of pull request %d: %w", previous.Number, err)
+		} else if state != pullrequest.PullRequestStateOpen {
+			logCtx.WithField("pullRequest", previous.URL).Infof("Pull request for hydrated manifests is %s", state)
+			if previous.HydratedSHA == hydratedSHA {
+				status.Phase = pullRequestPhase(state)
+				status.URL = previous.URL
+				status.Number = previous.Number
+				return status
+			}
+		}
+	}
+
+	var pr *pullrequest.PullRequest
+	if err == nil {
+		pr, err = upserter.Upsert(ctx, &pullrequest.UpsertOptions{
+			Title:        fmt.Sprintf("Promote hydrated manifests for %s", shortSHA(drySHA)),
+			Body:         getPullRequestBody(apps, drySHA, hydratedSHA),
+			Branch:       sourceHydrator.HydrateTo.TargetBranch,
+			TargetBranch: sourceHydrator.SyncSource.TargetBranch,
+		})
+	}
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to open pull request for hydrated manifests")
+		status.Phase = appv1.HydratePullRequestPhaseFailed
+		status.Message = fmt.Sprintf("Failed to open pull request from %q into %q: %v", sourceHydrator.HydrateTo.TargetBranch, sourceHydrator.SyncSource.TargetBranch, err)
+		// Keep pointing to the last known pull request, if any.
+		if previous != nil {
+			status.URL = previous.URL
+			status.Number = previous.Number
+		}
+		return status
+	}
+
+	logCtx.WithField("pullRequest", pr.URL).Info("Opened pull request for hydrated manifests")
+	status.Phase = appv1.HydratePullRequestPhaseOpen
+	status.URL = pr.URL
+	status.Number = pr.Number
+	return status
+}
+
+// refreshPullRequest checks the state of the open pull request of the application with the SCM provider, so that a
+// pull request merged or closed after the hydration is reported without waiting for the next hydration.
+func (h *Hydrator) refreshPullRequest(logCtx *log.Entry, origApp *appv1.Application) {
+	app := origApp.DeepCopy()
+	previous := app.Status.SourceHydrator.PullRequest
+	status := previous.DeepCopy()
+	status.UpdatedAt = metav1.Now()
+
+	state, err := h.getPullRequestState(app, previous.Number)
+	if err != nil {
+		// The pull request is only checked again after the next interval, to not hammer the SCM provider.
+		logCtx.WithError(err).Warn("Failed to refresh pull request for hydrated manifests")
+	} else if state != pullrequest.PullRequestStateOpen {
+		logCtx.WithField("pullRequest", previous.URL).Infof("Pull request for hydrated manifests is %s", state)
+		status.Phase = pullRequestPhase(state)
+	}
+	app.Status.SourceHydrator.PullRequest = status
+	h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+}
+
+func (h *Hydrator) getPullRequestState(app *appv1.Application, number int64) (pullrequest.PullRequestState, error) {
+	project, err := h.dependencies.GetProcessableAppProj(app)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+	ctx := context.Background()
+	upserter, err := h.getPullRequestUpserter(ctx, []*appv1.Application{app}, map[string]*appv1.AppProject{project.Name: project})
+	if err != nil {
+		return "", err
+	}
+	state, err := upserter.GetState(ctx, number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get state of pull request %d: %w", number, err)
+	}
+	return state, nil
+}
+
+func (h *Hydrator) getPullRequestUpserter(ctx context.Context, apps []*appv1.Application, projects map[string]*appv1.AppProject) (pullrequest.PullRequestUpserter, error) {
+	config := apps[0].Spec.SourceHydrator.HydrateTo.PullRequest
+	repoURL := apps[0].Spec.GetHydrateToSource().RepoURL
+
+	repo, err := h.dependencies.GetWriteCredentials(ctx, repoURL, hydrationProject(projects))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Hydrated manifests may be pushed without credentials, but opening a pull request always requires them.
+		return nil, fmt.Errorf("no write credentials configured for repository %q", repoURL)
+	}
+
+	factory := h.pullRequestUpserterFactory
+	if factory == nil {
+		factory = NewPullRequestUpserter
+	}
+	upserter, err := factory(ctx, repo, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client: %w", config.Provider, err)
+	}
+	return upserter, nil
+}
+
+// pullRequestPhase converts the state of a pull request reported by the SCM provider.
+func pullRequestPhase(state pullrequest.PullRequestState) appv1.HydratePullRequestPhase {
+	switch state {
+	case pullrequest.PullRequestStateMerged:
+		return appv1.HydratePullRequestPhaseMerged
+	case pullrequest.PullRequestStateClosed:
+		return appv1.HydratePullRequestPhaseClosed
+	default:
+		return appv1.HydratePullRequestPhaseOpen
+	}
+}
+
+// getPullRequestBody lists the applications whose hydrated manifests are promoted by the pull request.
+func getPullRequestBody(apps []*appv1.Application, drySHA, hydratedSHA string) string {
+	var sb strings.Builder
+	sb.WriteString("This pull request was opened by the Argo CD source hydrator.\n\n")
+	fmt.Fprintf(&sb, "* Dry source: %s@%s\n", apps[0].Spec.SourceHydrator.DrySource.RepoURL, drySHA)
+	fmt.Fprintf(&sb, "* Hydrated commit: %s\n\n", hydratedSHA)
+	sb.WriteString("| Application | Path |\n|---|---|\n")
+	for _, app := range apps {
+		fmt.Fprintf(&sb, "| %s | %s |\n", app.QualifiedName(), app.Spec.SourceHydrator.SyncSource.Path)
+	}
+	return sb.String()
+}
+
+// NewPullRequestUpserter returns a client for the SCM provider configured in the given pull request config. The owner
+// and name of the repository are derived from the repository URL.
+func NewPullRequestUpserter(ctx context.Context, repo *appv1.Repository, config *appv1.HydratePullRequest) (pullrequest.PullRequestUpserter, error) {
+	segments, err := repoPathSegments(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("cannot determine owner and name of repository %q", repo.Repo)
+	}
+	owner, name := segments[len(segments)-2], segments[len(segments)-1]
+
+	var svc pullrequest.PullRequestService
+	switch config.Provider {
+	case appv1.HydratePullRequestProviderGitHub:
+		if repo.GithubAppPrivateKey != "" {
+			svc, err = pullrequest.NewGithubAppService(github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}, config.API, owner, name, config.Labels)
+		} else {
+			svc, err = pullrequest.NewGithubService(repo.Password, config.API, owner, name, config.Labels)
+		}
+	case appv1.HydratePullRequestProviderGitLab:
+		svc, err = pullrequest.NewGitLabService(repo.Password, config.API, strings.Join(segments, "/"), config.Labels, "", "", repo.IsInsecure(), nil)
+	case appv1.HydratePullRequestProviderGitea:
+		svc, err = pullrequest.NewGiteaService(repo.Password, config.API, owner, name, nil, repo.IsInsecure())
+	case appv1.HydratePullRequestProviderBitbucketServer:
+		if repo.BearerToken != "" {
+			svc, err = pullrequest.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, config.API, owner, name, "", repo.IsInsecure(), nil)
+		} else {
+			svc, err = pullrequest.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, config.API, owner, name, "", repo.IsInsecure(), nil)
+		}
+	case appv1.HydratePullRequestProviderBitbucketCloud:
+		if repo.BearerToken != "" {
+			svc, err = pullrequest.NewBitbucketCloudServiceBearerToken(config.API, repo.BearerToken, owner, name)
+		} else {
+			svc, err = pullrequest.NewBitbucketCloudServiceBasicAuth(config.API, repo.Username, repo.Password, owner, name)
+		}
+	case appv1.HydratePullRequestProviderAzureDevOps:
+		// Azure DevOps repository paths have the form <organization>/<project>/_git/<repo>.
+		segments = trimAzureDevOpsSegments(segments)
+		if len(segments) < 3 {
+			return nil, fmt.Errorf("cannot determine organization, project and name of Azure DevOps repository %q", repo.Repo)
+		}
+		organization, project := segments[len(segments)-3], segments[len(segments)-2]
+		svc, err = pullrequest.NewAzureDevOpsService(repo.Password, config.API, organization, project, name, config.Labels)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", config.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	upserter, ok := svc.(pullrequest.PullRequestUpserter)
+	if !ok {
+		return nil, fmt.Errorf("provider %q does not support opening pull requests", config.Provider)
+	}
+	return upserter, nil
+}
+
+// repoPathSegments returns the path segments of a git repository URL, with the .git suffix removed. Both HTTP(S) and
+// SCP-like SSH URLs are supported.
+func repoPathSegments(repoURL string) ([]string, error) {
+	repoURL = strings.TrimSpace(repoURL)
+	if ok, _ := git.IsSSHURL(repoURL); ok && !strings.HasPrefix(repoURL, "ssh://") {
+		// Replace the first colon of git@host:owner/repo style URLs, so that net/url does not treat it as a port.
+		repoURL = "ssh://" + strings.Replace(repoURL, ":", "/", 1)
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repository URL: %w", err)
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if path == "" {
+		return nil, errors.New("repository URL has no path")
+	}
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	// Bitbucket Server serves HTTP(S) clones under /scm/<project>/<repo>.
+	if len(segments) > 0 && segments[0] == "scm" {
+		segments = segments[1:]
+	}
+	return segments, nil
+}
+
+// trimAzureDevOpsSegments removes the _git segment of Azure DevOps HTTPS URLs and the v3 prefix of Azure DevOps SSH URLs.
+func trimAzureDevOpsSegments(segments []string) []string {
+	var result []string
+	for i, segment := range segments {
+		if segment == "_git" || (i == 0 && segment == "v3") {
+			continue
+		}
+		result = append(result, segment)
+	}
+	return result
+}
+
+// hydrationProject returns the project shared by all the applications being hydrated together. If the applications
+// belong to different projects, an empty string is returned to indicate that global credentials are needed.
+func hydrationProject(projects map[string]*appv1.AppProject) string {
+	if len(projects) != 1 {
+		return ""
+	}
+	for p := range projects {
+		return p
+	}
+	return ""
+}
+
+// pullRequestFailedSince returns true if opening the pull request for the hydrated manifests failed more than the given
+// duration ago.
+func pullRequestFailedSince(app *appv1.Application, d time.Duration) bool {
+	if app.Spec.SourceHydrator.HydrateTo == nil || app.Spec.SourceHydrator.HydrateTo.PullRequest == nil {
+		return false
+	}
+	status := app.Status.SourceHydrator.PullRequest
+	return status != nil && status.Phase == appv1.HydratePullRequestPhaseFailed && metav1.Now().Sub(status.UpdatedAt.Time) > d
+}
+
+// pullRequestRefreshDue returns true if the application has an open pull request whose state was last checked more than
+// the given duration ago.
+func pullRequestRefreshDue(app *appv1.Application, d time.Duration) bool {
+	if app.Spec.SourceHydrator.HydrateTo == nil || app.Spec.SourceHydrator.HydrateTo.PullRequest == nil {
+		return false
+	}
+	status := app.Status.SourceHydrator.PullRequest
+	return status != nil && status.Phase == appv1.HydratePullRequestPhaseOpen && status.Number > 0 && metav1.Now().Sub(status.UpdatedAt.Time) > d
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,341 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	reposervermocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+type fakePullRequestUpserter struct {
+	opts     *pullrequest.UpsertOptions
+	pr       *pullrequest.PullRequest
+	err      error
+	state    pullrequest.PullRequestState
+	stateErr error
+}
+
+func (f *fakePullRequestUpserter) Upsert(_ context.Context, opts *pullrequest.UpsertOptions) (*pullrequest.PullRequest, error) {
+	f.opts = opts
+	return f.pr, f.err
+}
+
+func (f *fakePullRequestUpserter) GetState(_ context.Context, _ int64) (pullrequest.PullRequestState, error) {
+	if f.state == "" {
+		return pullrequest.PullRequestStateOpen, f.stateErr
+	}
+	return f.state, f.stateErr
+}
+
+func newTestAppWithPullRequest(name string) *v1alpha1.Application {
+	app := newTestApp(name)
+	app.Spec.SourceHydrator.HydrateTo.PullRequest = &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub}
+	return setTestAppPhase(app, v1alpha1.HydrateOperationPhaseHydrating)
+}
+
+func TestProcessHydrationQueueItem_PullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		upserter      *fakePullRequestUpserter
+		factoryErr    error
+		previous      *v1alpha1.HydratePullRequestStatus
+		expectedPhase v1alpha1.HydratePullRequestPhase
+		expectedURL   string
+		expectedMsg   string
+		expectUpsert  bool
+		expectNoCreds bool
+	}{
+		{
+			name:          "pull request opened",
+			upserter:      &fakePullRequestUpserter{pr: &pullrequest.PullRequest{Number: 42, URL: "https://example.com/repo/pull/42"}},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseOpen,
+			expectedURL:   "https://example.com/repo/pull/42",
+			expectUpsert:  true,
+		},
+		{
+			name:          "upsert fails and previous pull request is kept",
+			upserter:      &fakePullRequestUpserter{err: errors.New("rate limited")},
+			previous:      &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseOpen, Number: 41, URL: "https://example.com/repo/pull/41"},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseFailed,
+			expectedURL:   "https://example.com/repo/pull/41",
+			expectedMsg:   `Failed to open pull request from "hydrated-next" into "hydrated": rate limited`,
+			expectUpsert:  true,
+		},
+		{
+			name:          "previous pull request merged and fresh one opened for new hydrated commit",
+			upserter:      &fakePullRequestUpserter{state: pullrequest.PullRequestStateMerged, pr: &pullrequest.PullRequest{Number: 42, URL: "https://example.com/repo/pull/42"}},
+			previous:      &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseOpen, Number: 41, URL: "https://example.com/repo/pull/41", HydratedSHA: "old123"},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseOpen,
+			expectedURL:   "https://example.com/repo/pull/42",
+			expectUpsert:  true,
+		},
+		{
+			name:          "previous pull request closed without new hydrated commit",
+			upserter:      &fakePullRequestUpserter{state: pullrequest.PullRequestStateClosed},
+			previous:      &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseOpen, Number: 41, URL: "https://example.com/repo/pull/41", HydratedSHA: "def456"},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseClosed,
+			expectedURL:   "https://example.com/repo/pull/41",
+		},
+		{
+			name:          "previous pull request already merged without new hydrated commit",
+			upserter:      &fakePullRequestUpserter{},
+			previous:      &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseMerged, Number: 41, URL: "https://example.com/repo/pull/41", HydratedSHA: "def456"},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseMerged,
+			expectedURL:   "https://example.com/repo/pull/41",
+			expectNoCreds: true,
+		},
+		{
+			name:          "state of previous pull request cannot be retrieved",
+			upserter:      &fakePullRequestUpserter{stateErr: errors.New("not found")},
+			previous:      &v1alpha1.HydratePullRequestStatus{Phase: v1alpha1.HydratePullRequestPhaseOpen, Number: 41, URL: "https://example.com/repo/pull/41", HydratedSHA: "old123"},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseFailed,
+			expectedURL:   "https://example.com/repo/pull/41",
+			expectedMsg:   `Failed to open pull request from "hydrated-next" into "hydrated": failed to get state of pull request 41: not found`,
+		},
+		{
+			name:          "client cannot be created",
+			factoryErr:    errors.New("bad credentials"),
+			expectedPhase: v1alpha1.HydratePullRequestPhaseFailed,
+			expectedMsg:   `Failed to open pull request from "hydrated-next" into "hydrated": failed to create github client: bad credentials`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := mocks.NewDependencies(t)
+			r := mocks.NewRepoGetter(t)
+			rc := reposervermocks.NewRepoServerServiceClient(t)
+			cc := commitservermocks.NewCommitServiceClient(t)
+			app := newTestAppWithPullRequest("test-app")
+			app.Status.SourceHydrator.PullRequest = tc.previous
+			writeCreds := &v1alpha1.Repository{Repo: "https://example.com/repo", Password: "token"}
+
+			d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+			d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+			d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{Revision: "abc123"}}, nil).Once()
+			r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+			rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			if tc.expectNoCreds {
+				d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(writeCreds, nil).Once()
+			} else {
+				d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(writeCreds, nil).Twice()
+			}
+			d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+			cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil).Once()
+			var persistedStatus *v1alpha1.SourceHydratorStatus
+			d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+				persistedStatus = newStatus
+			}).Return().Once()
+			d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+
+			h := &Hydrator{
+				dependencies:    d,
+				repoGetter:      r,
+				commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+				repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+				pullRequestUpserterFactory: func(_ context.Context, repo *v1alpha1.Repository, config *v1alpha1.HydratePullRequest) (pullrequest.PullRequestUpserter, error) {
+					assert.Equal(t, writeCreds, repo)
+					assert.Equal(t, v1alpha1.HydratePullRequestProviderGitHub, config.Provider)
+					if tc.factoryErr != nil {
+						return nil, tc.factoryErr
+					}
+					return tc.upserter, nil
+				},
+			}
+
+			h.ProcessHydrationQueueItem(getHydrationQueueKey(app))
+
+			require.NotNil(t, persistedStatus)
+			assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, persistedStatus.CurrentOperation.Phase)
+			require.NotNil(t, persistedStatus.PullRequest)
+			assert.Equal(t, tc.expectedPhase, persistedStatus.PullRequest.Phase)
+			assert.Equal(t, tc.expectedURL, persistedStatus.PullRequest.URL)
+			assert.Equal(t, tc.expectedMsg, persistedStatus.PullRequest.Message)
+			assert.Equal(t, "def456", persistedStatus.PullRequest.HydratedSHA)
+			assert.Equal(t, persistedStatus.PullRequest, persistedStatus.CurrentOperation.PullRequest)
+			if tc.upserter != nil && !tc.expectUpsert {
+				assert.Nil(t, tc.upserter.opts)
+			}
+			if tc.expectUpsert {
+				require.NotNil(t, tc.upserter.opts)
+				assert.Equal(t, "hydrated-next", tc.upserter.opts.Branch)
+				assert.Equal(t, "hydrated", tc.upserter.opts.TargetBranch)
+				assert.Equal(t, "Promote hydrated manifests for abc123", tc.upserter.opts.Title)
+				assert.Contains(t, tc.upserter.opts.Body, "| default/test-app | app |")
+			}
+		})
+	}
+}
+
+func TestProcessHydrationQueueItem_PullRequestWithoutCredentials(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := newTestAppWithPullRequest("test-app")
+
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{Revision: "abc123"}}, nil).Once()
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Twice()
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil).Once()
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil).Once()
+	var persistedStatus *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		persistedStatus = newStatus
+	}).Return().Once()
+	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	h.ProcessHydrationQueueItem(getHydrationQueueKey(app))
+
+	require.NotNil(t, persistedStatus)
+	require.NotNil(t, persistedStatus.PullRequest)
+	assert.Equal(t, v1alpha1.HydratePullRequestPhaseFailed, persistedStatus.PullRequest.Phase)
+	assert.Contains(t, persistedStatus.PullRequest.Message, `no write credentials configured for repository "https://example.com/repo"`)
+}
+
+func TestRefreshPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		upserter      *fakePullRequestUpserter
+		expectedPhase v1alpha1.HydratePullRequestPhase
+	}{
+		{
+			name:          "pull request merged",
+			upserter:      &fakePullRequestUpserter{state: pullrequest.PullRequestStateMerged},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseMerged,
+		},
+		{
+			name:          "pull request still open",
+			upserter:      &fakePullRequestUpserter{},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseOpen,
+		},
+		{
+			name:          "state cannot be retrieved",
+			upserter:      &fakePullRequestUpserter{stateErr: errors.New("rate limited")},
+			expectedPhase: v1alpha1.HydratePullRequestPhaseOpen,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := mocks.NewDependencies(t)
+			app := setTestAppPhase(newTestAppWithPullRequest("test-app"), v1alpha1.HydrateOperationPhaseHydrated)
+			app.Status.SourceHydrator.PullRequest = &v1alpha1.HydratePullRequestStatus{
+				Phase:     v1alpha1.HydratePullRequestPhaseOpen,
+				Number:    41,
+				URL:       "https://example.com/repo/pull/41",
+				UpdatedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+			}
+			require.True(t, pullRequestRefreshDue(app, time.Minute))
+			require.False(t, pullRequestRefreshDue(app, 2*time.Hour))
+
+			d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil).Once()
+			d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(&v1alpha1.Repository{Repo: "https://example.com/repo", Password: "token"}, nil).Once()
+			var persistedStatus *v1alpha1.SourceHydratorStatus
+			d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+				persistedStatus = newStatus
+			}).Return().Once()
+			h := &Hydrator{
+				dependencies: d,
+				pullRequestUpserterFactory: func(_ context.Context, _ *v1alpha1.Repository, _ *v1alpha1.HydratePullRequest) (pullrequest.PullRequestUpserter, error) {
+					return tc.upserter, nil
+				},
+			}
+
+			h.refreshPullRequest(log.WithField("test", t.Name()), app)
+
+			require.NotNil(t, persistedStatus)
+			require.NotNil(t, persistedStatus.PullRequest)
+			assert.Equal(t, tc.expectedPhase, persistedStatus.PullRequest.Phase)
+			assert.Equal(t, "https://example.com/repo/pull/41", persistedStatus.PullRequest.URL)
+			assert.WithinDuration(t, time.Now(), persistedStatus.PullRequest.UpdatedAt.Time, time.Minute)
+			assert.Equal(t, v1alpha1.HydratePullRequestPhaseOpen, app.Status.SourceHydrator.PullRequest.Phase)
+		})
+	}
+}
+
+func TestRepoPathSegments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		repoURL  string
+		expected []string
+	}{
+		{repoURL: "https://github.com/argoproj/argo-cd.git", expected: []string{"argoproj", "argo-cd"}},
+		{repoURL: "https://github.com/argoproj/argo-cd/", expected: []string{"argoproj", "argo-cd"}},
+		{repoURL: "git@github.com:argoproj/argo-cd.git", expected: []string{"argoproj", "argo-cd"}},
+		{repoURL: "ssh://git@github.com:22/argoproj/argo-cd", expected: []string{"argoproj", "argo-cd"}},
+		{repoURL: "https://gitlab.com/group/subgroup/project.git", expected: []string{"group", "subgroup", "project"}},
+		{repoURL: "https://bitbucket.example.com/scm/proj/repo.git", expected: []string{"proj", "repo"}},
+		{repoURL: "https://dev.azure.com/org/project/_git/repo", expected: []string{"org", "project", "_git", "repo"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.repoURL, func(t *testing.T) {
+			t.Parallel()
+			segments, err := repoPathSegments(tc.repoURL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, segments)
+		})
+	}
+
+	_, err := repoPathSegments("https://github.com")
+	require.ErrorContains(t, err, "repository URL has no path")
+}
+
+func TestTrimAzureDevOpsSegments(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"org", "project", "repo"}, trimAzureDevOpsSegments([]string{"org", "project", "_git", "repo"}))
+	assert.Equal(t, []string{"org", "project", "repo"}, trimAzureDevOpsSegments([]string{"v3", "org", "project", "repo"}))
+}
+
+func TestNewPullRequestUpserter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewPullRequestUpserter(t.Context(), &v1alpha1.Repository{Repo: "https://example.com/org/repo"}, &v1alpha1.HydratePullRequest{Provider: "unknown"})
+		require.ErrorContains(t, err, `unsupported pull request provider "unknown"`)
+	})
+
+	t.Run("repository without owner", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewPullRequestUpserter(t.Context(), &v1alpha1.Repository{Repo: "https://example.com/repo"}, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub})
+		require.ErrorContains(t, err, "cannot determine owner and name")
+	})
+
+	t.Run("azure devops repository without project", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewPullRequestUpserter(t.Context(), &v1alpha1.Repository{Repo: "https://dev.azure.com/org/repo"}, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderAzureDevOps})
+		require.ErrorContains(t, err, "cannot determine organization, project and name")
+	})
+
+	t.Run("github", func(t *testing.T) {
+		t.Parallel()
+		upserter, err := NewPullRequestUpserter(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd", Password: "token"}, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub})
+		require.NoError(t, err)
+		assert.IsType(t, &pullrequest.GithubService{}, upserter)
+	})
+}
@@ -8,4 +8,7 @@ type HydrationQueueKey struct {
 	SourceRepoURL        string
 	SourceTargetRevision string
 	DestinationBranch    string
+	// DestinationRepoURL is the repository hydrated manifests are pushed to, if it is not SourceRepoURL, i.e. an OCI
+	// repository.
+	DestinationRepoURL string
 }
@@ -0,0 +1,187 @@
+package hydrator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	kubescheme "github.com/argoproj/gitops-engine/pkg/utils/kube/scheme"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoglob "github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// builtInTypeConverter converts the resources of the kinds built into Kubernetes to typed values, validating them
+// against the OpenAPI schema bundled with client-go.
+var builtInTypeConverter = managedfields.NewSchemeTypeConverter(scheme.Scheme, kubescheme.StaticParser())
+
+// PolicyViolationError is returned when the hydrated manifests of an application violate the policies checked by the
+// source hydrator before committing them.
+type PolicyViolationError struct {
+	Violations []string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return "hydrated manifests violate policies: " + strings.Join(e.Violations, "; ")
+}
+
+// validateManifests runs the configured checks on the hydrated manifests of the application. It returns a
+// PolicyViolationError if any of the manifests violates a policy.
+func (h *Hydrator) validateManifests(app *appv1.Application, proj *appv1.AppProject, objs []*unstructured.Unstructured) error {
+	if len(objs) == 0 {
+		return nil
+	}
+	validation, err := h.dependencies.GetHydratorValidation()
+	if err != nil {
+		return fmt.Errorf("failed to get hydrator validation settings: %w", err)
+	}
+
+	var violations []string
+	for _, obj := range objs {
+		resource := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+		var objViolations []string
+		if validation.Project {
+			projectViolations, err := h.validateProjectPermissions(app, proj, obj)
+			if err != nil {
+				return fmt.Errorf("failed to validate %s against project %q: %w", resource, proj.Name, err)
+			}
+			objViolations = append(objViolations, projectViolations...)
+		}
+		if validation.Schema {
+			objViolations = append(objViolations, validateSchema(obj)...)
+		}
+		policyViolations, err := validatePolicies(validation.Policies, obj)
+		if err != nil {
+			return fmt.Errorf("failed to validate %s: %w", resource, err)
+		}
+		objViolations = append(objViolations, policyViolations...)
+		for _, violation := range objViolations {
+			violations = append(violations, fmt.Sprintf("%s: %s", resource, violation))
+		}
+	}
+	if len(violations) > 0 {
+		return &PolicyViolationError{Violations: violations}
+	}
+	return nil
+}
+
+// validateProjectPermissions checks the resource against the allow and deny lists of kinds and the destinations of the
+// project, as is done when syncing. Resources of kinds the destination cluster does not know yet, e.g. custom
+// resources whose definition is part of the hydrated manifests, are only checked when syncing.
+func (h *Hydrator) validateProjectPermissions(app *appv1.Application, proj *appv1.AppProject, obj *unstructured.Unstructured) ([]string, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	namespaced, known, err := h.isNamespaced(app, gk)
+	if err != nil {
+		return nil, err
+	}
+	if !known {
+		return nil, nil
+	}
+	if !proj.IsGroupKindNamePermitted(gk, obj.GetName(), namespaced) {
+		return []string{fmt.Sprintf("resource %s:%s is not permitted in project %s", gk.Group, gk.Kind, proj.Name)}, nil
+	}
+	if !namespaced {
+		return nil, nil
+	}
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = app.Spec.Destination.Namespace
+	}
+	permitted, err := h.dependencies.IsDestinationPermitted(app, proj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !permitted {
+		return []string{fmt.Sprintf("namespace %v is not permitted in project '%s'", namespace, proj.Name)}, nil
+	}
+	return nil, nil
+}
+
+// isNamespaced returns whether resources of the kind are namespaced in the destination cluster of the application, and
+// whether the cluster knows the kind. Errors other than an unknown kind, e.g. a cluster cache failure, are returned.
+func (h *Hydrator) isNamespaced(app *appv1.Application, gk schema.GroupKind) (namespaced bool, known bool, err error) {
+	namespaced, err = h.dependencies.IsNamespaced(app, gk)
+	if apierrors.IsNotFound(err) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("failed to determine whether %s is namespaced: %w", gk.String(), err)
+	}
+	return namespaced, true, nil
+}
+
+// validateSchema validates resources of built-in kinds against the bundled OpenAPI schema. Resources of other kinds
+// are not validated.
+func validateSchema(obj *unstructured.Unstructured) []string {
+	if !scheme.Scheme.Recognizes(obj.GroupVersionKind()) {
+		return nil
+	}
+	_, err := builtInTypeConverter.ObjectToTyped(obj)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid %s: %v", obj.GroupVersionKind().String(), err)}
+	}
+	return nil
+}
+
+// validatePolicies runs the Lua policies matching the kind of the resource against it.
+func validatePolicies(policies []settings.SourceHydratorPolicy, obj *unstructured.Unstructured) ([]string, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	// Patterns are matched against group/kind with an explicit empty group for the core kinds, so that `*/*` matches
+	// all kinds. The bare kind is matched as well for the core kinds, e.g. `Service`.
+	keys := []string{gk.Group + "/" + gk.Kind}
+	if gk.Group == "" {
+		keys = append(keys, gk.Kind)
+	}
+	vm := lua.VM{}
+	var violations []string
+	for _, policy := range policies {
+		if len(policy.Kinds) > 0 && !slices.ContainsFunc(policy.Kinds, func(pattern string) bool {
+			return slices.ContainsFunc(keys, func(key string) bool {
+				return argoglob.Match(pattern, key)
+			})
+		}) {
+			continue
+		}
+		policyViolations, err := vm.ExecutePolicyLua(obj, policy.Script)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run policy %q: %w", policy.Name, err)
+		}
+		for _, violation := range policyViolations {
+			violations = append(violations, fmt.Sprintf("policy %q: %s", policy.Name, violation))
+		}
+	}
+	return violations, nil
+}
+
+// setHydrationPolicyConditions replaces the HydrationPolicyError conditions of the application with the ones reporting
+// the policy violations in the given hydration error. The conditions are cleared if the hydration succeeded or failed
+// for another reason.
+func (h *Hydrator) setHydrationPolicyConditions(app *appv1.Application, err error) {
+	conditions := policyViolationConditions(err)
+	if len(conditions) == 0 && len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionHydrationPolicyError: true})) == 0 {
+		return
+	}
+	h.dependencies.PersistAppHydrationConditions(app, conditions)
+}
+
+// policyViolationConditions returns the conditions reporting the policy violations in the given hydration error, if
+// any.
+func policyViolationConditions(err error) []appv1.ApplicationCondition {
+	var violationErr *PolicyViolationError
+	if !errors.As(err, &violationErr) {
+		return nil
+	}
+	return []appv1.ApplicationCondition{{
+		Type:    appv1.ApplicationConditionHydrationPolicyError,
+		Message: violationErr.Error(),
+	}}
+}
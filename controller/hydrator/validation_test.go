@@ -0,0 +1,298 @@
+package hydrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	reposervermocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func mustUnstructured(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &obj.Object))
+	return obj
+}
+
+const resourceLimitsPolicy = `local violations = {}
+for _, container in ipairs(obj.spec.template.spec.containers) do
+	if container.resources == nil or container.resources.limits == nil then
+		table.insert(violations, "container " .. container.name .. " has no resource limits")
+	end
+end
+return violations`
+
+func TestHydrator_validateManifests(t *testing.T) {
+	t.Parallel()
+
+	deployment := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook-ui
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: guestbook-ui
+        image: guestbook-ui:latest
+`
+	invalidDeployment := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook-ui
+spec:
+  replicas: "one"
+`
+	clusterRole := `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: guestbook
+`
+	service := `
+apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook-ui
+  namespace: kube-system
+`
+	customResource := `
+apiVersion: example.com/v1
+kind: Guestbook
+metadata:
+  name: guestbook
+spec:
+  replicas: "one"
+`
+
+	t.Run("no checks configured", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{}, nil)
+
+		err := h.validateManifests(newTestApp("test-app"), newTestProject(), []*unstructured.Unstructured{mustUnstructured(t, invalidDeployment)})
+		require.NoError(t, err)
+	})
+
+	t.Run("project", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestApp("test-app")
+		app.Spec.Destination.Namespace = "guestbook"
+		proj := newTestProject()
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Project: true}, nil)
+		d.EXPECT().IsNamespaced(app, schema.GroupKind{Group: "apps", Kind: "Deployment"}).Return(true, nil)
+		d.EXPECT().IsNamespaced(app, schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}).Return(false, nil)
+		d.EXPECT().IsNamespaced(app, schema.GroupKind{Kind: "Service"}).Return(true, nil)
+		d.EXPECT().IsNamespaced(app, schema.GroupKind{Group: "example.com", Kind: "Guestbook"}).Return(false, apierrors.NewNotFound(schema.GroupResource{Group: "example.com"}, ""))
+		// The deployment has no namespace, so it is deployed to the namespace of the destination.
+		d.EXPECT().IsDestinationPermitted(app, proj, "guestbook").Return(true, nil)
+		d.EXPECT().IsDestinationPermitted(app, proj, "kube-system").Return(false, nil)
+
+		err := h.validateManifests(app, proj, []*unstructured.Unstructured{
+			mustUnstructured(t, deployment),
+			mustUnstructured(t, clusterRole),
+			mustUnstructured(t, service),
+			mustUnstructured(t, customResource),
+		})
+		var violationErr *PolicyViolationError
+		require.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, []string{
+			"ClusterRole/guestbook: resource rbac.authorization.k8s.io:ClusterRole is not permitted in project test-project",
+			"Service/guestbook-ui: namespace kube-system is not permitted in project 'test-project'",
+		}, violationErr.Violations)
+	})
+
+	t.Run("project cluster cache error", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		app := newTestApp("test-app")
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Project: true}, nil)
+		d.EXPECT().IsNamespaced(app, schema.GroupKind{Group: "apps", Kind: "Deployment"}).Return(false, errors.New("cluster cache not synced"))
+
+		err := h.validateManifests(app, newTestProject(), []*unstructured.Unstructured{mustUnstructured(t, deployment)})
+		require.ErrorContains(t, err, "cluster cache not synced")
+		var violationErr *PolicyViolationError
+		assert.NotErrorAs(t, err, &violationErr)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Schema: true}, nil)
+
+		err := h.validateManifests(newTestApp("test-app"), newTestProject(), []*unstructured.Unstructured{
+			mustUnstructured(t, deployment),
+			mustUnstructured(t, invalidDeployment),
+			// Custom resources are not validated.
+			mustUnstructured(t, customResource),
+		})
+		var violationErr *PolicyViolationError
+		require.ErrorAs(t, err, &violationErr)
+		require.Len(t, violationErr.Violations, 1)
+		assert.Contains(t, violationErr.Violations[0], "Deployment/guestbook-ui: invalid apps/v1, Kind=Deployment")
+		assert.Contains(t, violationErr.Violations[0], ".spec.replicas")
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Policies: []settings.SourceHydratorPolicy{
+			{Name: "resource-limits", Kinds: []string{"apps/Deployment", "apps/StatefulSet"}, Script: resourceLimitsPolicy},
+			{Name: "no-kube-system", Script: `if obj.metadata.namespace == "kube-system" then return "must not use kube-system" end`},
+		}}, nil)
+
+		err := h.validateManifests(newTestApp("test-app"), newTestProject(), []*unstructured.Unstructured{
+			mustUnstructured(t, deployment),
+			mustUnstructured(t, service),
+		})
+		var violationErr *PolicyViolationError
+		require.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, []string{
+			`Deployment/guestbook-ui: policy "resource-limits": container guestbook-ui has no resource limits`,
+			`Service/guestbook-ui: policy "no-kube-system": must not use kube-system`,
+		}, violationErr.Violations)
+		assert.Equal(t, []v1alpha1.ApplicationCondition{{
+			Type:    v1alpha1.ApplicationConditionHydrationPolicyError,
+			Message: violationErr.Error(),
+		}}, policyViolationConditions(err))
+	})
+
+	t.Run("policies matching core kinds", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		noKubeSystem := `if obj.metadata.namespace == "kube-system" then return "must not use kube-system" end`
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Policies: []settings.SourceHydratorPolicy{
+			{Name: "all-kinds", Kinds: []string{"*/*"}, Script: noKubeSystem},
+			{Name: "bare-kind", Kinds: []string{"Service"}, Script: noKubeSystem},
+			{Name: "empty-group", Kinds: []string{"/Service"}, Script: noKubeSystem},
+			{Name: "apps-only", Kinds: []string{"apps/*"}, Script: noKubeSystem},
+		}}, nil)
+
+		err := h.validateManifests(newTestApp("test-app"), newTestProject(), []*unstructured.Unstructured{mustUnstructured(t, service)})
+		var violationErr *PolicyViolationError
+		require.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, []string{
+			`Service/guestbook-ui: policy "all-kinds": must not use kube-system`,
+			`Service/guestbook-ui: policy "bare-kind": must not use kube-system`,
+			`Service/guestbook-ui: policy "empty-group": must not use kube-system`,
+		}, violationErr.Violations)
+	})
+
+	t.Run("policy fails", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		h := &Hydrator{dependencies: d}
+		d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Policies: []settings.SourceHydratorPolicy{
+			{Name: "broken", Script: `return obj.spec.missing.field`},
+		}}, nil)
+
+		err := h.validateManifests(newTestApp("test-app"), newTestProject(), []*unstructured.Unstructured{mustUnstructured(t, service)})
+		require.ErrorContains(t, err, `failed to run policy "broken"`)
+		assert.Empty(t, policyViolationConditions(err))
+	})
+}
+
+func TestProcessHydrationQueueItem_PolicyViolation(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*unstructured.Unstructured{
+		mustUnstructured(t, "{apiVersion: v1, kind: Service, metadata: {name: guestbook-ui, namespace: kube-system}}"),
+	}, []*repoclient.ManifestResponse{{Revision: "abc123"}}, nil)
+	d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Policies: []settings.SourceHydratorPolicy{
+		{Name: "no-kube-system", Script: `if obj.metadata.namespace == "kube-system" then return "must not use kube-system" end`},
+	}}, nil)
+	h := &Hydrator{dependencies: d}
+
+	// The violations are reported as a condition, and nothing is committed.
+	d.EXPECT().PersistAppHydrationConditions(mock.Anything, []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionHydrationPolicyError,
+		Message: `hydrated manifests violate policies: Service/guestbook-ui: policy "no-kube-system": must not use kube-system`,
+	}}).Return().Once()
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, newStatus.CurrentOperation.Phase)
+		assert.Contains(t, newStatus.CurrentOperation.Message, "must not use kube-system")
+	}).Return().Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+}
+
+func TestProcessHydrationQueueItem_PolicyViolationResolved(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionHydrationPolicyError, Message: "hydrated manifests violate policies"}}
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*unstructured.Unstructured{
+		mustUnstructured(t, "{apiVersion: v1, kind: Service, metadata: {name: guestbook-ui, namespace: guestbook}}"),
+	}, []*repoclient.ManifestResponse{{Revision: "abc123"}}, nil)
+	d.EXPECT().GetHydratorValidation().Return(&settings.SourceHydratorValidation{Policies: []settings.SourceHydratorPolicy{
+		{Name: "no-kube-system", Script: `if obj.metadata.namespace == "kube-system" then return "must not use kube-system" end`},
+	}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "def456"}, nil)
+	h := &Hydrator{dependencies: d, repoGetter: r, commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc}, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Return().Once()
+	// The condition is cleared once the hydrated manifests comply with the policies.
+	d.EXPECT().PersistAppHydrationConditions(mock.Anything, []v1alpha1.ApplicationCondition(nil)).Return().Once()
+	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+}
+
+func TestProcessHydrationQueueItem_PolicyViolationClearedOnOtherFailure(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := setTestAppPhase(newTestApp("test-app"), v1alpha1.HydrateOperationPhaseHydrating)
+	app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionHydrationPolicyError, Message: "hydrated manifests violate policies"}}
+	hydrationKey := getHydrationQueueKey(app)
+	d.EXPECT().GetProcessableApps().Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("repo error"))
+	h := &Hydrator{dependencies: d}
+
+	// The stale violations are cleared, since the hydration failed for another reason.
+	d.EXPECT().PersistAppHydrationConditions(mock.Anything, []v1alpha1.ApplicationCondition(nil)).Return().Once()
+	d.EXPECT().PersistAppHydratorStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, newStatus *v1alpha1.SourceHydratorStatus) {
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, newStatus.CurrentOperation.Phase)
+		assert.Contains(t, newStatus.CurrentOperation.Message, "repo error")
+	}).Return().Once()
+
+	h.ProcessHydrationQueueItem(hydrationKey)
+}
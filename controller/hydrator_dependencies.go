@@ -8,9 +8,11 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	argoutil "github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 /**
@@ -31,10 +33,7 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
-func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	drySources := []appv1.ApplicationSource{drySource}
-	dryRevisions := []string{revision}
-
+func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySources []appv1.ApplicationSource, dryRevisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
@@ -48,6 +47,11 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 	//
 	// The long-term solution will probably be to persist the synced _dry_ revision and use that for the comparison.
 	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
+	if len(drySources) > 1 {
+		// Generate the manifests like those of a multi-source app, so that the repo-server resolves the `ref` sources.
+		app.Spec.SourceHydrator = nil
+		app.Spec.Sources = drySources
+	}
 
 	// FIXME: use cache and revision cache
 	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, false, project, false)
@@ -64,11 +68,11 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 		}
 	}
 
-	if len(resp) != 1 {
-		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+	if len(resp) != len(drySources) {
+		return nil, nil, fmt.Errorf("expected %d manifest responses, got %d", len(drySources), len(resp))
 	}
 
-	return objs, resp[0], nil
+	return objs, resp, nil
 }
 
 func (ctrl *ApplicationController) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
@@ -106,3 +110,35 @@ func (ctrl *ApplicationController) GetHydratorCommitMessageTemplate() (string, e
 
 	return sourceHydratorCommitMessageKey, nil
 }
+
+func (ctrl *ApplicationController) GetHydratorValidation() (*settings.SourceHydratorValidation, error) {
+	validation, err := ctrl.settingsMgr.GetSourceHydratorValidation()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sourceHydrator validation settings: %w", err)
+	}
+	return validation, nil
+}
+
+func (ctrl *ApplicationController) IsNamespaced(app *appv1.Application, gk schema.GroupKind) (bool, error) {
+	destCluster, err := argoutil.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		return false, fmt.Errorf("failed to get destination cluster: %w", err)
+	}
+	return ctrl.stateCache.IsNamespaced(destCluster, gk)
+}
+
+func (ctrl *ApplicationController) IsDestinationPermitted(app *appv1.Application, proj *appv1.AppProject, namespace string) (bool, error) {
+	destCluster, err := argoutil.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		return false, fmt.Errorf("failed to get destination cluster: %w", err)
+	}
+	return proj.IsDestinationPermitted(destCluster, namespace, func(project string) ([]*appv1.Cluster, error) {
+		return ctrl.db.GetProjectClusters(context.Background(), project)
+	})
+}
+
+func (ctrl *ApplicationController) PersistAppHydrationConditions(orig *appv1.Application, conditions []appv1.ApplicationCondition) {
+	status := orig.Status.DeepCopy()
+	status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionHydrationPolicyError: true})
+	ctrl.persistAppStatus(orig, status)
+}
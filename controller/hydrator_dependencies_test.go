@@ -47,7 +47,7 @@ func TestGetRepoObjs(t *testing.T) {
 	source := app.Spec.GetSource()
 	source.RepoURL = "oci://example.com/argo/argo-cd"
 
-	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, source, "abc123", &v1alpha1.AppProject{
+	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, []v1alpha1.ApplicationSource{source}, []string{"abc123"}, &v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "default",
 			Namespace: test.FakeArgoCDNamespace,
@@ -63,8 +63,8 @@ func TestGetRepoObjs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	assert.NotNil(t, resp)
-	assert.Equal(t, "abc123", resp.Revision)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "abc123", resp[0].Revision)
 	assert.Len(t, objs, 1)
 
 	annotations := objs[0].GetAnnotations()
@@ -121,3 +121,36 @@ func TestGetHydratorCommitMessageTemplate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, tmpl)
 }
+
+func TestGetHydratorValidation(t *testing.T) {
+	data := fakeData{
+		configMapData: map[string]string{
+			"sourceHydrator.validation": "project: true\nschema: true\n",
+		},
+	}
+
+	ctrl := newFakeControllerWithResync(t.Context(), &data, time.Minute, nil, errors.New("this should not be called"))
+
+	validation, err := ctrl.GetHydratorValidation()
+	require.NoError(t, err)
+	assert.Equal(t, &settings.SourceHydratorValidation{Project: true, Schema: true}, validation)
+}
+
+func TestIsDestinationPermitted(t *testing.T) {
+	ctrl := newFakeControllerWithResync(t.Context(), &fakeData{}, time.Minute, nil, errors.New("this should not be called"))
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: test.FakeDestNamespace}},
+		},
+	}
+
+	permitted, err := ctrl.IsDestinationPermitted(app, proj, test.FakeDestNamespace)
+	require.NoError(t, err)
+	assert.True(t, permitted)
+
+	permitted, err = ctrl.IsDestinationPermitted(app, proj, "kube-system")
+	require.NoError(t, err)
+	assert.False(t, permitted)
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 )
 
@@ -336,6 +337,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
+			OciSignatureKeys:                proj.GetOCISignatureKeys(),
 			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
 		})
@@ -497,12 +499,15 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 }
 
 // verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
-// revision.
+// revision. Revisions signed with SSH keys are verified against the SSH keys of the project.
 func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifestInfo *apiclient.ManifestResponse) []v1alpha1.ApplicationCondition {
 	now := metav1.Now()
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
 	// We need to have some data in the verification result to parse, otherwise there was no signature
 	if manifestInfo.VerifyResult != "" {
+		if sshResult, ok := sshsigning.ParseGitCommitVerification(manifestInfo.VerifyResult); ok {
+			return verifySSHSignature(project, sshResult)
+		}
 		verifyResult := gpg.ParseGitCommitVerification(manifestInfo.VerifyResult)
 		switch verifyResult.Result {
 		case gpg.VerifyResultGood:
@@ -535,6 +540,28 @@ func verifyGnuPGSignature(revision string, project *v1alpha1.AppProject, manifes
 	return conditions
 }
 
+// verifySSHSignature verifies the result of the verification of an SSH signature against the SSH keys of the project
+func verifySSHSignature(project *v1alpha1.AppProject, verifyResult sshsigning.VerifyResult) []v1alpha1.ApplicationCondition {
+	now := metav1.Now()
+	var msg string
+	switch verifyResult.Result {
+	case sshsigning.VerifyResultGood:
+		for _, k := range project.Spec.SSHSignatureKeys {
+			if k.Fingerprint == verifyResult.Fingerprint {
+				return nil
+			}
+		}
+		msg = fmt.Sprintf("Found good signature made with %s SSH key %s, but this key is not allowed in AppProject",
+			verifyResult.KeyType, verifyResult.Fingerprint)
+	case sshsigning.VerifyResultInvalid:
+		msg = fmt.Sprintf("Found signature made with %s SSH key %s, but verification result was invalid: '%s'",
+			verifyResult.KeyType, verifyResult.Fingerprint, verifyResult.Message)
+	default:
+		msg = "Found bad SSH signature: " + verifyResult.Message
+	}
+	return []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now}}
+}
+
 func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application) bool {
 	return ns != nil && ns.GetKind() == kubeutil.NamespaceKind && ns.GetName() == app.Spec.Destination.Namespace && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil
 }
@@ -576,7 +603,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 
 	// When signature keys are defined in the project spec, we need to verify the signature on the Git revision
-	verifySignature := len(project.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()
+	verifySignature := project.HasSignatureKeys() && gpg.IsGPGEnabled()
 
 	// do best effort loading live and target state to present as much information about app state as possible
 	failedToLoadObjs := false
@@ -671,6 +698,19 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	conditions = append(conditions, dedupConditions...)
 
+	if v1alpha1.TrackingMethod(trackingMethod) == v1alpha1.TrackingMethodAnnotationAndApplySet {
+		// the ApplySet parent is managed like any other resource of the application, so that it is updated by syncs and
+		// pruned with the application
+		parent := argo.NewApplySetParent(app.InstanceName(m.namespace), app.Spec.Destination.Namespace, targetObjs)
+		err = m.resourceTracking.SetAppInstance(parent, appLabelKey, app.InstanceName(m.namespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
+		if err != nil {
+			msg := "Failed to generate ApplySet parent: " + err.Error()
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+		} else {
+			targetObjs = append(targetObjs, parent)
+		}
+	}
+
 	for i := len(targetObjs) - 1; i >= 0; i-- {
 		targetObj := targetObjs[i]
 		gvk := targetObj.GroupVersionKind()
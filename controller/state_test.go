@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -352,6 +353,41 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestCompareAppStateApplySetParent checks that the ApplySet parent is managed like the other resources of the
+// application when resources are tracked with ApplySets
+func TestCompareAppStateApplySetParent(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	podBytes, _ := json.Marshal(pod)
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(podBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData: map[string]string{
+			"application.resourceTrackingMethod": string(v1alpha1.TrackingMethodAnnotationAndApplySet),
+		},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	sources := make([]v1alpha1.ApplicationSource, 0)
+	sources = append(sources, app.Spec.GetSource())
+	revisions := make([]string, 0)
+	revisions = append(revisions, "")
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	require.Len(t, compRes.resources, 2)
+	assert.Equal(t, "Pod", compRes.resources[0].Kind)
+	assert.Equal(t, "Secret", compRes.resources[1].Kind)
+	assert.Equal(t, argo.ApplySetParentName(app.InstanceName(test.FakeArgoCDNamespace)), compRes.resources[1].Name)
+	assert.Equal(t, test.FakeDestNamespace, compRes.resources[1].Namespace)
+	assert.Empty(t, app.Status.Conditions)
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -1283,6 +1319,52 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 	}
 }
 
+func TestVerifyGnuPGSignatureSSH(t *testing.T) {
+	const fingerprint = "SHA256:PI8johZKBD4JcrMk5xoNLg9sUfZwm3A8gY3x47ER2Ls"
+	sshProj := signedProj.DeepCopy()
+	sshProj.Spec.SignatureKeys = nil
+	sshProj.Spec.SSHSignatureKeys = []v1alpha1.SSHSignatureKey{{Fingerprint: fingerprint}}
+
+	t.Run("Good signature with allowed key", func(t *testing.T) {
+		conditions := verifyGnuPGSignature("abc123", sshProj, &apiclient.ManifestResponse{
+			VerifyResult: `Good "git" signature for jane@example.com with ED25519 key ` + fingerprint,
+		})
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("Good signature with key not allowed in project", func(t *testing.T) {
+		conditions := verifyGnuPGSignature("abc123", &signedProj, &apiclient.ManifestResponse{
+			VerifyResult: `Good "git" signature for jane@example.com with ED25519 key ` + fingerprint,
+		})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "this key is not allowed in AppProject")
+	})
+
+	t.Run("Untrusted key", func(t *testing.T) {
+		conditions := verifyGnuPGSignature("abc123", sshProj, &apiclient.ManifestResponse{
+			VerifyResult: "Good \"git\" signature with ED25519 key " + fingerprint + "\nNo principal matched.",
+		})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "verification result was invalid")
+	})
+
+	t.Run("Bad signature", func(t *testing.T) {
+		conditions := verifyGnuPGSignature("abc123", sshProj, &apiclient.ManifestResponse{
+			VerifyResult: "Could not verify signature.\nSignature verification failed: incorrect signature",
+		})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "incorrect signature")
+	})
+
+	t.Run("GnuPG signature with SSH keys only", func(t *testing.T) {
+		conditions := verifyGnuPGSignature("abc123", sshProj, &apiclient.ManifestResponse{
+			VerifyResult: mustReadFile("../util/gpg/testdata/good_signature.txt"),
+		})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "this key is not allowed in AppProject")
+	})
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := health.HealthStatusMissing
 	res := comparisonResult{
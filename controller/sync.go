@@ -133,6 +133,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 
 	syncOp := *state.Operation.Sync
+	syncOp.SyncStrategy = getSyncStrategy(app, &syncOp)
 
 	if state.SyncResult == nil {
 		state.SyncResult = newSyncOperationResult(app, syncOp)
@@ -354,6 +355,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
 	}
 
+	if syncOp.SyncStrategy != nil && syncOp.SyncStrategy.Batch != nil {
+		opts = append(opts, sync.WithBatches(syncBatchOf(syncOp.SyncStrategy.Batch), m.syncBatchGate(app, syncOp.SyncStrategy.Batch, state.SyncResult)))
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
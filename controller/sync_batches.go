@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// getSyncStrategy returns the sync strategy of the sync operation, which defaults to the sync strategy of the
+// application
+func getSyncStrategy(app *v1alpha1.Application, syncOp *v1alpha1.SyncOperation) *v1alpha1.SyncStrategy {
+	if syncOp.SyncStrategy == nil && app.Spec.SyncPolicy != nil {
+		return app.Spec.SyncPolicy.SyncStrategy
+	}
+	return syncOp.SyncStrategy
+}
+
+// syncBatchOf returns a function returning the batch of a resource of a batched sync. Resources not selected by any
+// batch are in batch zero, and resources selected by the i-th batch of the strategy are in batch i+1.
+func syncBatchOf(strategy *v1alpha1.SyncStrategyBatch) func(obj *unstructured.Unstructured) int {
+	return func(obj *unstructured.Unstructured) int {
+		for i := range strategy.Batches {
+			matches, err := strategy.Batches[i].Matches(obj.GroupVersionKind().GroupKind(), obj.GetLabels())
+			if err != nil {
+				log.Warnf("Failed to match resource %s/%s against batch: %v", obj.GetKind(), obj.GetName(), err)
+				continue
+			}
+			if matches {
+				return i + 1
+			}
+		}
+		return 0
+	}
+}
+
+// getSyncBatchResult returns the result of the batch with the given name in the given phase and wave, or nil if the sync
+// was not paused before the batch in that phase and wave yet
+func getSyncBatchResult(syncRes *v1alpha1.SyncOperationResult, phase common.SyncPhase, wave int, name string) *v1alpha1.SyncBatchResult {
+	for i := range syncRes.Batches {
+		if syncRes.Batches[i].SyncPhase == phase && syncRes.Batches[i].SyncWave == int64(wave) && syncRes.Batches[i].Name == name {
+			return &syncRes.Batches[i]
+		}
+	}
+	return nil
+}
+
+// syncBatchGate returns the gate holding the batches of a batched sync with a pause until the pause elapsed, and the
+// batches requiring approval until they are approved with the approve-sync-batch annotation of the application. The
+// state of paused batches is recorded in the sync result, for every phase and wave.
+func (m *appStateManager) syncBatchGate(app *v1alpha1.Application, strategy *v1alpha1.SyncStrategyBatch, syncRes *v1alpha1.SyncOperationResult) common.SyncBatchGate {
+	return func(phase common.SyncPhase, wave int, batch int) (bool, string, error) {
+		if batch < 1 || batch > len(strategy.Batches) {
+			return false, "", fmt.Errorf("unknown batch %d", batch)
+		}
+		syncBatch := strategy.Batches[batch-1]
+		pause, err := syncBatch.GetPause()
+		if err != nil {
+			return false, "", err
+		}
+		if pause == 0 && !syncBatch.Approval {
+			return true, "", nil
+		}
+
+		result := getSyncBatchResult(syncRes, phase, wave, syncBatch.Name)
+		if result == nil {
+			syncRes.Batches = append(syncRes.Batches, v1alpha1.SyncBatchResult{Name: syncBatch.Name, SyncPhase: phase, SyncWave: int64(wave), PausedAt: metav1.Now()})
+			result = &syncRes.Batches[len(syncRes.Batches)-1]
+		}
+		if result.ResumedAt != nil {
+			return true, "", nil
+		}
+		if resumeAt := result.PausedAt.Add(pause); time.Now().Before(resumeAt) {
+			return false, fmt.Sprintf("Sync paused before batch %s until %s", syncBatch.Name, resumeAt.UTC().Format(time.RFC3339)), nil
+		}
+		if syncBatch.Approval {
+			if app.Annotations[v1alpha1.AnnotationKeyApproveSyncBatch] != syncBatch.Name {
+				return false, "Sync waiting for approval of batch " + syncBatch.Name, nil
+			}
+			m.removeSyncBatchApproval(app)
+		}
+		now := metav1.Now()
+		result.ResumedAt = &now
+		return true, "", nil
+	}
+}
+
+// removeSyncBatchApproval removes the approve-sync-batch annotation of the application once the approval was consumed
+func (m *appStateManager) removeSyncBatchApproval(app *v1alpha1.Application) {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				v1alpha1.AnnotationKeyApproveSyncBatch: nil,
+			},
+		},
+	})
+	if err == nil {
+		_, err = m.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to remove sync batch approval: %v", err)
+	}
+}
+
+// syncBatchResumeAfter returns the duration after which the pause of the batch the sync is paused before elapses, or
+// nil if the sync is not paused
+func syncBatchResumeAfter(app *v1alpha1.Application, state *v1alpha1.OperationState) *time.Duration {
+	if state.Operation.Sync == nil || state.SyncResult == nil {
+		return nil
+	}
+	strategy := getSyncStrategy(app, state.Operation.Sync)
+	if strategy == nil || strategy.Batch == nil {
+		return nil
+	}
+	for _, result := range state.SyncResult.Batches {
+		if result.ResumedAt != nil {
+			continue
+		}
+		for _, syncBatch := range strategy.Batch.Batches {
+			if syncBatch.Name != result.Name {
+				continue
+			}
+			if pause, err := syncBatch.GetPause(); err == nil {
+				if resumeAfter := time.Until(result.PausedAt.Add(pause)); resumeAfter > 0 {
+					return &resumeAfter
+				}
+			}
+		}
+	}
+	return nil
+}
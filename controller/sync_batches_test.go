@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newBatchStrategy() *v1alpha1.SyncStrategyBatch {
+	return &v1alpha1.SyncStrategyBatch{
+		Batches: []v1alpha1.SyncBatch{
+			{Name: "canary", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}, Pause: "1h"},
+			{Name: "deployments", Kinds: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}}, Approval: true},
+			{Name: "config", Kinds: []metav1.GroupKind{{Kind: "ConfigMap"}}},
+		},
+	}
+}
+
+func TestGetSyncStrategy(t *testing.T) {
+	app := newFakeApp()
+	assert.Nil(t, getSyncStrategy(app, &v1alpha1.SyncOperation{}))
+
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncStrategy: &v1alpha1.SyncStrategy{Batch: newBatchStrategy()}}
+	assert.Equal(t, app.Spec.SyncPolicy.SyncStrategy, getSyncStrategy(app, &v1alpha1.SyncOperation{}))
+
+	hook := &v1alpha1.SyncStrategy{Hook: &v1alpha1.SyncStrategyHook{}}
+	assert.Equal(t, hook, getSyncStrategy(app, &v1alpha1.SyncOperation{SyncStrategy: hook}))
+}
+
+func TestSyncBatchOf(t *testing.T) {
+	batchOf := syncBatchOf(newBatchStrategy())
+
+	canary := test.NewDeployment()
+	canary.SetLabels(map[string]string{"canary": "true"})
+	assert.Equal(t, 1, batchOf(canary))
+	assert.Equal(t, 2, batchOf(test.NewDeployment()))
+	assert.Equal(t, 3, batchOf(test.NewConfigMap()))
+	assert.Equal(t, 0, batchOf(test.NewControllerRevision()))
+}
+
+func TestSyncBatchGate(t *testing.T) {
+	strategy := newBatchStrategy()
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	syncRes := &v1alpha1.SyncOperationResult{}
+	gate := manager.syncBatchGate(app, strategy, syncRes)
+
+	t.Run("Pause", func(t *testing.T) {
+		proceed, message, err := gate(synccommon.SyncPhaseSync, 0, 1)
+		require.NoError(t, err)
+		assert.False(t, proceed)
+		assert.Contains(t, message, "Sync paused before batch canary until ")
+		require.Len(t, syncRes.Batches, 1)
+		assert.Nil(t, syncRes.Batches[0].ResumedAt)
+
+		resumeAfter := syncBatchResumeAfter(app, &v1alpha1.OperationState{
+			Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{SyncStrategy: &v1alpha1.SyncStrategy{Batch: strategy}}},
+			SyncResult: syncRes,
+		})
+		require.NotNil(t, resumeAfter)
+		assert.InDelta(t, time.Hour, *resumeAfter, float64(time.Minute))
+
+		syncRes.Batches[0].PausedAt = metav1.NewTime(time.Now().Add(-time.Hour))
+		proceed, _, err = gate(synccommon.SyncPhaseSync, 0, 1)
+		require.NoError(t, err)
+		assert.True(t, proceed)
+		assert.NotNil(t, syncRes.Batches[0].ResumedAt)
+	})
+
+	t.Run("Approval", func(t *testing.T) {
+		proceed, message, err := gate(synccommon.SyncPhaseSync, 0, 2)
+		require.NoError(t, err)
+		assert.False(t, proceed)
+		assert.Equal(t, "Sync waiting for approval of batch deployments", message)
+
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeyApproveSyncBatch: "deployments"}
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(t.Context(), app, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		proceed, _, err = gate(synccommon.SyncPhaseSync, 0, 2)
+		require.NoError(t, err)
+		assert.True(t, proceed)
+
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, updatedApp.Annotations, v1alpha1.AnnotationKeyApproveSyncBatch)
+	})
+
+	t.Run("Pause in the next wave", func(t *testing.T) {
+		proceed, message, err := gate(synccommon.SyncPhaseSync, 1, 1)
+		require.NoError(t, err)
+		assert.False(t, proceed)
+		assert.Contains(t, message, "Sync paused before batch canary until ")
+		require.Len(t, syncRes.Batches, 3)
+		assert.Equal(t, v1alpha1.SyncBatchResult{Name: "canary", SyncPhase: synccommon.SyncPhaseSync, SyncWave: 1, PausedAt: syncRes.Batches[2].PausedAt}, syncRes.Batches[2])
+		assert.NotNil(t, syncRes.Batches[0].ResumedAt)
+	})
+
+	t.Run("No pause or approval", func(t *testing.T) {
+		proceed, _, err := gate(synccommon.SyncPhaseSync, 0, 3)
+		require.NoError(t, err)
+		assert.True(t, proceed)
+		assert.Len(t, syncRes.Batches, 3)
+	})
+
+	t.Run("Invalid pause", func(t *testing.T) {
+		strategy.Batches[2].Pause = "soon"
+		_, _, err := gate(synccommon.SyncPhaseSync, 0, 3)
+		require.ErrorContains(t, err, "invalid pause of batch config")
+	})
+}
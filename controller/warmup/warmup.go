@@ -0,0 +1,238 @@
+// Package warmup pre-fetches the Git repositories and revisions referenced by Applications into the repo server, so
+// that the first reconciliation of all Applications does not make the repo server clone all repositories at once.
+//
+// The warm-up runs in the application controller, which reads the Applications and the repository credentials, and
+// asks the repo server to fetch each revision. The repo server itself does not need access to the Kubernetes API. The
+// readiness of the repo server does not depend on the warm-up: instead, the controller only starts to reconcile
+// Applications once the initial warm-up has completed.
+package warmup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// Options configure the warm-up
+type Options struct {
+	// Interval is the interval in which the repositories are fetched again after the initial warm-up. The warm-up only
+	// runs once if it is zero.
+	Interval time.Duration
+	// HostParallelismLimit limits the number of revisions fetched concurrently from a single host. It is enforced by
+	// each controller shard on its own, so a host may be fetched from up to the limit times the number of shards
+	// concurrently. Any value less than 1 means no limit.
+	HostParallelismLimit int
+	// Timeout is the time after which the controller starts to reconcile Applications, even if the initial warm-up has
+	// not completed yet. Any value less than or equal to zero means no timeout.
+	Timeout time.Duration
+}
+
+// Scheduler runs the warm-up on startup and periodically afterwards
+type Scheduler struct {
+	appLister     applisters.ApplicationLister
+	filter        func(app *v1alpha1.Application) bool
+	db            db.ArgoDB
+	repoClientset apiclient.Clientset
+	opts          Options
+	initialDone   chan struct{}
+}
+
+// target is a revision of a repository to fetch
+type target struct {
+	repoURL  string
+	project  string
+	revision string
+}
+
+// NewScheduler returns a new warm-up scheduler. Only the Applications accepted by the filter are warmed up.
+func NewScheduler(appLister applisters.ApplicationLister, filter func(app *v1alpha1.Application) bool, db db.ArgoDB, repoClientset apiclient.Clientset, opts Options) *Scheduler {
+	return &Scheduler{
+		appLister:     appLister,
+		filter:        filter,
+		db:            db,
+		repoClientset: repoClientset,
+		opts:          opts,
+		initialDone:   make(chan struct{}),
+	}
+}
+
+// WaitForInitialWarmUp blocks until the initial warm-up has completed, the timeout has expired or the context is done
+func (s *Scheduler) WaitForInitialWarmUp(ctx context.Context) {
+	var timeout <-chan time.Time
+	if s.opts.Timeout > 0 {
+		timer := time.NewTimer(s.opts.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-s.initialDone:
+	case <-ctx.Done():
+	case <-timeout:
+		log.Warnf("Repository warm-up did not complete within %v, reconciling applications anyway", s.opts.Timeout)
+	}
+}
+
+// Run runs the warm-up until the context is done
+func (s *Scheduler) Run(ctx context.Context) {
+	s.WarmUp(ctx)
+	close(s.initialDone)
+	if s.opts.Interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.WarmUp(ctx)
+		}
+	}
+}
+
+// WarmUp fetches the revisions referenced by all Applications once. Failures are logged, but do not stop the warm-up.
+func (s *Scheduler) WarmUp(ctx context.Context) {
+	start := time.Now()
+	targets, err := s.getTargets()
+	if err != nil {
+		log.Errorf("Failed to list the repositories to warm up: %v", err)
+		return
+	}
+	if len(targets) == 0 {
+		return
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		log.Errorf("Failed to connect to the repo server to warm up repositories: %v", err)
+		return
+	}
+	defer utilio.Close(conn)
+	log.Infof("Warming up %d revisions of Git repositories", len(targets))
+
+	targetsByHost := map[string][]target{}
+	for _, t := range targets {
+		host := repoHost(t.repoURL)
+		targetsByHost[host] = append(targetsByHost[host], t)
+	}
+
+	var wg sync.WaitGroup
+	var failed atomic.Int64
+	for host, hostTargets := range targetsByHost {
+		parallelism := s.opts.HostParallelismLimit
+		if parallelism < 1 || parallelism > len(hostTargets) {
+			parallelism = len(hostTargets)
+		}
+		queue := make(chan target, len(hostTargets))
+		for _, t := range hostTargets {
+			queue <- t
+		}
+		close(queue)
+		for range parallelism {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for t := range queue {
+					if ctx.Err() != nil {
+						return
+					}
+					if err := s.warmUpTarget(ctx, repoClient, t); err != nil {
+						failed.Add(1)
+						log.WithFields(log.Fields{"repo": t.repoURL, "revision": t.revision, "host": host}).Warnf("Failed to warm up revision: %v", err)
+					}
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	log.Infof("Warmed up %d revisions of Git repositories in %v, %d failed", len(targets), time.Since(start), failed.Load())
+}
+
+func (s *Scheduler) warmUpTarget(ctx context.Context, repoClient apiclient.RepoServerServiceClient, t target) error {
+	repo, err := s.db.GetRepository(ctx, t.repoURL, t.project)
+	if err != nil {
+		return fmt.Errorf("failed to get repository: %w", err)
+	}
+	if repo.Type == "helm" || repo.Type == "oci" {
+		return nil
+	}
+	_, err = repoClient.WarmUp(ctx, &apiclient.WarmUpRequest{Repo: repo, Revision: t.revision})
+	return err
+}
+
+// getTargets returns the distinct Git repository revisions referenced by the sources of all Applications
+func (s *Scheduler) getTargets() ([]target, error) {
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	// The lister returns the Applications in random order, which would make the URL of the first Application win
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].QualifiedName() < apps[j].QualifiedName()
+	})
+
+	seen := map[string]bool{}
+	var targets []target
+	for _, app := range apps {
+		if s.filter != nil && !s.filter(app) {
+			continue
+		}
+		sources := app.Spec.GetSources()
+		if app.Spec.SourceHydrator != nil {
+			sources = append(sources, app.Spec.SourceHydrator.GetDrySource())
+		}
+		for _, source := range sources {
+			if source.RepoURL == "" || source.IsHelm() || source.IsOCI() {
+				continue
+			}
+			revision := source.TargetRevision
+			if revision == "" {
+				revision = "HEAD"
+			}
+			key := git.NormalizeGitURLAllowInvalid(source.RepoURL) + "@" + revision
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			targets = append(targets, target{repoURL: source.RepoURL, project: app.Spec.GetProject(), revision: revision})
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].repoURL != targets[j].repoURL {
+			return targets[i].repoURL < targets[j].repoURL
+		}
+		return targets[i].revision < targets[j].revision
+	})
+	return targets, nil
+}
+
+// repoHost returns the host of the given repository URL, which the parallelism of the warm-up is limited by
+func repoHost(repoURL string) string {
+	normalized := git.NormalizeGitURLAllowInvalid(repoURL)
+	if u, err := url.Parse(normalized); err == nil && u.Host != "" {
+		return u.Host
+	}
+	// SSH URLs are normalized to user@host/path
+	host, _, _ := strings.Cut(normalized, "/")
+	if _, h, found := strings.Cut(host, "@"); found {
+		return h
+	}
+	return host
+}
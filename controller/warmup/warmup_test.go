@@ -0,0 +1,186 @@
+package warmup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	reposervermocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+const testNamespace = "argocd"
+
+type fakeRepoServer struct {
+	lock        sync.Mutex
+	fetched     []string
+	active      map[string]int
+	maxActive   map[string]int
+	failingRepo string
+	delay       time.Duration
+}
+
+func (f *fakeRepoServer) WarmUp(_ context.Context, q *apiclient.WarmUpRequest, _ ...grpc.CallOption) (*apiclient.WarmUpResponse, error) {
+	host := repoHost(q.Repo.Repo)
+	f.lock.Lock()
+	f.fetched = append(f.fetched, q.Repo.Repo+"@"+q.Revision)
+	f.active[host]++
+	f.maxActive[host] = max(f.maxActive[host], f.active[host])
+	f.lock.Unlock()
+
+	time.Sleep(f.delay)
+
+	f.lock.Lock()
+	f.active[host]--
+	f.lock.Unlock()
+	if q.Repo.Repo == f.failingRepo {
+		return nil, errors.New("fetch failed")
+	}
+	return &apiclient.WarmUpResponse{}, nil
+}
+
+func newFakeRepoServer() *fakeRepoServer {
+	return &fakeRepoServer{active: map[string]int{}, maxActive: map[string]int{}}
+}
+
+func newTestRepoClientset(t *testing.T, server *fakeRepoServer) apiclient.Clientset {
+	t.Helper()
+	client := reposervermocks.NewRepoServerServiceClient(t)
+	client.EXPECT().WarmUp(mock.Anything, mock.Anything).RunAndReturn(server.WarmUp).Maybe()
+	return &reposervermocks.Clientset{RepoServerServiceClient: client}
+}
+
+func newTestApp(namespace, name string, spec v1alpha1.ApplicationSpec) *v1alpha1.Application {
+	return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Spec: spec}
+}
+
+func newTestLister(t *testing.T, apps ...*v1alpha1.Application) applisters.ApplicationLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, app := range apps {
+		require.NoError(t, indexer.Add(app))
+	}
+	return applisters.NewApplicationLister(indexer)
+}
+
+func newTestDB(t *testing.T) *dbmocks.ArgoDB {
+	t.Helper()
+	db := dbmocks.NewArgoDB(t)
+	db.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, url string, _ string) (*v1alpha1.Repository, error) {
+		return &v1alpha1.Repository{Repo: url}, nil
+	}).Maybe()
+	return db
+}
+
+func TestGetTargets(t *testing.T) {
+	apps := newTestLister(t,
+		newTestApp(testNamespace, "single", v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/repo.git", Path: "app"},
+		}),
+		newTestApp(testNamespace, "duplicate", v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/repo", TargetRevision: "HEAD"},
+		}),
+		newTestApp(testNamespace, "multi", v1alpha1.ApplicationSpec{
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://charts.example.com", Chart: "chart", TargetRevision: "1.0.0"},
+				{RepoURL: "git@gitlab.com:org/values.git", TargetRevision: "main", Ref: "values"},
+			},
+		}),
+		newTestApp(testNamespace, "hydrator", v1alpha1.ApplicationSpec{
+			SourceHydrator: &v1alpha1.SourceHydrator{
+				DrySource:  v1alpha1.DrySource{RepoURL: "https://github.com/org/dry", TargetRevision: "main", Path: "dry"},
+				SyncSource: v1alpha1.SyncSource{TargetBranch: "env/prod", Path: "prod"},
+			},
+		}),
+		newTestApp("other", "other-namespace", v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/other"},
+		}),
+	)
+
+	t.Run("Filtered applications", func(t *testing.T) {
+		filter := func(app *v1alpha1.Application) bool { return app.Namespace == testNamespace }
+		s := NewScheduler(apps, filter, newTestDB(t), newTestRepoClientset(t, newFakeRepoServer()), Options{})
+		targets, err := s.getTargets()
+		require.NoError(t, err)
+		assert.Equal(t, []target{
+			{repoURL: "git@gitlab.com:org/values.git", project: "default", revision: "main"},
+			{repoURL: "https://github.com/org/dry", project: "default", revision: "env/prod"},
+			{repoURL: "https://github.com/org/dry", project: "default", revision: "main"},
+			{repoURL: "https://github.com/org/repo", project: "default", revision: "HEAD"},
+		}, targets)
+	})
+
+	t.Run("All applications", func(t *testing.T) {
+		s := NewScheduler(apps, nil, newTestDB(t), newTestRepoClientset(t, newFakeRepoServer()), Options{})
+		targets, err := s.getTargets()
+		require.NoError(t, err)
+		assert.Contains(t, targets, target{repoURL: "https://github.com/org/other", project: "default", revision: "HEAD"})
+	})
+}
+
+func TestWarmUp(t *testing.T) {
+	var apps []*v1alpha1.Application
+	for _, revision := range []string{"a", "b", "c", "d"} {
+		apps = append(apps,
+			newTestApp(testNamespace, "github-"+revision, v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/repo", TargetRevision: revision}}),
+			newTestApp(testNamespace, "gitlab-"+revision, v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "git@gitlab.com:org/repo.git", TargetRevision: revision}}),
+		)
+	}
+
+	server := newFakeRepoServer()
+	server.delay = 10 * time.Millisecond
+	server.failingRepo = "git@gitlab.com:org/repo.git"
+	s := NewScheduler(newTestLister(t, apps...), nil, newTestDB(t), newTestRepoClientset(t, server), Options{HostParallelismLimit: 2})
+	s.WarmUp(t.Context())
+
+	assert.Len(t, server.fetched, 8)
+	assert.Equal(t, map[string]int{"github.com": 2, "gitlab.com": 2}, server.maxActive)
+}
+
+func TestWaitForInitialWarmUp(t *testing.T) {
+	t.Run("Initial warm-up completed", func(t *testing.T) {
+		s := NewScheduler(newTestLister(t), nil, newTestDB(t), newTestRepoClientset(t, newFakeRepoServer()), Options{})
+		s.Run(t.Context())
+		s.WaitForInitialWarmUp(t.Context())
+	})
+
+	t.Run("Timeout expired", func(t *testing.T) {
+		server := newFakeRepoServer()
+		server.delay = time.Second
+		apps := newTestLister(t, newTestApp(testNamespace, "app", v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/repo"}}))
+		s := NewScheduler(apps, nil, newTestDB(t), newTestRepoClientset(t, server), Options{Timeout: 10 * time.Millisecond})
+		done := make(chan struct{})
+		go func() {
+			s.Run(t.Context())
+			close(done)
+		}()
+		start := time.Now()
+		s.WaitForInitialWarmUp(t.Context())
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		<-done
+	})
+}
+
+func TestRepoHost(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/org/repo.git":       "github.com",
+		"https://user@GitHub.com/org/repo":      "github.com",
+		"git@gitlab.com:org/repo.git":           "gitlab.com",
+		"ssh://git@git.example.com:2222/org/re": "git.example.com:2222",
+	}
+	for repoURL, host := range tests {
+		assert.Equal(t, host, repoHost(repoURL), repoURL)
+	}
+}
@@ -0,0 +1,108 @@
+// Package applyset implements the labels and annotations of ApplySets (KEP-3659), which record the set of resources
+// applied together on a parent object, so that tools other than the one applying the set can see and prune it.
+package applyset
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// LabelKeyPartOf is the label of the members of an ApplySet, whose value is the ID of the ApplySet
+	LabelKeyPartOf = "applyset.kubernetes.io/part-of"
+	// LabelKeyID is the label of the parent object of an ApplySet, whose value is the ID of the ApplySet
+	LabelKeyID = "applyset.kubernetes.io/id"
+	// AnnotationKeyTooling is the annotation of the parent object naming the tool managing the ApplySet, in the format
+	// <tool>/<version>. Tools must not modify ApplySets managed by other tools.
+	AnnotationKeyTooling = "applyset.kubernetes.io/tooling"
+	// AnnotationKeyContainsGroupKinds is the annotation of the parent object listing the group kinds of the members
+	AnnotationKeyContainsGroupKinds = "applyset.kubernetes.io/contains-group-kinds"
+	// AnnotationKeyAdditionalNamespaces is the annotation of the parent object listing the namespaces of the members
+	// other than the namespace of the parent object
+	AnnotationKeyAdditionalNamespaces = "applyset.kubernetes.io/additional-namespaces"
+)
+
+// ID returns the ID of the ApplySet with the given parent object, which is
+// applyset-<base64url(sha256(<name>.<namespace>.<kind>.<group>))>-v1
+func ID(name, namespace string, gk schema.GroupKind) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{name, namespace, gk.Kind, gk.Group}, ".")))
+	return fmt.Sprintf("applyset-%s-v1", base64.RawURLEncoding.EncodeToString(hash[:]))
+}
+
+// SecretID returns the ID of the ApplySet whose parent object is the Secret with the given name and namespace
+func SecretID(name, namespace string) string {
+	return ID(name, namespace, schema.GroupKind{Kind: "Secret"})
+}
+
+// IsParent returns whether the object is the parent object of an ApplySet
+func IsParent(obj *unstructured.Unstructured) bool {
+	_, ok := obj.GetLabels()[LabelKeyID]
+	return ok
+}
+
+// SetPartOf labels the object as member of the ApplySet with the given ID
+func SetPartOf(obj *unstructured.Unstructured, id string) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[LabelKeyPartOf] = id
+	obj.SetLabels(labels)
+}
+
+// RemovePartOf removes the ApplySet membership label of the object
+func RemovePartOf(obj *unstructured.Unstructured) {
+	labels := obj.GetLabels()
+	if _, ok := labels[LabelKeyPartOf]; !ok {
+		return
+	}
+	delete(labels, LabelKeyPartOf)
+	obj.SetLabels(labels)
+}
+
+// NewSecretParent returns a Secret parent object with the given name and namespace of an ApplySet consisting of the
+// given members. The group kinds and namespaces of the members are recorded on the parent object.
+func NewSecretParent(name, namespace, tooling string, members []*unstructured.Unstructured) *unstructured.Unstructured {
+	groupKinds := map[string]bool{}
+	namespaces := map[string]bool{}
+	for _, member := range members {
+		if member == nil || IsParent(member) {
+			continue
+		}
+		gk := member.GroupVersionKind().GroupKind()
+		groupKinds[gk.String()] = true
+		if ns := member.GetNamespace(); ns != "" && ns != namespace {
+			namespaces[ns] = true
+		}
+	}
+
+	parent := &unstructured.Unstructured{}
+	parent.SetAPIVersion("v1")
+	parent.SetKind("Secret")
+	parent.SetName(name)
+	parent.SetNamespace(namespace)
+	parent.SetLabels(map[string]string{LabelKeyID: SecretID(name, namespace)})
+	parent.SetAnnotations(map[string]string{
+		AnnotationKeyTooling:              tooling,
+		AnnotationKeyContainsGroupKinds:   joinSorted(groupKinds),
+		AnnotationKeyAdditionalNamespaces: joinSorted(namespaces),
+	})
+	parent.Object["type"] = string(corev1.SecretTypeOpaque)
+	return parent
+}
+
+func joinSorted(set map[string]bool) string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
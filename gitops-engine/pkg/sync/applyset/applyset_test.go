@@ -0,0 +1,61 @@
+package applyset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
+)
+
+func TestID(t *testing.T) {
+	assert.Equal(t, "applyset-XPS7DQcglYD3_BOTiwpLtirwmT9y1Q06wbJ7TyrjGmY-v1", ID("my-set", "my-ns", schema.GroupKind{Kind: "Secret"}))
+	assert.Equal(t, ID("my-set", "my-ns", schema.GroupKind{Kind: "Secret"}), SecretID("my-set", "my-ns"))
+	assert.NotEqual(t, SecretID("my-set", "my-ns"), SecretID("my-set", "other-ns"))
+}
+
+func TestSetPartOf(t *testing.T) {
+	pod := testingutils.NewPod()
+	SetPartOf(pod, "my-id")
+	assert.Equal(t, "my-id", pod.GetLabels()[LabelKeyPartOf])
+
+	RemovePartOf(pod)
+	assert.NotContains(t, pod.GetLabels(), LabelKeyPartOf)
+}
+
+func TestNewSecretParent(t *testing.T) {
+	pod := testingutils.NewPod()
+	pod.SetNamespace("my-ns")
+	service := testingutils.NewService()
+	service.SetNamespace("other-ns")
+	deployment := testingutils.Unstructured(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  namespace: my-ns
+`)
+	clusterRole := testingutils.Unstructured(`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: my-cluster-role
+`)
+
+	parent := NewSecretParent("my-set", "my-ns", "argocd/v3", []*unstructured.Unstructured{pod, service, deployment, clusterRole, nil})
+
+	assert.Equal(t, "Secret", parent.GetKind())
+	assert.Equal(t, "my-set", parent.GetName())
+	assert.Equal(t, "my-ns", parent.GetNamespace())
+	assert.True(t, IsParent(parent))
+	assert.Equal(t, map[string]string{LabelKeyID: SecretID("my-set", "my-ns")}, parent.GetLabels())
+	assert.Equal(t, map[string]string{
+		AnnotationKeyTooling:              "argocd/v3",
+		AnnotationKeyContainsGroupKinds:   "ClusterRole.rbac.authorization.k8s.io,Deployment.apps,Pod,Service",
+		AnnotationKeyAdditionalNamespaces: "other-ns",
+	}, parent.GetAnnotations())
+
+	// parents are not members of other ApplySets
+	other := NewSecretParent("other-set", "my-ns", "argocd/v3", []*unstructured.Unstructured{parent})
+	assert.Empty(t, other.GetAnnotations()[AnnotationKeyContainsGroupKinds])
+}
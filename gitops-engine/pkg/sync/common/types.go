@@ -64,6 +64,12 @@ type SyncPhase string
 // executed, and whether or not that wave was the final one.
 type SyncWaveHook func(phase SyncPhase, wave int, final bool) error
 
+// SyncBatchGate is a callback function which will be invoked before the resources of a batch, other than the first
+// batch, are synced during a sync operation. The callback indicates the phase and wave of the resources of the batch,
+// since every phase and wave is synced in batches. It returns whether the batch can be synced, and otherwise a message
+// describing why the sync of the batch is held.
+type SyncBatchGate func(phase SyncPhase, wave int, batch int) (proceed bool, message string, err error)
+
 const (
 	SyncPhasePreSync  = "PreSync"
 	SyncPhaseSync     = "Sync"
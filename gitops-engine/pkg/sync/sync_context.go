@@ -182,6 +182,16 @@ func WithSyncWaveHook(syncWaveHook common.SyncWaveHook) SyncOpt {
 	}
 }
 
+// WithBatches syncs the resources of every phase and wave in batches, one batch after the other. The batchOf function
+// returns the batch of a resource, batches being synced in ascending order, and batchGate is invoked before every batch
+// other than batch zero is synced. Hooks are always in batch zero.
+func WithBatches(batchOf func(obj *unstructured.Unstructured) int, batchGate common.SyncBatchGate) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.batchOf = batchOf
+		ctx.batchGate = batchGate
+	}
+}
+
 func WithReplace(replace bool) SyncOpt {
 	return func(ctx *syncContext) {
 		ctx.replace = replace
@@ -386,6 +396,9 @@ type syncContext struct {
 
 	syncWaveHook common.SyncWaveHook
 
+	batchOf   func(obj *unstructured.Unstructured) int
+	batchGate common.SyncBatchGate
+
 	applyOutOfSyncOnly bool
 	// stores whether the resource is modified or not
 	modificationResult map[kubeutil.ResourceKey]bool
@@ -560,18 +573,34 @@ func (sc *syncContext) Sync() {
 		return
 	}
 
-	// remove any tasks not in this wave
+	// remove any tasks not in this wave and batch
 	phase := tasks.phase()
 	wave := tasks.wave()
-	finalWave := phase == tasks.lastPhase() && wave == tasks.lastWave()
+	batch := tasks.batch()
+	finalWave := phase == tasks.lastPhase() && wave == tasks.lastWave() && batch == tasks.lastBatch()
 
 	// if it is the last phase/wave and the only remaining tasks are non-hooks, the we are successful
 	// EVEN if those objects subsequently degraded
 	// This handles the common case where neither hooks or waves are used and a sync equates to simply an (asynchronous) kubectl apply of manifests, which succeeds immediately.
-	remainingTasks := tasks.Filter(func(t *syncTask) bool { return t.phase != phase || wave != t.wave() || t.isHook() })
+	remainingTasks := tasks.Filter(func(t *syncTask) bool {
+		return t.phase != phase || wave != t.wave() || batch != t.batch || t.isHook()
+	})
 
-	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
-	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
+	sc.log.WithValues("phase", phase, "wave", wave, "batch", batch, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase, wave and batch")
+	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave && t.batch == batch })
+
+	if sc.batchGate != nil && batch != 0 {
+		proceed, message, err := sc.batchGate(phase, wave, batch)
+		if err != nil {
+			sc.setOperationPhase(common.OperationFailed, fmt.Sprintf("SyncBatchGate failed: %v", err))
+			sc.log.Error(err, "SyncBatchGate failed")
+			return
+		}
+		if !proceed {
+			sc.setOperationPhase(common.OperationRunning, message)
+			return
+		}
+	}
 
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
@@ -957,6 +986,14 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	if sc.batchOf != nil {
+		for _, task := range tasks {
+			if !task.isHook() {
+				task.batch = sc.batchOf(task.obj())
+			}
+		}
+	}
+
 	tasks.Sort()
 
 	// finally enrich tasks with the result
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -1775,6 +1776,131 @@ func Test_setRunningPhase_pendingDeletion(t *testing.T) {
 	assert.Equal(t, "waiting for deletion of /Pod/my-pod and 2 more resources", sc.message)
 }
 
+func TestSyncBatches(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	podA := testingutils.NewPod()
+	podA.SetName("pod-a")
+	podA.SetLabels(map[string]string{"batch": "1"})
+	podB := testingutils.NewPod()
+	podB.SetName("pod-b")
+	podB.SetLabels(map[string]string{"batch": "2"})
+	podC := testingutils.NewPod()
+	podC.SetName("pod-c")
+
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil, nil},
+		Target: []*unstructured.Unstructured{podA, podB, podC},
+	})
+	proceed := true
+	var gatedBatches []int
+	WithBatches(func(obj *unstructured.Unstructured) int {
+		batch, _ := strconv.Atoi(obj.GetLabels()["batch"])
+		return batch
+	}, func(phase synccommon.SyncPhase, wave int, batch int) (bool, string, error) {
+		assert.EqualValues(t, synccommon.SyncPhaseSync, phase)
+		assert.Equal(t, 0, wave)
+		gatedBatches = append(gatedBatches, batch)
+		return proceed, "paused before batch " + strconv.Itoa(batch), nil
+	})(syncCtx)
+
+	syncedPods := func() []string {
+		_, _, results := syncCtx.GetState()
+		var names []string
+		for _, res := range results {
+			names = append(names, res.ResourceKey.Name)
+		}
+		return names
+	}
+	completePods := func() {
+		_, _, results := syncCtx.GetState()
+		for _, res := range results {
+			res.HookPhase = synccommon.OperationSucceeded
+			syncCtx.syncRes[resourceResultKey(res.ResourceKey, synccommon.SyncPhaseSync)] = res
+		}
+	}
+
+	// resources without batch are synced first
+	syncCtx.Sync()
+	phase, _, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, []string{"pod-c"}, syncedPods())
+	assert.Empty(t, gatedBatches)
+
+	// the next batch is only synced once the previous batches completed
+	syncCtx.Sync()
+	assert.Equal(t, []string{"pod-c"}, syncedPods())
+	completePods()
+	syncCtx.Sync()
+	assert.Equal(t, []string{"pod-c", "pod-a"}, syncedPods())
+	assert.Equal(t, []int{1}, gatedBatches)
+
+	// the gate holds the batch
+	completePods()
+	proceed = false
+	syncCtx.Sync()
+	phase, message, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Equal(t, "paused before batch 2", message)
+	assert.Equal(t, []string{"pod-c", "pod-a"}, syncedPods())
+
+	proceed = true
+	syncCtx.Sync()
+	phase, _, _ = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	assert.Equal(t, []string{"pod-c", "pod-a", "pod-b"}, syncedPods())
+	assert.Equal(t, []int{1, 2, 2}, gatedBatches)
+}
+
+func TestSyncBatches_Waves(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	podA := testingutils.NewPod()
+	podA.SetName("pod-a")
+	podB := testingutils.NewPod()
+	podB.SetName("pod-b")
+	podB.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{podA, podB},
+	})
+	var gatedWaves []int
+	WithBatches(func(_ *unstructured.Unstructured) int {
+		return 1
+	}, func(_ synccommon.SyncPhase, wave int, _ int) (bool, string, error) {
+		gatedWaves = append(gatedWaves, wave)
+		return true, "", nil
+	})(syncCtx)
+
+	// the batch is gated in every wave it has resources in
+	syncCtx.Sync()
+	assert.Equal(t, []int{0}, gatedWaves)
+	_, _, results := syncCtx.GetState()
+	for _, res := range results {
+		res.HookPhase = synccommon.OperationSucceeded
+		syncCtx.syncRes[resourceResultKey(res.ResourceKey, synccommon.SyncPhaseSync)] = res
+	}
+	syncCtx.Sync()
+	assert.Equal(t, []int{0, 1}, gatedWaves)
+}
+
+func TestSyncBatches_GateError(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false), WithBatches(func(_ *unstructured.Unstructured) int {
+		return 1
+	}, func(_ synccommon.SyncPhase, _ int, _ int) (bool, string, error) {
+		return false, "", errors.New("invalid batch")
+	}))
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{testingutils.NewPod()},
+	})
+
+	syncCtx.Sync()
+	phase, message, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "SyncBatchGate failed: invalid batch", message)
+	assert.Empty(t, results)
+}
+
 func TestSyncWaveHook(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
 	pod1 := testingutils.NewPod()
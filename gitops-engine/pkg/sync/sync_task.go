@@ -24,6 +24,8 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	// batch is the batch of the task within its phase and wave
+	batch int
 }
 
 func ternary(val bool, a, b string) string {
@@ -34,8 +36,8 @@ func ternary(val bool, a, b string) string {
 }
 
 func (t *syncTask) String() string {
-	return fmt.Sprintf("%s/%d %s %s/%s:%s/%s %s->%s (%s,%s,%s)",
-		t.phase, t.wave(),
+	return fmt.Sprintf("%s/%d/%d %s %s/%s:%s/%s %s->%s (%s,%s,%s)",
+		t.phase, t.wave(), t.batch,
 		ternary(t.isHook(), "hook", "resource"), t.group(), t.kind(), t.namespace(), t.name(),
 		ternary(t.liveObj != nil, "obj", "nil"), ternary(t.targetObj != nil, "obj", "nil"),
 		t.syncStatus, t.operationState, t.message,
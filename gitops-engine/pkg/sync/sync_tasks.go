@@ -7,6 +7,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/gitops-engine/pkg/sync/applyset"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
@@ -80,8 +81,9 @@ func (s syncTasks) Swap(i, j int) {
 // order is
 // 1. phase
 // 2. wave
-// 3. kind
-// 4. name
+// 3. batch
+// 4. kind
+// 5. name
 func (s syncTasks) Less(i, j int) bool {
 	tA := s[i]
 	tB := s[j]
@@ -96,6 +98,11 @@ func (s syncTasks) Less(i, j int) bool {
 		return d < 0
 	}
 
+	d = tA.batch - tB.batch
+	if d != 0 {
+		return d < 0
+	}
+
 	a := tA.obj()
 	b := tB.obj()
 
@@ -111,6 +118,14 @@ func (s syncTasks) Less(i, j int) bool {
 
 func (s syncTasks) Sort() {
 	sort.Sort(s)
+	// make sure ApplySet parents are updated before their members
+	s.adjustDeps(func(obj *unstructured.Unstructured) (string, bool) {
+		id, ok := obj.GetLabels()[applyset.LabelKeyID]
+		return id, ok
+	}, func(obj *unstructured.Unstructured) (string, bool) {
+		id, ok := obj.GetLabels()[applyset.LabelKeyPartOf]
+		return id, ok
+	})
 	// make sure namespaces are created before resources referencing namespaces
 	s.adjustDeps(func(obj *unstructured.Unstructured) (string, bool) {
 		return obj.GetName(), obj.GetKind() == kube.NamespaceKind && obj.GroupVersionKind().Group == ""
@@ -151,9 +166,10 @@ func (s syncTasks) adjustDeps(isDep func(obj *unstructured.Unstructured) (string
 		if depKey, ok := isDep(t.targetObj); ok {
 			// if tasks is a dependency then insert if before first task that reference it
 			if index, ok := firstIndexByDepKey[depKey]; ok {
-				// wave and sync phase of dependency resource must be same as wave and phase of resource that depend on it
+				// wave, batch and sync phase of dependency resource must be same as wave, batch and phase of resource that depend on it
 				wave := s[index].wave()
 				t.waveOverride = &wave
+				t.batch = s[index].batch
 				t.phase = s[index].phase
 
 				for j := i; j > index; j-- {
@@ -271,6 +287,20 @@ func (s syncTasks) lastWave() int {
 	return 0
 }
 
+func (s syncTasks) batch() int {
+	if len(s) > 0 {
+		return s[0].batch
+	}
+	return 0
+}
+
+func (s syncTasks) lastBatch() int {
+	if len(s) > 0 {
+		return s[len(s)-1].batch
+	}
+	return 0
+}
+
 func (s syncTasks) multiStep() bool {
-	return s.wave() != s.lastWave() || s.phase() != s.lastPhase()
+	return s.wave() != s.lastWave() || s.phase() != s.lastPhase() || s.batch() != s.lastBatch()
 }
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/gitops-engine/pkg/sync/applyset"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
 )
@@ -461,6 +462,38 @@ func TestSyncTasksSort_CRDAndCR(t *testing.T) {
 	assert.Equal(t, syncTasks{crd, cr}, unsorted)
 }
 
+func TestSyncTasksSort_ApplySetParentAndMembers(t *testing.T) {
+	id := applyset.SecretID("my-app", "my-namespace")
+	member := &syncTask{
+		phase:     common.SyncPhaseSync,
+		targetObj: testingutils.Annotate(testingutils.NewPod(), common.AnnotationSyncWave, "-1"),
+	}
+	applyset.SetPartOf(member.targetObj, id)
+	parent := &syncTask{
+		phase:     common.SyncPhaseSync,
+		targetObj: applyset.NewSecretParent("my-app", "my-namespace", "argocd/v3", []*unstructured.Unstructured{member.targetObj}),
+	}
+	namespace := &syncTask{
+		phase: common.SyncPhaseSync,
+		targetObj: &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]any{
+					"name": "my-namespace",
+				},
+			},
+		},
+	}
+
+	unsorted := syncTasks{member, parent, namespace}
+	unsorted.Sort()
+
+	assert.Equal(t, syncTasks{namespace, parent, member}, unsorted)
+	assert.Equal(t, -1, parent.wave())
+	assert.Equal(t, -1, namespace.wave())
+}
+
 func Test_syncTasks_multiStep(t *testing.T) {
 	t.Run("Single", func(t *testing.T) {
 		tasks := syncTasks{{liveObj: testingutils.Annotate(testingutils.NewPod(), common.AnnotationSyncWave, "-1"), phase: common.SyncPhaseSync}}
@@ -481,4 +514,24 @@ func Test_syncTasks_multiStep(t *testing.T) {
 		assert.Equal(t, 1, tasks.lastWave())
 		assert.True(t, tasks.multiStep())
 	})
+	t.Run("Batches", func(t *testing.T) {
+		tasks := syncTasks{
+			{liveObj: testingutils.NewPod(), phase: common.SyncPhaseSync},
+			{liveObj: testingutils.NewPod(), phase: common.SyncPhaseSync, batch: 1},
+		}
+		assert.Equal(t, 0, tasks.batch())
+		assert.Equal(t, 1, tasks.lastBatch())
+		assert.True(t, tasks.multiStep())
+	})
+}
+
+func TestSyncTasksSort_Batches(t *testing.T) {
+	wave0Batch1 := &syncTask{phase: common.SyncPhaseSync, targetObj: testingutils.NewPod(), batch: 1}
+	wave0Batch0 := &syncTask{phase: common.SyncPhaseSync, targetObj: testingutils.NewService(), batch: 0}
+	wave1Batch0 := &syncTask{phase: common.SyncPhaseSync, targetObj: testingutils.Annotate(testingutils.NewPod(), common.AnnotationSyncWave, "1")}
+
+	unsorted := syncTasks{wave1Batch0, wave0Batch1, wave0Batch0}
+	unsorted.Sort()
+
+	assert.Equal(t, syncTasks{wave0Batch0, wave0Batch1, wave1Batch0}, unsorted)
 }
@@ -1,6 +1,7 @@
 #!/bin/sh
-# Wrapper script to perform GPG signature validation on git commit SHAs and
-# annotated tags.
+# Wrapper script to perform GPG and SSH signature validation on git commit SHAs
+# and annotated tags. SSH signatures are verified against the allowed signers
+# file in ARGOCD_SSH_ALLOWED_SIGNERS_FILE, if set.
 #
 # We capture stderr to stdout, so we can have the output in the logs. Also,
 # we ignore error codes that are emitted if signature verification failed.
@@ -13,16 +14,24 @@ fi
 REVISION="$1"
 TYPE=
 
+git_verify() {
+	if test -n "${ARGOCD_SSH_ALLOWED_SIGNERS_FILE}"; then
+		git -c "gpg.ssh.allowedSignersFile=${ARGOCD_SSH_ALLOWED_SIGNERS_FILE}" "$@"
+	else
+		git "$@"
+	fi
+}
+
 # Figure out we have an annotated tag or a commit SHA
 if git describe --exact-match "${REVISION}" >/dev/null 2>&1; then
 	IFS=''
 	TYPE=tag
-	OUTPUT=$(git verify-tag "$REVISION" 2>&1)
+	OUTPUT=$(git_verify verify-tag "$REVISION" 2>&1)
 	RET=$?
 else
 	IFS=''
 	TYPE=commit
-	OUTPUT=$(git verify-commit "$REVISION" 2>&1)
+	OUTPUT=$(git_verify verify-commit "$REVISION" 2>&1)
 	RET=$?
 fi
 
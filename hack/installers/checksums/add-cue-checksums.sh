@@ -0,0 +1,15 @@
+#!/usr/bin/env sh
+
+# Usage: ./add-cue-checksums.sh 0.14.0  # use the desired version
+
+set -e
+
+wget "https://github.com/cue-lang/cue/releases/download/v$1/checksums.txt" -O "cue_v$1_checksums.txt"
+
+while IFS="" read -r line || [ -n "$line" ]
+do
+  filename=$(echo "$line" | awk -F ' ' '{print $2}')
+  test "${filename#*windows}" = "$filename" && echo "$line" > "$(git rev-parse --show-toplevel)/hack/installers/checksums/$filename.sha256"
+done < "cue_v$1_checksums.txt"
+
+rm "cue_v$1_checksums.txt"
@@ -0,0 +1,13 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. "$(dirname "$0")"/../tool-versions.sh
+
+# shellcheck disable=SC2154
+export TARGET_FILE=cue_v${cue_version}_${INSTALL_OS}_${ARCHITECTURE}.tar.gz
+
+[ -e "$DOWNLOADS/${TARGET_FILE}" ] || curl -sLf --retry 3 -o "$DOWNLOADS/${TARGET_FILE}" "https://github.com/cue-lang/cue/releases/download/v${cue_version}/${TARGET_FILE}"
+"$(dirname "$0")"/compare-chksum.sh
+mkdir -p /tmp/cue && tar -C /tmp/cue -xf "$DOWNLOADS/${TARGET_FILE}"
+sudo install -m 0755 /tmp/cue/cue "$BIN/cue"
+cue version
@@ -8,10 +8,12 @@
 # downloaded binary with a ".sha256" suffix appended, containing the proper
 # SHA256 sum of the binary.
 #
-# Use ./hack/installers/checksums/add-helm-checksums.sh and
-# add-kustomize-checksums.sh to help download checksums.
+# Use ./hack/installers/checksums/add-helm-checksums.sh,
+# add-kustomize-checksums.sh and add-cue-checksums.sh to help download
+# checksums.
 ###############################################################################
 helm3_version=3.19.2
 kustomize5_version=5.8.0
 protoc_version=29.3
 oras_version=1.2.0
+cue_version=0.14.0
@@ -43,6 +43,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsigningkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigningkey"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
@@ -82,6 +83,8 @@ type Client interface {
 	NewClusterClientOrDie() (io.Closer, clusterpkg.ClusterServiceClient)
 	NewGPGKeyClient() (io.Closer, gpgkeypkg.GPGKeyServiceClient, error)
 	NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClient)
+	NewSSHSigningKeyClient() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient, error)
+	NewSSHSigningKeyClientOrDie() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient)
 	NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error)
 	NewApplicationSetClient() (io.Closer, applicationsetpkg.ApplicationSetServiceClient, error)
 	NewApplicationClientOrDie() (io.Closer, applicationpkg.ApplicationServiceClient)
@@ -666,6 +669,23 @@ func (c *client) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClien
 	return conn, gpgkeyIf
 }
 
+func (c *client) NewSSHSigningKeyClient() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient, error) {
+	conn, closer, err := c.newConn(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	sshSigningKeyIf := sshsigningkeypkg.NewSSHSigningKeyServiceClient(conn)
+	return closer, sshSigningKeyIf, nil
+}
+
+func (c *client) NewSSHSigningKeyClientOrDie() (io.Closer, sshsigningkeypkg.SSHSigningKeyServiceClient) {
+	conn, sshSigningKeyIf, err := c.NewSSHSigningKeyClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, sshSigningKeyIf
+}
+
 func (c *client) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	conn, closer, err := c.newConn(context.Background())
 	if err != nil {
@@ -397,6 +397,7 @@ type ApplicationManifestQuery struct {
 	SourcePositions      []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
 	Revisions            []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
 	NoCache              *bool    `protobuf:"varint,7,opt,name=noCache" json:"noCache,omitempty"`
+	Explain              *bool    `protobuf:"varint,8,opt,name=explain" json:"explain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -484,6 +485,13 @@ func (m *ApplicationManifestQuery) GetNoCache() bool {
 	return false
 }
 
+func (m *ApplicationManifestQuery) GetExplain() bool {
+	if m != nil && m.Explain != nil {
+		return *m.Explain
+	}
+	return false
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2946,6 +2954,229 @@ func (m *ApplicationServerSideDiffResponse) GetModified() bool {
 	return false
 }
 
+// ApplicationHydrateDryRunRequest is a request to hydrate the manifests of an application without committing them
+type ApplicationHydrateDryRunRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// dryRevision is the revision of the dry source to hydrate. Defaults to the target revision of the dry source.
+	DryRevision *string `protobuf:"bytes,4,opt,name=dryRevision" json:"dryRevision,omitempty"`
+	// id is the ID of the hydrate history entry whose dry revision to hydrate. It cannot be combined with dryRevision.
+	Id                   *int64   `protobuf:"varint,5,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateDryRunRequest) Reset()         { *m = ApplicationHydrateDryRunRequest{} }
+func (m *ApplicationHydrateDryRunRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateDryRunRequest) ProtoMessage()    {}
+func (*ApplicationHydrateDryRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
+}
+func (m *ApplicationHydrateDryRunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateDryRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateDryRunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateDryRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateDryRunRequest.Merge(m, src)
+}
+func (m *ApplicationHydrateDryRunRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateDryRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateDryRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateDryRunRequest proto.InternalMessageInfo
+
+func (m *ApplicationHydrateDryRunRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunRequest) GetDryRevision() string {
+	if m != nil && m.DryRevision != nil {
+		return *m.DryRevision
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunRequest) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+// ApplicationHydrateRequest is a request to hydrate the manifests of an application
+type ApplicationHydrateRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// dryRevision is the revision of the dry source to hydrate. Defaults to the target revision of the dry source.
+	DryRevision *string `protobuf:"bytes,4,opt,name=dryRevision" json:"dryRevision,omitempty"`
+	// id is the ID of the hydrate history entry whose dry revision to hydrate. It cannot be combined with dryRevision.
+	Id                   *int64   `protobuf:"varint,5,opt,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateRequest) Reset()         { *m = ApplicationHydrateRequest{} }
+func (m *ApplicationHydrateRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateRequest) ProtoMessage()    {}
+func (*ApplicationHydrateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
+}
+func (m *ApplicationHydrateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateRequest.Merge(m, src)
+}
+func (m *ApplicationHydrateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateRequest proto.InternalMessageInfo
+
+func (m *ApplicationHydrateRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetDryRevision() string {
+	if m != nil && m.DryRevision != nil {
+		return *m.DryRevision
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+// ApplicationHydrateDryRunResponse contains the changes a hydration would commit
+type ApplicationHydrateDryRunResponse struct {
+	// drySha is the resolved revision of the dry source which was hydrated
+	DrySha *string `protobuf:"bytes,1,opt,name=drySha" json:"drySha,omitempty"`
+	// diff is a unified diff between the manifest files in the sync source path and the hydrated manifest files. It is
+	// empty if hydrating would not change any manifest file.
+	Diff                 *string  `protobuf:"bytes,2,opt,name=diff" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateDryRunResponse) Reset()         { *m = ApplicationHydrateDryRunResponse{} }
+func (m *ApplicationHydrateDryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateDryRunResponse) ProtoMessage()    {}
+func (*ApplicationHydrateDryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateDryRunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateDryRunResponse.Merge(m, src)
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateDryRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateDryRunResponse proto.InternalMessageInfo
+
+func (m *ApplicationHydrateDryRunResponse) GetDrySha() string {
+	if m != nil && m.DrySha != nil {
+		return *m.DrySha
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunResponse) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
 type LinkInfo struct {
 	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
 	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
@@ -2960,7 +3191,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3028,7 +3259,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3308,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+	return fileDescriptor_df6e82b174b5eaec, []int{41}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,6 +3395,9 @@ func init() {
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
 	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
 	proto.RegisterType((*ApplicationServerSideDiffResponse)(nil), "application.ApplicationServerSideDiffResponse")
+	proto.RegisterType((*ApplicationHydrateDryRunRequest)(nil), "application.ApplicationHydrateDryRunRequest")
+	proto.RegisterType((*ApplicationHydrateRequest)(nil), "application.ApplicationHydrateRequest")
+	proto.RegisterType((*ApplicationHydrateDryRunResponse)(nil), "application.ApplicationHydrateDryRunResponse")
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
@@ -3174,196 +3408,205 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3017 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xa7, 0x66, 0x76, 0x76, 0x67, 0xdf, 0x78, 0xfd, 0x51, 0xb1, 0x4d, 0x67, 0xbc, 0x31, 0x9b,
-	0xb6, 0x1d, 0x6f, 0xd6, 0xde, 0x19, 0x7b, 0x62, 0x20, 0xd9, 0x24, 0x04, 0x67, 0xed, 0x38, 0x0b,
-	0x6b, 0xc7, 0xf4, 0x3a, 0x31, 0x0a, 0x07, 0xa8, 0x74, 0xd7, 0xcc, 0x34, 0xdb, 0xd3, 0xdd, 0xee,
-	0xee, 0x99, 0xb0, 0x0a, 0xb9, 0x04, 0x21, 0x71, 0x88, 0x82, 0x80, 0x1c, 0x38, 0xf0, 0x99, 0x28,
-	0x08, 0x21, 0x10, 0x17, 0x84, 0x90, 0x10, 0x12, 0x1c, 0x82, 0xe0, 0x80, 0x84, 0xe0, 0x1f, 0x40,
-	0x11, 0xe2, 0xc0, 0x21, 0xb9, 0xe4, 0x8c, 0x50, 0x55, 0x57, 0x75, 0x77, 0xcd, 0x47, 0xcf, 0x2c,
-	0x33, 0x10, 0x4b, 0xdc, 0xfa, 0xd5, 0x74, 0xbf, 0xf7, 0x7b, 0xaf, 0xde, 0x7b, 0xf5, 0xea, 0xbd,
-	0x5d, 0x38, 0x1d, 0xd2, 0xa0, 0x47, 0x83, 0x3a, 0xf1, 0x7d, 0xc7, 0x36, 0x49, 0x64, 0x7b, 0x6e,
-	0xf6, 0xb9, 0xe6, 0x07, 0x5e, 0xe4, 0xe1, 0x4a, 0x66, 0xa9, 0xba, 0xdc, 0xf2, 0xbc, 0x96, 0x43,
-	0xeb, 0xc4, 0xb7, 0xeb, 0xc4, 0x75, 0xbd, 0x88, 0x2f, 0x87, 0xf1, 0xab, 0x55, 0x7d, 0xf7, 0xe1,
-	0xb0, 0x66, 0x7b, 0xfc, 0x57, 0xd3, 0x0b, 0x68, 0xbd, 0x77, 0xb1, 0xde, 0xa2, 0x2e, 0x0d, 0x48,
-	0x44, 0x2d, 0xf1, 0xce, 0xa5, 0xf4, 0x9d, 0x0e, 0x31, 0xdb, 0xb6, 0x4b, 0x83, 0xbd, 0xba, 0xbf,
-	0xdb, 0x62, 0x0b, 0x61, 0xbd, 0x43, 0x23, 0x32, 0xec, 0xab, 0xed, 0x96, 0x1d, 0xb5, 0xbb, 0x2f,
-	0xd4, 0x4c, 0xaf, 0x53, 0x27, 0x41, 0xcb, 0xf3, 0x03, 0xef, 0x8b, 0xfc, 0x61, 0xdd, 0xb4, 0xea,
-	0xbd, 0x87, 0x52, 0x06, 0x59, 0x5d, 0x7a, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63,
-	0xb8, 0x05, 0xd4, 0xf7, 0x84, 0x6d, 0xf8, 0xa3, 0x1d, 0x79, 0xc1, 0x5e, 0xe6, 0x31, 0x66, 0xa3,
-	0xbf, 0x8f, 0xe0, 0xf0, 0xe5, 0x54, 0xde, 0x67, 0xba, 0x34, 0xd8, 0xc3, 0x18, 0xe6, 0x5c, 0xd2,
-	0xa1, 0x1a, 0x5a, 0x41, 0xab, 0x8b, 0x06, 0x7f, 0xc6, 0x1a, 0x2c, 0x04, 0xb4, 0x19, 0xd0, 0xb0,
-	0xad, 0x15, 0xf8, 0xb2, 0x24, 0x71, 0x15, 0xca, 0x4c, 0x38, 0x35, 0xa3, 0x50, 0x2b, 0xae, 0x14,
-	0x57, 0x17, 0x8d, 0x84, 0xc6, 0xab, 0x70, 0x28, 0xa0, 0xa1, 0xd7, 0x0d, 0x4c, 0xfa, 0x1c, 0x0d,
-	0x42, 0xdb, 0x73, 0xb5, 0x39, 0xfe, 0x75, 0xff, 0x32, 0xe3, 0x12, 0x52, 0x87, 0x9a, 0x91, 0x17,
-	0x68, 0x25, 0xfe, 0x4a, 0x42, 0x33, 0x3c, 0x0c, 0xb8, 0x36, 0x1f, 0xe3, 0x61, 0xcf, 0x58, 0x87,
-	0x03, 0xc4, 0xf7, 0x6f, 0x90, 0x0e, 0x0d, 0x7d, 0x62, 0x52, 0x6d, 0x81, 0xff, 0xa6, 0xac, 0x31,
-	0xcc, 0x02, 0x89, 0x56, 0xe6, 0xc0, 0x24, 0xa9, 0x6f, 0xc2, 0xe2, 0x0d, 0xcf, 0xa2, 0xa3, 0xd5,
-	0xed, 0x67, 0x5f, 0x18, 0x64, 0xaf, 0xbf, 0x8d, 0xe0, 0x98, 0x41, 0x7b, 0x36, 0xc3, 0x7f, 0x9d,
-	0x46, 0xc4, 0x22, 0x11, 0xe9, 0xe7, 0x58, 0x48, 0x38, 0x56, 0xa1, 0x1c, 0x88, 0x97, 0xb5, 0x02,
-	0x5f, 0x4f, 0xe8, 0x01, 0x69, 0xc5, 0x7c, 0x65, 0x62, 0x13, 0x4a, 0x12, 0xaf, 0x40, 0x25, 0xb6,
-	0xe5, 0x96, 0x6b, 0xd1, 0x2f, 0x71, 0xeb, 0x95, 0x8c, 0xec, 0x12, 0x5e, 0x86, 0xc5, 0x5e, 0x6c,
-	0xe7, 0x2d, 0x8b, 0x5b, 0xb1, 0x64, 0xa4, 0x0b, 0xfa, 0x3f, 0x10, 0x9c, 0xcc, 0xf8, 0x80, 0x21,
-	0x76, 0xe6, 0x6a, 0x8f, 0xba, 0x51, 0x38, 0x5a, 0xa1, 0xf3, 0x70, 0x44, 0x6e, 0x62, 0xbf, 0x9d,
-	0x06, 0x7f, 0x60, 0x2a, 0x66, 0x17, 0xa5, 0x8a, 0xd9, 0x35, 0xa6, 0x88, 0xa4, 0x9f, 0xdd, 0xba,
-	0x22, 0xd4, 0xcc, 0x2e, 0x0d, 0x18, 0xaa, 0x94, 0x6f, 0xa8, 0x79, 0xc5, 0x50, 0xfa, 0x3f, 0x11,
-	0x68, 0x19, 0x45, 0xaf, 0x13, 0xd7, 0x6e, 0xd2, 0x30, 0x9a, 0x74, 0xcf, 0xd0, 0x0c, 0xf7, 0x6c,
-	0x15, 0x0e, 0xc5, 0x5a, 0xdd, 0x64, 0xf1, 0xc8, 0xf2, 0x8f, 0x56, 0x5a, 0x29, 0xae, 0x16, 0x8d,
-	0xfe, 0x65, 0xb6, 0x77, 0x52, 0x66, 0xa8, 0xcd, 0x73, 0x37, 0x4e, 0x17, 0x98, 0x04, 0xd7, 0xdb,
-	0x24, 0x66, 0x3b, 0x8e, 0x80, 0xb2, 0x21, 0x49, 0xfd, 0x7e, 0x58, 0x7c, 0xca, 0x76, 0xe8, 0x66,
-	0xbb, 0xeb, 0xee, 0xe2, 0xa3, 0x50, 0x32, 0xd9, 0x03, 0xd7, 0xee, 0x80, 0x11, 0x13, 0xfa, 0x37,
-	0x10, 0xdc, 0x3f, 0xca, 0x1e, 0xb7, 0xed, 0xa8, 0xcd, 0xbe, 0x0f, 0x47, 0x19, 0xc6, 0x6c, 0x53,
-	0x73, 0x37, 0xec, 0x76, 0xa4, 0x33, 0x4b, 0x7a, 0x3a, 0xc3, 0xe8, 0x3f, 0x41, 0xb0, 0x3a, 0x16,
-	0xd3, 0xed, 0x80, 0xf8, 0x3e, 0x0d, 0xf0, 0x53, 0x50, 0xba, 0xc3, 0x7e, 0xe0, 0xa1, 0x5b, 0x69,
-	0xd4, 0x6a, 0xd9, 0xd4, 0x3f, 0x96, 0xcb, 0xd3, 0x1f, 0x32, 0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7,
-	0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3, 0xd7, 0x9e, 0x9c, 0x87, 0x39, 0x9f,
-	0x04, 0x91, 0x7e, 0x0c, 0xee, 0x51, 0x03, 0xc7, 0xf7, 0xdc, 0x90, 0xea, 0xbf, 0x56, 0xfd, 0x6c,
-	0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84, 0x77, 0x21, 0x7b, 0x1a, 0x71, 0xab,
-	0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x9d, 0xd7, 0x64, 0x3a, 0xe7, 0x0f, 0x9f, 0x37, 0xad, 0x5a, 0xef,
-	0xa1, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0xc3, 0x41, 0x41, 0x26, 0x0f, 0x87, 0xac, 0xaa, 0x46, 0x96,
-	0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35, 0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7,
-	0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0, 0xfa, 0x6f, 0x54, 0xf4, 0xcf, 0xfa,
-	0xd6, 0x07, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a, 0x50, 0x51, 0xf5, 0xa0, 0x5f, 0xa8,
-	0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06, 0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49,
-	0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38, 0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b,
-	0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8, 0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec,
-	0xec, 0xb9, 0xe6, 0x33, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64, 0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87,
-	0x7c, 0x4c, 0xe8, 0xff, 0x2a, 0xc1, 0xf1, 0x8c, 0x6e, 0xec, 0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7,
-	0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10, 0x14, 0x13, 0xec, 0x07, 0x5d, 0x37,
-	0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac, 0xfe, 0x68, 0xed, 0x71, 0xe0, 0x95,
-	0xc6, 0xa7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34, 0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76,
-	0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1, 0x33, 0xbd, 0xa0, 0x67, 0x7c, 0x56,
-	0x3b, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47, 0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9,
-	0x02, 0xfe, 0x2c, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22, 0x07, 0xf3, 0xe4, 0x74, 0x60, 0xb6,
-	0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68, 0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0,
-	0xed, 0xfa, 0xe9, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a, 0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d,
-	0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46, 0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90,
-	0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77, 0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x3d,
-	0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40, 0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f,
-	0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59, 0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2,
-	0xf7, 0x11, 0x7c, 0x38, 0x23, 0xf3, 0x26, 0x89, 0xcc, 0x76, 0x9e, 0xb2, 0x2c, 0x7e, 0xd9, 0x3b,
-	0xe2, 0x5c, 0x8e, 0x09, 0x66, 0x55, 0xfe, 0x70, 0x6b, 0xcf, 0x67, 0x00, 0xd9, 0x2f, 0xe9, 0xc2,
-	0x94, 0x65, 0xd5, 0x4f, 0x11, 0x54, 0xb3, 0x39, 0xdc, 0x73, 0x9c, 0x17, 0x88, 0xb9, 0x9b, 0x07,
-	0xf2, 0x20, 0x14, 0x6c, 0x8b, 0x23, 0x2c, 0x1a, 0x05, 0xdb, 0xda, 0x67, 0x32, 0xea, 0x87, 0x3b,
-	0x9f, 0x0f, 0x77, 0x41, 0x85, 0xfb, 0x7e, 0x1f, 0x5c, 0x99, 0x12, 0x72, 0xe0, 0x2e, 0xc3, 0xa2,
-	0xdb, 0x57, 0xe2, 0xa6, 0x0b, 0x43, 0x4a, 0xdb, 0xc2, 0x40, 0x69, 0xab, 0xc1, 0x42, 0x2f, 0xb9,
-	0x00, 0xb1, 0x9f, 0x25, 0xc9, 0x54, 0x6c, 0x05, 0x5e, 0xd7, 0x17, 0x46, 0x8f, 0x09, 0x86, 0x62,
-	0xd7, 0x76, 0x59, 0xb1, 0xce, 0x51, 0xb0, 0xe7, 0xfd, 0x5f, 0x79, 0x14, 0xb5, 0x7f, 0x56, 0x80,
-	0x8f, 0x0c, 0x51, 0x7b, 0xac, 0x3f, 0xdd, 0x1d, 0xba, 0x27, 0x5e, 0xbd, 0x30, 0xd2, 0xab, 0xcb,
-	0xe3, 0xbc, 0x7a, 0x31, 0xdf, 0x5e, 0xa0, 0xda, 0xeb, 0xc7, 0x05, 0x58, 0x19, 0x62, 0xaf, 0xf1,
-	0xe5, 0xc4, 0x5d, 0x63, 0xb0, 0xa6, 0x17, 0x98, 0xf2, 0x5a, 0x10, 0x13, 0x2c, 0xce, 0xbc, 0xc0,
-	0x6f, 0x13, 0x97, 0x7b, 0x47, 0xd9, 0x10, 0xd4, 0x94, 0xa6, 0xba, 0x02, 0x9a, 0x34, 0xcf, 0x65,
-	0x33, 0x4e, 0x52, 0x01, 0xe9, 0xd0, 0x88, 0x06, 0xe1, 0xa8, 0x14, 0xd5, 0x23, 0x4e, 0x97, 0xca,
-	0x14, 0xc5, 0x09, 0xfd, 0xb5, 0x42, 0x3f, 0x1b, 0xa3, 0xeb, 0xde, 0xfd, 0x86, 0x3e, 0x0e, 0xf3,
-	0x84, 0xa3, 0x15, 0xae, 0x29, 0xa8, 0x01, 0x93, 0x96, 0xf3, 0x4d, 0xba, 0xa8, 0x98, 0x74, 0xa3,
-	0xa0, 0x21, 0xfd, 0xbd, 0x02, 0x54, 0x47, 0x19, 0xe4, 0xb9, 0xc6, 0xff, 0x9b, 0x49, 0x30, 0x01,
-	0x2d, 0x18, 0xe1, 0x65, 0x1a, 0xf0, 0xe2, 0xec, 0x8c, 0x72, 0x62, 0x8f, 0x72, 0x49, 0x63, 0x24,
-	0x1b, 0xfd, 0xab, 0x08, 0x4e, 0xa8, 0x9f, 0x85, 0xdb, 0x76, 0x18, 0xc9, 0x8b, 0x1d, 0x6e, 0xc2,
-	0x42, 0xac, 0x4a, 0x5c, 0x96, 0x57, 0x1a, 0xdb, 0xd3, 0x16, 0x6b, 0xca, 0xee, 0x4a, 0xe6, 0xfa,
-	0x23, 0x70, 0x62, 0xe8, 0x09, 0x25, 0x60, 0x54, 0xa1, 0x2c, 0x0b, 0x54, 0xb1, 0xfb, 0x09, 0xad,
-	0xbf, 0x39, 0xa7, 0x96, 0x0b, 0x9e, 0xb5, 0xed, 0xb5, 0x72, 0xba, 0x38, 0xf9, 0x1e, 0xc3, 0x76,
-	0xc3, 0xb3, 0x32, 0x0d, 0x1b, 0x49, 0xb2, 0xef, 0x4c, 0xcf, 0x8d, 0x88, 0xed, 0xd2, 0x40, 0x54,
-	0x34, 0xe9, 0x02, 0xdb, 0xe9, 0xd0, 0x76, 0x4d, 0xba, 0x43, 0x4d, 0xcf, 0xb5, 0x42, 0xee, 0x32,
-	0x45, 0x43, 0x59, 0xc3, 0x4f, 0xc3, 0x22, 0xa7, 0x6f, 0xd9, 0x9d, 0xf8, 0x08, 0xaf, 0x34, 0xd6,
-	0x6a, 0x71, 0x67, 0xb5, 0x96, 0xed, 0xac, 0xa6, 0x36, 0xec, 0xd0, 0x88, 0xd4, 0x7a, 0x17, 0x6b,
-	0xec, 0x0b, 0x23, 0xfd, 0x98, 0x61, 0x89, 0x88, 0xed, 0x6c, 0xdb, 0x2e, 0xbf, 0x34, 0x30, 0x51,
-	0xe9, 0x02, 0xf3, 0xc6, 0xa6, 0xe7, 0x38, 0xde, 0x8b, 0x32, 0xe7, 0xc5, 0x14, 0xfb, 0xaa, 0xeb,
-	0x46, 0xb6, 0xc3, 0xe5, 0xc7, 0xbe, 0x96, 0x2e, 0xf0, 0xaf, 0x6c, 0x27, 0xa2, 0x81, 0x48, 0x76,
-	0x82, 0x4a, 0xfc, 0xbd, 0x12, 0x37, 0x0b, 0x65, 0xae, 0x8d, 0x23, 0xe3, 0x40, 0x36, 0x32, 0xfa,
-	0xa3, 0x6d, 0x69, 0x48, 0xc7, 0x8b, 0xf7, 0x4e, 0x69, 0xcf, 0xf6, 0xba, 0xac, 0x1e, 0xe6, 0x65,
-	0xa3, 0xa4, 0x07, 0xa2, 0xe5, 0x50, 0x7e, 0xb4, 0x1c, 0x56, 0xa3, 0x85, 0xdf, 0x6a, 0x22, 0xb3,
-	0xbd, 0x49, 0x42, 0xaa, 0x1d, 0xe1, 0xac, 0xd3, 0x05, 0xfd, 0xb7, 0x08, 0xca, 0xdb, 0x5e, 0xeb,
-	0xaa, 0x1b, 0x05, 0x7b, 0xfc, 0xfe, 0xeb, 0xb9, 0x11, 0x75, 0xa5, 0x37, 0x49, 0x92, 0x6d, 0x51,
-	0x64, 0x77, 0xe8, 0x4e, 0x44, 0x3a, 0xbe, 0xa8, 0x9e, 0xf7, 0xb5, 0x45, 0xc9, 0xc7, 0xcc, 0x6c,
-	0x0e, 0x09, 0x23, 0x9e, 0x72, 0xca, 0x06, 0x7f, 0x66, 0x0a, 0x26, 0x2f, 0xec, 0x44, 0x81, 0xc8,
-	0x37, 0xca, 0x5a, 0xd6, 0x01, 0x4b, 0x31, 0x36, 0x41, 0xea, 0x1d, 0xb8, 0x37, 0xb9, 0xd6, 0xdd,
-	0xa2, 0x41, 0xc7, 0x76, 0x49, 0xfe, 0xb9, 0x3c, 0x41, 0x4b, 0x37, 0xa7, 0xab, 0xe0, 0x29, 0x21,
-	0xc9, 0x6e, 0x49, 0xb7, 0x6d, 0xd7, 0xf2, 0x5e, 0xcc, 0x09, 0xad, 0xe9, 0x04, 0xfe, 0x45, 0xed,
-	0xca, 0x66, 0x24, 0x26, 0x79, 0xe0, 0x69, 0x58, 0x62, 0x19, 0xa3, 0x47, 0xc5, 0x0f, 0x22, 0x29,
-	0xe9, 0xa3, 0xda, 0x60, 0x29, 0x0f, 0x43, 0xfd, 0x10, 0x6f, 0xc3, 0x21, 0x12, 0x86, 0x76, 0xcb,
-	0xa5, 0x96, 0xe4, 0x55, 0x98, 0x98, 0x57, 0xff, 0xa7, 0x71, 0x43, 0x85, 0xbf, 0x21, 0xf6, 0x5b,
-	0x92, 0xfa, 0x57, 0x10, 0x1c, 0x1b, 0xca, 0x24, 0x89, 0x2b, 0x94, 0x39, 0x47, 0xaa, 0x50, 0x0e,
-	0xcd, 0x36, 0xb5, 0xba, 0x8e, 0x2c, 0x15, 0x12, 0x9a, 0xfd, 0x66, 0x75, 0xe3, 0xdd, 0x17, 0xe7,
-	0x58, 0x42, 0xe3, 0x93, 0x00, 0x1d, 0xe2, 0x76, 0x89, 0xc3, 0x21, 0xcc, 0x71, 0x08, 0x99, 0x15,
-	0x7d, 0x19, 0xaa, 0xc3, 0x5c, 0x47, 0x74, 0xef, 0xde, 0x45, 0x70, 0x50, 0xa6, 0x5c, 0xb1, 0xbb,
-	0xab, 0x70, 0x28, 0x63, 0x86, 0x1b, 0xe9, 0x46, 0xf7, 0x2f, 0x8f, 0x49, 0xa7, 0xd2, 0x4b, 0x8a,
-	0xea, 0x60, 0xa5, 0xa7, 0x8c, 0x46, 0x26, 0x3e, 0x70, 0xd1, 0x8c, 0x6e, 0x06, 0x5f, 0x06, 0xed,
-	0x3a, 0x71, 0x49, 0x8b, 0x5a, 0x89, 0xda, 0x89, 0x8b, 0x7d, 0x21, 0xdb, 0x86, 0x9a, 0xba, 0xe9,
-	0x93, 0x14, 0xd1, 0x76, 0xb3, 0x29, 0x5b, 0x5a, 0xaf, 0x17, 0x54, 0x3f, 0xe7, 0x33, 0xab, 0x1d,
-	0xdb, 0xe2, 0x2f, 0xc5, 0xe6, 0xd7, 0x60, 0x41, 0xa8, 0x22, 0x13, 0x94, 0x20, 0xa7, 0x0b, 0x31,
-	0xec, 0xc3, 0x92, 0x63, 0xf7, 0x68, 0xa2, 0xb5, 0x36, 0x37, 0x73, 0x25, 0x55, 0x01, 0xcc, 0x91,
-	0x22, 0x12, 0xb4, 0x68, 0x74, 0x3d, 0xe9, 0x38, 0x95, 0x78, 0x8b, 0xa3, 0x7f, 0x59, 0xff, 0xa1,
-	0xda, 0x9b, 0x57, 0xcd, 0xf2, 0xbf, 0xdb, 0x1e, 0x5e, 0x6b, 0x78, 0x96, 0xdd, 0xb4, 0x69, 0x7c,
-	0x5f, 0x2f, 0x1b, 0x09, 0xad, 0x07, 0x50, 0xde, 0xb6, 0xdd, 0xdd, 0x2d, 0xb7, 0xe9, 0x31, 0x67,
-	0x8d, 0xec, 0xc8, 0x91, 0x3b, 0x14, 0x13, 0xf8, 0x30, 0x14, 0xbb, 0x81, 0x23, 0x82, 0x97, 0x3d,
-	0xe2, 0x15, 0xa8, 0x58, 0x34, 0x34, 0x03, 0xdb, 0x17, 0xa1, 0xcb, 0x67, 0x3c, 0x99, 0x25, 0x16,
-	0x42, 0xb6, 0xe9, 0xb9, 0x9b, 0x0e, 0x09, 0x43, 0x59, 0x59, 0x24, 0x0b, 0xfa, 0x63, 0xb0, 0xc4,
-	0x64, 0xa6, 0x1e, 0x7a, 0x4e, 0x35, 0xc1, 0x31, 0x45, 0x35, 0x09, 0x4f, 0x3a, 0x1b, 0x81, 0x7b,
-	0x58, 0x41, 0x77, 0xd9, 0xf7, 0x05, 0x93, 0x09, 0x6f, 0x17, 0xc5, 0x61, 0x85, 0xd1, 0xd0, 0x01,
-	0x46, 0xe3, 0xdd, 0xb3, 0x80, 0xfb, 0x36, 0xce, 0x36, 0x29, 0xfe, 0x26, 0x82, 0x39, 0x26, 0x1a,
-	0xdf, 0x37, 0x2a, 0xa3, 0x72, 0x5f, 0xaf, 0xce, 0xae, 0x3b, 0xc5, 0xa4, 0xe9, 0xcb, 0xaf, 0xfc,
-	0xf5, 0xef, 0xdf, 0x2a, 0x1c, 0xc7, 0x47, 0xf9, 0x40, 0xbb, 0x77, 0x31, 0x3b, 0x5c, 0x0e, 0xf1,
-	0xab, 0x08, 0xb0, 0x28, 0x70, 0x33, 0x23, 0x3f, 0x7c, 0x6e, 0x14, 0xc4, 0x21, 0xa3, 0xc1, 0xea,
-	0x7d, 0x99, 0x82, 0xa0, 0x66, 0x7a, 0x01, 0x65, 0xc7, 0x3f, 0x7f, 0x81, 0x03, 0x58, 0xe3, 0x00,
-	0x4e, 0x63, 0x7d, 0x18, 0x80, 0xfa, 0x4b, 0xcc, 0xa2, 0x2f, 0xd7, 0x69, 0x2c, 0xf7, 0x0d, 0x04,
-	0xa5, 0xdb, 0xfc, 0x62, 0x3f, 0xc6, 0x48, 0x3b, 0x33, 0x33, 0x12, 0x17, 0xc7, 0xd1, 0xea, 0xa7,
-	0x38, 0xd2, 0xfb, 0xf0, 0x09, 0x89, 0x34, 0x8c, 0x02, 0x4a, 0x3a, 0x0a, 0xe0, 0x0b, 0x08, 0xbf,
-	0x85, 0x60, 0x3e, 0x9e, 0xe8, 0xe0, 0x33, 0xa3, 0x50, 0x2a, 0x13, 0x9f, 0xea, 0xec, 0xc6, 0x23,
-	0xfa, 0x83, 0x1c, 0xe3, 0x29, 0x7d, 0xe8, 0x76, 0x6e, 0x28, 0xc3, 0x93, 0xd7, 0x11, 0x14, 0xaf,
-	0xd1, 0xb1, 0xfe, 0x36, 0x43, 0x70, 0x03, 0x06, 0x1c, 0xb2, 0xd5, 0xf8, 0x4d, 0x04, 0xf7, 0x5e,
-	0xa3, 0xd1, 0xf0, 0xca, 0x06, 0xaf, 0x8e, 0x2f, 0x37, 0x84, 0xdb, 0x9d, 0x9b, 0xe0, 0xcd, 0xe4,
-	0x48, 0xaf, 0x73, 0x64, 0x0f, 0xe2, 0xb3, 0x79, 0x4e, 0x18, 0xee, 0xb9, 0xe6, 0x8b, 0x02, 0xc7,
-	0x1f, 0x11, 0x1c, 0xee, 0x1f, 0xed, 0x63, 0xbd, 0xef, 0x7a, 0x39, 0x64, 0xf2, 0x5f, 0xbd, 0x31,
-	0x6d, 0x06, 0x56, 0x99, 0xea, 0x97, 0x39, 0xf2, 0x47, 0xf1, 0x23, 0x79, 0xc8, 0x93, 0xf6, 0x78,
-	0xfd, 0x25, 0xf9, 0xf8, 0x32, 0xff, 0x33, 0x14, 0x0e, 0xfb, 0x4f, 0x08, 0x8e, 0x4a, 0xbe, 0x9b,
-	0x6d, 0x12, 0x44, 0x57, 0x28, 0xbb, 0x1c, 0x85, 0x13, 0xe9, 0x33, 0xe5, 0x89, 0x92, 0x95, 0xa7,
-	0x5f, 0xe5, 0xba, 0x3c, 0x81, 0x1f, 0xdf, 0xb7, 0x2e, 0x26, 0x63, 0x63, 0x09, 0xd8, 0x6f, 0x23,
-	0x38, 0x78, 0x8d, 0x46, 0xcf, 0x6c, 0x6e, 0xed, 0x6b, 0x67, 0xa6, 0x74, 0xf4, 0x8c, 0x38, 0xfd,
-	0x0a, 0x57, 0xe4, 0x13, 0xf8, 0xb1, 0x7d, 0x2b, 0xe2, 0x99, 0x76, 0xb2, 0x2f, 0xaf, 0x20, 0x38,
-	0x70, 0x2d, 0x73, 0xe4, 0x8f, 0x4e, 0x27, 0xca, 0xf8, 0xba, 0xba, 0x5c, 0xcb, 0xfc, 0x15, 0x8f,
-	0xfc, 0x29, 0x71, 0xf5, 0x75, 0x8e, 0xed, 0x2c, 0x3e, 0x93, 0x87, 0x2d, 0x1d, 0x6f, 0xbd, 0x81,
-	0xe0, 0x58, 0x16, 0x44, 0x3a, 0xf6, 0xff, 0xe8, 0xfe, 0x86, 0xe9, 0x62, 0x24, 0x3f, 0x06, 0x5d,
-	0x83, 0xa3, 0x3b, 0xaf, 0x0f, 0x0f, 0xc4, 0xce, 0x00, 0x8a, 0x0d, 0xb4, 0xb6, 0x8a, 0xf0, 0xef,
-	0x10, 0xcc, 0xc7, 0x93, 0x9e, 0xd1, 0x36, 0x52, 0xc6, 0xd4, 0xb3, 0xcc, 0x6a, 0xc2, 0x6b, 0xab,
-	0x17, 0x86, 0x1b, 0x34, 0xfb, 0xbd, 0xdc, 0xda, 0x1a, 0xb7, 0xb2, 0x9a, 0x8e, 0x7f, 0x89, 0x00,
-	0xd2, 0x69, 0x15, 0x7e, 0x30, 0x5f, 0x8f, 0xcc, 0x44, 0xab, 0x3a, 0xdb, 0x79, 0x95, 0x5e, 0xe3,
-	0xfa, 0xac, 0x56, 0x57, 0x72, 0x73, 0xa1, 0x4f, 0xcd, 0x8d, 0x78, 0xb2, 0xf5, 0x03, 0x04, 0x25,
-	0x3e, 0x24, 0xc0, 0xa7, 0x47, 0x61, 0xce, 0xce, 0x10, 0x66, 0x69, 0xfa, 0x07, 0x38, 0xd4, 0x95,
-	0x46, 0xde, 0x81, 0xb2, 0x81, 0xd6, 0x70, 0x0f, 0xe6, 0xe3, 0xb6, 0xfc, 0x68, 0xf7, 0x50, 0xda,
-	0xf6, 0xd5, 0x95, 0x9c, 0x02, 0x27, 0x76, 0x54, 0x71, 0x96, 0xad, 0x8d, 0x3b, 0xcb, 0xe6, 0xd8,
-	0x71, 0x83, 0x4f, 0xe5, 0x1d, 0x46, 0xff, 0x05, 0xc3, 0x9c, 0xe3, 0xe8, 0xce, 0xe8, 0x2b, 0xe3,
-	0xce, 0x33, 0x66, 0x9d, 0x6f, 0x23, 0x38, 0xdc, 0x7f, 0xbf, 0xc3, 0x27, 0x86, 0xb6, 0x4a, 0xc5,
-	0xd9, 0xaa, 0x5a, 0x71, 0xd4, 0xdd, 0x50, 0xff, 0x24, 0x47, 0xb1, 0x81, 0x1f, 0x1e, 0x1b, 0x19,
-	0x37, 0x64, 0xd6, 0x61, 0x8c, 0xd6, 0xd3, 0xd1, 0xfb, 0x8f, 0x10, 0x1c, 0x54, 0x6f, 0x36, 0xa3,
-	0x6b, 0xcf, 0x21, 0x17, 0xc3, 0x6a, 0x6d, 0xb2, 0x97, 0x13, 0xc4, 0x1f, 0xe7, 0x88, 0x2f, 0xe2,
-	0xfa, 0x48, 0xc4, 0x31, 0xd2, 0xf8, 0x0f, 0x27, 0xd7, 0x43, 0xdb, 0xa2, 0xeb, 0x16, 0x43, 0xf5,
-	0x2b, 0x04, 0x07, 0xa4, 0x01, 0x6e, 0x05, 0x94, 0xe6, 0xdb, 0x6f, 0x76, 0x11, 0xcb, 0x64, 0xe9,
-	0x8f, 0x71, 0xd4, 0x1f, 0xc3, 0x97, 0x26, 0xb4, 0xb3, 0xb4, 0xef, 0x7a, 0xc4, 0x90, 0xfe, 0x1e,
-	0xc1, 0x91, 0xdb, 0x71, 0x80, 0x7e, 0x40, 0xf8, 0x37, 0x39, 0xfe, 0xc7, 0xf1, 0xa3, 0x39, 0x85,
-	0xf5, 0x38, 0x35, 0x2e, 0x20, 0xfc, 0x73, 0x04, 0x65, 0x39, 0x5b, 0xc6, 0x67, 0x47, 0x46, 0xb0,
-	0x3a, 0x7d, 0x9e, 0x65, 0xd4, 0x89, 0x2a, 0x52, 0x3f, 0x9d, 0x7b, 0xec, 0x0b, 0xf9, 0x2c, 0xf2,
-	0x5e, 0x47, 0x80, 0x93, 0xfe, 0x52, 0xd2, 0x71, 0xc2, 0x0f, 0x28, 0xa2, 0x46, 0x36, 0x31, 0xab,
-	0x67, 0xc7, 0xbe, 0xa7, 0x9e, 0xf9, 0x6b, 0xb9, 0x67, 0xbe, 0x97, 0xc8, 0x7f, 0x0d, 0x41, 0xe5,
-	0x1a, 0x4d, 0x2e, 0x7d, 0x39, 0xb6, 0x54, 0x47, 0xe3, 0xd5, 0xd5, 0xf1, 0x2f, 0x0a, 0x44, 0xe7,
-	0x39, 0xa2, 0x07, 0x70, 0xbe, 0xa9, 0x24, 0x80, 0xef, 0x20, 0x58, 0xba, 0x99, 0x75, 0x51, 0x7c,
-	0x7e, 0x9c, 0x24, 0xe5, 0xc8, 0x99, 0x1c, 0xd7, 0x43, 0x1c, 0xd7, 0xba, 0x3e, 0x11, 0xae, 0x0d,
-	0x31, 0x65, 0xfe, 0x1e, 0x8a, 0xbb, 0x06, 0x7d, 0x93, 0xa1, 0xff, 0xd4, 0x6e, 0x39, 0x03, 0x26,
-	0xfd, 0x12, 0xc7, 0x57, 0xc3, 0xe7, 0x27, 0xc1, 0x57, 0x17, 0xe3, 0x22, 0xfc, 0x5d, 0x04, 0x47,
-	0xf8, 0x68, 0x30, 0xcb, 0x18, 0xe7, 0x4d, 0xc3, 0xd2, 0x41, 0xe2, 0x04, 0x67, 0xe1, 0x13, 0x71,
-	0xfe, 0xd1, 0xf7, 0x05, 0x6a, 0x43, 0x0c, 0xfd, 0xbe, 0x56, 0x40, 0x6c, 0x7f, 0xef, 0x19, 0xc0,
-	0xf7, 0x5c, 0xa3, 0xcf, 0x80, 0xa3, 0x47, 0x9d, 0x13, 0x60, 0xdc, 0xe0, 0x18, 0x2f, 0xe9, 0xf5,
-	0xfd, 0x60, 0xac, 0xf7, 0x1a, 0x2c, 0x4c, 0xbf, 0x8e, 0xe0, 0xa0, 0xac, 0x0f, 0x84, 0xff, 0xad,
-	0x8f, 0xdb, 0xda, 0xfd, 0xd6, 0x13, 0x22, 0x20, 0xd6, 0x26, 0x0b, 0x88, 0xb7, 0x10, 0x2c, 0x88,
-	0xc9, 0x5d, 0x4e, 0xd5, 0x95, 0x19, 0xed, 0x55, 0xfb, 0xda, 0x5e, 0x62, 0xb4, 0xa3, 0x7f, 0x8e,
-	0x8b, 0x7d, 0x16, 0xe7, 0x9a, 0xc5, 0xf7, 0xac, 0xb0, 0xfe, 0x92, 0x98, 0xab, 0xbc, 0x5c, 0x77,
-	0xbc, 0x56, 0xf8, 0xbc, 0x8e, 0x73, 0x6b, 0x0b, 0xf6, 0xce, 0x05, 0x84, 0x23, 0x58, 0x64, 0xee,
-	0xcb, 0x7b, 0x69, 0x78, 0xa5, 0xaf, 0xf3, 0x36, 0xd0, 0x66, 0xab, 0x56, 0x07, 0x7a, 0x73, 0x69,
-	0x31, 0x21, 0x3a, 0x1b, 0xf8, 0xfe, 0x5c, 0xb1, 0x5c, 0xd0, 0xab, 0x08, 0x8e, 0x64, 0xe3, 0x31,
-	0x16, 0x3f, 0x71, 0x34, 0xe6, 0xa1, 0x10, 0xf7, 0x13, 0xbc, 0x36, 0x91, 0x1b, 0x71, 0x38, 0x4f,
-	0x3e, 0xf5, 0x87, 0x77, 0x4e, 0xa2, 0x3f, 0xbf, 0x73, 0x12, 0xfd, 0xed, 0x9d, 0x93, 0xe8, 0xf9,
-	0x87, 0x27, 0xfb, 0x2f, 0x0f, 0xd3, 0xb1, 0xa9, 0x1b, 0x65, 0xd9, 0xff, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xf3, 0x23, 0xc0, 0x98, 0xcb, 0x32, 0x00, 0x00,
+	// 3154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcb, 0x8f, 0x1c, 0x57,
+	0xd5, 0xff, 0x6e, 0xcf, 0xf4, 0x4c, 0xcf, 0x69, 0x8f, 0x1f, 0x37, 0xb6, 0xbf, 0x4a, 0x7b, 0xe2,
+	0x6f, 0x52, 0xb6, 0xe3, 0xc9, 0xd8, 0xd3, 0x6d, 0x4f, 0xfc, 0x41, 0x32, 0x49, 0x08, 0xce, 0xd8,
+	0xb1, 0x0d, 0x63, 0xc7, 0xd4, 0x38, 0x31, 0x0a, 0x0b, 0xb8, 0xa9, 0xba, 0xdd, 0x5d, 0x4c, 0x75,
+	0x55, 0xb9, 0xaa, 0xba, 0x93, 0x51, 0xc8, 0x26, 0x80, 0xc4, 0x22, 0x0a, 0x02, 0xb2, 0x60, 0xc1,
+	0x2b, 0x89, 0x82, 0x10, 0x0a, 0x62, 0x83, 0x10, 0x12, 0x42, 0x22, 0x8b, 0x20, 0x58, 0x20, 0x21,
+	0xf8, 0x07, 0x50, 0x84, 0x58, 0x92, 0x4d, 0xd6, 0x08, 0xdd, 0x57, 0x3d, 0xba, 0xbb, 0xaa, 0x7b,
+	0xe8, 0x0e, 0xb1, 0xc4, 0xae, 0xce, 0xed, 0x5b, 0xe7, 0xfc, 0xce, 0xb9, 0xe7, 0x9e, 0x7b, 0xee,
+	0x39, 0xd5, 0x70, 0x32, 0xa4, 0x41, 0x8f, 0x06, 0x0d, 0xe2, 0xfb, 0x8e, 0x6d, 0x92, 0xc8, 0xf6,
+	0xdc, 0xf4, 0x73, 0xdd, 0x0f, 0xbc, 0xc8, 0xc3, 0xd5, 0xd4, 0x50, 0x6d, 0xa9, 0xe5, 0x79, 0x2d,
+	0x87, 0x36, 0x88, 0x6f, 0x37, 0x88, 0xeb, 0x7a, 0x11, 0x1f, 0x0e, 0xc5, 0xd4, 0x9a, 0xbe, 0xf3,
+	0x70, 0x58, 0xb7, 0x3d, 0xfe, 0xab, 0xe9, 0x05, 0xb4, 0xd1, 0x3b, 0xdf, 0x68, 0x51, 0x97, 0x06,
+	0x24, 0xa2, 0x96, 0x9c, 0x73, 0x21, 0x99, 0xd3, 0x21, 0x66, 0xdb, 0x76, 0x69, 0xb0, 0xdb, 0xf0,
+	0x77, 0x5a, 0x6c, 0x20, 0x6c, 0x74, 0x68, 0x44, 0x86, 0xbd, 0xb5, 0xd5, 0xb2, 0xa3, 0x76, 0xf7,
+	0xf9, 0xba, 0xe9, 0x75, 0x1a, 0x24, 0x68, 0x79, 0x7e, 0xe0, 0x7d, 0x99, 0x3f, 0xac, 0x99, 0x56,
+	0xa3, 0xf7, 0x50, 0xc2, 0x20, 0xad, 0x4b, 0xef, 0x3c, 0x71, 0xfc, 0x36, 0x19, 0xe4, 0x76, 0x79,
+	0x04, 0xb7, 0x80, 0xfa, 0x9e, 0xb4, 0x0d, 0x7f, 0xb4, 0x23, 0x2f, 0xd8, 0x4d, 0x3d, 0x0a, 0x36,
+	0xfa, 0x87, 0x08, 0x0e, 0x5e, 0x4c, 0xe4, 0x7d, 0xae, 0x4b, 0x83, 0x5d, 0x8c, 0x61, 0xd6, 0x25,
+	0x1d, 0xaa, 0xa1, 0x65, 0xb4, 0xb2, 0x60, 0xf0, 0x67, 0xac, 0xc1, 0x7c, 0x40, 0x9b, 0x01, 0x0d,
+	0xdb, 0x5a, 0x89, 0x0f, 0x2b, 0x12, 0xd7, 0xa0, 0xc2, 0x84, 0x53, 0x33, 0x0a, 0xb5, 0x99, 0xe5,
+	0x99, 0x95, 0x05, 0x23, 0xa6, 0xf1, 0x0a, 0x1c, 0x08, 0x68, 0xe8, 0x75, 0x03, 0x93, 0x3e, 0x4b,
+	0x83, 0xd0, 0xf6, 0x5c, 0x6d, 0x96, 0xbf, 0xdd, 0x3f, 0xcc, 0xb8, 0x84, 0xd4, 0xa1, 0x66, 0xe4,
+	0x05, 0x5a, 0x99, 0x4f, 0x89, 0x69, 0x86, 0x87, 0x01, 0xd7, 0xe6, 0x04, 0x1e, 0xf6, 0x8c, 0x75,
+	0xd8, 0x47, 0x7c, 0xff, 0x06, 0xe9, 0xd0, 0xd0, 0x27, 0x26, 0xd5, 0xe6, 0xf9, 0x6f, 0x99, 0x31,
+	0x86, 0x59, 0x22, 0xd1, 0x2a, 0x1c, 0x98, 0x22, 0xf5, 0x4d, 0x58, 0xb8, 0xe1, 0x59, 0x34, 0x5f,
+	0xdd, 0x7e, 0xf6, 0xa5, 0x41, 0xf6, 0xfa, 0x7b, 0x08, 0x8e, 0x18, 0xb4, 0x67, 0x33, 0xfc, 0xd7,
+	0x69, 0x44, 0x2c, 0x12, 0x91, 0x7e, 0x8e, 0xa5, 0x98, 0x63, 0x0d, 0x2a, 0x81, 0x9c, 0xac, 0x95,
+	0xf8, 0x78, 0x4c, 0x0f, 0x48, 0x9b, 0x29, 0x56, 0x46, 0x98, 0x50, 0x91, 0x78, 0x19, 0xaa, 0xc2,
+	0x96, 0xd7, 0x5c, 0x8b, 0xbe, 0xc8, 0xad, 0x57, 0x36, 0xd2, 0x43, 0x78, 0x09, 0x16, 0x7a, 0xc2,
+	0xce, 0xd7, 0x2c, 0x6e, 0xc5, 0xb2, 0x91, 0x0c, 0xe8, 0x7f, 0x47, 0x70, 0x3c, 0xe5, 0x03, 0x86,
+	0x5c, 0x99, 0xcb, 0x3d, 0xea, 0x46, 0x61, 0xbe, 0x42, 0x67, 0xe1, 0x90, 0x5a, 0xc4, 0x7e, 0x3b,
+	0x0d, 0xfe, 0xc0, 0x54, 0x4c, 0x0f, 0x2a, 0x15, 0xd3, 0x63, 0x4c, 0x11, 0x45, 0x3f, 0x73, 0xed,
+	0x92, 0x54, 0x33, 0x3d, 0x34, 0x60, 0xa8, 0x72, 0xb1, 0xa1, 0xe6, 0x32, 0x86, 0xd2, 0xbf, 0x56,
+	0x02, 0x2d, 0xa5, 0xe8, 0x75, 0xe2, 0xda, 0x4d, 0x1a, 0x46, 0xe3, 0xae, 0x19, 0x9a, 0xe2, 0x9a,
+	0xad, 0xc0, 0x01, 0xa1, 0xd5, 0x4d, 0xb6, 0x1f, 0x59, 0xfc, 0xd1, 0xca, 0xcb, 0x33, 0x2b, 0x33,
+	0x46, 0xff, 0x30, 0x5b, 0x3b, 0x25, 0x33, 0xd4, 0xe6, 0xb8, 0x1b, 0x27, 0x03, 0x4c, 0x82, 0xeb,
+	0x6d, 0x12, 0xb3, 0x2d, 0x76, 0x40, 0xc5, 0x50, 0x24, 0xfb, 0x85, 0xbe, 0xe8, 0x3b, 0xc4, 0x76,
+	0xb5, 0x8a, 0xf8, 0x45, 0x92, 0xfa, 0xfd, 0xb0, 0xf0, 0x94, 0xed, 0xd0, 0xcd, 0x76, 0xd7, 0xdd,
+	0xc1, 0x87, 0xa1, 0x6c, 0xb2, 0x07, 0xae, 0xf7, 0x3e, 0x43, 0x10, 0xfa, 0xb7, 0x10, 0xdc, 0x9f,
+	0x67, 0xa9, 0xdb, 0x76, 0xd4, 0x66, 0xef, 0x87, 0x79, 0x26, 0x33, 0xdb, 0xd4, 0xdc, 0x09, 0xbb,
+	0x1d, 0xe5, 0xe6, 0x8a, 0x9e, 0xcc, 0x64, 0xfa, 0x4f, 0x11, 0xac, 0x8c, 0xc4, 0x74, 0x3b, 0x20,
+	0xbe, 0x4f, 0x03, 0xfc, 0x14, 0x94, 0xef, 0xb0, 0x1f, 0xf8, 0xa6, 0xae, 0xae, 0xd7, 0xeb, 0xe9,
+	0x43, 0x61, 0x24, 0x97, 0xab, 0xff, 0x63, 0x88, 0xd7, 0x71, 0x5d, 0x99, 0xa7, 0xc4, 0xf9, 0x1c,
+	0xcd, 0xf0, 0x89, 0xad, 0xc8, 0xe6, 0xf3, 0x69, 0x4f, 0xce, 0xc1, 0xac, 0x4f, 0x82, 0x48, 0x3f,
+	0x02, 0xf7, 0x64, 0xb7, 0x94, 0xef, 0xb9, 0x21, 0xd5, 0x7f, 0x8d, 0x32, 0x1e, 0xb8, 0x19, 0x50,
+	0x12, 0x51, 0x83, 0xde, 0xe9, 0xd2, 0x30, 0xc2, 0x3b, 0x90, 0x3e, 0xa7, 0xb8, 0x55, 0xab, 0xeb,
+	0xd7, 0xea, 0x49, 0xa0, 0xaf, 0xab, 0x40, 0xcf, 0x1f, 0xbe, 0x68, 0x5a, 0xf5, 0xde, 0x43, 0x75,
+	0x7f, 0xa7, 0x55, 0x67, 0xc7, 0x46, 0x06, 0x99, 0x3a, 0x36, 0xd2, 0xaa, 0x1a, 0x69, 0xee, 0xf8,
+	0x28, 0xcc, 0x75, 0xfd, 0x90, 0x06, 0x11, 0xd7, 0xac, 0x62, 0x48, 0x8a, 0xad, 0x5f, 0x8f, 0x38,
+	0xb6, 0x45, 0x22, 0xb1, 0x3e, 0x15, 0x23, 0xa6, 0xf5, 0xdf, 0x64, 0xd1, 0x3f, 0xe3, 0x5b, 0x1f,
+	0x17, 0xfa, 0x34, 0xca, 0x52, 0x16, 0x65, 0xda, 0x83, 0x66, 0xb2, 0x1e, 0xf4, 0x8b, 0x2c, 0xfe,
+	0x4b, 0xd4, 0xa1, 0x09, 0xfe, 0x61, 0xce, 0xac, 0xc1, 0xbc, 0x49, 0x42, 0x93, 0x58, 0x4a, 0x8a,
+	0x22, 0x59, 0xf0, 0xf3, 0x03, 0xcf, 0x27, 0x2d, 0xce, 0xe9, 0xa6, 0xe7, 0xd8, 0xe6, 0xae, 0x14,
+	0x37, 0xf8, 0xc3, 0x80, 0xe3, 0xcf, 0x16, 0x3b, 0x7e, 0x39, 0x0b, 0xfb, 0x04, 0x54, 0xb7, 0x77,
+	0x5d, 0xf3, 0x69, 0x5f, 0x04, 0x84, 0xc3, 0x50, 0xb6, 0x23, 0xda, 0x09, 0x35, 0xc4, 0x83, 0x81,
+	0x20, 0xf4, 0x7f, 0x96, 0xe1, 0x68, 0x4a, 0x37, 0xf6, 0x42, 0x91, 0x66, 0x45, 0x91, 0xed, 0x28,
+	0xcc, 0x59, 0xc1, 0xae, 0xd1, 0x75, 0xa5, 0x03, 0x48, 0x8a, 0x09, 0xf6, 0x83, 0xae, 0x2b, 0xe0,
+	0x57, 0x0c, 0x41, 0xe0, 0x26, 0x54, 0xc2, 0x88, 0x65, 0x26, 0xad, 0x5d, 0x0e, 0xbc, 0xba, 0xfe,
+	0x99, 0xc9, 0x16, 0x9d, 0x41, 0xdf, 0x96, 0x1c, 0x8d, 0x98, 0x37, 0xbe, 0xc3, 0xe2, 0xa0, 0x08,
+	0x8e, 0xa1, 0x36, 0xbf, 0x3c, 0xb3, 0x52, 0x5d, 0xdf, 0x9e, 0x5c, 0xd0, 0xd3, 0x3e, 0x0d, 0x84,
+	0x7f, 0x49, 0xde, 0x46, 0x22, 0x85, 0x85, 0xde, 0x8e, 0x8c, 0x0f, 0xa1, 0xcc, 0x20, 0x92, 0x01,
+	0xfc, 0x79, 0x28, 0xdb, 0x6e, 0xd3, 0x0b, 0xb5, 0x05, 0x0e, 0xe6, 0xc9, 0xc9, 0xc0, 0x5c, 0x73,
+	0x9b, 0x9e, 0x21, 0x18, 0xe2, 0x3b, 0xb0, 0x18, 0xd0, 0x28, 0xd8, 0x55, 0x56, 0xd0, 0x80, 0xdb,
+	0xf5, 0xb3, 0x93, 0x49, 0x30, 0xd2, 0x2c, 0x8d, 0xac, 0x04, 0xbc, 0x01, 0xd5, 0x30, 0xf1, 0x31,
+	0xad, 0xca, 0x05, 0x6a, 0x19, 0x46, 0x29, 0x1f, 0x34, 0xd2, 0x93, 0x07, 0xbc, 0x7b, 0x5f, 0xb1,
+	0x77, 0x2f, 0x8e, 0x3c, 0x09, 0xf7, 0x8f, 0x71, 0x12, 0x1e, 0xe8, 0x3b, 0x09, 0xf5, 0x0f, 0x10,
+	0x2c, 0x0d, 0x04, 0xa7, 0x6d, 0x9f, 0x16, 0x6e, 0x03, 0x02, 0xb3, 0xa1, 0x4f, 0x4d, 0x7e, 0x52,
+	0x55, 0xd7, 0xaf, 0x4f, 0x2d, 0x5a, 0x71, 0xb9, 0x9c, 0x75, 0x51, 0x40, 0x9d, 0x30, 0x2e, 0xfc,
+	0x10, 0xc1, 0xff, 0xa6, 0x64, 0xde, 0x24, 0x91, 0xd9, 0x2e, 0x52, 0x96, 0xed, 0x5f, 0x36, 0x47,
+	0x9e, 0xcb, 0x82, 0x60, 0x56, 0xe5, 0x0f, 0xb7, 0x76, 0x7d, 0x06, 0x90, 0xfd, 0x92, 0x0c, 0x4c,
+	0x98, 0x70, 0xbd, 0x83, 0xa0, 0x96, 0x8e, 0xe1, 0x9e, 0xe3, 0x3c, 0x4f, 0xcc, 0x9d, 0x22, 0x90,
+	0xfb, 0xa1, 0x64, 0x5b, 0x1c, 0xe1, 0x8c, 0x51, 0xb2, 0xad, 0x3d, 0x06, 0xa3, 0x7e, 0xb8, 0x73,
+	0xc5, 0x70, 0xe7, 0xb3, 0x70, 0x3f, 0xec, 0x83, 0xab, 0x42, 0x42, 0x01, 0xdc, 0x25, 0x58, 0x70,
+	0xfb, 0x92, 0xdf, 0x64, 0x60, 0x48, 0xd2, 0x5b, 0x1a, 0x48, 0x7a, 0x35, 0x98, 0xef, 0xc5, 0x57,
+	0x23, 0xf6, 0xb3, 0x22, 0x99, 0x8a, 0xad, 0xc0, 0xeb, 0xfa, 0xd2, 0xe8, 0x82, 0x60, 0x28, 0x76,
+	0x6c, 0x97, 0xa5, 0xf1, 0x1c, 0x05, 0x7b, 0xde, 0xfb, 0x65, 0x28, 0xa3, 0xf6, 0xcf, 0x4a, 0xf0,
+	0x7f, 0x43, 0xd4, 0x1e, 0xe9, 0x4f, 0x77, 0x87, 0xee, 0xb1, 0x57, 0xcf, 0xe7, 0x7a, 0x75, 0x65,
+	0x94, 0x57, 0x2f, 0x14, 0xdb, 0x0b, 0xb2, 0xf6, 0xfa, 0x49, 0x09, 0x96, 0x87, 0xd8, 0x6b, 0x74,
+	0x3a, 0x71, 0xd7, 0x18, 0xac, 0xe9, 0x05, 0xa6, 0xba, 0x30, 0x08, 0x82, 0xed, 0x33, 0x2f, 0xf0,
+	0xdb, 0x44, 0xdd, 0x16, 0x24, 0x35, 0xa1, 0xa9, 0x2e, 0x81, 0xa6, 0xcc, 0x73, 0xd1, 0x14, 0x41,
+	0x2a, 0x20, 0x1d, 0x1a, 0xd1, 0x20, 0xcc, 0x0b, 0x51, 0x3d, 0xe2, 0x74, 0xa9, 0x0a, 0x51, 0x9c,
+	0xd0, 0x5f, 0x2b, 0xf5, 0xb3, 0x31, 0xba, 0xee, 0xdd, 0x6f, 0xe8, 0xa3, 0x30, 0x47, 0x38, 0x5a,
+	0xe9, 0x9a, 0x92, 0x1a, 0x30, 0x69, 0xa5, 0xd8, 0xa4, 0x0b, 0x19, 0x93, 0x6e, 0x94, 0x34, 0xa4,
+	0x7f, 0x50, 0x82, 0x5a, 0x9e, 0x41, 0x9e, 0x5d, 0xff, 0x6f, 0x33, 0x09, 0x26, 0xa0, 0x05, 0x39,
+	0x5e, 0xa6, 0x01, 0x4f, 0xce, 0x4e, 0x65, 0x4e, 0xec, 0x3c, 0x97, 0x34, 0x72, 0xd9, 0xe8, 0x5f,
+	0x47, 0x70, 0x2c, 0xfb, 0x5a, 0xb8, 0x65, 0x87, 0x91, 0xba, 0xd8, 0xe1, 0x26, 0xcc, 0x0b, 0x55,
+	0x44, 0x5a, 0x5e, 0x5d, 0xdf, 0x9a, 0x34, 0x59, 0xcb, 0xac, 0xae, 0x62, 0xae, 0x3f, 0x02, 0xc7,
+	0x86, 0x9e, 0x50, 0x12, 0x46, 0x0d, 0x2a, 0x2a, 0x41, 0x95, 0xab, 0x1f, 0xd3, 0xfa, 0x5b, 0xb3,
+	0xd9, 0x74, 0xc1, 0xb3, 0xb6, 0xbc, 0x56, 0x41, 0x7d, 0xa7, 0xd8, 0x63, 0xd8, 0x6a, 0x78, 0x56,
+	0xaa, 0x94, 0xa3, 0x48, 0xf6, 0x9e, 0xe9, 0xb9, 0x11, 0xb1, 0x5d, 0x1a, 0xc8, 0x8c, 0x26, 0x19,
+	0x60, 0x2b, 0x1d, 0xda, 0xae, 0x49, 0xb7, 0xa9, 0xe9, 0xb9, 0x56, 0xc8, 0x5d, 0x66, 0xc6, 0xc8,
+	0x8c, 0xe1, 0xab, 0xb0, 0xc0, 0xe9, 0x5b, 0x76, 0x47, 0x1c, 0xe1, 0xd5, 0xf5, 0xd5, 0xba, 0xa8,
+	0xb9, 0xd6, 0xd3, 0x35, 0xd7, 0xc4, 0x86, 0xac, 0xe6, 0x5a, 0xef, 0x9d, 0xaf, 0xb3, 0x37, 0x8c,
+	0xe4, 0x65, 0x86, 0x25, 0x22, 0xb6, 0xb3, 0x65, 0xbb, 0xfc, 0xd2, 0xc0, 0x44, 0x25, 0x03, 0xcc,
+	0x1b, 0x9b, 0x9e, 0xe3, 0x78, 0x2f, 0xa8, 0x98, 0x27, 0x28, 0xf6, 0x56, 0xd7, 0x8d, 0x6c, 0x87,
+	0xcb, 0x17, 0xbe, 0x96, 0x0c, 0xf0, 0xb7, 0x6c, 0x27, 0xa2, 0x81, 0x0c, 0x76, 0x92, 0x8a, 0xfd,
+	0xbd, 0xca, 0x47, 0xe3, 0x58, 0x2b, 0x76, 0xc6, 0xbe, 0xf4, 0xce, 0xe8, 0xdf, 0x6d, 0x8b, 0x43,
+	0x6a, 0x61, 0xbc, 0xaa, 0x4a, 0x7b, 0xb6, 0xd7, 0x65, 0xf9, 0x30, 0x4f, 0x1b, 0x15, 0x3d, 0xb0,
+	0x5b, 0x0e, 0x14, 0xef, 0x96, 0x83, 0xd9, 0xdd, 0xc2, 0x6f, 0x35, 0x91, 0xd9, 0xde, 0x24, 0x21,
+	0xd5, 0x0e, 0x71, 0xd6, 0xc9, 0x80, 0xfe, 0x5b, 0x04, 0x95, 0x2d, 0xaf, 0x75, 0xd9, 0x8d, 0x82,
+	0x5d, 0xc6, 0x84, 0xad, 0x1c, 0x75, 0x95, 0x37, 0x29, 0x92, 0x2d, 0x51, 0x64, 0x77, 0xe8, 0x76,
+	0x44, 0x3a, 0xbe, 0xcc, 0x9e, 0xf7, 0xb4, 0x44, 0xf1, 0xcb, 0xcc, 0x6c, 0x0e, 0x09, 0x23, 0x1e,
+	0x72, 0x2a, 0x06, 0x7f, 0x66, 0x0a, 0xc6, 0x13, 0xb6, 0xa3, 0x40, 0xc6, 0x9b, 0xcc, 0x58, 0xda,
+	0x01, 0xcb, 0x02, 0x9b, 0x24, 0xf5, 0x0e, 0xdc, 0x1b, 0x5f, 0xeb, 0x6e, 0xd1, 0xa0, 0x63, 0xbb,
+	0xa4, 0xf8, 0x5c, 0x1e, 0xa3, 0xd8, 0x5b, 0x50, 0x55, 0xf0, 0x32, 0x5b, 0x92, 0xdd, 0x92, 0x6e,
+	0xdb, 0xae, 0xe5, 0xbd, 0x50, 0xb0, 0xb5, 0x26, 0x13, 0xf8, 0xe7, 0x6c, 0xbd, 0x36, 0x25, 0x31,
+	0x8e, 0x03, 0x57, 0x61, 0x91, 0x45, 0x8c, 0x1e, 0x95, 0x3f, 0xc8, 0xa0, 0xa4, 0xe7, 0x95, 0xc1,
+	0x12, 0x1e, 0x46, 0xf6, 0x45, 0xbc, 0x05, 0x07, 0x48, 0x18, 0xda, 0x2d, 0x97, 0x5a, 0x8a, 0x57,
+	0x69, 0x6c, 0x5e, 0xfd, 0xaf, 0x8a, 0x82, 0x0a, 0x9f, 0x21, 0xd7, 0x5b, 0x91, 0xfa, 0x57, 0x11,
+	0x1c, 0x19, 0xca, 0x24, 0xde, 0x57, 0x28, 0x75, 0x8e, 0xb0, 0x6e, 0x81, 0xd9, 0xa6, 0x56, 0xd7,
+	0x51, 0xa9, 0x42, 0x4c, 0xb3, 0xdf, 0xac, 0xae, 0x58, 0x7d, 0x79, 0x8e, 0xc5, 0x34, 0x3e, 0x0e,
+	0xd0, 0x21, 0x6e, 0x97, 0x38, 0x1c, 0xc2, 0x2c, 0x87, 0x90, 0x1a, 0xd1, 0x97, 0xa0, 0x36, 0xcc,
+	0x75, 0x64, 0xf5, 0xee, 0x1f, 0x08, 0xf6, 0xab, 0x90, 0x2b, 0x57, 0x77, 0x05, 0x0e, 0xa4, 0xcc,
+	0x70, 0x23, 0x59, 0xe8, 0xfe, 0xe1, 0x11, 0xe1, 0x54, 0x79, 0xc9, 0x4c, 0xb6, 0xe5, 0xd2, 0xcb,
+	0x34, 0x4d, 0xc6, 0x3e, 0x70, 0xd1, 0x94, 0x6e, 0x06, 0x5f, 0x01, 0xed, 0x3a, 0x71, 0x49, 0x8b,
+	0x5a, 0xb1, 0xda, 0xb1, 0x8b, 0x7d, 0x29, 0x5d, 0x86, 0x9a, 0xb8, 0xe8, 0x13, 0x27, 0xd1, 0x76,
+	0xb3, 0xa9, 0x4a, 0x5a, 0xaf, 0x97, 0xb2, 0x7e, 0xce, 0xbb, 0x59, 0xdb, 0xb6, 0xc5, 0x27, 0x09,
+	0xf3, 0x6b, 0x30, 0x2f, 0x55, 0x51, 0x01, 0x4a, 0x92, 0x93, 0x6d, 0x31, 0xec, 0xc3, 0xa2, 0x63,
+	0xf7, 0x68, 0xac, 0xb5, 0x36, 0x3b, 0x75, 0x25, 0xb3, 0x02, 0x98, 0x23, 0x45, 0x24, 0x68, 0xd1,
+	0xe8, 0x7a, 0x5c, 0x71, 0x2a, 0xf3, 0x12, 0x47, 0xff, 0xb0, 0xfe, 0x46, 0xb6, 0x36, 0x9f, 0x35,
+	0xcb, 0x7f, 0x6e, 0x79, 0x78, 0xae, 0xe1, 0x59, 0x76, 0xd3, 0xa6, 0xe2, 0xbe, 0x5e, 0x31, 0x62,
+	0x5a, 0x7f, 0x1b, 0x65, 0xae, 0x94, 0x57, 0x77, 0xad, 0x80, 0x44, 0xf4, 0x12, 0xbf, 0xba, 0x7f,
+	0x64, 0x91, 0x98, 0xf5, 0x8f, 0x58, 0x75, 0x40, 0xd5, 0x35, 0x65, 0xff, 0x28, 0x35, 0x24, 0xab,
+	0x0b, 0x22, 0xe7, 0x28, 0xd9, 0x16, 0x2b, 0xa1, 0xdc, 0x3b, 0x88, 0xf3, 0x6e, 0x42, 0x78, 0x03,
+	0x96, 0x07, 0x01, 0x2a, 0x43, 0xca, 0xb5, 0x16, 0x35, 0x92, 0xed, 0x36, 0x91, 0x2d, 0x4c, 0x49,
+	0x31, 0xfc, 0x96, 0xdd, 0x6c, 0x4a, 0x8c, 0xfc, 0x59, 0x0f, 0xa0, 0xb2, 0x65, 0xbb, 0x3b, 0xac,
+	0xdc, 0xc8, 0xc2, 0x48, 0x64, 0x47, 0x8e, 0x52, 0x50, 0x10, 0xf8, 0x20, 0xcc, 0x74, 0x03, 0x47,
+	0x86, 0x55, 0xf6, 0xc8, 0x51, 0xd3, 0xd0, 0x0c, 0x6c, 0x5f, 0x06, 0x55, 0x81, 0x3a, 0x19, 0x62,
+	0xc1, 0xcd, 0x36, 0x3d, 0x77, 0xd3, 0x21, 0x61, 0xa8, 0x72, 0xbe, 0x78, 0x40, 0x7f, 0x0c, 0x16,
+	0x99, 0xcc, 0x24, 0x76, 0x9c, 0xc9, 0x3a, 0xe7, 0x91, 0x8c, 0xd3, 0x29, 0x78, 0x2a, 0x0c, 0x10,
+	0xb8, 0x87, 0xa5, 0xda, 0x17, 0x7d, 0x5f, 0x32, 0x19, 0xf3, 0xde, 0x37, 0x33, 0x2c, 0x65, 0x1d,
+	0xda, 0x5a, 0x5a, 0x7f, 0x77, 0x15, 0x70, 0xdf, 0x96, 0xb2, 0x4d, 0x8a, 0xbf, 0x8d, 0x60, 0x96,
+	0x89, 0xc6, 0xf7, 0xe5, 0x9d, 0x75, 0x3c, 0x0a, 0xd5, 0xa6, 0x57, 0x37, 0x64, 0xd2, 0xf4, 0xa5,
+	0x57, 0xfe, 0xf2, 0xb7, 0xef, 0x94, 0x8e, 0xe2, 0xc3, 0xfc, 0x23, 0x84, 0xde, 0xf9, 0xf4, 0x07,
+	0x01, 0x21, 0x7e, 0x15, 0x01, 0x96, 0x57, 0x8f, 0x54, 0x9b, 0x16, 0x9f, 0xc9, 0x83, 0x38, 0xa4,
+	0x9d, 0x5b, 0xbb, 0x2f, 0x95, 0xaa, 0xd5, 0x4d, 0x2f, 0xa0, 0x2c, 0x31, 0xe3, 0x13, 0x38, 0x80,
+	0x55, 0x0e, 0xe0, 0x24, 0xd6, 0x87, 0x01, 0x68, 0xbc, 0xc4, 0x2c, 0xfa, 0x72, 0x83, 0x0a, 0xb9,
+	0x6f, 0x22, 0x28, 0xdf, 0xe6, 0x25, 0x97, 0x11, 0x46, 0xda, 0x9e, 0x9a, 0x91, 0xb8, 0x38, 0x8e,
+	0x56, 0x3f, 0xc1, 0x91, 0xde, 0x87, 0x8f, 0x29, 0xa4, 0x61, 0x14, 0x50, 0xd2, 0xc9, 0x00, 0x3e,
+	0x87, 0xf0, 0xdb, 0x08, 0xe6, 0x44, 0xaf, 0x0d, 0x9f, 0xca, 0x43, 0x99, 0xe9, 0xc5, 0xd5, 0xa6,
+	0xd7, 0xb8, 0xd2, 0x1f, 0xe4, 0x18, 0x4f, 0xe8, 0x43, 0x97, 0x73, 0x23, 0xd3, 0xd6, 0x7a, 0x1d,
+	0xc1, 0xcc, 0x15, 0x3a, 0xd2, 0xdf, 0xa6, 0x08, 0x6e, 0xc0, 0x80, 0x43, 0x96, 0x1a, 0xbf, 0x85,
+	0xe0, 0xde, 0x2b, 0x34, 0x1a, 0x9e, 0x73, 0xe2, 0x95, 0xd1, 0x89, 0xa0, 0x74, 0xbb, 0x33, 0x63,
+	0xcc, 0x8c, 0x93, 0xad, 0x06, 0x47, 0xf6, 0x20, 0x3e, 0x5d, 0xe4, 0x84, 0xac, 0x0d, 0xf1, 0x82,
+	0xc4, 0xf1, 0x07, 0x04, 0x07, 0xfb, 0x3f, 0xc7, 0xc0, 0x7a, 0xdf, 0xc5, 0x7f, 0xc8, 0xd7, 0x1a,
+	0xb5, 0x1b, 0x93, 0x9e, 0x8d, 0x59, 0xa6, 0xfa, 0x45, 0x8e, 0xfc, 0x51, 0xfc, 0x48, 0x11, 0xf2,
+	0xb8, 0x71, 0xd1, 0x78, 0x49, 0x3d, 0xbe, 0xdc, 0xe8, 0x48, 0x16, 0xf8, 0x8f, 0x08, 0x0e, 0x2b,
+	0xbe, 0x9b, 0x6d, 0x12, 0x44, 0x97, 0x28, 0xbb, 0xb6, 0x86, 0x63, 0xe9, 0x33, 0xe1, 0x59, 0x9f,
+	0x96, 0xa7, 0x5f, 0xe6, 0xba, 0x3c, 0x81, 0x1f, 0xdf, 0xb3, 0x2e, 0x26, 0x63, 0x63, 0x49, 0xd8,
+	0xef, 0x21, 0xd8, 0x7f, 0x85, 0x46, 0x4f, 0x6f, 0x5e, 0xdb, 0xd3, 0xca, 0x4c, 0xe8, 0xe8, 0x29,
+	0x71, 0xfa, 0x25, 0xae, 0xc8, 0xa7, 0xf0, 0x63, 0x7b, 0x56, 0xc4, 0x33, 0xed, 0x78, 0x5d, 0x5e,
+	0x41, 0xb0, 0xef, 0x4a, 0x2a, 0x19, 0xcb, 0x0f, 0x27, 0x99, 0x0f, 0x0b, 0x6a, 0x4b, 0xf5, 0xd4,
+	0x97, 0x57, 0xea, 0xa7, 0xd8, 0xd5, 0xd7, 0x38, 0xb6, 0xd3, 0xf8, 0x54, 0x11, 0xb6, 0xa4, 0xf1,
+	0xf8, 0x26, 0x82, 0x23, 0x69, 0x10, 0xc9, 0x07, 0x19, 0xff, 0xbf, 0xb7, 0xcf, 0x1c, 0xe4, 0xc7,
+	0x12, 0x23, 0xd0, 0xad, 0x73, 0x74, 0x67, 0xf5, 0xe1, 0x1b, 0xb1, 0x33, 0x80, 0x62, 0x03, 0xad,
+	0xae, 0x20, 0xfc, 0x2e, 0x82, 0x39, 0xd1, 0x83, 0xcb, 0xb7, 0x51, 0xe6, 0x03, 0x82, 0x69, 0x46,
+	0x35, 0xe9, 0xb5, 0xb5, 0x73, 0xc3, 0x0d, 0x9a, 0x7e, 0x5f, 0x2d, 0x6d, 0x9d, 0x5b, 0x39, 0x1b,
+	0x8e, 0x7f, 0x89, 0x00, 0x92, 0x3e, 0x22, 0x7e, 0xb0, 0x58, 0x8f, 0x54, 0xaf, 0xb1, 0x36, 0xdd,
+	0x4e, 0xa2, 0x5e, 0xe7, 0xfa, 0xac, 0xd4, 0x96, 0x0b, 0x63, 0xa1, 0x4f, 0xcd, 0x0d, 0xd1, 0x73,
+	0xfc, 0x11, 0x82, 0x32, 0x6f, 0xdf, 0xe0, 0x93, 0x79, 0x98, 0xd3, 0xdd, 0x9d, 0x69, 0x9a, 0xfe,
+	0x01, 0x0e, 0x75, 0x79, 0xbd, 0xe8, 0x40, 0xd9, 0x40, 0xab, 0xb8, 0x07, 0x73, 0xa2, 0x61, 0x92,
+	0xef, 0x1e, 0x99, 0x86, 0x4a, 0x6d, 0xb9, 0x20, 0xc1, 0x11, 0x8e, 0x2a, 0xcf, 0xb2, 0xd5, 0x51,
+	0x67, 0xd9, 0x2c, 0x3b, 0x6e, 0xf0, 0x89, 0xa2, 0xc3, 0xe8, 0x23, 0x30, 0xcc, 0x19, 0x8e, 0xee,
+	0x94, 0xbe, 0x3c, 0xea, 0x3c, 0x63, 0xd6, 0xf9, 0x2e, 0x82, 0x83, 0xfd, 0x37, 0x6f, 0x7c, 0x6c,
+	0x68, 0x11, 0x5b, 0x9e, 0xad, 0x59, 0x2b, 0xe6, 0xdd, 0xda, 0xf5, 0x4f, 0x73, 0x14, 0x1b, 0xf8,
+	0xe1, 0x91, 0x3b, 0xe3, 0x86, 0x8a, 0x3a, 0x8c, 0xd1, 0x5a, 0xf2, 0x51, 0xc4, 0x8f, 0x11, 0xec,
+	0xcf, 0xde, 0x39, 0xf3, 0x73, 0xcf, 0x21, 0x57, 0xf6, 0x5a, 0x7d, 0xbc, 0xc9, 0x31, 0xe2, 0x4f,
+	0x72, 0xc4, 0xe7, 0x71, 0x23, 0x17, 0xb1, 0x40, 0x2a, 0x3e, 0x76, 0x5d, 0x0b, 0x6d, 0x8b, 0xae,
+	0xb1, 0x9b, 0x0e, 0x7e, 0x03, 0xc1, 0x62, 0xe6, 0xbe, 0x84, 0xcf, 0xe6, 0x89, 0x1e, 0x76, 0x3f,
+	0xad, 0xad, 0x8d, 0x39, 0x5b, 0xe2, 0x7c, 0x88, 0xe3, 0x5c, 0xc3, 0x67, 0x8a, 0xd6, 0xb7, 0x2d,
+	0x5e, 0x6d, 0x58, 0xc1, 0xee, 0x5a, 0xd0, 0x75, 0xf1, 0x3b, 0x08, 0xe6, 0x25, 0x3b, 0xfc, 0xc0,
+	0x08, 0x79, 0x1f, 0x81, 0x4f, 0xca, 0xb8, 0xa2, 0x9f, 0x18, 0x03, 0x33, 0x73, 0xcb, 0x5f, 0x21,
+	0xd8, 0xa7, 0x7c, 0xea, 0x56, 0x40, 0x69, 0xb1, 0x4b, 0x4e, 0x2f, 0x08, 0x32, 0x59, 0xfa, 0x63,
+	0x1c, 0xec, 0x27, 0xf0, 0x85, 0x31, 0x5d, 0x57, 0xb9, 0xec, 0x5a, 0xc4, 0x90, 0xfe, 0x0e, 0xc1,
+	0xa1, 0xdb, 0x22, 0xe6, 0x7d, 0x4c, 0xf8, 0x37, 0x39, 0xfe, 0xc7, 0xf1, 0xa3, 0x05, 0x77, 0x95,
+	0x51, 0x6a, 0x9c, 0x43, 0xf8, 0xe7, 0x08, 0x2a, 0xea, 0x43, 0x0a, 0x7c, 0x3a, 0x37, 0x28, 0x66,
+	0x3f, 0xb5, 0x98, 0xa6, 0xd3, 0xc8, 0xc4, 0x5c, 0x3f, 0x59, 0x98, 0x49, 0x49, 0xf9, 0xcc, 0x6b,
+	0x5e, 0x47, 0x80, 0xe3, 0x62, 0x6a, 0x5c, 0x5e, 0xed, 0xf3, 0xf7, 0xdc, 0x8a, 0x7d, 0xed, 0xf4,
+	0xc8, 0x79, 0xd9, 0x34, 0x6a, 0xb5, 0x30, 0x8d, 0xf2, 0x62, 0xf9, 0xaf, 0x21, 0xa8, 0x5e, 0xa1,
+	0xf1, 0x3d, 0xba, 0xc0, 0x96, 0xd9, 0xef, 0x40, 0x6a, 0x2b, 0xa3, 0x27, 0x4a, 0x44, 0x67, 0x39,
+	0xa2, 0x07, 0x70, 0xb1, 0xa9, 0x14, 0x80, 0xef, 0x21, 0x58, 0xbc, 0x99, 0x76, 0xd1, 0xfc, 0x80,
+	0x35, 0xec, 0x1b, 0x8d, 0x3d, 0xe0, 0x92, 0xb1, 0x4a, 0x1f, 0x0b, 0xd7, 0x86, 0xfc, 0xa4, 0xe2,
+	0x07, 0x48, 0x14, 0x62, 0xfa, 0xda, 0xa0, 0xff, 0xae, 0xdd, 0x0a, 0xba, 0xa9, 0xfa, 0x05, 0x8e,
+	0xaf, 0x8e, 0xcf, 0x8e, 0x83, 0xaf, 0x21, 0x7b, 0xa3, 0xf8, 0xfb, 0x08, 0x0e, 0x89, 0x88, 0x9c,
+	0x62, 0x8c, 0x8b, 0x5a, 0xbf, 0xa9, 0x68, 0x3f, 0x3a, 0xbd, 0x78, 0x42, 0xc4, 0x1f, 0x7d, 0x4f,
+	0xa0, 0x36, 0x64, 0x87, 0xfb, 0x1b, 0x25, 0xc4, 0xd6, 0xf7, 0x9e, 0x01, 0x7c, 0xcf, 0xae, 0xf7,
+	0x19, 0x30, 0xbf, 0xaf, 0x3f, 0x06, 0xc6, 0x0d, 0x8e, 0xf1, 0x82, 0xde, 0xd8, 0x0b, 0xc6, 0x46,
+	0x6f, 0x9d, 0x6d, 0xd3, 0x6f, 0x22, 0xd8, 0xaf, 0x52, 0x2e, 0xe9, 0x7f, 0x6b, 0xa3, 0x96, 0x76,
+	0xaf, 0x29, 0x9a, 0xdc, 0x10, 0xab, 0xe3, 0x6d, 0x88, 0xb7, 0x11, 0xcc, 0xcb, 0x36, 0x75, 0x41,
+	0x22, 0x9b, 0xea, 0x63, 0xd7, 0xfa, 0x2a, 0x89, 0xb2, 0x8f, 0xa9, 0x7f, 0x81, 0x8b, 0x7d, 0x06,
+	0x17, 0x9a, 0xc5, 0xf7, 0xac, 0xb0, 0xf1, 0x92, 0x6c, 0x22, 0xbe, 0xdc, 0x70, 0xbc, 0x56, 0xf8,
+	0x9c, 0x8e, 0x0b, 0xd3, 0x35, 0x36, 0xe7, 0x1c, 0xc2, 0x11, 0x2c, 0x30, 0xf7, 0xe5, 0xe5, 0x49,
+	0x9c, 0x35, 0xc2, 0x90, 0xca, 0x65, 0xad, 0x36, 0x50, 0xee, 0x4c, 0xf2, 0x33, 0x59, 0x2c, 0xc2,
+	0xf7, 0x17, 0x8a, 0xe5, 0x82, 0x5e, 0x45, 0x70, 0x28, 0xbd, 0x1f, 0x85, 0xf8, 0xb1, 0x77, 0x63,
+	0x11, 0x0a, 0x79, 0xe5, 0xc3, 0xab, 0x63, 0xb9, 0x11, 0x87, 0xf3, 0xe4, 0x53, 0xbf, 0x7f, 0xff,
+	0x38, 0xfa, 0xd3, 0xfb, 0xc7, 0xd1, 0x5f, 0xdf, 0x3f, 0x8e, 0x9e, 0x7b, 0x78, 0xbc, 0x3f, 0x3b,
+	0x99, 0x8e, 0x4d, 0xdd, 0x28, 0xcd, 0xfe, 0x5f, 0x03, 0x00, 0xe8, 0x78, 0x28, 0xd2, 0xd2, 0x35,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3414,6 +3657,11 @@ type ApplicationServiceClient interface {
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// ServerSideDiff performs server-side diff calculation using dry-run apply
 	ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error)
+	// HydrateDryRun hydrates the manifests of an application and returns the changes to the sync source, without
+	// committing them
+	HydrateDryRun(ctx context.Context, in *ApplicationHydrateDryRunRequest, opts ...grpc.CallOption) (*ApplicationHydrateDryRunResponse, error)
+	// Hydrate requests the hydration of the manifests of an application, even if the dry revision was already hydrated
+	Hydrate(ctx context.Context, in *ApplicationHydrateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3663,6 +3911,24 @@ func (c *applicationServiceClient) ServerSideDiff(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationServiceClient) HydrateDryRun(ctx context.Context, in *ApplicationHydrateDryRunRequest, opts ...grpc.CallOption) (*ApplicationHydrateDryRunResponse, error) {
+	out := new(ApplicationHydrateDryRunResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/HydrateDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Hydrate(ctx context.Context, in *ApplicationHydrateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Hydrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	out := new(v1alpha1.ApplicationTree)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
@@ -3865,6 +4131,11 @@ type ApplicationServiceServer interface {
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
 	// ServerSideDiff performs server-side diff calculation using dry-run apply
 	ServerSideDiff(context.Context, *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error)
+	// HydrateDryRun hydrates the manifests of an application and returns the changes to the sync source, without
+	// committing them
+	HydrateDryRun(context.Context, *ApplicationHydrateDryRunRequest) (*ApplicationHydrateDryRunResponse, error)
+	// Hydrate requests the hydration of the manifests of an application, even if the dry revision was already hydrated
+	Hydrate(context.Context, *ApplicationHydrateRequest) (*v1alpha1.Application, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3954,6 +4225,12 @@ func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Conte
 func (*UnimplementedApplicationServiceServer) ServerSideDiff(ctx context.Context, req *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerSideDiff not implemented")
 }
+func (*UnimplementedApplicationServiceServer) HydrateDryRun(ctx context.Context, req *ApplicationHydrateDryRunRequest) (*ApplicationHydrateDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HydrateDryRun not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Hydrate(ctx context.Context, req *ApplicationHydrateRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hydrate not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -4333,6 +4610,42 @@ func _ApplicationService_ServerSideDiff_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_HydrateDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHydrateDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).HydrateDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/HydrateDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).HydrateDryRun(ctx, req.(*ApplicationHydrateDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Hydrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHydrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Hydrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Hydrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Hydrate(ctx, req.(*ApplicationHydrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -4641,6 +4954,14 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ServerSideDiff",
 			Handler:    _ApplicationService_ServerSideDiff_Handler,
 		},
+		{
+			MethodName: "HydrateDryRun",
+			Handler:    _ApplicationService_HydrateDryRun_Handler,
+		},
+		{
+			MethodName: "Hydrate",
+			Handler:    _ApplicationService_Hydrate_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
@@ -5003,6 +5324,16 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Explain != nil {
+		i--
+		if *m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.NoCache != nil {
 		i--
 		if *m.NoCache {
@@ -7270,7 +7601,7 @@ func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationHydrateDryRunRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7280,12 +7611,12 @@ func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateDryRunRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateDryRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7294,42 +7625,45 @@ func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IconClass != nil {
-		i -= len(*m.IconClass)
-		copy(dAtA[i:], *m.IconClass)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+	if m.Id != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DryRevision != nil {
+		i -= len(*m.DryRevision)
+		copy(dAtA[i:], *m.DryRevision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.DryRevision)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Description != nil {
-		i -= len(*m.Description)
-		copy(dAtA[i:], *m.Description)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Url == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
-	} else {
-		i -= len(*m.Url)
-		copy(dAtA[i:], *m.Url)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Title == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
-		i -= len(*m.Title)
-		copy(dAtA[i:], *m.Title)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationHydrateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7339,12 +7673,12 @@ func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7353,20 +7687,182 @@ func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	if m.Id != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DryRevision != nil {
+		i -= len(*m.DryRevision)
+		copy(dAtA[i:], *m.DryRevision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.DryRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationHydrateDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationHydrateDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationHydrateDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Diff != nil {
+		i -= len(*m.Diff)
+		copy(dAtA[i:], *m.Diff)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Diff)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrySha != nil {
+		i -= len(*m.DrySha)
+		copy(dAtA[i:], *m.DrySha)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.DrySha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IconClass != nil {
+		i -= len(*m.IconClass)
+		copy(dAtA[i:], *m.IconClass)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Url == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	} else {
+		i -= len(*m.Url)
+		copy(dAtA[i:], *m.Url)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Title == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	} else {
+		i -= len(*m.Title)
+		copy(dAtA[i:], *m.Title)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -7605,6 +8101,9 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 	if m.NoCache != nil {
 		n += 2
 	}
+	if m.Explain != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8612,6 +9111,88 @@ func (m *ApplicationServerSideDiffResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationHydrateDryRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRevision != nil {
+		l = len(*m.DryRevision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		n += 1 + sovApplication(uint64(*m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationHydrateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRevision != nil {
+		l = len(*m.DryRevision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		n += 1 + sovApplication(uint64(*m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationHydrateDryRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrySha != nil {
+		l = len(*m.DrySha)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Diff != nil {
+		l = len(*m.Diff)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LinkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9896,6 +10477,27 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.NoCache = &b
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Explain = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -16560,6 +17162,539 @@ func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationHydrateDryRunRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DryRevision = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationHydrateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DryRevision = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationHydrateDryRunResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DrySha = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Diff = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -389,10 +389,12 @@ func (m *ApplicationSetTreeQuery) GetAppsetNamespace() string {
 // ApplicationSetGetQuery is a query for applicationset resources
 type ApplicationSetGenerateRequest struct {
 	// the applicationsets
-	ApplicationSet       *v1alpha1.ApplicationSet `protobuf:"bytes,1,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ApplicationSet *v1alpha1.ApplicationSet `protobuf:"bytes,1,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
+	// whether to diff the generated applications against the live applications owned by the applicationset
+	Diff                 bool     `protobuf:"varint,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSetGenerateRequest) Reset()         { *m = ApplicationSetGenerateRequest{} }
@@ -435,12 +437,21 @@ func (m *ApplicationSetGenerateRequest) GetApplicationSet() *v1alpha1.Applicatio
 	return nil
 }
 
+func (m *ApplicationSetGenerateRequest) GetDiff() bool {
+	if m != nil {
+		return m.Diff
+	}
+	return false
+}
+
 // ApplicationSetGenerateResponse is a response for applicationset generate request
 type ApplicationSetGenerateResponse struct {
-	Applications         []*v1alpha1.Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Applications []*v1alpha1.Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	// the applications which would be created, updated or deleted, if diff was requested
+	Diffs                []*ApplicationSetApplicationDiff `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *ApplicationSetGenerateResponse) Reset()         { *m = ApplicationSetGenerateResponse{} }
@@ -483,6 +494,231 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+func (m *ApplicationSetGenerateResponse) GetDiffs() []*ApplicationSetApplicationDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// ApplicationSetApplicationDiff describes how an application owned by an applicationset would change
+type ApplicationSetApplicationDiff struct {
+	// the application name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the application namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the action of the applicationset controller: create, update or delete
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// whether the action is not allowed by the applications sync policy of the applicationset
+	Blocked bool `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// the field-level differences between the live and the generated application, if the application is updated
+	Fields               []*ApplicationSetFieldDiff `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ApplicationSetApplicationDiff) Reset()         { *m = ApplicationSetApplicationDiff{} }
+func (m *ApplicationSetApplicationDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetApplicationDiff) ProtoMessage()    {}
+func (*ApplicationSetApplicationDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{8}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetApplicationDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApplicationDiff.Merge(m, src)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApplicationDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApplicationDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApplicationDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetApplicationDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *ApplicationSetApplicationDiff) GetFields() []*ApplicationSetFieldDiff {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// ApplicationSetFieldDiff is a difference of a field between the live and the generated application
+type ApplicationSetFieldDiff struct {
+	// the path of the field, e.g. spec.source.targetRevision
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the JSON value of the field in the live application. Empty if the field is not set
+	LiveValue string `protobuf:"bytes,2,opt,name=liveValue,proto3" json:"liveValue,omitempty"`
+	// the JSON value of the field in the generated application. Empty if the field is not set
+	TargetValue          string   `protobuf:"bytes,3,opt,name=targetValue,proto3" json:"targetValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetFieldDiff) Reset()         { *m = ApplicationSetFieldDiff{} }
+func (m *ApplicationSetFieldDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetFieldDiff) ProtoMessage()    {}
+func (*ApplicationSetFieldDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
+}
+func (m *ApplicationSetFieldDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetFieldDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetFieldDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetFieldDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetFieldDiff.Merge(m, src)
+}
+func (m *ApplicationSetFieldDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetFieldDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetFieldDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetFieldDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetFieldDiff) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ApplicationSetFieldDiff) GetLiveValue() string {
+	if m != nil {
+		return m.LiveValue
+	}
+	return ""
+}
+
+func (m *ApplicationSetFieldDiff) GetTargetValue() string {
+	if m != nil {
+		return m.TargetValue
+	}
+	return ""
+}
+
+// ApplicationSetRolloutPromoteRequest is a request to promote a RollingSync step of an applicationset waiting for approval
+type ApplicationSetRolloutPromoteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the number of the step to promote. Default empty is the step waiting for approval
+	Step                 string   `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) Reset()         { *m = ApplicationSetRolloutPromoteRequest{} }
+func (m *ApplicationSetRolloutPromoteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetRolloutPromoteRequest) ProtoMessage()    {}
+func (*ApplicationSetRolloutPromoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{10}
+}
+func (m *ApplicationSetRolloutPromoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutPromoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetRolloutPromoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetRolloutPromoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutPromoteRequest.Merge(m, src)
+}
+func (m *ApplicationSetRolloutPromoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutPromoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutPromoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutPromoteRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutPromoteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) GetStep() string {
+	if m != nil {
+		return m.Step
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -492,6 +728,9 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetApplicationDiff)(nil), "applicationset.ApplicationSetApplicationDiff")
+	proto.RegisterType((*ApplicationSetFieldDiff)(nil), "applicationset.ApplicationSetFieldDiff")
+	proto.RegisterType((*ApplicationSetRolloutPromoteRequest)(nil), "applicationset.ApplicationSetRolloutPromoteRequest")
 }
 
 func init() {
@@ -499,49 +738,61 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x99, 0xb6, 0x6c, 0xb7, 0xd3, 0xa2, 0x30, 0x60, 0xbb, 0x46, 0x5d, 0x97, 0x80, 0xb5,
-	0xb6, 0x76, 0x42, 0x5b, 0x4f, 0xf5, 0xe4, 0x0f, 0x28, 0x85, 0x22, 0x9a, 0x15, 0x05, 0x3d, 0xc8,
-	0x34, 0xfb, 0x48, 0x63, 0xb3, 0xc9, 0x38, 0x33, 0x09, 0x94, 0xe2, 0x45, 0xf0, 0xec, 0x41, 0xf4,
-	0x0f, 0xd0, 0x8b, 0x7f, 0x80, 0x77, 0x0f, 0x5e, 0x3c, 0x0a, 0xfe, 0x03, 0x52, 0xfc, 0x33, 0x3c,
-	0x48, 0x26, 0xd9, 0x6d, 0x33, 0xec, 0x8f, 0x82, 0xd1, 0x5b, 0x5e, 0x66, 0xf2, 0xde, 0x67, 0xbe,
-	0xef, 0xe5, 0xcb, 0xe0, 0x65, 0x09, 0x22, 0x05, 0xe1, 0x30, 0xce, 0xc3, 0xc0, 0x63, 0x2a, 0x88,
-	0x23, 0x09, 0xca, 0x08, 0x29, 0x17, 0xb1, 0x8a, 0xc9, 0x99, 0xf2, 0x5b, 0xeb, 0xa2, 0x1f, 0xc7,
-	0x7e, 0x08, 0x0e, 0xe3, 0x81, 0xc3, 0xa2, 0x28, 0x56, 0xf9, 0x4a, 0xbe, 0xdb, 0xda, 0xf1, 0x03,
-	0xb5, 0x97, 0xec, 0x52, 0x2f, 0xee, 0x3a, 0x4c, 0xf8, 0x31, 0x17, 0xf1, 0x73, 0xfd, 0xb0, 0xea,
-	0x75, 0x9c, 0x74, 0xc3, 0xe1, 0xfb, 0x7e, 0xf6, 0xa5, 0x3c, 0x59, 0xcb, 0x49, 0xd7, 0x58, 0xc8,
-	0xf7, 0xd8, 0x9a, 0xe3, 0x43, 0x04, 0x82, 0x29, 0xe8, 0xe4, 0xd9, 0xec, 0x47, 0x78, 0xfe, 0xd6,
-	0xf1, 0xbe, 0x36, 0xa8, 0x2d, 0x50, 0x0f, 0x12, 0x10, 0x07, 0x84, 0xe0, 0xa9, 0x88, 0x75, 0xa1,
-	0x81, 0x5a, 0x68, 0x69, 0xc6, 0xd5, 0xcf, 0x64, 0x09, 0x9f, 0x65, 0x9c, 0x4b, 0x50, 0xf7, 0x58,
-	0x17, 0x24, 0x67, 0x1e, 0x34, 0x26, 0xf4, 0xb2, 0xf9, 0xda, 0x3e, 0xc4, 0x0b, 0xe5, 0xbc, 0x3b,
-	0x81, 0x2c, 0x12, 0x5b, 0xb8, 0x9e, 0x31, 0x83, 0xa7, 0x64, 0x03, 0xb5, 0x26, 0x97, 0x66, 0xdc,
-	0x7e, 0x9c, 0xad, 0x49, 0x08, 0xc1, 0x53, 0xb1, 0x28, 0x32, 0xf7, 0xe3, 0x41, 0xc5, 0x27, 0x07,
-	0x17, 0xff, 0x84, 0xcc, 0x53, 0xb9, 0x20, 0x79, 0x26, 0x2e, 0x69, 0xe0, 0xe9, 0xa2, 0x58, 0x71,
-	0xb0, 0x5e, 0x48, 0x14, 0x36, 0xfa, 0xa0, 0x01, 0x66, 0xd7, 0x77, 0xe8, 0xb1, 0xe0, 0xb4, 0x27,
-	0xb8, 0x7e, 0x78, 0xe6, 0x75, 0x68, 0xba, 0x41, 0xf9, 0xbe, 0x4f, 0x33, 0xc1, 0xe9, 0x89, 0xcf,
-	0x69, 0x4f, 0x70, 0x6a, 0x70, 0x18, 0x35, 0xec, 0xaf, 0x08, 0x5f, 0x28, 0x6f, 0xb9, 0x23, 0x80,
-	0x29, 0x70, 0xe1, 0x45, 0x02, 0x72, 0x10, 0x15, 0xfa, 0xf7, 0x54, 0x64, 0x1e, 0xd7, 0x12, 0x2e,
-	0x41, 0xe4, 0x1a, 0xd4, 0xdd, 0x22, 0xca, 0xde, 0x77, 0xc4, 0x81, 0x9b, 0x44, 0x5a, 0xf9, 0xba,
-	0x5b, 0x44, 0xf6, 0x53, 0xf3, 0x10, 0x77, 0x21, 0x84, 0xe3, 0x43, 0xfc, 0xdd, 0x28, 0x3d, 0x36,
-	0x47, 0xe9, 0xa1, 0x00, 0xa8, 0x62, 0x46, 0xdf, 0x21, 0x7c, 0xc9, 0x1c, 0xfe, 0xfc, 0xef, 0x18,
-	0xac, 0x7e, 0xfb, 0x3f, 0xa8, 0xdf, 0x06, 0x65, 0xbf, 0x41, 0xb8, 0x39, 0x8c, 0xab, 0x18, 0xe3,
-	0x2e, 0x9e, 0x3b, 0xd9, 0x32, 0xfd, 0x1f, 0xcd, 0xae, 0x6f, 0x57, 0x86, 0xe5, 0x96, 0xd2, 0xaf,
-	0xff, 0x9e, 0xc6, 0xe7, 0xca, 0x44, 0x6d, 0x10, 0x69, 0xe0, 0x01, 0xf9, 0x88, 0xf0, 0xe4, 0x16,
-	0x28, 0xb2, 0x48, 0x0d, 0x6b, 0x1b, 0xec, 0x2a, 0x56, 0xa5, 0xca, 0xd9, 0x8b, 0xaf, 0x7e, 0xfc,
-	0x7a, 0x3b, 0xd1, 0x22, 0x4d, 0xed, 0x95, 0xe9, 0x9a, 0xe1, 0xaf, 0xd2, 0x39, 0xcc, 0x46, 0xe2,
-	0x25, 0x79, 0x8f, 0x70, 0xbd, 0xa7, 0x21, 0x59, 0x1d, 0x87, 0x5a, 0x9a, 0x01, 0x8b, 0x9e, 0x76,
-	0x7b, 0xde, 0x1a, 0x7b, 0x45, 0x33, 0x5d, 0xb1, 0x5b, 0xc3, 0x98, 0x7a, 0x16, 0xbc, 0x89, 0x96,
-	0xc9, 0x07, 0x84, 0xa7, 0x32, 0x67, 0x24, 0x57, 0x47, 0x57, 0xe9, 0xbb, 0xa7, 0x75, 0xbf, 0x4a,
-	0x01, 0xb3, 0xb4, 0xf6, 0x65, 0x0d, 0x7c, 0x9e, 0x2c, 0x0c, 0x01, 0x26, 0x9f, 0x11, 0xae, 0xe5,
-	0xae, 0x44, 0x56, 0x46, 0x63, 0x96, 0xbc, 0xab, 0xe2, 0x5e, 0x3b, 0x1a, 0xf3, 0x9a, 0x3d, 0x0c,
-	0x73, 0xd3, 0x34, 0xb1, 0xd7, 0x08, 0xd7, 0x72, 0x1f, 0x1a, 0x87, 0x5d, 0x72, 0x2b, 0x6b, 0xcc,
-	0x28, 0xf7, 0x1b, 0x5d, 0x0c, 0xdf, 0xf2, 0xb8, 0xe1, 0xfb, 0x82, 0xf0, 0x9c, 0x0b, 0x32, 0x4e,
-	0x84, 0x07, 0x99, 0x75, 0x8d, 0xeb, 0x75, 0xdf, 0xde, 0xaa, 0xed, 0x75, 0x96, 0xd6, 0xbe, 0xa1,
-	0x99, 0x29, 0xb9, 0x3e, 0x9a, 0xd9, 0x11, 0x05, 0xef, 0xaa, 0x12, 0x00, 0xb7, 0xb7, 0xbf, 0x1d,
-	0x35, 0xd1, 0xf7, 0xa3, 0x26, 0xfa, 0x79, 0xd4, 0x44, 0x4f, 0x6e, 0x9e, 0xee, 0x02, 0xe2, 0x85,
-	0x01, 0x44, 0xe6, 0x8d, 0x67, 0xb7, 0xa6, 0xaf, 0x1d, 0x1b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc5, 0x6a, 0x1d, 0x1e, 0x20, 0x09, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x35, 0x6d, 0x37, 0x24, 0xd3, 0xd5, 0x22, 0x8d, 0xc4, 0x36, 0x84, 0x25, 0x44, 0x46,
+	0x2c, 0x25, 0x4b, 0x6c, 0xb5, 0xe1, 0x42, 0x39, 0x20, 0xd8, 0x15, 0xab, 0x95, 0x2a, 0xb4, 0x38,
+	0xa8, 0x48, 0x70, 0x40, 0x53, 0xe7, 0xc5, 0x35, 0x75, 0x3c, 0xc3, 0xcc, 0x38, 0xa8, 0xaa, 0xb8,
+	0x20, 0xf1, 0x17, 0x20, 0xf1, 0x07, 0xc0, 0x05, 0xee, 0xdc, 0x39, 0xc0, 0x81, 0x0b, 0x08, 0x09,
+	0xfe, 0x00, 0x54, 0xf1, 0x87, 0xa0, 0x19, 0xdb, 0x49, 0x3c, 0x24, 0x71, 0x25, 0xcc, 0xde, 0xe6,
+	0xcd, 0xaf, 0xf7, 0x79, 0xdf, 0x79, 0xcf, 0xcf, 0xb8, 0x2f, 0x41, 0xcc, 0x40, 0x78, 0x94, 0xf3,
+	0x38, 0x0a, 0xa8, 0x8a, 0x58, 0x22, 0x41, 0x59, 0xa6, 0xcb, 0x05, 0x53, 0x8c, 0xdc, 0x2a, 0xcf,
+	0x76, 0xee, 0x84, 0x8c, 0x85, 0x31, 0x78, 0x94, 0x47, 0x1e, 0x4d, 0x12, 0xa6, 0xb2, 0x95, 0x6c,
+	0x77, 0xe7, 0x38, 0x8c, 0xd4, 0x59, 0x7a, 0xea, 0x06, 0x6c, 0xea, 0x51, 0x11, 0x32, 0x2e, 0xd8,
+	0x27, 0x66, 0x30, 0x08, 0xc6, 0xde, 0x6c, 0xe8, 0xf1, 0xf3, 0x50, 0x9f, 0x94, 0xcb, 0xbe, 0xbc,
+	0xd9, 0x01, 0x8d, 0xf9, 0x19, 0x3d, 0xf0, 0x42, 0x48, 0x40, 0x50, 0x05, 0xe3, 0xec, 0x36, 0xe7,
+	0x04, 0xdf, 0x7e, 0x6b, 0xb1, 0x6f, 0x04, 0xea, 0x21, 0xa8, 0xf7, 0x52, 0x10, 0x17, 0x84, 0xe0,
+	0x9d, 0x84, 0x4e, 0xa1, 0x8d, 0x7a, 0x68, 0xbf, 0xe5, 0x9b, 0x31, 0xd9, 0xc7, 0x4f, 0x53, 0xce,
+	0x25, 0xa8, 0x77, 0xe9, 0x14, 0x24, 0xa7, 0x01, 0xb4, 0xb7, 0xcc, 0xb2, 0x3d, 0xed, 0x5c, 0xe2,
+	0xbd, 0xf2, 0xbd, 0xc7, 0x91, 0xcc, 0x2f, 0xee, 0xe0, 0xa6, 0x66, 0x86, 0x40, 0xc9, 0x36, 0xea,
+	0x6d, 0xef, 0xb7, 0xfc, 0xb9, 0xad, 0xd7, 0x24, 0xc4, 0x10, 0x28, 0x26, 0xf2, 0x9b, 0xe7, 0xf6,
+	0x2a, 0xe7, 0xdb, 0xab, 0x9d, 0x7f, 0x87, 0xec, 0xa8, 0x7c, 0x90, 0x5c, 0x8b, 0x4b, 0xda, 0xf8,
+	0xa9, 0xdc, 0x59, 0x1e, 0x58, 0x61, 0x12, 0x85, 0xad, 0x77, 0x30, 0x00, 0xbb, 0x87, 0xc7, 0xee,
+	0x42, 0x70, 0xb7, 0x10, 0xdc, 0x0c, 0x3e, 0x0e, 0xc6, 0xee, 0x6c, 0xe8, 0xf2, 0xf3, 0xd0, 0xd5,
+	0x82, 0xbb, 0x4b, 0xc7, 0xdd, 0x42, 0x70, 0xd7, 0xe2, 0xb0, 0x7c, 0x38, 0x3f, 0x21, 0xfc, 0x5c,
+	0x79, 0xcb, 0x7d, 0x01, 0x54, 0x81, 0x0f, 0x9f, 0xa6, 0x20, 0x57, 0x51, 0xa1, 0xff, 0x9f, 0x8a,
+	0xdc, 0xc6, 0x8d, 0x94, 0x4b, 0x10, 0x99, 0x06, 0x4d, 0x3f, 0xb7, 0xf4, 0xfc, 0x58, 0x5c, 0xf8,
+	0x69, 0x62, 0x94, 0x6f, 0xfa, 0xb9, 0xe5, 0x7c, 0x64, 0x07, 0xf1, 0x00, 0x62, 0x58, 0x04, 0xf1,
+	0xdf, 0x52, 0xe9, 0x03, 0x3b, 0x95, 0xde, 0x17, 0x00, 0x75, 0xe4, 0xe8, 0xf7, 0x08, 0x3f, 0x6f,
+	0x27, 0x7f, 0x56, 0x1d, 0xab, 0xd5, 0x1f, 0x3d, 0x01, 0xf5, 0x47, 0x60, 0xe4, 0x1a, 0x47, 0x93,
+	0x49, 0xae, 0xbd, 0x19, 0x3b, 0x7f, 0x22, 0xdc, 0x5d, 0xc7, 0x9a, 0xa7, 0xf6, 0x14, 0xdf, 0x5c,
+	0x7e, 0x46, 0x53, 0x5b, 0xbb, 0x87, 0x8f, 0x6a, 0x43, 0xf5, 0x4b, 0xd7, 0x93, 0xfb, 0xf8, 0x86,
+	0x26, 0x93, 0xed, 0x2d, 0xe3, 0x67, 0xe0, 0x5a, 0xdf, 0xb6, 0x32, 0xed, 0x92, 0xf5, 0x20, 0x9a,
+	0x4c, 0xfc, 0xec, 0xac, 0xf3, 0xf3, 0xbf, 0x9e, 0xc0, 0xda, 0xb8, 0xf2, 0x89, 0xef, 0xe0, 0x56,
+	0x62, 0x3d, 0xee, 0x62, 0x42, 0x27, 0x29, 0x0d, 0xf4, 0xf9, 0xfc, 0xf3, 0x90, 0x5b, 0xba, 0xf4,
+	0x4f, 0x63, 0x16, 0x9c, 0xc3, 0xb8, 0xbd, 0x63, 0x94, 0x2d, 0x4c, 0xf2, 0x26, 0x6e, 0x4c, 0x22,
+	0x88, 0xc7, 0xb2, 0x7d, 0xc3, 0xc4, 0xf2, 0xf2, 0xe6, 0x58, 0xde, 0xd1, 0x7b, 0x4d, 0x14, 0xf9,
+	0x31, 0x67, 0x8a, 0xf7, 0xd6, 0x6c, 0xd1, 0xfc, 0x9c, 0xaa, 0xb3, 0x82, 0x5f, 0x8f, 0x35, 0x7f,
+	0x1c, 0xcd, 0xe0, 0x84, 0xc6, 0xe9, 0x9c, 0x7f, 0x3e, 0x41, 0x7a, 0x78, 0x57, 0x51, 0x11, 0x82,
+	0xca, 0xd6, 0xb3, 0x20, 0x96, 0xa7, 0x9c, 0xcf, 0xf0, 0x8b, 0x56, 0x0a, 0xb1, 0x38, 0x66, 0xa9,
+	0x7a, 0x2c, 0xd8, 0x94, 0xd5, 0x54, 0x76, 0xfa, 0xb4, 0x54, 0xc0, 0x73, 0xff, 0x66, 0x7c, 0xf8,
+	0x6b, 0x0b, 0x3f, 0x53, 0xf6, 0x3c, 0x02, 0x31, 0x8b, 0x02, 0x20, 0xdf, 0x22, 0xbc, 0xfd, 0x10,
+	0x14, 0xb9, 0xbb, 0x59, 0xba, 0xa2, 0xbb, 0x74, 0x6a, 0xad, 0x20, 0xe7, 0xee, 0x17, 0x7f, 0xfc,
+	0xfd, 0xd5, 0x56, 0x8f, 0x74, 0x4d, 0xcf, 0x9c, 0x1d, 0x58, 0x7d, 0x56, 0x7a, 0x97, 0x3a, 0xf8,
+	0xcf, 0xc9, 0xd7, 0x08, 0x37, 0x8b, 0xba, 0x21, 0x83, 0x2a, 0xd4, 0xd2, 0xb7, 0xa0, 0xe3, 0x5e,
+	0x77, 0x7b, 0x56, 0x8e, 0xce, 0x3d, 0xc3, 0xf4, 0xd2, 0x11, 0xea, 0x3b, 0xbd, 0x75, 0x58, 0x45,
+	0x37, 0x26, 0xdf, 0x20, 0xbc, 0xa3, 0x3b, 0x24, 0xa9, 0x48, 0xbd, 0x79, 0x17, 0xed, 0x3c, 0xae,
+	0x53, 0x40, 0x7d, 0xad, 0xf3, 0x82, 0x01, 0x7e, 0x96, 0xec, 0xad, 0xa1, 0x25, 0x3f, 0x20, 0xdc,
+	0xc8, 0xba, 0x13, 0xb9, 0xb7, 0x19, 0xb3, 0xd4, 0xc3, 0x6a, 0x7e, 0x6b, 0xcf, 0x60, 0xbe, 0x72,
+	0x64, 0x77, 0xd2, 0xb5, 0xd8, 0x5f, 0x22, 0xdc, 0xc8, 0xfa, 0x51, 0x15, 0x76, 0xa9, 0x6b, 0x75,
+	0x2a, 0x52, 0x79, 0xfe, 0xd0, 0x79, 0xf2, 0xf5, 0xab, 0x92, 0xef, 0x37, 0x84, 0x6f, 0x95, 0x0b,
+	0x95, 0x0c, 0x2b, 0x5c, 0xac, 0x2a, 0xeb, 0x9a, 0xe5, 0x7c, 0xdd, 0xd0, 0x0f, 0x1d, 0x77, 0x33,
+	0xbd, 0x27, 0x32, 0x16, 0x8f, 0x67, 0x30, 0x47, 0xa8, 0x4f, 0x7e, 0x44, 0xf8, 0xa6, 0x0f, 0x92,
+	0xa5, 0x22, 0x00, 0xdd, 0x93, 0xab, 0x92, 0x77, 0xde, 0xb7, 0xeb, 0x4d, 0x5e, 0x7d, 0xad, 0xf3,
+	0x9a, 0x09, 0xc3, 0x25, 0xaf, 0x56, 0x85, 0x91, 0xf3, 0x0e, 0x94, 0x00, 0x78, 0xfb, 0xd1, 0x2f,
+	0x57, 0x5d, 0xf4, 0xfb, 0x55, 0x17, 0xfd, 0x75, 0xd5, 0x45, 0x1f, 0xbe, 0x71, 0xbd, 0x3f, 0xeb,
+	0x20, 0x8e, 0x20, 0xb1, 0x7f, 0xe5, 0x4f, 0x1b, 0xe6, 0x7f, 0x7a, 0xf8, 0xcf, 0x00, 0xa4, 0xcd,
+	0x8f, 0x95, 0xf9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -566,6 +817,8 @@ type ApplicationSetServiceClient interface {
 	Create(ctx context.Context, in *ApplicationSetCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(ctx context.Context, in *ApplicationSetDeleteRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
+	// RolloutPromote promotes a RollingSync step of an applicationset waiting for approval
+	RolloutPromote(ctx context.Context, in *ApplicationSetRolloutPromoteRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -623,6 +876,15 @@ func (c *applicationSetServiceClient) Delete(ctx context.Context, in *Applicatio
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) RolloutPromote(ctx context.Context, in *ApplicationSetRolloutPromoteRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/RolloutPromote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error) {
 	out := new(v1alpha1.ApplicationSetTree)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ResourceTree", in, out, opts...)
@@ -644,6 +906,8 @@ type ApplicationSetServiceServer interface {
 	Create(context.Context, *ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(context.Context, *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error)
+	// RolloutPromote promotes a RollingSync step of an applicationset waiting for approval
+	RolloutPromote(context.Context, *ApplicationSetRolloutPromoteRequest) (*v1alpha1.ApplicationSet, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -667,6 +931,9 @@ func (*UnimplementedApplicationSetServiceServer) Create(ctx context.Context, req
 func (*UnimplementedApplicationSetServiceServer) Delete(ctx context.Context, req *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) RolloutPromote(ctx context.Context, req *ApplicationSetRolloutPromoteRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutPromote not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) ResourceTree(ctx context.Context, req *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -765,6 +1032,24 @@ func _ApplicationSetService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_RolloutPromote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutPromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).RolloutPromote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/RolloutPromote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).RolloutPromote(ctx, req.(*ApplicationSetRolloutPromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationSetTreeQuery)
 	if err := dec(in); err != nil {
@@ -807,6 +1092,10 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationSetService_Delete_Handler,
 		},
+		{
+			MethodName: "RolloutPromote",
+			Handler:    _ApplicationSetService_RolloutPromote_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationSetService_ResourceTree_Handler,
@@ -1118,6 +1407,16 @@ func (m *ApplicationSetGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Diff {
+		i--
+		if m.Diff {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ApplicationSet != nil {
 		{
 			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
@@ -1157,6 +1456,20 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Applications) > 0 {
 		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1174,56 +1487,224 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationSetApplicationDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationSetGetQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *ApplicationSetApplicationDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSetListQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ApplicationSetApplicationDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplicationset(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.Selector)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetFieldDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetFieldDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetFieldDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TargetValue) > 0 {
+		i -= len(m.TargetValue)
+		copy(dAtA[i:], m.TargetValue)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.TargetValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiveValue) > 0 {
+		i -= len(m.LiveValue)
+		copy(dAtA[i:], m.LiveValue)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.LiveValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationSetGetQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetListQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1323,6 +1804,9 @@ func (m *ApplicationSetGenerateRequest) Size() (n int) {
 		l = m.ApplicationSet.Size()
 		n += 1 + l + sovApplicationset(uint64(l))
 	}
+	if m.Diff {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1341,6 +1825,93 @@ func (m *ApplicationSetGenerateResponse) Size() (n int) {
 			n += 1 + l + sovApplicationset(uint64(l))
 		}
 	}
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetApplicationDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Blocked {
+		n += 2
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetFieldDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.LiveValue)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.TargetValue)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutPromoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2156,6 +2727,26 @@ func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Diff = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
@@ -2241,6 +2832,535 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, &ApplicationSetApplicationDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetApplicationDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &ApplicationSetFieldDiff{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetFieldDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetFieldDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetFieldDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutPromoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutPromoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutPromoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
@@ -185,6 +185,11 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Default service account must not be empty or contain disallowed characters
 //   - Server/namespace values must compile as valid glob patterns
 //   - Each (server/namespace) combination must be unique
+//   - OCISignatureKeys:
+//   - Type must be either "cosign" or "notation"
+//   - Public key must not be empty
+//   - SSHSignatureKeys:
+//   - Fingerprint must be a SHA256 fingerprint
 func (proj *AppProject) ValidateProject() error {
 	destKeys := make(map[string]bool)
 	for _, dest := range proj.Spec.Destinations {
@@ -318,9 +323,41 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	for _, key := range proj.Spec.OCISignatureKeys {
+		if key.Type != OCISignatureKeyTypeCosign && key.Type != OCISignatureKeyTypeNotation {
+			return status.Errorf(codes.InvalidArgument, "OCI signature key has an invalid type '%s', must be one of '%s' or '%s'", key.Type, OCISignatureKeyTypeCosign, OCISignatureKeyTypeNotation)
+		}
+		if strings.TrimSpace(key.PublicKey) == "" {
+			return status.Errorf(codes.InvalidArgument, "OCI signature key of type '%s' has no public key", key.Type)
+		}
+	}
+
+	for _, key := range proj.Spec.SSHSignatureKeys {
+		if !strings.HasPrefix(key.Fingerprint, "SHA256:") || len(key.Fingerprint) == len("SHA256:") {
+			return status.Errorf(codes.InvalidArgument, "SSH signature key has an invalid fingerprint '%s', must be a SHA256 fingerprint", key.Fingerprint)
+		}
+	}
+
 	return nil
 }
 
+// HasSignatureKeys returns true if commits in Git must be signed with one of the project's GnuPG or SSH keys
+func (proj AppProject) HasSignatureKeys() bool {
+	return len(proj.Spec.SignatureKeys) > 0 || len(proj.Spec.SSHSignatureKeys) > 0
+}
+
+// GetOCISignatureKeys returns the keys the signatures of OCI sources of the project's applications must be verified with
+func (proj AppProject) GetOCISignatureKeys() []*OCISignatureKey {
+	if len(proj.Spec.OCISignatureKeys) == 0 {
+		return nil
+	}
+	keys := make([]*OCISignatureKey, len(proj.Spec.OCISignatureKeys))
+	for i := range proj.Spec.OCISignatureKeys {
+		keys[i] = &proj.Spec.OCISignatureKeys[i]
+	}
+	return keys
+}
+
 // RoleGroupExists checks if a group exists in the role
 func RoleGroupExists(role *ProjectRole) bool {
 	return len(role.Groups) != 0
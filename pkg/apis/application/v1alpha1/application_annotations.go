@@ -5,7 +5,18 @@ const (
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
 	// AnnotationKeyHydrate is the annotation key which indicates that app needs to be hydrated. Removed by application controller after app is hydrated.
+	// Might take values 'normal'/'manual'. Value 'manual' means the dry revision is hydrated even if it was already hydrated.
 	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
+	// AnnotationKeyHydrateRevision is the annotation key which holds the dry revision to hydrate when hydration is requested
+	// with the AnnotationKeyHydrate annotation. Removed by application controller along with AnnotationKeyHydrate.
+	AnnotationKeyHydrateRevision string = "argocd.argoproj.io/hydrate-revision"
+	// AnnotationKeyHydrateDryRevisions is the annotation key which holds the comma separated revisions of each of the dry
+	// sources to hydrate when hydration of an earlier hydration is requested with the AnnotationKeyHydrate annotation.
+	// Removed by application controller along with AnnotationKeyHydrate.
+	AnnotationKeyHydrateDryRevisions string = "argocd.argoproj.io/hydrate-dry-revisions"
+	// AnnotationKeyApproveSyncBatch is the annotation key which approves the sync of a batch of a batched sync which
+	// requires approval. Holds the name of the approved batch. Removed by application controller after the batch is approved.
+	AnnotationKeyApproveSyncBatch string = "argocd.argoproj.io/approve-sync-batch"
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
@@ -18,7 +18,9 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -93,11 +95,86 @@ type ApplicationSetStrategy struct {
 }
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// FailurePolicy is applied when an Application fails to sync or becomes Degraded during the rollout. Halt marks the
+	// rollout failed, and Rollback additionally rolls the Applications updated by the rollout back to their previous
+	// generated spec. By default, the rollout just stops progressing.
+	FailurePolicy ApplicationSetRolloutFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,2,opt,name=failurePolicy,casttype=ApplicationSetRolloutFailurePolicy"`
 }
 
+// ApplicationSetRolloutFailurePolicy is the policy applied when a RollingSync rollout fails
+type ApplicationSetRolloutFailurePolicy string
+
+const (
+	// RolloutFailurePolicyHalt marks the rollout failed and stops it
+	RolloutFailurePolicyHalt ApplicationSetRolloutFailurePolicy = "Halt"
+	// RolloutFailurePolicyRollback marks the rollout failed and rolls the updated Applications back
+	RolloutFailurePolicyRollback ApplicationSetRolloutFailurePolicy = "Rollback"
+)
+
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// BakeTime is the duration for which the Applications of the step have to stay synced and healthy before the
+	// next step is started, such as 15m
+	BakeTime string `json:"bakeTime,omitempty" protobuf:"bytes,3,opt,name=bakeTime"`
+	// Approval holds the next step until the step is promoted, such as with `argocd appset rollout promote`
+	Approval bool `json:"approval,omitempty" protobuf:"varint,4,opt,name=approval"`
+	// Analysis has to pass before the next step is started
+	Analysis *ApplicationSetRolloutAnalysis `json:"analysis,omitempty" protobuf:"bytes,5,opt,name=analysis"`
+}
+
+// HasGates returns whether the step has gates which have to pass before the next step is started
+func (s *ApplicationSetRolloutStep) HasGates() bool {
+	return s.BakeTime != "" || s.Approval || s.Analysis != nil
+}
+
+// GetBakeTime returns the duration for which the Applications of the step have to stay synced and healthy
+func (s *ApplicationSetRolloutStep) GetBakeTime() (time.Duration, error) {
+	if s.BakeTime == "" {
+		return 0, nil
+	}
+	bakeTime, err := time.ParseDuration(s.BakeTime)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bakeTime: %w", err)
+	}
+	return bakeTime, nil
+}
+
+// ApplicationSetRolloutAnalysis is a check which has to pass before the next step of a rollout is started. Exactly one
+// of HTTP and Prometheus has to be set.
+type ApplicationSetRolloutAnalysis struct {
+	// HTTP passes if a GET request to the URL returns a 2xx status code
+	HTTP *ApplicationSetRolloutHTTPAnalysis `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	// Prometheus passes if the query returns a non-empty result
+	Prometheus *ApplicationSetRolloutPrometheusAnalysis `json:"prometheus,omitempty" protobuf:"bytes,2,opt,name=prometheus"`
+	// Interval is the duration after which a failed analysis is run again, such as 5m. Defaults to 1m.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,3,opt,name=interval"`
+}
+
+// GetInterval returns the duration after which a failed analysis is run again
+func (a *ApplicationSetRolloutAnalysis) GetInterval() (time.Duration, error) {
+	if a.Interval == "" {
+		return time.Minute, nil
+	}
+	interval, err := time.ParseDuration(a.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid analysis interval: %w", err)
+	}
+	return interval, nil
+}
+
+// ApplicationSetRolloutHTTPAnalysis checks an HTTP endpoint
+type ApplicationSetRolloutHTTPAnalysis struct {
+	// URL is the URL of the endpoint
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+}
+
+// ApplicationSetRolloutPrometheusAnalysis runs a Prometheus query
+type ApplicationSetRolloutPrometheusAnalysis struct {
+	// Address is the address of the Prometheus server, such as http://prometheus.monitoring:9090
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is the PromQL query, such as sum(rate(http_requests_total{code=~"5.."}[5m])) < 1
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
 }
 
 type ApplicationMatchExpression struct {
@@ -205,6 +282,10 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	ClusterProfile *ClusterProfileGenerator `json:"clusterProfile,omitempty" protobuf:"bytes,11,name=clusterProfile"`
+
+	Artifact *ArtifactGenerator `json:"artifact,omitempty" protobuf:"bytes,12,name=artifact"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -227,6 +308,10 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	ClusterProfile *ClusterProfileGenerator `json:"clusterProfile,omitempty" protobuf:"bytes,11,name=clusterProfile"`
+
+	Artifact *ArtifactGenerator `json:"artifact,omitempty" protobuf:"bytes,12,name=artifact"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -246,6 +331,10 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	ClusterProfile *ClusterProfileGenerator `json:"clusterProfile,omitempty" protobuf:"bytes,9,name=clusterProfile"`
+
+	Artifact *ArtifactGenerator `json:"artifact,omitempty" protobuf:"bytes,10,name=artifact"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -264,6 +353,8 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
+			ClusterProfile:          terminalGenerator.ClusterProfile,
+			Artifact:                terminalGenerator.Artifact,
 			Selector:                terminalGenerator.Selector,
 		}
 	}
@@ -406,6 +497,52 @@ type DuckTypeGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
 }
 
+// ClusterProfileGenerator defines a generator to match against the clusters of a fleet inventory, described by the
+// SIG-Multicluster ClusterProfile API, which are registered with Argo CD.
+type ClusterProfileGenerator struct {
+	// Namespace is the namespace of the ClusterProfiles. Defaults to the namespace of Argo CD.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Selector defines a label selector to match against the labels of the ClusterProfiles.
+	Selector metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+	// RequiredConditions are the types of the conditions of the ClusterProfiles which must be True, e.g.
+	// ControlPlaneHealthy. ClusterProfiles without all required conditions are ignored.
+	RequiredConditions []string `json:"requiredConditions,omitempty" protobuf:"bytes,3,rep,name=requiredConditions"`
+	// ClusterNameProperty is the name of the property of the ClusterProfiles which contains the name of the cluster
+	// registered with Argo CD. Defaults to the name of the ClusterProfile.
+	ClusterNameProperty string `json:"clusterNameProperty,omitempty" protobuf:"bytes,4,opt,name=clusterNameProperty"`
+	// RequeueAfterSeconds is how long before the ClusterProfiles will be rechecked for a change
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,rep,name=values"`
+}
+
+// ArtifactGenerator defines a generator to list the tags of an OCI repository, or the versions of a chart within a Helm
+// repository.
+type ArtifactGenerator struct {
+	// RepoURL is the URL of the OCI repository, e.g. oci://ghcr.io/argoproj/argo-helm/argo-cd, or of the Helm repository.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// Chart is the name of the chart within the Helm repository. It is not used for OCI repositories.
+	Chart string `json:"chart,omitempty" protobuf:"bytes,2,opt,name=chart"`
+	// Filters for which versions should be considered. A version is considered if it matches any of the filters.
+	Filters []ArtifactGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,3,rep,name=filters"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,4,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,rep,name=values"`
+}
+
+// ArtifactGeneratorFilter is a single version filter. If multiple filter types are set on a single struct, they will
+// be AND'd together. All filters must pass for a version to be included.
+type ArtifactGeneratorFilter struct {
+	// VersionConstraint is a semantic version constraint, e.g. ">=1.2.0-0 <2.0.0", which the version must satisfy.
+	// Versions which are not semantic versions never satisfy a constraint.
+	VersionConstraint *string `json:"versionConstraint,omitempty" protobuf:"bytes,1,opt,name=versionConstraint"`
+	// VersionMatch is a regular expression which the version must match.
+	VersionMatch *string `json:"versionMatch,omitempty" protobuf:"bytes,2,opt,name=versionMatch"`
+}
+
 type GitGenerator struct {
 	RepoURL             string                      `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
 	Directories         []GitDirectoryGeneratorItem `json:"directories,omitempty" protobuf:"bytes,2,name=directories"`
@@ -811,6 +948,10 @@ type ApplicationSetStatus struct {
 	// ResourcesCount is the total number of resources managed by this application set. The count may be higher than actual number of items in the Resources field when
 	// the number of managed resources exceeds the limit imposed by the controller (to avoid making the status field too large).
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
+	// RolloutSteps contains the state of the gates of the RollingSync steps which have gates
+	RolloutSteps []ApplicationSetRolloutStepStatus `json:"rolloutSteps,omitempty" protobuf:"bytes,5,rep,name=rolloutSteps"`
+	// RolloutFailure describes the failure of the RollingSync rollout, if the rollout failed
+	RolloutFailure *ApplicationSetRolloutFailure `json:"rolloutFailure,omitempty" protobuf:"bytes,6,opt,name=rolloutFailure"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -870,6 +1011,7 @@ const (
 	ApplicationSetReasonApplicationValidationError       = "ApplicationValidationError"
 	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
 	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
+	ApplicationSetReasonApplicationSetRolloutFailed      = "ApplicationSetRolloutFailed"
 	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
 )
 
@@ -903,6 +1045,67 @@ type ApplicationSetApplicationStatus struct {
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
 }
 
+// ApplicationSetRolloutStepStatusCode is the status of the gates of a RollingSync step
+type ApplicationSetRolloutStepStatusCode string
+
+const (
+	// Indicates that the Applications of the step are not synced and healthy yet
+	RolloutStepProgressing ApplicationSetRolloutStepStatusCode = "Progressing"
+	// Indicates that the Applications of the step have to stay synced and healthy for the bake time of the step
+	RolloutStepBaking ApplicationSetRolloutStepStatusCode = "Baking"
+	// Indicates that the step has to be promoted
+	RolloutStepWaitingForApproval ApplicationSetRolloutStepStatusCode = "WaitingForApproval"
+	// Indicates that the analysis of the step failed, and is run again after the analysis interval
+	RolloutStepAnalysisFailed ApplicationSetRolloutStepStatusCode = "AnalysisFailed"
+	// Indicates that the gates of the step passed, and the next step can be started
+	RolloutStepCompleted ApplicationSetRolloutStepStatusCode = "Completed"
+)
+
+// ApplicationSetRolloutStepStatus contains the state of the gates of a RollingSync step
+type ApplicationSetRolloutStepStatus struct {
+	// Step is the number of the step, starting with 1
+	Step string `json:"step" protobuf:"bytes,1,opt,name=step"`
+	// Status is the status of the gates of the step
+	Status ApplicationSetRolloutStepStatusCode `json:"status" protobuf:"bytes,2,opt,name=status"`
+	// Message contains human-readable message indicating details about the status
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// LastTransitionTime is the time the status was last updated
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	// HealthyAt is the time since which the Applications of the step are synced and healthy
+	HealthyAt *metav1.Time `json:"healthyAt,omitempty" protobuf:"bytes,5,opt,name=healthyAt"`
+	// ApprovedAt is the time at which the step was promoted
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,6,opt,name=approvedAt"`
+	// Analysis is the result of the last analysis of the step
+	Analysis *ApplicationSetRolloutAnalysisResult `json:"analysis,omitempty" protobuf:"bytes,7,opt,name=analysis"`
+}
+
+// ApplicationSetRolloutAnalysisResult is the result of the analysis of a RollingSync step
+type ApplicationSetRolloutAnalysisResult struct {
+	// Passed is whether the analysis passed
+	Passed bool `json:"passed" protobuf:"varint,1,opt,name=passed"`
+	// Message describes the result of the analysis
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// CheckedAt is the time at which the analysis was run
+	CheckedAt metav1.Time `json:"checkedAt" protobuf:"bytes,3,opt,name=checkedAt"`
+}
+
+// ApplicationSetRolloutFailure describes the failure of a RollingSync rollout
+type ApplicationSetRolloutFailure struct {
+	// Application is the name of the Application which failed the rollout
+	Application string `json:"application" protobuf:"bytes,1,opt,name=application"`
+	// Step is the step of the Application which failed the rollout
+	Step string `json:"step" protobuf:"bytes,2,opt,name=step"`
+	// Message contains human-readable message indicating why the Application failed the rollout
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+	// FailedAt is the time at which the rollout failed
+	FailedAt metav1.Time `json:"failedAt" protobuf:"bytes,4,opt,name=failedAt"`
+	// RolledBack contains the names of the Applications rolled back to their previous generated spec
+	RolledBack []string `json:"rolledBack,omitempty" protobuf:"bytes,5,rep,name=rolledBack"`
+	// Revision identifies the generated Applications of the failed rollout. The failure is cleared once the generated
+	// Applications change.
+	Revision string `json:"revision" protobuf:"bytes,6,opt,name=revision"`
+}
+
 // ApplicationSetList contains a list of ApplicationSet
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
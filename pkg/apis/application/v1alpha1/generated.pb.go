@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,10 +629,152 @@ func (m *ApplicationSetResourceIgnoreDifferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessageInfo
 
+func (m *ApplicationSetRolloutAnalysis) Reset()      { *m = ApplicationSetRolloutAnalysis{} }
+func (*ApplicationSetRolloutAnalysis) ProtoMessage() {}
+func (*ApplicationSetRolloutAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{21}
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutAnalysis.Merge(m, src)
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutAnalysis proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutAnalysisResult) Reset()      { *m = ApplicationSetRolloutAnalysisResult{} }
+func (*ApplicationSetRolloutAnalysisResult) ProtoMessage() {}
+func (*ApplicationSetRolloutAnalysisResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{22}
+}
+func (m *ApplicationSetRolloutAnalysisResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutAnalysisResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutAnalysisResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutAnalysisResult.Merge(m, src)
+}
+func (m *ApplicationSetRolloutAnalysisResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutAnalysisResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutAnalysisResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutAnalysisResult proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutFailure) Reset()      { *m = ApplicationSetRolloutFailure{} }
+func (*ApplicationSetRolloutFailure) ProtoMessage() {}
+func (*ApplicationSetRolloutFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{23}
+}
+func (m *ApplicationSetRolloutFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutFailure.Merge(m, src)
+}
+func (m *ApplicationSetRolloutFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutFailure proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutHTTPAnalysis) Reset()      { *m = ApplicationSetRolloutHTTPAnalysis{} }
+func (*ApplicationSetRolloutHTTPAnalysis) ProtoMessage() {}
+func (*ApplicationSetRolloutHTTPAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{24}
+}
+func (m *ApplicationSetRolloutHTTPAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutHTTPAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutHTTPAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutHTTPAnalysis.Merge(m, src)
+}
+func (m *ApplicationSetRolloutHTTPAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutHTTPAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutHTTPAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutHTTPAnalysis proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutPrometheusAnalysis) Reset() {
+	*m = ApplicationSetRolloutPrometheusAnalysis{}
+}
+func (*ApplicationSetRolloutPrometheusAnalysis) ProtoMessage() {}
+func (*ApplicationSetRolloutPrometheusAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{25}
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutPrometheusAnalysis.Merge(m, src)
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutPrometheusAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutPrometheusAnalysis proto.InternalMessageInfo
+
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,10 +799,38 @@ func (m *ApplicationSetRolloutStep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 
+func (m *ApplicationSetRolloutStepStatus) Reset()      { *m = ApplicationSetRolloutStepStatus{} }
+func (*ApplicationSetRolloutStepStatus) ProtoMessage() {}
+func (*ApplicationSetRolloutStepStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{27}
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStepStatus.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStepStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStepStatus proto.InternalMessageInfo
+
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +858,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +886,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +914,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +942,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +970,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +998,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1026,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +1054,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1082,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,10 +1107,38 @@ func (m *ApplicationSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 
+func (m *ApplicationSourceCUE) Reset()      { *m = ApplicationSourceCUE{} }
+func (*ApplicationSourceCUE) ProtoMessage() {}
+func (*ApplicationSourceCUE) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{38}
+}
+func (m *ApplicationSourceCUE) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourceCUE) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSourceCUE) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourceCUE.Merge(m, src)
+}
+func (m *ApplicationSourceCUE) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourceCUE) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourceCUE.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourceCUE proto.InternalMessageInfo
+
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1166,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1194,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1222,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1250,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1278,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1306,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1334,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1362,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1390,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1418,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,10 +1443,66 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *ArtifactGenerator) Reset()      { *m = ArtifactGenerator{} }
+func (*ArtifactGenerator) ProtoMessage() {}
+func (*ArtifactGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{50}
+}
+func (m *ArtifactGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGenerator.Merge(m, src)
+}
+func (m *ArtifactGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGenerator proto.InternalMessageInfo
+
+func (m *ArtifactGeneratorFilter) Reset()      { *m = ArtifactGeneratorFilter{} }
+func (*ArtifactGeneratorFilter) ProtoMessage() {}
+func (*ArtifactGeneratorFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{51}
+}
+func (m *ArtifactGeneratorFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGeneratorFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGeneratorFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGeneratorFilter.Merge(m, src)
+}
+func (m *ArtifactGeneratorFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGeneratorFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGeneratorFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGeneratorFilter proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1530,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1558,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1586,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,10 +1611,38 @@ func (m *BearerTokenBitbucketCloud) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 
+func (m *CUETag) Reset()      { *m = CUETag{} }
+func (*CUETag) ProtoMessage() {}
+func (*CUETag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{56}
+}
+func (m *CUETag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CUETag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CUETag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CUETag.Merge(m, src)
+}
+func (m *CUETag) XXX_Size() int {
+	return m.Size()
+}
+func (m *CUETag) XXX_DiscardUnknown() {
+	xxx_messageInfo_CUETag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CUETag proto.InternalMessageInfo
+
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1670,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1698,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1726,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1754,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1782,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1810,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,10 +1835,38 @@ func (m *ClusterList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 
+func (m *ClusterProfileGenerator) Reset()      { *m = ClusterProfileGenerator{} }
+func (*ClusterProfileGenerator) ProtoMessage() {}
+func (*ClusterProfileGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{64}
+}
+func (m *ClusterProfileGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterProfileGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterProfileGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterProfileGenerator.Merge(m, src)
+}
+func (m *ClusterProfileGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterProfileGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterProfileGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterProfileGenerator proto.InternalMessageInfo
+
 func (m *ClusterResourceRestrictionItem) Reset()      { *m = ClusterResourceRestrictionItem{} }
 func (*ClusterResourceRestrictionItem) ProtoMessage() {}
 func (*ClusterResourceRestrictionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *ClusterResourceRestrictionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1894,7 @@ var xxx_messageInfo_ClusterResourceRestrictionItem proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1922,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1950,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1978,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +2006,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +2034,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +2062,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +2090,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +2118,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +2146,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +2174,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2202,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2230,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2258,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2286,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2314,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{81}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2342,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2370,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2398,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2426,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2113,10 +2451,66 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmPostRender) Reset()      { *m = HelmPostRender{} }
+func (*HelmPostRender) ProtoMessage() {}
+func (*HelmPostRender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{86}
+}
+func (m *HelmPostRender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRender.Merge(m, src)
+}
+func (m *HelmPostRender) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRender) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRender proto.InternalMessageInfo
+
+func (m *HelmPostRenderJSONPatch) Reset()      { *m = HelmPostRenderJSONPatch{} }
+func (*HelmPostRenderJSONPatch) ProtoMessage() {}
+func (*HelmPostRenderJSONPatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{87}
+}
+func (m *HelmPostRenderJSONPatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRenderJSONPatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRenderJSONPatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRenderJSONPatch.Merge(m, src)
+}
+func (m *HelmPostRenderJSONPatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRenderJSONPatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRenderJSONPatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRenderJSONPatch proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2538,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2169,10 +2563,66 @@ func (m *HostResourceInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 
+func (m *HydrateHistory) Reset()      { *m = HydrateHistory{} }
+func (*HydrateHistory) ProtoMessage() {}
+func (*HydrateHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{90}
+}
+func (m *HydrateHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateHistory.Merge(m, src)
+}
+func (m *HydrateHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateHistory proto.InternalMessageInfo
+
+func (m *HydrateLayout) Reset()      { *m = HydrateLayout{} }
+func (*HydrateLayout) ProtoMessage() {}
+func (*HydrateLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{91}
+}
+func (m *HydrateLayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateLayout.Merge(m, src)
+}
+func (m *HydrateLayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateLayout proto.InternalMessageInfo
+
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,10 +2647,66 @@ func (m *HydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 
+func (m *HydratePullRequest) Reset()      { *m = HydratePullRequest{} }
+func (*HydratePullRequest) ProtoMessage() {}
+func (*HydratePullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{93}
+}
+func (m *HydratePullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratePullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratePullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratePullRequest.Merge(m, src)
+}
+func (m *HydratePullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratePullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratePullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratePullRequest proto.InternalMessageInfo
+
+func (m *HydratePullRequestStatus) Reset()      { *m = HydratePullRequestStatus{} }
+func (*HydratePullRequestStatus) ProtoMessage() {}
+func (*HydratePullRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{94}
+}
+func (m *HydratePullRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratePullRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratePullRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratePullRequestStatus.Merge(m, src)
+}
+func (m *HydratePullRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratePullRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratePullRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratePullRequestStatus proto.InternalMessageInfo
+
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2734,7 @@ var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2762,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2790,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2818,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2846,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2874,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2902,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2930,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2958,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2986,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +3014,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +3042,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +3070,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +3098,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +3126,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +3154,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +3182,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +3210,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +3238,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3266,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2785,10 +3291,38 @@ func (m *OCIMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 
+func (m *OCISignatureKey) Reset()      { *m = OCISignatureKey{} }
+func (*OCISignatureKey) ProtoMessage() {}
+func (*OCISignatureKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{116}
+}
+func (m *OCISignatureKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCISignatureKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCISignatureKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCISignatureKey.Merge(m, src)
+}
+func (m *OCISignatureKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCISignatureKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCISignatureKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCISignatureKey proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3350,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3378,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3406,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3434,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3462,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3490,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3518,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3546,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3574,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3602,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3630,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3658,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3686,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3714,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3742,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3770,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3798,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3826,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3854,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3882,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3910,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3938,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3966,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3994,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +4022,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +4050,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +4078,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +4106,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +4134,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4162,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +4190,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +4218,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +4246,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4274,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4302,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4330,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4358,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4386,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4414,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4442,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4470,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4498,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4526,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4554,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4582,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4610,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4638,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4666,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4694,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4722,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4750,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4241,10 +4775,94 @@ func (m *SCMProviderGeneratorGitlab) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 
+func (m *SSHSignatureKey) Reset()      { *m = SSHSignatureKey{} }
+func (*SSHSignatureKey) ProtoMessage() {}
+func (*SSHSignatureKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{169}
+}
+func (m *SSHSignatureKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSignatureKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSignatureKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSignatureKey.Merge(m, src)
+}
+func (m *SSHSignatureKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSignatureKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSignatureKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSignatureKey proto.InternalMessageInfo
+
+func (m *SSHSigningKey) Reset()      { *m = SSHSigningKey{} }
+func (*SSHSigningKey) ProtoMessage() {}
+func (*SSHSigningKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{170}
+}
+func (m *SSHSigningKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSigningKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKey.Merge(m, src)
+}
+func (m *SSHSigningKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKey proto.InternalMessageInfo
+
+func (m *SSHSigningKeyList) Reset()      { *m = SSHSigningKeyList{} }
+func (*SSHSigningKeyList) ProtoMessage() {}
+func (*SSHSigningKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{171}
+}
+func (m *SSHSigningKeyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSigningKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SSHSigningKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSigningKeyList.Merge(m, src)
+}
+func (m *SSHSigningKeyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSigningKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSigningKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSigningKeyList proto.InternalMessageInfo
+
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4890,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4918,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{174}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4946,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4974,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4381,10 +4999,66 @@ func (m *SuccessfulHydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 
+func (m *SyncBatch) Reset()      { *m = SyncBatch{} }
+func (*SyncBatch) ProtoMessage() {}
+func (*SyncBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{177}
+}
+func (m *SyncBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncBatch.Merge(m, src)
+}
+func (m *SyncBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncBatch proto.InternalMessageInfo
+
+func (m *SyncBatchResult) Reset()      { *m = SyncBatchResult{} }
+func (*SyncBatchResult) ProtoMessage() {}
+func (*SyncBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{178}
+}
+func (m *SyncBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncBatchResult.Merge(m, src)
+}
+func (m *SyncBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncBatchResult proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{179}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +5086,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{180}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +5114,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{181}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +5142,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{182}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +5170,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{183}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +5198,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{184}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +5226,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{185}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +5254,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{186}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +5282,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{187}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4633,10 +5307,38 @@ func (m *SyncStrategyApply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 
+func (m *SyncStrategyBatch) Reset()      { *m = SyncStrategyBatch{} }
+func (*SyncStrategyBatch) ProtoMessage() {}
+func (*SyncStrategyBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{188}
+}
+func (m *SyncStrategyBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStrategyBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncStrategyBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStrategyBatch.Merge(m, src)
+}
+func (m *SyncStrategyBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStrategyBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStrategyBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStrategyBatch proto.InternalMessageInfo
+
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{189}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4664,7 +5366,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{190}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5394,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{191}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +5422,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{192}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4755,6 +5457,7 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
@@ -4767,7 +5470,13 @@ func init() {
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetList")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
 	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
+	proto.RegisterType((*ApplicationSetRolloutAnalysis)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutAnalysis")
+	proto.RegisterType((*ApplicationSetRolloutAnalysisResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutAnalysisResult")
+	proto.RegisterType((*ApplicationSetRolloutFailure)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutFailure")
+	proto.RegisterType((*ApplicationSetRolloutHTTPAnalysis)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutHTTPAnalysis")
+	proto.RegisterType((*ApplicationSetRolloutPrometheusAnalysis)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutPrometheusAnalysis")
 	proto.RegisterType((*ApplicationSetRolloutStep)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStep")
+	proto.RegisterType((*ApplicationSetRolloutStepStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStepStatus")
 	proto.RegisterType((*ApplicationSetRolloutStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStrategy")
 	proto.RegisterType((*ApplicationSetSpec)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetSpec")
 	proto.RegisterType((*ApplicationSetStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetStatus")
@@ -4780,6 +5489,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetTerminalGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTerminalGenerator")
 	proto.RegisterType((*ApplicationSetTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetTree")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSource")
+	proto.RegisterType((*ApplicationSourceCUE)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceCUE")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
 	proto.RegisterType((*ApplicationSourceHelm)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceHelm")
 	proto.RegisterType((*ApplicationSourceJsonnet)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSourceJsonnet")
@@ -4793,10 +5503,14 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*ArtifactGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ArtifactGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ArtifactGenerator.ValuesEntry")
+	proto.RegisterType((*ArtifactGeneratorFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ArtifactGeneratorFilter")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucket)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucket")
 	proto.RegisterType((*BearerTokenBitbucketCloud)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucketCloud")
+	proto.RegisterType((*CUETag)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.CUETag")
 	proto.RegisterType((*ChartDetails)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ChartDetails")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
@@ -4807,6 +5521,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterGenerator.ValuesEntry")
 	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterInfo")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterList")
+	proto.RegisterType((*ClusterProfileGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterProfileGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterProfileGenerator.ValuesEntry")
 	proto.RegisterType((*ClusterResourceRestrictionItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterResourceRestrictionItem")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*CommitMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.CommitMetadata")
@@ -4831,10 +5547,16 @@ func init() {
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmPostRender)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmPostRender")
+	proto.RegisterType((*HelmPostRenderJSONPatch)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmPostRenderJSONPatch")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo.LabelsEntry")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
+	proto.RegisterType((*HydrateHistory)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateHistory")
+	proto.RegisterType((*HydrateLayout)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateLayout")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
+	proto.RegisterType((*HydratePullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratePullRequest")
+	proto.RegisterType((*HydratePullRequestStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratePullRequestStatus")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
@@ -4859,6 +5581,7 @@ func init() {
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
+	proto.RegisterType((*OCISignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCISignatureKey")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
@@ -4918,11 +5641,16 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitea")
 	proto.RegisterType((*SCMProviderGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGithub")
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
+	proto.RegisterType((*SSHSignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SSHSignatureKey")
+	proto.RegisterType((*SSHSigningKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SSHSigningKey")
+	proto.RegisterType((*SSHSigningKeyList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SSHSigningKeyList")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SignatureKey")
 	proto.RegisterType((*SourceHydrator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydrator")
 	proto.RegisterType((*SourceHydratorStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydratorStatus")
 	proto.RegisterType((*SuccessfulHydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SuccessfulHydrateOperation")
+	proto.RegisterType((*SyncBatch)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncBatch")
+	proto.RegisterType((*SyncBatchResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncBatchResult")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -4932,6 +5660,7 @@ func init() {
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
+	proto.RegisterType((*SyncStrategyBatch)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyBatch")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
@@ -4943,787 +5672,906 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x24, 0xdb,
-	0x55, 0x18, 0xee, 0x9e, 0xd1, 0x48, 0x33, 0x47, 0x1f, 0xbb, 0xba, 0xbb, 0xfb, 0x9e, 0x76, 0xdf,
-	0x87, 0x96, 0x7e, 0xf0, 0x6c, 0x7e, 0x7e, 0x96, 0xf0, 0xda, 0xcf, 0xbc, 0x1f, 0x0f, 0x0c, 0xfa,
-	0xd8, 0x0f, 0xed, 0x4a, 0x2b, 0xf9, 0x8e, 0x76, 0x17, 0x7f, 0x3c, 0xdb, 0xad, 0x9e, 0xab, 0x51,
-	0xaf, 0x7a, 0xba, 0xe7, 0x75, 0xf7, 0x68, 0x35, 0x0f, 0x63, 0x6c, 0x6c, 0x83, 0xc1, 0xc6, 0x7e,
-	0x81, 0x14, 0x3c, 0x92, 0x98, 0x98, 0x40, 0x3e, 0xaa, 0x52, 0x14, 0x24, 0xfc, 0x11, 0x2a, 0x40,
-	0x51, 0x01, 0x8a, 0x82, 0x0a, 0x09, 0x84, 0x22, 0x40, 0x02, 0x28, 0xf6, 0x26, 0x29, 0xa8, 0x54,
-	0x85, 0x2a, 0x92, 0xfc, 0x91, 0xda, 0xa4, 0xa8, 0xd4, 0xfd, 0xbe, 0xdd, 0xd3, 0x23, 0x8d, 0x56,
-	0x2d, 0xed, 0x1a, 0xde, 0x7f, 0x33, 0xf7, 0x9c, 0x3e, 0xe7, 0xf6, 0xed, 0x7b, 0xcf, 0x39, 0xf7,
-	0xdc, 0x73, 0xce, 0x85, 0xe5, 0xa6, 0x97, 0x6c, 0x75, 0x36, 0x66, 0xdc, 0xb0, 0x35, 0xeb, 0x44,
-	0xcd, 0xb0, 0x1d, 0x85, 0x77, 0xd9, 0x8f, 0x77, 0xb8, 0x8d, 0xd9, 0x9d, 0x77, 0xcd, 0xb6, 0xb7,
-	0x9b, 0xb3, 0x4e, 0xdb, 0x8b, 0x67, 0x9d, 0x76, 0xdb, 0xf7, 0x5c, 0x27, 0xf1, 0xc2, 0x60, 0x76,
-	0xe7, 0x9d, 0x8e, 0xdf, 0xde, 0x72, 0xde, 0x39, 0xdb, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0x66,
-	0xda, 0x51, 0x98, 0x84, 0xe8, 0x5b, 0x35, 0xb5, 0x19, 0x49, 0x8d, 0xfd, 0xf8, 0x88, 0xdb, 0x98,
-	0xd9, 0x79, 0xd7, 0x4c, 0x7b, 0xbb, 0x39, 0x43, 0xa9, 0xcd, 0x18, 0xd4, 0x66, 0x24, 0xb5, 0x0b,
-	0xef, 0x30, 0xfa, 0xd2, 0x0c, 0x9b, 0xe1, 0x2c, 0x23, 0xba, 0xd1, 0xd9, 0x64, 0xff, 0xd8, 0x1f,
-	0xf6, 0x8b, 0x33, 0xbb, 0x60, 0x6f, 0xbf, 0x14, 0xcf, 0x78, 0x21, 0xed, 0xde, 0xac, 0x1b, 0x46,
-	0x64, 0x76, 0xa7, 0xa7, 0x43, 0x17, 0xae, 0x69, 0x1c, 0xb2, 0x9b, 0x90, 0x20, 0xf6, 0xc2, 0x20,
-	0x7e, 0x07, 0xed, 0x02, 0x89, 0x76, 0x48, 0x64, 0xbe, 0x9e, 0x81, 0x90, 0x47, 0xe9, 0xdd, 0x9a,
-	0x52, 0xcb, 0x71, 0xb7, 0xbc, 0x80, 0x44, 0x5d, 0xfd, 0x78, 0x8b, 0x24, 0x4e, 0xde, 0x53, 0xb3,
-	0xfd, 0x9e, 0x8a, 0x3a, 0x41, 0xe2, 0xb5, 0x48, 0xcf, 0x03, 0xef, 0x39, 0xe8, 0x81, 0xd8, 0xdd,
-	0x22, 0x2d, 0xa7, 0xe7, 0xb9, 0x77, 0xf5, 0x7b, 0xae, 0x93, 0x78, 0xfe, 0xac, 0x17, 0x24, 0x71,
-	0x12, 0x65, 0x1f, 0xb2, 0xff, 0x9e, 0x05, 0xe3, 0x73, 0x77, 0xea, 0x73, 0x9d, 0x64, 0x6b, 0x21,
-	0x0c, 0x36, 0xbd, 0x26, 0x7a, 0x11, 0x46, 0x5d, 0xbf, 0x13, 0x27, 0x24, 0xba, 0xe9, 0xb4, 0xc8,
-	0x94, 0x75, 0xd1, 0x7a, 0x5b, 0x6d, 0xfe, 0xcc, 0x6f, 0xee, 0x4d, 0xbf, 0xe5, 0xfe, 0xde, 0xf4,
-	0xe8, 0x82, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0x23, 0x8c, 0x44, 0xa1, 0x4f, 0xe6, 0xf0, 0xcd, 0xa9,
-	0x12, 0x7b, 0xe4, 0x94, 0x78, 0x64, 0x04, 0xf3, 0x66, 0x2c, 0xe1, 0x14, 0xb5, 0x1d, 0x85, 0x9b,
-	0x9e, 0x4f, 0xa6, 0xca, 0x69, 0xd4, 0x35, 0xde, 0x8c, 0x25, 0xdc, 0xfe, 0xf1, 0x12, 0x9c, 0x9a,
-	0x6b, 0xb7, 0xaf, 0x11, 0xc7, 0x4f, 0xb6, 0xea, 0x89, 0x93, 0x74, 0x62, 0xd4, 0x84, 0xe1, 0x98,
-	0xfd, 0x12, 0x7d, 0x5b, 0x15, 0x4f, 0x0f, 0x73, 0xf8, 0x83, 0xbd, 0xe9, 0x6f, 0xcb, 0x9b, 0xd1,
-	0x4d, 0x2f, 0x09, 0xdb, 0xf1, 0x3b, 0x48, 0xd0, 0xf4, 0x02, 0xc2, 0xc6, 0x65, 0x8b, 0x51, 0x9d,
-	0x31, 0x89, 0x2f, 0x84, 0x0d, 0x82, 0x05, 0x79, 0xda, 0xcf, 0x16, 0x89, 0x63, 0xa7, 0x49, 0xb2,
-	0xaf, 0xb4, 0xc2, 0x9b, 0xb1, 0x84, 0xa3, 0x08, 0x90, 0xef, 0xc4, 0xc9, 0x7a, 0xe4, 0x04, 0xb1,
-	0x47, 0xa7, 0xf4, 0xba, 0xd7, 0xe2, 0x6f, 0x37, 0x7a, 0xe9, 0xff, 0x9b, 0xe1, 0x1f, 0x66, 0xc6,
-	0xfc, 0x30, 0x7a, 0x1d, 0xd0, 0x79, 0x33, 0xb3, 0xf3, 0xce, 0x19, 0xfa, 0xc4, 0xfc, 0x13, 0xf7,
-	0xf7, 0xa6, 0xd1, 0x72, 0x0f, 0x25, 0x9c, 0x43, 0xdd, 0xfe, 0x83, 0x12, 0xc0, 0x5c, 0xbb, 0xbd,
-	0x16, 0x85, 0x77, 0x89, 0x9b, 0xa0, 0x8f, 0x42, 0x95, 0x92, 0x6a, 0x38, 0x89, 0xc3, 0x06, 0x66,
-	0xf4, 0xd2, 0x37, 0x0d, 0xc6, 0x78, 0x75, 0x83, 0x3e, 0xbf, 0x42, 0x12, 0x67, 0x1e, 0x89, 0x17,
-	0x04, 0xdd, 0x86, 0x15, 0x55, 0x14, 0xc0, 0x50, 0xdc, 0x26, 0x2e, 0x1b, 0x8c, 0xd1, 0x4b, 0xcb,
-	0x33, 0x47, 0x59, 0xe9, 0x33, 0xba, 0xe7, 0xf5, 0x36, 0x71, 0xe7, 0xc7, 0x04, 0xe7, 0x21, 0xfa,
-	0x0f, 0x33, 0x3e, 0x68, 0x47, 0x7d, 0x68, 0x3e, 0x90, 0x37, 0x0b, 0xe3, 0xc8, 0xa8, 0xce, 0x4f,
-	0xa4, 0x27, 0x8e, 0xfc, 0xee, 0xf6, 0x9f, 0x5a, 0x30, 0xa1, 0x91, 0x97, 0xbd, 0x38, 0x41, 0x1f,
-	0xea, 0x19, 0xdc, 0x99, 0xc1, 0x06, 0x97, 0x3e, 0xcd, 0x86, 0xf6, 0xb4, 0x60, 0x56, 0x95, 0x2d,
-	0xc6, 0xc0, 0xb6, 0xa0, 0xe2, 0x25, 0xa4, 0x15, 0x4f, 0x95, 0x2e, 0x96, 0xdf, 0x36, 0x7a, 0xe9,
-	0x5a, 0x51, 0xef, 0x39, 0x3f, 0x2e, 0x98, 0x56, 0x96, 0x28, 0x79, 0xcc, 0xb9, 0xd8, 0xbf, 0x3d,
-	0x61, 0xbe, 0x1f, 0x1d, 0x70, 0xf4, 0x4e, 0x18, 0x8d, 0xc3, 0x4e, 0xe4, 0x12, 0x4c, 0xda, 0x21,
-	0x5d, 0x58, 0x65, 0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xeb, 0x66, 0x6c, 0xe2, 0xa0, 0x2f, 0x58, 0x30,
-	0xd6, 0x20, 0x71, 0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xfa, 0x91, 0x3b, 0x2f, 0x1b, 0x17, 0x35,
-	0xf1, 0xf9, 0xb3, 0xe2, 0x45, 0xc6, 0x8c, 0xc6, 0x18, 0xa7, 0xf8, 0x53, 0xc1, 0xd5, 0x20, 0xb1,
-	0x1b, 0x79, 0x6d, 0xfa, 0x5f, 0x88, 0x16, 0x25, 0xb8, 0x16, 0x35, 0x08, 0x9b, 0x78, 0x28, 0x80,
-	0x0a, 0x15, 0x4c, 0xf1, 0xd4, 0x10, 0xeb, 0xff, 0xd2, 0xd1, 0xfa, 0x2f, 0x06, 0x95, 0xca, 0x3c,
-	0x3d, 0xfa, 0xf4, 0x5f, 0x8c, 0x39, 0x1b, 0xf4, 0x2f, 0x2d, 0x98, 0x12, 0x82, 0x13, 0x13, 0x3e,
-	0xa0, 0x77, 0xb6, 0xbc, 0x84, 0xf8, 0x5e, 0x9c, 0x4c, 0x55, 0x58, 0x1f, 0x3e, 0x74, 0xb4, 0x3e,
-	0x2c, 0xa4, 0xa9, 0x63, 0x12, 0x27, 0x91, 0xe7, 0x52, 0x1c, 0x3a, 0x0d, 0xe6, 0x2f, 0x8a, 0x6e,
-	0x4d, 0x2d, 0xf4, 0xe9, 0x05, 0xee, 0xdb, 0x3f, 0xf4, 0x23, 0x16, 0x5c, 0x08, 0x9c, 0x16, 0x89,
-	0xdb, 0x0e, 0x23, 0xcc, 0xc0, 0xf3, 0xbe, 0xe3, 0x6e, 0xb3, 0xee, 0x0f, 0xb3, 0xee, 0xcf, 0x0e,
-	0xb6, 0x34, 0xae, 0x46, 0x61, 0xa7, 0x7d, 0xc3, 0x0b, 0x1a, 0xf3, 0xb6, 0xe8, 0xd1, 0x85, 0x9b,
-	0x7d, 0x49, 0xe3, 0x7d, 0xd8, 0xa2, 0x9f, 0xb2, 0x60, 0x32, 0x8c, 0xda, 0x5b, 0x4e, 0x40, 0x1a,
-	0x12, 0x1a, 0x4f, 0x8d, 0xb0, 0x75, 0xfa, 0xe1, 0xa3, 0x8d, 0xe5, 0x6a, 0x96, 0xec, 0x4a, 0x18,
-	0x78, 0x49, 0x18, 0xd5, 0x49, 0x92, 0x78, 0x41, 0x33, 0x9e, 0x3f, 0x77, 0x7f, 0x6f, 0x7a, 0xb2,
-	0x07, 0x0b, 0xf7, 0xf6, 0x07, 0x7d, 0x17, 0x8c, 0xc6, 0xdd, 0xc0, 0xbd, 0xe3, 0x05, 0x8d, 0xf0,
-	0x5e, 0x3c, 0x55, 0x2d, 0x62, 0xad, 0xd7, 0x15, 0x41, 0xb1, 0x5a, 0x35, 0x03, 0x6c, 0x72, 0xcb,
-	0xff, 0x70, 0x7a, 0xde, 0xd5, 0x8a, 0xfe, 0x70, 0x7a, 0x32, 0xed, 0xc3, 0x16, 0x7d, 0xbf, 0x05,
-	0xe3, 0xb1, 0xd7, 0x0c, 0x9c, 0xa4, 0x13, 0x91, 0x1b, 0xa4, 0x1b, 0x4f, 0x01, 0xeb, 0xc8, 0xf5,
-	0x23, 0x8e, 0x8a, 0x41, 0x72, 0xfe, 0x9c, 0xe8, 0xe3, 0xb8, 0xd9, 0x1a, 0xe3, 0x34, 0xdf, 0xbc,
-	0x55, 0xa9, 0xa7, 0xf5, 0xe8, 0x23, 0x5c, 0x95, 0x7a, 0x05, 0xf4, 0xed, 0x1f, 0xfa, 0x0e, 0x38,
-	0xcd, 0x9b, 0xd4, 0x67, 0x88, 0xa7, 0xc6, 0x98, 0x08, 0x3f, 0x7b, 0x7f, 0x6f, 0xfa, 0x74, 0x3d,
-	0x03, 0xc3, 0x3d, 0xd8, 0xe8, 0x55, 0x98, 0x6e, 0x93, 0xa8, 0xe5, 0x25, 0xab, 0x81, 0xdf, 0x95,
-	0x8a, 0xc1, 0x0d, 0xdb, 0xa4, 0x21, 0xba, 0x13, 0x4f, 0x8d, 0x5f, 0xb4, 0xde, 0x56, 0x9d, 0x7f,
-	0xab, 0xe8, 0xe6, 0xf4, 0xda, 0xfe, 0xe8, 0xf8, 0x20, 0x7a, 0xe8, 0x37, 0x2c, 0xb8, 0x60, 0xc8,
-	0xef, 0x3a, 0x89, 0x76, 0x3c, 0x97, 0xcc, 0xb9, 0x6e, 0xd8, 0x09, 0x92, 0x78, 0x6a, 0x82, 0x8d,
-	0xf9, 0xc6, 0x71, 0x68, 0x93, 0x34, 0x2b, 0x3d, 0x89, 0xfb, 0xa2, 0xc4, 0x78, 0x9f, 0x9e, 0xda,
-	0xbf, 0x55, 0x82, 0xd3, 0x59, 0xdb, 0x02, 0xfd, 0x23, 0x0b, 0x4e, 0xdd, 0xbd, 0x97, 0xac, 0x87,
-	0xdb, 0x24, 0x88, 0xe7, 0xbb, 0x54, 0x03, 0x30, 0xad, 0x3a, 0x7a, 0xc9, 0x2d, 0xd6, 0x8a, 0x99,
-	0xb9, 0x9e, 0xe6, 0x72, 0x39, 0x48, 0xa2, 0xee, 0xfc, 0x93, 0xe2, 0x9d, 0x4e, 0x5d, 0xbf, 0xb3,
-	0x6e, 0x42, 0x71, 0xb6, 0x53, 0x17, 0x3e, 0x67, 0xc1, 0xd9, 0x3c, 0x12, 0xe8, 0x34, 0x94, 0xb7,
-	0x49, 0x97, 0xdb, 0xd8, 0x98, 0xfe, 0x44, 0xaf, 0x40, 0x65, 0xc7, 0xf1, 0x3b, 0x44, 0x18, 0x80,
-	0x57, 0x8f, 0xf6, 0x22, 0xaa, 0x67, 0x98, 0x53, 0xfd, 0x96, 0xd2, 0x4b, 0x96, 0xfd, 0x3b, 0x65,
-	0x18, 0x35, 0x3e, 0xda, 0x09, 0x18, 0xb5, 0x61, 0xca, 0xa8, 0x5d, 0x29, 0x6c, 0xbe, 0xf5, 0xb5,
-	0x6a, 0xef, 0x65, 0xac, 0xda, 0xd5, 0xe2, 0x58, 0xee, 0x6b, 0xd6, 0xa2, 0x04, 0x6a, 0x61, 0x9b,
-	0x6e, 0xfe, 0xa8, 0x75, 0x34, 0x54, 0xc4, 0x27, 0x5c, 0x95, 0xe4, 0xe6, 0xc7, 0xef, 0xef, 0x4d,
-	0xd7, 0xd4, 0x5f, 0xac, 0x19, 0xd9, 0x7f, 0x68, 0xc1, 0x59, 0xa3, 0x8f, 0x0b, 0x61, 0xd0, 0x60,
-	0x5b, 0x18, 0x74, 0x11, 0x86, 0x92, 0x6e, 0x5b, 0x6e, 0x30, 0xd5, 0x48, 0xad, 0x77, 0xdb, 0x04,
-	0x33, 0xc8, 0xe3, 0xbe, 0xff, 0xfa, 0x11, 0x0b, 0x9e, 0xc8, 0x17, 0x30, 0xe8, 0x79, 0x18, 0xe6,
-	0xde, 0x05, 0xf1, 0x76, 0xfa, 0x93, 0xb0, 0x56, 0x2c, 0xa0, 0x68, 0x16, 0x6a, 0x4a, 0x3b, 0x8a,
-	0x77, 0x9c, 0x14, 0xa8, 0x35, 0xad, 0x52, 0x35, 0x0e, 0x1d, 0x34, 0xfa, 0x47, 0x18, 0xb7, 0x6a,
-	0xd0, 0xd8, 0x76, 0x9c, 0x41, 0xec, 0xdf, 0xb7, 0xe0, 0xeb, 0x07, 0x11, 0x7b, 0xc7, 0xd7, 0xc7,
-	0x3a, 0x9c, 0x6b, 0x90, 0x4d, 0xa7, 0xe3, 0x27, 0x69, 0x8e, 0xa2, 0xd3, 0xcf, 0x88, 0x87, 0xcf,
-	0x2d, 0xe6, 0x21, 0xe1, 0xfc, 0x67, 0xed, 0xff, 0x64, 0x31, 0x47, 0x80, 0x7c, 0xad, 0x13, 0xd8,
-	0x94, 0x05, 0xe9, 0x4d, 0xd9, 0x52, 0x61, 0xcb, 0xb4, 0xcf, 0xae, 0xec, 0x87, 0x2c, 0xb8, 0x60,
-	0x60, 0xad, 0x38, 0x89, 0xbb, 0x75, 0x79, 0xb7, 0x1d, 0x91, 0x38, 0xa6, 0x53, 0xea, 0x19, 0x43,
-	0x1c, 0xcf, 0x8f, 0x0a, 0x0a, 0xe5, 0x1b, 0xa4, 0xcb, 0x65, 0xf3, 0x0b, 0x50, 0xe5, 0x6b, 0x2e,
-	0x8c, 0xc4, 0x47, 0x52, 0xef, 0xb6, 0x2a, 0xda, 0xb1, 0xc2, 0x40, 0x36, 0x0c, 0x33, 0x99, 0x4b,
-	0x65, 0x10, 0x35, 0x13, 0x80, 0x7e, 0xf7, 0xdb, 0xac, 0x05, 0x0b, 0x88, 0x1d, 0xa7, 0xba, 0xb3,
-	0x16, 0x11, 0x36, 0x1f, 0x1a, 0x57, 0x3c, 0xe2, 0x37, 0x62, 0xba, 0x61, 0x74, 0x82, 0x20, 0x4c,
-	0xc4, 0xde, 0xcf, 0xd8, 0x30, 0xce, 0xe9, 0x66, 0x6c, 0xe2, 0x50, 0xa6, 0xbe, 0xb3, 0x41, 0x7c,
-	0x3e, 0xa2, 0x82, 0xe9, 0x32, 0x6b, 0xc1, 0x02, 0x62, 0xdf, 0x2f, 0xb1, 0xad, 0xa9, 0x92, 0x68,
-	0xe4, 0x24, 0xfc, 0x1a, 0x51, 0x4a, 0x05, 0xac, 0x15, 0x27, 0x8f, 0x49, 0x7f, 0xdf, 0xc6, 0x6b,
-	0x19, 0x2d, 0x80, 0x0b, 0xe5, 0xba, 0xbf, 0x7f, 0xe3, 0x4b, 0x65, 0x98, 0x4e, 0x3f, 0xd0, 0xa3,
-	0x44, 0xe8, 0x66, 0xda, 0x60, 0x94, 0xf5, 0x02, 0x1a, 0xf8, 0xd8, 0xc4, 0xeb, 0x23, 0x87, 0x4b,
-	0xc7, 0x29, 0x87, 0x4d, 0x35, 0x51, 0x3e, 0x40, 0x4d, 0x2c, 0xa8, 0x51, 0x1f, 0x62, 0x98, 0x6f,
-	0xef, 0x71, 0x1d, 0x9e, 0x5f, 0x8b, 0xc2, 0x26, 0x5b, 0x73, 0x3b, 0x84, 0x6e, 0xa6, 0x72, 0xdc,
-	0x82, 0x17, 0x61, 0x28, 0x4e, 0x48, 0x7b, 0xaa, 0x92, 0x96, 0xc1, 0xf5, 0x84, 0xb4, 0x31, 0x83,
-	0xa0, 0x6f, 0x83, 0x53, 0x89, 0x13, 0x35, 0x49, 0x12, 0x91, 0x1d, 0x8f, 0xb9, 0x93, 0xd9, 0xce,
-	0xb8, 0x36, 0x7f, 0x86, 0x9a, 0x64, 0xeb, 0x0c, 0x84, 0x25, 0x08, 0x67, 0x71, 0xed, 0xff, 0x56,
-	0x82, 0x27, 0xd3, 0xdf, 0x47, 0x6b, 0xcd, 0x6f, 0x4f, 0x69, 0xcd, 0xb7, 0x9b, 0x5a, 0xf3, 0xc1,
-	0xde, 0xf4, 0x53, 0x7d, 0x1e, 0xfb, 0x9a, 0x51, 0xaa, 0xe8, 0x6a, 0xe6, 0x0b, 0xcd, 0xf6, 0x7c,
-	0xa1, 0x67, 0xfa, 0xbc, 0x63, 0xc6, 0xda, 0x79, 0x1e, 0x86, 0x23, 0xe2, 0xc4, 0x61, 0x20, 0xbe,
-	0x93, 0x5a, 0x0c, 0x98, 0xb5, 0x62, 0x01, 0xb5, 0x7f, 0xaf, 0x96, 0x1d, 0xec, 0xab, 0xdc, 0x45,
-	0x1e, 0x46, 0xc8, 0x83, 0x21, 0xb6, 0xff, 0xe3, 0x62, 0xe7, 0xc6, 0xd1, 0x96, 0x28, 0x55, 0x31,
-	0x8a, 0xf4, 0x7c, 0x95, 0x7e, 0x35, 0xda, 0x84, 0x19, 0x0b, 0xb4, 0x0b, 0x55, 0x57, 0xee, 0xb4,
-	0x4a, 0x45, 0x78, 0x3b, 0xc5, 0x3e, 0x4b, 0x73, 0x1c, 0xa3, 0xba, 0x40, 0x6d, 0xcf, 0x14, 0x37,
-	0x44, 0xa0, 0xdc, 0xf4, 0x12, 0xf1, 0x59, 0x8f, 0xb8, 0xf1, 0xbe, 0xea, 0x19, 0xaf, 0x38, 0x42,
-	0x15, 0xd4, 0x55, 0x2f, 0xc1, 0x94, 0x3e, 0xfa, 0x8c, 0x05, 0xa3, 0xb1, 0xdb, 0x5a, 0x8b, 0xc2,
-	0x1d, 0xaf, 0x41, 0x22, 0x61, 0x80, 0x1e, 0x51, 0xec, 0xd5, 0x17, 0x56, 0x24, 0x41, 0xcd, 0x97,
-	0x3b, 0x42, 0x34, 0x04, 0x9b, 0x7c, 0xe9, 0xc6, 0xec, 0x49, 0xf1, 0xee, 0x8b, 0xc4, 0x65, 0x2b,
-	0x4e, 0x6e, 0xa8, 0xd9, 0x4c, 0x39, 0xb2, 0x41, 0xbe, 0xd8, 0x71, 0xb7, 0xe9, 0x7a, 0xd3, 0x1d,
-	0x7a, 0xea, 0xfe, 0xde, 0xf4, 0x93, 0x0b, 0xf9, 0x3c, 0x71, 0xbf, 0xce, 0xb0, 0x01, 0x6b, 0x77,
-	0x7c, 0x1f, 0x93, 0x57, 0x3b, 0x84, 0xf9, 0xd6, 0x0a, 0x18, 0xb0, 0x35, 0x4d, 0x30, 0x33, 0x60,
-	0x06, 0x04, 0x9b, 0x7c, 0xd1, 0xab, 0x30, 0xdc, 0x72, 0x92, 0xc8, 0xdb, 0x15, 0x0e, 0xb5, 0x23,
-	0x6e, 0x91, 0x56, 0x18, 0x2d, 0xcd, 0x9c, 0x59, 0x01, 0xbc, 0x11, 0x0b, 0x46, 0xa8, 0x05, 0x95,
-	0x16, 0x89, 0x9a, 0x64, 0xaa, 0x5a, 0xc4, 0x49, 0xc3, 0x0a, 0x25, 0xa5, 0x19, 0xd6, 0xa8, 0xe5,
-	0xc5, 0xda, 0x30, 0xe7, 0x82, 0x5e, 0x81, 0x6a, 0x4c, 0x7c, 0xe2, 0x52, 0xdb, 0xa9, 0xc6, 0x38,
-	0xbe, 0x6b, 0x40, 0x3b, 0x92, 0x1a, 0x2d, 0x75, 0xf1, 0x28, 0x5f, 0x60, 0xf2, 0x1f, 0x56, 0x24,
-	0xe9, 0x00, 0xb6, 0xfd, 0x4e, 0xd3, 0x0b, 0xa6, 0xa0, 0x88, 0x01, 0x5c, 0x63, 0xb4, 0x32, 0x03,
-	0xc8, 0x1b, 0xb1, 0x60, 0x64, 0xff, 0x57, 0x0b, 0x50, 0x5a, 0xa8, 0x9d, 0x80, 0xc1, 0xfc, 0x6a,
-	0xda, 0x60, 0x5e, 0x2e, 0xd2, 0xa2, 0xe9, 0x63, 0x33, 0xff, 0x62, 0x0d, 0x32, 0xea, 0xe0, 0x26,
-	0x89, 0x13, 0xd2, 0x78, 0x53, 0x84, 0xbf, 0x29, 0xc2, 0xdf, 0x14, 0xe1, 0x4a, 0x84, 0x6f, 0x64,
-	0x44, 0xf8, 0x7b, 0x8d, 0x55, 0xaf, 0x43, 0x1e, 0x3e, 0xa2, 0x62, 0x22, 0xcc, 0x1e, 0x18, 0x08,
-	0x54, 0x12, 0x5c, 0xaf, 0xaf, 0xde, 0xcc, 0x95, 0xd9, 0x1f, 0x49, 0xcb, 0xec, 0xa3, 0xb2, 0xf8,
-	0x9b, 0x20, 0xa5, 0x7f, 0xc3, 0x82, 0xb7, 0xa6, 0xa5, 0x97, 0x9c, 0x39, 0x4b, 0xcd, 0x20, 0x8c,
-	0xc8, 0xa2, 0xb7, 0xb9, 0x49, 0x22, 0x12, 0xb8, 0x24, 0x56, 0x8e, 0x1f, 0xab, 0x9f, 0xe3, 0x07,
-	0xbd, 0x1b, 0xc6, 0xee, 0xc6, 0x61, 0xb0, 0x16, 0x7a, 0x81, 0x10, 0x41, 0x74, 0xc7, 0x71, 0xfa,
-	0xfe, 0xde, 0xf4, 0x18, 0x1d, 0x51, 0xd9, 0x8e, 0x53, 0x58, 0x68, 0x01, 0x26, 0xef, 0xbe, 0xba,
-	0xe6, 0x24, 0x86, 0xab, 0x41, 0x3a, 0x05, 0xd8, 0xc9, 0xd6, 0xf5, 0xf7, 0x65, 0x80, 0xb8, 0x17,
-	0xdf, 0xfe, 0xbb, 0x25, 0x38, 0x9f, 0x79, 0x91, 0xd0, 0xf7, 0xc3, 0x4e, 0x42, 0xf7, 0x44, 0xe8,
-	0x27, 0x2c, 0x38, 0xdd, 0x4a, 0x7b, 0x33, 0x62, 0xe1, 0x0b, 0xff, 0xce, 0xc2, 0x74, 0x44, 0xc6,
-	0x5d, 0x32, 0x3f, 0x25, 0x46, 0xe8, 0x74, 0x06, 0x10, 0xe3, 0x9e, 0xbe, 0xa0, 0x57, 0xa0, 0xd6,
-	0x72, 0x76, 0x6f, 0xb5, 0x1b, 0x4e, 0x22, 0xf7, 0xaa, 0xfd, 0x5d, 0x0c, 0x9d, 0xc4, 0xf3, 0x67,
-	0x78, 0x30, 0xcd, 0xcc, 0x52, 0x90, 0xac, 0x46, 0xf5, 0x24, 0xf2, 0x82, 0x26, 0xf7, 0x80, 0xae,
-	0x48, 0x32, 0x58, 0x53, 0xb4, 0xbf, 0x64, 0x65, 0x95, 0x94, 0x1a, 0x9d, 0xc8, 0x49, 0x48, 0xb3,
-	0x8b, 0x3e, 0x06, 0x15, 0xba, 0x6f, 0x94, 0xa3, 0x72, 0xa7, 0x48, 0xcd, 0x69, 0x7c, 0x09, 0xad,
-	0x44, 0xe9, 0xbf, 0x18, 0x73, 0xa6, 0xf6, 0x4f, 0xd4, 0xb2, 0xc6, 0x02, 0x0b, 0x09, 0xb8, 0x04,
-	0xd0, 0x0c, 0xd7, 0x49, 0xab, 0xed, 0xd3, 0x61, 0xb1, 0xd8, 0xe9, 0x8f, 0xf2, 0xa3, 0x5c, 0x55,
-	0x10, 0x6c, 0x60, 0xa1, 0x1f, 0xb0, 0x00, 0x9a, 0x72, 0xce, 0x4b, 0x43, 0xe0, 0x56, 0x91, 0xaf,
-	0xa3, 0x57, 0x94, 0xee, 0x8b, 0x62, 0x88, 0x0d, 0xe6, 0xe8, 0x7b, 0x2d, 0xa8, 0x26, 0xb2, 0xfb,
-	0x5c, 0x35, 0xae, 0x17, 0xd9, 0x13, 0xf9, 0xd2, 0xda, 0x26, 0x52, 0x43, 0xa2, 0xf8, 0xa2, 0xef,
-	0xb3, 0x00, 0xe2, 0x6e, 0xe0, 0xae, 0x85, 0xbe, 0xe7, 0x76, 0x85, 0xc6, 0xbc, 0x5d, 0xa8, 0xaf,
-	0x47, 0x51, 0x9f, 0x9f, 0xa0, 0xa3, 0xa1, 0xff, 0x63, 0x83, 0x33, 0xfa, 0x38, 0x54, 0x63, 0x31,
-	0xdd, 0x84, 0x8e, 0x5c, 0x2f, 0xd6, 0xe3, 0xc4, 0x69, 0x0b, 0xf1, 0x2a, 0xfe, 0x61, 0xc5, 0x13,
-	0xfd, 0x98, 0x05, 0xa7, 0xda, 0x69, 0x1f, 0xa2, 0x50, 0x87, 0xc5, 0xc9, 0x80, 0x8c, 0x8f, 0x92,
-	0x7b, 0x5b, 0x32, 0x8d, 0x38, 0xdb, 0x0b, 0x2a, 0x01, 0xf5, 0x0c, 0x5e, 0x6d, 0x73, 0x7f, 0xe6,
-	0x88, 0x96, 0x80, 0x57, 0xb3, 0x40, 0xdc, 0x8b, 0x8f, 0xd6, 0xe0, 0x2c, 0xed, 0x5d, 0x97, 0x9b,
-	0x9f, 0x52, 0xbd, 0xc4, 0x4c, 0x19, 0x56, 0xe7, 0x9f, 0x16, 0x33, 0x84, 0x1d, 0x84, 0x64, 0x71,
-	0x70, 0xee, 0x93, 0xe8, 0x77, 0x2c, 0x78, 0xda, 0x63, 0x6a, 0xc0, 0xf4, 0xe6, 0x6b, 0x8d, 0x20,
-	0x8e, 0xec, 0x49, 0xa1, 0xb2, 0xa2, 0x9f, 0xfa, 0x99, 0xff, 0x7a, 0xf1, 0x06, 0x4f, 0x2f, 0xed,
-	0xd3, 0x25, 0xbc, 0x6f, 0x87, 0xd1, 0x37, 0xc3, 0xb8, 0x5c, 0x17, 0x6b, 0x54, 0x04, 0x33, 0x45,
-	0x5b, 0x9b, 0x9f, 0xbc, 0xbf, 0x37, 0x3d, 0xbe, 0x6e, 0x02, 0x70, 0x1a, 0xcf, 0xfe, 0xc1, 0xa1,
-	0xd4, 0x11, 0x92, 0x72, 0x70, 0x32, 0x71, 0xe3, 0x4a, 0xff, 0x8f, 0x94, 0x9e, 0x85, 0x8a, 0x1b,
-	0xe5, 0x5d, 0xd2, 0xe2, 0x46, 0x35, 0xc5, 0xd8, 0x60, 0x4e, 0x8d, 0xd2, 0x49, 0x27, 0xeb, 0x46,
-	0x15, 0x12, 0xf0, 0x95, 0x22, 0xbb, 0xd4, 0x7b, 0xe0, 0x77, 0x5e, 0x74, 0x6d, 0xb2, 0x07, 0x84,
-	0x7b, 0xbb, 0x84, 0xbe, 0x1b, 0x6a, 0x91, 0x8a, 0x91, 0x29, 0x17, 0xb1, 0x55, 0x93, 0xd3, 0x46,
-	0x74, 0x47, 0x9d, 0x0e, 0xe9, 0x68, 0x18, 0xcd, 0x11, 0xbd, 0x17, 0x26, 0xd4, 0x9f, 0x05, 0x76,
-	0x2c, 0x44, 0x85, 0x62, 0x79, 0xfe, 0x09, 0xf1, 0xd4, 0x04, 0x4e, 0x41, 0x71, 0x06, 0xdb, 0xfe,
-	0x6c, 0x29, 0x75, 0xea, 0x66, 0xc8, 0x9e, 0x01, 0x4e, 0x14, 0xbf, 0x60, 0xc1, 0x68, 0x14, 0xfa,
-	0xbe, 0x17, 0x34, 0xa9, 0x9c, 0x14, 0xca, 0xfe, 0x83, 0xc7, 0xa2, 0x6f, 0x85, 0x40, 0x64, 0x96,
-	0x39, 0xd6, 0x3c, 0xb1, 0xd9, 0x01, 0xf4, 0x32, 0x8c, 0x37, 0x88, 0x4f, 0xe8, 0xb3, 0xab, 0x11,
-	0xdd, 0x53, 0x71, 0x0f, 0xb6, 0x8a, 0x59, 0x59, 0x34, 0x81, 0x38, 0x8d, 0x6b, 0xff, 0xa9, 0x05,
-	0x53, 0xfd, 0x94, 0x01, 0x22, 0xf0, 0x94, 0x94, 0x74, 0x6a, 0x44, 0x57, 0x03, 0x49, 0x4f, 0xe8,
-	0xf3, 0xe7, 0x04, 0x9f, 0xa7, 0xd6, 0xfa, 0xa3, 0xe2, 0xfd, 0xe8, 0xa0, 0x0f, 0xc0, 0x69, 0x63,
-	0x50, 0x62, 0x35, 0xaa, 0xb5, 0xf9, 0x19, 0x6a, 0x7d, 0xcd, 0x65, 0x60, 0x0f, 0xf6, 0xa6, 0x9f,
-	0xc8, 0xb6, 0x09, 0x6d, 0xd5, 0x43, 0xc7, 0xfe, 0xe9, 0x9e, 0x4f, 0xad, 0x0c, 0x8d, 0x37, 0xac,
-	0x1e, 0x57, 0xc6, 0x77, 0x1e, 0x87, 0x72, 0x67, 0x4e, 0x0f, 0x15, 0x20, 0xd2, 0x1f, 0xe7, 0x11,
-	0x06, 0x14, 0xd8, 0xbf, 0x3d, 0x04, 0xfb, 0xf4, 0x6c, 0x80, 0x9d, 0xc3, 0xa1, 0x4f, 0x78, 0x3f,
-	0x6f, 0xa9, 0xa3, 0x3c, 0x2e, 0x40, 0x1a, 0xc7, 0x35, 0xf6, 0x7c, 0xf3, 0x16, 0xf3, 0xa0, 0x16,
-	0xe5, 0xc2, 0x4f, 0x1f, 0x1a, 0xa2, 0x2f, 0x5b, 0xe9, 0xc3, 0x48, 0x1e, 0xc8, 0xe9, 0x1d, 0x5b,
-	0x9f, 0x8c, 0x13, 0x4e, 0xde, 0x31, 0x7d, 0x2e, 0xd6, 0xef, 0xec, 0x73, 0x06, 0x60, 0xd3, 0x0b,
-	0x1c, 0xdf, 0x7b, 0x8d, 0x6e, 0xcd, 0x2a, 0xcc, 0xba, 0x60, 0xe6, 0xda, 0x15, 0xd5, 0x8a, 0x0d,
-	0x8c, 0x0b, 0xff, 0x3f, 0x8c, 0x1a, 0x6f, 0x9e, 0x13, 0x8b, 0x73, 0xd6, 0x8c, 0xc5, 0xa9, 0x19,
-	0x21, 0x34, 0x17, 0xde, 0x0b, 0xa7, 0xb3, 0x1d, 0x3c, 0xcc, 0xf3, 0xf6, 0xff, 0x1e, 0xc9, 0x9e,
-	0x0e, 0xae, 0x93, 0xa8, 0x45, 0xbb, 0xf6, 0xa6, 0x57, 0xed, 0x4d, 0xaf, 0xda, 0x9b, 0x5e, 0x35,
-	0xf3, 0x60, 0x44, 0x78, 0x8c, 0x46, 0x4e, 0xc8, 0x63, 0x94, 0xf2, 0x81, 0x55, 0x0b, 0xf7, 0x81,
-	0xd9, 0x9f, 0xe9, 0x39, 0x36, 0x58, 0x8f, 0x08, 0x41, 0x21, 0x54, 0x82, 0xb0, 0x41, 0xa4, 0x81,
-	0x7d, 0xbd, 0x18, 0x6b, 0xf1, 0x66, 0xd8, 0x30, 0x42, 0xe4, 0xe9, 0xbf, 0x18, 0x73, 0x3e, 0xf6,
-	0xa7, 0x87, 0x21, 0x65, 0xcb, 0xf2, 0xef, 0xfe, 0x8d, 0x30, 0x12, 0x91, 0x76, 0x78, 0x0b, 0x2f,
-	0x0b, 0x5d, 0xa6, 0x33, 0x8c, 0x78, 0x33, 0x96, 0x70, 0xaa, 0xf3, 0xda, 0x4e, 0xb2, 0x25, 0x94,
-	0x99, 0xd2, 0x79, 0x6b, 0x4e, 0xb2, 0x85, 0x19, 0x84, 0x9a, 0xa1, 0x49, 0xea, 0x1c, 0x5e, 0x9c,
-	0x37, 0x2b, 0x33, 0x34, 0x7d, 0x4a, 0x8f, 0x33, 0xd8, 0xe8, 0x55, 0x18, 0xda, 0x22, 0x7e, 0x4b,
-	0x7c, 0xfa, 0x7a, 0x71, 0xba, 0x86, 0xbd, 0xeb, 0x35, 0xe2, 0xb7, 0xb8, 0x24, 0xa4, 0xbf, 0x30,
-	0x63, 0x45, 0xe7, 0x7d, 0x6d, 0xbb, 0x13, 0x27, 0x61, 0xcb, 0x7b, 0x4d, 0xba, 0x59, 0xbf, 0xb3,
-	0x60, 0xc6, 0x37, 0x24, 0x7d, 0xee, 0xcf, 0x52, 0x7f, 0xb1, 0xe6, 0xcc, 0xfa, 0xd1, 0xf0, 0x22,
-	0x36, 0x65, 0xba, 0xc2, 0x5b, 0x5a, 0x74, 0x3f, 0x16, 0x25, 0x7d, 0xde, 0x0f, 0xf5, 0x17, 0x6b,
-	0xce, 0xa8, 0xab, 0xd6, 0xdf, 0x28, 0xeb, 0xc3, 0xad, 0x82, 0xfb, 0xc0, 0xd7, 0x5e, 0xee, 0x3a,
-	0x7c, 0x0e, 0x2a, 0xee, 0x96, 0x13, 0x25, 0x53, 0x63, 0x6c, 0xd2, 0xa8, 0x59, 0xbc, 0x40, 0x1b,
-	0x31, 0x87, 0xa1, 0x67, 0xa0, 0x1c, 0x91, 0x4d, 0x16, 0x37, 0x6d, 0x44, 0x6c, 0x61, 0xb2, 0x89,
-	0x69, 0xbb, 0xb2, 0xcb, 0x26, 0xfa, 0x86, 0xf2, 0xfd, 0x64, 0x29, 0x6d, 0xd8, 0xa5, 0x47, 0x86,
-	0xaf, 0x07, 0xb7, 0x13, 0xc5, 0xd2, 0x3b, 0x67, 0xac, 0x07, 0xd6, 0x8c, 0x25, 0x1c, 0x7d, 0xd2,
-	0x82, 0x91, 0xbb, 0x71, 0x18, 0x04, 0x24, 0x11, 0x4a, 0xf4, 0x76, 0xc1, 0x83, 0x75, 0x9d, 0x53,
-	0xd7, 0x7d, 0x10, 0x0d, 0x58, 0xf2, 0xa5, 0xdd, 0x25, 0xbb, 0xae, 0xdf, 0x69, 0xf4, 0x84, 0xe9,
-	0x5c, 0xe6, 0xcd, 0x58, 0xc2, 0x29, 0xaa, 0x17, 0x70, 0xd4, 0xa1, 0x34, 0xea, 0x52, 0x20, 0x50,
-	0x05, 0xdc, 0xfe, 0xf9, 0x2a, 0x9c, 0xcb, 0x5d, 0x3e, 0xd4, 0xe4, 0x62, 0x46, 0xcd, 0x15, 0xcf,
-	0x27, 0x32, 0x40, 0x8d, 0x99, 0x5c, 0xb7, 0x55, 0x2b, 0x36, 0x30, 0xd0, 0xf7, 0x00, 0xb4, 0x9d,
-	0xc8, 0x69, 0x11, 0xe5, 0x3d, 0x3f, 0xb2, 0x65, 0x43, 0xfb, 0xb1, 0x26, 0x69, 0x6a, 0x0f, 0x82,
-	0x6a, 0x8a, 0xb1, 0xc1, 0x12, 0xbd, 0x08, 0xa3, 0x11, 0xf1, 0x89, 0x13, 0xb3, 0xc0, 0xfc, 0x6c,
-	0xfe, 0x12, 0xd6, 0x20, 0x6c, 0xe2, 0xa1, 0xe7, 0x55, 0x2c, 0xdf, 0x50, 0x3a, 0xd0, 0x25, 0x1d,
-	0xcf, 0x87, 0xbe, 0x68, 0xc1, 0xc4, 0xa6, 0xe7, 0x13, 0xcd, 0x5d, 0x64, 0x1b, 0xad, 0x1e, 0xfd,
-	0x25, 0xaf, 0x98, 0x74, 0xb5, 0x0c, 0x4d, 0x35, 0xc7, 0x38, 0xc3, 0x9e, 0x7e, 0xe6, 0x1d, 0x12,
-	0x31, 0xe1, 0x3b, 0x9c, 0xfe, 0xcc, 0xb7, 0x79, 0x33, 0x96, 0x70, 0x34, 0x07, 0xa7, 0xda, 0x4e,
-	0x1c, 0x2f, 0x44, 0xa4, 0x41, 0x82, 0xc4, 0x73, 0x7c, 0x9e, 0xde, 0x53, 0xd5, 0x81, 0xee, 0x6b,
-	0x69, 0x30, 0xce, 0xe2, 0xa3, 0xf7, 0xc3, 0x93, 0xdc, 0x3d, 0xb5, 0xe2, 0xc5, 0xb1, 0x17, 0x34,
-	0xf5, 0x34, 0x10, 0x5e, 0xba, 0x69, 0x41, 0xea, 0xc9, 0xa5, 0x7c, 0x34, 0xdc, 0xef, 0x79, 0xf4,
-	0x02, 0x54, 0xe3, 0x6d, 0xaf, 0xbd, 0x10, 0x35, 0x62, 0x76, 0x34, 0x55, 0xd5, 0x3e, 0xe1, 0xba,
-	0x68, 0xc7, 0x0a, 0x03, 0xb9, 0x30, 0xc6, 0x3f, 0x09, 0x0f, 0x46, 0x14, 0x12, 0xf4, 0x1d, 0x7d,
-	0x15, 0xb9, 0x48, 0xfb, 0x9d, 0xc1, 0xce, 0xbd, 0xcb, 0xf2, 0xa0, 0x8c, 0x9f, 0xeb, 0xdc, 0x36,
-	0xc8, 0xe0, 0x14, 0xd1, 0xf4, 0x9e, 0x6e, 0x74, 0x80, 0x3d, 0xdd, 0x8b, 0x30, 0xba, 0xdd, 0xd9,
-	0x20, 0x62, 0xe4, 0x85, 0x60, 0x53, 0xb3, 0xef, 0x86, 0x06, 0x61, 0x13, 0x8f, 0xc5, 0x81, 0xb6,
-	0x3d, 0xf1, 0x2f, 0x9e, 0x1a, 0x37, 0xe2, 0x40, 0xd7, 0x96, 0x64, 0x33, 0x36, 0x71, 0x68, 0xd7,
-	0xe8, 0x58, 0xac, 0x93, 0x98, 0xa5, 0x79, 0xd0, 0xe1, 0x52, 0x5d, 0xab, 0x4b, 0x00, 0xd6, 0x38,
-	0x68, 0x0d, 0xce, 0xd2, 0x3f, 0x75, 0x96, 0xf6, 0x7c, 0xdb, 0xf1, 0xbd, 0x06, 0x0f, 0x4a, 0x3c,
-	0x95, 0x76, 0xae, 0xd6, 0x73, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x8f, 0x97, 0xd2, 0x9e, 0x13, 0x53,
-	0x84, 0xa1, 0x98, 0x0a, 0xaa, 0xe4, 0xb6, 0x13, 0x49, 0x83, 0xe7, 0x88, 0x39, 0x5a, 0x82, 0xee,
-	0x6d, 0x27, 0x32, 0x45, 0x1e, 0x63, 0x80, 0x25, 0x27, 0x74, 0x17, 0x86, 0x12, 0xdf, 0x29, 0x28,
-	0x03, 0xd4, 0xe0, 0xa8, 0xbd, 0x60, 0xcb, 0x73, 0x31, 0x66, 0x3c, 0xd0, 0xd3, 0x74, 0xf7, 0xb6,
-	0x21, 0x8f, 0xf9, 0xc4, 0x86, 0x6b, 0x23, 0xc6, 0xac, 0xd5, 0xfe, 0xdb, 0xe3, 0x39, 0x5a, 0x47,
-	0x19, 0x02, 0xe8, 0x12, 0x00, 0x9d, 0x34, 0x6b, 0x11, 0xd9, 0xf4, 0x76, 0x85, 0x21, 0xa6, 0x24,
-	0xdb, 0x4d, 0x05, 0xc1, 0x06, 0x96, 0x7c, 0xa6, 0xde, 0xd9, 0xa4, 0xcf, 0x94, 0x7a, 0x9f, 0xe1,
-	0x10, 0x6c, 0x60, 0xa1, 0x77, 0xc3, 0xb0, 0xd7, 0x72, 0x9a, 0x2a, 0x44, 0xf9, 0x69, 0x2a, 0xd2,
-	0x96, 0x58, 0xcb, 0x83, 0xbd, 0xe9, 0x09, 0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x69, 0x0b,
-	0xc6, 0xdc, 0xb0, 0xd5, 0x0a, 0x03, 0xbe, 0x7d, 0x16, 0xbe, 0x80, 0xbb, 0xc7, 0x65, 0x26, 0xcd,
-	0x2c, 0x18, 0xcc, 0xb8, 0x33, 0x40, 0xa5, 0xaa, 0x9a, 0x20, 0x9c, 0xea, 0x95, 0x29, 0xf9, 0x2a,
-	0x07, 0x48, 0xbe, 0x5f, 0xb0, 0x60, 0x92, 0x3f, 0x6b, 0xec, 0xea, 0x45, 0xa2, 0x65, 0x78, 0xcc,
-	0xaf, 0xd5, 0xe3, 0xe8, 0x50, 0x9e, 0xe6, 0x1e, 0x38, 0xee, 0xed, 0x24, 0xba, 0x0a, 0x93, 0x9b,
-	0x61, 0xe4, 0x12, 0x73, 0x20, 0x84, 0xd8, 0x56, 0x84, 0xae, 0x64, 0x11, 0x70, 0xef, 0x33, 0xe8,
-	0x36, 0x3c, 0x61, 0x34, 0x9a, 0xe3, 0xc0, 0x25, 0xf7, 0xb3, 0x82, 0xda, 0x13, 0x57, 0x72, 0xb1,
-	0x70, 0x9f, 0xa7, 0xd3, 0x42, 0xb2, 0x36, 0x80, 0x90, 0xfc, 0x08, 0x9c, 0x77, 0x7b, 0x47, 0x66,
-	0x27, 0xee, 0x6c, 0xc4, 0x5c, 0x8e, 0x57, 0xe7, 0xbf, 0x4e, 0x10, 0x38, 0xbf, 0xd0, 0x0f, 0x11,
-	0xf7, 0xa7, 0x81, 0x3e, 0x06, 0xd5, 0x88, 0xb0, 0xaf, 0x12, 0x8b, 0xac, 0xc3, 0x23, 0x7a, 0x3b,
-	0xb4, 0x05, 0xcf, 0xc9, 0x6a, 0xcd, 0x24, 0x1a, 0x62, 0xac, 0x38, 0xa2, 0x7b, 0x30, 0xd2, 0x76,
-	0x12, 0x77, 0x4b, 0xa4, 0x0f, 0x1e, 0xf9, 0x60, 0x40, 0x31, 0x67, 0xe7, 0x38, 0x46, 0x99, 0x07,
-	0xce, 0x04, 0x4b, 0x6e, 0xd4, 0x56, 0x73, 0xc3, 0x56, 0x3b, 0x0c, 0x48, 0x90, 0x48, 0x25, 0x32,
-	0xc1, 0x0f, 0x5b, 0x64, 0x2b, 0x36, 0x30, 0x7a, 0x74, 0xb9, 0x46, 0x9b, 0x9a, 0xdc, 0x47, 0x97,
-	0x1b, 0xd4, 0xfa, 0x3d, 0x4f, 0x95, 0x0d, 0x73, 0x2b, 0xde, 0xf1, 0x92, 0xad, 0xb0, 0x93, 0xc8,
-	0x5d, 0xb2, 0x50, 0x54, 0x4a, 0xd9, 0x2c, 0xe7, 0xe0, 0xe0, 0xdc, 0x27, 0xb3, 0x9a, 0xf5, 0xd4,
-	0xc3, 0x69, 0xd6, 0xd3, 0x03, 0x68, 0xd6, 0x3a, 0x9c, 0x63, 0x3d, 0x10, 0x56, 0xb2, 0x74, 0x5a,
-	0xc6, 0x53, 0x88, 0x75, 0x5e, 0x65, 0xde, 0x2c, 0xe7, 0x21, 0xe1, 0xfc, 0x67, 0x2f, 0x7c, 0x3b,
-	0x4c, 0xf6, 0x08, 0xb9, 0x43, 0x39, 0x24, 0x17, 0xe1, 0x89, 0x7c, 0x71, 0x72, 0x28, 0xb7, 0xe4,
-	0xcf, 0x67, 0x82, 0xe2, 0x8d, 0x2d, 0xda, 0x00, 0x2e, 0x6e, 0x07, 0xca, 0x24, 0xd8, 0x11, 0xda,
-	0xf5, 0xca, 0xd1, 0x66, 0xf5, 0xe5, 0x60, 0x87, 0x4b, 0x43, 0xe6, 0xc7, 0xbb, 0x1c, 0xec, 0x60,
-	0x4a, 0x1b, 0xfd, 0xb0, 0x95, 0xda, 0x40, 0x70, 0xc7, 0xf8, 0x87, 0x8f, 0x65, 0x4f, 0x3a, 0xf0,
-	0x9e, 0xc2, 0xfe, 0x37, 0x25, 0xb8, 0x78, 0x10, 0x91, 0x01, 0x86, 0xef, 0x39, 0x18, 0x8e, 0x59,
-	0x98, 0x8b, 0x50, 0x57, 0xa3, 0x74, 0x15, 0xf3, 0xc0, 0x97, 0x8f, 0x60, 0x01, 0x42, 0x3e, 0x94,
-	0x5b, 0x4e, 0x5b, 0xf8, 0x4b, 0x97, 0x8e, 0x9a, 0x59, 0x48, 0xff, 0x3b, 0xfe, 0x8a, 0xd3, 0xe6,
-	0x73, 0xde, 0x68, 0xc0, 0x94, 0x0d, 0x4a, 0xa0, 0xe2, 0x44, 0x91, 0x23, 0x63, 0x2a, 0x6e, 0x14,
-	0xc3, 0x6f, 0x8e, 0x92, 0xe4, 0x47, 0xd2, 0xa9, 0x26, 0xcc, 0x99, 0xd9, 0x3f, 0x56, 0x4d, 0xa5,
-	0xa1, 0xb1, 0x40, 0x99, 0x18, 0x86, 0x85, 0x9b, 0xd4, 0x2a, 0x3a, 0xa1, 0x93, 0xe7, 0x79, 0x33,
-	0x0f, 0x84, 0xa8, 0xc3, 0x21, 0x58, 0xa1, 0xcf, 0x59, 0xac, 0xda, 0x85, 0xcc, 0xed, 0x13, 0xbb,
-	0xfa, 0xe3, 0x29, 0xbe, 0x61, 0xd6, 0xd0, 0x90, 0x8d, 0xd8, 0xe4, 0x2e, 0x2a, 0xfa, 0xb0, 0xdd,
-	0x4c, 0x6f, 0x45, 0x1f, 0xb6, 0x3b, 0x91, 0x70, 0xb4, 0x9b, 0x13, 0x10, 0x53, 0x40, 0x11, 0x84,
-	0x01, 0x42, 0x60, 0xbe, 0x6c, 0xc1, 0xa4, 0x97, 0x8d, 0x6c, 0x10, 0x7b, 0xe0, 0x3b, 0xc5, 0xf8,
-	0x34, 0x7b, 0x03, 0x27, 0x94, 0xa1, 0xd3, 0x03, 0xc2, 0xbd, 0x9d, 0x41, 0x0d, 0x18, 0xf2, 0x82,
-	0xcd, 0x50, 0x98, 0x77, 0xf3, 0x47, 0xeb, 0xd4, 0x52, 0xb0, 0x19, 0xea, 0xd5, 0x4c, 0xff, 0x61,
-	0x46, 0x1d, 0x2d, 0xc3, 0x59, 0x99, 0x6c, 0x74, 0xcd, 0x8b, 0x93, 0x30, 0xea, 0x2e, 0x7b, 0x2d,
-	0x2f, 0x61, 0xa6, 0x59, 0x79, 0x7e, 0x8a, 0xaa, 0x37, 0x9c, 0x03, 0xc7, 0xb9, 0x4f, 0xa1, 0xd7,
-	0x60, 0x44, 0x46, 0x13, 0x54, 0x8b, 0xf0, 0x27, 0xf4, 0xce, 0x7f, 0x35, 0x99, 0xea, 0x22, 0x9c,
-	0x40, 0x32, 0x44, 0x9f, 0xb5, 0x60, 0x82, 0xff, 0xbe, 0xd6, 0x6d, 0xf0, 0xe4, 0xc7, 0x5a, 0x11,
-	0x29, 0x03, 0xf5, 0x14, 0xcd, 0x79, 0x74, 0x7f, 0x6f, 0x7a, 0x22, 0xdd, 0x86, 0x33, 0x7c, 0xed,
-	0x7f, 0x3c, 0x06, 0xbd, 0xf1, 0x17, 0xe9, 0x60, 0x0b, 0xeb, 0xc4, 0x83, 0x2d, 0xee, 0xc2, 0x50,
-	0xac, 0xe3, 0x1c, 0x0a, 0x58, 0x66, 0x82, 0xab, 0x3e, 0x86, 0xee, 0x06, 0x2e, 0x66, 0x3c, 0x50,
-	0x07, 0x86, 0x79, 0x41, 0x2d, 0xa1, 0x01, 0x8e, 0x7e, 0xf2, 0x6d, 0x16, 0xe6, 0xd2, 0x6e, 0x2d,
-	0xde, 0x8a, 0x05, 0x33, 0xb4, 0x0b, 0x23, 0x5b, 0x7c, 0x3a, 0x8a, 0xbd, 0xde, 0xca, 0x51, 0xc7,
-	0x37, 0x35, 0xc7, 0xf5, 0xe4, 0x13, 0x0d, 0x58, 0xb2, 0x63, 0xb1, 0x7d, 0x46, 0xf4, 0x11, 0x17,
-	0x24, 0xc5, 0xe5, 0x71, 0x0e, 0x1e, 0x7a, 0xf4, 0x51, 0x18, 0x8b, 0x88, 0x1b, 0x06, 0xae, 0xe7,
-	0x93, 0xc6, 0x9c, 0x3c, 0x10, 0x3b, 0x4c, 0x86, 0x1e, 0xf3, 0x26, 0x61, 0x83, 0x06, 0x4e, 0x51,
-	0x64, 0xeb, 0x4c, 0xa5, 0xf4, 0xd3, 0x0f, 0x42, 0xc4, 0xc1, 0xc7, 0x72, 0x41, 0x05, 0x04, 0x18,
-	0x4d, 0xbe, 0xce, 0xd2, 0x6d, 0x38, 0xc3, 0x17, 0x7d, 0x00, 0x20, 0xdc, 0xe0, 0x01, 0x7c, 0x73,
-	0x89, 0x38, 0x05, 0x39, 0xcc, 0xab, 0x4e, 0xf0, 0x34, 0x60, 0x49, 0x01, 0x1b, 0xd4, 0xd0, 0x0d,
-	0x00, 0xbe, 0x72, 0xd6, 0xbb, 0x6d, 0xb9, 0x21, 0x94, 0x29, 0x96, 0x50, 0x57, 0x90, 0x07, 0x7b,
-	0xd3, 0xbd, 0x3e, 0x67, 0x16, 0x65, 0x64, 0x3c, 0x8e, 0xbe, 0x0b, 0x46, 0xe2, 0x4e, 0xab, 0xe5,
-	0xa8, 0x33, 0x92, 0x02, 0x13, 0x8b, 0x39, 0x5d, 0x43, 0x30, 0xf2, 0x06, 0x2c, 0x39, 0xa2, 0xbb,
-	0x54, 0xc4, 0x0b, 0x09, 0xc5, 0x57, 0x11, 0xb7, 0x50, 0xb8, 0x27, 0xf0, 0x3d, 0x72, 0x17, 0x83,
-	0x73, 0x70, 0x1e, 0xec, 0x4d, 0x3f, 0x91, 0x6e, 0x5f, 0x0e, 0x45, 0xaa, 0x6f, 0x2e, 0x4d, 0x74,
-	0x5d, 0xd6, 0x0e, 0xa3, 0xaf, 0x2d, 0x0b, 0xcf, 0xbc, 0x4d, 0xd7, 0x0e, 0x63, 0xcd, 0xfd, 0xc7,
-	0xcc, 0x7c, 0x18, 0xad, 0xc0, 0x19, 0x37, 0x0c, 0x92, 0x28, 0xf4, 0x7d, 0x5e, 0x57, 0x90, 0xef,
-	0xcd, 0xf9, 0x19, 0xca, 0x53, 0xa2, 0xdb, 0x67, 0x16, 0x7a, 0x51, 0x70, 0xde, 0x73, 0xd4, 0x26,
-	0xcf, 0xea, 0x87, 0x89, 0x42, 0x8e, 0xd7, 0x53, 0x34, 0x85, 0x84, 0x52, 0x6e, 0xef, 0x03, 0x34,
-	0x45, 0x90, 0x3e, 0x64, 0x15, 0x5f, 0xec, 0xdd, 0x30, 0x46, 0x76, 0x13, 0x12, 0x05, 0x8e, 0x7f,
-	0x0b, 0x2f, 0xcb, 0x03, 0x0b, 0xb6, 0x30, 0x2f, 0x1b, 0xed, 0x38, 0x85, 0x85, 0x6c, 0xe5, 0x25,
-	0x33, 0x72, 0xea, 0xb9, 0x97, 0x4c, 0xfa, 0xc4, 0xec, 0x9f, 0x2b, 0xa7, 0x6c, 0xd6, 0x47, 0x72,
-	0xa4, 0xcb, 0x2a, 0x3d, 0xc9, 0x92, 0x58, 0x0c, 0x20, 0xf6, 0x62, 0x45, 0x72, 0x56, 0x51, 0x73,
-	0xab, 0x26, 0x23, 0x9c, 0xe6, 0x8b, 0xb6, 0xa1, 0xb2, 0x15, 0xc6, 0x89, 0xdc, 0xa1, 0x1d, 0x71,
-	0x33, 0x78, 0x2d, 0x8c, 0x13, 0x66, 0x68, 0xa9, 0xd7, 0xa6, 0x2d, 0x31, 0xe6, 0x3c, 0xe8, 0xde,
-	0x3f, 0xde, 0x72, 0xa2, 0x46, 0x2a, 0xd4, 0x51, 0xd9, 0xd3, 0x75, 0x0d, 0xc2, 0x26, 0x9e, 0xfd,
-	0x67, 0x56, 0xea, 0x54, 0xeb, 0x0e, 0xcb, 0x58, 0xd8, 0x21, 0x01, 0x15, 0x51, 0x66, 0x8c, 0xe3,
-	0x37, 0x67, 0xf2, 0xbf, 0xdf, 0xda, 0xaf, 0x04, 0xe8, 0x3d, 0x4a, 0x61, 0x86, 0x91, 0x30, 0xc2,
-	0x21, 0x3f, 0x61, 0xa5, 0xb3, 0xfc, 0x4b, 0x45, 0x6c, 0xdd, 0xcc, 0x4a, 0x17, 0x07, 0x16, 0x0c,
-	0xb0, 0x7f, 0xd8, 0x82, 0x91, 0x79, 0xc7, 0xdd, 0x0e, 0x37, 0x37, 0xd1, 0x0b, 0x50, 0x6d, 0x74,
-	0x22, 0xb3, 0xe0, 0x80, 0x72, 0x56, 0x2d, 0x8a, 0x76, 0xac, 0x30, 0xe8, 0xd4, 0xdf, 0x74, 0x5c,
-	0x59, 0xef, 0xa2, 0xcc, 0xa7, 0xfe, 0x15, 0xd6, 0x82, 0x05, 0x84, 0x0e, 0x7f, 0xcb, 0xd9, 0x95,
-	0x0f, 0x67, 0x8f, 0xd4, 0x56, 0x34, 0x08, 0x9b, 0x78, 0xf6, 0xaf, 0x5b, 0x30, 0x35, 0xef, 0xc4,
-	0x9e, 0x3b, 0xd7, 0x49, 0xb6, 0xe6, 0xbd, 0x64, 0xa3, 0xe3, 0x6e, 0x93, 0x84, 0xd7, 0x45, 0xa1,
-	0xbd, 0xec, 0xc4, 0x74, 0x05, 0xaa, 0x1d, 0xb3, 0xea, 0xe5, 0x2d, 0xd1, 0x8e, 0x15, 0x06, 0x7a,
-	0x0d, 0x46, 0xdb, 0x4e, 0x1c, 0xdf, 0x0b, 0xa3, 0x06, 0x26, 0x9b, 0xc5, 0x54, 0x4e, 0xaa, 0x13,
-	0x37, 0x22, 0x09, 0x26, 0x9b, 0x22, 0x40, 0x45, 0xd3, 0xc7, 0x26, 0x33, 0xfb, 0x07, 0x2c, 0x38,
-	0x3b, 0x4f, 0x9c, 0x88, 0x44, 0xac, 0xd0, 0x92, 0x7a, 0x11, 0xf4, 0x2a, 0x54, 0x13, 0xda, 0x42,
-	0x7b, 0x64, 0x15, 0xdb, 0x23, 0x16, 0x5a, 0xb2, 0x2e, 0x88, 0x63, 0xc5, 0xc6, 0xfe, 0x82, 0x05,
-	0xe7, 0xf3, 0xfa, 0xb2, 0xe0, 0x87, 0x9d, 0xc6, 0xa3, 0xe8, 0xd0, 0xdf, 0xb1, 0x60, 0x8c, 0x1d,
-	0xd7, 0x2f, 0x92, 0xc4, 0xf1, 0xfc, 0x9e, 0xf2, 0x91, 0xd6, 0x80, 0xe5, 0x23, 0x2f, 0xc2, 0xd0,
-	0x56, 0xd8, 0x22, 0xd9, 0x50, 0x93, 0x6b, 0x61, 0x8b, 0x60, 0x06, 0x41, 0xef, 0xa4, 0x93, 0xd0,
-	0x0b, 0x12, 0x87, 0x2e, 0x47, 0x79, 0x9c, 0x71, 0x8a, 0x4f, 0x40, 0xd5, 0x8c, 0x4d, 0x1c, 0xfb,
-	0x5f, 0xd5, 0x60, 0x44, 0xc4, 0x45, 0x0d, 0x5c, 0xa7, 0x47, 0x7a, 0x71, 0x4a, 0x7d, 0xbd, 0x38,
-	0x31, 0x0c, 0xbb, 0xac, 0xc6, 0xaf, 0xb0, 0xd0, 0x6f, 0x14, 0x12, 0x48, 0xc7, 0xcb, 0x06, 0xeb,
-	0x6e, 0xf1, 0xff, 0x58, 0xb0, 0x42, 0xaf, 0x5b, 0x70, 0xca, 0x0d, 0x83, 0x80, 0xb8, 0xda, 0x76,
-	0x1c, 0x2a, 0x62, 0x83, 0xb0, 0x90, 0x26, 0xaa, 0x4f, 0x82, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x5e,
-	0x86, 0x71, 0x3e, 0x66, 0xb7, 0x53, 0x67, 0x30, 0xba, 0x50, 0xa0, 0x09, 0xc4, 0x69, 0x5c, 0x34,
-	0xc3, 0xcf, 0xb2, 0x44, 0x95, 0xbd, 0x61, 0xed, 0xaa, 0x36, 0xea, 0xeb, 0x19, 0x18, 0x28, 0x02,
-	0x14, 0x91, 0xcd, 0x88, 0xc4, 0x5b, 0x22, 0x6e, 0x8c, 0xd9, 0xad, 0x23, 0x0f, 0x57, 0x44, 0x03,
-	0xf7, 0x50, 0xc2, 0x39, 0xd4, 0xd1, 0xb6, 0x70, 0x23, 0x54, 0x8b, 0x90, 0xe7, 0xe2, 0x33, 0xf7,
-	0xf5, 0x26, 0x4c, 0x43, 0x85, 0xa9, 0x2e, 0x66, 0x2f, 0x97, 0x79, 0xe2, 0x26, 0x53, 0x6c, 0x98,
-	0xb7, 0xa3, 0x45, 0x38, 0x9d, 0xa9, 0x5c, 0x18, 0x8b, 0xb3, 0x12, 0x95, 0xa4, 0x97, 0xa9, 0x79,
-	0x18, 0xe3, 0x9e, 0x27, 0x4c, 0x17, 0xd3, 0xe8, 0x01, 0x2e, 0xa6, 0xae, 0x8a, 0x4e, 0xe6, 0xa7,
-	0x18, 0xef, 0x2b, 0x64, 0x00, 0x06, 0x0a, 0x45, 0xfe, 0xa1, 0x4c, 0x28, 0xf2, 0x38, 0xeb, 0xc0,
-	0xed, 0x62, 0x3a, 0x70, 0xf8, 0xb8, 0xe3, 0x47, 0x19, 0x47, 0xfc, 0xbf, 0x2c, 0x90, 0xdf, 0x75,
-	0xc1, 0x71, 0xb7, 0x08, 0x9d, 0x32, 0x39, 0xd9, 0x1f, 0xd6, 0x61, 0xb2, 0x3f, 0xd0, 0x2c, 0xd4,
-	0xe8, 0x38, 0xf1, 0x47, 0xb9, 0xde, 0x57, 0x1e, 0x90, 0xb9, 0xb5, 0x25, 0xf1, 0x94, 0xc6, 0x41,
-	0x21, 0x4c, 0xfa, 0x4e, 0x9c, 0xb0, 0x1e, 0xd4, 0xbb, 0x81, 0xfb, 0x90, 0x25, 0x6c, 0x58, 0x26,
-	0xd8, 0x72, 0x96, 0x10, 0xee, 0xa5, 0x6d, 0xff, 0xbb, 0x0a, 0x8c, 0xa7, 0x24, 0xe3, 0x21, 0x0d,
-	0x86, 0x17, 0xa0, 0x2a, 0x75, 0x78, 0xb6, 0x90, 0x97, 0x52, 0xf4, 0x0a, 0x83, 0x2a, 0xad, 0x0d,
-	0xad, 0x55, 0xb3, 0x06, 0x8e, 0xa1, 0x70, 0xb1, 0x89, 0xc7, 0x84, 0x72, 0xe2, 0xc7, 0x0b, 0xbe,
-	0x47, 0x82, 0x84, 0x77, 0xb3, 0x18, 0xa1, 0xbc, 0xbe, 0x5c, 0x37, 0x89, 0x6a, 0xa1, 0x9c, 0x01,
-	0xe0, 0x2c, 0x7b, 0xf4, 0x69, 0x0b, 0xc6, 0x9d, 0x7b, 0xb1, 0x2e, 0x44, 0x2f, 0x82, 0x8e, 0x8f,
-	0xa8, 0xa4, 0x52, 0xb5, 0xed, 0xb9, 0x63, 0x3f, 0xd5, 0x84, 0xd3, 0x4c, 0xd1, 0x1b, 0x16, 0x20,
-	0xb2, 0x4b, 0x5c, 0x19, 0x16, 0x2d, 0xfa, 0x32, 0x5c, 0xc4, 0x0e, 0xfe, 0x72, 0x0f, 0x5d, 0x2e,
-	0xd5, 0x7b, 0xdb, 0x71, 0x4e, 0x1f, 0xd0, 0x75, 0x40, 0x0d, 0x2f, 0x76, 0x36, 0x7c, 0xb2, 0x10,
-	0xb6, 0x64, 0xf6, 0xb2, 0x38, 0x4f, 0xbf, 0x20, 0xc6, 0x19, 0x2d, 0xf6, 0x60, 0xe0, 0x9c, 0xa7,
-	0xd8, 0x2c, 0x8b, 0xc2, 0xdd, 0xee, 0xad, 0xc8, 0x67, 0x5a, 0xc2, 0x9c, 0x65, 0xa2, 0x1d, 0x2b,
-	0x0c, 0xfb, 0xcf, 0xcb, 0x6a, 0x29, 0xeb, 0x1c, 0x00, 0xc7, 0x88, 0x45, 0xb6, 0x1e, 0x3e, 0x16,
-	0x59, 0x47, 0x4a, 0xf5, 0xe6, 0xe4, 0xa7, 0x52, 0x78, 0x4b, 0x8f, 0x28, 0x85, 0xf7, 0x7b, 0xad,
-	0x54, 0xb1, 0xbc, 0xd1, 0x4b, 0x1f, 0x28, 0x36, 0xff, 0x60, 0x86, 0x47, 0x71, 0x65, 0xf4, 0x4a,
-	0x26, 0x78, 0xef, 0x05, 0xa8, 0x6e, 0xfa, 0x0e, 0xab, 0xe2, 0xc2, 0x16, 0xaa, 0x11, 0x61, 0x76,
-	0x45, 0xb4, 0x63, 0x85, 0x41, 0xa5, 0xbe, 0x41, 0xf4, 0x50, 0x52, 0xfb, 0x3f, 0x96, 0x61, 0xd4,
-	0xd0, 0xf8, 0xb9, 0xe6, 0x9b, 0xf5, 0x98, 0x99, 0x6f, 0xa5, 0x43, 0x98, 0x6f, 0xdf, 0x03, 0x35,
-	0x57, 0x6a, 0xa3, 0x62, 0xae, 0x15, 0xc8, 0xea, 0x38, 0xad, 0x90, 0x54, 0x13, 0xd6, 0x3c, 0xd1,
-	0xd5, 0x54, 0x9a, 0x68, 0xca, 0x2f, 0x90, 0x97, 0xc7, 0x29, 0x34, 0x5a, 0xef, 0x33, 0xd9, 0xf8,
-	0x80, 0xca, 0xc1, 0xf1, 0x01, 0xf6, 0x1f, 0x5a, 0xea, 0xe3, 0x9e, 0x40, 0x3d, 0xa0, 0xbb, 0xe9,
-	0x7a, 0x40, 0x97, 0x0b, 0x19, 0xe6, 0x3e, 0x85, 0x80, 0x7e, 0xc0, 0x82, 0x67, 0xf7, 0x2f, 0xb0,
-	0x8d, 0x9e, 0x83, 0x4a, 0x33, 0x0a, 0x3b, 0x6d, 0xa1, 0x83, 0x15, 0x1d, 0x56, 0xcd, 0x1c, 0x73,
-	0x18, 0xdd, 0x44, 0x6d, 0x7b, 0x41, 0x23, 0xbb, 0x89, 0xba, 0xe1, 0x05, 0x0d, 0xcc, 0x20, 0x03,
-	0x54, 0x60, 0xbd, 0x09, 0x23, 0x0b, 0x61, 0xab, 0xe5, 0x04, 0x0d, 0xf4, 0x0d, 0x30, 0xe2, 0xf2,
-	0x9f, 0xc2, 0x9f, 0xc7, 0x0e, 0xce, 0x05, 0x14, 0x4b, 0x18, 0x7a, 0x1a, 0x86, 0x9c, 0xa8, 0x29,
-	0x7d, 0x78, 0x2c, 0x20, 0x6f, 0x2e, 0x6a, 0xc6, 0x98, 0xb5, 0xda, 0x7f, 0x69, 0xc1, 0x04, 0x7d,
-	0xc4, 0x63, 0x03, 0xcc, 0x86, 0xf6, 0x79, 0x18, 0x76, 0x3a, 0xc9, 0x56, 0xd8, 0xb3, 0x27, 0x9c,
-	0x63, 0xad, 0x58, 0x40, 0x69, 0x67, 0x55, 0x51, 0x0b, 0xa3, 0xb3, 0x8b, 0x74, 0x5d, 0x31, 0x08,
-	0x35, 0xab, 0xe3, 0xce, 0x46, 0xde, 0xc9, 0x6d, 0x9d, 0x37, 0x63, 0x09, 0xa7, 0xc4, 0x36, 0xc2,
-	0x46, 0x57, 0x84, 0x19, 0x2b, 0x62, 0xf3, 0x61, 0xa3, 0x8b, 0x19, 0x04, 0x3d, 0x03, 0xe5, 0x78,
-	0xcb, 0x91, 0x31, 0x02, 0x32, 0xe2, 0xbd, 0x7e, 0x6d, 0x0e, 0xd3, 0x76, 0x95, 0xc0, 0x11, 0xf9,
-	0xd9, 0x78, 0xdf, 0x74, 0x02, 0x47, 0xe4, 0xdb, 0xff, 0x7c, 0x08, 0x58, 0xec, 0x8f, 0x13, 0x91,
-	0xc6, 0x7a, 0xc8, 0x6a, 0x26, 0x1f, 0xeb, 0x11, 0xbb, 0xde, 0x54, 0x3f, 0xce, 0xc7, 0xec, 0xc6,
-	0x51, 0x6b, 0xf9, 0xa4, 0x8f, 0x5a, 0xf3, 0x4f, 0xcf, 0x87, 0x1e, 0xa3, 0xd3, 0x73, 0xfb, 0xf3,
-	0x16, 0x20, 0x15, 0xc9, 0xa5, 0xc3, 0x5b, 0x66, 0xa1, 0xa6, 0x42, 0xc7, 0xc4, 0x7a, 0xd1, 0x22,
-	0x5a, 0x02, 0xb0, 0xc6, 0x19, 0xc0, 0x93, 0xf2, 0x9c, 0xd4, 0x9f, 0xe5, 0xb4, 0x2c, 0x61, 0x5a,
-	0x57, 0xa8, 0x53, 0xfb, 0x57, 0x4b, 0xf0, 0x04, 0x37, 0xdd, 0x56, 0x9c, 0xc0, 0x69, 0x92, 0x16,
-	0xed, 0xd5, 0xa0, 0x01, 0x4b, 0x2e, 0xdd, 0xc2, 0x7b, 0x32, 0x5b, 0xe3, 0xa8, 0xb2, 0x93, 0xcb,
-	0x19, 0x2e, 0x59, 0x96, 0x02, 0x2f, 0xc1, 0x8c, 0x38, 0x8a, 0xa1, 0x2a, 0xef, 0x83, 0x12, 0xba,
-	0xb0, 0x20, 0x46, 0x4a, 0x2d, 0x08, 0x2b, 0x87, 0x60, 0xc5, 0x88, 0x9a, 0x32, 0x7e, 0xe8, 0x6e,
-	0xd3, 0x25, 0x9f, 0x35, 0x65, 0x96, 0x45, 0x3b, 0x56, 0x18, 0x76, 0x0b, 0x4e, 0xc9, 0x31, 0x6c,
-	0xdf, 0x20, 0x5d, 0x4c, 0x36, 0xa9, 0xfe, 0x77, 0x65, 0x93, 0x71, 0x45, 0x95, 0xd2, 0xff, 0x0b,
-	0x26, 0x10, 0xa7, 0x71, 0x65, 0x19, 0xe5, 0x52, 0x7e, 0x19, 0x65, 0xfb, 0x57, 0x2d, 0xc8, 0x1a,
-	0x20, 0xcc, 0x01, 0x67, 0xde, 0x37, 0xd5, 0xaf, 0xbe, 0xfa, 0x21, 0x2a, 0xab, 0x7e, 0x08, 0x46,
-	0x9d, 0x84, 0x5a, 0x98, 0xdc, 0x1b, 0x54, 0x7e, 0xb8, 0x53, 0xcc, 0x95, 0xb0, 0xe1, 0x6d, 0x7a,
-	0xcc, 0x0b, 0x64, 0x92, 0xb3, 0x7f, 0xb4, 0x02, 0xb5, 0xc5, 0xa8, 0x7b, 0xf8, 0xb4, 0xb9, 0xde,
-	0xa4, 0xb8, 0xd2, 0xa1, 0x92, 0xe2, 0x64, 0xda, 0x5d, 0xb9, 0x6f, 0xda, 0x9d, 0x4c, 0x9b, 0x1b,
-	0x7a, 0x54, 0x69, 0x73, 0x95, 0xc7, 0x24, 0x6d, 0x6e, 0xf8, 0x31, 0x48, 0x9b, 0x1b, 0x39, 0xe1,
-	0xb4, 0x39, 0xfb, 0x7f, 0x0c, 0xc1, 0x64, 0x4f, 0x16, 0x30, 0x7a, 0x09, 0xc6, 0xd4, 0x1a, 0x95,
-	0x07, 0x00, 0x35, 0x33, 0x8c, 0x5e, 0xc3, 0x70, 0x0a, 0x73, 0x00, 0x41, 0xbd, 0x04, 0x67, 0x22,
-	0xf2, 0x6a, 0x87, 0x74, 0xc8, 0xdc, 0x66, 0x42, 0xa2, 0x3a, 0x71, 0xc3, 0xa0, 0xc1, 0x6b, 0x6e,
-	0x97, 0xe7, 0x9f, 0xbc, 0xbf, 0x37, 0x7d, 0x06, 0xf7, 0x82, 0x71, 0xde, 0x33, 0xa8, 0x0d, 0xe3,
-	0xbe, 0xb9, 0x73, 0x15, 0x73, 0xf8, 0xa1, 0x36, 0xbd, 0x4a, 0x56, 0xa5, 0x9a, 0x71, 0x9a, 0x41,
-	0x7a, 0xfb, 0x5b, 0x79, 0x44, 0xdb, 0xdf, 0x4f, 0xe9, 0xed, 0x2f, 0x8f, 0x4a, 0xfb, 0x60, 0xc1,
-	0x59, 0xe0, 0x83, 0xec, 0x7f, 0x8f, 0xb2, 0xa3, 0x7d, 0x1f, 0x54, 0x65, 0xc4, 0xee, 0x40, 0x91,
-	0xae, 0x26, 0x9d, 0x3e, 0x9a, 0xfd, 0x41, 0x09, 0x72, 0x9c, 0x36, 0x54, 0xd2, 0x6a, 0x6b, 0x3f,
-	0x25, 0x69, 0x0f, 0x67, 0xf1, 0xa3, 0x5d, 0x1e, 0xad, 0xcc, 0x6d, 0xbc, 0xf7, 0x17, 0xed, 0x74,
-	0xd2, 0x01, 0xcc, 0x4a, 0xff, 0xa9, 0x20, 0xe6, 0x4b, 0x00, 0x7a, 0xc3, 0x28, 0x2c, 0x7d, 0x15,
-	0x7e, 0xa4, 0xf7, 0x95, 0xd8, 0xc0, 0x42, 0x2f, 0xc2, 0xa8, 0x17, 0xc4, 0x89, 0xe3, 0xfb, 0xd7,
-	0xbc, 0x20, 0x11, 0xd6, 0xbf, 0x32, 0x66, 0x97, 0x34, 0x08, 0x9b, 0x78, 0x17, 0xde, 0x63, 0x7c,
-	0x97, 0xc3, 0x7c, 0xcf, 0x2d, 0x38, 0x7f, 0xd5, 0x4b, 0x94, 0x68, 0x53, 0xf3, 0x88, 0x6d, 0xf2,
-	0xa4, 0x06, 0xb2, 0xfa, 0x6a, 0x20, 0x23, 0x0d, 0xb5, 0x94, 0xce, 0x9a, 0xcd, 0xa6, 0xa1, 0xda,
-	0x2e, 0x9c, 0xbd, 0xea, 0x25, 0x57, 0x3c, 0x9f, 0x1c, 0x23, 0x93, 0x5f, 0x19, 0x86, 0x31, 0xb3,
-	0x3a, 0xc4, 0x61, 0xf4, 0xf5, 0x17, 0xe8, 0xee, 0x44, 0x0c, 0x84, 0xa7, 0x42, 0x2a, 0xee, 0x1c,
-	0xb9, 0x54, 0x45, 0xfe, 0xe0, 0x1a, 0x1b, 0x14, 0xcd, 0x13, 0x9b, 0x1d, 0x40, 0xf7, 0xa0, 0xb2,
-	0xc9, 0x32, 0x2a, 0xcb, 0x45, 0x04, 0xc3, 0xe5, 0x0d, 0xbe, 0x5e, 0x91, 0x3c, 0x27, 0x93, 0xf3,
-	0xa3, 0x46, 0x65, 0x94, 0x4e, 0xe4, 0x37, 0xf2, 0x5c, 0x84, 0xb5, 0xa2, 0x30, 0xfa, 0x69, 0x85,
-	0xca, 0x43, 0x68, 0x85, 0x94, 0x8c, 0x1e, 0x7e, 0x44, 0x32, 0x9a, 0x65, 0xc7, 0x26, 0x5b, 0x6c,
-	0xcb, 0x23, 0x12, 0xf3, 0x46, 0xd8, 0x20, 0x18, 0xd9, 0xb1, 0x29, 0x30, 0xce, 0xe2, 0xa3, 0x8f,
-	0x2b, 0x29, 0x5f, 0x2d, 0xe2, 0xc8, 0xca, 0x9c, 0xd1, 0xc7, 0x2d, 0xe0, 0x3f, 0x5f, 0x82, 0x89,
-	0xab, 0x41, 0x67, 0xed, 0xea, 0x5a, 0x67, 0xc3, 0xf7, 0xdc, 0x1b, 0xa4, 0x4b, 0xa5, 0xf8, 0x36,
-	0xe9, 0x2e, 0x2d, 0x66, 0x7d, 0x3d, 0x37, 0x68, 0x23, 0xe6, 0x30, 0x2a, 0xb7, 0x36, 0xbd, 0xa0,
-	0x49, 0xa2, 0x76, 0xe4, 0x89, 0xd3, 0x24, 0x43, 0x6e, 0x5d, 0xd1, 0x20, 0x6c, 0xe2, 0x51, 0xda,
-	0xe1, 0xbd, 0x40, 0x95, 0xea, 0x52, 0xb4, 0x57, 0x69, 0x23, 0xe6, 0x30, 0x8a, 0x94, 0x44, 0x1d,
-	0xe1, 0xac, 0x35, 0x90, 0xd6, 0x69, 0x23, 0xe6, 0x30, 0xe1, 0x7b, 0x61, 0xb1, 0x86, 0x95, 0x1e,
-	0xdf, 0x0b, 0x0b, 0xd3, 0x91, 0x70, 0x8a, 0xba, 0x4d, 0xba, 0x8b, 0x4e, 0xe2, 0x64, 0x5d, 0x27,
-	0x37, 0x78, 0x33, 0x96, 0x70, 0x56, 0xfb, 0x3b, 0x3d, 0x1c, 0x5f, 0x73, 0xb5, 0xbf, 0xd3, 0xdd,
-	0xef, 0xe3, 0xf2, 0xfb, 0xd1, 0x12, 0x8c, 0xbd, 0x79, 0x2f, 0x70, 0xce, 0xbd, 0x54, 0x77, 0x60,
-	0xb2, 0x27, 0x27, 0x7f, 0x00, 0xcb, 0xe7, 0xc0, 0x9a, 0x29, 0x36, 0x86, 0x51, 0x4a, 0x58, 0xd6,
-	0xbc, 0x5c, 0x80, 0x49, 0xbe, 0x78, 0x29, 0x27, 0x96, 0x62, 0xad, 0xea, 0x2c, 0xb0, 0xe3, 0xd2,
-	0xdb, 0x59, 0x20, 0xee, 0xc5, 0xb7, 0x7f, 0xc8, 0x82, 0xf1, 0x54, 0x99, 0x84, 0x82, 0x6c, 0x34,
-	0xb6, 0xba, 0x43, 0x16, 0x27, 0xcf, 0xf2, 0x96, 0xca, 0x4c, 0x0d, 0xeb, 0xd5, 0xad, 0x41, 0xd8,
-	0xc4, 0xb3, 0x7f, 0xab, 0x0c, 0x55, 0x19, 0xd3, 0x37, 0x40, 0x57, 0x3e, 0x67, 0xc1, 0xb8, 0x3a,
-	0xa2, 0x66, 0x67, 0x0a, 0xa5, 0x22, 0xb2, 0x36, 0x69, 0x0f, 0x94, 0x57, 0x2c, 0xd8, 0x0c, 0xf5,
-	0x86, 0x01, 0x9b, 0xcc, 0x70, 0x9a, 0x37, 0xba, 0x0d, 0x10, 0x77, 0xe3, 0x84, 0xb4, 0x8c, 0xd3,
-	0x0d, 0xdb, 0x98, 0x65, 0x33, 0x6e, 0x18, 0x11, 0x3a, 0xa7, 0x6e, 0x86, 0x0d, 0x52, 0x57, 0x98,
-	0xda, 0xc2, 0xd3, 0x6d, 0xd8, 0xa0, 0x84, 0x5e, 0x53, 0x01, 0x15, 0x43, 0x45, 0xe8, 0x75, 0x39,
-	0xbe, 0x83, 0x44, 0x54, 0x1c, 0x21, 0x82, 0xc1, 0xfe, 0xd9, 0x12, 0x9c, 0xce, 0x8e, 0x24, 0xfa,
-	0x20, 0x8c, 0xc9, 0x41, 0x33, 0x9c, 0x47, 0x32, 0x90, 0x72, 0x0c, 0x1b, 0xb0, 0x07, 0x7b, 0xd3,
-	0xd3, 0xbd, 0x17, 0xcc, 0xcf, 0x98, 0x28, 0x38, 0x45, 0x8c, 0x87, 0x37, 0x88, 0x38, 0x9c, 0xf9,
-	0xee, 0x5c, 0xbb, 0x2d, 0x62, 0x14, 0x8c, 0xf0, 0x06, 0x13, 0x8a, 0x33, 0xd8, 0x68, 0x0d, 0xce,
-	0x1a, 0x2d, 0x37, 0x89, 0xd7, 0xdc, 0xda, 0x08, 0x23, 0xb9, 0x5f, 0x7d, 0x5a, 0x87, 0x6d, 0xf7,
-	0xe2, 0xe0, 0xdc, 0x27, 0xa9, 0x61, 0xe4, 0x3a, 0x6d, 0xc7, 0xf5, 0x92, 0xae, 0x38, 0x65, 0x52,
-	0x62, 0x7c, 0x41, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x83, 0x21, 0x38, 0xcd, 0xe3, 0x94, 0x89, 0x0a,
-	0xc3, 0x47, 0x1f, 0x84, 0x5a, 0x9c, 0x38, 0x11, 0x77, 0x55, 0x59, 0x87, 0x16, 0x5d, 0xba, 0xb6,
-	0x83, 0x24, 0x82, 0x35, 0x3d, 0xf4, 0x01, 0x56, 0x18, 0xcf, 0x8b, 0xb7, 0x18, 0xf5, 0xd2, 0xc3,
-	0x39, 0xc2, 0xae, 0x28, 0x0a, 0xd8, 0xa0, 0x86, 0xbe, 0x15, 0x2a, 0xed, 0x2d, 0x27, 0x96, 0x5e,
-	0xda, 0xe7, 0xa5, 0x9c, 0x58, 0xa3, 0x8d, 0x0f, 0xf6, 0xa6, 0xcf, 0x65, 0x5f, 0x95, 0x01, 0x30,
-	0x7f, 0xc8, 0x94, 0xf2, 0x43, 0x07, 0x48, 0xf9, 0xe7, 0x61, 0xb8, 0x11, 0x75, 0xeb, 0xd7, 0xe6,
-	0xb2, 0x77, 0x0d, 0x2d, 0xb2, 0x56, 0x2c, 0xa0, 0x54, 0x26, 0x6d, 0x71, 0x96, 0x0d, 0x8a, 0x3c,
-	0x9c, 0xb6, 0x38, 0xae, 0x69, 0x10, 0x36, 0xf1, 0xd0, 0xe7, 0x7b, 0xa3, 0xd8, 0x47, 0x8e, 0x21,
-	0xcb, 0x69, 0xd0, 0xf8, 0xf5, 0xcb, 0x50, 0x13, 0x5d, 0x5d, 0x0f, 0xd1, 0x4b, 0x30, 0xc6, 0x9d,
-	0x80, 0xf3, 0x91, 0x13, 0xb8, 0x5b, 0x59, 0xe7, 0xcd, 0xba, 0x01, 0xc3, 0x29, 0x4c, 0x7b, 0x05,
-	0x86, 0x06, 0x14, 0xb2, 0x03, 0xed, 0xc9, 0xdf, 0x07, 0x55, 0x4a, 0x4e, 0x6e, 0xd0, 0x8a, 0x20,
-	0x19, 0x42, 0x55, 0x5e, 0x52, 0x8a, 0x6c, 0x28, 0x7b, 0x8e, 0x8c, 0x56, 0x52, 0x4b, 0x68, 0x29,
-	0x8e, 0x3b, 0x6c, 0xda, 0x51, 0x20, 0x7a, 0x0e, 0xca, 0x64, 0xb7, 0x9d, 0x0d, 0x4b, 0xba, 0xbc,
-	0xdb, 0xf6, 0x22, 0x12, 0x53, 0x24, 0xb2, 0xdb, 0x46, 0x17, 0xa0, 0xe4, 0x35, 0xc4, 0x8c, 0x04,
-	0x81, 0x53, 0x5a, 0x5a, 0xc4, 0x25, 0xaf, 0x61, 0xef, 0x42, 0x4d, 0xdd, 0x8a, 0x8a, 0xb6, 0xa5,
-	0x49, 0x65, 0x15, 0x11, 0xa7, 0x2e, 0xe9, 0xf6, 0x31, 0xa6, 0x3a, 0x00, 0xba, 0x68, 0x48, 0x51,
-	0x2a, 0xf8, 0x22, 0x0c, 0xb9, 0xa1, 0x28, 0xf7, 0x54, 0xd5, 0x64, 0x98, 0x2d, 0xc5, 0x20, 0xf6,
-	0x1d, 0x98, 0xb8, 0x11, 0x84, 0xf7, 0xd8, 0xfd, 0x64, 0xac, 0x1c, 0x37, 0x25, 0xbc, 0x49, 0x7f,
-	0x64, 0x2d, 0x77, 0x06, 0xc5, 0x1c, 0xa6, 0x0a, 0xfd, 0x96, 0xfa, 0x15, 0xfa, 0xb5, 0x3f, 0x61,
-	0xc1, 0x98, 0xf2, 0xc2, 0x5e, 0xdd, 0xd9, 0x1e, 0xec, 0xf4, 0xd7, 0x28, 0xcb, 0x51, 0x3a, 0xa0,
-	0x2c, 0x87, 0x3c, 0x28, 0x2e, 0xf7, 0x3b, 0x28, 0xb6, 0xff, 0xca, 0x82, 0xd3, 0xaa, 0x0b, 0xd2,
-	0x66, 0x7a, 0x09, 0xc6, 0x36, 0x3a, 0x9e, 0xdf, 0x90, 0x75, 0xc6, 0x33, 0xcb, 0x65, 0xde, 0x80,
-	0xe1, 0x14, 0x26, 0xba, 0x04, 0xb0, 0xe1, 0x05, 0x4e, 0xd4, 0x5d, 0xd3, 0x46, 0x9a, 0xd2, 0xdb,
-	0xf3, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x31, 0xa8, 0xee, 0xc8, 0xf8, 0x80, 0x72, 0xa1, 0xd5, 0x24,
-	0xc4, 0x78, 0xe8, 0x95, 0xa0, 0x02, 0x0e, 0x14, 0x47, 0xfb, 0x8b, 0x65, 0x98, 0x48, 0x57, 0x80,
-	0x18, 0xc0, 0x73, 0xf2, 0x1c, 0x54, 0x58, 0x51, 0x88, 0xec, 0xc4, 0xe2, 0x85, 0xc1, 0x39, 0x0c,
-	0xc5, 0x30, 0xcc, 0x45, 0x49, 0x31, 0x57, 0xe8, 0xaa, 0x4e, 0x2a, 0xff, 0x2c, 0x73, 0x5e, 0x8b,
-	0xc3, 0x0e, 0xc1, 0x0a, 0x7d, 0xda, 0x82, 0x91, 0xb0, 0x6d, 0x56, 0x98, 0x7d, 0x7f, 0x91, 0xd5,
-	0x31, 0x44, 0x0a, 0xba, 0xb0, 0x86, 0xd4, 0xc4, 0x93, 0x93, 0x41, 0xb2, 0xbe, 0xf0, 0x2d, 0x30,
-	0x66, 0x62, 0x1e, 0x64, 0x10, 0x55, 0x4d, 0x83, 0xe8, 0x73, 0xe6, 0x94, 0x14, 0xf5, 0x3f, 0x06,
-	0x58, 0xec, 0xb7, 0xa0, 0xe2, 0xaa, 0x80, 0xcb, 0x87, 0xba, 0x1b, 0x43, 0xd5, 0xc7, 0x63, 0xc1,
-	0x2c, 0x9c, 0x9a, 0xfd, 0x87, 0x96, 0x31, 0x3f, 0x30, 0x89, 0x97, 0x1a, 0x28, 0x82, 0x72, 0x73,
-	0x67, 0x5b, 0x18, 0x19, 0xd7, 0x0b, 0x1a, 0xde, 0xab, 0x3b, 0xdb, 0x7a, 0x85, 0x99, 0xad, 0x98,
-	0x32, 0x1b, 0xe0, 0x10, 0x21, 0x55, 0x26, 0xa6, 0x7c, 0x70, 0x99, 0x18, 0xfb, 0x8d, 0x12, 0x4c,
-	0xf6, 0x4c, 0x2a, 0xf4, 0x1a, 0x54, 0x22, 0xfa, 0x96, 0xe2, 0xf5, 0x96, 0x0b, 0x2b, 0xec, 0x12,
-	0x2f, 0x35, 0xb4, 0xf2, 0x4e, 0xb7, 0x63, 0xce, 0x12, 0x5d, 0x07, 0xa4, 0xc3, 0x82, 0xd5, 0x09,
-	0x06, 0x7f, 0x65, 0x15, 0x3b, 0x38, 0xd7, 0x83, 0x81, 0x73, 0x9e, 0x42, 0x2f, 0x67, 0x0f, 0x42,
-	0x32, 0x35, 0xcb, 0xf7, 0x3b, 0xd3, 0xb0, 0x5f, 0x37, 0xa7, 0xe0, 0x6d, 0x2d, 0x4c, 0x8f, 0xba,
-	0x39, 0xed, 0x91, 0xac, 0xe5, 0x41, 0x25, 0xab, 0xfd, 0x4b, 0x25, 0x18, 0x4f, 0xd5, 0x20, 0x46,
-	0x3e, 0x54, 0x89, 0xcf, 0xce, 0xeb, 0xa5, 0xf6, 0x3d, 0xea, 0x75, 0x46, 0x4a, 0x4e, 0x5e, 0x16,
-	0x74, 0xb1, 0xe2, 0xf0, 0x78, 0x44, 0x39, 0xbe, 0x04, 0x63, 0xb2, 0x43, 0xef, 0x77, 0x5a, 0x7e,
-	0x76, 0xf8, 0x2e, 0x1b, 0x30, 0x9c, 0xc2, 0xb4, 0x7f, 0xad, 0x0c, 0x53, 0x3c, 0xc0, 0xa1, 0xa1,
-	0x16, 0x83, 0x0a, 0x54, 0xfa, 0x41, 0x5d, 0x29, 0xdc, 0x2a, 0xe2, 0x42, 0xff, 0x7e, 0x8c, 0x06,
-	0x0a, 0xce, 0xff, 0x89, 0x4c, 0x70, 0x3e, 0xdf, 0xaa, 0x37, 0x8f, 0xa9, 0x47, 0x5f, 0x5b, 0xd1,
-	0xfa, 0xff, 0xa4, 0x04, 0xa7, 0x32, 0x57, 0x33, 0xa2, 0x2f, 0xa6, 0x6f, 0xf3, 0xb1, 0x8a, 0x38,
-	0xfe, 0xdb, 0xf7, 0xb6, 0xbe, 0xc3, 0xdd, 0xe9, 0xf3, 0x88, 0x96, 0x8a, 0xfd, 0xfb, 0x25, 0x98,
-	0x48, 0xdf, 0x29, 0xf9, 0x18, 0x8e, 0xd4, 0xdb, 0xa1, 0xc6, 0xae, 0x4d, 0xbb, 0x41, 0xba, 0xf2,
-	0x94, 0x91, 0xdf, 0x50, 0x25, 0x1b, 0xb1, 0x86, 0x3f, 0x16, 0x57, 0x25, 0xd9, 0xff, 0xd4, 0x82,
-	0x73, 0xfc, 0x2d, 0xb3, 0xf3, 0xf0, 0x6f, 0xe5, 0x8d, 0xee, 0x2b, 0xc5, 0x76, 0x30, 0x53, 0xe1,
-	0xfe, 0xa0, 0xf1, 0xa5, 0xc6, 0xcb, 0x59, 0xd1, 0xdb, 0xf4, 0x54, 0x78, 0x0c, 0x3b, 0x7b, 0xa8,
-	0xc9, 0x60, 0xff, 0xfb, 0x12, 0x8c, 0xae, 0x2e, 0x2c, 0x29, 0x11, 0x3e, 0x0b, 0x35, 0x37, 0x22,
-	0x8e, 0x76, 0xff, 0x98, 0xe1, 0x73, 0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0xf0, 0xd3, 0x38,
-	0xbb, 0x8b, 0xe2, 0xd1, 0xa9, 0x31, 0x96, 0x70, 0xf4, 0x02, 0x54, 0x59, 0x92, 0xfa, 0xad, 0x48,
-	0x6a, 0x1c, 0xbd, 0xb5, 0x66, 0xed, 0x78, 0x19, 0x2b, 0x0c, 0x4a, 0xb8, 0x11, 0xba, 0x31, 0x45,
-	0xce, 0x78, 0x64, 0x16, 0x69, 0x33, 0x5e, 0xc6, 0x12, 0xce, 0x6a, 0x8c, 0x32, 0xaf, 0x05, 0x45,
-	0xae, 0xa4, 0x3b, 0xcd, 0xdd, 0x1b, 0x14, 0x5d, 0xe3, 0x1c, 0xa6, 0x16, 0x6d, 0x26, 0x51, 0x74,
-	0x64, 0xb0, 0x44, 0x51, 0xfb, 0xf7, 0xcb, 0x50, 0xd3, 0x4e, 0x35, 0x4f, 0x54, 0x66, 0x29, 0xe4,
-	0x06, 0x85, 0x7a, 0x37, 0x70, 0x15, 0x69, 0x1e, 0x4d, 0x60, 0x14, 0x66, 0xf9, 0x7e, 0x0b, 0x46,
-	0xbd, 0xc0, 0x4b, 0x3c, 0x87, 0xf9, 0x06, 0x8b, 0xb9, 0xe6, 0x5e, 0xb1, 0x5b, 0xe2, 0x94, 0xc3,
-	0xc8, 0x3c, 0xf2, 0x57, 0xcc, 0xb0, 0xc9, 0x19, 0x7d, 0x54, 0xe4, 0x25, 0x96, 0x0b, 0x2b, 0x6f,
-	0x54, 0xcd, 0x24, 0x23, 0xb6, 0xa9, 0x8d, 0x9d, 0x44, 0x05, 0x55, 0x05, 0xc3, 0x94, 0x94, 0xba,
-	0xc9, 0x47, 0xed, 0x62, 0x58, 0x33, 0xe6, 0x8c, 0xec, 0x18, 0x50, 0xef, 0x58, 0x1c, 0x32, 0xe7,
-	0x6b, 0x16, 0x6a, 0x4e, 0x27, 0x09, 0x5b, 0x74, 0x98, 0x44, 0xc0, 0x80, 0xce, 0x6a, 0x93, 0x00,
-	0xac, 0x71, 0xec, 0x2f, 0x56, 0x20, 0x53, 0x27, 0x05, 0xed, 0x42, 0x4d, 0x55, 0x4a, 0x29, 0x26,
-	0x87, 0x5a, 0xcf, 0x28, 0xd5, 0x19, 0xd5, 0x84, 0x35, 0x33, 0xd4, 0x94, 0x6e, 0x56, 0xbe, 0xda,
-	0xdf, 0x97, 0x75, 0xb3, 0x7e, 0xc7, 0x60, 0xa7, 0x6e, 0x74, 0xae, 0xce, 0xf2, 0xca, 0x98, 0x33,
-	0x07, 0x7a, 0x64, 0x0f, 0xba, 0xe8, 0xff, 0x93, 0xe2, 0xde, 0x3d, 0x4c, 0xe2, 0x8e, 0x9f, 0x88,
-	0xd9, 0xf0, 0xbe, 0x02, 0x57, 0x19, 0x27, 0xac, 0xeb, 0x8d, 0xf1, 0xff, 0xd8, 0x60, 0x9a, 0xf6,
-	0x9b, 0x0f, 0x1f, 0xab, 0xdf, 0x7c, 0xa4, 0x50, 0xbf, 0xf9, 0x25, 0x00, 0x36, 0xb7, 0x79, 0x6e,
-	0x4a, 0x95, 0xb9, 0x33, 0x95, 0x8a, 0xc1, 0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x9b, 0x20, 0x5d, 0x30,
-	0x0f, 0x4d, 0xcb, 0xfa, 0x7c, 0xfc, 0x44, 0x90, 0xa5, 0x05, 0xa7, 0x4a, 0xe9, 0xfd, 0x82, 0x05,
-	0x66, 0x55, 0x3f, 0xf4, 0x2a, 0x2f, 0x1f, 0x68, 0x15, 0x71, 0xc2, 0x64, 0xd0, 0x9d, 0x59, 0x71,
-	0xda, 0x99, 0x68, 0x27, 0x59, 0x43, 0xf0, 0xc2, 0x7b, 0xa0, 0x2a, 0xa1, 0x87, 0x32, 0x96, 0x3f,
-	0x0e, 0x67, 0x64, 0x89, 0x11, 0x79, 0x18, 0x24, 0xa2, 0x0e, 0x4e, 0x26, 0xc3, 0xe4, 0x17, 0x2d,
-	0xb8, 0x98, 0xed, 0x40, 0xbc, 0x12, 0x06, 0x5e, 0x12, 0x46, 0x75, 0x92, 0x24, 0x5e, 0xd0, 0x64,
-	0x55, 0x9e, 0xef, 0x39, 0x91, 0xbc, 0xe9, 0x8b, 0x09, 0xca, 0x3b, 0x4e, 0x14, 0x60, 0xd6, 0x8a,
-	0xba, 0x30, 0xcc, 0x03, 0xe8, 0xc5, 0x2e, 0xe8, 0x88, 0x6b, 0x23, 0x67, 0x38, 0xf4, 0x36, 0x8c,
-	0x07, 0xef, 0x63, 0xc1, 0xd0, 0xfe, 0x8a, 0x05, 0x68, 0x75, 0x87, 0x44, 0x91, 0xd7, 0x30, 0x42,
-	0xfe, 0xd9, 0xfd, 0xb5, 0xc6, 0x3d, 0xb5, 0x66, 0x01, 0x9c, 0xcc, 0xfd, 0xb5, 0xc6, 0xbf, 0xfc,
-	0xfb, 0x6b, 0x4b, 0x87, 0xbb, 0xbf, 0x16, 0xad, 0xc2, 0xb9, 0x16, 0xdf, 0xc6, 0xf1, 0x3b, 0x21,
-	0xf9, 0x9e, 0x4e, 0xd5, 0x6a, 0x38, 0x7f, 0x7f, 0x6f, 0xfa, 0xdc, 0x4a, 0x1e, 0x02, 0xce, 0x7f,
-	0xce, 0x7e, 0x0f, 0x20, 0x1e, 0xfa, 0xba, 0x90, 0x17, 0xae, 0xda, 0xd7, 0xcd, 0x61, 0x7f, 0xa9,
-	0x02, 0xa7, 0x32, 0xf7, 0xc0, 0xd0, 0x2d, 0x74, 0x6f, 0x7c, 0xec, 0x91, 0xf5, 0x77, 0x6f, 0xf7,
-	0x06, 0x8a, 0xb8, 0x0d, 0xa0, 0xe2, 0x05, 0xed, 0x4e, 0x52, 0x4c, 0xa9, 0x18, 0xde, 0x89, 0x25,
-	0x4a, 0xd0, 0x38, 0x97, 0xa0, 0x7f, 0x31, 0x67, 0x53, 0x64, 0xfc, 0x6e, 0x6a, 0x93, 0x33, 0xf4,
-	0x88, 0xdc, 0x2c, 0x9f, 0xd4, 0xd1, 0xb4, 0x95, 0x22, 0x7c, 0xc8, 0x99, 0xc9, 0x72, 0xdc, 0xa1,
-	0x56, 0x3f, 0x57, 0x82, 0x51, 0xe3, 0xa3, 0xa1, 0x9f, 0x4c, 0xd7, 0xbc, 0xb5, 0x8a, 0x7b, 0x25,
-	0x46, 0x7f, 0x46, 0x57, 0xb5, 0xe5, 0xaf, 0xf4, 0x7c, 0x6f, 0xb9, 0xdb, 0x07, 0x7b, 0xd3, 0xa7,
-	0x33, 0x05, 0x6d, 0x53, 0x25, 0x70, 0x2f, 0x7c, 0x37, 0x9c, 0xca, 0x90, 0xc9, 0x79, 0xe5, 0x75,
-	0xf3, 0x95, 0x8f, 0xec, 0xee, 0x33, 0x87, 0xec, 0x67, 0xe8, 0x90, 0x89, 0x0a, 0x15, 0xa1, 0x4f,
-	0x06, 0xf0, 0x75, 0x66, 0xf6, 0x17, 0xa5, 0x01, 0x0b, 0xd1, 0xbc, 0x0d, 0xaa, 0xed, 0xd0, 0xf7,
-	0x5c, 0x4f, 0x95, 0xcc, 0x67, 0xa5, 0x6f, 0xd6, 0x44, 0x1b, 0x56, 0x50, 0x74, 0x0f, 0x6a, 0x77,
-	0xef, 0x25, 0xfc, 0x98, 0x51, 0x1c, 0x65, 0x14, 0x75, 0xba, 0xa8, 0x8c, 0x16, 0x75, 0x8e, 0x89,
-	0x35, 0x2f, 0x64, 0xc3, 0x30, 0x53, 0x82, 0x32, 0x5b, 0x95, 0x1d, 0xb3, 0x30, 0xed, 0x18, 0x63,
-	0x01, 0xb1, 0xff, 0xed, 0x28, 0x9c, 0xcd, 0xbb, 0x8c, 0x0b, 0x7d, 0x0c, 0x86, 0x79, 0x1f, 0x8b,
-	0xb9, 0xef, 0x31, 0x8f, 0xc7, 0x55, 0x46, 0x50, 0x74, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee,
-	0x3b, 0x1b, 0x62, 0x86, 0x1c, 0x0f, 0xf7, 0x65, 0x47, 0x73, 0x5f, 0x76, 0x38, 0x77, 0xdf, 0xd9,
-	0x40, 0xbb, 0x50, 0x69, 0x7a, 0x09, 0x71, 0x84, 0x73, 0xe6, 0xce, 0xb1, 0x30, 0x27, 0x0e, 0xb7,
-	0xd2, 0xd8, 0x4f, 0xcc, 0x19, 0xa2, 0x2f, 0x5b, 0x70, 0x6a, 0x23, 0x5d, 0x01, 0x4b, 0x08, 0x4f,
-	0xe7, 0x18, 0x2e, 0x5c, 0x4b, 0x33, 0xe2, 0x17, 0x38, 0x67, 0x1a, 0x71, 0xb6, 0x3b, 0xe8, 0x53,
-	0x16, 0x8c, 0x6c, 0x7a, 0xbe, 0x71, 0xa3, 0xcd, 0x31, 0x7c, 0x9c, 0x2b, 0x8c, 0x81, 0xde, 0x71,
-	0xf0, 0xff, 0x31, 0x96, 0x9c, 0xfb, 0x69, 0xaa, 0xe1, 0xa3, 0x6a, 0xaa, 0x91, 0x47, 0xa4, 0xa9,
-	0x3e, 0x6b, 0x41, 0x4d, 0x8d, 0xb4, 0xa8, 0x24, 0xf4, 0xc1, 0x63, 0xfc, 0xe4, 0xdc, 0x23, 0xa5,
-	0xfe, 0x62, 0xcd, 0x1c, 0xbd, 0x6e, 0xc1, 0xa8, 0xf3, 0x5a, 0x27, 0x22, 0x0d, 0xb2, 0x13, 0xb6,
-	0x63, 0x51, 0xe2, 0xf7, 0x95, 0xe2, 0x3b, 0x33, 0x47, 0x99, 0x2c, 0x92, 0x9d, 0xd5, 0x76, 0x2c,
-	0x32, 0xe9, 0x75, 0x03, 0x36, 0xbb, 0x80, 0xbe, 0x4f, 0xeb, 0x71, 0x28, 0xa2, 0xd0, 0x7b, 0x5e,
-	0x6f, 0x06, 0x2a, 0x0c, 0x41, 0xe0, 0x29, 0x37, 0x0c, 0x12, 0x2f, 0xe8, 0x90, 0xd5, 0x00, 0x93,
-	0x76, 0x78, 0x33, 0x4c, 0xae, 0x84, 0x9d, 0xa0, 0x71, 0x39, 0x8a, 0xc2, 0x88, 0x95, 0x4a, 0x32,
-	0xae, 0xf9, 0x5d, 0xe8, 0x8f, 0x8a, 0xf7, 0xa3, 0x73, 0x14, 0x9b, 0x61, 0xaf, 0x04, 0xd3, 0x07,
-	0x0c, 0x36, 0x7a, 0x09, 0xc6, 0xc2, 0xa8, 0xe9, 0x04, 0xde, 0x6b, 0x66, 0xf5, 0x3f, 0x65, 0x90,
-	0xae, 0x1a, 0x30, 0x9c, 0xc2, 0x34, 0xcb, 0x42, 0x95, 0x0e, 0x28, 0x0b, 0x75, 0x11, 0x86, 0x22,
-	0xd2, 0x0e, 0xb3, 0xfb, 0x2a, 0x96, 0x70, 0xca, 0x20, 0xe8, 0x19, 0x28, 0x3b, 0x6d, 0x4f, 0x38,
-	0x17, 0xd5, 0x76, 0x71, 0x6e, 0x6d, 0x09, 0xd3, 0xf6, 0x54, 0x95, 0xba, 0xca, 0x89, 0x54, 0xa9,
-	0xa3, 0x1a, 0x53, 0x1c, 0x9f, 0x0d, 0x6b, 0x8d, 0x99, 0x3e, 0xd6, 0xb2, 0xdf, 0x28, 0xc3, 0x33,
-	0xfb, 0x2e, 0x2d, 0x1d, 0xb2, 0x6e, 0xed, 0x13, 0xb2, 0x2e, 0x87, 0xa7, 0x74, 0xd0, 0xf0, 0x94,
-	0xfb, 0x0c, 0xcf, 0xa7, 0xa8, 0xc4, 0x90, 0x55, 0x13, 0x85, 0x92, 0x38, 0x62, 0x1a, 0x41, 0xbf,
-	0x22, 0x8c, 0x42, 0x58, 0x48, 0x28, 0xd6, 0x7c, 0xe9, 0x76, 0x29, 0x55, 0x12, 0xa9, 0x52, 0x84,
-	0xc6, 0xec, 0x5b, 0xb9, 0x90, 0x8b, 0x89, 0x7e, 0x75, 0x96, 0xec, 0x5f, 0x1e, 0x82, 0xe7, 0x06,
-	0x50, 0x74, 0xe6, 0x2c, 0xb6, 0x06, 0x9c, 0xc5, 0x5f, 0xe3, 0x9f, 0xe9, 0x33, 0xb9, 0x9f, 0x09,
-	0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec, 0x04, 0x22, 0x88, 0x89, 0xdb, 0x89, 0x78, 0xfa, 0x8e,
-	0x91, 0x8d, 0xbe, 0x24, 0xda, 0xb1, 0xc2, 0xa0, 0xdb, 0x5f, 0xd7, 0xa1, 0xcb, 0x7f, 0xa4, 0xa0,
-	0x12, 0x38, 0x66, 0x62, 0x3b, 0xb7, 0xbe, 0x16, 0xe6, 0xa8, 0x04, 0xe0, 0x6c, 0xec, 0x5f, 0xb7,
-	0xe0, 0x42, 0x7f, 0x6b, 0x04, 0xbd, 0x13, 0x46, 0x37, 0x58, 0x30, 0xe5, 0x0a, 0x0b, 0x99, 0x12,
-	0x53, 0x87, 0xbd, 0xaf, 0x6e, 0xc6, 0x26, 0x0e, 0x5a, 0x80, 0x49, 0x33, 0x0a, 0x73, 0xc5, 0x88,
-	0xb5, 0x62, 0xfe, 0x92, 0xf5, 0x2c, 0x10, 0xf7, 0xe2, 0xa3, 0x19, 0x80, 0xc4, 0x4b, 0x7c, 0xc2,
-	0x9f, 0xe6, 0x13, 0x8d, 0x39, 0x14, 0xd7, 0x55, 0x2b, 0x36, 0x30, 0xec, 0xaf, 0x96, 0xf3, 0x5f,
-	0x83, 0x5b, 0xb9, 0x87, 0x99, 0xfd, 0x62, 0x6e, 0x97, 0x06, 0x90, 0xd0, 0xe5, 0x93, 0x96, 0xd0,
-	0x43, 0xfd, 0x24, 0x34, 0x5a, 0x84, 0xd3, 0xc6, 0xc5, 0xc1, 0xbc, 0x88, 0x12, 0x3f, 0x94, 0x52,
-	0x15, 0x10, 0xd7, 0x32, 0x70, 0xdc, 0xf3, 0xc4, 0x63, 0x3e, 0x55, 0x7f, 0xa3, 0x04, 0xe7, 0xfb,
-	0x6e, 0x2c, 0x4e, 0x48, 0x03, 0x99, 0x9f, 0x7f, 0xe8, 0x64, 0x3e, 0xbf, 0xf9, 0x51, 0x2a, 0x07,
-	0x7e, 0x94, 0x41, 0xd4, 0xf9, 0x1f, 0x94, 0xfa, 0x2e, 0x16, 0xba, 0x11, 0xfd, 0x6b, 0x3b, 0x92,
-	0x2f, 0xc3, 0xb8, 0xd3, 0x6e, 0x73, 0x3c, 0x96, 0x99, 0x91, 0xa9, 0xca, 0x3a, 0x67, 0x02, 0x71,
-	0x1a, 0x77, 0xa0, 0x81, 0xfd, 0x13, 0x0b, 0x6a, 0x98, 0x6c, 0x72, 0x09, 0x87, 0xee, 0x8a, 0x21,
-	0xb2, 0x8a, 0xb8, 0x1a, 0x83, 0x0e, 0x6c, 0xec, 0xb1, 0xc2, 0x0b, 0x79, 0x83, 0x7d, 0xd4, 0xba,
-	0x1a, 0xea, 0xba, 0xe1, 0x72, 0xff, 0xeb, 0x86, 0xed, 0x5f, 0xa9, 0xd1, 0xd7, 0x6b, 0x87, 0x0b,
-	0x11, 0x69, 0xc4, 0xf4, 0xfb, 0x76, 0x22, 0x5f, 0x4c, 0x12, 0xf5, 0x7d, 0x6f, 0xe1, 0x65, 0x4c,
-	0xdb, 0x53, 0xe7, 0x93, 0xa5, 0x43, 0xd5, 0xa4, 0x2c, 0x1f, 0x58, 0x93, 0xf2, 0x65, 0x18, 0x8f,
-	0xe3, 0xad, 0xb5, 0xc8, 0xdb, 0x71, 0x12, 0x72, 0x83, 0xc8, 0x82, 0x51, 0xba, 0x3e, 0x5b, 0xfd,
-	0x9a, 0x06, 0xe2, 0x34, 0x2e, 0xba, 0x0a, 0x93, 0xba, 0x32, 0x24, 0x89, 0x12, 0x96, 0xf2, 0xc8,
-	0x67, 0x82, 0x2a, 0x06, 0xa4, 0x6b, 0x49, 0x0a, 0x04, 0xdc, 0xfb, 0x0c, 0x95, 0xb9, 0xa9, 0x46,
-	0xda, 0x91, 0xe1, 0xb4, 0xcc, 0x4d, 0xd1, 0xa1, 0x7d, 0xe9, 0x79, 0x02, 0xad, 0xc0, 0x19, 0x3e,
-	0x31, 0xe6, 0xda, 0x6d, 0xe3, 0x8d, 0x46, 0xd2, 0xf7, 0x11, 0x5c, 0xed, 0x45, 0xc1, 0x79, 0xcf,
-	0xa1, 0x17, 0x61, 0x54, 0x35, 0x2f, 0x2d, 0x8a, 0xa3, 0x35, 0xe5, 0xda, 0x53, 0x64, 0x96, 0x1a,
-	0xd8, 0xc4, 0x43, 0xef, 0x87, 0x27, 0xf5, 0x5f, 0x9e, 0x42, 0xcf, 0xcf, 0x9b, 0x17, 0x45, 0xd1,
-	0x5d, 0x75, 0xdd, 0xdd, 0xd5, 0x5c, 0xb4, 0x06, 0xee, 0xf7, 0x3c, 0xda, 0x80, 0x0b, 0x0a, 0x74,
-	0x39, 0x48, 0x58, 0x92, 0x6b, 0x4c, 0xe6, 0x9d, 0x98, 0x45, 0x4e, 0x00, 0x7b, 0x4f, 0x5b, 0x50,
-	0xbf, 0x70, 0xd5, 0x4b, 0xae, 0xe5, 0x61, 0xe2, 0x65, 0xbc, 0x0f, 0x15, 0x34, 0x0b, 0x35, 0x12,
-	0x38, 0x1b, 0x3e, 0x59, 0x5d, 0x58, 0x12, 0x3b, 0x52, 0x9d, 0x1d, 0x21, 0x01, 0x58, 0xe3, 0xa8,
-	0xf8, 0xfe, 0xb1, 0x7e, 0xf1, 0xfd, 0x68, 0x0d, 0xce, 0x36, 0xdd, 0x36, 0xb5, 0x32, 0x3d, 0x97,
-	0xcc, 0xb9, 0x2c, 0xa0, 0x98, 0x7e, 0x18, 0x7e, 0x51, 0x84, 0x4a, 0x94, 0xba, 0xba, 0xb0, 0xd6,
-	0x83, 0x83, 0x73, 0x9f, 0x64, 0x81, 0xe7, 0x51, 0xb8, 0xdb, 0x9d, 0x3a, 0x93, 0x09, 0x3c, 0xa7,
-	0x8d, 0x98, 0xc3, 0xd0, 0x75, 0x40, 0x2c, 0x59, 0xf0, 0x5a, 0x92, 0xb4, 0x95, 0x59, 0x3b, 0x75,
-	0x36, 0x5d, 0x82, 0xf3, 0x4a, 0x0f, 0x06, 0xce, 0x79, 0x8a, 0x5a, 0x3d, 0x41, 0xc8, 0xa8, 0x4f,
-	0x3d, 0x99, 0xb6, 0x7a, 0x6e, 0xf2, 0x66, 0x2c, 0xe1, 0xe8, 0x43, 0x30, 0xd5, 0x89, 0x09, 0xdb,
-	0x30, 0xdf, 0x09, 0xa3, 0x6d, 0x3f, 0x74, 0x1a, 0x4b, 0xec, 0x5e, 0xe3, 0xa4, 0x3b, 0x35, 0xc5,
-	0x98, 0x5f, 0x14, 0xcf, 0x4e, 0xdd, 0xea, 0x83, 0x87, 0xfb, 0x52, 0xc8, 0xd6, 0x90, 0x3d, 0x3f,
-	0x60, 0x0d, 0xd9, 0x35, 0x38, 0x2b, 0xf5, 0xda, 0xea, 0xc2, 0x92, 0x7a, 0xe9, 0xa9, 0x0b, 0xe9,
-	0x8b, 0x12, 0x97, 0x72, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x1f, 0x5b, 0x30, 0xae, 0x24, 0xd8, 0x09,
-	0x24, 0x2d, 0xfb, 0xe9, 0xa4, 0xe5, 0xab, 0x47, 0xd7, 0x01, 0xac, 0xe7, 0x7d, 0x52, 0x6c, 0x7e,
-	0x69, 0x1c, 0x40, 0xeb, 0x09, 0xa5, 0xa2, 0xad, 0xbe, 0x2a, 0xfa, 0xb1, 0x95, 0xd1, 0x79, 0x35,
-	0x41, 0x2b, 0x8f, 0xb6, 0x26, 0x68, 0x1d, 0xce, 0xc9, 0x29, 0xc5, 0x8f, 0x94, 0xaf, 0x85, 0xb1,
-	0x12, 0xf9, 0xc6, 0xcd, 0x97, 0x4b, 0x79, 0x48, 0x38, 0xff, 0xd9, 0x94, 0x6d, 0x37, 0x72, 0xa0,
-	0x6d, 0xa7, 0xa4, 0xdc, 0xf2, 0xa6, 0xbc, 0x97, 0x36, 0x23, 0xe5, 0x96, 0xaf, 0xd4, 0xb1, 0xc6,
-	0xc9, 0x57, 0x75, 0xb5, 0x82, 0x54, 0x1d, 0x1c, 0x5a, 0xd5, 0x49, 0xa1, 0x3b, 0xda, 0x57, 0xe8,
-	0xca, 0xa3, 0xab, 0xb1, 0xbe, 0x47, 0x57, 0xef, 0x85, 0x09, 0x2f, 0xd8, 0x22, 0x91, 0x97, 0x90,
-	0x06, 0x5b, 0x0b, 0x4c, 0x20, 0x57, 0xb5, 0xa1, 0xb3, 0x94, 0x82, 0xe2, 0x0c, 0x76, 0x5a, 0x53,
-	0x4c, 0x0c, 0xa0, 0x29, 0xfa, 0xe8, 0xe7, 0x53, 0xc5, 0xe8, 0xe7, 0xd3, 0x47, 0xd7, 0xcf, 0x93,
-	0xc7, 0xaa, 0x9f, 0x51, 0x21, 0xfa, 0x79, 0x20, 0xd5, 0x67, 0x6c, 0xd2, 0xcf, 0x1e, 0xb0, 0x49,
-	0xef, 0xa7, 0x9c, 0xcf, 0x3d, 0xb4, 0x72, 0xce, 0xd7, 0xbb, 0x4f, 0xbc, 0xa9, 0x77, 0x8b, 0xd0,
-	0xbb, 0xf4, 0xfb, 0x37, 0x48, 0x3b, 0xd9, 0x9a, 0x7a, 0x8a, 0x4d, 0x56, 0xf5, 0xfd, 0x17, 0x69,
-	0x23, 0xe6, 0x30, 0xfb, 0xb3, 0x25, 0x38, 0xa7, 0xd5, 0x17, 0x15, 0x1a, 0xde, 0x26, 0x15, 0xe0,
-	0xec, 0x46, 0x78, 0x7e, 0x2a, 0x6e, 0xe4, 0xd3, 0xeb, 0x8a, 0x02, 0x0a, 0x82, 0x0d, 0x2c, 0x96,
-	0x96, 0x4e, 0x22, 0x76, 0x17, 0x51, 0x56, 0xb7, 0x2d, 0x88, 0x76, 0xac, 0x30, 0xe8, 0x48, 0xd1,
-	0xdf, 0xa2, 0x2a, 0x4a, 0xb6, 0xca, 0xfd, 0x82, 0x06, 0x61, 0x13, 0x0f, 0xbd, 0x8d, 0x33, 0x61,
-	0x72, 0x95, 0xea, 0xb7, 0x31, 0xbe, 0xf7, 0x54, 0xa2, 0x54, 0x41, 0x65, 0x77, 0x58, 0xd9, 0x84,
-	0x4a, 0x6f, 0x77, 0x58, 0x80, 0xa9, 0xc2, 0xb0, 0xff, 0xa7, 0x05, 0xe7, 0x73, 0x87, 0xe2, 0x04,
-	0x6c, 0x96, 0xdd, 0xb4, 0xcd, 0x52, 0x2f, 0x6a, 0xdf, 0x6a, 0xbc, 0x45, 0x1f, 0xfb, 0xe5, 0x3f,
-	0x58, 0x30, 0xa1, 0xf1, 0x4f, 0xe0, 0x55, 0xbd, 0xf4, 0xab, 0x16, 0xb7, 0x45, 0xaf, 0xf5, 0xbc,
-	0xdb, 0xaf, 0x95, 0x40, 0xdd, 0x3c, 0x31, 0xe7, 0x26, 0x83, 0xe5, 0xa4, 0x75, 0x61, 0x98, 0x85,
-	0x99, 0xc4, 0xc5, 0x84, 0xd0, 0xa5, 0xf9, 0xb3, 0x90, 0x15, 0x7d, 0xea, 0xc7, 0xfe, 0xc6, 0x58,
-	0x30, 0x64, 0x37, 0x65, 0xf1, 0xa2, 0xfe, 0x0d, 0x91, 0x5d, 0xad, 0x6f, 0xca, 0x12, 0xed, 0x58,
-	0x61, 0x50, 0xad, 0xea, 0xb9, 0x61, 0xb0, 0xe0, 0x3b, 0x71, 0x2c, 0x0c, 0x3d, 0xa5, 0x55, 0x97,
-	0x24, 0x00, 0x6b, 0x1c, 0x16, 0x81, 0xe2, 0xc5, 0x6d, 0xdf, 0xe9, 0x1a, 0x8e, 0x18, 0xa3, 0xfa,
-	0x97, 0x02, 0x61, 0x13, 0xcf, 0x6e, 0xc1, 0x54, 0xfa, 0x25, 0x16, 0xc9, 0x26, 0x0b, 0xff, 0x1e,
-	0x68, 0x38, 0x67, 0xa1, 0xe6, 0xb0, 0xa7, 0x96, 0x3b, 0x8e, 0x90, 0x09, 0x3a, 0x08, 0x5a, 0x02,
-	0xb0, 0xc6, 0xb1, 0xbf, 0x19, 0xce, 0xe4, 0x8c, 0xd9, 0x00, 0x51, 0x76, 0xbf, 0x54, 0x82, 0x53,
-	0xe9, 0x27, 0x63, 0x96, 0x20, 0xc9, 0xfb, 0xec, 0xc5, 0x6e, 0xb8, 0x43, 0xa2, 0x2e, 0xed, 0x86,
-	0x95, 0x49, 0x90, 0xec, 0xc1, 0xc0, 0x39, 0x4f, 0xb1, 0x4b, 0x60, 0x1a, 0xea, 0xd5, 0xe5, 0xf4,
-	0xb8, 0x5d, 0xe4, 0xf4, 0xd0, 0x23, 0x6b, 0x46, 0x06, 0x29, 0x96, 0xd8, 0xe4, 0x4f, 0x8d, 0x24,
-	0x96, 0xde, 0x31, 0xdf, 0xf1, 0xfc, 0xc4, 0x0b, 0xc4, 0x2b, 0x8b, 0x89, 0xa3, 0x8c, 0xa4, 0x95,
-	0x5e, 0x14, 0x9c, 0xf7, 0x9c, 0xfd, 0x95, 0x21, 0x50, 0x65, 0x52, 0x58, 0xe4, 0x66, 0x41, 0x71,
-	0xaf, 0x87, 0x4d, 0xb3, 0x55, 0x5f, 0x7a, 0x68, 0xbf, 0x50, 0x2a, 0xee, 0x4a, 0x33, 0x7d, 0xee,
-	0x6a, 0xc0, 0xd6, 0x35, 0x08, 0x9b, 0x78, 0xb4, 0x27, 0xbe, 0xb7, 0x43, 0xf8, 0x43, 0xc3, 0xe9,
-	0x9e, 0x2c, 0x4b, 0x00, 0xd6, 0x38, 0xac, 0xce, 0xba, 0xb7, 0xb9, 0x29, 0xfc, 0x42, 0xba, 0xce,
-	0xba, 0xb7, 0xb9, 0x89, 0x19, 0x84, 0x5f, 0x13, 0x16, 0x6e, 0x8b, 0x8d, 0x81, 0x71, 0x4d, 0x58,
-	0xb8, 0x8d, 0x19, 0x84, 0x7e, 0xa5, 0x20, 0x8c, 0x5a, 0x8e, 0xef, 0xbd, 0x46, 0x1a, 0x8a, 0x8b,
-	0xd8, 0x10, 0xa8, 0xaf, 0x74, 0xb3, 0x17, 0x05, 0xe7, 0x3d, 0x47, 0x27, 0x74, 0x3b, 0x22, 0x0d,
-	0xcf, 0x4d, 0x4c, 0x6a, 0x90, 0x9e, 0xd0, 0x6b, 0x3d, 0x18, 0x38, 0xe7, 0x29, 0x34, 0x07, 0xa7,
-	0x64, 0x99, 0x1b, 0x59, 0x1a, 0x72, 0x34, 0x5d, 0x5f, 0x0e, 0xa7, 0xc1, 0x38, 0x8b, 0x4f, 0x25,
-	0x56, 0x4b, 0x94, 0x2b, 0x66, 0xfb, 0x07, 0x43, 0x62, 0xc9, 0x32, 0xc6, 0x58, 0x61, 0xd8, 0x9f,
-	0x2c, 0x53, 0x0d, 0xdb, 0xa7, 0x2a, 0xf8, 0x89, 0xc5, 0x59, 0xa7, 0x67, 0xe4, 0xd0, 0x00, 0x33,
-	0xf2, 0xdd, 0x30, 0x76, 0x37, 0x0e, 0x03, 0x15, 0xc3, 0x5c, 0xe9, 0x1b, 0xc3, 0x6c, 0x60, 0xe5,
-	0xc7, 0x30, 0x0f, 0x17, 0x15, 0xc3, 0x3c, 0xf2, 0x90, 0x31, 0xcc, 0xff, 0xba, 0x02, 0xea, 0x1e,
-	0xd8, 0x9b, 0x24, 0xb9, 0x17, 0x46, 0xdb, 0x5e, 0xd0, 0x64, 0x25, 0x5b, 0xbe, 0x6c, 0xc9, 0xaa,
-	0x2f, 0xcb, 0x66, 0x6e, 0xef, 0x66, 0x41, 0x77, 0x79, 0xa6, 0x98, 0xcd, 0xac, 0x1b, 0x8c, 0x78,
-	0x2c, 0x4c, 0xa6, 0xba, 0x8c, 0x70, 0xf3, 0xa7, 0x7a, 0x84, 0xbe, 0x1b, 0x40, 0x3a, 0xd1, 0x37,
-	0xa5, 0x04, 0x5e, 0x2a, 0xa6, 0x7f, 0x98, 0x6c, 0x6a, 0xfb, 0x76, 0x5d, 0x31, 0xc1, 0x06, 0x43,
-	0xf4, 0x59, 0x9d, 0xf7, 0xcc, 0x93, 0x9d, 0x3e, 0x7a, 0x2c, 0x63, 0x33, 0x48, 0xd6, 0x33, 0x86,
-	0x11, 0x2f, 0x68, 0xd2, 0x79, 0x22, 0x62, 0x3d, 0xdf, 0x9a, 0x57, 0x11, 0x6c, 0x39, 0x74, 0x1a,
-	0xf3, 0x8e, 0xef, 0x04, 0x2e, 0x89, 0x96, 0x38, 0xba, 0xde, 0x18, 0x89, 0x06, 0x2c, 0x09, 0xf5,
-	0x5c, 0x56, 0x5b, 0x19, 0xe4, 0xb2, 0xda, 0x0b, 0xdf, 0x0e, 0x93, 0x3d, 0x1f, 0xf3, 0x50, 0x49,
-	0xce, 0x47, 0xa8, 0x05, 0xf6, 0xcb, 0xc3, 0x5a, 0x69, 0xdd, 0x0c, 0x1b, 0xfc, 0xee, 0xd3, 0x48,
-	0x7f, 0x51, 0x61, 0xbf, 0x16, 0x38, 0x45, 0x94, 0x9a, 0x31, 0x1a, 0xb1, 0xc9, 0x92, 0xce, 0xd1,
-	0xb6, 0x13, 0x91, 0xe0, 0xb8, 0xe7, 0xe8, 0x9a, 0x62, 0x82, 0x0d, 0x86, 0x68, 0x2b, 0x95, 0x8d,
-	0x77, 0xe5, 0xe8, 0xd9, 0x78, 0xac, 0x3e, 0x6b, 0xde, 0x15, 0x81, 0xaf, 0x5b, 0x30, 0x11, 0xa4,
-	0x66, 0x6e, 0x31, 0x01, 0xf8, 0xf9, 0xab, 0x82, 0x5f, 0x23, 0x9e, 0x6e, 0xc3, 0x19, 0xfe, 0x79,
-	0x2a, 0xad, 0x72, 0x48, 0x95, 0xa6, 0xef, 0x5e, 0x1e, 0xee, 0x77, 0xf7, 0x32, 0x0a, 0xd4, 0xa5,
-	0xf8, 0x23, 0x45, 0xd4, 0x34, 0x49, 0xdd, 0x88, 0x0f, 0x39, 0xb7, 0xe1, 0xdf, 0x31, 0x93, 0x75,
-	0x0f, 0x7f, 0x39, 0xfa, 0x78, 0xbf, 0xa4, 0x5e, 0xfb, 0xff, 0x0c, 0xc1, 0x69, 0x39, 0x22, 0x32,
-	0x79, 0x87, 0xea, 0x47, 0xce, 0x57, 0xdb, 0xca, 0x4a, 0x3f, 0x5e, 0x93, 0x00, 0xac, 0x71, 0xa8,
-	0x3d, 0xd6, 0x89, 0xc9, 0x6a, 0x9b, 0x04, 0xcb, 0xde, 0x46, 0x2c, 0x0e, 0xcc, 0xd5, 0x42, 0xb9,
-	0xa5, 0x41, 0xd8, 0xc4, 0x63, 0x19, 0xc5, 0xae, 0x59, 0xd6, 0x43, 0x67, 0x14, 0x0b, 0x43, 0x55,
-	0xc2, 0xd1, 0x8f, 0xe7, 0x5e, 0x53, 0x52, 0x4c, 0xca, 0x6b, 0x4f, 0xce, 0xd2, 0xe1, 0xee, 0x27,
-	0x41, 0xff, 0xd0, 0x82, 0x73, 0xbc, 0x55, 0x8e, 0xe4, 0xad, 0x76, 0xc3, 0x49, 0x48, 0x5c, 0xcc,
-	0xf5, 0x72, 0x39, 0xfd, 0xd3, 0x7e, 0xef, 0x3c, 0xb6, 0x38, 0xbf, 0x37, 0xe8, 0x8b, 0x16, 0x9c,
-	0xda, 0x4e, 0x95, 0xe5, 0x92, 0xaa, 0xe3, 0xa8, 0x35, 0x6b, 0x52, 0x44, 0xf5, 0x52, 0x4b, 0xb7,
-	0xc7, 0x38, 0xcb, 0xdd, 0xfe, 0x4b, 0x0b, 0x4c, 0x31, 0x7a, 0xf2, 0xd5, 0xbc, 0x0e, 0x6f, 0x0a,
-	0x4a, 0xeb, 0xb2, 0xd2, 0xd7, 0xba, 0x7c, 0x06, 0xca, 0x1d, 0xaf, 0x21, 0xf6, 0x17, 0xfa, 0x88,
-	0x7e, 0x69, 0x11, 0xd3, 0x76, 0xfb, 0x4f, 0x2b, 0xda, 0x27, 0x21, 0x32, 0x4a, 0xff, 0x5a, 0xbc,
-	0xf6, 0xa6, 0x2a, 0xd3, 0xcb, 0xdf, 0xfc, 0x66, 0x4f, 0x99, 0xde, 0x6f, 0x3d, 0x7c, 0xc2, 0x30,
-	0x1f, 0xa0, 0x7e, 0x55, 0x7a, 0x47, 0x0e, 0xc8, 0x16, 0xbe, 0x0b, 0x55, 0xba, 0x05, 0x63, 0xce,
-	0xc5, 0x6a, 0xaa, 0x53, 0xd5, 0x6b, 0xa2, 0xfd, 0xc1, 0xde, 0xf4, 0xb7, 0x1c, 0xbe, 0x5b, 0xf2,
-	0x69, 0xac, 0xe8, 0xa3, 0x18, 0x6a, 0xf4, 0x37, 0x4b, 0x6c, 0x16, 0x9b, 0xbb, 0x5b, 0x4a, 0x66,
-	0x4a, 0x40, 0x21, 0x59, 0xd3, 0x9a, 0x0f, 0x0a, 0xa0, 0x46, 0x11, 0x39, 0x53, 0xbe, 0x07, 0x5c,
-	0x53, 0xe9, 0xc5, 0x12, 0xf0, 0x60, 0x6f, 0xfa, 0xe5, 0xc3, 0x33, 0x55, 0x8f, 0x63, 0xcd, 0xc2,
-	0x50, 0x8d, 0xa3, 0xfd, 0x54, 0xa3, 0xfd, 0x7f, 0x87, 0xf4, 0xfc, 0x16, 0x15, 0x9c, 0xff, 0x5a,
-	0xcc, 0xef, 0x97, 0x32, 0xf3, 0xfb, 0x62, 0xcf, 0xfc, 0x9e, 0xa0, 0x63, 0x96, 0x53, 0x57, 0xfa,
-	0xa4, 0x8d, 0x85, 0x83, 0x7d, 0x12, 0xcc, 0x4a, 0x7a, 0xb5, 0xe3, 0x45, 0x24, 0x5e, 0x8b, 0x3a,
-	0x81, 0x17, 0x34, 0xd9, 0x94, 0xad, 0x9a, 0x56, 0x52, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0xe3, 0x4f,
-	0xe7, 0xc5, 0x1d, 0x67, 0x87, 0xcf, 0x3c, 0xa3, 0x7a, 0x66, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0xb6,
-	0xe0, 0x69, 0x49, 0x60, 0x91, 0xf8, 0x84, 0xbe, 0x10, 0x0b, 0x3d, 0x8c, 0x5a, 0x3c, 0x31, 0x80,
-	0x47, 0x8f, 0x7c, 0xbd, 0xa0, 0xf0, 0x34, 0xde, 0x07, 0x17, 0xef, 0x4b, 0xc9, 0xfe, 0x23, 0x16,
-	0x6c, 0x60, 0xd4, 0x77, 0xa0, 0xb3, 0xcf, 0xf7, 0x5a, 0x9e, 0x2c, 0xf2, 0xa9, 0x66, 0xdf, 0x32,
-	0x6d, 0xc4, 0x1c, 0x86, 0xee, 0xc1, 0xc8, 0x86, 0xe3, 0x6e, 0x87, 0x9b, 0x9b, 0xc5, 0x5c, 0xcd,
-	0x35, 0xcf, 0x89, 0xb1, 0x02, 0xdf, 0x23, 0xe2, 0xcf, 0x03, 0xfd, 0x13, 0x4b, 0x6e, 0xfc, 0x5a,
-	0x08, 0x76, 0xd3, 0xb7, 0x70, 0xdc, 0x19, 0xd7, 0x42, 0xf0, 0x0b, 0xc0, 0x25, 0xdc, 0xfe, 0xbd,
-	0x0a, 0x9c, 0x92, 0xb1, 0x63, 0xd7, 0xbc, 0x98, 0x85, 0x1b, 0x98, 0x17, 0x24, 0x94, 0x0e, 0xbc,
-	0x20, 0xe1, 0xc3, 0x00, 0x0d, 0xd2, 0xf6, 0xc3, 0x2e, 0xb3, 0x23, 0x87, 0x0e, 0x6d, 0x47, 0xaa,
-	0xad, 0xc7, 0xa2, 0xa2, 0x82, 0x0d, 0x8a, 0xa2, 0x08, 0x2a, 0xbf, 0x6f, 0x21, 0x53, 0x04, 0xd5,
-	0xb8, 0xeb, 0x6f, 0xf8, 0x64, 0xef, 0xfa, 0xf3, 0xe0, 0x14, 0xef, 0xa2, 0x2a, 0xb8, 0xf0, 0x10,
-	0x75, 0x15, 0x58, 0xca, 0xda, 0x62, 0x9a, 0x0c, 0xce, 0xd2, 0x35, 0x2f, 0xf2, 0xab, 0x9e, 0xf4,
-	0x45, 0x7e, 0x6f, 0x87, 0x9a, 0xfc, 0xce, 0xf1, 0x54, 0x4d, 0x17, 0x03, 0x92, 0xd3, 0x20, 0xc6,
-	0x1a, 0xde, 0x53, 0x3b, 0x06, 0x1e, 0x55, 0xed, 0x18, 0xfb, 0xf5, 0x32, 0xdd, 0x80, 0xf0, 0x7e,
-	0x1d, 0xfa, 0x1e, 0xcc, 0x6b, 0xc6, 0x3d, 0x98, 0x87, 0xfb, 0x9e, 0xd5, 0xcc, 0x7d, 0x99, 0x4f,
-	0xc3, 0x50, 0xe2, 0x34, 0x65, 0x86, 0x2d, 0x83, 0xae, 0x3b, 0xcd, 0x18, 0xb3, 0xd6, 0xc3, 0xd4,
-	0x8c, 0x7e, 0x19, 0xc6, 0x63, 0xaf, 0x19, 0x38, 0x49, 0x27, 0x22, 0xc6, 0xb9, 0xa3, 0x8e, 0xc0,
-	0x31, 0x81, 0x38, 0x8d, 0x8b, 0x3e, 0x65, 0x01, 0x44, 0x44, 0x6d, 0x6f, 0x86, 0x8b, 0x98, 0x43,
-	0x4a, 0x0c, 0x48, 0xba, 0x66, 0xcd, 0x0f, 0xb5, 0xad, 0x31, 0xd8, 0xda, 0x9f, 0xb1, 0x60, 0xb2,
-	0xe7, 0x29, 0xd4, 0x86, 0x61, 0x97, 0xdd, 0x56, 0x5a, 0x4c, 0x9d, 0xcb, 0xf4, 0xcd, 0xa7, 0x5c,
-	0x8f, 0xf1, 0x36, 0x2c, 0xf8, 0xd8, 0xbf, 0x32, 0x06, 0x67, 0xeb, 0x0b, 0x2b, 0xf2, 0x96, 0xa3,
-	0x63, 0x4b, 0x19, 0xce, 0xe3, 0x71, 0x72, 0x29, 0xc3, 0x7d, 0xb8, 0xfb, 0x46, 0xca, 0xb0, 0x6f,
-	0xa4, 0x0c, 0xa7, 0xf3, 0x37, 0xcb, 0x45, 0xe4, 0x6f, 0xe6, 0xf5, 0x60, 0x90, 0xfc, 0xcd, 0x63,
-	0xcb, 0x21, 0xde, 0xb7, 0x43, 0x87, 0xca, 0x21, 0x56, 0x09, 0xd6, 0x85, 0xa4, 0x8b, 0xf5, 0xf9,
-	0x54, 0xb9, 0x09, 0xd6, 0x2a, 0xb9, 0x95, 0xa7, 0x42, 0x0a, 0xa5, 0xf7, 0x4a, 0xf1, 0x1d, 0x18,
-	0x20, 0xb9, 0x55, 0x64, 0x63, 0x9a, 0x09, 0xd5, 0x23, 0x45, 0x24, 0x54, 0xe7, 0x75, 0xe7, 0xc0,
-	0x84, 0xea, 0x97, 0x61, 0xdc, 0xf5, 0xc3, 0x80, 0xac, 0x45, 0x61, 0x12, 0xba, 0xa1, 0xbc, 0xa7,
-	0x5e, 0x5f, 0xf3, 0x69, 0x02, 0x71, 0x1a, 0xb7, 0x5f, 0x36, 0x76, 0xed, 0xa8, 0xd9, 0xd8, 0xf0,
-	0x88, 0xb2, 0xb1, 0x8d, 0x7c, 0xe3, 0xd1, 0x22, 0xf2, 0x8d, 0xf3, 0xbe, 0xc8, 0x40, 0xf9, 0xc6,
-	0x6f, 0x58, 0x30, 0xee, 0xdc, 0x63, 0xfb, 0x16, 0x2e, 0x85, 0xd9, 0x69, 0xde, 0xe8, 0xa5, 0x8f,
-	0x1c, 0xc3, 0x84, 0xbd, 0x53, 0xd7, 0x6c, 0xe6, 0x27, 0x59, 0x0e, 0x88, 0xd9, 0x84, 0xd3, 0x1d,
-	0x39, 0x4a, 0x8e, 0xf2, 0x97, 0x4a, 0xf0, 0x75, 0x07, 0x76, 0x01, 0xdd, 0x03, 0x48, 0x9c, 0xa6,
-	0x98, 0xa8, 0xe2, 0xcc, 0xeb, 0x88, 0x41, 0xc3, 0xeb, 0x92, 0x9e, 0xc8, 0x9f, 0x53, 0xe4, 0xb1,
-	0xc1, 0x8a, 0xc5, 0x0a, 0x87, 0x7e, 0x4f, 0x89, 0x6a, 0x1c, 0xfa, 0x04, 0x33, 0x08, 0x35, 0x84,
-	0x22, 0xd2, 0xa4, 0xc6, 0x7d, 0x39, 0x6d, 0x08, 0x61, 0xd6, 0x8a, 0x05, 0x14, 0xbd, 0x08, 0xa3,
-	0x8e, 0xef, 0xf3, 0x5c, 0x3e, 0x12, 0x8b, 0xfb, 0x77, 0x75, 0x61, 0x5a, 0x0d, 0xc2, 0x26, 0x9e,
-	0xfd, 0x17, 0x25, 0x98, 0x3e, 0x40, 0xa6, 0xf4, 0xe4, 0x70, 0x57, 0x06, 0xce, 0xe1, 0x16, 0xb9,
-	0x48, 0xc3, 0x7d, 0x72, 0x91, 0x5e, 0x84, 0xd1, 0x84, 0x38, 0x2d, 0x11, 0x66, 0x98, 0xad, 0xb7,
-	0xb8, 0xae, 0x41, 0xd8, 0xc4, 0xa3, 0x52, 0x6c, 0xc2, 0x71, 0x5d, 0x12, 0xc7, 0x32, 0xd9, 0x48,
-	0x38, 0xc4, 0x0b, 0xcb, 0x64, 0x62, 0xe7, 0x0c, 0x73, 0x29, 0x16, 0x38, 0xc3, 0x32, 0x3b, 0xe0,
-	0xb5, 0x01, 0x07, 0xfc, 0xa7, 0x4a, 0xf0, 0xcc, 0xbe, 0xda, 0x6d, 0xe0, 0x3c, 0xb0, 0x4e, 0x4c,
-	0xa2, 0xec, 0xc4, 0xb9, 0x15, 0x93, 0x08, 0x33, 0x08, 0x1f, 0xa5, 0x76, 0x5b, 0x85, 0x88, 0x17,
-	0x9f, 0x38, 0xc9, 0x47, 0x29, 0xc5, 0x02, 0x67, 0x58, 0x3e, 0xec, 0xb4, 0xfc, 0xbd, 0x21, 0x78,
-	0x6e, 0x00, 0x1b, 0xa0, 0xc0, 0x04, 0xd3, 0x74, 0xf2, 0x74, 0xf9, 0x11, 0x25, 0x4f, 0x3f, 0xdc,
-	0x70, 0xbd, 0x99, 0x73, 0x3d, 0x50, 0x22, 0xeb, 0xcf, 0x94, 0xe0, 0x42, 0x7f, 0x83, 0x05, 0x7d,
-	0x1b, 0x9c, 0x8a, 0x54, 0x28, 0xa1, 0x99, 0x77, 0x7d, 0x86, 0xbb, 0xc3, 0x52, 0x20, 0x9c, 0xc5,
-	0x45, 0x33, 0x00, 0x6d, 0x27, 0xd9, 0x8a, 0x2f, 0xef, 0x7a, 0x71, 0x22, 0x0a, 0xd5, 0x4d, 0xf0,
-	0x43, 0x5a, 0xd9, 0x8a, 0x0d, 0x0c, 0xca, 0x8e, 0xfd, 0x5b, 0x0c, 0x6f, 0x86, 0x09, 0x7f, 0x88,
-	0x6f, 0x3d, 0xcf, 0xc8, 0x6b, 0x1d, 0x0d, 0x10, 0xce, 0xe2, 0x52, 0x76, 0x2c, 0x0c, 0x80, 0x77,
-	0x74, 0x48, 0x67, 0x6a, 0x2f, 0xab, 0x56, 0x6c, 0x60, 0x64, 0x33, 0xca, 0x2b, 0x07, 0x67, 0x94,
-	0xdb, 0xff, 0xa2, 0x04, 0xe7, 0xfb, 0x1a, 0xbc, 0x83, 0x89, 0xa9, 0xc7, 0x2f, 0xab, 0xfb, 0x21,
-	0x57, 0xd8, 0xa1, 0xb2, 0x81, 0xed, 0x3f, 0xe9, 0x33, 0xd3, 0x44, 0xa6, 0xef, 0xc3, 0x17, 0x45,
-	0x79, 0xfc, 0xc6, 0xb3, 0x27, 0xb9, 0x77, 0xe8, 0x10, 0xc9, 0xbd, 0x99, 0x8f, 0x51, 0x19, 0x50,
-	0x3b, 0xfc, 0x97, 0xa1, 0xbe, 0xc3, 0x4b, 0x37, 0xc8, 0x03, 0x1d, 0x36, 0x2c, 0xc2, 0x69, 0x2f,
-	0x60, 0x17, 0xf5, 0xd6, 0x3b, 0x1b, 0xa2, 0x76, 0x19, 0x2f, 0xd0, 0xab, 0x52, 0x6b, 0x96, 0x32,
-	0x70, 0xdc, 0xf3, 0xc4, 0x63, 0x98, 0x6c, 0xfd, 0x70, 0x43, 0x7a, 0x48, 0xc9, 0xbd, 0x0a, 0xe7,
-	0xe4, 0x50, 0x6c, 0x39, 0x11, 0x69, 0x08, 0x65, 0x1b, 0x8b, 0x64, 0xaa, 0xf3, 0x3c, 0x21, 0x2b,
-	0x07, 0x01, 0xe7, 0x3f, 0xc7, 0x6e, 0x55, 0x0d, 0xdb, 0x9e, 0x2b, 0xb6, 0x82, 0xfa, 0x56, 0x55,
-	0xda, 0x88, 0x39, 0x4c, 0xeb, 0x8b, 0xda, 0xc9, 0xe8, 0x8b, 0x0f, 0x43, 0x4d, 0x8d, 0x37, 0xcf,
-	0x85, 0x50, 0x93, 0xbc, 0x27, 0x17, 0x42, 0xcd, 0x70, 0x03, 0x8b, 0xce, 0x0e, 0xba, 0x51, 0xc9,
-	0xac, 0x56, 0xca, 0x8f, 0xb6, 0xdb, 0xef, 0x82, 0x31, 0xe5, 0x0b, 0x1c, 0xf4, 0x6e, 0x5b, 0xfb,
-	0xaf, 0x4a, 0x90, 0xb9, 0xc6, 0x0d, 0xed, 0x42, 0xad, 0x11, 0x75, 0x79, 0x63, 0x31, 0x05, 0xa2,
-	0x17, 0x25, 0x39, 0x7d, 0x66, 0xa6, 0x9a, 0xb0, 0x66, 0x86, 0x3e, 0xc6, 0x6b, 0x31, 0x0b, 0xd6,
-	0xa5, 0x22, 0x12, 0xee, 0xeb, 0x8a, 0x9e, 0x79, 0x79, 0xa5, 0x6c, 0xc3, 0x06, 0x3f, 0x94, 0x40,
-	0x6d, 0x4b, 0x5e, 0x57, 0x57, 0x8c, 0xb8, 0x53, 0xb7, 0xdf, 0x71, 0x13, 0x4d, 0xfd, 0xc5, 0x9a,
-	0x91, 0xfd, 0xc7, 0x25, 0x38, 0x9b, 0xfe, 0x00, 0xe2, 0x8c, 0xf3, 0x67, 0x2d, 0x78, 0xd2, 0x77,
-	0xe2, 0xa4, 0xde, 0x61, 0x1b, 0x85, 0xcd, 0x8e, 0xbf, 0x9a, 0x29, 0xdb, 0x7d, 0x54, 0x67, 0x8b,
-	0x22, 0x9c, 0xbd, 0xde, 0x70, 0xfe, 0xa9, 0xfb, 0x7b, 0xd3, 0x4f, 0x2e, 0xe7, 0x33, 0xc7, 0xfd,
-	0x7a, 0x85, 0x5e, 0xb7, 0xe0, 0xb4, 0xdb, 0x89, 0x22, 0x12, 0x24, 0xba, 0xab, 0xfc, 0x2b, 0xde,
-	0x2c, 0x64, 0x20, 0x75, 0x07, 0xcf, 0x52, 0x81, 0xba, 0x90, 0xe1, 0x85, 0x7b, 0xb8, 0xdb, 0x3f,
-	0x48, 0x35, 0x67, 0xdf, 0xf7, 0xfc, 0x1b, 0x76, 0x1f, 0xe3, 0x9f, 0x0d, 0xc3, 0x78, 0xaa, 0x36,
-	0x79, 0xea, 0xb0, 0xcf, 0x3a, 0xf0, 0xb0, 0x8f, 0xa5, 0xff, 0x75, 0x02, 0x79, 0x55, 0xbd, 0x91,
-	0xfe, 0xd7, 0x09, 0x08, 0xe6, 0x30, 0x31, 0xa4, 0xb8, 0x13, 0x88, 0xd3, 0x47, 0x73, 0x48, 0x71,
-	0x27, 0xc0, 0x02, 0x8a, 0x3e, 0x61, 0xc1, 0x18, 0x5b, 0x7c, 0xe2, 0x54, 0x55, 0x28, 0xb4, 0xeb,
-	0x05, 0x2c, 0x77, 0x59, 0x87, 0x9f, 0x85, 0x99, 0x9a, 0x2d, 0x38, 0xc5, 0x11, 0x7d, 0xda, 0x82,
-	0x9a, 0xba, 0x17, 0x57, 0x9c, 0x8d, 0xd4, 0x8b, 0x2d, 0xfd, 0x9e, 0x91, 0x7a, 0xaa, 0x06, 0x37,
-	0xd6, 0x8c, 0x51, 0xac, 0xce, 0x31, 0x47, 0x8e, 0xe7, 0x1c, 0x13, 0x72, 0xce, 0x30, 0xdf, 0x0e,
-	0xb5, 0x96, 0x13, 0x78, 0x9b, 0x24, 0x4e, 0xf8, 0xd1, 0xa2, 0xbc, 0xe9, 0x43, 0x36, 0x62, 0x0d,
-	0xa7, 0xc6, 0x7e, 0xcc, 0x5e, 0x2c, 0x31, 0xce, 0x02, 0x99, 0xb1, 0x5f, 0xd7, 0xcd, 0xd8, 0xc4,
-	0x31, 0x0f, 0x2e, 0xe1, 0x91, 0x1e, 0x5c, 0x8e, 0x1e, 0x70, 0x70, 0x59, 0x87, 0x73, 0x4e, 0x27,
-	0x09, 0xaf, 0x11, 0xc7, 0x9f, 0x4b, 0x12, 0xd2, 0x6a, 0x27, 0x31, 0x2f, 0x67, 0x3f, 0xc6, 0x5c,
-	0xc0, 0x2a, 0x30, 0xae, 0x4e, 0xfc, 0xcd, 0x1e, 0x24, 0x9c, 0xff, 0xac, 0xfd, 0xcf, 0x2c, 0x38,
-	0x97, 0x3b, 0x15, 0x1e, 0xdf, 0x94, 0x04, 0xfb, 0x47, 0x2a, 0x70, 0x26, 0xe7, 0xe6, 0x02, 0xd4,
-	0x35, 0x17, 0x89, 0x55, 0x44, 0x74, 0x5f, 0x3a, 0x58, 0x4d, 0x7e, 0x9b, 0x9c, 0x95, 0x71, 0xb8,
-	0x58, 0x04, 0x1d, 0x0f, 0x50, 0x3e, 0xd9, 0x78, 0x00, 0x63, 0xae, 0x0f, 0x3d, 0xd2, 0xb9, 0x5e,
-	0x39, 0x60, 0xae, 0xff, 0x9c, 0x05, 0x53, 0xad, 0x3e, 0xd7, 0x90, 0x89, 0xf3, 0xa4, 0xdb, 0xc7,
-	0x73, 0xc9, 0xd9, 0xfc, 0xd3, 0xf7, 0xf7, 0xa6, 0xfb, 0xde, 0xfe, 0x86, 0xfb, 0xf6, 0xca, 0xfe,
-	0x4a, 0x19, 0x98, 0xbd, 0xc6, 0xaa, 0x53, 0x77, 0xd1, 0xc7, 0xcd, 0x0b, 0x50, 0xac, 0xa2, 0x2e,
-	0xeb, 0xe0, 0xc4, 0xd5, 0x05, 0x2a, 0x7c, 0x04, 0xf3, 0xee, 0x53, 0xc9, 0x4a, 0xc2, 0xd2, 0x00,
-	0x92, 0xd0, 0x97, 0x37, 0xcd, 0x94, 0x8b, 0xbf, 0x69, 0xa6, 0x96, 0xbd, 0x65, 0x66, 0xff, 0x4f,
-	0x3c, 0xf4, 0x58, 0x7e, 0xe2, 0x5f, 0xb3, 0xb8, 0xe0, 0xc9, 0x7c, 0x05, 0x6d, 0x6e, 0x58, 0xfb,
-	0x98, 0x1b, 0x2f, 0x40, 0x35, 0x16, 0x92, 0x59, 0x98, 0x25, 0x3a, 0x6a, 0x4c, 0xb4, 0x63, 0x85,
-	0x41, 0x77, 0x5d, 0x8e, 0xef, 0x87, 0xf7, 0x2e, 0xb7, 0xda, 0x49, 0x57, 0x18, 0x28, 0x6a, 0x5b,
-	0x30, 0xa7, 0x20, 0xd8, 0xc0, 0x42, 0xdf, 0x00, 0x23, 0xbc, 0x8c, 0x44, 0x43, 0x78, 0x77, 0x46,
-	0xe9, 0x42, 0xe4, 0x45, 0x26, 0x1a, 0x58, 0xc2, 0xec, 0x2d, 0x30, 0xf6, 0x15, 0x0f, 0x7f, 0xdb,
-	0xf5, 0xc1, 0x17, 0x58, 0xda, 0x7f, 0xbf, 0x24, 0x58, 0xf1, 0x7d, 0x82, 0x0e, 0x23, 0xb4, 0x0e,
-	0x19, 0x46, 0xf8, 0x31, 0x00, 0x37, 0x6c, 0xb5, 0xe9, 0xce, 0x79, 0x3d, 0x2c, 0x66, 0xbb, 0xb5,
-	0xa0, 0xe8, 0xe9, 0x71, 0xd5, 0x6d, 0xd8, 0xe0, 0x97, 0x12, 0xee, 0xe5, 0x03, 0x85, 0x7b, 0x4a,
-	0xce, 0x0d, 0xed, 0x2f, 0xe7, 0xec, 0xbf, 0xb0, 0x20, 0x65, 0xf7, 0xa1, 0x36, 0x54, 0x68, 0x77,
-	0xbb, 0x42, 0x64, 0xac, 0x16, 0x67, 0x64, 0x52, 0x59, 0x2d, 0xd6, 0x21, 0xfb, 0x89, 0x39, 0x23,
-	0xe4, 0x8b, 0x90, 0xc9, 0x42, 0xb6, 0x3f, 0x26, 0xc3, 0x6b, 0x61, 0xb8, 0xcd, 0xc3, 0x89, 0x74,
-	0xf8, 0xa5, 0xfd, 0x12, 0x4c, 0xf6, 0x74, 0x8a, 0xdd, 0x90, 0x1d, 0xca, 0x3d, 0xbc, 0xb1, 0x7e,
-	0x58, 0x3d, 0x07, 0xcc, 0x61, 0xf6, 0xcf, 0x58, 0x70, 0x3a, 0x4b, 0x1e, 0xbd, 0x61, 0xc1, 0x64,
-	0x9c, 0xa5, 0x77, 0x5c, 0x63, 0xa7, 0x52, 0x23, 0x7a, 0x40, 0xb8, 0xb7, 0x13, 0xf6, 0x7f, 0x17,
-	0xfa, 0xe0, 0x8e, 0x17, 0x34, 0xc2, 0x7b, 0xca, 0x52, 0xb2, 0xfa, 0x5a, 0x4a, 0x54, 0x40, 0xb8,
-	0x5b, 0xa4, 0xd1, 0xf1, 0x7b, 0x0a, 0x48, 0xd4, 0x45, 0x3b, 0x56, 0x18, 0x2c, 0x5f, 0xbe, 0x23,
-	0x76, 0xae, 0x99, 0x49, 0xb9, 0x28, 0xda, 0xb1, 0xc2, 0x40, 0xef, 0x86, 0x31, 0xe3, 0x25, 0xe5,
-	0xbc, 0x64, 0xdb, 0x0e, 0x43, 0x87, 0xc7, 0x38, 0x85, 0x85, 0x66, 0x00, 0x94, 0xd5, 0x25, 0x75,
-	0x36, 0x73, 0xb5, 0x2b, 0xd1, 0x18, 0x63, 0x03, 0x83, 0x55, 0xa7, 0xf0, 0x3b, 0x31, 0x3b, 0x4b,
-	0x1e, 0xd6, 0xf7, 0x35, 0x2c, 0x88, 0x36, 0xac, 0xa0, 0x54, 0xbc, 0xb5, 0x9c, 0xa0, 0xe3, 0xf8,
-	0x74, 0x84, 0x84, 0xf3, 0x4c, 0x2d, 0xc3, 0x15, 0x05, 0xc1, 0x06, 0x16, 0x7d, 0xe3, 0xc4, 0x6b,
-	0x91, 0x0f, 0x84, 0x81, 0x0c, 0x69, 0xd7, 0xe1, 0x05, 0xa2, 0x1d, 0x2b, 0x0c, 0xf4, 0x12, 0x8c,
-	0x3a, 0x41, 0x83, 0x9b, 0x88, 0x61, 0x24, 0x4e, 0x29, 0xd5, 0xfe, 0xf3, 0x56, 0x4c, 0xe6, 0x34,
-	0x14, 0x9b, 0xa8, 0xd9, 0xcb, 0x2a, 0x60, 0xc0, 0xcb, 0xf0, 0xfe, 0xdc, 0x82, 0x53, 0xba, 0x26,
-	0x11, 0xf3, 0xb1, 0xa5, 0x9c, 0x8b, 0xd6, 0x81, 0xce, 0xc5, 0x74, 0xd5, 0x91, 0xd2, 0x40, 0x55,
-	0x47, 0xcc, 0x82, 0x20, 0xe5, 0x7d, 0x0b, 0x82, 0x7c, 0x03, 0x8c, 0x6c, 0x93, 0xae, 0x51, 0x39,
-	0x84, 0x69, 0x87, 0x1b, 0xbc, 0x09, 0x4b, 0x18, 0xb2, 0x61, 0xd8, 0x75, 0x54, 0x89, 0xc2, 0x31,
-	0x11, 0x9d, 0x36, 0xc7, 0x90, 0x04, 0xc4, 0x5e, 0x85, 0x9a, 0x3a, 0xd6, 0x97, 0xbe, 0x3e, 0x2b,
-	0xdf, 0xd7, 0x37, 0xd0, 0xb5, 0xfa, 0xf3, 0x1b, 0xbf, 0xf9, 0xd5, 0x67, 0xdf, 0xf2, 0xbb, 0x5f,
-	0x7d, 0xf6, 0x2d, 0x7f, 0xf4, 0xd5, 0x67, 0xdf, 0xf2, 0x89, 0xfb, 0xcf, 0x5a, 0xbf, 0x79, 0xff,
-	0x59, 0xeb, 0x77, 0xef, 0x3f, 0x6b, 0xfd, 0xd1, 0xfd, 0x67, 0xad, 0xaf, 0xdc, 0x7f, 0xd6, 0x7a,
-	0xfd, 0x3f, 0x3f, 0xfb, 0x96, 0x0f, 0xe4, 0x26, 0x51, 0xd0, 0x1f, 0xef, 0x70, 0x1b, 0xb3, 0x3b,
-	0xef, 0x62, 0x71, 0xfc, 0x74, 0x3d, 0xcf, 0x1a, 0x93, 0x78, 0x56, 0xae, 0xe7, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0x5d, 0x36, 0xf5, 0x14, 0x04, 0x01, 0x00,
+	// 14370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x65, 0xd9,
+	0x55, 0x18, 0xec, 0x73, 0x1f, 0x92, 0xee, 0x96, 0x5a, 0xea, 0x3e, 0xd3, 0x3d, 0x73, 0xa7, 0xe7,
+	0xa1, 0xf6, 0x19, 0xb0, 0xfd, 0x7d, 0x60, 0x35, 0x1e, 0xbf, 0xe6, 0xc3, 0xd8, 0xa0, 0x47, 0x3f,
+	0xd4, 0x2d, 0xb5, 0x34, 0xeb, 0xaa, 0xbb, 0xfd, 0x1a, 0x8f, 0x8f, 0xee, 0xdd, 0x92, 0x4e, 0xeb,
+	0xde, 0x73, 0xee, 0x9c, 0x73, 0xae, 0xba, 0x35, 0xd8, 0xc6, 0x06, 0xfb, 0xc3, 0xfe, 0x6c, 0xb0,
+	0x79, 0x7c, 0xc4, 0xa4, 0x02, 0x81, 0x40, 0x1e, 0x45, 0x42, 0x01, 0xa1, 0x52, 0xa1, 0x12, 0x28,
+	0x12, 0x48, 0x11, 0x08, 0x49, 0x20, 0x14, 0x21, 0x84, 0x47, 0xc7, 0x1e, 0x48, 0x41, 0xa5, 0x8a,
+	0x54, 0x25, 0x50, 0x49, 0x6a, 0x92, 0x4a, 0x52, 0x6b, 0xbf, 0xf7, 0xb9, 0xe7, 0x4a, 0x57, 0xd2,
+	0x91, 0xba, 0xed, 0xcc, 0x2f, 0xe9, 0xee, 0xb5, 0xf6, 0x5a, 0xeb, 0xec, 0xf7, 0x5e, 0x7b, 0x3d,
+	0xc8, 0xd2, 0x66, 0x90, 0x6e, 0xf5, 0xd6, 0x67, 0x9a, 0x51, 0xe7, 0xa2, 0x1f, 0x6f, 0x46, 0xdd,
+	0x38, 0xba, 0xc3, 0xfe, 0x79, 0x73, 0xb3, 0x75, 0x71, 0xe7, 0xad, 0x17, 0xbb, 0xdb, 0x9b, 0x17,
+	0xfd, 0x6e, 0x90, 0x5c, 0xf4, 0xbb, 0xdd, 0x76, 0xd0, 0xf4, 0xd3, 0x20, 0x0a, 0x2f, 0xee, 0xbc,
+	0xc5, 0x6f, 0x77, 0xb7, 0xfc, 0xb7, 0x5c, 0xdc, 0xa4, 0x21, 0x8d, 0xfd, 0x94, 0xb6, 0x66, 0xba,
+	0x71, 0x94, 0x46, 0xee, 0x37, 0x69, 0x6a, 0x33, 0x92, 0x1a, 0xfb, 0xe7, 0xc5, 0x66, 0x6b, 0x66,
+	0xe7, 0xad, 0x33, 0xdd, 0xed, 0xcd, 0x19, 0xa4, 0x36, 0x63, 0x50, 0x9b, 0x91, 0xd4, 0xce, 0xbf,
+	0xd9, 0x90, 0x65, 0x33, 0xda, 0x8c, 0x2e, 0x32, 0xa2, 0xeb, 0xbd, 0x0d, 0xf6, 0x8b, 0xfd, 0x60,
+	0xff, 0x71, 0x66, 0xe7, 0xbd, 0xed, 0xe7, 0x92, 0x99, 0x20, 0x42, 0xf1, 0x2e, 0x36, 0xa3, 0x98,
+	0x5e, 0xdc, 0xe9, 0x13, 0xe8, 0xfc, 0x55, 0x8d, 0x43, 0xef, 0xa5, 0x34, 0x4c, 0x82, 0x28, 0x4c,
+	0xde, 0x8c, 0x22, 0xd0, 0x78, 0x87, 0xc6, 0xe6, 0xe7, 0x19, 0x08, 0x79, 0x94, 0xde, 0xa6, 0x29,
+	0x75, 0xfc, 0xe6, 0x56, 0x10, 0xd2, 0x78, 0x57, 0x57, 0xef, 0xd0, 0xd4, 0xcf, 0xab, 0x75, 0x71,
+	0x50, 0xad, 0xb8, 0x17, 0xa6, 0x41, 0x87, 0xf6, 0x55, 0x78, 0xc7, 0x7e, 0x15, 0x92, 0xe6, 0x16,
+	0xed, 0xf8, 0x7d, 0xf5, 0xde, 0x3a, 0xa8, 0x5e, 0x2f, 0x0d, 0xda, 0x17, 0x83, 0x30, 0x4d, 0xd2,
+	0x38, 0x5b, 0xc9, 0xfb, 0x2b, 0x0e, 0x39, 0x35, 0x7b, 0xbb, 0x31, 0xdb, 0x4b, 0xb7, 0xe6, 0xa3,
+	0x70, 0x23, 0xd8, 0x74, 0xdf, 0x4e, 0xc6, 0x9b, 0xed, 0x5e, 0x92, 0xd2, 0xf8, 0x86, 0xdf, 0xa1,
+	0x75, 0xe7, 0x82, 0xf3, 0xa6, 0xda, 0xdc, 0x23, 0xbf, 0x7a, 0x7f, 0xfa, 0x75, 0xaf, 0xdc, 0x9f,
+	0x1e, 0x9f, 0xd7, 0x20, 0x30, 0xf1, 0xdc, 0xff, 0x8b, 0x8c, 0xc6, 0x51, 0x9b, 0xce, 0xc2, 0x8d,
+	0x7a, 0x89, 0x55, 0x99, 0x12, 0x55, 0x46, 0x81, 0x17, 0x83, 0x84, 0x23, 0x6a, 0x37, 0x8e, 0x36,
+	0x82, 0x36, 0xad, 0x97, 0x6d, 0xd4, 0x55, 0x5e, 0x0c, 0x12, 0xee, 0xfd, 0x60, 0x89, 0x4c, 0xcd,
+	0x76, 0xbb, 0x57, 0xa9, 0xdf, 0x4e, 0xb7, 0x1a, 0xa9, 0x9f, 0xf6, 0x12, 0x77, 0x93, 0x8c, 0x24,
+	0xec, 0x3f, 0x21, 0xdb, 0x8a, 0xa8, 0x3d, 0xc2, 0xe1, 0xaf, 0xde, 0x9f, 0x7e, 0x77, 0xde, 0x88,
+	0xde, 0x0c, 0xd2, 0xa8, 0x9b, 0xbc, 0x99, 0x86, 0x9b, 0x41, 0x48, 0x59, 0xbb, 0x6c, 0x31, 0xaa,
+	0x33, 0x26, 0xf1, 0xf9, 0xa8, 0x45, 0x41, 0x90, 0x47, 0x39, 0x3b, 0x34, 0x49, 0xfc, 0x4d, 0x9a,
+	0xfd, 0xa4, 0x65, 0x5e, 0x0c, 0x12, 0xee, 0xc6, 0xc4, 0x6d, 0xfb, 0x49, 0xba, 0x16, 0xfb, 0x61,
+	0x12, 0xe0, 0x90, 0x5e, 0x0b, 0x3a, 0xfc, 0xeb, 0xc6, 0x9f, 0xfd, 0xbf, 0x67, 0x78, 0xc7, 0xcc,
+	0x98, 0x1d, 0xa3, 0xe7, 0x01, 0x8e, 0x9b, 0x99, 0x9d, 0xb7, 0xcc, 0x60, 0x8d, 0xb9, 0x47, 0x5f,
+	0xb9, 0x3f, 0xed, 0x2e, 0xf5, 0x51, 0x82, 0x1c, 0xea, 0xde, 0xef, 0x94, 0x08, 0x99, 0xed, 0x76,
+	0x57, 0xe3, 0xe8, 0x0e, 0x6d, 0xa6, 0xee, 0x87, 0xc9, 0x18, 0x92, 0x6a, 0xf9, 0xa9, 0xcf, 0x1a,
+	0x66, 0xfc, 0xd9, 0x6f, 0x18, 0x8e, 0xf1, 0xca, 0x3a, 0xd6, 0x5f, 0xa6, 0xa9, 0x3f, 0xe7, 0x8a,
+	0x0f, 0x24, 0xba, 0x0c, 0x14, 0x55, 0x37, 0x24, 0x95, 0xa4, 0x4b, 0x9b, 0xac, 0x31, 0xc6, 0x9f,
+	0x5d, 0x9a, 0x39, 0xca, 0x4c, 0x9f, 0xd1, 0x92, 0x37, 0xba, 0xb4, 0x39, 0x37, 0x21, 0x38, 0x57,
+	0xf0, 0x17, 0x30, 0x3e, 0xee, 0x8e, 0xea, 0x68, 0xde, 0x90, 0x37, 0x0a, 0xe3, 0xc8, 0xa8, 0xce,
+	0x4d, 0xda, 0x03, 0x47, 0xf6, 0xbb, 0xf7, 0x87, 0x0e, 0x99, 0xd4, 0xc8, 0x4b, 0x41, 0x92, 0xba,
+	0x1f, 0xec, 0x6b, 0xdc, 0x99, 0xe1, 0x1a, 0x17, 0x6b, 0xb3, 0xa6, 0x3d, 0x2d, 0x98, 0x8d, 0xc9,
+	0x12, 0xa3, 0x61, 0x3b, 0xa4, 0x1a, 0xa4, 0xb4, 0x93, 0xd4, 0x4b, 0x17, 0xca, 0x6f, 0x1a, 0x7f,
+	0xf6, 0x6a, 0x51, 0xdf, 0x39, 0x77, 0x4a, 0x30, 0xad, 0x2e, 0x22, 0x79, 0xe0, 0x5c, 0xbc, 0xff,
+	0x76, 0xda, 0xfc, 0x3e, 0x6c, 0x70, 0xf7, 0x2d, 0x64, 0x3c, 0x89, 0x7a, 0x71, 0x93, 0x02, 0xed,
+	0x46, 0x38, 0xb1, 0xca, 0x38, 0xdc, 0x71, 0xc2, 0x37, 0x74, 0x31, 0x98, 0x38, 0xee, 0x77, 0x3b,
+	0x64, 0xa2, 0x45, 0x93, 0x34, 0x08, 0x19, 0x7f, 0x29, 0xfc, 0xda, 0x91, 0x85, 0x97, 0x85, 0x0b,
+	0x9a, 0xf8, 0xdc, 0x59, 0xf1, 0x21, 0x13, 0x46, 0x61, 0x02, 0x16, 0x7f, 0x5c, 0xb8, 0x5a, 0x34,
+	0x69, 0xc6, 0x41, 0x17, 0x7f, 0xd7, 0xcb, 0xf6, 0xc2, 0xb5, 0xa0, 0x41, 0x60, 0xe2, 0xb9, 0x21,
+	0xa9, 0xe2, 0xc2, 0x94, 0xd4, 0x2b, 0x4c, 0xfe, 0xc5, 0xa3, 0xc9, 0x2f, 0x1a, 0x15, 0xd7, 0x3c,
+	0xdd, 0xfa, 0xf8, 0x2b, 0x01, 0xce, 0xc6, 0xfd, 0x07, 0x0e, 0xa9, 0x8b, 0x85, 0x13, 0x28, 0x6f,
+	0xd0, 0xdb, 0x5b, 0x41, 0x4a, 0xdb, 0x41, 0x92, 0xd6, 0xab, 0x4c, 0x86, 0x0f, 0x1e, 0x4d, 0x86,
+	0x79, 0x9b, 0x3a, 0xd0, 0x24, 0x8d, 0x83, 0x26, 0xe2, 0xe0, 0x30, 0x98, 0xbb, 0x20, 0xc4, 0xaa,
+	0xcf, 0x0f, 0x90, 0x02, 0x06, 0xca, 0xe7, 0x7e, 0x9f, 0x43, 0xce, 0x87, 0x7e, 0x87, 0x26, 0x5d,
+	0xbf, 0x49, 0x25, 0x78, 0xae, 0xed, 0x37, 0xb7, 0x99, 0xf8, 0x23, 0x4c, 0xfc, 0x8b, 0xc3, 0x4d,
+	0x8d, 0x2b, 0x71, 0xd4, 0xeb, 0x5e, 0x0f, 0xc2, 0xd6, 0x9c, 0x27, 0x24, 0x3a, 0x7f, 0x63, 0x20,
+	0x69, 0xd8, 0x83, 0xad, 0xfb, 0x63, 0x0e, 0x39, 0x13, 0xc5, 0xdd, 0x2d, 0x3f, 0xa4, 0x2d, 0x09,
+	0x4d, 0xea, 0xa3, 0x6c, 0x9e, 0x7e, 0xe8, 0x68, 0x6d, 0xb9, 0x92, 0x25, 0xbb, 0x1c, 0x85, 0x41,
+	0x1a, 0xc5, 0x0d, 0x9a, 0xa6, 0x41, 0xb8, 0x99, 0xcc, 0x9d, 0x7b, 0xe5, 0xfe, 0xf4, 0x99, 0x3e,
+	0x2c, 0xe8, 0x97, 0xc7, 0xfd, 0x56, 0x32, 0x9e, 0xec, 0x86, 0xcd, 0xdb, 0x41, 0xd8, 0x8a, 0xee,
+	0x26, 0xf5, 0xb1, 0x22, 0xe6, 0x7a, 0x43, 0x11, 0x14, 0xb3, 0x55, 0x33, 0x00, 0x93, 0x5b, 0x7e,
+	0xc7, 0xe9, 0x71, 0x57, 0x2b, 0xba, 0xe3, 0xf4, 0x60, 0xda, 0x83, 0xad, 0xfb, 0x9d, 0x0e, 0x39,
+	0x95, 0x04, 0x9b, 0xa1, 0x9f, 0xf6, 0x62, 0x7a, 0x9d, 0xee, 0x26, 0x75, 0xc2, 0x04, 0xb9, 0x76,
+	0xc4, 0x56, 0x31, 0x48, 0xce, 0x9d, 0x13, 0x32, 0x9e, 0x32, 0x4b, 0x13, 0xb0, 0xf9, 0xe6, 0xcd,
+	0x4a, 0x3d, 0xac, 0xc7, 0x1f, 0xe0, 0xac, 0xd4, 0x33, 0x60, 0xa0, 0x7c, 0xee, 0xb7, 0x90, 0xd3,
+	0xbc, 0x48, 0x75, 0x43, 0x52, 0x9f, 0x60, 0x4b, 0xf8, 0xd9, 0x57, 0xee, 0x4f, 0x9f, 0x6e, 0x64,
+	0x60, 0xd0, 0x87, 0xed, 0xbe, 0x44, 0xa6, 0xbb, 0x34, 0xee, 0x04, 0xe9, 0x4a, 0xd8, 0xde, 0x95,
+	0x1b, 0x43, 0x33, 0xea, 0xd2, 0x96, 0x10, 0x27, 0xa9, 0x9f, 0xba, 0xe0, 0xbc, 0x69, 0x6c, 0xee,
+	0x8d, 0x42, 0xcc, 0xe9, 0xd5, 0xbd, 0xd1, 0x61, 0x3f, 0x7a, 0xee, 0xaf, 0x38, 0xe4, 0xbc, 0xb1,
+	0x7e, 0x37, 0x68, 0xbc, 0x13, 0x34, 0xe9, 0x6c, 0xb3, 0x19, 0xf5, 0xc2, 0x34, 0xa9, 0x4f, 0xb2,
+	0x36, 0x5f, 0x3f, 0x8e, 0xdd, 0xc4, 0x66, 0xa5, 0x07, 0xf1, 0x40, 0x94, 0x04, 0xf6, 0x90, 0x14,
+	0xa7, 0xd6, 0xe9, 0xa8, 0x19, 0x58, 0xc3, 0xab, 0x3e, 0xc5, 0xc4, 0x5f, 0x3e, 0xe2, 0xe2, 0x33,
+	0xbf, 0x68, 0x0d, 0xe5, 0xba, 0x90, 0xf4, 0x74, 0x06, 0x90, 0x40, 0x9f, 0x00, 0x4c, 0xaa, 0x24,
+	0xd9, 0xb2, 0xa5, 0x3a, 0x5d, 0x84, 0x54, 0x8d, 0xc6, 0xd5, 0x7c, 0xa9, 0x32, 0x80, 0x04, 0xfa,
+	0x04, 0xf0, 0x7e, 0xad, 0x44, 0x4e, 0x67, 0xcf, 0x61, 0xee, 0xdf, 0x70, 0xc8, 0xd4, 0x9d, 0xbb,
+	0xe9, 0x5a, 0xb4, 0x4d, 0xc3, 0x64, 0x6e, 0x17, 0x77, 0x4b, 0x76, 0x02, 0x19, 0x7f, 0xb6, 0x59,
+	0xec, 0x89, 0x6f, 0xe6, 0x9a, 0xcd, 0xe5, 0x52, 0x98, 0xc6, 0xbb, 0x73, 0x8f, 0x09, 0xf9, 0xa7,
+	0xae, 0xdd, 0x5e, 0x33, 0xa1, 0x90, 0x15, 0xea, 0xfc, 0x67, 0x1d, 0x72, 0x36, 0x8f, 0x84, 0x7b,
+	0x9a, 0x94, 0xb7, 0xe9, 0x2e, 0xbf, 0x8f, 0x00, 0xfe, 0xeb, 0xbe, 0x40, 0xaa, 0x3b, 0x7e, 0xbb,
+	0x47, 0xc5, 0x61, 0xf9, 0xca, 0xd1, 0x3e, 0x44, 0x49, 0x06, 0x9c, 0xea, 0x37, 0x96, 0x9e, 0x73,
+	0xbc, 0xdf, 0x28, 0x93, 0x71, 0x63, 0x80, 0x9f, 0xc0, 0x05, 0x20, 0xb2, 0x2e, 0x00, 0xcb, 0x85,
+	0xcd, 0xcd, 0x81, 0x37, 0x80, 0xbb, 0x99, 0x1b, 0xc0, 0x4a, 0x71, 0x2c, 0xf7, 0xbc, 0x02, 0xb8,
+	0x29, 0xa9, 0x45, 0x5d, 0x1a, 0x33, 0xd4, 0x7a, 0xa5, 0x88, 0x2e, 0x5c, 0x91, 0xe4, 0xe6, 0x4e,
+	0xbd, 0x72, 0x7f, 0xba, 0xa6, 0x7e, 0x82, 0x66, 0xe4, 0xfd, 0x1b, 0x87, 0x9c, 0x35, 0x64, 0x9c,
+	0x8f, 0xc2, 0x16, 0xbb, 0xee, 0xb9, 0x17, 0x48, 0x25, 0xdd, 0xed, 0xca, 0xcb, 0xb8, 0x6a, 0xa9,
+	0xb5, 0xdd, 0x2e, 0x05, 0x06, 0x79, 0xd8, 0xef, 0xaa, 0x5f, 0x74, 0xc8, 0x39, 0x6b, 0x31, 0xee,
+	0xd2, 0xb0, 0x45, 0xc3, 0xe6, 0x2e, 0x7e, 0x5a, 0xe8, 0x77, 0xfa, 0x3e, 0x8d, 0x29, 0x18, 0x18,
+	0xc4, 0x7d, 0x81, 0x8c, 0x25, 0xb4, 0x4d, 0x9b, 0x69, 0x14, 0x8b, 0x91, 0xf7, 0xd6, 0x21, 0xef,
+	0x5e, 0xfe, 0x3a, 0x6d, 0x37, 0x44, 0xd5, 0xb9, 0x09, 0xbc, 0x7c, 0xc9, 0x5f, 0xa0, 0x48, 0x7a,
+	0xdf, 0xe7, 0x90, 0x47, 0xf3, 0xf7, 0x09, 0xf7, 0x0d, 0x64, 0x84, 0x2b, 0x89, 0x84, 0x74, 0x7a,
+	0xb4, 0xb0, 0x52, 0x10, 0x50, 0xf7, 0x22, 0xa9, 0xa9, 0x43, 0x8e, 0x68, 0xfe, 0x33, 0x02, 0xb5,
+	0xa6, 0x4f, 0x46, 0x1a, 0x47, 0x7d, 0x74, 0x79, 0xd0, 0x47, 0x7b, 0xbf, 0xed, 0x90, 0xaf, 0x19,
+	0x66, 0xf7, 0x3a, 0x3e, 0x19, 0x1b, 0xe4, 0x5c, 0x8b, 0x6e, 0xf8, 0xbd, 0x76, 0x6a, 0x73, 0x14,
+	0x42, 0x3f, 0x25, 0x2a, 0x9f, 0x5b, 0xc8, 0x43, 0x82, 0xfc, 0xba, 0xde, 0xbf, 0x73, 0xc8, 0x94,
+	0xf1, 0x59, 0x27, 0x70, 0xb7, 0x0e, 0xed, 0xbb, 0xf5, 0x62, 0x61, 0x2b, 0xc8, 0x80, 0xcb, 0xf5,
+	0x77, 0x39, 0xe4, 0xbc, 0x81, 0xb5, 0xec, 0xa7, 0xcd, 0xad, 0x4b, 0xf7, 0xba, 0x31, 0x4d, 0x12,
+	0x1c, 0x52, 0x4f, 0x19, 0x3b, 0xc5, 0xdc, 0xb8, 0xa0, 0x50, 0xbe, 0x4e, 0x77, 0xf9, 0xb6, 0xf1,
+	0xf5, 0x64, 0x8c, 0x2f, 0x07, 0x62, 0xac, 0xd7, 0xf4, 0xb7, 0xad, 0x88, 0x72, 0x50, 0x18, 0xae,
+	0x47, 0x46, 0xd8, 0x76, 0x80, 0xcb, 0x23, 0x9e, 0xf6, 0x08, 0xf6, 0xfb, 0x2d, 0x56, 0x02, 0x02,
+	0xe2, 0x25, 0x96, 0x38, 0xab, 0x31, 0x65, 0xe3, 0xa1, 0x75, 0x39, 0xa0, 0xed, 0x56, 0x82, 0xf7,
+	0x7e, 0x3f, 0x0c, 0xa3, 0x54, 0x5c, 0xe1, 0x8d, 0x7b, 0xff, 0xac, 0x2e, 0x06, 0x13, 0x07, 0x99,
+	0xb6, 0x71, 0x62, 0xf1, 0x16, 0x15, 0x4c, 0xd9, 0x54, 0x4b, 0x40, 0x40, 0xbc, 0x57, 0x4a, 0x64,
+	0xd2, 0xe0, 0xda, 0xa0, 0x27, 0xa1, 0x9e, 0x8a, 0xad, 0xdd, 0x69, 0xb5, 0xb8, 0xad, 0x82, 0x0e,
+	0x56, 0x51, 0xbd, 0x9c, 0xd9, 0xa0, 0xa0, 0x50, 0xae, 0x7b, 0xab, 0xa9, 0x7e, 0xa8, 0x4c, 0xa6,
+	0xed, 0x0a, 0x7d, 0xfb, 0x1b, 0xea, 0x44, 0x0c, 0x46, 0x59, 0x65, 0xae, 0x81, 0x0f, 0x26, 0xde,
+	0x80, 0x2d, 0xa2, 0x74, 0x9c, 0x5b, 0x84, 0xb9, 0x83, 0x95, 0xf7, 0xd9, 0xc1, 0xe6, 0x55, 0xab,
+	0x57, 0x18, 0xe6, 0xd7, 0xf5, 0x69, 0x80, 0x1f, 0x5f, 0x8d, 0xa3, 0x4d, 0x36, 0xe7, 0x76, 0x28,
+	0xde, 0x89, 0x73, 0xb4, 0xbb, 0x17, 0x48, 0x25, 0x49, 0x69, 0xb7, 0x5e, 0xb5, 0xd7, 0xe0, 0x46,
+	0x4a, 0xbb, 0xc0, 0x20, 0xee, 0xbb, 0xc9, 0x54, 0xea, 0xc7, 0x9b, 0x34, 0x8d, 0xe9, 0x4e, 0xc0,
+	0x5e, 0x05, 0x98, 0x82, 0xa3, 0x36, 0xf7, 0x08, 0x9e, 0x16, 0xd7, 0x18, 0x08, 0x24, 0x08, 0xb2,
+	0xb8, 0xde, 0x7f, 0x28, 0x91, 0xc7, 0xec, 0xfe, 0xd1, 0x1b, 0xfa, 0x37, 0x5b, 0x1b, 0xfa, 0xd7,
+	0x99, 0x1b, 0xfa, 0xab, 0xf7, 0xa7, 0x9f, 0x18, 0x50, 0xed, 0x2b, 0x66, 0xbf, 0x77, 0xaf, 0x64,
+	0x7a, 0xe8, 0x62, 0x5f, 0x0f, 0x3d, 0x35, 0xe0, 0x1b, 0x33, 0x07, 0xb1, 0x37, 0x90, 0x91, 0x98,
+	0xfa, 0x49, 0x14, 0x8a, 0x7e, 0x52, 0x93, 0x01, 0x58, 0x29, 0x08, 0xa8, 0xf7, 0xe5, 0xf1, 0x6c,
+	0x63, 0x5f, 0xe1, 0x2f, 0x1d, 0x51, 0xec, 0x06, 0xa4, 0xc2, 0xae, 0xf1, 0x7c, 0xd9, 0xb9, 0x7e,
+	0xb4, 0x29, 0x8a, 0x5b, 0x8c, 0x22, 0x3d, 0x37, 0x86, 0xbd, 0x86, 0x45, 0xc0, 0x58, 0xb8, 0xf7,
+	0xc8, 0x58, 0x53, 0x5e, 0x98, 0x4b, 0x45, 0x28, 0xad, 0xc5, 0x75, 0x59, 0x73, 0x64, 0xc7, 0x18,
+	0x75, 0xcb, 0x56, 0xdc, 0x5c, 0x4a, 0xca, 0x9b, 0x41, 0x2a, 0xba, 0xf5, 0x88, 0xfa, 0x93, 0x2b,
+	0x81, 0xf1, 0x89, 0xa3, 0xb8, 0x41, 0x5d, 0x09, 0x52, 0x40, 0xfa, 0xee, 0xa7, 0x1c, 0x32, 0x9e,
+	0x34, 0x3b, 0xab, 0x71, 0xb4, 0x13, 0xb4, 0x68, 0x5c, 0xaf, 0x14, 0xb1, 0xec, 0x35, 0xe6, 0x97,
+	0x25, 0x41, 0xcd, 0x97, 0xeb, 0xb3, 0x34, 0x04, 0x4c, 0xbe, 0x78, 0x67, 0x7c, 0x4c, 0x7c, 0xfb,
+	0x02, 0x6d, 0xb2, 0x19, 0x27, 0xf5, 0x22, 0xf5, 0x6a, 0x11, 0x77, 0x85, 0x85, 0x5e, 0x73, 0x1b,
+	0xe7, 0x9b, 0x16, 0xe8, 0x89, 0x57, 0xee, 0x4f, 0x3f, 0x36, 0x9f, 0xcf, 0x13, 0x06, 0x09, 0xc3,
+	0x1a, 0xac, 0xdb, 0x6b, 0xb7, 0x81, 0xbe, 0xd4, 0xa3, 0x4c, 0x45, 0x5a, 0x40, 0x83, 0xad, 0x6a,
+	0x82, 0x99, 0x06, 0x33, 0x20, 0x60, 0xf2, 0x75, 0x5f, 0x22, 0x23, 0x1d, 0x3f, 0x8d, 0x83, 0x7b,
+	0xf5, 0xd1, 0x22, 0x6e, 0x6f, 0xcb, 0x8c, 0x96, 0x66, 0xce, 0x4e, 0x01, 0xbc, 0x10, 0x04, 0x23,
+	0x7c, 0xd6, 0xe8, 0xd0, 0x78, 0x93, 0xd6, 0xc7, 0x8a, 0x78, 0x30, 0x5a, 0x46, 0x52, 0x9a, 0x61,
+	0x0d, 0x4f, 0x5e, 0xac, 0x0c, 0x38, 0x17, 0xeb, 0x9e, 0x50, 0x2b, 0xfc, 0x9e, 0x80, 0x0d, 0xd8,
+	0x6d, 0xf7, 0x36, 0x83, 0xb0, 0x4e, 0x8a, 0x68, 0xc0, 0x55, 0x46, 0x2b, 0xd3, 0x80, 0xbc, 0x10,
+	0x04, 0x23, 0xf7, 0x7b, 0x1c, 0x32, 0x29, 0xc6, 0x95, 0x78, 0x19, 0xad, 0x8f, 0x33, 0xde, 0x37,
+	0x0b, 0x59, 0x54, 0x04, 0x4d, 0x2d, 0x83, 0xfb, 0xca, 0xfd, 0xe9, 0x49, 0x1b, 0x08, 0x19, 0x01,
+	0xdc, 0x5d, 0x32, 0xe6, 0xc7, 0x69, 0xb0, 0xe1, 0x37, 0xd3, 0xfa, 0x44, 0x21, 0x97, 0x72, 0x41,
+	0x2d, 0xb3, 0xc4, 0xc9, 0x62, 0x50, 0xec, 0xbc, 0x7f, 0xef, 0x10, 0xd7, 0x5e, 0xe3, 0x4f, 0xe0,
+	0xfe, 0xf0, 0x92, 0x7d, 0x7f, 0x58, 0x2a, 0xf2, 0x80, 0x37, 0xe0, 0x0a, 0xf1, 0xcf, 0xc6, 0x49,
+	0x66, 0x77, 0xbc, 0x41, 0x93, 0x94, 0xb6, 0x5e, 0xdb, 0xd1, 0x5e, 0xdb, 0xd1, 0x5e, 0xdb, 0xd1,
+	0xe4, 0x0f, 0x77, 0x3d, 0xb3, 0xa3, 0xbd, 0xc7, 0x98, 0xf5, 0xda, 0x90, 0xe7, 0x45, 0x65, 0xe9,
+	0x63, 0x4a, 0x60, 0x20, 0xe0, 0x4a, 0x70, 0xad, 0xb1, 0x72, 0x23, 0x77, 0x0b, 0x7b, 0xd1, 0xde,
+	0xc2, 0x8e, 0xca, 0xe2, 0xb5, 0x4d, 0xeb, 0xff, 0xb8, 0x4d, 0xeb, 0x57, 0x1c, 0xf2, 0x46, 0x7b,
+	0x31, 0x97, 0x13, 0x69, 0x71, 0x33, 0x8c, 0x62, 0xba, 0x10, 0x6c, 0x6c, 0xd0, 0x98, 0x86, 0xf8,
+	0x0a, 0xb7, 0xbf, 0x2e, 0xf4, 0x6d, 0x64, 0xe2, 0x4e, 0x12, 0x85, 0xab, 0x51, 0x10, 0x8a, 0x15,
+	0x19, 0xef, 0xa3, 0xa7, 0xd1, 0x32, 0x02, 0x07, 0x98, 0x2c, 0x07, 0x0b, 0xcb, 0x9d, 0x27, 0x67,
+	0xee, 0xbc, 0xb4, 0xea, 0xa7, 0x86, 0x22, 0x4a, 0xaa, 0x8c, 0xd8, 0xf3, 0xf5, 0xb5, 0xe7, 0x33,
+	0x40, 0xe8, 0xc7, 0xf7, 0xfe, 0xb8, 0x94, 0xdd, 0x95, 0x20, 0x6a, 0xb7, 0xa3, 0x5e, 0x3a, 0x1b,
+	0xfa, 0xed, 0xdd, 0x24, 0x48, 0xdc, 0x8f, 0x92, 0xca, 0x56, 0x9a, 0x76, 0xc5, 0xae, 0xf4, 0x62,
+	0x91, 0x3b, 0xa5, 0x60, 0x75, 0x75, 0x6d, 0x6d, 0x55, 0xb2, 0xe3, 0x3b, 0x15, 0x96, 0x00, 0x63,
+	0xeb, 0xfe, 0xff, 0x0e, 0x21, 0xdd, 0x38, 0xea, 0xd0, 0x74, 0x8b, 0xf6, 0xe4, 0x66, 0x45, 0x8f,
+	0x41, 0x8a, 0x55, 0xc5, 0x44, 0xc9, 0x32, 0x89, 0x5a, 0x29, 0x5d, 0x0e, 0x86, 0x20, 0xa8, 0xd3,
+	0x63, 0xfd, 0xb0, 0xe3, 0xb7, 0x85, 0x66, 0x43, 0x9d, 0x37, 0x16, 0x45, 0x39, 0x28, 0x0c, 0xef,
+	0x5f, 0x3b, 0xe4, 0x99, 0x3d, 0x9b, 0x19, 0x68, 0xd2, 0x6b, 0x33, 0xbd, 0x6f, 0xd7, 0x4f, 0x12,
+	0xda, 0x62, 0xcd, 0x3d, 0xa6, 0x2f, 0xc6, 0xab, 0xac, 0x14, 0x04, 0xf4, 0x20, 0x8a, 0x82, 0x0f,
+	0x90, 0x5a, 0x73, 0x8b, 0x36, 0xb7, 0x69, 0x6b, 0x36, 0x3d, 0x84, 0x7e, 0x40, 0xa9, 0x93, 0xe7,
+	0x25, 0x11, 0xd0, 0xf4, 0xbc, 0xdf, 0x2b, 0x91, 0x27, 0x73, 0xbf, 0xeb, 0xb2, 0x1f, 0xb4, 0x7b,
+	0x31, 0x3d, 0xac, 0xaa, 0x4a, 0xaa, 0x71, 0x4a, 0x03, 0xd5, 0x38, 0x07, 0x50, 0x2c, 0xbd, 0x97,
+	0x8c, 0x6d, 0xf8, 0x41, 0x9b, 0x35, 0x40, 0xe5, 0xc0, 0x0d, 0xa0, 0xba, 0xf5, 0xb2, 0xa0, 0x01,
+	0x8a, 0x9a, 0x3b, 0x43, 0x48, 0x1c, 0xb5, 0xdb, 0xb4, 0x35, 0xe7, 0x37, 0xb7, 0x99, 0x99, 0x4f,
+	0x8d, 0x0f, 0x1a, 0x50, 0xa5, 0x60, 0x60, 0xe0, 0xa0, 0x91, 0x9a, 0xa4, 0xfa, 0x88, 0x3d, 0x68,
+	0xa4, 0xca, 0x09, 0x14, 0x86, 0x37, 0x47, 0x5e, 0xbf, 0xef, 0x7c, 0x41, 0xd5, 0x73, 0x2f, 0x6e,
+	0x67, 0x55, 0xcf, 0x37, 0x61, 0x09, 0xb0, 0xdc, 0xdb, 0x25, 0x6f, 0xcc, 0xa5, 0xd1, 0x3f, 0xda,
+	0xb1, 0x45, 0xfd, 0x56, 0x0b, 0x57, 0x86, 0xba, 0x63, 0xb7, 0xe8, 0x2c, 0x2f, 0x06, 0x09, 0x77,
+	0x9f, 0x21, 0xd5, 0x97, 0x7a, 0x34, 0xde, 0x15, 0xfd, 0xa3, 0x0e, 0xbc, 0xcf, 0x63, 0x21, 0x70,
+	0x98, 0xf7, 0xbf, 0xca, 0xe4, 0xf1, 0x5c, 0xde, 0xd8, 0x8b, 0xee, 0x0f, 0x3b, 0xe4, 0x74, 0xc7,
+	0x56, 0xa3, 0x27, 0xe2, 0x7d, 0xf8, 0xbd, 0x85, 0xcd, 0xee, 0x8c, 0x9e, 0x5e, 0x3f, 0x6a, 0x67,
+	0x00, 0x09, 0xf4, 0xc9, 0xe2, 0xbe, 0x40, 0x6a, 0x1d, 0xff, 0xde, 0xcd, 0x6e, 0xcb, 0x4f, 0xa5,
+	0x92, 0x74, 0xb0, 0x6e, 0xbb, 0x97, 0x06, 0xed, 0x19, 0x6e, 0x8c, 0x3b, 0xb3, 0x18, 0xa6, 0x2b,
+	0x71, 0x23, 0x8d, 0x83, 0x70, 0x93, 0xbf, 0x0a, 0x2e, 0x4b, 0x32, 0xa0, 0x29, 0xe2, 0x50, 0x58,
+	0xf7, 0xb7, 0xa9, 0xd2, 0xda, 0x19, 0x43, 0x61, 0x4e, 0x94, 0x83, 0xc2, 0x40, 0x6c, 0xbf, 0xdb,
+	0x8d, 0x23, 0x5c, 0x6d, 0x2a, 0x6c, 0x65, 0x50, 0xd8, 0xb3, 0xa2, 0x1c, 0x14, 0x06, 0x9e, 0xe5,
+	0xc6, 0x7c, 0xd1, 0xad, 0xe2, 0x94, 0xf9, 0x81, 0x63, 0x58, 0x31, 0xd5, 0x3a, 0xc9, 0x77, 0x49,
+	0xf1, 0x0b, 0x14, 0x6b, 0xef, 0x2f, 0x2a, 0x64, 0x3a, 0xb7, 0x26, 0x8e, 0x80, 0x86, 0xad, 0xb0,
+	0x75, 0x06, 0xce, 0xf4, 0x65, 0xa5, 0x75, 0xe4, 0xa3, 0xed, 0xed, 0x7d, 0x5a, 0xc7, 0x67, 0xf6,
+	0x61, 0x32, 0xc8, 0xfe, 0xb7, 0x7c, 0x28, 0x1d, 0x6b, 0xe5, 0x58, 0x75, 0xac, 0xb7, 0x49, 0x8d,
+	0x5b, 0x30, 0xef, 0xce, 0xa6, 0xf5, 0xea, 0x81, 0x59, 0xb1, 0x01, 0x77, 0x55, 0x12, 0x00, 0x4d,
+	0xcb, 0x7d, 0x3f, 0x21, 0x7c, 0x80, 0xb0, 0x75, 0x70, 0xe4, 0xc0, 0x94, 0xd9, 0xba, 0x36, 0xab,
+	0x28, 0x80, 0x41, 0xcd, 0xfd, 0xac, 0x39, 0xe0, 0xf8, 0xb9, 0xdd, 0x3f, 0xc6, 0x01, 0xc7, 0x37,
+	0xcb, 0x81, 0xc3, 0xee, 0xe3, 0x83, 0xce, 0x34, 0x8d, 0x34, 0xf6, 0x53, 0xba, 0xb9, 0xeb, 0x7e,
+	0x84, 0x54, 0x71, 0x68, 0xc9, 0x05, 0xe7, 0xf6, 0x31, 0xc8, 0x8a, 0xa3, 0x4f, 0x2f, 0x8c, 0xf8,
+	0x2b, 0x01, 0xce, 0xd4, 0xf5, 0xc9, 0xa9, 0x0d, 0xbe, 0x3d, 0xae, 0x46, 0xed, 0xa0, 0x29, 0x57,
+	0xd1, 0x77, 0x49, 0x93, 0xb6, 0xcb, 0x26, 0xf0, 0xd5, 0xfb, 0xd3, 0xde, 0x5e, 0x3b, 0x2c, 0xc7,
+	0x02, 0x9b, 0xa2, 0xf7, 0xc3, 0xb5, 0xac, 0x52, 0x85, 0x19, 0x04, 0x3f, 0x4b, 0xc8, 0x66, 0xb4,
+	0x46, 0x3b, 0xdd, 0x36, 0x2e, 0x6a, 0xfc, 0x88, 0xa1, 0x9e, 0xdf, 0xae, 0x28, 0x08, 0x18, 0x58,
+	0xee, 0x67, 0x1c, 0x42, 0x36, 0xe5, 0x81, 0x58, 0x2a, 0x4c, 0x6e, 0x16, 0xd9, 0x62, 0xc6, 0xa9,
+	0x5f, 0xc9, 0xa2, 0x18, 0x82, 0xc1, 0xdc, 0xfd, 0x76, 0x87, 0x8c, 0xa5, 0x52, 0x7c, 0x7e, 0x96,
+	0x59, 0x2b, 0x52, 0x12, 0xf9, 0xd1, 0x7a, 0x75, 0x55, 0x4d, 0xa2, 0xf8, 0xba, 0xff, 0xaf, 0x43,
+	0x08, 0x1a, 0x61, 0x8a, 0xce, 0xe3, 0xcb, 0xc1, 0xad, 0x42, 0x9f, 0x08, 0x15, 0x75, 0x3e, 0xeb,
+	0xf4, 0x6f, 0x30, 0x38, 0xbb, 0x1f, 0x23, 0x63, 0x89, 0x18, 0xd1, 0xf5, 0x6a, 0xf1, 0x8d, 0x21,
+	0x67, 0x8b, 0xb8, 0x86, 0x8a, 0x5f, 0xa0, 0x78, 0xba, 0x7f, 0xc9, 0x21, 0x53, 0x5d, 0xfb, 0xe9,
+	0x59, 0xac, 0x2b, 0xc5, 0xed, 0xe0, 0x99, 0xa7, 0x6d, 0xfe, 0x48, 0x97, 0x29, 0x84, 0xac, 0x14,
+	0x78, 0x35, 0xd2, 0x23, 0x78, 0xa5, 0xcb, 0x9f, 0xc1, 0x47, 0xf5, 0xd5, 0xe8, 0x4a, 0x16, 0x08,
+	0xfd, 0xf8, 0xee, 0x2a, 0x39, 0x8b, 0xd2, 0xed, 0x72, 0x35, 0x9d, 0xbc, 0x86, 0x27, 0x4c, 0x69,
+	0x30, 0x36, 0xf7, 0xa4, 0x18, 0x21, 0x67, 0x67, 0x73, 0x70, 0x20, 0xb7, 0xa6, 0xfb, 0x1b, 0x0e,
+	0x79, 0x32, 0x60, 0xf7, 0x43, 0xd3, 0x08, 0x44, 0x5f, 0x15, 0x85, 0xc1, 0x6e, 0xb1, 0xb7, 0x9b,
+	0x41, 0xf7, 0xd2, 0xb9, 0xaf, 0x11, 0x5f, 0xf0, 0xe4, 0xe2, 0x1e, 0x22, 0xc1, 0x9e, 0x02, 0xbb,
+	0xef, 0x24, 0xa7, 0xe4, 0xbc, 0x58, 0xc5, 0x03, 0x14, 0x53, 0x48, 0xd4, 0xe6, 0xce, 0xe0, 0x32,
+	0xb6, 0x66, 0x02, 0xc0, 0xc6, 0xf3, 0x7e, 0x61, 0x84, 0x9c, 0xcd, 0x0e, 0x37, 0xb6, 0x3d, 0xe3,
+	0x72, 0xd3, 0x94, 0xcf, 0x86, 0x72, 0x81, 0x2e, 0x74, 0xb9, 0x51, 0x8f, 0x92, 0x7a, 0xb9, 0x51,
+	0x45, 0x09, 0x18, 0xcc, 0x51, 0x79, 0x77, 0xc6, 0xcf, 0xbe, 0xbe, 0x8b, 0x15, 0xf0, 0x85, 0x22,
+	0x45, 0xea, 0x37, 0x61, 0x7b, 0x5c, 0x88, 0x76, 0xa6, 0x0f, 0x04, 0xfd, 0x22, 0xb9, 0x1f, 0x25,
+	0xb5, 0x58, 0x59, 0xc8, 0x97, 0x8b, 0x50, 0x69, 0xcb, 0x61, 0x23, 0xc4, 0x51, 0xb7, 0x40, 0x6d,
+	0x0b, 0xaf, 0x39, 0xba, 0xef, 0x21, 0x93, 0xea, 0xc7, 0x3c, 0xb3, 0x26, 0xc2, 0x45, 0xb1, 0x3c,
+	0xf7, 0xa8, 0xa8, 0x35, 0x09, 0x16, 0x14, 0x32, 0xd8, 0xb8, 0x90, 0x4c, 0xc4, 0x7a, 0xdb, 0x4c,
+	0xea, 0xd5, 0xe2, 0x9b, 0xb8, 0xef, 0x50, 0xa8, 0xbd, 0x4f, 0x0c, 0x50, 0x02, 0x96, 0x20, 0xee,
+	0x0f, 0x38, 0x64, 0x32, 0xb6, 0xf6, 0x5b, 0xb1, 0xc2, 0xbd, 0xff, 0x18, 0x64, 0x13, 0x1c, 0xb8,
+	0xee, 0xcb, 0x2e, 0x83, 0x8c, 0x14, 0xde, 0xa7, 0x4b, 0xe4, 0xd1, 0xec, 0xfc, 0x11, 0xcb, 0xf2,
+	0xfe, 0x66, 0x85, 0xdf, 0xed, 0x90, 0x71, 0xa4, 0x17, 0x84, 0x9b, 0xb8, 0xb5, 0xd4, 0x4b, 0xc7,
+	0x76, 0x45, 0x50, 0x7b, 0x08, 0x53, 0xfa, 0x82, 0xe6, 0x09, 0xa6, 0x00, 0xee, 0xbb, 0xc8, 0xa9,
+	0x16, 0x6d, 0x53, 0xac, 0xbb, 0x12, 0xa3, 0xba, 0x9e, 0x9f, 0xcc, 0x95, 0x91, 0xff, 0x82, 0x09,
+	0x04, 0x1b, 0x17, 0x1d, 0xbb, 0xea, 0x83, 0xf6, 0x4f, 0x97, 0x92, 0x27, 0xe4, 0xe6, 0xa0, 0x06,
+	0xe1, 0x4a, 0x28, 0xe9, 0x89, 0x23, 0xd0, 0x33, 0x82, 0xcf, 0x13, 0xab, 0x83, 0x51, 0x61, 0x2f,
+	0x3a, 0xee, 0xfb, 0xc9, 0x69, 0xa3, 0x51, 0x12, 0xd5, 0xaa, 0xb5, 0xb9, 0x19, 0xbc, 0x6e, 0xce,
+	0x66, 0x60, 0xaf, 0xde, 0x9f, 0x7e, 0x34, 0x5b, 0x26, 0x36, 0xf8, 0x3e, 0x3a, 0xde, 0x8f, 0xf7,
+	0x75, 0xb5, 0x3a, 0x9b, 0x7d, 0xd1, 0xe9, 0x7b, 0x25, 0x7b, 0xef, 0x71, 0x9c, 0x87, 0xd8, 0x7b,
+	0x9a, 0xb2, 0xa8, 0x1f, 0x8c, 0xf3, 0x00, 0xad, 0x8a, 0xbd, 0x7f, 0x5e, 0x21, 0x7b, 0x48, 0x36,
+	0x84, 0x16, 0xf6, 0xc0, 0xb6, 0x94, 0x9f, 0x73, 0x94, 0xd1, 0x1c, 0x5f, 0x73, 0x5b, 0xc7, 0xd5,
+	0xf6, 0xfc, 0x5d, 0x20, 0xe1, 0x96, 0xed, 0x4a, 0x27, 0x68, 0x9b, 0xe7, 0xb9, 0x3f, 0xe2, 0xd8,
+	0x66, 0x7f, 0xdc, 0xf3, 0x2d, 0x38, 0x36, 0x99, 0x0c, 0x5b, 0x42, 0x2e, 0x98, 0x56, 0xeb, 0x0d,
+	0xb2, 0x32, 0x9c, 0x21, 0x64, 0x23, 0x08, 0xfd, 0x76, 0xf0, 0x32, 0x8d, 0xf9, 0x2a, 0x2f, 0xf4,
+	0x65, 0x97, 0x55, 0x29, 0x18, 0x18, 0xe7, 0xff, 0x1f, 0x32, 0x6e, 0x7c, 0x79, 0x8e, 0x41, 0xfe,
+	0x59, 0xd3, 0x20, 0xbf, 0x66, 0xd8, 0xd1, 0x9f, 0x7f, 0x0f, 0x39, 0x9d, 0x15, 0xf0, 0x20, 0xf5,
+	0xbd, 0xef, 0x24, 0x59, 0xdd, 0xc5, 0x1a, 0x8d, 0x3b, 0x28, 0xda, 0x6b, 0x0f, 0xb6, 0xaf, 0x3d,
+	0xd8, 0xbe, 0xf6, 0x60, 0x6b, 0x9a, 0x20, 0x89, 0xc7, 0xc8, 0xd1, 0x93, 0x7a, 0x8c, 0x34, 0x9f,
+	0x57, 0xc7, 0x8a, 0x7f, 0x5e, 0xcd, 0x79, 0xeb, 0xac, 0x3d, 0x4c, 0x6f, 0x9d, 0xe4, 0x64, 0xdf,
+	0x3a, 0x3f, 0xd5, 0x67, 0xa0, 0xb3, 0x16, 0x53, 0xea, 0x46, 0xa4, 0x1a, 0x46, 0x2d, 0x2a, 0xaf,
+	0x68, 0xd7, 0x8a, 0xb9, 0x6f, 0xdc, 0x88, 0x5a, 0x86, 0x8b, 0x35, 0xfe, 0x4a, 0x80, 0xf3, 0xf1,
+	0xfe, 0x7c, 0x84, 0x58, 0xb7, 0x21, 0x3e, 0x0d, 0x30, 0x42, 0x05, 0xed, 0x46, 0x37, 0x61, 0x29,
+	0xfb, 0x6a, 0x01, 0xbc, 0x18, 0x24, 0x1c, 0x8f, 0x00, 0x5d, 0x3f, 0xdd, 0xca, 0x3e, 0x2a, 0xe1,
+	0x93, 0x28, 0x30, 0x08, 0x5e, 0x64, 0x52, 0xcb, 0x00, 0x58, 0x18, 0xba, 0xaa, 0x8b, 0x8c, 0x6d,
+	0x1e, 0x0c, 0x19, 0x6c, 0xf7, 0x25, 0x52, 0xd9, 0xa2, 0xed, 0x8e, 0x98, 0x09, 0x8d, 0xe2, 0xb6,
+	0x5e, 0xf6, 0xad, 0x57, 0x69, 0xbb, 0x23, 0xde, 0x47, 0x69, 0xbb, 0x03, 0x8c, 0x15, 0x2e, 0x03,
+	0xb5, 0xed, 0x5e, 0x92, 0x46, 0x9d, 0xe0, 0x65, 0x69, 0xd0, 0xf0, 0xde, 0x82, 0x19, 0x5f, 0x97,
+	0xf4, 0xb9, 0x7a, 0x59, 0xfd, 0x04, 0xcd, 0x99, 0xc9, 0xd1, 0x0a, 0x62, 0x36, 0x83, 0x76, 0xeb,
+	0xe4, 0x58, 0xe4, 0x58, 0x90, 0xf4, 0xb9, 0x1c, 0xea, 0x27, 0x68, 0xce, 0xee, 0xae, 0x5a, 0x8e,
+	0x0a, 0xb1, 0x4f, 0xe8, 0x93, 0x81, 0x2f, 0x45, 0xb9, 0xcb, 0xd2, 0x33, 0xa4, 0xda, 0xdc, 0xf2,
+	0x63, 0x6e, 0x8c, 0x60, 0xbc, 0x8a, 0xcd, 0x63, 0x21, 0x70, 0x18, 0xbe, 0xd7, 0xc5, 0x74, 0xa3,
+	0x7e, 0xca, 0x7e, 0xaf, 0x03, 0xba, 0x01, 0x58, 0xae, 0x8e, 0xa9, 0x93, 0x03, 0x8f, 0xa9, 0x1d,
+	0x52, 0x6e, 0xf6, 0x68, 0x7d, 0xaa, 0x68, 0xcf, 0x04, 0xf6, 0x75, 0xf3, 0x37, 0x2f, 0xf1, 0x7d,
+	0x79, 0xfe, 0xe6, 0x25, 0x40, 0x3e, 0x78, 0xbb, 0x3a, 0x9b, 0x87, 0x86, 0x13, 0xaf, 0xeb, 0x37,
+	0xb7, 0xf1, 0x1d, 0x25, 0x33, 0xf1, 0x56, 0x79, 0x31, 0x48, 0x38, 0xaa, 0x9d, 0xa9, 0x7a, 0x59,
+	0x13, 0xd3, 0x4f, 0xe9, 0x5e, 0xf4, 0x9b, 0x1b, 0x18, 0x58, 0xee, 0x06, 0xa9, 0xa4, 0xfe, 0xa6,
+	0x3c, 0x59, 0x2f, 0x1c, 0x71, 0xe5, 0xbd, 0x79, 0x69, 0xcd, 0xdf, 0x34, 0xee, 0xc2, 0xfe, 0x66,
+	0x02, 0x8c, 0xbe, 0xf7, 0xa3, 0x25, 0x72, 0xbe, 0xef, 0xfb, 0xd4, 0xc8, 0xe2, 0xcb, 0x4b, 0xb3,
+	0x17, 0x27, 0x52, 0x5d, 0x6e, 0x2c, 0x2f, 0xac, 0x18, 0x24, 0xdc, 0xfd, 0x84, 0x43, 0x46, 0xd1,
+	0x40, 0x23, 0xa4, 0x69, 0xbd, 0x54, 0xb4, 0x52, 0x98, 0x89, 0x75, 0x8d, 0x53, 0xd7, 0x32, 0x88,
+	0x02, 0x90, 0x7c, 0x51, 0x5c, 0x7a, 0xaf, 0xd9, 0xee, 0xb5, 0xfa, 0x1e, 0xb7, 0x2e, 0xf1, 0x62,
+	0x90, 0x70, 0x44, 0x0d, 0x42, 0x8e, 0x5a, 0xb1, 0x51, 0x17, 0x43, 0x81, 0x2a, 0xe0, 0xde, 0xcf,
+	0xd4, 0xc8, 0xb9, 0x3e, 0x61, 0x70, 0x0d, 0xc2, 0x03, 0x3d, 0x3b, 0x32, 0x5f, 0x0e, 0xda, 0x54,
+	0x3a, 0x1a, 0xb1, 0x03, 0xfd, 0x2d, 0x55, 0x0a, 0x06, 0x86, 0xfb, 0x6d, 0x84, 0x74, 0xfd, 0xd8,
+	0xef, 0x50, 0x65, 0xe7, 0x72, 0xe4, 0x73, 0x33, 0xca, 0xb1, 0x2a, 0x69, 0xea, 0x61, 0xa5, 0x8a,
+	0xd0, 0x6c, 0x43, 0xfd, 0x8f, 0xf6, 0x08, 0x31, 0x6d, 0x53, 0x3f, 0x61, 0x7e, 0xf2, 0xd9, 0x70,
+	0x22, 0xa0, 0x41, 0x60, 0xe2, 0xa1, 0x5d, 0x86, 0xf0, 0xc9, 0xaa, 0xd8, 0x0e, 0x0b, 0xb6, 0x5f,
+	0x96, 0xfb, 0x79, 0x87, 0x4c, 0xe2, 0x7e, 0xad, 0xb9, 0x0b, 0x5d, 0xd6, 0xca, 0xd1, 0x3f, 0xf2,
+	0xb2, 0x49, 0x57, 0x6f, 0x49, 0x56, 0x71, 0x02, 0x19, 0xf6, 0xd8, 0xcd, 0x3b, 0x34, 0x36, 0x2c,
+	0x0e, 0x54, 0x37, 0xdf, 0xe2, 0xc5, 0x20, 0xe1, 0xee, 0x2c, 0x99, 0xea, 0xfa, 0x49, 0x32, 0x1f,
+	0xd3, 0x16, 0x0d, 0xd3, 0xc0, 0x6f, 0xf3, 0xb7, 0xbc, 0x31, 0xed, 0x4b, 0xbd, 0x6a, 0x83, 0x21,
+	0x8b, 0xef, 0xbe, 0x8f, 0x3c, 0xc6, 0xf5, 0xc5, 0xcb, 0x41, 0x92, 0x04, 0xe1, 0xa6, 0x1e, 0x06,
+	0x42, 0x6d, 0x3e, 0x2d, 0x48, 0x3d, 0xb6, 0x98, 0x8f, 0x06, 0x83, 0xea, 0xe3, 0x13, 0x78, 0xb2,
+	0x1d, 0x74, 0xe7, 0xe3, 0x56, 0x52, 0xaf, 0xd9, 0x4f, 0xe0, 0x0d, 0x51, 0x0e, 0x0a, 0xc3, 0x6d,
+	0x92, 0x09, 0xde, 0x25, 0xdc, 0xa9, 0x4c, 0x6c, 0x48, 0x6f, 0x1e, 0x78, 0x4c, 0x14, 0x51, 0xb8,
+	0x66, 0xc0, 0xbf, 0x7b, 0x49, 0x5a, 0xf8, 0x71, 0x0b, 0xac, 0x5b, 0x06, 0x19, 0xb0, 0x88, 0xda,
+	0x1a, 0x83, 0xf1, 0x21, 0x34, 0x06, 0x6f, 0x27, 0xe3, 0xdb, 0xbd, 0x75, 0x2a, 0x5a, 0xbe, 0x3e,
+	0x61, 0x8f, 0xbe, 0xeb, 0x1a, 0x04, 0x26, 0x1e, 0xf3, 0xe7, 0xeb, 0x06, 0xe2, 0x17, 0xc6, 0x6c,
+	0xd0, 0xfe, 0x7c, 0xab, 0x8b, 0xb2, 0x18, 0x4c, 0x1c, 0x14, 0x0d, 0xdb, 0x62, 0x8d, 0x26, 0x2c,
+	0xea, 0x02, 0x36, 0x97, 0x12, 0xad, 0x21, 0x01, 0xa0, 0x71, 0xf0, 0xb5, 0x03, 0x7f, 0x34, 0x58,
+	0x14, 0xb2, 0x5b, 0x7e, 0x3b, 0x68, 0x71, 0x8b, 0x9d, 0x29, 0xfb, 0xb5, 0xa3, 0x91, 0x83, 0x03,
+	0xb9, 0x35, 0xdd, 0x8f, 0x10, 0xd2, 0x8d, 0x92, 0x14, 0xd0, 0x27, 0x38, 0xae, 0x9f, 0x2e, 0xc2,
+	0x5b, 0x80, 0xcd, 0x75, 0x45, 0x53, 0xd8, 0x67, 0xa9, 0xdf, 0x60, 0xf0, 0xc3, 0x18, 0x63, 0xf5,
+	0x41, 0x0b, 0xa8, 0x9b, 0xe0, 0x32, 0x99, 0xde, 0xf2, 0x63, 0x79, 0x7a, 0x3d, 0x62, 0xc0, 0x16,
+	0x41, 0xf7, 0x96, 0x1f, 0x9b, 0x0b, 0x2e, 0x63, 0x00, 0x92, 0x93, 0x7b, 0x87, 0x54, 0xd2, 0xb6,
+	0x5f, 0x50, 0x38, 0x28, 0x83, 0xa3, 0xde, 0xd5, 0x96, 0x66, 0x71, 0x57, 0x6b, 0xfb, 0x89, 0xfb,
+	0x24, 0x6a, 0x26, 0xd6, 0xa5, 0x39, 0xa0, 0x50, 0x26, 0xac, 0x27, 0xc0, 0x4a, 0xbd, 0xef, 0x3f,
+	0x95, 0xb3, 0xe7, 0xa9, 0x53, 0x1d, 0x6e, 0xd7, 0x38, 0x64, 0x57, 0x63, 0xba, 0x11, 0xdc, 0x13,
+	0x9b, 0xbb, 0x5a, 0x57, 0x6f, 0x28, 0x08, 0x18, 0x58, 0xb2, 0x4e, 0xa3, 0xb7, 0x81, 0x75, 0x4a,
+	0xfd, 0x75, 0x38, 0x04, 0x0c, 0x2c, 0xf7, 0x6d, 0x64, 0x24, 0xe8, 0xf8, 0x9b, 0xca, 0xd1, 0xf5,
+	0x49, 0x5c, 0x50, 0x17, 0x59, 0xc9, 0xab, 0xf7, 0xa7, 0x27, 0x95, 0x40, 0xac, 0x08, 0x04, 0xae,
+	0xfb, 0xe3, 0x0e, 0x99, 0x68, 0x46, 0x9d, 0x4e, 0x14, 0x72, 0xd5, 0x90, 0xd0, 0x73, 0xdd, 0x39,
+	0xae, 0x33, 0xef, 0xcc, 0xbc, 0xc1, 0x8c, 0x2b, 0xba, 0xd4, 0xcb, 0x81, 0x09, 0x02, 0x4b, 0x2a,
+	0x73, 0xdd, 0xad, 0xee, 0xb3, 0xee, 0xfe, 0x9c, 0x43, 0xce, 0xf0, 0xba, 0x86, 0xc6, 0x4a, 0x44,
+	0x5d, 0x8a, 0x8e, 0xf9, 0xb3, 0xfa, 0x94, 0x78, 0xea, 0xe1, 0xa9, 0x0f, 0x0e, 0xfd, 0x42, 0xba,
+	0x57, 0xc8, 0x99, 0x8d, 0x08, 0x0f, 0x84, 0x66, 0x87, 0xf0, 0x4d, 0x43, 0x11, 0xba, 0x9c, 0x45,
+	0x80, 0xfe, 0x3a, 0xee, 0x2d, 0xf2, 0xa8, 0x51, 0x68, 0xb6, 0x03, 0xdf, 0x37, 0x9e, 0x16, 0xd4,
+	0x1e, 0xbd, 0x9c, 0x8b, 0x05, 0x03, 0x6a, 0xdb, 0x4b, 0x74, 0x6d, 0x88, 0x25, 0xfa, 0x45, 0xf2,
+	0x78, 0xb3, 0xbf, 0x65, 0x76, 0x92, 0xde, 0x7a, 0xc2, 0x77, 0x91, 0xb1, 0xb9, 0xd7, 0x0b, 0x02,
+	0x8f, 0xcf, 0x0f, 0x42, 0x84, 0xc1, 0x34, 0xdc, 0x8f, 0xa0, 0x0d, 0x20, 0xeb, 0x95, 0x44, 0x84,
+	0x20, 0x3a, 0xa2, 0x26, 0x4f, 0x5f, 0xc7, 0x38, 0x59, 0xd3, 0xa6, 0x90, 0xf3, 0x01, 0xc5, 0xd1,
+	0xbd, 0x8b, 0xa7, 0xf6, 0xb4, 0xb9, 0x25, 0x62, 0x09, 0x1d, 0x79, 0x45, 0x56, 0xcc, 0xd9, 0xb3,
+	0xae, 0x79, 0x07, 0x60, 0x4c, 0x40, 0x72, 0xc3, 0x93, 0x62, 0x33, 0xea, 0x74, 0xa3, 0x90, 0x86,
+	0xa9, 0xdc, 0xc2, 0x26, 0xf9, 0xdb, 0xab, 0x2c, 0x05, 0x03, 0xa3, 0xef, 0x24, 0xa1, 0xd1, 0xea,
+	0x67, 0xf6, 0x38, 0x49, 0x18, 0xd4, 0x06, 0xd5, 0xc7, 0xad, 0x8e, 0xa9, 0xcc, 0x6f, 0x07, 0xe9,
+	0x16, 0xbe, 0x51, 0x49, 0x55, 0xd2, 0xa4, 0xbd, 0xd5, 0x2d, 0xe5, 0xe0, 0x40, 0x6e, 0xcd, 0xec,
+	0xbe, 0x3e, 0x75, 0xb8, 0x7d, 0xfd, 0xf4, 0x10, 0xfb, 0x7a, 0x83, 0x9c, 0x63, 0x12, 0x88, 0x33,
+	0xba, 0x54, 0xc8, 0x27, 0x75, 0x97, 0x09, 0xaf, 0xe2, 0x37, 0x2c, 0xe5, 0x21, 0x41, 0x7e, 0xdd,
+	0xf3, 0xdf, 0x4c, 0xce, 0xf4, 0x2d, 0x72, 0x07, 0x52, 0xb6, 0x2f, 0x90, 0x47, 0xf3, 0x97, 0x93,
+	0x03, 0xa9, 0xdc, 0x7f, 0x36, 0xe3, 0x5a, 0x6d, 0xdc, 0xb7, 0x87, 0x78, 0xbe, 0xf1, 0x49, 0x99,
+	0x86, 0x3b, 0x62, 0x77, 0xbd, 0x7c, 0xb4, 0x51, 0x7d, 0x29, 0xdc, 0xe1, 0xab, 0x21, 0xbb, 0x0b,
+	0x5f, 0x0a, 0x77, 0x00, 0x69, 0xbb, 0xdf, 0xeb, 0x58, 0xd7, 0x17, 0x7e, 0x35, 0xfd, 0xd0, 0xb1,
+	0x28, 0x18, 0x86, 0xbe, 0xd1, 0x78, 0xff, 0xa2, 0x44, 0x2e, 0xec, 0x47, 0x64, 0x88, 0xe6, 0x7b,
+	0x06, 0xad, 0x2c, 0xd1, 0x66, 0x55, 0x6c, 0x57, 0xe3, 0x38, 0x8b, 0xb9, 0x15, 0xeb, 0x8b, 0x20,
+	0x40, 0x6e, 0x9b, 0x94, 0x3b, 0x7e, 0x57, 0xbc, 0x05, 0x2c, 0x1e, 0x35, 0x74, 0x0e, 0xfe, 0xf6,
+	0xdb, 0xcb, 0x7e, 0x97, 0x8f, 0x79, 0xa3, 0x00, 0x90, 0x8d, 0x9b, 0x92, 0xaa, 0x1f, 0xc7, 0xbe,
+	0x34, 0xb1, 0xba, 0x5e, 0x0c, 0xbf, 0x59, 0x24, 0xc9, 0x2d, 0x54, 0xac, 0x22, 0xe0, 0xcc, 0xd0,
+	0x74, 0x6e, 0x2a, 0xf3, 0xde, 0xe8, 0x26, 0x64, 0x44, 0x3c, 0x01, 0x38, 0x45, 0x47, 0x2c, 0x62,
+	0x64, 0xb9, 0x3a, 0x89, 0xff, 0x0f, 0x82, 0x15, 0x1a, 0x55, 0x8e, 0x1b, 0x01, 0xca, 0xea, 0xa5,
+	0x82, 0x4d, 0xbc, 0xcc, 0x48, 0x9c, 0x66, 0x40, 0x4d, 0x59, 0x08, 0x26, 0x77, 0x11, 0xde, 0x97,
+	0xdd, 0xa5, 0xfa, 0xc3, 0xfb, 0x62, 0x31, 0x48, 0xb8, 0x7b, 0x2f, 0xc7, 0x3e, 0xae, 0x80, 0x88,
+	0x88, 0x43, 0x58, 0xc4, 0xfd, 0x88, 0x43, 0xce, 0x04, 0x59, 0x43, 0xa7, 0x7a, 0xb5, 0x08, 0x23,
+	0xcf, 0xc1, 0x76, 0x54, 0xea, 0xa0, 0xd3, 0x07, 0x82, 0x7e, 0x61, 0xdc, 0x16, 0xa9, 0x04, 0xe1,
+	0x46, 0x24, 0x8e, 0x77, 0x73, 0x47, 0x13, 0x6a, 0x31, 0xdc, 0x88, 0xf4, 0x6c, 0xc6, 0x5f, 0xc0,
+	0xa8, 0xbb, 0x4b, 0xe4, 0xac, 0x74, 0x23, 0xb8, 0x1a, 0x24, 0xa8, 0xc9, 0x5a, 0x0a, 0x3a, 0x41,
+	0xca, 0x8e, 0x66, 0xe5, 0xb9, 0x3a, 0x6e, 0x6f, 0x90, 0x03, 0x87, 0xdc, 0x5a, 0xee, 0xcb, 0x64,
+	0x54, 0x1a, 0x17, 0x8d, 0x15, 0xa1, 0xcd, 0xe8, 0x1f, 0xff, 0x6a, 0x30, 0xf1, 0xdf, 0x09, 0x48,
+	0x86, 0xee, 0xa7, 0x1d, 0x32, 0xc9, 0xff, 0xbf, 0xba, 0xdb, 0xe2, 0x21, 0x74, 0x6a, 0x45, 0x5c,
+	0x25, 0x1b, 0x16, 0x4d, 0xfe, 0x06, 0x63, 0x97, 0x41, 0x86, 0xaf, 0xfb, 0x49, 0x54, 0x71, 0xb3,
+	0x18, 0x57, 0xc9, 0x4a, 0x28, 0x62, 0x5a, 0x36, 0x0a, 0x9c, 0x8e, 0x32, 0x7a, 0x96, 0x3e, 0xa1,
+	0x2e, 0x48, 0x6e, 0xa0, 0x19, 0x7b, 0x7f, 0x73, 0x82, 0x9c, 0x99, 0xdd, 0xdb, 0x04, 0xcc, 0x39,
+	0x71, 0x13, 0xb0, 0x3b, 0xa4, 0x92, 0x68, 0x53, 0xa2, 0x02, 0x66, 0xbb, 0xe0, 0xaa, 0x2d, 0x3d,
+	0xd0, 0x68, 0x88, 0xf1, 0x70, 0x7b, 0x64, 0x84, 0x9b, 0xb5, 0xd7, 0xcb, 0x45, 0xbc, 0x38, 0x66,
+	0x22, 0x91, 0x6b, 0xdd, 0x1e, 0x2f, 0x05, 0xc1, 0xcc, 0xbd, 0x47, 0x46, 0xb7, 0xf8, 0xac, 0x10,
+	0x57, 0xce, 0xe5, 0xa3, 0xb6, 0xaf, 0x35, 0xd5, 0xf4, 0x1c, 0x10, 0x05, 0x20, 0xd9, 0x31, 0x8b,
+	0x63, 0xc3, 0x26, 0x92, 0xaf, 0x67, 0xc5, 0xa9, 0xfe, 0x87, 0x37, 0x88, 0xfc, 0x30, 0x99, 0x88,
+	0x69, 0x33, 0x0a, 0x9b, 0xc2, 0x9b, 0xea, 0xe0, 0x5e, 0x04, 0x4c, 0xa5, 0x06, 0x06, 0x0d, 0xb0,
+	0x28, 0xb2, 0xe9, 0xae, 0x42, 0xe7, 0x61, 0x87, 0x50, 0xf1, 0x98, 0xb6, 0x54, 0x50, 0xa0, 0x3e,
+	0x46, 0x93, 0x4f, 0x77, 0xbb, 0x0c, 0x32, 0x7c, 0xd1, 0x61, 0x22, 0x5a, 0xe7, 0x66, 0xc5, 0xb3,
+	0x69, 0x7d, 0xec, 0xc0, 0x9f, 0x3a, 0xc9, 0x63, 0x5a, 0x49, 0x0a, 0x60, 0x50, 0x73, 0xaf, 0x13,
+	0xc2, 0x67, 0x0e, 0x5a, 0x02, 0xd4, 0x6b, 0x56, 0xbc, 0x20, 0xd2, 0x50, 0x90, 0x57, 0xef, 0x4f,
+	0xf7, 0x2b, 0xde, 0x11, 0x00, 0x46, 0x75, 0xf7, 0x5b, 0xc9, 0x68, 0xd2, 0xeb, 0x74, 0x7c, 0xf5,
+	0xee, 0x56, 0x60, 0x94, 0x2c, 0x4e, 0xd7, 0x58, 0x9f, 0x79, 0x01, 0x48, 0x8e, 0xee, 0x1d, 0xdc,
+	0x69, 0xc4, 0x42, 0xc9, 0x67, 0x11, 0xfb, 0x5f, 0xa8, 0x43, 0xdf, 0x21, 0x2f, 0x53, 0x90, 0x83,
+	0x83, 0x56, 0x70, 0x76, 0xf9, 0x52, 0xd4, 0x14, 0x1a, 0xc5, 0x3c, 0x9a, 0xee, 0x35, 0x32, 0xae,
+	0x3f, 0x5b, 0x06, 0xc3, 0x7d, 0x93, 0x8e, 0x67, 0xce, 0x8a, 0x07, 0xb7, 0x99, 0x59, 0xd9, 0x5d,
+	0x26, 0x8f, 0x34, 0xa3, 0x30, 0x65, 0xce, 0x79, 0xb1, 0xd2, 0x04, 0x88, 0x77, 0xb9, 0x27, 0x84,
+	0xd8, 0x8f, 0xcc, 0xf7, 0xa3, 0x40, 0x5e, 0x3d, 0xbc, 0x1a, 0x64, 0xb7, 0xa9, 0xc9, 0x42, 0x2c,
+	0x58, 0x2c, 0x9a, 0x62, 0x85, 0x52, 0xba, 0xff, 0xbd, 0x37, 0x2c, 0x2f, 0xb4, 0x1f, 0xee, 0x45,
+	0x8f, 0xbd, 0x8d, 0x4c, 0xa0, 0x13, 0x7b, 0x1c, 0xfa, 0xed, 0x9b, 0xb0, 0x24, 0x5f, 0x6d, 0xd8,
+	0xc4, 0xbc, 0x64, 0x94, 0x83, 0x85, 0x85, 0x01, 0xe2, 0x84, 0xb2, 0xce, 0x08, 0x10, 0xc7, 0x95,
+	0x75, 0x52, 0x35, 0xe7, 0xfd, 0x74, 0xd9, 0x3a, 0x3a, 0x3f, 0x10, 0x33, 0x01, 0x16, 0x7d, 0x5a,
+	0x86, 0xe9, 0x66, 0x80, 0x7a, 0xa9, 0x70, 0xce, 0xca, 0x30, 0x75, 0xc5, 0x64, 0x04, 0x36, 0x5f,
+	0x77, 0x9b, 0x54, 0xb7, 0xa2, 0x24, 0x95, 0x17, 0xc5, 0x23, 0xde, 0x49, 0xaf, 0x46, 0x49, 0xca,
+	0xce, 0x7b, 0xea, 0xb3, 0xb1, 0x24, 0x01, 0xce, 0x03, 0x55, 0x10, 0xc9, 0x96, 0x1f, 0xb7, 0x2c,
+	0x03, 0x6c, 0x75, 0xac, 0x6f, 0x68, 0x10, 0x98, 0x78, 0xde, 0x9f, 0xd8, 0x21, 0x3c, 0x6f, 0x33,
+	0x2f, 0xc8, 0x1d, 0x1a, 0xe2, 0x12, 0x65, 0x9a, 0x11, 0xbf, 0x33, 0x13, 0xcc, 0xec, 0x8d, 0x83,
+	0xd2, 0x92, 0xdc, 0x45, 0x0a, 0x33, 0x8c, 0x84, 0x61, 0x71, 0xfc, 0x71, 0xc7, 0xf6, 0x03, 0x2e,
+	0x15, 0x71, 0x83, 0x34, 0xe4, 0xde, 0xdf, 0xa5, 0xd8, 0xfb, 0xaf, 0x15, 0x72, 0xa6, 0xcf, 0xe8,
+	0xe5, 0x20, 0xe6, 0x23, 0xea, 0x79, 0xbf, 0xb4, 0xc7, 0xf3, 0xfe, 0xc7, 0x1d, 0x32, 0xba, 0x11,
+	0xb4, 0x0d, 0xfd, 0xc0, 0xcd, 0x82, 0xed, 0x74, 0x2e, 0x33, 0xea, 0x5a, 0x4e, 0xfe, 0x3b, 0x01,
+	0xc9, 0xd6, 0x5d, 0x24, 0x8f, 0xc4, 0x68, 0x9b, 0xd5, 0xa3, 0xb3, 0x1b, 0x29, 0x8d, 0x1b, 0xb8,
+	0xbd, 0xb6, 0x12, 0x31, 0x22, 0x1e, 0xc3, 0x55, 0x0d, 0xfa, 0xc1, 0x90, 0x57, 0xc7, 0xf6, 0xb7,
+	0xaa, 0x3e, 0x20, 0x7f, 0xab, 0xef, 0x70, 0xd4, 0xe3, 0x2b, 0xbf, 0x34, 0x7d, 0xa0, 0xe0, 0x16,
+	0x9d, 0xe1, 0x4f, 0x7c, 0x19, 0xeb, 0x5a, 0xfb, 0x65, 0x17, 0x4d, 0x51, 0x0d, 0xb4, 0x03, 0xe9,
+	0xb5, 0xbe, 0xdf, 0x21, 0x8f, 0x0d, 0xe8, 0x46, 0xf4, 0x54, 0x12, 0xcf, 0x00, 0xf3, 0x51, 0x98,
+	0xa4, 0xb1, 0x1f, 0x84, 0xa9, 0x18, 0x89, 0xcc, 0x53, 0xe9, 0x56, 0x16, 0x08, 0xfd, 0xf8, 0xb8,
+	0xa2, 0x8b, 0x42, 0xe6, 0xd7, 0x2c, 0x63, 0x8c, 0xb2, 0xd7, 0x4b, 0xa3, 0x1c, 0x2c, 0x2c, 0xef,
+	0x7b, 0x1d, 0x32, 0x8a, 0x5e, 0xe9, 0xd1, 0xc6, 0x06, 0x3e, 0xae, 0xb6, 0x7a, 0xb1, 0xe9, 0xa3,
+	0xaf, 0x7a, 0x64, 0x41, 0x94, 0x83, 0xc2, 0xc0, 0xbd, 0x00, 0xbf, 0x45, 0x44, 0x33, 0x2d, 0xf3,
+	0xbd, 0xe0, 0x32, 0x2b, 0x01, 0x01, 0xc1, 0xf5, 0xa8, 0xe3, 0xdf, 0x93, 0x95, 0xb3, 0x0f, 0xed,
+	0xcb, 0x1a, 0x04, 0x26, 0x9e, 0xf7, 0x4f, 0x1c, 0x52, 0x9f, 0xf3, 0x93, 0xa0, 0x89, 0xb9, 0x8b,
+	0xe6, 0x82, 0x74, 0xbd, 0xd7, 0xdc, 0xa6, 0x29, 0x8f, 0x7a, 0x8b, 0x52, 0xf6, 0x12, 0x1a, 0x1b,
+	0x9a, 0x2c, 0x25, 0xe5, 0x4d, 0x51, 0x0e, 0x0a, 0xc3, 0x7d, 0x99, 0x8c, 0x77, 0xfd, 0x24, 0xb9,
+	0x1b, 0xc5, 0x2d, 0xa0, 0x1b, 0xc5, 0x84, 0xec, 0x6e, 0xd0, 0x66, 0x4c, 0x53, 0xa0, 0x1b, 0xc2,
+	0x28, 0x52, 0xd3, 0x07, 0x93, 0x99, 0xf7, 0x19, 0x87, 0x9c, 0x9d, 0xa3, 0x7e, 0x4c, 0x63, 0x16,
+	0xe1, 0x5b, 0x7d, 0x88, 0xfb, 0x12, 0x19, 0x4b, 0xb1, 0x04, 0x25, 0x72, 0x8a, 0x95, 0x88, 0xd9,
+	0xef, 0xad, 0x09, 0xe2, 0xa0, 0xd8, 0x78, 0xdf, 0xed, 0x90, 0xc7, 0xf3, 0x64, 0x99, 0x6f, 0x47,
+	0xbd, 0xd6, 0x83, 0x10, 0x68, 0x85, 0x8c, 0x70, 0x83, 0x9c, 0xa1, 0xd4, 0x92, 0xe6, 0xac, 0xd2,
+	0x8b, 0x2e, 0x9b, 0x8b, 0x62, 0x92, 0x79, 0x7f, 0xd9, 0x21, 0x13, 0x6c, 0x15, 0x5e, 0xa0, 0xa9,
+	0x1f, 0xb4, 0xfb, 0x92, 0xc6, 0x38, 0x43, 0x26, 0x8d, 0xb9, 0x40, 0x2a, 0x5b, 0x51, 0x87, 0x66,
+	0x0d, 0x04, 0xaf, 0x46, 0x28, 0x0e, 0x42, 0x50, 0x63, 0xdf, 0xc1, 0x29, 0xe7, 0x07, 0xa1, 0x5c,
+	0xe1, 0x85, 0xc6, 0x7e, 0x59, 0x17, 0x83, 0x89, 0xe3, 0xfd, 0xe3, 0x1a, 0x19, 0x15, 0xc6, 0x9d,
+	0x43, 0x87, 0x75, 0x96, 0xed, 0x52, 0x1a, 0xd8, 0x2e, 0x09, 0x19, 0x69, 0xb2, 0xcc, 0x5e, 0xf5,
+	0x72, 0x11, 0xca, 0x51, 0x21, 0x20, 0x4f, 0x16, 0xa6, 0xc5, 0xe2, 0xbf, 0x41, 0xb0, 0x72, 0xbf,
+	0xe0, 0x90, 0xa9, 0x66, 0x14, 0x86, 0xb4, 0xa9, 0x6f, 0x67, 0x95, 0x22, 0xae, 0xe0, 0xf3, 0x36,
+	0x51, 0x6d, 0x70, 0x92, 0x01, 0x40, 0x96, 0x3d, 0x7a, 0x0e, 0xf1, 0x36, 0xbb, 0x65, 0x3d, 0xb6,
+	0xea, 0xf4, 0x20, 0x26, 0x10, 0x6c, 0x5c, 0x7c, 0x93, 0x0a, 0x75, 0x6e, 0x8d, 0x11, 0xfd, 0x26,
+	0x65, 0x64, 0xd5, 0x30, 0x30, 0x30, 0x1e, 0x40, 0x4c, 0x37, 0x62, 0x9a, 0x6c, 0x09, 0xe3, 0x67,
+	0x76, 0x33, 0x1c, 0x3d, 0x5c, 0x3c, 0x00, 0xe8, 0xa3, 0x04, 0x39, 0xd4, 0xdd, 0x6d, 0xa1, 0x2f,
+	0x1c, 0x2b, 0xe2, 0xc4, 0x24, 0xba, 0x79, 0xa0, 0xda, 0x70, 0x9a, 0x54, 0xd9, 0xe1, 0x90, 0xdd,
+	0x48, 0xcb, 0x3c, 0xb0, 0x15, 0x3b, 0x3a, 0x02, 0x2f, 0x77, 0x17, 0xc8, 0xe9, 0x4c, 0xbe, 0x92,
+	0x44, 0x3c, 0x8a, 0xaa, 0xd0, 0x1a, 0x99, 0x4c, 0x27, 0x09, 0xf4, 0xd5, 0x30, 0x75, 0xc9, 0xe3,
+	0xfb, 0xe8, 0x92, 0x77, 0x95, 0x8b, 0x0d, 0x7f, 0xae, 0x7c, 0xbe, 0x90, 0x06, 0x18, 0xca, 0x9f,
+	0xe6, 0xbb, 0x32, 0xfe, 0x34, 0xa7, 0x2e, 0x94, 0x8f, 0x6e, 0xd3, 0x27, 0x05, 0x38, 0xb8, 0xf3,
+	0xcc, 0x83, 0x74, 0x86, 0xf9, 0x0b, 0x87, 0xc8, 0x7e, 0x9d, 0xf7, 0x9b, 0x5b, 0x14, 0x87, 0x4c,
+	0x8e, 0xd7, 0xa7, 0x73, 0x20, 0xaf, 0xcf, 0x8b, 0xa4, 0x86, 0xed, 0xc4, 0xab, 0xf2, 0x83, 0x84,
+	0xd2, 0x31, 0xce, 0xae, 0x2e, 0x8a, 0x5a, 0x1a, 0xc7, 0x8d, 0xc8, 0x99, 0xb6, 0x9f, 0xa4, 0x4c,
+	0x02, 0x54, 0x07, 0x1e, 0x32, 0xe2, 0x31, 0x3b, 0x57, 0x2d, 0x65, 0x09, 0x41, 0x3f, 0x6d, 0xef,
+	0x5f, 0x55, 0xc9, 0x29, 0x6b, 0x65, 0x3c, 0xe0, 0x09, 0xe4, 0xeb, 0xc9, 0x98, 0x3c, 0x14, 0x64,
+	0xe3, 0xbe, 0xab, 0x93, 0x83, 0xc2, 0xc0, 0x4d, 0x6b, 0x5d, 0x6f, 0xd3, 0xd9, 0x13, 0x93, 0xb1,
+	0x83, 0x83, 0x89, 0xc7, 0x16, 0xe5, 0xb4, 0x9d, 0xcc, 0xb7, 0x03, 0x1a, 0xa6, 0x5c, 0xcc, 0x62,
+	0x16, 0xe5, 0xb5, 0xa5, 0x86, 0x49, 0x54, 0x2f, 0xca, 0x19, 0x00, 0x64, 0xd9, 0xa3, 0xa2, 0xfc,
+	0x94, 0x7f, 0x37, 0xd1, 0xe9, 0x27, 0xeb, 0xd5, 0x22, 0x36, 0x29, 0x2b, 0xa3, 0x25, 0x7f, 0xc1,
+	0xb3, 0x8a, 0xc0, 0x66, 0x8a, 0xde, 0x91, 0x2e, 0xbd, 0x47, 0x9b, 0xd2, 0xb7, 0x47, 0xc8, 0x32,
+	0x52, 0x84, 0x8e, 0xec, 0x52, 0x1f, 0x5d, 0xbe, 0xaa, 0xf7, 0x97, 0x43, 0x8e, 0x0c, 0xee, 0x35,
+	0xe2, 0xb6, 0x82, 0xc4, 0x5f, 0x6f, 0xa3, 0xc9, 0x8a, 0xb2, 0x8c, 0xe6, 0x86, 0x33, 0xe7, 0x45,
+	0x3b, 0xbb, 0x0b, 0x7d, 0x18, 0x90, 0x53, 0x8b, 0x8d, 0xb2, 0x38, 0xba, 0xb7, 0x7b, 0x33, 0x6e,
+	0xd7, 0xc7, 0x32, 0xa3, 0x4c, 0x94, 0x83, 0xc2, 0xf0, 0xfe, 0xb4, 0xac, 0xa6, 0xb2, 0xbe, 0x05,
+	0xfb, 0x86, 0x43, 0x8d, 0x73, 0x78, 0x87, 0x1a, 0xc5, 0x37, 0xc7, 0xa9, 0xc6, 0xba, 0x4a, 0x96,
+	0x1e, 0xd0, 0x55, 0xf2, 0xdb, 0x1d, 0x2b, 0xb7, 0xc2, 0x91, 0xdd, 0xb8, 0xb3, 0x0d, 0x39, 0xcc,
+	0x4d, 0x12, 0xfb, 0x6b, 0xa3, 0xed, 0xb3, 0x28, 0xb7, 0xd9, 0x58, 0x4e, 0x97, 0x45, 0x39, 0x28,
+	0x8c, 0xa3, 0xdc, 0x3b, 0x7f, 0xaf, 0x4c, 0xc6, 0x8d, 0x1d, 0x3f, 0xf7, 0xf8, 0xe6, 0x3c, 0x64,
+	0xc7, 0xb7, 0xd2, 0x01, 0x8e, 0x6f, 0xdf, 0x46, 0x6a, 0x4d, 0xb9, 0x1b, 0x15, 0x93, 0x4c, 0x34,
+	0xbb, 0xc7, 0x19, 0xd1, 0xef, 0x64, 0x11, 0x68, 0x9e, 0x68, 0xfd, 0x66, 0x90, 0xb1, 0x34, 0x6f,
+	0x79, 0xf1, 0x1b, 0xc4, 0x8e, 0xd6, 0x5f, 0x27, 0x6b, 0x08, 0x54, 0xdd, 0xdf, 0x10, 0x08, 0xb3,
+	0x0a, 0xc9, 0xce, 0x3d, 0x81, 0x78, 0xc9, 0x77, 0xec, 0x78, 0xc9, 0x97, 0x0a, 0x69, 0xe6, 0x01,
+	0x81, 0x92, 0x7f, 0xbf, 0x4a, 0x1e, 0x1b, 0xe0, 0x2a, 0x67, 0x9b, 0xf3, 0x39, 0x43, 0x98, 0xf3,
+	0xf9, 0xc5, 0xa4, 0x19, 0xda, 0x6b, 0x65, 0xbb, 0x8c, 0xb7, 0x82, 0x97, 0x7a, 0x41, 0x4c, 0x5b,
+	0xfa, 0xd9, 0x4c, 0x5c, 0x0d, 0xc5, 0x49, 0x3f, 0x0b, 0x85, 0x9c, 0x1a, 0xec, 0x45, 0x42, 0xa7,
+	0x5e, 0x5e, 0x8d, 0xa3, 0x2e, 0x8d, 0xd3, 0xdd, 0x7a, 0x25, 0xf3, 0x22, 0xd1, 0x8f, 0x02, 0x79,
+	0xf5, 0x06, 0xa9, 0x01, 0xab, 0x47, 0x55, 0x03, 0x8e, 0x3c, 0xa0, 0xb5, 0xfb, 0x33, 0x7a, 0xed,
+	0x1e, 0xbd, 0x50, 0x3e, 0x7a, 0x84, 0xb1, 0x01, 0x43, 0xec, 0xb8, 0x95, 0x81, 0x9f, 0x71, 0xc8,
+	0xd3, 0x7b, 0x27, 0x8d, 0x44, 0x9d, 0xc7, 0x66, 0x1c, 0xf5, 0x64, 0x4c, 0x3c, 0x35, 0x4b, 0x58,
+	0x86, 0x4e, 0xe0, 0x30, 0x54, 0x11, 0x6c, 0x07, 0x61, 0x2b, 0xab, 0x22, 0xc0, 0x04, 0x9e, 0xc0,
+	0x20, 0x43, 0xa4, 0xa3, 0xba, 0x41, 0x46, 0xd1, 0x6c, 0xcf, 0x0f, 0x5b, 0xee, 0xd7, 0x92, 0xd1,
+	0x26, 0xff, 0x57, 0xbc, 0x07, 0x31, 0xfb, 0x2f, 0x01, 0x05, 0x09, 0x43, 0xbb, 0x72, 0x3f, 0xde,
+	0x94, 0x6f, 0x40, 0xcc, 0xae, 0x7c, 0x36, 0x46, 0x5f, 0x2a, 0x2c, 0xf5, 0xfe, 0x93, 0x43, 0x26,
+	0xb1, 0x4a, 0x90, 0x2e, 0xcb, 0x85, 0xe3, 0x0d, 0x64, 0xc4, 0xef, 0xa5, 0x5b, 0x51, 0x9f, 0xc6,
+	0x63, 0x96, 0x95, 0x82, 0x80, 0xa2, 0xb0, 0x2a, 0xd0, 0xa2, 0x21, 0xec, 0x02, 0x8e, 0x01, 0x06,
+	0xc1, 0x4b, 0x63, 0xd2, 0x5b, 0xcf, 0x33, 0x40, 0x6a, 0xf0, 0x62, 0x90, 0x70, 0x24, 0xb6, 0x1e,
+	0xb5, 0xe4, 0xd4, 0x51, 0xc4, 0xe6, 0xa2, 0xd6, 0x2e, 0x30, 0x08, 0x7a, 0xe1, 0x25, 0x5b, 0xbe,
+	0x34, 0x75, 0x13, 0x08, 0xe5, 0xc6, 0xd5, 0x59, 0xc0, 0x72, 0xf5, 0x2a, 0x10, 0xb7, 0xeb, 0x23,
+	0x7b, 0xbd, 0x0a, 0xc4, 0x6d, 0xef, 0xef, 0x56, 0x08, 0x33, 0x61, 0xf5, 0x63, 0xda, 0x5a, 0x8b,
+	0x58, 0x6e, 0xbb, 0x63, 0xb5, 0x14, 0xd3, 0x2a, 0xa3, 0x87, 0xd9, 0x5a, 0xcc, 0xb0, 0x18, 0x2a,
+	0x9f, 0xb4, 0xc5, 0x50, 0xbe, 0x11, 0x58, 0xe5, 0x21, 0x32, 0x02, 0xf3, 0x3e, 0xe7, 0x10, 0x57,
+	0x19, 0x24, 0x6b, 0x2b, 0xcd, 0x8b, 0xa4, 0xa6, 0x2c, 0xa0, 0xb3, 0xbb, 0x9b, 0x42, 0x07, 0x8d,
+	0x33, 0x84, 0x9e, 0x50, 0xe9, 0x4f, 0xcb, 0x7b, 0xe8, 0x4f, 0x7f, 0xa9, 0x44, 0x1e, 0xe5, 0x17,
+	0x93, 0x65, 0x3f, 0xf4, 0x37, 0x69, 0x07, 0xa5, 0x1a, 0xd6, 0xee, 0xb6, 0x89, 0x0a, 0xaa, 0x40,
+	0xba, 0x3c, 0x1e, 0xf5, 0x64, 0xc0, 0xd7, 0x19, 0xbe, 0xb2, 0x2c, 0x86, 0x41, 0x0a, 0x8c, 0xb8,
+	0x9b, 0x90, 0x31, 0x11, 0x06, 0x50, 0xde, 0xf8, 0x0b, 0x62, 0xa4, 0x76, 0x1c, 0xb1, 0x01, 0x50,
+	0x50, 0x8c, 0xf0, 0xa0, 0xde, 0x8e, 0x9a, 0xdb, 0x38, 0xe5, 0xb3, 0x07, 0xf5, 0x25, 0x51, 0x0e,
+	0x0a, 0xc3, 0xeb, 0x90, 0x29, 0xd9, 0x86, 0x5d, 0xcc, 0xfc, 0x46, 0x37, 0xf0, 0x74, 0xdb, 0x94,
+	0x45, 0x37, 0x74, 0x2b, 0xaa, 0xd3, 0xed, 0xbc, 0x09, 0x04, 0x1b, 0x57, 0xe6, 0x94, 0x2b, 0xe5,
+	0xe7, 0x94, 0xf3, 0x7e, 0xc9, 0x21, 0xd9, 0xe3, 0x35, 0x53, 0x2f, 0xf3, 0x18, 0x65, 0x59, 0xf5,
+	0xb2, 0x9d, 0x7e, 0xe9, 0x00, 0xd1, 0xa3, 0x3f, 0x48, 0xc6, 0xfd, 0x14, 0xf7, 0xe0, 0xf4, 0x90,
+	0xf1, 0xa3, 0x99, 0x3e, 0x75, 0x39, 0x6a, 0x05, 0x1b, 0x01, 0x52, 0x00, 0x93, 0x9c, 0xf7, 0xbd,
+	0x23, 0xa4, 0xb6, 0x10, 0xef, 0x1e, 0xdc, 0x95, 0xbf, 0xdf, 0x51, 0xbf, 0x74, 0x20, 0x47, 0x7d,
+	0x19, 0x0a, 0xa0, 0x3c, 0x30, 0x14, 0x80, 0x74, 0xe5, 0xaf, 0x3c, 0x28, 0x57, 0xfe, 0xea, 0x43,
+	0xe2, 0xca, 0x3f, 0xf2, 0x10, 0xb8, 0xf2, 0x8f, 0x9e, 0xb4, 0x2b, 0xff, 0x03, 0xb4, 0x78, 0xf5,
+	0xfe, 0x73, 0x85, 0x9c, 0xe9, 0x0b, 0x12, 0xe3, 0x3e, 0x47, 0x26, 0xd4, 0xfa, 0x20, 0xdf, 0xea,
+	0x6a, 0xa6, 0x27, 0x9a, 0x86, 0x81, 0x85, 0x39, 0xc4, 0x26, 0x31, 0xe0, 0xaa, 0x50, 0x3e, 0xc4,
+	0x55, 0xa1, 0x4b, 0x4e, 0xb5, 0xcd, 0x9b, 0x53, 0xbd, 0x72, 0xf8, 0x4b, 0x97, 0x5a, 0x27, 0xad,
+	0x62, 0xb0, 0x19, 0x7c, 0x75, 0xda, 0x28, 0xf4, 0xf5, 0xff, 0x71, 0x5f, 0x4b, 0x9e, 0x27, 0x63,
+	0xd2, 0xe9, 0xa5, 0xa8, 0x57, 0xd9, 0x57, 0x4b, 0x24, 0x47, 0x1d, 0x8a, 0xab, 0xbc, 0xbe, 0x69,
+	0x58, 0xab, 0xfc, 0xc1, 0x6e, 0x1b, 0xee, 0x3d, 0xee, 0xf0, 0xc3, 0xcf, 0x97, 0xef, 0x2b, 0x5a,
+	0x9d, 0xab, 0x7d, 0x80, 0xd4, 0xde, 0xab, 0xfc, 0x80, 0x9e, 0x25, 0x44, 0xab, 0x62, 0xc4, 0x2d,
+	0x43, 0x99, 0xce, 0x6a, 0x8d, 0x0d, 0x18, 0x58, 0xa8, 0xdd, 0x0f, 0xc2, 0x24, 0xf5, 0xdb, 0xed,
+	0xab, 0x68, 0xe2, 0x51, 0xb5, 0xb5, 0xfb, 0x8b, 0x1a, 0x04, 0x26, 0xde, 0xf9, 0x77, 0x18, 0xfd,
+	0x72, 0x90, 0xfe, 0xdc, 0x22, 0x8f, 0x5f, 0x09, 0x52, 0xb5, 0xac, 0xaa, 0x71, 0xc4, 0x2e, 0x98,
+	0x72, 0xf7, 0x73, 0x06, 0xee, 0x7e, 0x46, 0x1c, 0x89, 0x92, 0x1d, 0xf6, 0x22, 0x1b, 0x47, 0xc2,
+	0x6b, 0x92, 0xb3, 0x57, 0x82, 0xf4, 0xb2, 0x79, 0x87, 0x2e, 0x9e, 0xc9, 0x2f, 0x8e, 0x90, 0x09,
+	0x33, 0x78, 0xd8, 0x41, 0xce, 0x0a, 0x18, 0xed, 0x52, 0x6e, 0x2a, 0x81, 0x32, 0x07, 0xbc, 0x7d,
+	0xe4, 0x48, 0x66, 0xf9, 0x8d, 0x6b, 0x5c, 0x8e, 0x34, 0x4f, 0x30, 0x05, 0x70, 0xef, 0x92, 0xea,
+	0x06, 0x0b, 0x89, 0x50, 0x2e, 0xc2, 0x90, 0x3b, 0xaf, 0xf1, 0xf5, 0x8c, 0xe4, 0x41, 0x15, 0x38,
+	0x3f, 0x2b, 0xfd, 0x44, 0x65, 0xbf, 0xf4, 0x13, 0x5f, 0x75, 0x0a, 0x24, 0x16, 0xde, 0x22, 0xdd,
+	0x62, 0xd7, 0x2d, 0xe1, 0xdb, 0x3e, 0xca, 0x1a, 0xc1, 0x08, 0x6f, 0x61, 0x81, 0x21, 0x8b, 0xef,
+	0x7e, 0x4c, 0xad, 0xf2, 0x63, 0x45, 0x3c, 0x06, 0x9b, 0x23, 0xfa, 0xb8, 0x17, 0xf8, 0xcf, 0x95,
+	0xc8, 0xe4, 0x95, 0xb0, 0xb7, 0x7a, 0x65, 0xb5, 0xb7, 0xde, 0x0e, 0x9a, 0xd7, 0xe9, 0x2e, 0xae,
+	0xe2, 0xdb, 0x74, 0x77, 0x71, 0x21, 0xab, 0x67, 0xba, 0x8e, 0x85, 0xc0, 0x61, 0xb8, 0x6e, 0x6d,
+	0x04, 0xe1, 0x26, 0x8d, 0xbb, 0x71, 0x10, 0x4a, 0xdb, 0x47, 0x35, 0xc6, 0x2f, 0x6b, 0x10, 0x98,
+	0x78, 0x48, 0x3b, 0xba, 0x1b, 0xaa, 0x48, 0xae, 0x8a, 0xf6, 0x0a, 0x16, 0x02, 0x87, 0x21, 0x52,
+	0x1a, 0xf7, 0xc4, 0x33, 0x88, 0x81, 0xb4, 0x86, 0x85, 0xc0, 0x61, 0x42, 0xef, 0xc3, 0xec, 0xe4,
+	0xab, 0x7d, 0x7a, 0x1f, 0x2c, 0x06, 0x09, 0x47, 0xd4, 0x6d, 0xba, 0xbb, 0x80, 0x2a, 0xf0, 0x8c,
+	0xda, 0xe6, 0x3a, 0x2f, 0x06, 0x09, 0x67, 0x59, 0x07, 0xed, 0xe6, 0xf8, 0x8a, 0xcb, 0x3a, 0x68,
+	0x8b, 0x3f, 0x40, 0x99, 0xfe, 0x03, 0x25, 0x32, 0x61, 0x7a, 0xb7, 0xb8, 0x9b, 0x99, 0x3b, 0xe2,
+	0x4a, 0x5f, 0x36, 0x8d, 0x77, 0x6b, 0xa9, 0x2e, 0x4a, 0xa9, 0x2e, 0x6e, 0x06, 0x69, 0xd4, 0x4d,
+	0xde, 0x4c, 0xc3, 0xcd, 0x20, 0xa4, 0xcc, 0xd0, 0x97, 0x7b, 0xc5, 0xcc, 0x98, 0xc4, 0x07, 0xe5,
+	0xd9, 0x78, 0x08, 0x73, 0x19, 0x7b, 0xb7, 0xc9, 0x99, 0xbe, 0xa0, 0x3a, 0x43, 0x9c, 0x7c, 0xf6,
+	0x8d, 0x21, 0xe7, 0x01, 0x19, 0x47, 0xc2, 0x32, 0x8a, 0x3c, 0x1a, 0x78, 0xb2, 0x29, 0x8b, 0x9c,
+	0x58, 0x8c, 0x14, 0x15, 0x28, 0x89, 0x1b, 0x78, 0x66, 0x81, 0xd0, 0x8f, 0x8f, 0xe9, 0xe7, 0x4f,
+	0x59, 0x71, 0x8e, 0x0a, 0x3a, 0xa3, 0xb1, 0xd9, 0x1d, 0x31, 0x1f, 0x2f, 0xe6, 0xfa, 0x5b, 0x66,
+	0xdb, 0xb0, 0x9e, 0xdd, 0x1a, 0x04, 0x26, 0x9e, 0xf7, 0x7d, 0x65, 0x32, 0x69, 0xc7, 0x62, 0x31,
+	0x03, 0x0b, 0x38, 0x27, 0x1a, 0x58, 0xe0, 0x73, 0x0e, 0x19, 0x67, 0x79, 0xd1, 0x04, 0xf7, 0x42,
+	0x12, 0x54, 0xd8, 0x1f, 0xc7, 0x92, 0xb0, 0x31, 0x31, 0x54, 0xd3, 0xa8, 0x22, 0xdc, 0xdc, 0x0d,
+	0xf6, 0xc6, 0xa5, 0xb5, 0x7c, 0xc2, 0x97, 0x56, 0xef, 0x67, 0x1d, 0xf2, 0xd8, 0x00, 0xc1, 0xd1,
+	0x5e, 0x90, 0xab, 0x40, 0x8a, 0xd1, 0x4b, 0xab, 0xde, 0x51, 0x97, 0x35, 0x26, 0x90, 0xd0, 0xba,
+	0x08, 0x56, 0x38, 0x04, 0xbb, 0x86, 0x41, 0xb2, 0x1a, 0x82, 0x4c, 0x24, 0xe0, 0x30, 0xef, 0xd7,
+	0xca, 0x64, 0x4c, 0xfa, 0x36, 0x0c, 0x31, 0xac, 0x3f, 0xeb, 0x90, 0x53, 0xca, 0x90, 0x08, 0xeb,
+	0x88, 0x0e, 0xbf, 0x71, 0x74, 0xef, 0x0a, 0xa5, 0xdd, 0xc5, 0x97, 0x5f, 0x75, 0xf9, 0x04, 0x93,
+	0x19, 0xd8, 0xbc, 0xdd, 0x5b, 0xe8, 0xea, 0x9c, 0xa4, 0xb4, 0x63, 0xbc, 0x41, 0x7b, 0xc6, 0x8a,
+	0x35, 0xd3, 0x8c, 0x62, 0x8a, 0xeb, 0x13, 0x7a, 0x84, 0x34, 0x14, 0xa6, 0xbe, 0x2d, 0xe8, 0x32,
+	0x30, 0x28, 0xb9, 0x2f, 0x2b, 0xb3, 0xb7, 0x4a, 0x11, 0x67, 0x44, 0xd9, 0xbe, 0xc3, 0xd8, 0xbd,
+	0x1d, 0xc1, 0xce, 0xcc, 0xfb, 0xa9, 0x12, 0x39, 0x9d, 0x6d, 0x49, 0xf7, 0x03, 0xe8, 0x34, 0xc8,
+	0x7f, 0x1b, 0x4a, 0xd0, 0x77, 0xaa, 0xe8, 0xfb, 0x06, 0xec, 0xd5, 0xfb, 0xd3, 0xd3, 0xda, 0xb1,
+	0xe4, 0x22, 0x36, 0xde, 0xc5, 0x1d, 0xc3, 0xf7, 0x06, 0x87, 0x81, 0x45, 0x8c, 0x1b, 0xa1, 0x09,
+	0x6b, 0xc9, 0xb9, 0xdd, 0xd9, 0x6e, 0x57, 0x58, 0x92, 0x19, 0x46, 0x68, 0x26, 0x14, 0x32, 0xd8,
+	0x18, 0x0b, 0xc4, 0x28, 0xb9, 0x41, 0x83, 0xcd, 0xad, 0xf5, 0x28, 0x96, 0xba, 0x8f, 0x27, 0xb5,
+	0xfb, 0x5a, 0x3f, 0x0e, 0xe4, 0xd6, 0xc4, 0x43, 0x76, 0xd3, 0xef, 0xfa, 0xcd, 0x40, 0xbc, 0xdd,
+	0x96, 0xf5, 0x91, 0x60, 0x5e, 0x94, 0x83, 0xc2, 0xf0, 0x7e, 0xa6, 0x42, 0x26, 0xb9, 0xbf, 0x16,
+	0x15, 0x6e, 0x9f, 0xee, 0x79, 0x52, 0x0a, 0x5a, 0xc2, 0x96, 0x8e, 0x88, 0xaa, 0xa5, 0xc5, 0x05,
+	0x28, 0x05, 0x2d, 0xd4, 0xf0, 0xb6, 0xe2, 0xdd, 0xc6, 0xd5, 0x59, 0x31, 0xa5, 0x54, 0x1f, 0x2e,
+	0xb0, 0x52, 0x10, 0x50, 0x5c, 0xd7, 0xb7, 0x38, 0xd5, 0x16, 0x22, 0x67, 0x6c, 0xc9, 0xae, 0x6a,
+	0x10, 0x98, 0x78, 0xe8, 0x48, 0xd0, 0x8a, 0x77, 0xe5, 0x5d, 0x80, 0x0f, 0x3e, 0xe1, 0x48, 0xb0,
+	0x60, 0x94, 0x83, 0x85, 0x85, 0x2b, 0x70, 0xd6, 0xf7, 0xad, 0x7a, 0x0c, 0x2e, 0xda, 0x43, 0x7a,
+	0xbd, 0x61, 0x06, 0xad, 0x24, 0xf5, 0xe3, 0xf4, 0x90, 0x1e, 0xaa, 0x4c, 0x1f, 0xd9, 0x90, 0x04,
+	0x40, 0xd3, 0x72, 0x3f, 0x44, 0x88, 0x6c, 0xac, 0x43, 0x99, 0xfd, 0xaa, 0x49, 0x7f, 0x55, 0x51,
+	0x01, 0x83, 0x22, 0x76, 0x6e, 0xc7, 0x0f, 0x7b, 0x7e, 0x5b, 0xc4, 0x3c, 0x52, 0x9d, 0xbb, 0xcc,
+	0x4a, 0x41, 0x40, 0xbd, 0xef, 0xc0, 0xd3, 0x00, 0xaf, 0xb6, 0xe4, 0xef, 0x46, 0xbd, 0xd4, 0x7d,
+	0xbb, 0xe5, 0xab, 0xf5, 0xfa, 0x8c, 0xaf, 0xd6, 0x19, 0x0b, 0xd9, 0xf0, 0xca, 0x7a, 0x17, 0x39,
+	0x25, 0xb5, 0xbe, 0xfa, 0xd1, 0x70, 0x4c, 0x2f, 0x7d, 0xd7, 0x4d, 0x20, 0xd8, 0xb8, 0xde, 0xf7,
+	0x8f, 0x92, 0xd3, 0x82, 0xb0, 0x72, 0xa4, 0xc5, 0x64, 0x93, 0xba, 0xed, 0x9d, 0xc3, 0x27, 0x9b,
+	0xcc, 0x6d, 0xff, 0xf7, 0xb3, 0xe8, 0xf1, 0x41, 0xb2, 0xc5, 0xa8, 0x97, 0x0e, 0xf7, 0x14, 0x71,
+	0x59, 0x51, 0x00, 0x83, 0x9a, 0xfb, 0x4d, 0xa4, 0xda, 0xdd, 0xf2, 0x13, 0xf9, 0x4e, 0xf6, 0x06,
+	0xb5, 0x55, 0x61, 0x21, 0xba, 0x94, 0x66, 0x3f, 0x95, 0x01, 0x80, 0x57, 0x32, 0xcf, 0xba, 0x95,
+	0x7d, 0xce, 0xba, 0x7a, 0x06, 0x57, 0x0f, 0x32, 0x83, 0x47, 0x86, 0x9c, 0xc1, 0x39, 0x73, 0x71,
+	0xf4, 0x01, 0xce, 0xc5, 0xec, 0x82, 0x32, 0x36, 0xd4, 0x82, 0xa2, 0x27, 0x42, 0x6d, 0xaf, 0x89,
+	0x90, 0xf3, 0x0a, 0x44, 0x0e, 0xf4, 0x0a, 0xf4, 0x99, 0x4c, 0x08, 0xf5, 0xf1, 0x22, 0xa2, 0xb6,
+	0x8a, 0xae, 0x31, 0xe2, 0xa5, 0x0b, 0xaf, 0xdd, 0xbd, 0xc3, 0xa8, 0x5f, 0x26, 0x2e, 0x97, 0xce,
+	0x6c, 0x17, 0xe1, 0xe2, 0xcc, 0xee, 0x35, 0x6b, 0x7d, 0x50, 0xc8, 0xa9, 0xe1, 0xfd, 0x98, 0x43,
+	0xdc, 0x7e, 0x11, 0xdc, 0x6b, 0xcc, 0x48, 0x94, 0x87, 0xd6, 0xe7, 0xab, 0xc4, 0x8c, 0x61, 0x24,
+	0xca, 0xca, 0x5f, 0xbd, 0x3f, 0x7d, 0xbe, 0xbf, 0xa6, 0x84, 0x82, 0xaa, 0x8f, 0x2f, 0x93, 0x7e,
+	0x37, 0xc8, 0xbe, 0x4c, 0xce, 0xae, 0x2e, 0x02, 0x96, 0xa3, 0x77, 0x98, 0x91, 0x15, 0x43, 0x78,
+	0x0a, 0xdb, 0x67, 0x0c, 0xef, 0x4b, 0x25, 0x52, 0x1f, 0xd4, 0x50, 0xee, 0x7b, 0xe4, 0x5c, 0xe4,
+	0x82, 0xbe, 0x29, 0x3b, 0x17, 0x1f, 0xcb, 0x91, 0xd2, 0x9c, 0x8d, 0x22, 0x25, 0x6a, 0x29, 0x3f,
+	0x25, 0x2a, 0x8e, 0xae, 0xb0, 0xd7, 0x59, 0x17, 0xba, 0x89, 0xb2, 0x1e, 0x5d, 0x37, 0x58, 0x29,
+	0x08, 0x68, 0x76, 0x06, 0x56, 0x86, 0x9c, 0x81, 0xc6, 0x5a, 0x50, 0xdd, 0x3f, 0x35, 0x6f, 0x8f,
+	0x65, 0x03, 0x3d, 0xdc, 0x4e, 0xa5, 0x56, 0xcb, 0x9b, 0x92, 0x08, 0x68, 0x7a, 0xde, 0xaf, 0x3b,
+	0xa4, 0x26, 0x84, 0x5c, 0x8b, 0xf0, 0xf9, 0x88, 0x0f, 0x96, 0xb9, 0xd8, 0x0f, 0x9b, 0x5b, 0xd9,
+	0xe7, 0xa3, 0x35, 0x03, 0x06, 0x16, 0x26, 0x3e, 0x6d, 0x58, 0x93, 0xa4, 0x54, 0x84, 0xf9, 0x74,
+	0x7f, 0x0f, 0xee, 0x3d, 0x3d, 0xbc, 0x65, 0x52, 0x19, 0xf2, 0x82, 0x30, 0xd4, 0xdb, 0xc4, 0xf3,
+	0x64, 0x0c, 0xc9, 0x49, 0x45, 0x75, 0x11, 0x24, 0x23, 0x32, 0x76, 0xed, 0xf6, 0x1a, 0xb7, 0xc9,
+	0xf7, 0x48, 0x39, 0xf0, 0xa5, 0x3f, 0x84, 0xce, 0x0b, 0x9d, 0x24, 0x3d, 0xd6, 0x4b, 0x08, 0x74,
+	0x9f, 0x21, 0x65, 0x7a, 0xaf, 0x9b, 0x75, 0x7c, 0xb8, 0x74, 0xaf, 0x1b, 0xc4, 0x34, 0x41, 0x24,
+	0x7a, 0xaf, 0x2b, 0xce, 0x82, 0x7c, 0x4f, 0xca, 0x9c, 0x05, 0xbd, 0x7b, 0xa4, 0x26, 0x19, 0x32,
+	0x5f, 0x73, 0xae, 0x5a, 0x72, 0x8a, 0xf0, 0x35, 0x97, 0x74, 0x07, 0x28, 0x95, 0x7a, 0x84, 0xe8,
+	0xf8, 0xa3, 0x45, 0xa9, 0x22, 0x2e, 0x90, 0x4a, 0x33, 0x12, 0x71, 0xab, 0xc7, 0x34, 0x19, 0xa6,
+	0x53, 0x62, 0x10, 0xef, 0x36, 0x99, 0xbc, 0x1e, 0x46, 0x77, 0x43, 0x3c, 0xc1, 0xb0, 0x44, 0x7f,
+	0x48, 0x78, 0x03, 0xff, 0xc9, 0x6a, 0x30, 0x19, 0x14, 0x38, 0x4c, 0xe5, 0xc3, 0x2a, 0x0d, 0xca,
+	0x87, 0xe5, 0x7d, 0xdc, 0x21, 0x13, 0xea, 0x46, 0x7b, 0x65, 0x67, 0x7b, 0x38, 0x0b, 0x3c, 0x23,
+	0xc2, 0x67, 0x69, 0x9f, 0x08, 0x9f, 0xd2, 0x58, 0xaf, 0x3c, 0xc8, 0x58, 0xcf, 0xfb, 0x9f, 0x0e,
+	0x39, 0xad, 0x44, 0x90, 0xba, 0xa3, 0xe7, 0xc8, 0xc4, 0x7a, 0x2f, 0x68, 0xb7, 0xc4, 0xef, 0xec,
+	0xa4, 0x9d, 0x33, 0x60, 0x60, 0x61, 0xe2, 0x0b, 0xd5, 0x7a, 0x10, 0xfa, 0xf1, 0xee, 0xaa, 0x56,
+	0x56, 0xa9, 0xe3, 0xe7, 0x9c, 0x82, 0x80, 0x81, 0x85, 0x81, 0x29, 0x77, 0xa4, 0x05, 0x72, 0xb9,
+	0xd0, 0xc0, 0x94, 0xa2, 0x3d, 0xf4, 0x4c, 0x50, 0x26, 0xcd, 0x8a, 0xa3, 0xf7, 0xf9, 0x32, 0x99,
+	0xb4, 0x75, 0x3e, 0x43, 0xbc, 0x20, 0x0d, 0xa3, 0x60, 0x30, 0x54, 0x1f, 0xe5, 0x93, 0x53, 0x7d,
+	0x7c, 0xd2, 0x21, 0xa3, 0x51, 0xd7, 0x4c, 0xc4, 0xf4, 0xbe, 0x22, 0xf5, 0x61, 0x22, 0x9a, 0x9d,
+	0xb8, 0xc9, 0xab, 0x81, 0x27, 0x07, 0x83, 0x64, 0x7d, 0xfe, 0x1b, 0xc9, 0x84, 0x89, 0xb9, 0xdf,
+	0x65, 0x7e, 0xcc, 0xbc, 0xcc, 0x7f, 0xd6, 0x1c, 0x92, 0x22, 0x94, 0xe8, 0x10, 0x93, 0xfd, 0x26,
+	0xa9, 0x36, 0x95, 0x4b, 0xd7, 0xa1, 0x72, 0x66, 0xab, 0xc0, 0x0a, 0x48, 0x06, 0x38, 0x35, 0xb4,
+	0x77, 0x9f, 0x34, 0xa4, 0x49, 0x16, 0x5b, 0x6e, 0x4c, 0xca, 0x9b, 0x3b, 0xdb, 0xe2, 0x9a, 0x71,
+	0xad, 0xa0, 0xe6, 0xbd, 0xb2, 0xb3, 0xad, 0x67, 0x98, 0x59, 0x0a, 0xc8, 0x6c, 0x08, 0x63, 0x0a,
+	0xcb, 0x44, 0xbd, 0xbc, 0xbf, 0x89, 0xba, 0xf7, 0xc5, 0x12, 0x39, 0xd3, 0x37, 0xa8, 0xdc, 0x97,
+	0x49, 0x35, 0xc6, 0xaf, 0xac, 0x3b, 0x45, 0x1c, 0xdf, 0xed, 0x96, 0xd3, 0x07, 0x64, 0xbb, 0x1c,
+	0x38, 0x4b, 0xf4, 0x4e, 0xd2, 0x8e, 0x87, 0x0d, 0xd3, 0x7c, 0xbe, 0xa6, 0xbd, 0x93, 0x66, 0xfb,
+	0x30, 0x20, 0xa7, 0x16, 0xde, 0x31, 0x6d, 0x83, 0x90, 0x4c, 0x6a, 0xbf, 0xbd, 0x6c, 0x3b, 0xbc,
+	0x2f, 0x98, 0x43, 0xf0, 0x96, 0x5e, 0x4c, 0x8f, 0xaa, 0xa4, 0xef, 0x5b, 0x59, 0xcb, 0xc3, 0xae,
+	0xac, 0xde, 0xcf, 0x97, 0xc8, 0x29, 0x2b, 0x55, 0x97, 0xdb, 0x26, 0x63, 0xb4, 0xcd, 0x6c, 0x26,
+	0xe5, 0xee, 0xfb, 0x1e, 0x63, 0xcc, 0x53, 0x19, 0x4d, 0x3e, 0x79, 0x11, 0xbb, 0x87, 0x39, 0xb0,
+	0x98, 0x9d, 0x65, 0x20, 0xe0, 0xc1, 0x0e, 0x35, 0xb6, 0x7a, 0x9d, 0xbc, 0x24, 0xe8, 0x82, 0xe2,
+	0xf0, 0x70, 0xf8, 0x51, 0x3d, 0x47, 0x26, 0xa4, 0x40, 0xef, 0xf3, 0x3b, 0xed, 0x6c, 0xf3, 0x5d,
+	0x32, 0x60, 0x60, 0x61, 0x7a, 0xbf, 0x5c, 0x26, 0x75, 0x6e, 0x64, 0xda, 0x52, 0x93, 0x41, 0x19,
+	0x8b, 0xff, 0x7f, 0x3a, 0xa1, 0x1e, 0x6f, 0xc8, 0xf5, 0xa3, 0x7d, 0xd9, 0x20, 0x46, 0x43, 0xb9,
+	0xff, 0xfe, 0x70, 0xc6, 0xfd, 0x97, 0xab, 0x99, 0x37, 0x8f, 0x49, 0xa2, 0xaf, 0x2c, 0x7f, 0xe0,
+	0xbf, 0x55, 0x22, 0x53, 0xcb, 0x7e, 0x1a, 0x07, 0xf7, 0xf4, 0x34, 0xf8, 0xbc, 0x9d, 0x27, 0xdc,
+	0x29, 0x24, 0x54, 0x8b, 0x35, 0x34, 0x79, 0x3a, 0xe5, 0x43, 0x66, 0x0b, 0x7f, 0x40, 0x53, 0xc5,
+	0xfb, 0xed, 0x12, 0x99, 0x5c, 0xa6, 0xf1, 0x26, 0x7d, 0x98, 0x5b, 0xea, 0xeb, 0x48, 0xad, 0x83,
+	0x32, 0x5e, 0xa7, 0xbb, 0xd2, 0xda, 0x8a, 0xa9, 0x41, 0x97, 0x65, 0x21, 0x68, 0xf8, 0x43, 0x91,
+	0x84, 0xdd, 0xfb, 0xdb, 0x0e, 0x39, 0xc7, 0xbf, 0x32, 0x3b, 0x0e, 0xbf, 0x27, 0xaf, 0x75, 0x5f,
+	0x28, 0x56, 0xc0, 0x4c, 0x22, 0xc8, 0xfd, 0xda, 0x17, 0x0f, 0x2f, 0x67, 0x85, 0xb4, 0xf6, 0x50,
+	0x78, 0x08, 0x85, 0x3d, 0xd0, 0x60, 0xf0, 0x7e, 0xa2, 0x4c, 0xc6, 0x57, 0xe6, 0x17, 0xd5, 0x12,
+	0x8e, 0x2e, 0x0c, 0x31, 0xf5, 0xb5, 0x02, 0xd8, 0x74, 0x61, 0x90, 0x00, 0xd0, 0x38, 0x78, 0x8b,
+	0xe2, 0x2e, 0x40, 0x49, 0xf6, 0x16, 0xc5, 0x3d, 0x84, 0x12, 0x90, 0x70, 0x7c, 0x59, 0x61, 0x81,
+	0xe6, 0xd0, 0x2d, 0xa7, 0x6c, 0x9b, 0x2f, 0xb1, 0x40, 0x74, 0xa8, 0xe1, 0x51, 0x18, 0x48, 0xb8,
+	0x15, 0x35, 0x13, 0x44, 0xce, 0xe8, 0x64, 0x17, 0xb0, 0x18, 0x2d, 0xc4, 0x04, 0x1c, 0x85, 0xe6,
+	0x7a, 0x4b, 0x44, 0xae, 0xda, 0x42, 0x73, 0x05, 0x27, 0xa2, 0x6b, 0x9c, 0x83, 0x24, 0xd5, 0xc9,
+	0x84, 0xa2, 0x19, 0x1d, 0x32, 0x14, 0x4d, 0x83, 0x9c, 0x4b, 0x82, 0xcd, 0xd0, 0x4f, 0x7b, 0x31,
+	0x1e, 0x7c, 0x82, 0x0d, 0x19, 0x39, 0x8d, 0x7b, 0x78, 0xab, 0x38, 0xef, 0x8d, 0x3c, 0x24, 0xc8,
+	0xaf, 0xeb, 0xb5, 0xc8, 0xd4, 0xca, 0xfc, 0xa2, 0xaa, 0x82, 0x36, 0x40, 0xfb, 0x27, 0x8b, 0xbe,
+	0x48, 0x6a, 0x5d, 0x69, 0x64, 0x92, 0x4d, 0x8b, 0xab, 0xac, 0x4f, 0x40, 0xe3, 0x78, 0xbf, 0x5d,
+	0x26, 0x35, 0xfd, 0x22, 0x10, 0x88, 0xc0, 0xb0, 0x85, 0xe4, 0x48, 0xc5, 0xc8, 0x0c, 0x8a, 0x34,
+	0x37, 0x08, 0x35, 0xe2, 0xc2, 0x7e, 0xa7, 0x83, 0x36, 0x96, 0x41, 0x1a, 0xf8, 0xec, 0x49, 0xae,
+	0x18, 0x4d, 0x95, 0x62, 0xb7, 0xc8, 0x29, 0x47, 0xb1, 0x69, 0xb5, 0xa9, 0x98, 0x81, 0xc9, 0xd9,
+	0xfd, 0xb0, 0x08, 0xda, 0x52, 0x2e, 0x2c, 0xc8, 0xf3, 0x58, 0x26, 0x52, 0x4b, 0x17, 0xaf, 0x07,
+	0x69, 0x5c, 0x50, 0x6c, 0x74, 0x40, 0x52, 0x2a, 0x57, 0xb7, 0xba, 0x80, 0xb1, 0x62, 0xe0, 0x8c,
+	0xbc, 0x84, 0xb8, 0xfd, 0x6d, 0x71, 0xc0, 0x80, 0x18, 0x18, 0xf2, 0xa3, 0x97, 0x46, 0x1d, 0x6c,
+	0x26, 0xf1, 0xd8, 0xa4, 0x43, 0x7e, 0x48, 0x00, 0x68, 0x1c, 0xef, 0xf3, 0x55, 0x92, 0x09, 0xd3,
+	0xea, 0xde, 0x23, 0x35, 0x15, 0xa8, 0xb5, 0x98, 0x88, 0x55, 0x7a, 0x44, 0x29, 0x61, 0x54, 0x11,
+	0x68, 0x66, 0xee, 0xa6, 0xd4, 0x4b, 0xf3, 0x59, 0xf0, 0x7c, 0x56, 0x2f, 0xfd, 0x2d, 0xc3, 0x19,
+	0x4e, 0xe1, 0x58, 0xbd, 0xc8, 0xf3, 0x83, 0xcc, 0xec, 0xfb, 0x9c, 0x54, 0xde, 0x47, 0x85, 0xfc,
+	0x09, 0x87, 0x07, 0x5b, 0x07, 0x9a, 0xf4, 0xda, 0xa9, 0x18, 0x0d, 0xcf, 0x17, 0x38, 0xcb, 0x38,
+	0x61, 0x1d, 0x75, 0x9d, 0xff, 0x06, 0x83, 0xa9, 0xfd, 0xe8, 0x37, 0x72, 0xac, 0x8f, 0x7e, 0xa3,
+	0x85, 0x3e, 0xfa, 0x3d, 0x4b, 0x08, 0x1b, 0xdb, 0xdc, 0x71, 0x7f, 0x8c, 0x69, 0x62, 0xd5, 0xee,
+	0x08, 0x0a, 0x02, 0x06, 0x96, 0xf7, 0x0d, 0xc4, 0x4e, 0x1b, 0x80, 0x31, 0x93, 0x78, 0x96, 0x02,
+	0x6e, 0xd4, 0xc5, 0x62, 0x26, 0x59, 0x09, 0x05, 0x7e, 0xce, 0x21, 0x66, 0x6e, 0x03, 0xf7, 0x25,
+	0x9e, 0x44, 0xc1, 0x29, 0xc2, 0xb0, 0xc3, 0xa0, 0x3b, 0xb3, 0xec, 0x77, 0x33, 0x06, 0xeb, 0x32,
+	0x93, 0x02, 0x5a, 0x91, 0x4b, 0xe8, 0x81, 0xce, 0xf9, 0x1f, 0x23, 0x8f, 0xc8, 0x08, 0xa7, 0xd2,
+	0x06, 0x43, 0x18, 0x8e, 0x9e, 0x8c, 0x83, 0xf2, 0x3f, 0x74, 0xc8, 0x85, 0xac, 0x00, 0xc9, 0x72,
+	0x14, 0x06, 0x69, 0x14, 0x37, 0x68, 0x9a, 0x06, 0xe1, 0x26, 0xcb, 0x75, 0x75, 0xd7, 0x8f, 0x65,
+	0x2e, 0x7f, 0xb6, 0x50, 0xde, 0xf6, 0xe3, 0x10, 0x58, 0x29, 0xda, 0x63, 0x71, 0xff, 0x4b, 0x71,
+	0x81, 0x3b, 0xe2, 0xdc, 0xc8, 0x69, 0x0e, 0x7d, 0x83, 0xe4, 0xbe, 0x9f, 0x20, 0x18, 0x7a, 0x5f,
+	0x72, 0x88, 0xbb, 0xb2, 0x43, 0xe3, 0x38, 0x68, 0x19, 0x1e, 0xa3, 0xf8, 0x26, 0x7a, 0x07, 0xed,
+	0xb2, 0xa2, 0x20, 0x64, 0x61, 0x42, 0x8d, 0xf8, 0xbb, 0xd7, 0x8c, 0x72, 0xb0, 0xb0, 0xd0, 0x8e,
+	0xf0, 0xce, 0x4b, 0xa8, 0xc1, 0xd0, 0xf9, 0x32, 0xe5, 0xe9, 0x8c, 0xd9, 0x11, 0x5e, 0x7b, 0x3e,
+	0x03, 0x84, 0x7e, 0x7c, 0x77, 0x85, 0x9c, 0xeb, 0xf0, 0x1b, 0x28, 0xd3, 0x90, 0x27, 0xfc, 0x3a,
+	0xaa, 0x02, 0xd9, 0x3d, 0x8e, 0x27, 0x8a, 0xe5, 0x3c, 0x04, 0xc8, 0xaf, 0xe7, 0xbd, 0x83, 0xb8,
+	0xdc, 0x08, 0x6d, 0x3e, 0xcf, 0xe3, 0x68, 0xa0, 0x86, 0xc6, 0xfb, 0xa1, 0x2a, 0x99, 0xca, 0x64,
+	0x7a, 0xc6, 0xdb, 0x7f, 0xbf, 0x8b, 0xd3, 0x91, 0xf7, 0xef, 0x7e, 0xf1, 0x86, 0x72, 0x9a, 0x0a,
+	0x49, 0x35, 0x08, 0xbb, 0xbd, 0xb4, 0x98, 0x48, 0xb5, 0x5c, 0x88, 0x45, 0x24, 0x68, 0x3c, 0xa9,
+	0xe0, 0x4f, 0xe0, 0x6c, 0x8a, 0x74, 0xc1, 0xb2, 0xee, 0x67, 0x95, 0x07, 0xa4, 0x21, 0xfa, 0x84,
+	0x76, 0x88, 0xaa, 0x16, 0xa1, 0xfe, 0xce, 0x0c, 0x96, 0xe3, 0xb6, 0x96, 0xff, 0xe9, 0x12, 0x19,
+	0x37, 0x3a, 0xcd, 0xfd, 0x51, 0x3b, 0xf3, 0x8f, 0x53, 0xdc, 0x27, 0x31, 0xfa, 0x33, 0x3a, 0xb7,
+	0x0f, 0xff, 0xa4, 0x37, 0xf4, 0x27, 0xfd, 0x79, 0xf5, 0xfe, 0xf4, 0xe9, 0x4c, 0x5a, 0x1f, 0x2b,
+	0x11, 0xd0, 0xf9, 0x8f, 0x92, 0xa9, 0x0c, 0x99, 0x9c, 0x4f, 0x5e, 0x33, 0x3f, 0xf9, 0xc8, 0x9a,
+	0x4a, 0xb3, 0xc9, 0x7e, 0x12, 0x9b, 0x4c, 0x84, 0xef, 0x8b, 0xda, 0x74, 0x08, 0x35, 0x6d, 0xe6,
+	0x6a, 0x54, 0x1a, 0xf2, 0x6a, 0xf4, 0x26, 0x32, 0xd6, 0x8d, 0xda, 0x41, 0x33, 0x50, 0x89, 0x03,
+	0x59, 0xa0, 0xd1, 0x55, 0x51, 0x06, 0x0a, 0xea, 0xde, 0x25, 0xb5, 0x3b, 0x77, 0x53, 0xfe, 0x42,
+	0x5a, 0xaf, 0x14, 0xfa, 0x30, 0xaa, 0x0e, 0x2d, 0xb2, 0x24, 0x01, 0xcd, 0x0b, 0x4d, 0x20, 0xd8,
+	0x26, 0x28, 0x43, 0xf9, 0xb0, 0x17, 0x22, 0xb6, 0x3b, 0x26, 0x20, 0x20, 0xde, 0xbf, 0x1c, 0x27,
+	0x67, 0xf3, 0xd2, 0xed, 0xbb, 0x1f, 0x21, 0x23, 0x5c, 0xc6, 0xba, 0x53, 0x84, 0xeb, 0x6d, 0x1e,
+	0x8f, 0x2b, 0x8c, 0xa0, 0x10, 0x8b, 0xfd, 0x0f, 0x82, 0xa7, 0xe0, 0xde, 0xf6, 0xd7, 0xeb, 0xa5,
+	0x63, 0xe4, 0xbe, 0xe4, 0x6b, 0xee, 0x4b, 0x3e, 0xe7, 0xde, 0xf6, 0xd7, 0xdd, 0x7b, 0xa4, 0xba,
+	0x19, 0xa4, 0xd4, 0x17, 0x7a, 0xa5, 0xdb, 0xc7, 0xc2, 0x9c, 0xfa, 0xfc, 0x94, 0xc6, 0xfe, 0x05,
+	0xce, 0x10, 0xa3, 0x46, 0x4c, 0xad, 0xdb, 0xf1, 0x86, 0xc5, 0xe2, 0xe9, 0x17, 0x2f, 0x44, 0x26,
+	0xb0, 0xf1, 0xdc, 0x23, 0xe8, 0x7d, 0x94, 0x29, 0x84, 0xac, 0x38, 0x68, 0x89, 0xa1, 0x62, 0x8b,
+	0xf3, 0x45, 0xf5, 0x18, 0x3a, 0xe7, 0xb0, 0xe1, 0xc5, 0x47, 0x8e, 0xba, 0x53, 0x8d, 0x3e, 0xa0,
+	0x9d, 0xea, 0xd3, 0x0e, 0xa9, 0xa9, 0x96, 0x16, 0x61, 0x56, 0x3f, 0x70, 0x8c, 0x5d, 0xce, 0x95,
+	0x69, 0xea, 0x27, 0x68, 0xe6, 0x18, 0xa0, 0x6d, 0xdc, 0x7f, 0xb9, 0x17, 0xd3, 0x16, 0xdd, 0x89,
+	0xba, 0x89, 0x48, 0x74, 0xf4, 0x42, 0xf1, 0xc2, 0xcc, 0x22, 0x93, 0x05, 0xba, 0xb3, 0xd2, 0x15,
+	0x66, 0x69, 0x46, 0x01, 0x98, 0x22, 0x60, 0xea, 0x19, 0xb9, 0x8f, 0x93, 0x22, 0xd2, 0xdd, 0xe5,
+	0x49, 0x33, 0x54, 0xd4, 0x3c, 0x4a, 0x9e, 0x68, 0x46, 0x61, 0x1a, 0x84, 0x3d, 0xba, 0x12, 0x02,
+	0xed, 0x46, 0x37, 0xa2, 0xf4, 0x72, 0xd4, 0x0b, 0x5b, 0x97, 0xe2, 0x38, 0x8a, 0x99, 0xe9, 0xde,
+	0xd8, 0xdc, 0x33, 0xa2, 0xf2, 0x13, 0xf3, 0x83, 0x51, 0x61, 0x2f, 0x3a, 0x47, 0x39, 0x33, 0xdc,
+	0x2f, 0x91, 0xe9, 0x7d, 0x1a, 0x1b, 0x1f, 0xce, 0xa2, 0x78, 0xd3, 0x0f, 0xa5, 0xc1, 0x6d, 0xc6,
+	0xa2, 0x63, 0xc5, 0x80, 0x81, 0x85, 0x69, 0xc6, 0xcc, 0x2d, 0xed, 0x13, 0x33, 0xf7, 0x02, 0xa9,
+	0xc4, 0xb4, 0x1b, 0x65, 0xef, 0x55, 0xf8, 0xb1, 0xc0, 0x20, 0xd2, 0x82, 0xaf, 0x32, 0xc0, 0x82,
+	0xcf, 0x8c, 0x09, 0x5e, 0x3d, 0x91, 0x98, 0xe0, 0x86, 0xd1, 0xe0, 0xc8, 0x40, 0xa3, 0xc1, 0x2f,
+	0x96, 0xc9, 0x53, 0x7b, 0x4e, 0x2d, 0xed, 0x75, 0xe8, 0xec, 0xe1, 0x75, 0x28, 0x9b, 0xa7, 0xb4,
+	0x5f, 0xf3, 0x94, 0x07, 0x34, 0xcf, 0x77, 0xe0, 0x8a, 0x21, 0x63, 0xd4, 0xd7, 0x2b, 0x45, 0x18,
+	0x8d, 0x0e, 0x0a, 0x79, 0x2f, 0x16, 0x0b, 0x09, 0x05, 0xcd, 0x17, 0xaf, 0x4b, 0x56, 0xbc, 0xd8,
+	0x6a, 0x11, 0x3b, 0xe6, 0xc0, 0x38, 0xf1, 0x7c, 0x99, 0x18, 0x14, 0x84, 0xd6, 0xfb, 0x85, 0x0a,
+	0x79, 0x66, 0x88, 0x8d, 0xce, 0x1c, 0xc5, 0xce, 0x90, 0xa3, 0xf8, 0x2b, 0xbc, 0x9b, 0x3e, 0x95,
+	0xdb, 0x4d, 0x50, 0x7c, 0x37, 0xed, 0xdd, 0x43, 0xec, 0xf1, 0x24, 0x4c, 0x68, 0xb3, 0x17, 0x73,
+	0x0f, 0x6c, 0x23, 0x98, 0xd1, 0xa2, 0x28, 0x07, 0x85, 0x81, 0xd7, 0xdf, 0xa6, 0x8f, 0xd3, 0x7f,
+	0xb4, 0xa0, 0xf8, 0xa0, 0x66, 0x5c, 0x24, 0x7e, 0xfa, 0x9a, 0x9f, 0xc5, 0x15, 0x80, 0xb3, 0xc1,
+	0xb4, 0x0f, 0xe7, 0x07, 0x9f, 0x46, 0x30, 0x3e, 0xe6, 0x3a, 0xb3, 0x46, 0xe5, 0xf9, 0x2d, 0xc4,
+	0xd0, 0x61, 0xdf, 0xab, 0x8b, 0xc1, 0xc4, 0x41, 0x7d, 0x89, 0x69, 0xc6, 0x6a, 0x26, 0xc6, 0x60,
+	0xfa, 0x92, 0xb5, 0x2c, 0x10, 0xfa, 0xf1, 0x31, 0x40, 0x7c, 0x1a, 0xa4, 0x6d, 0xca, 0x6b, 0xf3,
+	0x81, 0xc6, 0x14, 0x8a, 0x6b, 0xaa, 0x14, 0x0c, 0x0c, 0xef, 0xcb, 0xe5, 0xfc, 0xcf, 0xe0, 0xa7,
+	0xdc, 0x83, 0x8c, 0xfe, 0x7d, 0x6c, 0xac, 0xcd, 0x15, 0xba, 0x7c, 0xd2, 0x2b, 0x74, 0x65, 0xd0,
+	0x0a, 0x8d, 0xe1, 0xe1, 0xbb, 0xb6, 0x39, 0xb7, 0x34, 0x82, 0x56, 0xe1, 0xe1, 0x33, 0xe6, 0xde,
+	0x14, 0xfa, 0x6a, 0x3c, 0xe4, 0x43, 0xf5, 0x57, 0x4a, 0xe4, 0xf1, 0x81, 0x17, 0x8b, 0x13, 0xda,
+	0x81, 0xcc, 0xee, 0xaf, 0x9c, 0x4c, 0xf7, 0x9b, 0x9d, 0x52, 0xdd, 0xb7, 0x53, 0x86, 0xd9, 0xce,
+	0x7f, 0xa7, 0x34, 0x70, 0xb2, 0xe0, 0x45, 0xf4, 0xab, 0xb6, 0x25, 0xdf, 0x45, 0x4e, 0xf9, 0xdd,
+	0x2e, 0xc7, 0x63, 0x0e, 0x91, 0x99, 0x94, 0x15, 0xb3, 0x26, 0x10, 0x6c, 0xdc, 0xa1, 0x1a, 0xf6,
+	0x0f, 0x1c, 0x52, 0x03, 0xba, 0xc1, 0x57, 0x38, 0xcc, 0xcc, 0xc9, 0x9a, 0xc8, 0x29, 0x22, 0x33,
+	0x27, 0x36, 0x6c, 0x12, 0xb0, 0xb8, 0x5d, 0x79, 0x8d, 0x7d, 0xd4, 0xb0, 0x6c, 0x2a, 0xc5, 0x56,
+	0x79, 0x70, 0x8a, 0x2d, 0xef, 0xcf, 0x08, 0x7e, 0x5e, 0x37, 0x9a, 0x8f, 0x69, 0x2b, 0x91, 0xce,
+	0x1e, 0xce, 0x00, 0x67, 0x0f, 0xf3, 0x7d, 0xb2, 0x74, 0xa0, 0x80, 0xfd, 0xe5, 0x7d, 0x03, 0xf6,
+	0x63, 0xf0, 0xea, 0x64, 0x6b, 0x35, 0x0e, 0x76, 0xfc, 0x14, 0x1f, 0x02, 0xea, 0x15, 0xbb, 0x23,
+	0x1b, 0x8d, 0xab, 0x1a, 0x08, 0x36, 0x2e, 0xc6, 0x8e, 0xd6, 0x61, 0xf3, 0x69, 0x9c, 0xb2, 0xa8,
+	0x15, 0x7c, 0x24, 0xa8, 0x58, 0x92, 0x3a, 0xd0, 0xbe, 0x40, 0x80, 0xfe, 0x3a, 0xb8, 0xe6, 0x5a,
+	0x85, 0x28, 0xc8, 0x88, 0xbd, 0xe6, 0x5a, 0x74, 0x50, 0x96, 0xbe, 0x1a, 0x18, 0x7c, 0x98, 0x0f,
+	0x8c, 0xd9, 0x6e, 0xd7, 0xf8, 0xa2, 0x51, 0x3b, 0xf8, 0xf0, 0x95, 0x7e, 0x14, 0xc8, 0xab, 0x87,
+	0xaa, 0x3d, 0x55, 0xbc, 0xb8, 0x20, 0x9e, 0xd6, 0x94, 0x6a, 0x4f, 0x91, 0x59, 0x6c, 0x81, 0x89,
+	0x87, 0x49, 0xff, 0xf5, 0x4f, 0x1e, 0x05, 0x89, 0xbf, 0x37, 0x2f, 0x88, 0x8c, 0x24, 0x2a, 0xe9,
+	0xff, 0x95, 0x5c, 0xb4, 0x16, 0x0c, 0xaa, 0xef, 0xae, 0x93, 0xf3, 0x0a, 0x74, 0x29, 0x4c, 0x59,
+	0x9c, 0x92, 0x84, 0xce, 0xf9, 0x09, 0x33, 0xfa, 0xe0, 0x7e, 0x63, 0x9e, 0xa0, 0x7e, 0xfe, 0x4a,
+	0x90, 0x5e, 0xcd, 0xc3, 0x84, 0x25, 0xd8, 0x83, 0x0a, 0x3e, 0x6f, 0xd3, 0xd0, 0x5f, 0x6f, 0xd3,
+	0x95, 0xf9, 0x45, 0x71, 0x23, 0xd5, 0x8e, 0x1d, 0x12, 0x00, 0x1a, 0x47, 0x59, 0x5f, 0x4c, 0x0c,
+	0xb4, 0xbe, 0x58, 0x25, 0x67, 0x37, 0x9b, 0x5d, 0x3c, 0x65, 0x06, 0x4d, 0x3a, 0xdb, 0x64, 0xb6,
+	0xd0, 0xd8, 0x31, 0x3c, 0x4f, 0xa5, 0xf2, 0x4f, 0xbe, 0x32, 0xbf, 0xda, 0x87, 0x03, 0xb9, 0x35,
+	0x71, 0x8e, 0xb1, 0x64, 0x00, 0xf5, 0x47, 0x32, 0x36, 0xf3, 0x58, 0x08, 0x1c, 0x86, 0x16, 0xc0,
+	0x2c, 0xde, 0xc3, 0xd5, 0x34, 0xed, 0xaa, 0x63, 0x6d, 0xfd, 0xac, 0x9d, 0x9f, 0xe0, 0x72, 0x1f,
+	0x06, 0xe4, 0xd4, 0xc2, 0x53, 0x4f, 0x18, 0x31, 0xea, 0xf5, 0xc7, 0xec, 0x53, 0xcf, 0x0d, 0x5e,
+	0x0c, 0x12, 0xee, 0x7e, 0x90, 0xd4, 0x7b, 0x09, 0x65, 0x17, 0xe6, 0xdb, 0x51, 0xbc, 0xdd, 0x8e,
+	0xfc, 0xd6, 0x62, 0x8b, 0x86, 0x29, 0xfa, 0x52, 0xd7, 0x19, 0xf3, 0x0b, 0xa2, 0x6e, 0xfd, 0xe6,
+	0x00, 0x3c, 0x18, 0x48, 0x21, 0x9b, 0x60, 0xe3, 0xf1, 0x21, 0x13, 0x6c, 0xac, 0x92, 0xb3, 0x72,
+	0x5f, 0x5b, 0x99, 0x5f, 0x54, 0x1f, 0x5d, 0x3f, 0xcf, 0x04, 0x52, 0x5d, 0xb0, 0x98, 0x83, 0x03,
+	0xb9, 0x35, 0x71, 0x9c, 0x74, 0x02, 0x54, 0x37, 0x60, 0xf8, 0xaa, 0x27, 0x6c, 0x93, 0x9a, 0x65,
+	0x09, 0x00, 0x8d, 0x83, 0x15, 0xd6, 0x7b, 0x61, 0xab, 0x4d, 0x6f, 0xc2, 0x62, 0xfd, 0x49, 0xbb,
+	0xc2, 0x9c, 0x04, 0x80, 0xc6, 0xc1, 0xfe, 0xe3, 0xb5, 0x97, 0xfd, 0x7b, 0x8d, 0xd4, 0x6f, 0xd3,
+	0x90, 0x26, 0x49, 0xfd, 0x29, 0xdb, 0x82, 0x7b, 0xb9, 0x0f, 0x03, 0x72, 0x6a, 0x79, 0xbf, 0xef,
+	0x90, 0x53, 0x6a, 0xbd, 0x3d, 0x81, 0x28, 0x39, 0x6d, 0x3b, 0x4a, 0xce, 0x95, 0xa3, 0xef, 0x58,
+	0x4c, 0xf2, 0x01, 0xbe, 0x4c, 0x3f, 0x31, 0x45, 0x88, 0xde, 0xd5, 0xd4, 0x81, 0xc2, 0x19, 0x78,
+	0xa0, 0x78, 0x68, 0x77, 0x94, 0xbc, 0xf4, 0x0e, 0xd5, 0x07, 0x9b, 0xde, 0xa1, 0x41, 0xce, 0xc9,
+	0x09, 0xc0, 0x1f, 0xc0, 0x31, 0x38, 0x84, 0xdc, 0xa0, 0xc6, 0xb4, 0x15, 0xdb, 0x62, 0x1e, 0x12,
+	0xe4, 0xd7, 0xb5, 0x4e, 0xa2, 0xa3, 0xfb, 0x9e, 0x44, 0xd5, 0x9a, 0xbc, 0xb4, 0x91, 0x08, 0xbf,
+	0xfa, 0xcc, 0x9a, 0xbc, 0x74, 0xb9, 0x01, 0x1a, 0x27, 0x7f, 0x63, 0xae, 0x15, 0xb4, 0x31, 0x93,
+	0x03, 0x6f, 0xcc, 0x72, 0x8b, 0x18, 0x1f, 0xb8, 0x45, 0xc8, 0x87, 0xb6, 0x89, 0x81, 0x0f, 0x6d,
+	0xef, 0x21, 0x93, 0x41, 0xb8, 0x45, 0xe3, 0x20, 0xa5, 0x2d, 0x36, 0x17, 0xd8, 0xf6, 0x31, 0xa6,
+	0x8f, 0x65, 0x8b, 0x16, 0x14, 0x32, 0xd8, 0xf6, 0xbe, 0x36, 0x39, 0xc4, 0xbe, 0x36, 0xe0, 0x34,
+	0x31, 0x55, 0xcc, 0x69, 0xe2, 0xf4, 0xd1, 0x4f, 0x13, 0x67, 0x8e, 0xf5, 0x34, 0xe1, 0x16, 0x72,
+	0x9a, 0x18, 0x6a, 0xa3, 0x36, 0x54, 0x0a, 0x67, 0xf7, 0x51, 0x29, 0x0c, 0x3a, 0x4a, 0x9c, 0x3b,
+	0xf4, 0x51, 0x22, 0xff, 0x94, 0xf0, 0xe8, 0x6b, 0xa7, 0x84, 0x42, 0x4e, 0x09, 0xcf, 0x90, 0x6a,
+	0x8b, 0x76, 0xd3, 0x2d, 0x76, 0x42, 0x28, 0xeb, 0xfe, 0x5f, 0xc0, 0x42, 0xe0, 0x30, 0x9c, 0xda,
+	0x49, 0xd7, 0x8f, 0x13, 0x3a, 0xbf, 0x45, 0x9b, 0xdb, 0x51, 0x2f, 0xad, 0x3f, 0x69, 0x4f, 0xed,
+	0x86, 0x05, 0x85, 0x0c, 0xb6, 0x7d, 0x14, 0x79, 0xea, 0xa0, 0x47, 0x91, 0xa7, 0x0f, 0x7d, 0x14,
+	0x99, 0x3e, 0xd4, 0x51, 0xe4, 0xd3, 0x25, 0x72, 0x4e, 0x6f, 0xd6, 0xb8, 0x44, 0x72, 0xe3, 0x66,
+	0x8a, 0xa6, 0x7c, 0xdc, 0x62, 0xc1, 0x08, 0x31, 0xa4, 0x83, 0x2c, 0x29, 0x08, 0x18, 0x58, 0x2c,
+	0x52, 0x0f, 0x8d, 0x59, 0x40, 0x94, 0xec, 0x4e, 0x3e, 0x2f, 0xca, 0x41, 0x61, 0xe0, 0xb8, 0xc0,
+	0xff, 0x45, 0xd0, 0xc1, 0x6c, 0x48, 0x9d, 0x79, 0x0d, 0x02, 0x13, 0x0f, 0xad, 0x15, 0x9a, 0x72,
+	0x17, 0xc1, 0xdd, 0x7c, 0x82, 0xeb, 0x05, 0xd4, 0xc6, 0xa1, 0xa0, 0x52, 0x1c, 0x16, 0x49, 0xaa,
+	0xda, 0x2f, 0x0e, 0x96, 0x83, 0xc2, 0xf0, 0xfe, 0xdc, 0x21, 0x8f, 0xe7, 0x36, 0xc5, 0x09, 0x9c,
+	0xd0, 0xee, 0xd9, 0x27, 0xb4, 0x46, 0x51, 0x3a, 0x05, 0xe3, 0x2b, 0x06, 0x9c, 0xd6, 0xfe, 0xad,
+	0x43, 0x26, 0x35, 0xfe, 0x09, 0x7c, 0x6a, 0x60, 0x7f, 0x6a, 0x71, 0xea, 0x93, 0x5a, 0xdf, 0xb7,
+	0xfd, 0x72, 0x89, 0xa8, 0x94, 0x89, 0xb3, 0xcd, 0x74, 0x38, 0x57, 0x47, 0x0c, 0x37, 0xe7, 0xc7,
+	0x7e, 0x27, 0x29, 0xc6, 0xbc, 0xd1, 0xe6, 0xcf, 0xcc, 0x89, 0xf4, 0x8b, 0x2c, 0xfb, 0x99, 0x80,
+	0x60, 0xc8, 0x72, 0x46, 0xf3, 0x6c, 0x74, 0x2d, 0xe1, 0xb4, 0xaf, 0x73, 0x46, 0x8b, 0x72, 0x50,
+	0x18, 0xb8, 0x6e, 0x04, 0xcd, 0x28, 0x9c, 0x6f, 0xfb, 0x49, 0x52, 0xaf, 0xd8, 0xeb, 0xc6, 0xa2,
+	0x04, 0x80, 0xc6, 0x61, 0xd6, 0x41, 0x41, 0xd2, 0x6d, 0xfb, 0xbb, 0x86, 0x92, 0xcc, 0x08, 0xae,
+	0xab, 0x40, 0x60, 0xe2, 0x79, 0x1d, 0x52, 0xb7, 0x3f, 0x62, 0x81, 0x6e, 0x30, 0xd3, 0xfc, 0xa1,
+	0x9a, 0x13, 0x0d, 0xd4, 0x59, 0xad, 0xa5, 0x9e, 0x9f, 0x75, 0x76, 0x98, 0x95, 0x00, 0xd0, 0x38,
+	0xde, 0x3b, 0xc9, 0x23, 0x39, 0x6d, 0x36, 0x84, 0x05, 0xe4, 0xcf, 0x97, 0xc8, 0x94, 0x5d, 0x33,
+	0x61, 0x7e, 0xb7, 0x5c, 0xe6, 0x20, 0x69, 0x46, 0x3b, 0x34, 0xde, 0x45, 0x31, 0x9c, 0x8c, 0xdf,
+	0x6d, 0x1f, 0x06, 0xe4, 0xd4, 0x62, 0xd9, 0x4b, 0x5b, 0xea, 0xd3, 0xe5, 0xf0, 0xb8, 0x55, 0xe4,
+	0xf0, 0xd0, 0x2d, 0x6b, 0xf4, 0x8b, 0x66, 0x09, 0x26, 0x7f, 0x3c, 0x12, 0x32, 0xaf, 0x21, 0x74,
+	0xad, 0x4d, 0x83, 0x50, 0x7c, 0xb2, 0x18, 0x38, 0xea, 0x48, 0xb8, 0xdc, 0x8f, 0x02, 0x79, 0xf5,
+	0xbc, 0x2f, 0x55, 0x88, 0x8a, 0x1c, 0xc7, 0xac, 0x6a, 0x0b, 0xb2, 0x49, 0x3e, 0xa8, 0xf7, 0xb6,
+	0xea, 0xe9, 0xca, 0x5e, 0x66, 0x6e, 0x5c, 0xcd, 0x69, 0xbe, 0x87, 0xa8, 0x06, 0x5b, 0xd3, 0x20,
+	0x30, 0xf1, 0x50, 0x92, 0x76, 0xb0, 0x43, 0x79, 0xa5, 0x11, 0x5b, 0x92, 0x25, 0x09, 0x00, 0x8d,
+	0x83, 0x92, 0xb4, 0x82, 0x8d, 0x8d, 0xfa, 0xa8, 0x2d, 0x09, 0xb6, 0x0e, 0x30, 0x08, 0xcf, 0x6f,
+	0x1d, 0x6d, 0x8b, 0x6b, 0x90, 0x91, 0xdf, 0x3a, 0xda, 0x06, 0x06, 0xc1, 0x5e, 0x0a, 0xa3, 0xb8,
+	0xe3, 0xb7, 0x83, 0x97, 0x69, 0x4b, 0x71, 0x11, 0xd7, 0x1f, 0xd5, 0x4b, 0x37, 0xfa, 0x51, 0x20,
+	0xaf, 0x1e, 0x0e, 0xe8, 0x6e, 0x4c, 0x5b, 0x41, 0x33, 0x35, 0xa9, 0x11, 0x7b, 0x40, 0xaf, 0xf6,
+	0x61, 0x40, 0x4e, 0x2d, 0x0c, 0xdf, 0x2c, 0x23, 0xff, 0xc9, 0xc8, 0xeb, 0xe3, 0x76, 0xf8, 0x66,
+	0xb0, 0xc1, 0x90, 0xc5, 0xc7, 0x15, 0xab, 0x23, 0x32, 0x91, 0xd4, 0x27, 0xec, 0x15, 0x4b, 0x66,
+	0x28, 0x01, 0x85, 0xe1, 0x7d, 0xa2, 0x8c, 0x3b, 0xec, 0x80, 0x84, 0x3f, 0x27, 0x66, 0x03, 0x6f,
+	0x8f, 0xc8, 0xca, 0x10, 0x23, 0x12, 0xed, 0xcb, 0x31, 0x20, 0xa9, 0xb4, 0x2f, 0xaf, 0x0e, 0xb4,
+	0x2f, 0x37, 0xb0, 0xf2, 0xed, 0xcb, 0x47, 0x8a, 0xb2, 0x2f, 0x1f, 0x3d, 0xa4, 0x7d, 0xf9, 0xaf,
+	0x57, 0xc9, 0xa3, 0x2a, 0xfa, 0x23, 0x4d, 0xef, 0x46, 0xf1, 0x76, 0x10, 0x6e, 0xb2, 0x48, 0x40,
+	0x3f, 0xe2, 0xc8, 0x90, 0x46, 0x4b, 0xa6, 0xcb, 0xf8, 0x46, 0x31, 0x2b, 0x9c, 0xcd, 0x6c, 0x66,
+	0xcd, 0x60, 0xc4, 0xed, 0x94, 0x32, 0xa1, 0x93, 0x38, 0x08, 0x2c, 0x89, 0xdc, 0x8f, 0x12, 0x22,
+	0x1f, 0x38, 0x36, 0xe4, 0x0a, 0xbc, 0x58, 0x8c, 0x7c, 0xf8, 0xc0, 0xa4, 0xce, 0xb7, 0x6b, 0x8a,
+	0x09, 0x18, 0x0c, 0xd1, 0xb2, 0xcd, 0x8c, 0xc4, 0x35, 0xfe, 0xec, 0x87, 0x8f, 0xa5, 0x6d, 0x86,
+	0x71, 0xa6, 0x07, 0x32, 0x1a, 0x84, 0x9b, 0x38, 0x4e, 0x84, 0x1d, 0xee, 0x1b, 0xf3, 0x82, 0xa4,
+	0x2e, 0x45, 0x7e, 0x6b, 0xce, 0x6f, 0xfb, 0x61, 0x13, 0x33, 0x96, 0x32, 0x74, 0x7d, 0x0d, 0x14,
+	0x05, 0x20, 0x09, 0xe1, 0x38, 0x47, 0x8b, 0xe4, 0x38, 0xf4, 0xdb, 0x37, 0x61, 0xc9, 0x1a, 0xe7,
+	0x97, 0x8c, 0x72, 0xb0, 0xb0, 0xce, 0x7f, 0x33, 0x39, 0xd3, 0xd7, 0x99, 0x07, 0xf2, 0x9d, 0x3f,
+	0x42, 0x78, 0xd4, 0x5f, 0x18, 0xd1, 0x9b, 0x16, 0x06, 0x84, 0x75, 0x3f, 0xee, 0x90, 0xf1, 0x58,
+	0xf7, 0xa8, 0x38, 0xbf, 0x16, 0x38, 0x44, 0xd4, 0x36, 0x63, 0x14, 0x82, 0xc9, 0x12, 0xc7, 0x68,
+	0xd7, 0x8f, 0x69, 0x78, 0xdc, 0x63, 0x74, 0x55, 0x31, 0x01, 0x83, 0xa1, 0xbb, 0x65, 0x79, 0x4a,
+	0x5e, 0x3e, 0xba, 0xa7, 0x24, 0x4b, 0x7f, 0x90, 0x97, 0xdb, 0xfe, 0x0b, 0x0e, 0x99, 0x0c, 0xad,
+	0x91, 0x5b, 0x8c, 0x73, 0x44, 0xfe, 0xac, 0x98, 0x73, 0xf1, 0xf2, 0x6d, 0x97, 0x41, 0x86, 0x7f,
+	0xde, 0x96, 0x56, 0x3d, 0xe0, 0x96, 0xe6, 0x91, 0x11, 0xe6, 0xf1, 0x6c, 0xbd, 0x07, 0x33, 0x6f,
+	0xe8, 0x04, 0x04, 0xc4, 0x0d, 0xc9, 0x08, 0x0f, 0xd6, 0x5e, 0x1f, 0x2d, 0x22, 0x54, 0x8e, 0x19,
+	0xf1, 0x9d, 0xf3, 0xe3, 0x25, 0x20, 0xb8, 0x60, 0x00, 0x56, 0xed, 0x03, 0x3e, 0x76, 0xb8, 0x00,
+	0xac, 0x79, 0xbe, 0xe2, 0xde, 0x7f, 0xaf, 0x90, 0xd3, 0xb2, 0x45, 0xa4, 0x63, 0x15, 0xee, 0x8f,
+	0x9c, 0xaf, 0x3e, 0x2b, 0xab, 0xfd, 0xf1, 0xaa, 0x04, 0x80, 0xc6, 0xc1, 0xf3, 0x58, 0x2f, 0xc1,
+	0x40, 0x9e, 0xe1, 0x52, 0xb0, 0x9e, 0x08, 0x63, 0x06, 0x35, 0x51, 0x6e, 0x6a, 0x10, 0x98, 0x78,
+	0xcc, 0x51, 0xbd, 0x69, 0x46, 0x8b, 0xd1, 0x8e, 0xea, 0x4d, 0x11, 0x75, 0x49, 0xc0, 0xdd, 0x1f,
+	0xcc, 0xcd, 0x40, 0x58, 0x8c, 0x3b, 0x72, 0x9f, 0x3f, 0xd9, 0xc1, 0x52, 0x0f, 0xba, 0x7f, 0xdd,
+	0x21, 0xe7, 0x78, 0xa9, 0x6c, 0x49, 0x1e, 0x3d, 0x30, 0xa9, 0x8f, 0x1c, 0x93, 0x7c, 0x5a, 0xcb,
+	0x9f, 0xc7, 0x16, 0xf2, 0xa5, 0xc1, 0x20, 0x19, 0x53, 0xdb, 0x56, 0xb4, 0x37, 0xb9, 0x75, 0x1c,
+	0x35, 0x14, 0x92, 0x45, 0x54, 0x4f, 0x35, 0xbb, 0x3c, 0x81, 0x2c, 0x77, 0xcc, 0x6e, 0x6a, 0x2e,
+	0xa3, 0x27, 0x1f, 0x24, 0xee, 0xe0, 0x47, 0x41, 0x79, 0xba, 0xac, 0x0e, 0x3c, 0x5d, 0xa2, 0xf9,
+	0x44, 0xd0, 0xaa, 0x8f, 0x64, 0xcc, 0x27, 0x16, 0x17, 0x00, 0xcb, 0xbd, 0x3f, 0xac, 0x6a, 0x9d,
+	0x84, 0xf0, 0xf6, 0xfd, 0xaa, 0xf8, 0xec, 0x0d, 0x95, 0x05, 0x83, 0x7f, 0xf9, 0x8d, 0xbe, 0x2c,
+	0x18, 0xdf, 0x74, 0x70, 0x67, 0x6e, 0xde, 0x40, 0x83, 0x92, 0x60, 0x8c, 0xee, 0xe3, 0xc9, 0x7d,
+	0x87, 0x8c, 0xe1, 0x15, 0x8c, 0x29, 0x17, 0xc7, 0x2c, 0xa1, 0xc6, 0xae, 0x8a, 0xf2, 0x57, 0xef,
+	0x4f, 0x7f, 0xe3, 0xc1, 0xc5, 0x92, 0xb5, 0x41, 0xd1, 0x77, 0x13, 0x52, 0xc3, 0xff, 0x99, 0xd3,
+	0xb9, 0xb8, 0xdc, 0xdd, 0x54, 0x6b, 0xa6, 0x04, 0x14, 0xe2, 0xd1, 0xae, 0xf9, 0xb8, 0x21, 0xa9,
+	0x21, 0x22, 0x67, 0xca, 0xef, 0x80, 0xab, 0x92, 0x69, 0x43, 0x02, 0x5e, 0xbd, 0x3f, 0xfd, 0xae,
+	0x83, 0x33, 0x55, 0xd5, 0x41, 0xb3, 0x30, 0xb6, 0xc6, 0xf1, 0x41, 0x5b, 0xa3, 0xf7, 0x3f, 0x2a,
+	0x7a, 0x7c, 0xf3, 0xae, 0xff, 0xea, 0x18, 0xdf, 0xcf, 0x65, 0xc6, 0xf7, 0x85, 0xbe, 0xf1, 0x3d,
+	0x89, 0x6d, 0x96, 0x93, 0xb6, 0xe5, 0xa4, 0x0f, 0x0b, 0xfb, 0xeb, 0x24, 0xd8, 0x29, 0x89, 0x65,
+	0x4b, 0x4f, 0x56, 0xe3, 0x5e, 0x88, 0x79, 0x4a, 0x78, 0x58, 0x68, 0xe3, 0x94, 0x64, 0x81, 0x21,
+	0x8b, 0x8f, 0x17, 0x7f, 0x1c, 0x17, 0xb7, 0xfd, 0x1d, 0x3e, 0xf2, 0x8c, 0xa0, 0xac, 0x0d, 0x51,
+	0x0e, 0x0a, 0xc3, 0xdd, 0x22, 0x4f, 0x4a, 0x02, 0x0b, 0xb4, 0x4d, 0xf1, 0x83, 0x98, 0x59, 0x68,
+	0xdc, 0xf1, 0x53, 0xa9, 0x76, 0x18, 0x9b, 0xfb, 0x1a, 0x41, 0xe1, 0x49, 0xd8, 0x03, 0x17, 0xf6,
+	0xa4, 0xe4, 0xfd, 0x2e, 0x33, 0xad, 0x30, 0x62, 0x6f, 0xe0, 0xe8, 0x6b, 0x07, 0x9d, 0x40, 0xc6,
+	0x8e, 0x55, 0xa3, 0x6f, 0x09, 0x0b, 0x81, 0xc3, 0x30, 0xd7, 0xca, 0xba, 0xdf, 0xdc, 0x8e, 0x36,
+	0x36, 0x8a, 0xc9, 0xba, 0x3b, 0xc7, 0x89, 0xb1, 0x38, 0xd3, 0xa3, 0xe2, 0xc7, 0xab, 0xfa, 0x5f,
+	0x90, 0xdc, 0x78, 0xd6, 0xb5, 0x8d, 0x98, 0x26, 0x5b, 0x42, 0x71, 0x67, 0x64, 0x5d, 0x63, 0xc5,
+	0x20, 0xe1, 0xde, 0x6f, 0x55, 0xc9, 0x94, 0xb4, 0xeb, 0x93, 0x99, 0x0d, 0xcc, 0xfc, 0x63, 0xa5,
+	0x7d, 0xf3, 0x8f, 0x7d, 0x88, 0x90, 0x16, 0xed, 0xb6, 0xa3, 0x5d, 0x76, 0x8e, 0xac, 0x1c, 0x3e,
+	0xdc, 0xfe, 0x82, 0xa2, 0x02, 0x06, 0x45, 0x11, 0x5b, 0xb7, 0x9a, 0x9b, 0x67, 0x41, 0xa7, 0xf1,
+	0x1e, 0x39, 0xd9, 0x34, 0xde, 0x01, 0x99, 0xe2, 0x22, 0xaa, 0x60, 0x18, 0x87, 0x88, 0x79, 0xc1,
+	0xdc, 0x09, 0x17, 0x6c, 0x32, 0x90, 0xa5, 0xfb, 0x20, 0x73, 0x9c, 0x62, 0x8c, 0xa9, 0x58, 0x05,
+	0x39, 0xaf, 0xe9, 0x18, 0x53, 0x3a, 0xb6, 0xb9, 0x86, 0xf7, 0xc5, 0xf5, 0x21, 0x0f, 0x2a, 0xae,
+	0x8f, 0xf7, 0x85, 0x32, 0x5e, 0x40, 0xb8, 0x5c, 0x07, 0x4e, 0x71, 0x7f, 0xd5, 0x48, 0x71, 0x7f,
+	0xb0, 0xfe, 0x1c, 0xcb, 0xa4, 0xc2, 0x7f, 0x92, 0x54, 0x52, 0x7f, 0x53, 0x7a, 0x3f, 0x33, 0xe8,
+	0x9a, 0x8f, 0x79, 0x31, 0xb1, 0xf4, 0x20, 0xc9, 0x08, 0xd0, 0xde, 0x48, 0x46, 0x83, 0x32, 0xde,
+	0x1d, 0xb5, 0xbd, 0x91, 0x09, 0x04, 0x1b, 0x17, 0xfd, 0x6b, 0x48, 0x4c, 0xd5, 0xf5, 0x66, 0xa4,
+	0x88, 0x31, 0xa4, 0x96, 0x01, 0x49, 0xd7, 0x8c, 0xc7, 0xa2, 0xae, 0x35, 0x06, 0x5b, 0xef, 0x53,
+	0x0e, 0x39, 0xd3, 0x57, 0xcb, 0xed, 0x92, 0x11, 0x3c, 0x1a, 0x04, 0x69, 0x31, 0xe1, 0x53, 0xe7,
+	0x19, 0x2d, 0xd9, 0xe3, 0x7c, 0x1f, 0xe3, 0x65, 0x20, 0xf8, 0x78, 0xbf, 0x38, 0x41, 0xce, 0x36,
+	0xe6, 0x97, 0x65, 0xb8, 0xfc, 0x63, 0x73, 0xe7, 0xce, 0xe3, 0x71, 0x72, 0xee, 0xdc, 0x03, 0xb8,
+	0xb7, 0x0d, 0x77, 0xee, 0xb6, 0xe1, 0xce, 0x6d, 0xfb, 0xd6, 0x96, 0x8b, 0xf0, 0xad, 0xcd, 0x93,
+	0x60, 0x18, 0xdf, 0xda, 0x63, 0xf3, 0xef, 0xde, 0x53, 0xa0, 0x03, 0xf9, 0x77, 0x2b, 0xe7, 0xf7,
+	0x42, 0x5c, 0xf9, 0x06, 0x74, 0x55, 0xae, 0xf3, 0xbb, 0x72, 0x3c, 0xe6, 0x6e, 0xaa, 0xf5, 0x91,
+	0x22, 0x1c, 0x8f, 0xf3, 0x04, 0x18, 0xc2, 0xf1, 0x98, 0xff, 0xb0, 0x9c, 0xdd, 0x47, 0x8b, 0x70,
+	0x76, 0xcf, 0x13, 0x67, 0x5f, 0x67, 0x77, 0xcc, 0xe0, 0xdf, 0x8e, 0x42, 0xba, 0x1a, 0x47, 0x69,
+	0xd4, 0x8c, 0xda, 0xf5, 0x31, 0x7b, 0x81, 0x9c, 0x37, 0x81, 0x60, 0xe3, 0x0e, 0xf2, 0x94, 0xaf,
+	0x1d, 0xd5, 0x53, 0x9e, 0x3c, 0x20, 0x4f, 0x79, 0xc3, 0x17, 0x7c, 0xbc, 0x08, 0x5f, 0xf0, 0xbc,
+	0x1e, 0x19, 0xca, 0x17, 0xfc, 0x8b, 0x0e, 0x39, 0xe5, 0xdf, 0x65, 0xf7, 0x16, 0xbe, 0x0a, 0xb3,
+	0xd7, 0xbc, 0xf1, 0x67, 0x5f, 0x3c, 0x86, 0x01, 0x7b, 0xbb, 0xa1, 0xd9, 0xcc, 0x9d, 0x61, 0xfe,
+	0x39, 0x66, 0x11, 0xd8, 0x82, 0x1c, 0xc5, 0x7f, 0xfc, 0x87, 0x4a, 0xe4, 0xf5, 0xfb, 0x8a, 0xe0,
+	0xde, 0xc5, 0x37, 0xa5, 0x4d, 0x31, 0x50, 0xeb, 0x4e, 0x11, 0x26, 0xd2, 0x6b, 0x92, 0x9e, 0xf0,
+	0x6d, 0x54, 0xe4, 0xc1, 0x60, 0xc5, 0x2c, 0xa3, 0xa3, 0x76, 0x5f, 0xe4, 0x73, 0x88, 0xda, 0x14,
+	0x18, 0x04, 0x0f, 0x42, 0x31, 0xdd, 0xc4, 0xc3, 0x7d, 0xd9, 0x3e, 0x08, 0x01, 0x2b, 0x05, 0x01,
+	0x45, 0x05, 0xac, 0xdf, 0x6e, 0x73, 0x3f, 0x4b, 0xca, 0x8d, 0x41, 0x0c, 0x05, 0xec, 0xac, 0x06,
+	0x81, 0x89, 0xe7, 0xfd, 0xc7, 0x12, 0x99, 0xde, 0x67, 0x4d, 0xe9, 0xf3, 0xaf, 0xaf, 0x0e, 0xed,
+	0x5f, 0x2f, 0xfc, 0xc4, 0x46, 0x06, 0xf8, 0x89, 0xe1, 0x23, 0x3e, 0xc5, 0x3c, 0xc0, 0xdc, 0xd6,
+	0x32, 0x13, 0xc6, 0x73, 0x4d, 0x83, 0xc0, 0xc4, 0xc3, 0x55, 0x6c, 0xd2, 0x6f, 0x36, 0x69, 0x92,
+	0x48, 0x47, 0x30, 0xa1, 0x10, 0x2f, 0xcc, 0xcb, 0x8c, 0xbd, 0x33, 0xcc, 0x5a, 0x2c, 0x20, 0xc3,
+	0x32, 0xdb, 0xe0, 0xb5, 0x21, 0x1b, 0xfc, 0xc7, 0x4a, 0xe4, 0xa9, 0x3d, 0x77, 0xb7, 0xa1, 0x7d,
+	0xf4, 0x7a, 0x09, 0x8d, 0xb3, 0x03, 0x07, 0x8d, 0xe5, 0x81, 0x41, 0x78, 0x2b, 0x75, 0xbb, 0xca,
+	0x20, 0xbe, 0x78, 0xa7, 0x56, 0xde, 0x4a, 0x16, 0x0b, 0xc8, 0xb0, 0x3c, 0xec, 0xb0, 0xfc, 0xad,
+	0x0a, 0x79, 0x66, 0x88, 0x33, 0x40, 0x81, 0xce, 0xbf, 0xb6, 0x63, 0x7b, 0xf9, 0x01, 0x39, 0xb6,
+	0x1f, 0xae, 0xb9, 0x5e, 0xf3, 0x87, 0x1f, 0xca, 0xc9, 0xf8, 0x27, 0x4b, 0xe4, 0xfc, 0xe0, 0x03,
+	0x8b, 0xfb, 0x6e, 0x54, 0x89, 0x49, 0x53, 0x42, 0xd3, 0x27, 0xfe, 0x11, 0xae, 0x0e, 0xb3, 0x40,
+	0x90, 0xc5, 0x45, 0xb7, 0x76, 0xcc, 0xff, 0x9f, 0x5c, 0xba, 0x17, 0xb0, 0x84, 0x4e, 0x65, 0xe9,
+	0xd6, 0xbe, 0xaa, 0x4a, 0xc1, 0xc0, 0x40, 0x76, 0xec, 0xd7, 0x02, 0x06, 0x4b, 0xe1, 0x95, 0xf8,
+	0xd5, 0xf3, 0x11, 0x99, 0x35, 0xdd, 0x00, 0x41, 0x16, 0x17, 0xd9, 0x31, 0x33, 0x00, 0x2e, 0x68,
+	0x45, 0x7b, 0xd1, 0x2f, 0xa9, 0x52, 0x30, 0x30, 0xb2, 0xde, 0xfe, 0xd5, 0xfd, 0xbd, 0xfd, 0xbd,
+	0xbf, 0x5f, 0x22, 0x8f, 0x0f, 0x3c, 0xf0, 0x0e, 0xb7, 0x4c, 0x3d, 0x7c, 0x1e, 0xf7, 0x87, 0x9c,
+	0x61, 0x07, 0xf2, 0xd4, 0xf6, 0xfe, 0x60, 0xc0, 0x48, 0x13, 0x5e, 0xd8, 0x87, 0x0f, 0x58, 0xf3,
+	0xf0, 0xb5, 0x67, 0x9f, 0xe3, 0x75, 0xe5, 0x00, 0x8e, 0xd7, 0x99, 0xce, 0xa8, 0x0e, 0xb9, 0x3b,
+	0xfc, 0x71, 0x65, 0x60, 0xf3, 0xe2, 0x05, 0x79, 0xa8, 0xc7, 0x86, 0x05, 0x72, 0x3a, 0x08, 0x9b,
+	0xed, 0x5e, 0x8b, 0x36, 0x7a, 0xeb, 0x22, 0xae, 0x1c, 0x0f, 0x9e, 0xac, 0x1c, 0x89, 0x16, 0x33,
+	0x70, 0xe8, 0xab, 0xf1, 0x10, 0x3a, 0xc2, 0x1f, 0xae, 0x49, 0x0f, 0xb8, 0x72, 0xaf, 0x90, 0x73,
+	0xb2, 0x29, 0xb6, 0xfc, 0x98, 0xb6, 0xc4, 0x66, 0x9b, 0x08, 0xd7, 0xb1, 0xc7, 0xb9, 0xfb, 0x59,
+	0x0e, 0x02, 0xe4, 0xd7, 0xc3, 0x2e, 0x4b, 0xa3, 0x6e, 0xd0, 0xac, 0x8f, 0xd9, 0x5d, 0xb6, 0x86,
+	0x85, 0xc0, 0x61, 0x7a, 0xbf, 0xa8, 0x9d, 0xcc, 0x7e, 0x71, 0x95, 0x4c, 0x35, 0x1a, 0x57, 0xad,
+	0xc8, 0xee, 0x98, 0xda, 0x3d, 0x08, 0x37, 0x99, 0x53, 0x51, 0x28, 0xcf, 0x1c, 0x3a, 0xb5, 0xbb,
+	0x06, 0x81, 0x89, 0xe7, 0xfd, 0x53, 0x87, 0x9c, 0x12, 0xa4, 0x82, 0x70, 0xf3, 0xf0, 0x84, 0xf0,
+	0xbc, 0xb3, 0x4d, 0x77, 0x0d, 0xe7, 0x0a, 0x75, 0xde, 0xb9, 0xce, 0x8b, 0x41, 0xc2, 0x11, 0x15,
+	0xb5, 0x68, 0x34, 0x4c, 0xb3, 0xa6, 0x15, 0xf3, 0xbc, 0x18, 0x24, 0x5c, 0x50, 0x55, 0xde, 0x14,
+	0x36, 0x55, 0x2c, 0x06, 0x09, 0xf7, 0xfe, 0xc8, 0x21, 0x67, 0xac, 0x2f, 0x39, 0x01, 0x77, 0x81,
+	0xae, 0xed, 0x2e, 0x70, 0xd4, 0x70, 0xf7, 0xa6, 0xf4, 0x03, 0x3c, 0x22, 0x3e, 0x44, 0x6a, 0x6a,
+	0xa6, 0x71, 0x2f, 0x18, 0xb5, 0xbc, 0xf5, 0x79, 0xc1, 0x48, 0x08, 0x18, 0x58, 0xee, 0x53, 0xfc,
+	0x8a, 0x9a, 0x59, 0xa7, 0x71, 0xa4, 0x61, 0xb9, 0xf7, 0x56, 0x32, 0x61, 0x0d, 0xab, 0x67, 0x48,
+	0x75, 0x9b, 0xee, 0x2e, 0x2e, 0x64, 0x57, 0xac, 0xeb, 0x58, 0x08, 0x1c, 0xe6, 0xfd, 0x9d, 0x32,
+	0xc9, 0x64, 0x86, 0xc5, 0xb0, 0xed, 0x98, 0xd9, 0x96, 0x15, 0x16, 0x13, 0xb6, 0x7d, 0x41, 0x92,
+	0xd3, 0xaf, 0xa5, 0xaa, 0x08, 0x34, 0x33, 0xf7, 0x23, 0x3c, 0x42, 0xba, 0x60, 0x5d, 0x2a, 0x22,
+	0x0c, 0x46, 0x43, 0xd1, 0x33, 0x9a, 0x57, 0x95, 0x81, 0xc1, 0xcf, 0x4d, 0x49, 0x6d, 0x4b, 0x66,
+	0xe1, 0x2c, 0x66, 0xa3, 0x53, 0x49, 0x3d, 0xf9, 0xe1, 0x5c, 0xfd, 0x04, 0xcd, 0x88, 0x3d, 0x78,
+	0x36, 0xb7, 0x68, 0xab, 0xd7, 0x96, 0xbb, 0x9c, 0x7e, 0xf0, 0x14, 0xe5, 0xa0, 0x30, 0xbc, 0xff,
+	0x52, 0x21, 0x67, 0xed, 0xee, 0x12, 0x6f, 0xe1, 0x3f, 0xe5, 0x90, 0xc7, 0xda, 0x7e, 0x92, 0x36,
+	0x7a, 0xec, 0x42, 0xb9, 0xd1, 0x6b, 0xaf, 0x64, 0x42, 0xef, 0x1f, 0x55, 0x29, 0xa7, 0x08, 0x67,
+	0xf3, 0x2b, 0xcf, 0x3d, 0x81, 0x8e, 0x99, 0x4b, 0xf9, 0xcc, 0x61, 0x90, 0x54, 0xa8, 0xc9, 0x3c,
+	0xdd, 0xec, 0xc5, 0x31, 0x0d, 0x53, 0x2d, 0x2a, 0xef, 0xf3, 0x1b, 0x85, 0x34, 0xbb, 0x16, 0xf0,
+	0x2c, 0x6e, 0xbc, 0xf3, 0x19, 0x5e, 0xd0, 0xc7, 0xbd, 0x2f, 0xcb, 0x70, 0xf9, 0x01, 0x66, 0x19,
+	0xc6, 0xf4, 0xd0, 0x5b, 0x56, 0xba, 0xf9, 0x62, 0x8c, 0xaa, 0xec, 0x14, 0xf6, 0xda, 0xfb, 0xd0,
+	0x2e, 0x87, 0x0c, 0x6f, 0xef, 0x1f, 0xe1, 0xe1, 0x73, 0xe0, 0x10, 0x78, 0x2d, 0x57, 0xf6, 0xfe,
+	0xb9, 0xb2, 0xbd, 0xbf, 0x57, 0x22, 0xcc, 0x7a, 0x66, 0x4e, 0xa6, 0xcc, 0xdc, 0xc7, 0xc3, 0xe9,
+	0x05, 0x32, 0x96, 0x98, 0x19, 0xfd, 0xc6, 0x9f, 0x7d, 0xeb, 0x90, 0xfb, 0x9f, 0x99, 0x99, 0x8f,
+	0x9f, 0xe9, 0xe4, 0x2f, 0x50, 0x24, 0x31, 0xbe, 0x34, 0xda, 0xb4, 0x48, 0x8b, 0xf3, 0x8b, 0xc3,
+	0xd1, 0x66, 0xc7, 0x5d, 0xb4, 0x88, 0x31, 0xf6, 0x13, 0xa4, 0x02, 0x9c, 0x18, 0xcf, 0xf3, 0xd9,
+	0x4b, 0xe4, 0x5a, 0x66, 0xe4, 0xf9, 0xec, 0xb1, 0xac, 0x19, 0xf8, 0x07, 0xd7, 0x3c, 0xbf, 0xdb,
+	0x8d, 0xa3, 0x1d, 0xbf, 0x9d, 0xbd, 0xf7, 0xcc, 0x8a, 0x72, 0x50, 0x18, 0xde, 0x27, 0xcb, 0x64,
+	0x4a, 0xb5, 0x9b, 0x30, 0x6d, 0xdb, 0xbf, 0xf5, 0xde, 0x8b, 0xe1, 0x1c, 0x7a, 0xc9, 0x21, 0x13,
+	0xd0, 0x1b, 0xa1, 0x1f, 0x38, 0x0d, 0x50, 0xd4, 0xd0, 0x68, 0x36, 0xa6, 0x49, 0xaf, 0xc3, 0x48,
+	0x97, 0x0f, 0x67, 0x34, 0x0b, 0x92, 0x00, 0x68, 0x5a, 0xb6, 0xd9, 0x55, 0xe5, 0xf8, 0xcd, 0xae,
+	0x4c, 0x5b, 0x9b, 0xea, 0x7e, 0xb6, 0x36, 0xde, 0x9f, 0x8c, 0x90, 0x53, 0x56, 0xbe, 0x11, 0xcb,
+	0x48, 0xc4, 0xd9, 0xd7, 0x48, 0x04, 0x47, 0x46, 0xdc, 0x0b, 0x45, 0xfa, 0x52, 0x63, 0x64, 0x60,
+	0x21, 0x70, 0x98, 0x58, 0x47, 0xa0, 0x17, 0x0a, 0xab, 0x15, 0x73, 0x1d, 0x81, 0x5e, 0x08, 0x02,
+	0x8a, 0xe6, 0xf8, 0x13, 0x6c, 0xeb, 0x16, 0xd6, 0x38, 0xf5, 0x4a, 0x11, 0x26, 0x50, 0x0d, 0x83,
+	0x22, 0x9f, 0xce, 0x66, 0x09, 0x58, 0x1c, 0x31, 0x6f, 0x6c, 0x4d, 0xda, 0x78, 0xcb, 0x37, 0xf5,
+	0x46, 0xb1, 0xe9, 0x5c, 0x32, 0x67, 0x26, 0x59, 0xc2, 0x4c, 0x2e, 0xc4, 0xbf, 0x98, 0x33, 0x97,
+	0xff, 0x2b, 0x56, 0xc4, 0xc2, 0x4d, 0x43, 0x48, 0x8e, 0xed, 0x0b, 0x26, 0x1e, 0xf3, 0xc3, 0x60,
+	0x83, 0x26, 0xa9, 0x5c, 0xfd, 0x78, 0xe2, 0x31, 0x59, 0x08, 0x1a, 0x8e, 0x4a, 0xa2, 0x84, 0x7d,
+	0x58, 0x6a, 0xd8, 0x90, 0xb0, 0xcd, 0xaf, 0xa1, 0x8b, 0xc1, 0xc4, 0x31, 0x0d, 0x5e, 0xc8, 0x03,
+	0x35, 0x78, 0x19, 0xdf, 0xc7, 0xe0, 0xa5, 0x41, 0xce, 0xf9, 0xbd, 0x34, 0x42, 0x4b, 0xb9, 0xd9,
+	0x14, 0x9f, 0xdf, 0xd2, 0x84, 0xa7, 0xa8, 0x99, 0x60, 0xf3, 0x49, 0x27, 0xff, 0xa2, 0xed, 0x8d,
+	0x3e, 0x24, 0xc8, 0xaf, 0xeb, 0xfd, 0x8c, 0x43, 0xce, 0xe5, 0x0e, 0x85, 0x87, 0xd7, 0x95, 0xcd,
+	0xfb, 0xae, 0x11, 0xf2, 0x48, 0x4e, 0x36, 0x22, 0x77, 0xd7, 0x9c, 0x24, 0x4e, 0x11, 0x07, 0x18,
+	0xdb, 0xc8, 0x59, 0x2d, 0xa7, 0xfd, 0x33, 0xe3, 0x60, 0x36, 0x6c, 0xda, 0x8e, 0xac, 0x7c, 0xb2,
+	0x76, 0x64, 0xc6, 0x58, 0xaf, 0x3c, 0xd0, 0xb1, 0x5e, 0xdd, 0x67, 0xac, 0xff, 0xb4, 0x43, 0xea,
+	0x9d, 0x01, 0x59, 0x51, 0xeb, 0x23, 0x45, 0x1c, 0x95, 0x07, 0xe5, 0x5c, 0x9d, 0x7b, 0x12, 0x23,
+	0x84, 0x0c, 0x82, 0xc2, 0x40, 0xa9, 0xdc, 0x7b, 0x68, 0x7e, 0x99, 0x32, 0x9d, 0x15, 0xb7, 0x4c,
+	0x58, 0x3e, 0xfa, 0x02, 0x6d, 0x1c, 0x43, 0x74, 0xcb, 0xce, 0x71, 0x2e, 0x20, 0xd9, 0x79, 0x7f,
+	0x54, 0x21, 0xec, 0x9e, 0xc9, 0x72, 0x5d, 0xec, 0xba, 0x1f, 0x33, 0xd3, 0xa9, 0x39, 0x45, 0xa5,
+	0xfe, 0xe2, 0xc4, 0x55, 0x3a, 0x36, 0xde, 0x77, 0x79, 0xd9, 0xd9, 0xb2, 0x6b, 0x70, 0x69, 0x88,
+	0x35, 0xb8, 0x2d, 0xf3, 0xd6, 0x95, 0x8b, 0xcf, 0x5b, 0x57, 0xcb, 0xe6, 0xac, 0xdb, 0x7b, 0x70,
+	0x55, 0x1e, 0xca, 0xc1, 0xd5, 0x77, 0x06, 0xa9, 0x9e, 0xf4, 0x19, 0xc4, 0xfb, 0x65, 0x87, 0x3c,
+	0x92, 0x33, 0x10, 0xf4, 0x59, 0xcb, 0xd9, 0xe3, 0xac, 0xf5, 0xf5, 0xec, 0x7e, 0xc1, 0xb6, 0x25,
+	0x71, 0x26, 0xd3, 0xc7, 0x3f, 0x51, 0x0e, 0x0a, 0x03, 0x15, 0x56, 0x7e, 0xbb, 0x1d, 0xdd, 0xbd,
+	0xd4, 0xe9, 0xa6, 0xbb, 0xe2, 0x74, 0xa6, 0x34, 0x2a, 0xb3, 0x0a, 0x02, 0x06, 0x96, 0xfb, 0xb5,
+	0x64, 0x94, 0x47, 0x9a, 0x6a, 0x89, 0x27, 0x91, 0x71, 0x9c, 0x2b, 0x3c, 0x0e, 0x55, 0x0b, 0x24,
+	0xcc, 0xfb, 0x64, 0x89, 0x18, 0x3a, 0x19, 0x7c, 0xc8, 0x30, 0xe3, 0x3b, 0x67, 0x1f, 0x32, 0xcc,
+	0x70, 0xd0, 0x60, 0x61, 0x0e, 0x91, 0x4d, 0x3c, 0x42, 0x3f, 0xdb, 0x5d, 0x0c, 0xbe, 0x53, 0xc8,
+	0xa8, 0x16, 0x17, 0xd6, 0x25, 0x46, 0x52, 0x46, 0x78, 0xc5, 0xff, 0x41, 0xb0, 0xe1, 0x76, 0xd8,
+	0xdd, 0x08, 0x63, 0xf6, 0x64, 0xb4, 0xa0, 0xc0, 0x8b, 0x41, 0xc2, 0xbd, 0xbf, 0x2a, 0x9b, 0x81,
+	0x6b, 0x74, 0xb4, 0x63, 0x80, 0x73, 0x40, 0xc7, 0x80, 0x8f, 0x10, 0xd2, 0x8c, 0x3a, 0x5d, 0x3f,
+	0xa6, 0xad, 0xb5, 0xa8, 0x18, 0x35, 0xda, 0xbc, 0xa2, 0xa7, 0x3b, 0x5d, 0x97, 0x81, 0xc1, 0xcf,
+	0xda, 0x76, 0xcb, 0xfb, 0x6e, 0xbb, 0xd6, 0x0e, 0x54, 0xd9, 0x7b, 0x07, 0xf2, 0xbe, 0x54, 0x22,
+	0xd6, 0x6c, 0x40, 0x25, 0x2e, 0x8a, 0xbb, 0x2b, 0x96, 0xd4, 0x95, 0xe2, 0xa6, 0x1e, 0xee, 0xa2,
+	0x62, 0x9d, 0x62, 0xff, 0x02, 0x67, 0xe4, 0xb6, 0x85, 0x13, 0x44, 0x21, 0x8a, 0x2a, 0x93, 0x21,
+	0xba, 0x51, 0x70, 0x03, 0x61, 0xc3, 0xa1, 0xa2, 0x4b, 0xaa, 0xeb, 0x2a, 0xa0, 0x79, 0xa1, 0xdf,
+	0xc7, 0x36, 0x2c, 0xfe, 0x7d, 0xec, 0x5f, 0xe0, 0x8c, 0xbc, 0xe7, 0xc8, 0x99, 0xbe, 0x66, 0xc0,
+	0xe5, 0x84, 0xc5, 0x01, 0xcb, 0x2e, 0x27, 0x2c, 0x02, 0x16, 0x70, 0x98, 0xf7, 0xd7, 0x4a, 0x76,
+	0x55, 0x46, 0x16, 0x0d, 0xc0, 0xce, 0x24, 0x59, 0x82, 0xc7, 0xd5, 0x5d, 0xca, 0xbf, 0xb2, 0x0f,
+	0x04, 0xfd, 0x42, 0xb8, 0xb1, 0x3e, 0x1c, 0x14, 0x12, 0xbf, 0x52, 0x1d, 0x0e, 0xf6, 0x38, 0x16,
+	0xfc, 0xa4, 0x43, 0x4e, 0x67, 0x7b, 0xfd, 0x21, 0x6e, 0x23, 0xef, 0xcf, 0xca, 0x7c, 0x4d, 0xba,
+	0x1d, 0x84, 0xad, 0xe8, 0xae, 0xba, 0x5a, 0x38, 0x03, 0xaf, 0x16, 0xa6, 0x3a, 0xbb, 0xb4, 0x9f,
+	0x3a, 0x1b, 0xb1, 0x5b, 0x3d, 0xa1, 0xfa, 0xcd, 0xac, 0x15, 0x0b, 0xa2, 0x1c, 0x14, 0x06, 0xaa,
+	0xdd, 0x8c, 0x8f, 0x94, 0xcb, 0x05, 0xdb, 0x23, 0x8d, 0x43, 0x6f, 0x02, 0x16, 0x16, 0xda, 0x34,
+	0xa8, 0x6b, 0x8a, 0x3c, 0xe4, 0x32, 0x9b, 0x06, 0xb5, 0xa3, 0x27, 0x60, 0x60, 0xb0, 0x30, 0x60,
+	0xed, 0x5e, 0xc2, 0x8c, 0xf6, 0x46, 0x74, 0xd2, 0xb2, 0x79, 0x51, 0x06, 0x0a, 0x8a, 0x5b, 0x62,
+	0xc7, 0x0f, 0x7b, 0x7e, 0x1b, 0x5b, 0x48, 0xbc, 0x52, 0xaa, 0xd5, 0x71, 0x59, 0x41, 0xc0, 0xc0,
+	0xc2, 0x2f, 0x4e, 0x83, 0x0e, 0x7d, 0x7f, 0x14, 0x4a, 0xdf, 0x41, 0x6d, 0xc7, 0x29, 0xca, 0x41,
+	0x61, 0xb8, 0xcf, 0x91, 0x71, 0x3f, 0x6c, 0xf1, 0x3b, 0x55, 0x14, 0x0b, 0x73, 0x30, 0xa5, 0xa5,
+	0xc4, 0x90, 0x79, 0x1a, 0x0a, 0x26, 0x6a, 0x36, 0x63, 0x1b, 0x19, 0x2e, 0x63, 0x9b, 0xf7, 0xa7,
+	0x0e, 0x99, 0xd2, 0xa1, 0x2e, 0xd9, 0x63, 0xa6, 0xf5, 0x8a, 0xeb, 0xec, 0xfb, 0x8a, 0x6b, 0x87,
+	0x77, 0x2b, 0x0d, 0x15, 0xde, 0xcd, 0x8c, 0xbc, 0x56, 0xde, 0x33, 0xf2, 0xda, 0xd7, 0xda, 0x8f,
+	0x8a, 0x13, 0x73, 0xe3, 0x79, 0x0f, 0x8a, 0xe8, 0x50, 0xd8, 0xf4, 0x55, 0x9c, 0xee, 0x09, 0xe1,
+	0x06, 0x30, 0xcb, 0x90, 0x04, 0xc4, 0x5b, 0x21, 0x35, 0x65, 0x3f, 0x29, 0x9f, 0xd6, 0x9c, 0xfc,
+	0xa7, 0x35, 0x5c, 0x00, 0x0d, 0x53, 0x50, 0xbd, 0x00, 0x32, 0x03, 0x52, 0x61, 0x19, 0x3a, 0xb7,
+	0xfe, 0xab, 0x5f, 0x7e, 0xfa, 0x75, 0xbf, 0xf9, 0xe5, 0xa7, 0x5f, 0xf7, 0xbb, 0x5f, 0x7e, 0xfa,
+	0x75, 0x1f, 0x7f, 0xe5, 0x69, 0xe7, 0x57, 0x5f, 0x79, 0xda, 0xf9, 0xcd, 0x57, 0x9e, 0x76, 0x7e,
+	0xf7, 0x95, 0xa7, 0x9d, 0x2f, 0xbd, 0xf2, 0xb4, 0xf3, 0x85, 0x3f, 0x7a, 0xfa, 0x75, 0xef, 0xcf,
+	0xf5, 0x56, 0xc5, 0x7f, 0xde, 0xdc, 0x6c, 0x5d, 0xdc, 0x79, 0x2b, 0xd3, 0xdc, 0xe1, 0x7c, 0xbe,
+	0x68, 0x0c, 0xe2, 0x8b, 0x72, 0x3e, 0xff, 0xef, 0x01, 0x00, 0x04, 0xff, 0x6e, 0xf5, 0x2c, 0x30,
+	0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5929,6 +6777,36 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SSHSignatureKeys) > 0 {
+		for iNdEx := len(m.SSHSignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SSHSignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.OCISignatureKeys) > 0 {
+		for iNdEx := len(m.OCISignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCISignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6263,6 +7141,46 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6661,6 +7579,30 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ClusterProfile != nil {
+		{
+			size, err := m.ClusterProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6851,6 +7793,30 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ClusterProfile != nil {
+		{
+			size, err := m.ClusterProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -7020,6 +7986,227 @@ func (m *ApplicationSetResourceIgnoreDifferences) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Prometheus != nil {
+		{
+			size, err := m.Prometheus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutAnalysisResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutAnalysisResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutAnalysisResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CheckedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.Passed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.RolledBack) > 0 {
+		for iNdEx := len(m.RolledBack) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RolledBack[iNdEx])
+			copy(dAtA[i:], m.RolledBack[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RolledBack[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.FailedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Application)
+	copy(dAtA[i:], m.Application)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Application)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutHTTPAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutHTTPAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutHTTPAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutPrometheusAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutPrometheusAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutPrometheusAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetRolloutStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7040,6 +8227,31 @@ func (m *ApplicationSetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.Approval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.BakeTime)
+	copy(dAtA[i:], m.BakeTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BakeTime)))
+	i--
+	dAtA[i] = 0x1a
 	if m.MaxUpdate != nil {
 		{
 			size, err := m.MaxUpdate.MarshalToSizedBuffer(dAtA[:i])
@@ -7069,6 +8281,92 @@ func (m *ApplicationSetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutStepStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStepStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStepStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HealthyAt != nil {
+		{
+			size, err := m.HealthyAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetRolloutStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7089,6 +8387,11 @@ func (m *ApplicationSetRolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.FailurePolicy)
+	copy(dAtA[i:], m.FailurePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailurePolicy)))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Steps) > 0 {
 		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7255,6 +8558,32 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RolloutFailure != nil {
+		{
+			size, err := m.RolloutFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RolloutSteps) > 0 {
+		for iNdEx := len(m.RolloutSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RolloutSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ResourcesCount))
 	i--
 	dAtA[i] = 0x20
@@ -7539,6 +8868,30 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ClusterProfile != nil {
+		{
+			size, err := m.ClusterProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -7695,6 +9048,18 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CUE != nil {
+		{
+			size, err := m.CUE.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -7776,6 +9141,53 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSourceCUE) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourceCUE) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSourceCUE) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Package)
+	copy(dAtA[i:], m.Package)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Package)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSourceDirectory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7847,6 +9259,20 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PostRender != nil {
+		{
+			size, err := m.PostRender.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	i--
 	if m.SkipSchemaValidation {
 		dAtA[i] = 1
@@ -8373,6 +9799,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8786,6 +10226,129 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactGeneratorFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGeneratorFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGeneratorFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionMatch != nil {
+		i -= len(*m.VersionMatch)
+		copy(dAtA[i:], *m.VersionMatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.VersionMatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VersionConstraint != nil {
+		i -= len(*m.VersionConstraint)
+		copy(dAtA[i:], *m.VersionConstraint)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.VersionConstraint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8934,6 +10497,39 @@ func (m *BearerTokenBitbucketCloud) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *CUETag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CUETag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CUETag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ChartDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9439,6 +11035,97 @@ func (m *ClusterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterProfileGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterProfileGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterProfileGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.ClusterNameProperty)
+	copy(dAtA[i:], m.ClusterNameProperty)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterNameProperty)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.RequiredConditions) > 0 {
+		for iNdEx := len(m.RequiredConditions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredConditions[iNdEx])
+			copy(dAtA[i:], m.RequiredConditions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequiredConditions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterResourceRestrictionItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9836,6 +11523,20 @@ func (m *DrySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -10516,6 +12217,109 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmPostRender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JSONPatches) > 0 {
+		for iNdEx := len(m.JSONPatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JSONPatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Patches) > 0 {
+		for iNdEx := len(m.Patches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Patches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmPostRenderJSONPatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRenderJSONPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRenderJSONPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Patch)
+	copy(dAtA[i:], m.Patch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i--
+	dAtA[i] = 0x12
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10629,6 +12433,127 @@ func (m *HostResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydrateHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Manual {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	{
+		size, err := m.HydratedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.HydratedSHA)
+	copy(dAtA[i:], m.HydratedSHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HydratedSHA)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DrySHA)
+	copy(dAtA[i:], m.DrySHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DrySHA)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *HydrateLayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateLayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Kustomization {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10649,6 +12574,49 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetDryRevisions) > 0 {
+		for iNdEx := len(m.TargetDryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetDryRevisions[iNdEx])
+			copy(dAtA[i:], m.TargetDryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetDryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.TargetRevision)
+	copy(dAtA[i:], m.TargetRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
+	i--
+	dAtA[i] = 0x52
+	i--
+	if m.Manual {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10704,6 +12672,104 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratePullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratePullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratePullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HydratePullRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratePullRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratePullRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.HydratedSHA)
+	copy(dAtA[i:], m.HydratedSHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HydratedSHA)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Number))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateTo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10724,6 +12790,18 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.TargetBranch)
 	copy(dAtA[i:], m.TargetBranch)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
@@ -11591,6 +13669,11 @@ func (m *OCIMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SignatureVerification)
+	copy(dAtA[i:], m.SignatureVerification)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SignatureVerification)))
+	i--
+	dAtA[i] = 0x42
 	i -= len(m.Description)
 	copy(dAtA[i:], m.Description)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
@@ -11629,6 +13712,39 @@ func (m *OCIMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCISignatureKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCISignatureKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCISignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PublicKey)
+	copy(dAtA[i:], m.PublicKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PublicKey)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12936,6 +15052,27 @@ func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MirrorMaxStaleness)
+	copy(dAtA[i:], m.MirrorMaxStaleness)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MirrorMaxStaleness)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	i -= len(m.BundleURI)
+	copy(dAtA[i:], m.BundleURI)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BundleURI)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	i -= len(m.MirrorURL)
+	copy(dAtA[i:], m.MirrorURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MirrorURL)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
 	i--
 	if m.InsecureOCIForceHttp {
 		dAtA[i] = 1
@@ -13121,6 +15258,37 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MirrorMaxStaleness)
+	copy(dAtA[i:], m.MirrorMaxStaleness)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MirrorMaxStaleness)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	i -= len(m.BundleURI)
+	copy(dAtA[i:], m.BundleURI)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BundleURI)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	i -= len(m.MirrorURL)
+	copy(dAtA[i:], m.MirrorURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MirrorURL)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	i--
+	if m.SparseCheckout {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe0
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Depth))
 	i--
 	dAtA[i] = 0x1
@@ -15244,6 +17412,124 @@ func (m *SCMProviderGeneratorGitlab) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SSHSignatureKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSignatureKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.KeyData)
+	copy(dAtA[i:], m.KeyData)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyData)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Comment)
+	copy(dAtA[i:], m.Comment)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Comment)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.KeyType)
+	copy(dAtA[i:], m.KeyType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyType)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSigningKeyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSigningKeyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSigningKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SecretRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15325,6 +17611,11 @@ func (m *SourceHydrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0x22
 	if m.HydrateTo != nil {
 		{
 			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
@@ -15380,6 +17671,32 @@ func (m *SourceHydratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HydrateHistory) > 0 {
+		for iNdEx := len(m.HydrateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HydrateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.CurrentOperation != nil {
 		{
 			size, err := m.CurrentOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -15427,6 +17744,15 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.DryRevisions) > 0 {
+		for iNdEx := len(m.DryRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DryRevisions[iNdEx])
+			copy(dAtA[i:], m.DryRevisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DryRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15450,6 +17776,131 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Approval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Pause)
+	copy(dAtA[i:], m.Pause)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pause)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Kinds) > 0 {
+		for iNdEx := len(m.Kinds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kinds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.SyncPhase)
+	copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
+	i--
+	dAtA[i] = 0x22
+	if m.ResumedAt != nil {
+		{
+			size, err := m.ResumedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.PausedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15639,6 +18090,20 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15726,6 +18191,18 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncStrategy != nil {
+		{
+			size, err := m.SyncStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15851,6 +18328,23 @@ func (m *SyncSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x22
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -15936,6 +18430,18 @@ func (m *SyncStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Hook != nil {
 		{
 			size, err := m.Hook.MarshalToSizedBuffer(dAtA[:i])
@@ -15994,6 +18500,53 @@ func (m *SyncStrategyApply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncStrategyBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStrategyBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStrategyBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SyncStrategyApply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncStrategyHook) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16362,6 +18915,18 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.OCISignatureKeys) > 0 {
+		for _, e := range m.OCISignatureKeys {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SSHSignatureKeys) > 0 {
+		for _, e := range m.SSHSignatureKeys {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16419,6 +18984,21 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16615,6 +19195,14 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ClusterProfile != nil {
+		l = m.ClusterProfile.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16681,6 +19269,14 @@ func (m *ApplicationSetNestedGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ClusterProfile != nil {
+		l = m.ClusterProfile.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16707,6 +19303,88 @@ func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetRolloutAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetRolloutAnalysisResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.CheckedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetRolloutFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Application)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FailedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RolledBack) > 0 {
+		for _, s := range m.RolledBack {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetRolloutHTTPAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApplicationSetRolloutPrometheusAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationSetRolloutStep) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16723,6 +19401,44 @@ func (m *ApplicationSetRolloutStep) Size() (n int) {
 		l = m.MaxUpdate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.BakeTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStepStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HealthyAt != nil {
+		l = m.HealthyAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16738,6 +19454,8 @@ func (m *ApplicationSetRolloutStrategy) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.FailurePolicy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16813,6 +19531,16 @@ func (m *ApplicationSetStatus) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.ResourcesCount))
+	if len(m.RolloutSteps) > 0 {
+		for _, e := range m.RolloutSteps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RolloutFailure != nil {
+		l = m.RolloutFailure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16933,6 +19661,14 @@ func (m *ApplicationSetTerminalGenerator) Size() (n int) {
 		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ClusterProfile != nil {
+		l = m.ClusterProfile.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16985,6 +19721,29 @@ func (m *ApplicationSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.CUE != nil {
+		l = m.CUE.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSourceCUE) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Package)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17053,6 +19812,10 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	if m.PostRender != nil {
+		l = m.PostRender.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17243,6 +20006,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17367,6 +20136,55 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *ArtifactGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ArtifactGeneratorFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersionConstraint != nil {
+		l = len(*m.VersionConstraint)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.VersionMatch != nil {
+		l = len(*m.VersionMatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17424,6 +20242,19 @@ func (m *BearerTokenBitbucketCloud) Size() (n int) {
 	return n
 }
 
+func (m *CUETag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ChartDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17600,6 +20431,40 @@ func (m *ClusterList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterProfileGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Selector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RequiredConditions) > 0 {
+		for _, s := range m.RequiredConditions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ClusterNameProperty)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ClusterResourceRestrictionItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17773,6 +20638,12 @@ func (m *DrySource) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18007,6 +20878,46 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmPostRender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Patches) > 0 {
+		for _, e := range m.Patches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.JSONPatches) > 0 {
+		for _, e := range m.JSONPatches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HelmPostRenderJSONPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Patch)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18048,6 +20959,47 @@ func (m *HostResourceInfo) Size() (n int) {
 	return n
 }
 
+func (m *HydrateHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.DrySHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HydratedSHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.SourceHydrator.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.HydratedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *HydrateLayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *HydrateOperation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18070,6 +21022,64 @@ func (m *HydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.TargetRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.TargetDryRevisions) > 0 {
+		for _, s := range m.TargetDryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HydratePullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HydratePullRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.HydratedSHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.UpdatedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18081,6 +21091,10 @@ func (m *HydrateTo) Size() (n int) {
 	_ = l
 	l = len(m.TargetBranch)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18422,6 +21436,21 @@ func (m *OCIMetadata) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SignatureVerification)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OCISignatureKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PublicKey)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18952,6 +21981,12 @@ func (m *RepoCreds) Size() (n int) {
 	l = len(m.BearerToken)
 	n += 2 + l + sovGenerated(uint64(l))
 	n += 3
+	l = len(m.MirrorURL)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.BundleURI)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.MirrorMaxStaleness)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19021,6 +22056,13 @@ func (m *Repository) Size() (n int) {
 	n += 2 + l + sovGenerated(uint64(l))
 	n += 3
 	n += 2 + sovGenerated(uint64(m.Depth))
+	n += 3
+	l = len(m.MirrorURL)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.BundleURI)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.MirrorMaxStaleness)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19741,6 +22783,51 @@ func (m *SCMProviderGeneratorGitlab) Size() (n int) {
 	return n
 }
 
+func (m *SSHSignatureKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SSHSigningKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Comment)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyData)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SSHSigningKeyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SecretRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19779,6 +22866,8 @@ func (m *SourceHydrator) Size() (n int) {
 		l = m.HydrateTo.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19796,6 +22885,16 @@ func (m *SourceHydratorStatus) Size() (n int) {
 		l = m.CurrentOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.HydrateHistory) > 0 {
+		for _, e := range m.HydrateHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19811,6 +22910,56 @@ func (m *SuccessfulHydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DryRevisions) > 0 {
+		for _, s := range m.DryRevisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Kinds) > 0 {
+		for _, e := range m.Kinds {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Pause)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *SyncBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.PausedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ResumedAt != nil {
+		l = m.ResumedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.SyncPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SyncWave))
 	return n
 }
 
@@ -19915,6 +23064,12 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19942,6 +23097,10 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SyncStrategy != nil {
+		l = m.SyncStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19970,6 +23129,12 @@ func (m *SyncSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20008,6 +23173,10 @@ func (m *SyncStrategy) Size() (n int) {
 		l = m.Hook.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20021,6 +23190,23 @@ func (m *SyncStrategyApply) Size() (n int) {
 	return n
 }
 
+func (m *SyncStrategyBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SyncStrategyApply.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SyncStrategyHook) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20215,6 +23401,16 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForOCISignatureKeys := "[]OCISignatureKey{"
+	for _, f := range this.OCISignatureKeys {
+		repeatedStringForOCISignatureKeys += strings.Replace(strings.Replace(f.String(), "OCISignatureKey", "OCISignatureKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCISignatureKeys += "}"
+	repeatedStringForSSHSignatureKeys := "[]SSHSignatureKey{"
+	for _, f := range this.SSHSignatureKeys {
+		repeatedStringForSSHSignatureKeys += strings.Replace(strings.Replace(f.String(), "SSHSignatureKey", "SSHSignatureKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSSHSignatureKeys += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -20230,6 +23426,8 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`OCISignatureKeys:` + repeatedStringForOCISignatureKeys + `,`,
+		`SSHSignatureKeys:` + repeatedStringForSSHSignatureKeys + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20279,6 +23477,17 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -20398,6 +23607,8 @@ func (this *ApplicationSetGenerator) String() string {
 		`Merge:` + strings.Replace(this.Merge.String(), "MergeGenerator", "MergeGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`ClusterProfile:` + strings.Replace(this.ClusterProfile.String(), "ClusterProfileGenerator", "ClusterProfileGenerator", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactGenerator", "ArtifactGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20433,6 +23644,8 @@ func (this *ApplicationSetNestedGenerator) String() string {
 		`Merge:` + strings.Replace(fmt.Sprintf("%v", this.Merge), "JSON", "v11.JSON", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`ClusterProfile:` + strings.Replace(this.ClusterProfile.String(), "ClusterProfileGenerator", "ClusterProfileGenerator", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactGenerator", "ArtifactGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20449,6 +23662,66 @@ func (this *ApplicationSetResourceIgnoreDifferences) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetRolloutAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutAnalysis{`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "ApplicationSetRolloutHTTPAnalysis", "ApplicationSetRolloutHTTPAnalysis", 1) + `,`,
+		`Prometheus:` + strings.Replace(this.Prometheus.String(), "ApplicationSetRolloutPrometheusAnalysis", "ApplicationSetRolloutPrometheusAnalysis", 1) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutAnalysisResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutAnalysisResult{`,
+		`Passed:` + fmt.Sprintf("%v", this.Passed) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`CheckedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CheckedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutFailure{`,
+		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`FailedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FailedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`RolledBack:` + fmt.Sprintf("%v", this.RolledBack) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutHTTPAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutHTTPAnalysis{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutPrometheusAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutPrometheusAnalysis{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetRolloutStep) String() string {
 	if this == nil {
 		return "nil"
@@ -20461,6 +23734,25 @@ func (this *ApplicationSetRolloutStep) String() string {
 	s := strings.Join([]string{`&ApplicationSetRolloutStep{`,
 		`MatchExpressions:` + repeatedStringForMatchExpressions + `,`,
 		`MaxUpdate:` + strings.Replace(fmt.Sprintf("%v", this.MaxUpdate), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`BakeTime:` + fmt.Sprintf("%v", this.BakeTime) + `,`,
+		`Approval:` + fmt.Sprintf("%v", this.Approval) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "ApplicationSetRolloutAnalysis", "ApplicationSetRolloutAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutStepStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutStepStatus{`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+		`HealthyAt:` + strings.Replace(fmt.Sprintf("%v", this.HealthyAt), "Time", "v1.Time", 1) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "ApplicationSetRolloutAnalysisResult", "ApplicationSetRolloutAnalysisResult", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20476,6 +23768,7 @@ func (this *ApplicationSetRolloutStrategy) String() string {
 	repeatedStringForSteps += "}"
 	s := strings.Join([]string{`&ApplicationSetRolloutStrategy{`,
 		`Steps:` + repeatedStringForSteps + `,`,
+		`FailurePolicy:` + fmt.Sprintf("%v", this.FailurePolicy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20528,11 +23821,18 @@ func (this *ApplicationSetStatus) String() string {
 		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForResources += "}"
+	repeatedStringForRolloutSteps := "[]ApplicationSetRolloutStepStatus{"
+	for _, f := range this.RolloutSteps {
+		repeatedStringForRolloutSteps += strings.Replace(strings.Replace(f.String(), "ApplicationSetRolloutStepStatus", "ApplicationSetRolloutStepStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRolloutSteps += "}"
 	s := strings.Join([]string{`&ApplicationSetStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`ApplicationStatus:` + repeatedStringForApplicationStatus + `,`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
+		`RolloutSteps:` + repeatedStringForRolloutSteps + `,`,
+		`RolloutFailure:` + strings.Replace(this.RolloutFailure.String(), "ApplicationSetRolloutFailure", "ApplicationSetRolloutFailure", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20618,6 +23918,8 @@ func (this *ApplicationSetTerminalGenerator) String() string {
 		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestGenerator", "PullRequestGenerator", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`ClusterProfile:` + strings.Replace(this.ClusterProfile.String(), "ClusterProfileGenerator", "ClusterProfileGenerator", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactGenerator", "ArtifactGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20652,6 +23954,24 @@ func (this *ApplicationSource) String() string {
 		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
 		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CUE:` + strings.Replace(this.CUE.String(), "ApplicationSourceCUE", "ApplicationSourceCUE", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSourceCUE) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTags := "[]CUETag{"
+	for _, f := range this.Tags {
+		repeatedStringForTags += strings.Replace(strings.Replace(f.String(), "CUETag", "CUETag", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTags += "}"
+	s := strings.Join([]string{`&ApplicationSourceCUE{`,
+		`Package:` + fmt.Sprintf("%v", this.Package) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Tags:` + repeatedStringForTags + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20699,6 +24019,7 @@ func (this *ApplicationSourceHelm) String() string {
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`SkipTests:` + fmt.Sprintf("%v", this.SkipTests) + `,`,
 		`SkipSchemaValidation:` + fmt.Sprintf("%v", this.SkipSchemaValidation) + `,`,
+		`PostRender:` + strings.Replace(this.PostRender.String(), "HelmPostRender", "HelmPostRender", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20836,6 +24157,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20846,6 +24172,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20938,6 +24265,47 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *ArtifactGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFilters := "[]ArtifactGeneratorFilter{"
+	for _, f := range this.Filters {
+		repeatedStringForFilters += strings.Replace(strings.Replace(f.String(), "ArtifactGeneratorFilter", "ArtifactGeneratorFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFilters += "}"
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&ArtifactGenerator{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`Filters:` + repeatedStringForFilters + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGeneratorFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGeneratorFilter{`,
+		`VersionConstraint:` + valueToStringGenerated(this.VersionConstraint) + `,`,
+		`VersionMatch:` + valueToStringGenerated(this.VersionMatch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -20981,6 +24349,17 @@ func (this *BearerTokenBitbucketCloud) String() string {
 	}, "")
 	return s
 }
+func (this *CUETag) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CUETag{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ChartDetails) String() string {
 	if this == nil {
 		return "nil"
@@ -21117,6 +24496,32 @@ func (this *ClusterList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterProfileGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&ClusterProfileGenerator{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`RequiredConditions:` + fmt.Sprintf("%v", this.RequiredConditions) + `,`,
+		`ClusterNameProperty:` + fmt.Sprintf("%v", this.ClusterNameProperty) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ClusterResourceRestrictionItem) String() string {
 	if this == nil {
 		return "nil"
@@ -21230,6 +24635,11 @@ func (this *DrySource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&DrySource{`,
 		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
@@ -21238,6 +24648,7 @@ func (this *DrySource) String() string {
 		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
 		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21437,6 +24848,39 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmPostRender) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPatches := "[]KustomizePatch{"
+	for _, f := range this.Patches {
+		repeatedStringForPatches += strings.Replace(strings.Replace(f.String(), "KustomizePatch", "KustomizePatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPatches += "}"
+	repeatedStringForJSONPatches := "[]HelmPostRenderJSONPatch{"
+	for _, f := range this.JSONPatches {
+		repeatedStringForJSONPatches += strings.Replace(strings.Replace(f.String(), "HelmPostRenderJSONPatch", "HelmPostRenderJSONPatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForJSONPatches += "}"
+	s := strings.Join([]string{`&HelmPostRender{`,
+		`Patches:` + repeatedStringForPatches + `,`,
+		`JSONPatches:` + repeatedStringForJSONPatches + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmPostRenderJSONPatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmPostRenderJSONPatch{`,
+		`Target:` + strings.Replace(this.Target.String(), "KustomizeSelector", "KustomizeSelector", 1) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -21478,6 +24922,34 @@ func (this *HostResourceInfo) String() string {
 	}, "")
 	return s
 }
+func (this *HydrateHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateHistory{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
+		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
+		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`HydratedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.HydratedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Manual:` + fmt.Sprintf("%v", this.Manual) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydrateLayout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateLayout{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Kustomization:` + fmt.Sprintf("%v", this.Kustomization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HydrateOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -21490,6 +24962,38 @@ func (this *HydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
+		`Manual:` + fmt.Sprintf("%v", this.Manual) + `,`,
+		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequestStatus", "HydratePullRequestStatus", 1) + `,`,
+		`TargetDryRevisions:` + fmt.Sprintf("%v", this.TargetDryRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratePullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratePullRequest{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratePullRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratePullRequestStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21500,6 +25004,7 @@ func (this *HydrateTo) String() string {
 	}
 	s := strings.Join([]string{`&HydrateTo{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequest", "HydratePullRequest", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21799,6 +25304,18 @@ func (this *OCIMetadata) String() string {
 		`SourceURL:` + fmt.Sprintf("%v", this.SourceURL) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`SignatureVerification:` + fmt.Sprintf("%v", this.SignatureVerification) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCISignatureKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCISignatureKey{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22165,6 +25682,9 @@ func (this *RepoCreds) String() string {
 		`UseAzureWorkloadIdentity:` + fmt.Sprintf("%v", this.UseAzureWorkloadIdentity) + `,`,
 		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
 		`InsecureOCIForceHttp:` + fmt.Sprintf("%v", this.InsecureOCIForceHttp) + `,`,
+		`MirrorURL:` + fmt.Sprintf("%v", this.MirrorURL) + `,`,
+		`BundleURI:` + fmt.Sprintf("%v", this.BundleURI) + `,`,
+		`MirrorMaxStaleness:` + fmt.Sprintf("%v", this.MirrorMaxStaleness) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22217,6 +25737,10 @@ func (this *Repository) String() string {
 		`BearerToken:` + fmt.Sprintf("%v", this.BearerToken) + `,`,
 		`InsecureOCIForceHttp:` + fmt.Sprintf("%v", this.InsecureOCIForceHttp) + `,`,
 		`Depth:` + fmt.Sprintf("%v", this.Depth) + `,`,
+		`SparseCheckout:` + fmt.Sprintf("%v", this.SparseCheckout) + `,`,
+		`MirrorURL:` + fmt.Sprintf("%v", this.MirrorURL) + `,`,
+		`BundleURI:` + fmt.Sprintf("%v", this.BundleURI) + `,`,
+		`MirrorMaxStaleness:` + fmt.Sprintf("%v", this.MirrorMaxStaleness) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22728,6 +26252,45 @@ func (this *SCMProviderGeneratorGitlab) String() string {
 	}, "")
 	return s
 }
+func (this *SSHSignatureKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SSHSignatureKey{`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SSHSigningKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SSHSigningKey{`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`KeyType:` + fmt.Sprintf("%v", this.KeyType) + `,`,
+		`Comment:` + fmt.Sprintf("%v", this.Comment) + `,`,
+		`KeyData:` + fmt.Sprintf("%v", this.KeyData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SSHSigningKeyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]SSHSigningKey{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "SSHSigningKey", "SSHSigningKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&SSHSigningKeyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SecretRef) String() string {
 	if this == nil {
 		return "nil"
@@ -22757,6 +26320,7 @@ func (this *SourceHydrator) String() string {
 		`DrySource:` + strings.Replace(strings.Replace(this.DrySource.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + `,`,
 		`SyncSource:` + strings.Replace(strings.Replace(this.SyncSource.String(), "SyncSource", "SyncSource", 1), `&`, ``, 1) + `,`,
 		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22765,9 +26329,16 @@ func (this *SourceHydratorStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHydrateHistory := "[]HydrateHistory{"
+	for _, f := range this.HydrateHistory {
+		repeatedStringForHydrateHistory += strings.Replace(strings.Replace(f.String(), "HydrateHistory", "HydrateHistory", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHydrateHistory += "}"
 	s := strings.Join([]string{`&SourceHydratorStatus{`,
 		`LastSuccessfulOperation:` + strings.Replace(this.LastSuccessfulOperation.String(), "SuccessfulHydrateOperation", "SuccessfulHydrateOperation", 1) + `,`,
 		`CurrentOperation:` + strings.Replace(this.CurrentOperation.String(), "HydrateOperation", "HydrateOperation", 1) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequestStatus", "HydratePullRequestStatus", 1) + `,`,
+		`HydrateHistory:` + repeatedStringForHydrateHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22780,6 +26351,40 @@ func (this *SuccessfulHydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`DryRevisions:` + fmt.Sprintf("%v", this.DryRevisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKinds := "[]GroupKind{"
+	for _, f := range this.Kinds {
+		repeatedStringForKinds += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForKinds += "}"
+	s := strings.Join([]string{`&SyncBatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Kinds:` + repeatedStringForKinds + `,`,
+		`Pause:` + fmt.Sprintf("%v", this.Pause) + `,`,
+		`Approval:` + fmt.Sprintf("%v", this.Approval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncBatchResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncBatchResult{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`PausedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.PausedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ResumedAt:` + strings.Replace(fmt.Sprintf("%v", this.ResumedAt), "Time", "v1.Time", 1) + `,`,
+		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22841,6 +26446,11 @@ func (this *SyncOperationResult) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForBatches := "[]SyncBatchResult{"
+	for _, f := range this.Batches {
+		repeatedStringForBatches += strings.Replace(strings.Replace(f.String(), "SyncBatchResult", "SyncBatchResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBatches += "}"
 	s := strings.Join([]string{`&SyncOperationResult{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
@@ -22848,6 +26458,7 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Batches:` + repeatedStringForBatches + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22861,6 +26472,7 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`SyncStrategy:` + strings.Replace(this.SyncStrategy.String(), "SyncStrategy", "SyncStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22885,6 +26497,8 @@ func (this *SyncSource) String() string {
 	s := strings.Join([]string{`&SyncSource{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Layout:` + strings.Replace(this.Layout.String(), "HydrateLayout", "HydrateLayout", 1) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22909,6 +26523,7 @@ func (this *SyncStrategy) String() string {
 	s := strings.Join([]string{`&SyncStrategy{`,
 		`Apply:` + strings.Replace(this.Apply.String(), "SyncStrategyApply", "SyncStrategyApply", 1) + `,`,
 		`Hook:` + strings.Replace(this.Hook.String(), "SyncStrategyHook", "SyncStrategyHook", 1) + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "SyncStrategyBatch", "SyncStrategyBatch", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22923,6 +26538,22 @@ func (this *SyncStrategyApply) String() string {
 	}, "")
 	return s
 }
+func (this *SyncStrategyBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBatches := "[]SyncBatch{"
+	for _, f := range this.Batches {
+		repeatedStringForBatches += strings.Replace(strings.Replace(f.String(), "SyncBatch", "SyncBatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBatches += "}"
+	s := strings.Join([]string{`&SyncStrategyBatch{`,
+		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
+		`Batches:` + repeatedStringForBatches + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncStrategyHook) String() string {
 	if this == nil {
 		return "nil"
@@ -24034,6 +27665,74 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCISignatureKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OCISignatureKeys = append(m.OCISignatureKeys, OCISignatureKey{})
+			if err := m.OCISignatureKeys[len(m.OCISignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHSignatureKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SSHSignatureKeys = append(m.SSHSignatureKeys, SSHSignatureKey{})
+			if err := m.SSHSignatureKeys[len(m.SSHSignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24569,6 +28268,124 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26236,59 +30053,131 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterProfile == nil {
+				m.ClusterProfile = &ClusterProfileGenerator{}
+			}
+			if err := m.ClusterProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactGenerator{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26763,6 +30652,78 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterProfile == nil {
+				m.ClusterProfile = &ClusterProfileGenerator{}
+			}
+			if err := m.ClusterProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactGenerator{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26930,7 +30891,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26953,15 +30914,15 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26988,14 +30949,16 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
-			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &ApplicationSetRolloutHTTPAnalysis{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27022,68 +30985,18 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUpdate == nil {
-				m.MaxUpdate = &intstr.IntOrString{}
+			if m.Prometheus == nil {
+				m.Prometheus = &ApplicationSetRolloutPrometheusAnalysis{}
 			}
-			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27093,25 +31006,23 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27134,7 +31045,7 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutAnalysisResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27157,15 +31068,15 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysisResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysisResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27182,12 +31093,12 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.GoTemplate = bool(v != 0)
+			m.Passed = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27197,29 +31108,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27246,51 +31155,65 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CheckedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27300,33 +31223,29 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &ApplicationSetStrategy{}
-			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27336,31 +31255,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreservedFields == nil {
-				m.PreservedFields = &ApplicationPreservedFields{}
-			}
-			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27388,13 +31303,13 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27404,17 +31319,30 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ApplyNestedSelectors = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27424,29 +31352,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
-			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RolledBack = append(m.RolledBack, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27474,8 +31400,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TemplatePatch = &s
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27498,7 +31423,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutHTTPAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27521,17 +31446,17 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutHTTPAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutHTTPAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27541,31 +31466,79 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutPrometheusAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutPrometheusAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutPrometheusAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27575,31 +31548,29 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
-			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27609,45 +31580,24 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
-			}
-			m.ResourcesCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27669,7 +31619,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27692,17 +31642,17 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27712,27 +31662,29 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
+			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27759,16 +31711,16 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollingSync == nil {
-				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			if m.MaxUpdate == nil {
+				m.MaxUpdate = &intstr.IntOrString{}
 			}
-			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BakeTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27796,61 +31748,11 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletionOrder = string(dAtA[iNdEx:postIndex])
+			m.BakeTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27867,12 +31769,12 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
-		case 2:
+			m.Approval = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27882,24 +31784,27 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
-			m.ApplicationsSync = &s
+			if m.Analysis == nil {
+				m.Analysis = &ApplicationSetRolloutAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27922,7 +31827,7 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStepStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27945,17 +31850,17 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStepStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStepStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27965,30 +31870,29 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27998,78 +31902,27 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = ApplicationSetRolloutStepStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28097,13 +31950,13 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28113,27 +31966,31 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28160,107 +32017,16 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.HealthyAt == nil {
+				m.HealthyAt = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.HealthyAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28287,109 +32053,18 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28399,23 +32074,27 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			if m.Analysis == nil {
+				m.Analysis = &ApplicationSetRolloutAnalysisResult{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28438,7 +32117,7 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28461,15 +32140,15 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28496,16 +32175,116 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailurePolicy = ApplicationSetRolloutFailurePolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoTemplate = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28532,16 +32311,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28568,16 +32345,13 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28604,16 +32378,16 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ApplicationSetSyncPolicy{}
 			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28640,16 +32414,16 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			if m.Strategy == nil {
+				m.Strategy = &ApplicationSetStrategy{}
 			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28676,18 +32450,18 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
+			if m.PreservedFields == nil {
+				m.PreservedFields = &ApplicationPreservedFields{}
 			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28697,31 +32471,47 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyNestedSelectors = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28748,68 +32538,16 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28819,25 +32557,24 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28860,7 +32597,7 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28883,17 +32620,17 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28903,29 +32640,31 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28935,59 +32674,29 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29014,18 +32723,16 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
 			}
-			var msglen int
+			m.ResourcesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29035,31 +32742,14 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ResourcesCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29086,16 +32776,14 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RolloutSteps = append(m.RolloutSteps, ApplicationSetRolloutStepStatus{})
+			if err := m.RolloutSteps[len(m.RolloutSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29122,16 +32810,66 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
+			if m.RolloutFailure == nil {
+				m.RolloutFailure = &ApplicationSetRolloutFailure{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RolloutFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29159,13 +32897,13 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29175,27 +32913,31 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
+			}
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionOrder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29223,7 +32965,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DeletionOrder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29246,7 +32988,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29269,15 +33011,15 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29294,75 +33036,10 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
+			m.PreserveResourcesOnDeletion = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29390,7 +33067,8 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29413,7 +33091,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29436,17 +33114,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29456,27 +33134,28 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29503,14 +33182,63 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29538,11 +33266,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29570,11 +33298,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29601,16 +33329,109 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29620,69 +33441,3391 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PassCredentials = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			m.IgnoreMissingValueFiles = bool(v != 0)
-		case 9:
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
+			}
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterProfile == nil {
+				m.ClusterProfile = &ClusterProfileGenerator{}
+			}
+			if err := m.ClusterProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactGenerator{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CUE", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CUE == nil {
+				m.CUE = &ApplicationSourceCUE{}
+			}
+			if err := m.CUE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceCUE) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceCUE: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceCUE: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, CUETag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recurse = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PassCredentials = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingValueFiles = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCrds = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &runtime.RawExtension{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipTests = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSchemaValidation = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostRender == nil {
+				m.PostRender = &HelmPostRender{}
+			}
+			if err := m.PostRender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtVars = append(m.ExtVars, JsonnetVar{})
+			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLAs = append(m.TLAs, JsonnetVar{})
+			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonLabels == nil {
+				m.CommonLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonAnnotations == nil {
+				m.CommonAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonLabels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonAnnotations = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommonAnnotationsEnvsubst = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelWithoutSelector = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingComponents = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelIncludeTemplates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalMap == nil {
+				m.OptionalMap = &OptionalMap{}
+			}
+			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalArray == nil {
+				m.OptionalArray = &OptionalArray{}
+			}
+			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.String_ = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29692,15 +36835,85 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipCrds = bool(v != 0)
+			m.RevisionHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceHydrator == nil {
+				m.SourceHydrator = &SourceHydrator{}
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29727,16 +36940,405 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValuesObject == nil {
-				m.ValuesObject = &runtime.RawExtension{}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, RevisionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &v1.Time{}
+			}
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
+			}
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservedAt == nil {
+				m.ObservedAt = &v1.Time{}
+			}
+			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29764,11 +37366,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29796,11 +37398,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29828,13 +37430,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29844,32 +37446,25 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipTests = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SkipSchemaValidation = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29891,7 +37486,7 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29914,17 +37509,17 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29934,63 +37529,27 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtVars = append(m.ExtVars, JsonnetVar{})
-			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TLAs = append(m.TLAs, JsonnetVar{})
-			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30018,7 +37577,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30041,7 +37600,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30064,17 +37623,17 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30084,29 +37643,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30116,29 +37677,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30148,29 +37711,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			m.Hosts = append(m.Hosts, HostInfo{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
 			}
-			var msglen int
+			m.ShardsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30180,122 +37745,64 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonLabels == nil {
-				m.CommonLabels = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CommonLabels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30323,11 +37830,11 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30354,109 +37861,65 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonAnnotations == nil {
-				m.CommonAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CommonAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30466,17 +37929,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonLabels = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30486,17 +37961,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceCommonAnnotations = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30506,29 +37993,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Filters = append(m.Filters, ArtifactGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30538,15 +38027,15 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CommonAnnotationsEnvsubst = bool(v != 0)
-		case 11:
+			m.RequeueAfterSeconds = &v
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30573,14 +38062,13 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, KustomizeReplica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30607,66 +38095,157 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patches = append(m.Patches, KustomizePatch{})
-			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGeneratorFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LabelWithoutSelector = bool(v != 0)
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGeneratorFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30694,11 +38273,12 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.VersionConstraint = &s
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30726,48 +38306,9 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.VersionMatch = &s
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IgnoreMissingComponents = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LabelIncludeTemplates = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30789,7 +38330,7 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30812,15 +38353,15 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30848,13 +38389,13 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30864,31 +38405,17 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, &EnvEntry{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Factor = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30898,25 +38425,23 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30939,7 +38464,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30962,15 +38487,15 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30998,11 +38523,11 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31029,54 +38554,68 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalMap == nil {
-				m.OptionalMap = &OptionalMap{}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
 			}
-			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalArray == nil {
-				m.OptionalArray = &OptionalArray{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31086,24 +38625,27 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.String_ = &s
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31126,7 +38668,7 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31149,15 +38691,15 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31184,49 +38726,66 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CUETag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CUETag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CUETag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31254,13 +38813,13 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31270,67 +38829,79 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
-			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31340,51 +38911,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevisionHistoryLimit = &v
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31394,31 +38943,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Home = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31428,27 +38975,23 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceHydrator == nil {
-				m.SourceHydrator = &SourceHydrator{}
-			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31471,7 +39014,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *Cluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31494,17 +39037,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31514,31 +39057,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31548,28 +39089,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31596,13 +39136,13 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31629,16 +39169,15 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, RevisionHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31648,31 +39187,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31682,31 +39219,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReconciledAt == nil {
-				m.ReconciledAt = &v1.Time{}
-			}
-			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31733,16 +39266,16 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
+			if m.RefreshRequestedAt == nil {
+				m.RefreshRequestedAt = &v1.Time{}
 			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31769,18 +39302,15 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObservedAt == nil {
-				m.ObservedAt = &v1.Time{}
-			}
-			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31790,29 +39320,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Shard = &v
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31822,28 +39340,15 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ClusterResources = bool(v != 0)
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31871,13 +39376,13 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31887,29 +39392,124 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31919,56 +39519,118 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31991,7 +39653,7 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32014,17 +39676,17 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
 			}
-			var stringLen uint64
+			m.ResourcesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32034,29 +39696,35 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResourcesCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.APIsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.APIsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32066,23 +39734,27 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
+			}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32105,7 +39777,7 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32128,17 +39800,17 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32148,31 +39820,29 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32182,31 +39852,29 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
-			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32216,100 +39884,29 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hosts = append(m.Hosts, HostInfo{})
-			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
-			}
-			m.ShardsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32319,27 +39916,28 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32366,65 +39964,18 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Backoff) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32434,29 +39985,33 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if m.ExecProviderConfig == nil {
+				m.ExecProviderConfig = &ExecProviderConfig{}
+			}
+			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32466,15 +40021,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Factor = &v
-		case 3:
+			m.DisableCompression = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32502,7 +40057,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32525,7 +40080,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32548,17 +40103,17 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32568,27 +40123,28 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32615,68 +40171,142 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
-			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32686,28 +40316,12 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.FlatList = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32729,7 +40343,7 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32752,15 +40366,15 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32787,66 +40401,13 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChartDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32874,13 +40435,13 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32890,27 +40451,47 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Home = string(dAtA[iNdEx:postIndex])
+			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
+			}
+			m.ApplicationsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32938,7 +40519,7 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32961,7 +40542,7 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32984,17 +40565,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33004,59 +40585,28 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33083,46 +40633,64 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterProfileGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterProfileGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterProfileGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33150,13 +40718,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33166,29 +40734,30 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredConditions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33198,33 +40767,29 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RefreshRequestedAt == nil {
-				m.RefreshRequestedAt = &v1.Time{}
-			}
-			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequiredConditions = append(m.RequiredConditions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterNameProperty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33234,28 +40799,27 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterNameProperty = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
 			var v int64
 			for shift := uint(0); ; shift += 7 {
@@ -33272,62 +40836,10 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Shard = &v
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ClusterResources = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Project = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.RequeueAfterSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33354,107 +40866,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33481,9 +40899,9 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
 			var mapkey string
 			var mapvalue string
 			for iNdEx < postIndex {
@@ -33577,7 +40995,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33600,7 +41018,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33623,17 +41041,17 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterResourceRestrictionItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterResourceRestrictionItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.ResourcesCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33643,16 +41061,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.APIsCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33662,16 +41093,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.APIsCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33681,27 +41125,23 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCacheSyncTime == nil {
-				m.LastCacheSyncTime = &v1.Time{}
-			}
-			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33724,7 +41164,7 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33747,15 +41187,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33783,11 +41223,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33815,11 +41255,61 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33847,13 +41337,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33863,30 +41353,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33896,33 +41385,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33932,33 +41417,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecProviderConfig == nil {
-				m.ExecProviderConfig = &ExecProviderConfig{}
-			}
-			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33968,15 +41449,27 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableCompression = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34004,7 +41497,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34027,7 +41520,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34050,15 +41543,15 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34085,13 +41578,13 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34118,13 +41611,13 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34151,109 +41644,16 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34263,12 +41663,26 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FlatList = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34290,7 +41704,7 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34313,17 +41727,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34333,28 +41747,27 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34382,13 +41795,13 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34398,30 +41811,111 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			m.ApplicationsCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34431,16 +41925,33 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplicationsCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34450,24 +41961,45 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockRepo = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34489,7 +42021,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34512,17 +42044,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34532,30 +42064,29 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34565,25 +42096,23 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34606,7 +42135,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34629,15 +42158,15 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterResourceRestrictionItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterResourceRestrictionItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34665,11 +42194,11 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34697,13 +42226,13 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34713,23 +42242,27 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34752,7 +42285,7 @@ func (m *ClusterResourceRestrictionItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *DrySource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34775,15 +42308,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34811,11 +42344,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34843,61 +42376,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34925,13 +42408,13 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34941,29 +42424,33 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34973,29 +42460,33 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35005,29 +42496,33 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35037,29 +42532,33 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SHA = string(dAtA[iNdEx:postIndex])
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35069,23 +42568,25 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35108,7 +42609,7 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35131,17 +42632,17 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35151,28 +42652,79 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35199,13 +42751,13 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35232,14 +42784,13 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35266,10 +42817,103 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35292,68 +42936,36 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -35385,7 +42997,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -35438,7 +43050,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35461,15 +43073,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35497,13 +43109,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35513,31 +43125,27 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35564,15 +43172,109 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35582,12 +43284,56 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LockRepo = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35609,7 +43355,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35632,15 +43378,15 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35668,13 +43414,13 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35684,24 +43430,12 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35723,7 +43457,7 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35746,15 +43480,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35782,45 +43516,13 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35830,28 +43532,12 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35873,7 +43559,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DrySource) Unmarshal(dAtA []byte) error {
+func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35896,10 +43582,10 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -35936,9 +43622,9 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35948,29 +43634,31 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
+			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35980,29 +43668,31 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Files = append(m.Files, GitFileGeneratorItem{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36012,31 +43702,47 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36063,18 +43769,15 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36084,31 +43787,27 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36135,12 +43834,103 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36163,7 +43953,7 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36186,15 +43976,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36222,11 +44012,11 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36254,13 +44044,13 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36270,17 +44060,29 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36290,30 +44092,29 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36323,30 +44124,29 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36356,118 +44156,23 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			m.KeyData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36490,7 +44195,7 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36513,17 +44218,17 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36533,29 +44238,30 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36565,23 +44271,25 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, GnuPGPublicKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36604,7 +44312,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36627,15 +44335,15 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36663,11 +44371,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36695,11 +44403,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36726,107 +44434,98 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Env[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36854,11 +44553,61 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36886,7 +44635,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36909,7 +44658,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36932,15 +44681,15 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36968,11 +44717,43 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -36989,7 +44770,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37011,7 +44792,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HelmPostRender) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37034,17 +44815,17 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPostRender: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPostRender: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37054,29 +44835,31 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPatches", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37086,12 +44869,62 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPatches = append(m.JSONPatches, HelmPostRenderJSONPatch{})
+			if err := m.JSONPatches[len(m.JSONPatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37113,7 +44946,7 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitGenerator) Unmarshal(dAtA []byte) error {
+func (m *HelmPostRenderJSONPatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37136,17 +44969,17 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPostRenderJSONPatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPostRenderJSONPatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37156,29 +44989,33 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37188,63 +45025,77 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
-			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Files = append(m.Files, GitFileGeneratorItem{})
-			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37272,31 +45123,11 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37323,15 +45154,16 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37341,27 +45173,28 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37388,8 +45221,8 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -37484,7 +45317,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Values[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37507,7 +45340,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37530,15 +45363,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37566,13 +45399,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37582,29 +45415,16 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByNeighbors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37614,93 +45434,16 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var stringLen uint64
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37710,24 +45453,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Capacity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37749,7 +45479,7 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
+func (m *HydrateHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37772,17 +45502,36 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37792,30 +45541,29 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37825,81 +45573,61 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37909,29 +45637,30 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37941,27 +45670,31 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37988,13 +45721,30 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HydratedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manual", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Manual = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38016,7 +45766,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *HydrateLayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38039,15 +45789,15 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateLayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateLayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38075,13 +45825,13 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = HydrateLayoutType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomization", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38091,24 +45841,12 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Kustomization = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38130,7 +45868,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38153,17 +45891,17 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38173,79 +45911,30 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38255,27 +45944,31 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38303,13 +45996,13 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38319,65 +46012,27 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38405,13 +46060,13 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38421,29 +46076,27 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38470,15 +46123,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38488,172 +46141,47 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manual", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Manual = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38681,13 +46209,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			m.RequestedByApp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38697,35 +46225,33 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Capacity = 0
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDryRevisions", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38735,11 +46261,24 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDryRevisions = append(m.TargetDryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38761,7 +46300,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
+func (m *HydratePullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38784,17 +46323,17 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratePullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratePullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38804,30 +46343,29 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = HydratePullRequestProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38837,31 +46375,27 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38889,11 +46423,61 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydratePullRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydratePullRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydratePullRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38921,11 +46505,11 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Phase = HydratePullRequestPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38953,9 +46537,28 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
@@ -38987,9 +46590,41 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39016,7 +46651,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39102,6 +46737,42 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41927,11 +49598,107 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authors = string(dAtA[iNdEx:postIndex])
+			m.Authors = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41959,11 +49726,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41991,11 +49758,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerification", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42023,11 +49790,61 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.SignatureVerification = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCISignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCISignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCISignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42055,11 +49872,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42087,7 +49904,7 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46565,11 +54382,197 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
+			}
+			m.GithubAppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
+			}
+			m.GithubAppInstallationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOCI = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46597,11 +54600,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46629,32 +54632,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
-			}
-			m.GithubAppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
 			}
-			m.GithubAppInstallationId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46664,14 +54648,15 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.ForceHttpBasicAuth = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46699,11 +54684,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.NoProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseAzureWorkloadIdentity", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -46720,10 +54705,10 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableOCI = bool(v != 0)
-		case 12:
+			m.UseAzureWorkloadIdentity = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46751,13 +54736,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureOCIForceHttp", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46767,27 +54752,15 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
+			m.InsecureOCIForceHttp = bool(v != 0)
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46815,31 +54788,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.MirrorURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceHttpBasicAuth = bool(v != 0)
-		case 23:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleURI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46867,31 +54820,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoProxy = string(dAtA[iNdEx:postIndex])
+			m.BundleURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseAzureWorkloadIdentity", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseAzureWorkloadIdentity = bool(v != 0)
-		case 25:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorMaxStaleness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46919,28 +54852,8 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			m.MirrorMaxStaleness = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureOCIForceHttp", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureOCIForceHttp = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47838,6 +55751,122 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SparseCheckout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SparseCheckout = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MirrorURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorMaxStaleness", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MirrorMaxStaleness = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53053,11 +61082,383 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamProject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessTokenRef == nil {
+				m.AccessTokenRef = &SecretRef{}
+			}
+			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppPasswordRef == nil {
+				m.AppPasswordRef = &SecretRef{}
+			}
+			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53067,27 +61468,51 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeamProject = string(dAtA[iNdEx:postIndex])
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53114,16 +61539,16 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessTokenRef == nil {
-				m.AccessTokenRef = &SecretRef{}
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucket{}
 			}
-			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -53140,7 +61565,43 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53162,7 +61623,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53185,15 +61646,15 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53221,11 +61682,12 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53253,13 +61715,13 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53269,33 +61731,62 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppPasswordRef == nil {
-				m.AppPasswordRef = &SecretRef{}
+			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
 			}
-			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LabelMatch = &s
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53305,12 +61796,25 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53332,7 +61836,7 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53355,15 +61859,15 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53391,7 +61895,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -53427,7 +61931,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53454,10 +61958,10 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -53482,42 +61986,6 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			}
 			m.AllBranches = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucket{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
@@ -53537,42 +62005,6 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Insecure = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53594,7 +62026,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53617,15 +62049,15 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53653,12 +62085,11 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RepositoryMatch = &s
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53686,13 +62117,13 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53702,27 +62133,31 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53750,14 +62185,13 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.LabelMatch = &s
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53767,25 +62201,12 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53807,7 +62228,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53830,15 +62251,15 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53866,9 +62287,29 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSubgroups = bool(v != 0)
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -53900,7 +62341,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
@@ -53936,7 +62377,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -53956,7 +62397,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
@@ -53976,61 +62417,11 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Insecure = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54040,27 +62431,16 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := bool(v != 0)
+			m.IncludeSharedProjects = &b
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54088,11 +62468,11 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54119,16 +62499,66 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54156,28 +62586,8 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54199,7 +62609,7 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
+func (m *SSHSigningKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54222,15 +62632,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSHSigningKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSHSigningKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54258,13 +62668,13 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54274,15 +62684,27 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeSubgroups = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54310,13 +62732,13 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54326,94 +62748,79 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.AllBranches = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSigningKeyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			b := bool(v != 0)
-			m.IncludeSharedProjects = &b
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSigningKeyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSigningKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54423,27 +62830,28 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54470,10 +62878,8 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, SSHSigningKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54825,6 +63231,38 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54947,6 +63385,76 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydrateHistory = append(m.HydrateHistory, HydrateHistory{})
+			if err := m.HydrateHistory[len(m.HydrateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55027,11 +63535,362 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRevisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRevisions = append(m.DryRevisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kinds = append(m.Kinds, v1.GroupKind{})
+			if err := m.Kinds[len(m.Kinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pause = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approval = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55059,11 +63918,11 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55090,10 +63949,97 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PausedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumedAt == nil {
+				m.ResumedAt = &v1.Time{}
+			}
+			if err := m.ResumedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55900,6 +64846,40 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, SyncBatchResult{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55979,48 +64959,84 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
 			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -56047,16 +65063,16 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -56083,10 +65099,10 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
 			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -56335,6 +65351,74 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Layout == nil {
+				m.Layout = &HydrateLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56636,6 +65720,42 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &SyncStrategyBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56727,6 +65847,123 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncStrategyBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, SyncBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,6 +21,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectStatus":                        schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application":                             schema_pkg_apis_application_v1alpha1_Application(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition":                    schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency":                   schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination":                  schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount":    schema_pkg_apis_application_v1alpha1_ApplicationDestinationServiceAccount(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationList":                         schema_pkg_apis_application_v1alpha1_ApplicationList(ref),
@@ -33,7 +34,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetList":                      schema_pkg_apis_application_v1alpha1_ApplicationSetList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator":           schema_pkg_apis_application_v1alpha1_ApplicationSetNestedGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences": schema_pkg_apis_application_v1alpha1_ApplicationSetResourceIgnoreDifferences(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis":           schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysis(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisResult":     schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysisResult(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutFailure":            schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutFailure(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis":       schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutHTTPAnalysis(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis": schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPrometheusAnalysis(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep":               schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStepStatus":         schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStepStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy":           schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSpec":                      schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStatus":                    schema_pkg_apis_application_v1alpha1_ApplicationSetStatus(ref),
@@ -44,6 +51,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator":         schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTree":                      schema_pkg_apis_application_v1alpha1_ApplicationSetTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource":                       schema_pkg_apis_application_v1alpha1_ApplicationSource(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceCUE":                    schema_pkg_apis_application_v1alpha1_ApplicationSourceCUE(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory":              schema_pkg_apis_application_v1alpha1_ApplicationSourceDirectory(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm":                   schema_pkg_apis_application_v1alpha1_ApplicationSourceHelm(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceJsonnet":                schema_pkg_apis_application_v1alpha1_ApplicationSourceJsonnet(ref),
@@ -55,10 +63,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary":                      schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationTree":                         schema_pkg_apis_application_v1alpha1_ApplicationTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationWatchEvent":                   schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator":                       schema_pkg_apis_application_v1alpha1_ArtifactGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGeneratorFilter":                 schema_pkg_apis_application_v1alpha1_ArtifactGeneratorFilter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Backoff":                                 schema_pkg_apis_application_v1alpha1_Backoff(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer":                schema_pkg_apis_application_v1alpha1_BasicAuthBitbucketServer(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BearerTokenBitbucket":                    schema_pkg_apis_application_v1alpha1_BearerTokenBitbucket(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BearerTokenBitbucketCloud":               schema_pkg_apis_application_v1alpha1_BearerTokenBitbucketCloud(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CUETag":                                  schema_pkg_apis_application_v1alpha1_CUETag(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ChartDetails":                            schema_pkg_apis_application_v1alpha1_ChartDetails(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Cluster":                                 schema_pkg_apis_application_v1alpha1_Cluster(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterCacheInfo":                        schema_pkg_apis_application_v1alpha1_ClusterCacheInfo(ref),
@@ -66,6 +77,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator":                        schema_pkg_apis_application_v1alpha1_ClusterGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterInfo":                             schema_pkg_apis_application_v1alpha1_ClusterInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterList":                             schema_pkg_apis_application_v1alpha1_ClusterList(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator":                 schema_pkg_apis_application_v1alpha1_ClusterProfileGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Command":                                 schema_pkg_apis_application_v1alpha1_Command(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ComparedTo":                              schema_pkg_apis_application_v1alpha1_ComparedTo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ComponentParameter":                      schema_pkg_apis_application_v1alpha1_ComponentParameter(ref),
@@ -85,9 +97,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmFileParameter":                       schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmOptions":                             schema_pkg_apis_application_v1alpha1_HelmOptions(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmParameter":                           schema_pkg_apis_application_v1alpha1_HelmParameter(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRender":                          schema_pkg_apis_application_v1alpha1_HelmPostRender(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRenderJSONPatch":                 schema_pkg_apis_application_v1alpha1_HelmPostRenderJSONPatch(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo":                                schema_pkg_apis_application_v1alpha1_HostInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo":                        schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateHistory":                          schema_pkg_apis_application_v1alpha1_HydrateHistory(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateLayout":                           schema_pkg_apis_application_v1alpha1_HydrateLayout(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation":                        schema_pkg_apis_application_v1alpha1_HydrateOperation(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequest":                      schema_pkg_apis_application_v1alpha1_HydratePullRequest(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequestStatus":                schema_pkg_apis_application_v1alpha1_HydratePullRequestStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateTo":                               schema_pkg_apis_application_v1alpha1_HydrateTo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info":                                    schema_pkg_apis_application_v1alpha1_Info(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.InfoItem":                                schema_pkg_apis_application_v1alpha1_InfoItem(ref),
@@ -107,6 +125,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator":                          schema_pkg_apis_application_v1alpha1_MergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMatrixGenerator":                   schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCISignatureKey":                         schema_pkg_apis_application_v1alpha1_OCISignatureKey(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator":                      schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState":                          schema_pkg_apis_application_v1alpha1_OperationState(ref),
@@ -158,11 +177,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitea":               schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGithub":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGithub(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitlab":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSignatureKey":                         schema_pkg_apis_application_v1alpha1_SSHSignatureKey(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSigningKey":                           schema_pkg_apis_application_v1alpha1_SSHSigningKey(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSigningKeyList":                       schema_pkg_apis_application_v1alpha1_SSHSigningKeyList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef":                               schema_pkg_apis_application_v1alpha1_SecretRef(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey":                            schema_pkg_apis_application_v1alpha1_SignatureKey(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator":                          schema_pkg_apis_application_v1alpha1_SourceHydrator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus":                    schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation":              schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatch":                               schema_pkg_apis_application_v1alpha1_SyncBatch(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatchResult":                         schema_pkg_apis_application_v1alpha1_SyncBatchResult(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperation":                           schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource":                   schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult":                     schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
@@ -172,6 +196,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus":                              schema_pkg_apis_application_v1alpha1_SyncStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy":                            schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply":                       schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyBatch":                       schema_pkg_apis_application_v1alpha1_SyncStrategyBatch(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook":                        schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow":                              schema_pkg_apis_application_v1alpha1_SyncWindow(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig":                         schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
@@ -494,11 +519,39 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"ociSignatureKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCISignatureKeys contains a list of keys that OCI artifacts and Helm charts in OCI registries must be signed with in order to be allowed for sync",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCISignatureKey"),
+									},
+								},
+							},
+						},
+					},
+					"sshSignatureKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHSignatureKeys contains a list of SSH key fingerprints that commits in Git must be signed with in order to be allowed for sync. Commits signed with any of the SignatureKeys or SSHSignatureKeys are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSignatureKey"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OCISignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -623,6 +676,34 @@ func schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationDependency references applications an application depends on, either by name or by labels",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the applications by labels",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1047,11 +1128,21 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref common.Ref
 							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator"),
 						},
 					},
+					"clusterProfile": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator"),
+						},
+					},
+					"artifact": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1164,11 +1255,21 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetNestedGenerator(ref comm
 							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator"),
 						},
 					},
+					"clusterProfile": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator"),
+						},
+					},
+					"artifact": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1222,115 +1323,118 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetResourceIgnoreDifference
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ApplicationSetRolloutAnalysis is a check which has to pass before the next step of a rollout is started. Exactly one of HTTP and Prometheus has to be set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"matchExpressions": {
+					"http": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression"),
-									},
-								},
-							},
+							Description: "HTTP passes if a GET request to the URL returns a 2xx status code",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis"),
 						},
 					},
-					"maxUpdate": {
+					"prometheus": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Prometheus passes if the query returns a non-empty result",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis"),
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the duration after which a failed analysis is run again, such as 5m. Defaults to 1m.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutHTTPAnalysis", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutPrometheusAnalysis"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutAnalysisResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ApplicationSetRolloutAnalysisResult is the result of the analysis of a RollingSync step",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"steps": {
+					"passed": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep"),
-									},
-								},
-							},
+							Description: "Passed is whether the analysis passed",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the result of the analysis",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckedAt is the time at which the analysis was run",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"passed", "checkedAt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetSpec represents a class of application set state.",
+				Description: "ApplicationSetRolloutFailure describes the failure of a RollingSync rollout",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"goTemplate": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"generators": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator"),
-									},
-								},
-							},
-						},
-					},
-					"template": {
+					"application": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+							Description: "Application is the name of the Application which failed the rollout",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"syncPolicy": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy"),
+							Description: "Step is the step of the Application which failed the rollout",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"strategy": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy"),
+							Description: "Message contains human-readable message indicating why the Application failed the rollout",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"preservedFields": {
+					"failedAt": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields"),
+							Description: "FailedAt is the time at which the rollout failed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"goTemplateOptions": {
+					"rolledBack": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "RolledBack contains the names of the Applications rolled back to their previous generated spec",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -1342,141 +1446,447 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.Referenc
 							},
 						},
 					},
-					"applyNestedSelectors": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ApplyNestedSelectors enables selectors defined within the generators of two level-nested matrix or merge generators",
-							Type:        []string{"boolean"},
+							Description: "Revision identifies the generated Applications of the failed rollout. The failure is cleared once the generated Applications change.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ignoreApplicationDifferences": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences"),
-									},
-								},
-							},
-						},
-					},
-					"templatePatch": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 				},
-				Required: []string{"generators", "template"},
+				Required: []string{"application", "step", "message", "failedAt", "revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutHTTPAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetStatus defines the observed state of ApplicationSet",
+				Description: "ApplicationSetRolloutHTTPAnalysis checks an HTTP endpoint",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "INSERT ADDITIONAL STATUS FIELD - define observed state of cluster Important: Run \"make\" to regenerate code after modifying this file",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition"),
-									},
-								},
-							},
-						},
-					},
-					"applicationStatus": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus"),
-									},
-								},
-							},
-						},
-					},
-					"resources": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources is a list of Applications resources managed by this application set.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"),
-									},
-								},
-							},
+							Description: "URL is the URL of the endpoint",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutPrometheusAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetStrategy configures how generated Applications are updated in sequence.",
+				Description: "ApplicationSetRolloutPrometheusAnalysis runs a Prometheus query",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"address": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Address is the address of the Prometheus server, such as http://prometheus.monitoring:9090",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"rollingSync": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy"),
+							Description: "Query is the PromQL query, such as sum(rate(http_requests_total{code=~\"5..\"}[5m])) < 1",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"address", "query"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetSyncPolicy configures how generated Applications will relate to their ApplicationSet.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"preserveResourcesOnDeletion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PreserveResourcesOnDeletion will preserve resources on deletion. If PreserveResourcesOnDeletion is set to true, these Applications will not be deleted.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"applicationsSync": {
+					"matchExpressions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ApplicationsSync represents the policy applied on the generated applications. Possible values are create-only, create-update, create-delete, sync",
-							Type:        []string{"string"},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression"),
+									},
+								},
+							},
+						},
+					},
+					"maxUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"bakeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BakeTime is the duration for which the Applications of the step have to stay synced and healthy before the next step is started, such as 15m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval holds the next step until the step is promoted, such as with `argocd appset rollout promote`",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis has to pass before the next step is started",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysis", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetRolloutStepStatus contains the state of the gates of a RollingSync step",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the number of the step, starting with 1",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the gates of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains human-readable message indicating details about the status",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time the status was last updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"healthyAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthyAt is the time since which the Applications of the step are synced and healthy",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"approvedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedAt is the time at which the step was promoted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis is the result of the last analysis of the step",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisResult"),
+						},
+					},
+				},
+				Required: []string{"step", "status"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutAnalysisResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep"),
+									},
+								},
+							},
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy is applied when an Application fails to sync or becomes Degraded during the rollout. Halt marks the rollout failed, and Rollback additionally rolls the Applications updated by the rollout back to their previous generated spec. By default, the rollout just stops progressing.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStep"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetSpec represents a class of application set state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"goTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"generators": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator"),
+									},
+								},
+							},
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"syncPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy"),
+						},
+					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy"),
+						},
+					},
+					"preservedFields": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields"),
+						},
+					},
+					"goTemplateOptions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"applyNestedSelectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplyNestedSelectors enables selectors defined within the generators of two level-nested matrix or merge generators",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ignoreApplicationDifferences": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences"),
+									},
+								},
+							},
+						},
+					},
+					"templatePatch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"generators", "template"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetStatus defines the observed state of ApplicationSet",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "INSERT ADDITIONAL STATUS FIELD - define observed state of cluster Important: Run \"make\" to regenerate code after modifying this file",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition"),
+									},
+								},
+							},
+						},
+					},
+					"applicationStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources is a list of Applications resources managed by this application set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"),
+									},
+								},
+							},
+						},
+					},
+					"resourcesCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourcesCount is the total number of resources managed by this application set. The count may be higher than actual number of items in the Resources field when the number of managed resources exceeds the limit imposed by the controller (to avoid making the status field too large).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rolloutSteps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutSteps contains the state of the gates of the RollingSync steps which have gates",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStepStatus"),
+									},
+								},
+							},
+						},
+					},
+					"rolloutFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutFailure describes the failure of the RollingSync rollout, if the rollout failed",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutFailure"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutFailure", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStepStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetStrategy configures how generated Applications are updated in sequence.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rollingSync": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetRolloutStrategy"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetSyncPolicy configures how generated Applications will relate to their ApplicationSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preserveResourcesOnDeletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreserveResourcesOnDeletion will preserve resources on deletion. If PreserveResourcesOnDeletion is set to true, these Applications will not be deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"applicationsSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplicationsSync represents the policy applied on the generated applications. Possible values are create-only, create-update, create-delete, sync",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -1631,11 +2041,21 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"clusterProfile": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator"),
+						},
+					},
+					"artifact": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterProfileGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1735,12 +2155,68 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSource(ref common.Reference
 							Format:      "",
 						},
 					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CUE holds CUE specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceCUE"),
+						},
+					},
 				},
 				Required: []string{"repoURL"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceCUE", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSourceCUE(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSourceCUE holds options specific to applications of type CUE",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"package": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Package is the CUE package to evaluate, e.g. \"./...\" or \":name\". Defaults to the package in the path of the application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is the expression selecting the manifests in the evaluated package, e.g. \"objects\". Defaults to the whole package.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags are the values injected into the fields of the package with a matching @tag attribute",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CUETag"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CUETag"},
 	}
 }
 
@@ -1919,11 +2395,17 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSourceHelm(ref common.Refer
 							},
 						},
 					},
+					"postRender": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRender post-processes the manifests rendered by Helm before they are returned",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRender"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmParameter", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmParameter", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRender", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -2329,12 +2811,26 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists the applications, in the namespace of the application, which have to be synced and healthy at their target revisions before the application is synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -2607,6 +3103,107 @@ func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ArtifactGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtifactGenerator defines a generator to list the tags of an OCI repository, or the versions of a chart within a Helm repository.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the OCI repository, e.g. oci://ghcr.io/argoproj/argo-helm/argo-cd, or of the Helm repository.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Chart is the name of the chart within the Helm repository. It is not used for OCI repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters for which versions should be considered. A version is considered if it matches any of the filters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGeneratorFilter"),
+									},
+								},
+							},
+						},
+					},
+					"requeueAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard parameters.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"repoURL"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ArtifactGeneratorFilter"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ArtifactGeneratorFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtifactGeneratorFilter is a single version filter. If multiple filter types are set on a single struct, they will be AND'd together. All filters must pass for a version to be included.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"versionConstraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionConstraint is a semantic version constraint, e.g. \">=1.2.0-0 <2.0.0\", which the version must satisfy. Versions which are not semantic versions never satisfy a constraint.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versionMatch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VersionMatch is a regular expression which the version must match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Backoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2715,6 +3312,35 @@ func schema_pkg_apis_application_v1alpha1_BearerTokenBitbucketCloud(ref common.R
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_CUETag(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CUETag is a value injected into the fields of a CUE package with a matching @tag attribute",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the tag",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the tag",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ChartDetails(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3110,19 +3736,99 @@ func schema_pkg_apis_application_v1alpha1_ClusterList(ref common.ReferenceCallba
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Cluster"),
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Cluster"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Cluster", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ClusterProfileGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterProfileGenerator defines a generator to match against the clusters of a fleet inventory, described by the SIG-Multicluster ClusterProfile API, which are registered with Argo CD.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the ClusterProfiles. Defaults to the namespace of Argo CD.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector defines a label selector to match against the labels of the ClusterProfiles.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"requiredConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredConditions are the types of the conditions of the ClusterProfiles which must be True, e.g. ControlPlaneHealthy. ClusterProfiles without all required conditions are ignored.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusterNameProperty": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterNameProperty is the name of the property of the ClusterProfiles which contains the name of the cluster registered with Argo CD. Defaults to the name of the ClusterProfile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requeueAfterSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequeueAfterSeconds is how long before the ClusterProfiles will be rechecked for a change",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"),
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values contains key/value pairs which are passed directly as parameters to the template",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Cluster", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -3390,16 +4096,55 @@ func schema_pkg_apis_application_v1alpha1_DrySource(ref common.ReferenceCallback
 					},
 					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is a directory path within the Git repository where the manifests are located",
-							Default:     "",
+							Description: "Path is a directory path within the Git repository where the manifests are located. It is ignored if Sources is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"helm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Helm specifies helm specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm"),
+						},
+					},
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize specifies kustomize specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize"),
+						},
+					},
+					"directory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory specifies path/directory specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory"),
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin specifies config management plugin specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources is a list of sources from which manifests are hydrated, such as Helm charts from Helm or OCI repositories and sources referenced by `ref` in Helm value files. If set, Path and the tool specific options of the dry source are ignored. RepoURL and TargetRevision still determine the git commit being hydrated, so at least one of the sources must use the same repository URL and target revision.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"repoURL", "targetRevision", "path"},
+				Required: []string{"repoURL", "targetRevision"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
 	}
 }
 
@@ -3733,134 +4478,374 @@ func schema_pkg_apis_application_v1alpha1_GnuPGPublicKey(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
-					"subType": {
+					"subType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubType holds the key's sub type (e.g. rsa4096)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyData holds the raw key data, in base64 encoded format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"keyID"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_GnuPGPublicKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GnuPGPublicKeyList is a collection of GnuPGPublicKey objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HealthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HealthStatus contains information about the currently observed health state of an application or resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the status code of the application or resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable informational message describing the health status",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the time the HealthStatus was set",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmFileParameter is a file parameter that's passed to helm template during manifest generation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Helm parameter",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path to the file containing the values for the Helm parameter",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmOptions holds helm options",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ValuesFileSchemes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ValuesFileSchemes"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmParameter is a parameter that's passed to helm template during manifest generation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Helm parameter",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value for the Helm parameter",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forceString": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceString determines whether to tell Helm to interpret booleans and numbers as strings",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmPostRender(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmPostRender holds the steps post-processing the manifests rendered by Helm, like Helm's --post-renderer. The steps are applied in order: the Kustomize patches first, then the JSON patches and the plugin last.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are Kustomize patches applied to the rendered manifests. Paths of patch files are relative to the path of the application.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizePatch"),
+									},
+								},
+							},
+						},
+					},
+					"jsonPatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPatches are JSON patches (RFC 6902) applied to the rendered manifests matching their target",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRenderJSONPatch"),
+									},
+								},
+							},
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin is the config management plugin the rendered manifests are passed to. The manifests are stored in the all.yaml file in the working directory of the plugin.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmPostRenderJSONPatch", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizePatch"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmPostRenderJSONPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmPostRenderJSONPatch is a JSON patch applied to the manifests rendered by Helm",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubType holds the key's sub type (e.g. rsa4096)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target selects the manifests the patch is applied to. Group, version, kind, name and namespace are regular expressions. All manifests are patched if it is omitted.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizeSelector"),
 						},
 					},
-					"keyData": {
+					"patch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeyData holds the raw key data, in base64 encoded format",
+							Description: "Patch is the list of JSON patch operations, in JSON or YAML",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"keyID"},
+				Required: []string{"patch"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizeSelector"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_GnuPGPublicKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HostInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GnuPGPublicKeyList is a collection of GnuPGPublicKey objects",
+				Description: "HostInfo holds host name and resources metrics",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"resourcesInfo": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo"),
 									},
 								},
 							},
 						},
 					},
+					"systemInfo": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/api/core/v1.NodeSystemInfo"),
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo", "k8s.io/api/core/v1.NodeSystemInfo"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HealthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HealthStatus contains information about the currently observed health state of an application or resource",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"status": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status holds the status code of the application or resource",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"message": {
+					"requestedByApp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is a human-readable informational message describing the health status",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"lastTransitionTime": {
+					"requestedByNeighbors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastTransitionTime is the time the HealthStatus was set",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HydrateHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmFileParameter is a file parameter that's passed to helm template during manifest generation",
+				Description: "HydrateHistory contains information about a hydration which changed the hydrated branch",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the Helm parameter",
+							Description: "ID is an auto incrementing identifier of the HydrateHistory",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"drySHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrySHA holds the resolved revision (sha) of the dry source which was hydrated",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"hydratedSHA": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the path to the file containing the values for the Helm parameter",
+							Description: "HydratedSHA holds the revision (sha) of the hydrated commit",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_HelmOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmOptions holds helm options",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ValuesFileSchemes": {
+					"dryRevisions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It is only set if the dry source has multiple sources.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -3872,37 +4857,58 @@ func schema_pkg_apis_application_v1alpha1_HelmOptions(ref common.ReferenceCallba
 							},
 						},
 					},
+					"sourceHydrator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceHydrator holds the hydrator config used for the hydrate operation",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt holds the time the hydrate operation started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"hydratedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedAt holds the time the hydrate operation completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"manual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manual is true if the hydrate operation was explicitly requested",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"ValuesFileSchemes"},
+				Required: []string{"id", "hydratedAt"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HydrateLayout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmParameter is a parameter that's passed to helm template during manifest generation",
+				Description: "HydrateLayout specifies how hydrated manifests are laid out in files.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the Helm parameter",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"value": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is the value for the Helm parameter",
+							Description: "Type is the way the hydrated manifests are split into files. One of: singleFile, filePerResource, namespace. Defaults to singleFile.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"forceString": {
+					"kustomization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ForceString determines whether to tell Helm to interpret booleans and numbers as strings",
+							Description: "Kustomization generates a kustomization.yaml file listing the hydrated manifest files as resources.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -3913,144 +4919,220 @@ func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HostInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HydrateOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HostInfo holds host name and resources metrics",
+				Description: "HydrateOperation contains information about the most recent hydrate operation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"startedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "StartedAt indicates when the hydrate operation started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"resourcesInfo": {
+					"finishedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "FinishedAt indicates when the hydrate operation finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase indicates the status of the hydrate operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains a message describing the current status of the hydrate operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drySHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrySHA holds the resolved revision (sha) of the dry source as of the most recent reconciliation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hydratedSHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceHydrator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceHydrator holds the hydrator config used for the hydrate operation",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
+						},
+					},
+					"dryRevisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It is only set if the dry source has multiple sources.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"systemInfo": {
+					"manual": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("k8s.io/api/core/v1.NodeSystemInfo"),
+							Description: "Manual is true if the hydrate operation was explicitly requested, in which case the dry revision is hydrated even if it was already hydrated",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetRevision holds the revision of the dry source the hydrate operation was requested for, if it was pinned to a revision other than the target revision of the dry source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest holds the state of the pull request promoting the hydrated commit of this operation, if hydrateTo.pullRequest is configured",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequestStatus"),
+						},
+					},
+					"targetDryRevisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetDryRevisions holds the revisions of each of the dry sources the hydrate operation was requested for, in the same order as drySource.sources, if it was pinned to the revisions of an earlier hydration. The sources using the dry repository are hydrated from TargetRevision instead.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"phase", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo", "k8s.io/api/core/v1.NodeSystemInfo"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequestStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HydratePullRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "HydratePullRequest specifies how to open pull requests promoting hydrated manifests from the HydrateTo branch to the SyncSource branch. The owner and name of the repository are derived from the URL of the repository the hydrated manifests are pushed to, i.e. the SyncSource's RepoURL if set and the DrySource's RepoURL otherwise. The pull request is opened with the write credentials configured for that repository.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"requestedByApp": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Provider is the SCM provider hosting the repository.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"requestedByNeighbors": {
+					"api": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "API is the base URL of the SCM provider's API. Defaults to the public API of the provider. Required for gitea and bitbucketServer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"capacity": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Labels is a list of labels to add to the pull request. Not supported by gitea and bitbucket.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"provider"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_HydrateOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_HydratePullRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HydrateOperation contains information about the most recent hydrate operation",
+				Description: "HydratePullRequestStatus contains information about the pull request opened by the hydrator",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"startedAt": {
-						SchemaProps: spec.SchemaProps{
-							Description: "StartedAt indicates when the hydrate operation started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"finishedAt": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FinishedAt indicates when the hydrate operation finished",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase indicates the status of the hydrate operation",
+							Description: "Phase indicates whether the pull request is open, was merged or closed, or whether opening it failed",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message contains a message describing the current status of the hydrate operation",
-							Default:     "",
+							Description: "URL is the link to the pull request",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"drySHA": {
+					"number": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DrySHA holds the resolved revision (sha) of the dry source as of the most recent reconciliation",
+							Description: "Number is the number (ID) of the pull request",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hydratedSHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedSHA holds the hydrated commit the pull request was last updated for",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hydratedSHA": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HydratedSHA holds the resolved revision (sha) of the hydrated source as of the most recent reconciliation",
+							Description: "Message contains the error if opening or updating the pull request failed",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sourceHydrator": {
+					"updatedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SourceHydrator holds the hydrator config used for the hydrate operation",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
+							Description: "UpdatedAt indicates when the pull request was last opened or updated by the hydrator",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"phase", "message"},
+				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4069,10 +5151,18 @@ func schema_pkg_apis_application_v1alpha1_HydrateTo(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest configures the hydrator to open a pull request from TargetBranch into the SyncSource's TargetBranch whenever new hydrated manifests are pushed. An already open pull request between these branches is updated.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequest"),
+						},
+					},
 				},
 				Required: []string{"targetBranch"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequest"},
 	}
 }
 
@@ -4719,6 +5809,36 @@ func schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_OCISignatureKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCISignatureKey is the specification of a key required to verify the signatures of OCI artifacts with",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the signatures verified with the key, either \"cosign\" or \"notation\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKey is the PEM encoded public key of cosign signatures, or the PEM encoded trusted root certificates of Notation signatures",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "publicKey"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5816,7 +6936,7 @@ func schema_pkg_apis_application_v1alpha1_RepoCreds(ref common.ReferenceCallback
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the type of the repoCreds. Can be either \"git\" or \"helm. \"git\" is assumed if empty or absent.",
+							Description: "Type specifies the type of the repoCreds. Can be either \"git\", \"helm\" or \"oci\". \"git\" is assumed if empty or absent.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5849,6 +6969,48 @@ func schema_pkg_apis_application_v1alpha1_RepoCreds(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"useAzureWorkloadIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseAzureWorkloadIdentity specifies whether to use Azure Workload Identity for authentication",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken contains the bearer token used for Git BitBucket Data Center auth at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureOCIForceHttp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureOCIForceHttp specifies whether the connection to the repository uses TLS at _all_. If true, no TLS. This flag is applicable for OCI repos only.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"mirrorURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorURL specifies the URL of a read-only mirror of the repositories matched by these credentials. The part of the repository URL following the URL of the credentials is appended to it. The mirror is accessed without credentials. Only used with Git repos. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bundleURI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BundleURI specifies the HTTP(S) URI of Git bundles the repositories matched by these credentials are initialized from. The part of the repository URL following the URL of the credentials is appended to it. Only used with Git repos. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirrorMaxStaleness": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorMaxStaleness specifies for how long branches may be resolved from the mirror after the refs were last listed from the repository itself, e.g. \"5m\". Branches are only resolved from the repository if empty or zero. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
@@ -6060,6 +7222,62 @@ func schema_pkg_apis_application_v1alpha1_Repository(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"useAzureWorkloadIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseAzureWorkloadIdentity specifies whether to use Azure Workload Identity for authentication",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken contains the bearer token used for Git BitBucket Data Center auth at the repo server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureOCIForceHttp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureOCIForceHttp specifies whether the connection to the repository uses TLS at _all_. If true, no TLS. This flag is applicable for OCI repos only.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"depth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sparseCheckout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SparseCheckout specifies whether to fetch the repository without file contents and to check out only the directories needed to generate the manifests of an application, i.e. its path and its manifest-generate-paths",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"mirrorURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorURL specifies the URL of a read-only mirror of the repository. Objects are fetched from the mirror first, and only the objects missing in the mirror are fetched from the repository itself. Revisions are resolved from the repository, unless MirrorMaxStaleness is set. The mirror is accessed without credentials. Only used with Git repos. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bundleURI": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BundleURI specifies the HTTP(S) URI of a Git bundle the local clone of the repository is initialized from, before the remaining revisions are fetched. Only used with Git repos. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirrorMaxStaleness": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorMaxStaleness specifies for how long branches may be resolved from the branches fetched from the mirror after the refs were last listed from the repository itself, e.g. \"5m\". Branches are only resolved from the repository if empty or zero. Setting it through the API requires the override action on repositories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"repo"},
 			},
@@ -7656,89 +8874,189 @@ func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGithub(ref common.
 						},
 					},
 				},
-				Required: []string{"organization"},
+				Required: []string{"organization"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SCMProviderGeneratorGitlab defines connection info specific to Gitlab.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gitlab group to scan. Required.  You can use either the project id (recommended) or the full namespaced path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"includeSubgroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recurse through subgroups (true) or scan only the base group (false).  Defaults to \"false\"",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"api": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Gitlab API URL to talk to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication token reference.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"),
+						},
+					},
+					"allBranches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scan all branches instead of just the default branch.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skips validating the SCM provider's TLS certificate - useful for self-signed certificates.; default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"includeSharedProjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When recursing through subgroups, also include shared Projects (true) or scan only the subgroups under same path (false).  Defaults to \"true\"",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter repos list based on Gitlab Topic.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap key holding the trusted certificates",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
+						},
+					},
+				},
+				Required: []string{"group"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SSHSignatureKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHSignatureKey is the specification of an SSH key required to verify commit signatures with",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the SHA256 fingerprint of the public key, e.g. SHA256:4o7Hd0nmhm1VCV6Lz+6d+QS5rTX2mQhT9pZ4MK3sc9g",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"fingerprint"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SSHSigningKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SCMProviderGeneratorGitlab defines connection info specific to Gitlab.",
+				Description: "SSHSigningKey is a representation of an SSH public key trusted to sign commits",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"group": {
+					"fingerprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gitlab group to scan. Required.  You can use either the project id (recommended) or the full namespaced path.",
+							Description: "Fingerprint is the SHA256 fingerprint of the key",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"includeSubgroups": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Recurse through subgroups (true) or scan only the base group (false).  Defaults to \"false\"",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"api": {
+					"keyType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Gitlab API URL to talk to.",
+							Description: "KeyType is the type of the key (e.g. ssh-ed25519)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Authentication token reference.",
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"),
-						},
-					},
-					"allBranches": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Scan all branches instead of just the default branch.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"insecure": {
+					"comment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Skips validating the SCM provider's TLS certificate - useful for self-signed certificates.; default: false",
-							Type:        []string{"boolean"},
+							Description: "Comment holds the comment of the key, usually identifying its owner",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"includeSharedProjects": {
+					"keyData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When recursing through subgroups, also include shared Projects (true) or scan only the subgroups under same path (false).  Defaults to \"true\"",
-							Type:        []string{"boolean"},
+							Description: "KeyData holds the public key in authorized_keys format",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"topic": {
+				},
+				Required: []string{"fingerprint"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SSHSigningKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHSigningKeyList is a collection of SSHSigningKey objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter repos list based on Gitlab Topic.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"caRef": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMap key holding the trusted certificates",
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSigningKey"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"group"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SSHSigningKey", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
@@ -7815,10 +9133,17 @@ func schema_pkg_apis_application_v1alpha1_SourceHydrator(ref common.ReferenceCal
 					},
 					"hydrateTo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HydrateTo specifies an optional \"staging\" location to push hydrated manifests to. An external system would then have to move manifests to the SyncSource, e.g. by pull request.",
+							Description: "HydrateTo specifies an optional \"staging\" location to push hydrated manifests to. Manifests then have to be moved to the SyncSource, e.g. by a pull request which the hydrator can open if HydrateTo.PullRequest is configured.",
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateTo"),
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression on which the latest revision of the dry source is hydrated, in addition to the hydrations triggered by webhooks, refreshes and manual requests. The schedule is checked whenever the application is reconciled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"drySource", "syncSource"},
 			},
@@ -7847,11 +9172,31 @@ func schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref common.Refere
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation"),
 						},
 					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest holds information about the pull request opened to promote hydrated manifests from the hydrateTo branch to the sync branch",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequestStatus"),
+						},
+					},
+					"hydrateHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydrateHistory contains information about the most recent hydrations which changed the hydrated branch. The number of entries is limited by spec.revisionHistoryLimit.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateHistory"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratePullRequestStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation"},
 	}
 }
 
@@ -7883,6 +9228,21 @@ func schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref common.
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"dryRevisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It is only set if the dry source has multiple sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -7891,6 +9251,114 @@ func schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref common.
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncBatch selects the resources of a batch of a batched sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the batch",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the resources of the batch by labels",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"kinds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kinds select the resources of the batch by group and kind. If both selector and kinds are set, resources have to match both.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+									},
+								},
+							},
+						},
+					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pause is the duration for which the sync is paused before the batch is synced, such as 5m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval holds the sync before the batch until the batch is approved with the argocd.argoproj.io/approve-sync-batch annotation of the application",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncBatchResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncBatchResult holds the state of a batch of a batched sync which was paused before it was synced. Since the resources of every sync phase and wave are synced in batches, a batch is paused once per phase and wave.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the batch",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pausedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PausedAt is the time at which the sync was paused before the batch",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"resumedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResumedAt is the time at which the sync of the batch was resumed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPhase is the sync phase of the resources of the batch",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWave is the sync wave of the resources of the batch",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "pausedAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8122,12 +9590,26 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"batches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batches contains the state of the batches of a batched sync which were paused",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatchResult"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatchResult"},
 	}
 }
 
@@ -8171,11 +9653,17 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"syncStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStrategy is the sync strategy of automated syncs, and of syncs which do not specify a sync strategy",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"},
 	}
 }
 
@@ -8217,12 +9705,12 @@ func schema_pkg_apis_application_v1alpha1_SyncSource(ref common.ReferenceCallbac
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the associated DrySource config in the SourceHydrator.",
+				Description: "SyncSource specifies a location from which hydrated manifests may be synced. Unless RepoURL is set, the repository is assumed based on the associated DrySource config in the SourceHydrator.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"targetBranch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetBranch is the branch to which hydrated manifests should be committed",
+							Description: "TargetBranch is the branch from which hydrated manifests will be synced, or the tag if RepoURL is an OCI repository. If HydrateTo is not set, this is also the branch to which hydrated manifests are committed.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -8230,16 +9718,31 @@ func schema_pkg_apis_application_v1alpha1_SyncSource(ref common.ReferenceCallbac
 					},
 					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is a directory path within the git repository where hydrated manifests should be committed to and synced from. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.",
+							Description: "Path is a directory path within the git repository where hydrated manifests should be committed to and synced from. The Path should never point to the root of the repo. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"layout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Layout specifies how the hydrated manifests are laid out in files within Path. It also applies to the manifests pushed to HydrateTo, if set. Defaults to a single manifest.yaml file.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateLayout"),
+						},
+					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of an OCI repository (oci://) to push the hydrated manifests to as an OCI artifact instead of committing them to the repository of the dry source. The artifact is tagged with TargetBranch, which must be a valid OCI tag, and with the hydrated dry SHA.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"targetBranch", "path"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateLayout"},
 	}
 }
 
@@ -8315,11 +9818,17 @@ func schema_pkg_apis_application_v1alpha1_SyncStrategy(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook"),
 						},
 					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch will perform the sync like Hook, but syncs the resources of every sync phase and wave in batches",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyBatch"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyBatch", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook"},
 	}
 }
 
@@ -8343,6 +9852,43 @@ func schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncStrategyBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncStrategyBatch will perform a sync using hooks annotations, and syncs the resources of every sync phase and wave in batches. The resources of a batch are synced once the resources of the previous batches are healthy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Force indicates whether or not to supply the --force flag to `kubectl apply`. The --force flag deletes and re-create the resource, when PATCH encounters conflict and has retried for 5 times.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"batches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batches are the batches in the order they are synced. Resources belong to the first batch selecting them, and resources not selected by any batch are synced before the batches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"batches"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncBatch"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
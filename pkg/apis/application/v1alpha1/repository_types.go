@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/oci"
 
@@ -57,6 +58,18 @@ type RepoCreds struct {
 	BearerToken string `json:"bearerToken,omitempty" protobuf:"bytes,25,opt,name=bearerToken"`
 	// InsecureOCIForceHttp specifies whether the connection to the repository uses TLS at _all_. If true, no TLS. This flag is applicable for OCI repos only.
 	InsecureOCIForceHttp bool `json:"insecureOCIForceHttp,omitempty" protobuf:"bytes,26,opt,name=insecureOCIForceHttp"` //nolint:revive //FIXME(var-naming)
+	// MirrorURL specifies the URL of a read-only mirror of the repositories matched by these credentials. The part of the
+	// repository URL following the URL of the credentials is appended to it. The mirror is accessed without credentials.
+	// Only used with Git repos. Setting it through the API requires the override action on repositories.
+	MirrorURL string `json:"mirrorURL,omitempty" protobuf:"bytes,27,opt,name=mirrorURL"`
+	// BundleURI specifies the HTTP(S) URI of Git bundles the repositories matched by these credentials are initialized
+	// from. The part of the repository URL following the URL of the credentials is appended to it. Only used with Git
+	// repos. Setting it through the API requires the override action on repositories.
+	BundleURI string `json:"bundleURI,omitempty" protobuf:"bytes,28,opt,name=bundleURI"`
+	// MirrorMaxStaleness specifies for how long branches may be resolved from the mirror after the refs were last listed
+	// from the repository itself, e.g. "5m". Branches are only resolved from the repository if empty or zero. Setting it
+	// through the API requires the override action on repositories.
+	MirrorMaxStaleness string `json:"mirrorMaxStaleness,omitempty" protobuf:"bytes,29,opt,name=mirrorMaxStaleness"`
 }
 
 // Repository is a repository holding application configurations
@@ -116,6 +129,22 @@ type Repository struct {
 	InsecureOCIForceHttp bool `json:"insecureOCIForceHttp,omitempty" protobuf:"bytes,26,opt,name=insecureOCIForceHttp"` //nolint:revive //FIXME(var-naming)
 	// Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.
 	Depth int64 `json:"depth,omitempty" protobuf:"bytes,27,opt,name=depth"`
+	// SparseCheckout specifies whether to fetch the repository without file contents and to check out only the
+	// directories needed to generate the manifests of an application, i.e. its path and its manifest-generate-paths
+	SparseCheckout bool `json:"sparseCheckout,omitempty" protobuf:"bytes,28,opt,name=sparseCheckout"`
+	// MirrorURL specifies the URL of a read-only mirror of the repository. Objects are fetched from the mirror first, and
+	// only the objects missing in the mirror are fetched from the repository itself. Revisions are resolved from the
+	// repository, unless MirrorMaxStaleness is set. The mirror is accessed without credentials. Only used with Git repos.
+	// Setting it through the API requires the override action on repositories.
+	MirrorURL string `json:"mirrorURL,omitempty" protobuf:"bytes,29,opt,name=mirrorURL"`
+	// BundleURI specifies the HTTP(S) URI of a Git bundle the local clone of the repository is initialized from, before
+	// the remaining revisions are fetched. Only used with Git repos. Setting it through the API requires the override
+	// action on repositories.
+	BundleURI string `json:"bundleURI,omitempty" protobuf:"bytes,30,opt,name=bundleURI"`
+	// MirrorMaxStaleness specifies for how long branches may be resolved from the branches fetched from the mirror after
+	// the refs were last listed from the repository itself, e.g. "5m". Branches are only resolved from the repository if
+	// empty or zero. Setting it through the API requires the override action on repositories.
+	MirrorMaxStaleness string `json:"mirrorMaxStaleness,omitempty" protobuf:"bytes,31,opt,name=mirrorMaxStaleness"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -175,6 +204,17 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 	}
 }
 
+// urlForRepo returns the given URL of the credential template for the given repository, by appending the part of the
+// repository URL following the URL of the credential template, e.g. the URL https://mirror.example.com/org for the
+// repository https://github.com/org/repo of the credential template https://github.com/org is
+// https://mirror.example.com/org/repo.
+func (creds *RepoCreds) urlForRepo(url string, repoURL string) string {
+	if suffix, ok := strings.CutPrefix(repoURL, creds.URL); ok {
+		return strings.TrimSuffix(url, "/") + "/" + strings.TrimPrefix(suffix, "/")
+	}
+	return url
+}
+
 // CopyCredentialsFrom copies credentials from given credential template to receiving repository
 func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 	if source != nil {
@@ -220,6 +260,15 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.Type == "" {
 			repo.Type = source.Type
 		}
+		if repo.MirrorURL == "" && source.MirrorURL != "" {
+			repo.MirrorURL = source.urlForRepo(source.MirrorURL, repo.Repo)
+		}
+		if repo.BundleURI == "" && source.BundleURI != "" {
+			repo.BundleURI = source.urlForRepo(source.BundleURI, repo.Repo)
+		}
+		if repo.MirrorMaxStaleness == "" {
+			repo.MirrorMaxStaleness = source.MirrorMaxStaleness
+		}
 
 		repo.EnableOCI = source.EnableOCI
 		repo.InsecureOCIForceHttp = source.InsecureOCIForceHttp
@@ -333,7 +382,25 @@ func (repo *Repository) CopySettingsFrom(source *Repository) {
 		repo.Insecure = source.Insecure
 		repo.InheritedCreds = source.InheritedCreds
 		repo.Depth = source.Depth
+		repo.SparseCheckout = source.SparseCheckout
+		repo.MirrorURL = source.MirrorURL
+		repo.BundleURI = source.BundleURI
+		repo.MirrorMaxStaleness = source.MirrorMaxStaleness
+	}
+}
+
+// GetMirrorMaxStaleness returns for how long branches may be resolved from the mirror of the repository, or zero if
+// branches are only resolved from the repository itself.
+func (repo *Repository) GetMirrorMaxStaleness() time.Duration {
+	if repo.MirrorURL == "" || repo.MirrorMaxStaleness == "" {
+		return 0
+	}
+	staleness, err := time.ParseDuration(repo.MirrorMaxStaleness)
+	if err != nil {
+		log.Warnf("Invalid mirror max staleness %q of repository %s: %v", repo.MirrorMaxStaleness, repo.Repo, err)
+		return 0
 	}
+	return staleness
 }
 
 // StringForLogging gets a string representation of the Repository which is safe to log or return to the user.
@@ -362,6 +429,9 @@ func (repo *Repository) Sanitized() *Repository {
 		GithubAppInstallationId:    repo.GithubAppInstallationId,
 		GitHubAppEnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
 		UseAzureWorkloadIdentity:   repo.UseAzureWorkloadIdentity,
+		MirrorURL:                  repo.MirrorURL,
+		BundleURI:                  repo.BundleURI,
+		MirrorMaxStaleness:         repo.MirrorMaxStaleness,
 	}
 }
 
@@ -441,3 +511,21 @@ type GnuPGPublicKeyList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []GnuPGPublicKey `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// SSHSigningKey is a representation of an SSH public key trusted to sign commits
+type SSHSigningKey struct {
+	// Fingerprint is the SHA256 fingerprint of the key
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,1,opt,name=fingerprint"`
+	// KeyType is the type of the key (e.g. ssh-ed25519)
+	KeyType string `json:"keyType,omitempty" protobuf:"bytes,2,opt,name=keyType"`
+	// Comment holds the comment of the key, usually identifying its owner
+	Comment string `json:"comment,omitempty" protobuf:"bytes,3,opt,name=comment"`
+	// KeyData holds the public key in authorized_keys format
+	KeyData string `json:"keyData,omitempty" protobuf:"bytes,4,opt,name=keyData"`
+}
+
+// SSHSigningKeyList is a collection of SSHSigningKey objects
+type SSHSigningKeyList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []SSHSigningKey `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
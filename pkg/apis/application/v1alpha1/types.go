@@ -32,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -46,6 +47,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -99,6 +101,18 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn lists the applications, in the namespace of the application, which have to be synced and healthy at
+	// their target revisions before the application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency references applications an application depends on, either by name or by labels
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Selector selects the applications by labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -113,6 +127,9 @@ const (
 	TrackingMethodAnnotation         TrackingMethod = "annotation"
 	TrackingMethodLabel              TrackingMethod = "label"
 	TrackingMethodAnnotationAndLabel TrackingMethod = "annotation+label"
+	// TrackingMethodAnnotationAndApplySet tracks resources with annotations, and additionally records them as members of
+	// a Kubernetes ApplySet (KEP-3659), whose parent is a Secret managed by the Application
+	TrackingMethodAnnotationAndApplySet TrackingMethod = "annotation+applyset"
 )
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -211,6 +228,8 @@ type ApplicationSource struct {
 	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
 	// Name is used to refer to a source and is displayed in the UI. It is used in multi-source Applications.
 	Name string `json:"name,omitempty" protobuf:"bytes,14,opt,name=name"`
+	// CUE holds CUE specific options
+	CUE *ApplicationSourceCUE `json:"cue,omitempty" protobuf:"bytes,15,opt,name=cue"`
 }
 
 // ApplicationSources contains list of required information about the sources of an application
@@ -255,7 +274,7 @@ func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 			targetRevision = spec.SourceHydrator.HydrateTo.TargetBranch
 		}
 		return ApplicationSource{
-			RepoURL:        spec.SourceHydrator.DrySource.RepoURL,
+			RepoURL:        spec.SourceHydrator.GetSyncRepoURL(),
 			Path:           spec.SourceHydrator.SyncSource.Path,
 			TargetRevision: targetRevision,
 		}
@@ -340,7 +359,8 @@ func (source *ApplicationSource) IsZero() bool {
 			source.Helm.IsZero() &&
 			source.Kustomize.IsZero() &&
 			source.Directory.IsZero() &&
-			source.Plugin.IsZero()
+			source.Plugin.IsZero() &&
+			source.CUE.IsZero()
 }
 
 // GetNamespaceOrDefault gets the static namespace configured in the source. If none is configured, returns the given
@@ -396,6 +416,7 @@ const (
 	ApplicationSourceTypeKustomize ApplicationSourceType = "Kustomize"
 	ApplicationSourceTypeDirectory ApplicationSourceType = "Directory"
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
+	ApplicationSourceTypeCUE       ApplicationSourceType = "CUE"
 )
 
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
@@ -405,21 +426,33 @@ type SourceHydrator struct {
 	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
 	// SyncSource specifies where to sync hydrated manifests from.
 	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
-	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
-	// have to move manifests to the SyncSource, e.g. by pull request.
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. Manifests then have to be moved
+	// to the SyncSource, e.g. by a pull request which the hydrator can open if HydrateTo.PullRequest is configured.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// Schedule is a cron expression on which the latest revision of the dry source is hydrated, in addition to the
+	// hydrations triggered by webhooks, refreshes and manual requests. The schedule is checked whenever the application
+	// is reconciled.
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,4,opt,name=schedule"`
 }
 
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
 	return ApplicationSource{
-		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
-		RepoURL:        s.DrySource.RepoURL,
+		RepoURL:        s.GetSyncRepoURL(),
 		Path:           s.SyncSource.Path,
 		TargetRevision: s.SyncSource.TargetBranch,
 	}
 }
 
+// GetSyncRepoURL gets the URL of the repository hydrated manifests are pushed to and synced from. Unless the sync source
+// is an OCI repository, this is the repository of the dry source.
+func (s SourceHydrator) GetSyncRepoURL() string {
+	if s.SyncSource.RepoURL != "" {
+		return s.SyncSource.RepoURL
+	}
+	return s.DrySource.RepoURL
+}
+
 // GetDrySource gets the dry source when a source hydrator is configured.
 func (s SourceHydrator) GetDrySource() ApplicationSource {
 	return ApplicationSource{
@@ -429,9 +462,31 @@ func (s SourceHydrator) GetDrySource() ApplicationSource {
 	}
 }
 
+// GetDrySources gets the sources from which manifests are hydrated when a source hydrator is configured. If the dry
+// source has multiple sources, those are returned. Otherwise, the dry source itself is returned as the only source.
+func (s SourceHydrator) GetDrySources() ApplicationSources {
+	if s.DrySource.HasMultipleSources() {
+		return s.DrySource.Sources
+	}
+	return ApplicationSources{s.GetDrySource()}
+}
+
+// GetSchedule parses the hydration schedule. It returns nil if no schedule is configured.
+func (s SourceHydrator) GetSchedule() (cron.Schedule, error) {
+	if s.Schedule == "" {
+		return nil, nil
+	}
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(s.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse hydration schedule %q: %w", s.Schedule, err)
+	}
+	return schedule, nil
+}
+
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	return s.DrySource.Equals(hydrator.DrySource) && reflect.DeepEqual(s.SyncSource, hydrator.SyncSource) && s.HydrateTo.DeepEquals(hydrator.HydrateTo) && s.Schedule == hydrator.Schedule
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -440,8 +495,9 @@ type DrySource struct {
 	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
 	// TargetRevision defines the revision of the source to hydrate
 	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
-	// Path is a directory path within the Git repository where the manifests are located
-	Path string `json:"path" protobuf:"bytes,3,name=path"`
+	// Path is a directory path within the Git repository where the manifests are located. It is ignored if Sources is
+	// set.
+	Path string `json:"path,omitempty" protobuf:"bytes,3,name=path"`
 	// Helm specifies helm specific options
 	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,4,opt,name=helm"`
 	// Kustomize specifies kustomize specific options
@@ -450,26 +506,44 @@ type DrySource struct {
 	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,6,opt,name=directory"`
 	// Plugin specifies config management plugin specific options
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,7,opt,name=plugin"`
+	// Sources is a list of sources from which manifests are hydrated, such as Helm charts from Helm or OCI repositories
+	// and sources referenced by `ref` in Helm value files. If set, Path and the tool specific options of the dry source
+	// are ignored. RepoURL and TargetRevision still determine the git commit being hydrated, so at least one of the
+	// sources must use the same repository URL and target revision.
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,rep,name=sources"`
+}
+
+// HasMultipleSources returns true if the dry source is made of multiple sources.
+func (in DrySource) HasMultipleSources() bool {
+	return len(in.Sources) > 0
+}
+
+// IsDryRepoSource returns true if the given source uses the git repository and target revision of the dry source. The
+// resolved revision of such a source is the dry commit being hydrated.
+func (in DrySource) IsDryRepoSource(source ApplicationSource) bool {
+	return !source.IsHelm() && !source.IsOCI() && git.SameURL(source.RepoURL, in.RepoURL) && source.TargetRevision == in.TargetRevision
 }
 
 func (in DrySource) Equals(other DrySource) bool {
 	// Equals compares two instances of ApplicationSource and return true if instances are equal.
-	if !in.Plugin.Equals(other.Plugin) {
+	if !in.Plugin.Equals(other.Plugin) || !in.Sources.Equals(other.Sources) {
 		return false
 	}
-	// reflect.DeepEqual works fine for the other fields. Since the plugin fields are equal, set them to null so they're
-	// not considered in the DeepEqual comparison.
+	// reflect.DeepEqual works fine for the other fields. Since the plugin and sources fields are equal, set them to null
+	// so they're not considered in the DeepEqual comparison.
 	sourceCopy := in
 	otherCopy := other
 	sourceCopy.Plugin = nil
 	otherCopy.Plugin = nil
+	sourceCopy.Sources = nil
+	otherCopy.Sources = nil
 	return reflect.DeepEqual(sourceCopy, otherCopy)
 }
 
-// SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
-// associated DrySource config in the SourceHydrator.
+// SyncSource specifies a location from which hydrated manifests may be synced. Unless RepoURL is set, the repository is
+// assumed based on the associated DrySource config in the SourceHydrator.
 type SyncSource struct {
-	// TargetBranch is the branch from which hydrated manifests will be synced.
+	// TargetBranch is the branch from which hydrated manifests will be synced, or the tag if RepoURL is an OCI repository.
 	// If HydrateTo is not set, this is also the branch to which hydrated manifests are committed.
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
 	// Path is a directory path within the git repository where hydrated manifests should be committed to and synced
@@ -480,6 +554,49 @@ type SyncSource struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^.{2,}|[^./]$`
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// Layout specifies how the hydrated manifests are laid out in files within Path. It also applies to the manifests
+	// pushed to HydrateTo, if set. Defaults to a single manifest.yaml file.
+	Layout *HydrateLayout `json:"layout,omitempty" protobuf:"bytes,3,opt,name=layout"`
+	// RepoURL is the URL of an OCI repository (oci://) to push the hydrated manifests to as an OCI artifact instead of
+	// committing them to the repository of the dry source. The artifact is tagged with TargetBranch, which must be a
+	// valid OCI tag, and with the hydrated dry SHA.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,4,opt,name=repoURL"`
+}
+
+// IsOCI returns true if the hydrated manifests are pushed to an OCI repository.
+func (s SyncSource) IsOCI() bool {
+	return strings.HasPrefix(s.RepoURL, "oci://")
+}
+
+// HydrateLayoutType is the way hydrated manifests are split into files.
+// +kubebuilder:validation:Enum=singleFile;filePerResource;namespace
+type HydrateLayoutType string
+
+const (
+	// HydrateLayoutTypeSingleFile writes all the hydrated manifests to a single manifest.yaml file.
+	HydrateLayoutTypeSingleFile HydrateLayoutType = "singleFile"
+	// HydrateLayoutTypeFilePerResource writes each hydrated manifest to its own <kind>-<name>.yaml file.
+	HydrateLayoutTypeFilePerResource HydrateLayoutType = "filePerResource"
+	// HydrateLayoutTypeNamespace writes the hydrated manifests of each namespace to a <namespace>.yaml file. Manifests
+	// without a namespace, such as cluster-scoped resources, are written to a _cluster.yaml file.
+	HydrateLayoutTypeNamespace HydrateLayoutType = "namespace"
+)
+
+// HydrateLayout specifies how hydrated manifests are laid out in files.
+type HydrateLayout struct {
+	// Type is the way the hydrated manifests are split into files. One of: singleFile, filePerResource, namespace.
+	// Defaults to singleFile.
+	Type HydrateLayoutType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	// Kustomization generates a kustomization.yaml file listing the hydrated manifest files as resources.
+	Kustomization bool `json:"kustomization,omitempty" protobuf:"varint,2,opt,name=kustomization"`
+}
+
+// GetType returns the layout type, defaulting to singleFile.
+func (in *HydrateLayout) GetType() HydrateLayoutType {
+	if in == nil || in.Type == "" {
+		return HydrateLayoutTypeSingleFile
+	}
+	return in.Type
 }
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
@@ -487,6 +604,9 @@ type SyncSource struct {
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// PullRequest configures the hydrator to open a pull request from TargetBranch into the SyncSource's TargetBranch
+	// whenever new hydrated manifests are pushed. An already open pull request between these branches is updated.
+	PullRequest *HydratePullRequest `json:"pullRequest,omitempty" protobuf:"bytes,2,opt,name=pullRequest"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -498,8 +618,34 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
-	// Compare de-referenced structs.
-	return *in == *to
+	return reflect.DeepEqual(in, to)
+}
+
+// HydratePullRequestProvider is the SCM provider used to open pull requests for hydrated manifests.
+// +kubebuilder:validation:Enum=github;gitlab;gitea;bitbucketServer;bitbucketCloud;azureDevOps
+type HydratePullRequestProvider string
+
+const (
+	HydratePullRequestProviderGitHub          HydratePullRequestProvider = "github"
+	HydratePullRequestProviderGitLab          HydratePullRequestProvider = "gitlab"
+	HydratePullRequestProviderGitea           HydratePullRequestProvider = "gitea"
+	HydratePullRequestProviderBitbucketServer HydratePullRequestProvider = "bitbucketServer"
+	HydratePullRequestProviderBitbucketCloud  HydratePullRequestProvider = "bitbucketCloud"
+	HydratePullRequestProviderAzureDevOps     HydratePullRequestProvider = "azureDevOps"
+)
+
+// HydratePullRequest specifies how to open pull requests promoting hydrated manifests from the HydrateTo branch to the
+// SyncSource branch. The owner and name of the repository are derived from the URL of the repository the hydrated
+// manifests are pushed to, i.e. the SyncSource's RepoURL if set and the DrySource's RepoURL otherwise. The pull request
+// is opened with the write credentials configured for that repository.
+type HydratePullRequest struct {
+	// Provider is the SCM provider hosting the repository.
+	Provider HydratePullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the base URL of the SCM provider's API. Defaults to the public API of the provider. Required for gitea and
+	// bitbucketServer.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Labels is a list of labels to add to the pull request. Not supported by gitea and bitbucket.
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
 }
 
 // RefreshType specifies how to refresh the sources of a given application
@@ -515,6 +661,9 @@ type HydrateType string
 const (
 	// HydrateTypeNormal is a normal hydration
 	HydrateTypeNormal HydrateType = "normal"
+	// HydrateTypeManual is a hydration explicitly requested by a user, which hydrates the dry revision even if it was
+	// already hydrated
+	HydrateTypeManual HydrateType = "manual"
 )
 
 type RefTarget struct {
@@ -561,6 +710,35 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// PostRender post-processes the manifests rendered by Helm before they are returned
+	PostRender *HelmPostRender `json:"postRender,omitempty" protobuf:"bytes,16,opt,name=postRender"`
+}
+
+// HelmPostRender holds the steps post-processing the manifests rendered by Helm, like Helm's --post-renderer. The
+// steps are applied in order: the Kustomize patches first, then the JSON patches and the plugin last.
+type HelmPostRender struct {
+	// Patches are Kustomize patches applied to the rendered manifests. Paths of patch files are relative to the
+	// path of the application.
+	Patches KustomizePatches `json:"patches,omitempty" protobuf:"bytes,1,rep,name=patches"`
+	// JSONPatches are JSON patches (RFC 6902) applied to the rendered manifests matching their target
+	JSONPatches []HelmPostRenderJSONPatch `json:"jsonPatches,omitempty" protobuf:"bytes,2,rep,name=jsonPatches"`
+	// Plugin is the config management plugin the rendered manifests are passed to. The manifests are stored in the
+	// all.yaml file in the working directory of the plugin.
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,3,opt,name=plugin"`
+}
+
+// HelmPostRenderJSONPatch is a JSON patch applied to the manifests rendered by Helm
+type HelmPostRenderJSONPatch struct {
+	// Target selects the manifests the patch is applied to. Group, version, kind, name and namespace are regular
+	// expressions. All manifests are patched if it is omitted.
+	Target *KustomizeSelector `json:"target,omitempty" protobuf:"bytes,1,opt,name=target"`
+	// Patch is the list of JSON patch operations, in JSON or YAML
+	Patch string `json:"patch" protobuf:"bytes,2,opt,name=patch"`
+}
+
+// IsZero returns true if no post-render steps are defined
+func (p *HelmPostRender) IsZero() bool {
+	return p == nil || len(p.Patches) == 0 && len(p.JSONPatches) == 0 && p.Plugin == nil
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -642,7 +820,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && ash.PostRender.IsZero()
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -906,6 +1084,62 @@ func (d *ApplicationSourceDirectory) IsZero() bool {
 	return d == nil || !d.Recurse && d.Jsonnet.IsZero()
 }
 
+// ApplicationSourceCUE holds options specific to applications of type CUE
+type ApplicationSourceCUE struct {
+	// Package is the CUE package to evaluate, e.g. "./..." or ":name". Defaults to the package in the path of the
+	// application.
+	Package string `json:"package,omitempty" protobuf:"bytes,1,opt,name=package"`
+	// Expression is the expression selecting the manifests in the evaluated package, e.g. "objects". Defaults to the
+	// whole package.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+	// Tags are the values injected into the fields of the package with a matching @tag attribute
+	Tags []CUETag `json:"tags,omitempty" protobuf:"bytes,3,rep,name=tags"`
+}
+
+// CUETag is a value injected into the fields of a CUE package with a matching @tag attribute
+type CUETag struct {
+	// Name is the name of the tag
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the tag
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// NewCUETag parses a string in format name=value into a CUETag
+func NewCUETag(text string) (*CUETag, error) {
+	name, value, ok := strings.Cut(text, "=")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("expected CUE tag of the form: name=value. Received: %s", text)
+	}
+	return &CUETag{Name: name, Value: value}, nil
+}
+
+// IsZero returns true if the ApplicationSourceCUE is considered empty
+func (c *ApplicationSourceCUE) IsZero() bool {
+	return c == nil || c.Package == "" && c.Expression == "" && len(c.Tags) == 0
+}
+
+// AddTag adds the given tag, replacing the value of an existing tag with the same name
+func (c *ApplicationSourceCUE) AddTag(tag CUETag) {
+	for i, t := range c.Tags {
+		if t.Name == tag.Name {
+			c.Tags[i] = tag
+			return
+		}
+	}
+	c.Tags = append(c.Tags, tag)
+}
+
+// RemoveTag removes the tag with the given name and returns true if it was present
+func (c *ApplicationSourceCUE) RemoveTag(name string) bool {
+	for i, t := range c.Tags {
+		if t.Name == name {
+			c.Tags = append(c.Tags[:i], c.Tags[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 type OptionalMap struct {
 	// Map is the value of a map type parameter.
 	// +optional
@@ -1210,6 +1444,39 @@ type SourceHydratorStatus struct {
 	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
 	// CurrentOperation holds the status of the hydrate operation
 	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+	// PullRequest holds information about the pull request opened to promote hydrated manifests from the hydrateTo
+	// branch to the sync branch
+	PullRequest *HydratePullRequestStatus `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+	// HydrateHistory contains information about the most recent hydrations which changed the hydrated branch. The number
+	// of entries is limited by spec.revisionHistoryLimit.
+	HydrateHistory HydrateHistories `json:"hydrateHistory,omitempty" protobuf:"bytes,4,rep,name=hydrateHistory"`
+}
+
+// HydratePullRequestPhase indicates the state of the pull request opened by the hydrator
+// +kubebuilder:validation:Enum=Open;Merged;Closed;Failed
+type HydratePullRequestPhase string
+
+const (
+	HydratePullRequestPhaseOpen   HydratePullRequestPhase = "Open"
+	HydratePullRequestPhaseMerged HydratePullRequestPhase = "Merged"
+	HydratePullRequestPhaseClosed HydratePullRequestPhase = "Closed"
+	HydratePullRequestPhaseFailed HydratePullRequestPhase = "Failed"
+)
+
+// HydratePullRequestStatus contains information about the pull request opened by the hydrator
+type HydratePullRequestStatus struct {
+	// Phase indicates whether the pull request is open, was merged or closed, or whether opening it failed
+	Phase HydratePullRequestPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// URL is the link to the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// Number is the number (ID) of the pull request
+	Number int64 `json:"number,omitempty" protobuf:"varint,3,opt,name=number"`
+	// HydratedSHA holds the hydrated commit the pull request was last updated for
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,4,opt,name=hydratedSHA"`
+	// Message contains the error if opening or updating the pull request failed
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// UpdatedAt indicates when the pull request was last opened or updated by the hydrator
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty" protobuf:"bytes,6,opt,name=updatedAt"`
 }
 
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
@@ -1238,6 +1505,22 @@ type HydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It
+	// is only set if the dry source has multiple sources.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,8,rep,name=dryRevisions"`
+	// Manual is true if the hydrate operation was explicitly requested, in which case the dry revision is hydrated even
+	// if it was already hydrated
+	Manual bool `json:"manual,omitempty" protobuf:"varint,9,opt,name=manual"`
+	// TargetRevision holds the revision of the dry source the hydrate operation was requested for, if it was pinned to a
+	// revision other than the target revision of the dry source
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,10,opt,name=targetRevision"`
+	// PullRequest holds the state of the pull request promoting the hydrated commit of this operation, if
+	// hydrateTo.pullRequest is configured
+	PullRequest *HydratePullRequestStatus `json:"pullRequest,omitempty" protobuf:"bytes,11,opt,name=pullRequest"`
+	// TargetDryRevisions holds the revisions of each of the dry sources the hydrate operation was requested for, in the
+	// same order as drySource.sources, if it was pinned to the revisions of an earlier hydration. The sources using the
+	// dry repository are hydrated from TargetRevision instead.
+	TargetDryRevisions []string `json:"targetDryRevisions,omitempty" protobuf:"bytes,12,rep,name=targetDryRevisions"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1248,6 +1531,50 @@ type SuccessfulHydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It
+	// is only set if the dry source has multiple sources.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,8,rep,name=dryRevisions"`
+}
+
+// HydrateHistory contains information about a hydration which changed the hydrated branch
+type HydrateHistory struct {
+	// ID is an auto incrementing identifier of the HydrateHistory
+	ID int64 `json:"id" protobuf:"varint,1,opt,name=id"`
+	// DrySHA holds the resolved revision (sha) of the dry source which was hydrated
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,2,opt,name=drySHA"`
+	// HydratedSHA holds the revision (sha) of the hydrated commit
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,3,opt,name=hydratedSHA"`
+	// DryRevisions holds the resolved revisions of each of the dry sources, in the same order as drySource.sources. It
+	// is only set if the dry source has multiple sources.
+	DryRevisions []string `json:"dryRevisions,omitempty" protobuf:"bytes,4,rep,name=dryRevisions"`
+	// SourceHydrator holds the hydrator config used for the hydrate operation
+	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,5,opt,name=sourceHydrator"`
+	// StartedAt holds the time the hydrate operation started
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,6,opt,name=startedAt"`
+	// HydratedAt holds the time the hydrate operation completed
+	HydratedAt metav1.Time `json:"hydratedAt" protobuf:"bytes,7,opt,name=hydratedAt"`
+	// Manual is true if the hydrate operation was explicitly requested
+	Manual bool `json:"manual,omitempty" protobuf:"varint,8,opt,name=manual"`
+}
+
+// HydrateHistories is an array of hydrate history, oldest first and newest last
+type HydrateHistories []HydrateHistory
+
+// LastHydrateHistory returns the latest history item from the hydrate history
+func (in HydrateHistories) LastHydrateHistory() HydrateHistory {
+	return in[len(in)-1]
+}
+
+// Trunc truncates the list of history items to size n
+func (in HydrateHistories) Trunc(n int) HydrateHistories {
+	if n < 0 {
+		n = 0
+	}
+	i := len(in) - n
+	if i > 0 {
+		in = in[i:]
+	}
+	return in
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
@@ -1477,6 +1804,8 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// SyncStrategy is the sync strategy of automated syncs, and of syncs which do not specify a sync strategy
+	SyncStrategy *SyncStrategy `json:"syncStrategy,omitempty" protobuf:"bytes,5,opt,name=syncStrategy"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1490,7 +1819,7 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.SyncStrategy == nil)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1575,6 +1904,8 @@ type SyncStrategy struct {
 	Apply *SyncStrategyApply `json:"apply,omitempty" protobuf:"bytes,1,opt,name=apply"`
 	// Hook will submit any referenced resources to perform the sync. This is the default strategy
 	Hook *SyncStrategyHook `json:"hook,omitempty" protobuf:"bytes,2,opt,name=hook"`
+	// Batch will perform the sync like Hook, but syncs the resources of every sync phase and wave in batches
+	Batch *SyncStrategyBatch `json:"batch,omitempty" protobuf:"bytes,3,opt,name=batch"`
 }
 
 // Force returns true if the sync strategy specifies to perform a forced sync
@@ -1586,6 +1917,8 @@ func (m *SyncStrategy) Force() bool {
 		return m.Apply.Force
 	case m.Hook != nil:
 		return m.Hook.Force
+	case m.Batch != nil:
+		return m.Batch.Force
 	}
 	return false
 }
@@ -1606,6 +1939,70 @@ type SyncStrategyHook struct {
 	SyncStrategyApply `json:",inline" protobuf:"bytes,1,opt,name=syncStrategyApply"`
 }
 
+// SyncStrategyBatch will perform a sync using hooks annotations, and syncs the resources of every sync phase and wave
+// in batches. The resources of a batch are synced once the resources of the previous batches are healthy.
+type SyncStrategyBatch struct {
+	// Embed SyncStrategyApply type to inherit any `apply` options
+	// +optional
+	SyncStrategyApply `json:",inline" protobuf:"bytes,1,opt,name=syncStrategyApply"`
+	// Batches are the batches in the order they are synced. Resources belong to the first batch selecting them, and
+	// resources not selected by any batch are synced before the batches.
+	Batches []SyncBatch `json:"batches" protobuf:"bytes,2,rep,name=batches"`
+}
+
+// SyncBatch selects the resources of a batch of a batched sync
+type SyncBatch struct {
+	// Name is the name of the batch
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Selector selects the resources of the batch by labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+	// Kinds select the resources of the batch by group and kind. If both selector and kinds are set, resources have to
+	// match both.
+	Kinds []metav1.GroupKind `json:"kinds,omitempty" protobuf:"bytes,3,rep,name=kinds"`
+	// Pause is the duration for which the sync is paused before the batch is synced, such as 5m
+	Pause string `json:"pause,omitempty" protobuf:"bytes,4,opt,name=pause"`
+	// Approval holds the sync before the batch until the batch is approved with the
+	// argocd.argoproj.io/approve-sync-batch annotation of the application
+	Approval bool `json:"approval,omitempty" protobuf:"varint,5,opt,name=approval"`
+}
+
+// Matches returns whether the resource with the given group kind and labels belongs to the batch
+func (b *SyncBatch) Matches(gk schema.GroupKind, resourceLabels map[string]string) (bool, error) {
+	if b.Selector == nil && len(b.Kinds) == 0 {
+		return false, nil
+	}
+	if b.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(b.Selector)
+		if err != nil {
+			return false, fmt.Errorf("invalid selector of batch %s: %w", b.Name, err)
+		}
+		if !selector.Matches(labels.Set(resourceLabels)) {
+			return false, nil
+		}
+	}
+	if len(b.Kinds) == 0 {
+		return true, nil
+	}
+	for _, kind := range b.Kinds {
+		if kind.Group == gk.Group && kind.Kind == gk.Kind {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetPause returns the duration for which the sync is paused before the batch is synced
+func (b *SyncBatch) GetPause() (time.Duration, error) {
+	if b.Pause == "" {
+		return 0, nil
+	}
+	pause, err := time.ParseDuration(b.Pause)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pause of batch %s: %w", b.Name, err)
+	}
+	return pause, nil
+}
+
 // CommitMetadata contains metadata about a commit that is related in some way to another commit.
 type CommitMetadata struct {
 	// Author is the author of the commit, i.e. `git show -s --format=%an <%ae>`.
@@ -1668,6 +2065,10 @@ type OCIMetadata struct {
 	SourceURL   string `json:"sourceUrl,omitempty" protobuf:"bytes,5,opt,name=sourceUrl"`
 	Version     string `json:"version,omitempty" protobuf:"bytes,6,opt,name=version"`
 	Description string `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+	// SignatureVerification is the result of verifying the signatures of the artifact with the OCI signature keys of
+	// the project, if any. It starts with "Verification failed: " if no valid signature could be found, or if the
+	// signatures could not be checked.
+	SignatureVerification string `json:"signatureVerification,omitempty" protobuf:"bytes,8,opt,name=signatureVerification"`
 }
 
 // ChartDetails contains helm chart metadata for a specific version
@@ -1693,6 +2094,23 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Batches contains the state of the batches of a batched sync which were paused
+	Batches []SyncBatchResult `json:"batches,omitempty" protobuf:"bytes,7,rep,name=batches"`
+}
+
+// SyncBatchResult holds the state of a batch of a batched sync which was paused before it was synced. Since the
+// resources of every sync phase and wave are synced in batches, a batch is paused once per phase and wave.
+type SyncBatchResult struct {
+	// Name is the name of the batch
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// PausedAt is the time at which the sync was paused before the batch
+	PausedAt metav1.Time `json:"pausedAt" protobuf:"bytes,2,opt,name=pausedAt"`
+	// ResumedAt is the time at which the sync of the batch was resumed
+	ResumedAt *metav1.Time `json:"resumedAt,omitempty" protobuf:"bytes,3,opt,name=resumedAt"`
+	// SyncPhase is the sync phase of the resources of the batch
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,4,opt,name=syncPhase"`
+	// SyncWave is the sync wave of the resources of the batch
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"varint,5,opt,name=syncWave"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1840,6 +2258,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionWaitingForDependencies indicates that the application is not synced until the applications it
+	// depends on are synced and healthy
+	ApplicationConditionWaitingForDependencies = "WaitingForDependencies"
+	// ApplicationConditionHydrationPolicyError indicates that the hydrated manifests of the application violate the
+	// policies checked by the source hydrator, which prevents them from being committed
+	ApplicationConditionHydrationPolicyError = "HydrationPolicyError"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2721,6 +3145,29 @@ type SignatureKey struct {
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
 }
 
+// SSHSignatureKey is the specification of an SSH key required to verify commit signatures with
+type SSHSignatureKey struct {
+	// Fingerprint is the SHA256 fingerprint of the public key, e.g. SHA256:4o7Hd0nmhm1VCV6Lz+6d+QS5rTX2mQhT9pZ4MK3sc9g
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,1,name=fingerprint"`
+}
+
+const (
+	// OCISignatureKeyTypeCosign is the type of keys verifying cosign signatures
+	OCISignatureKeyTypeCosign = "cosign"
+	// OCISignatureKeyTypeNotation is the type of keys verifying Notation signatures
+	OCISignatureKeyTypeNotation = "notation"
+)
+
+// OCISignatureKey is the specification of a key required to verify the signatures of OCI artifacts with
+type OCISignatureKey struct {
+	// Type is the type of the signatures verified with the key, either "cosign" or "notation"
+	// +kubebuilder:validation:Enum=cosign;notation
+	Type string `json:"type" protobuf:"bytes,1,name=type"`
+	// PublicKey is the PEM encoded public key of cosign signatures, or the PEM encoded trusted root certificates of
+	// Notation signatures
+	PublicKey string `json:"publicKey" protobuf:"bytes,2,name=publicKey"`
+}
+
 // AppProjectSpec is the specification of an AppProject
 type AppProjectSpec struct {
 	// SourceRepos contains list of repository URLs which can be used for deployment
@@ -2752,6 +3199,12 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// OCISignatureKeys contains a list of keys that OCI artifacts and Helm charts in OCI registries must be signed with
+	// in order to be allowed for sync
+	OCISignatureKeys []OCISignatureKey `json:"ociSignatureKeys,omitempty" protobuf:"bytes,15,opt,name=ociSignatureKeys"`
+	// SSHSignatureKeys contains a list of SSH key fingerprints that commits in Git must be signed with in order to be
+	// allowed for sync. Commits signed with any of the SignatureKeys or SSHSignatureKeys are allowed.
+	SSHSignatureKeys []SSHSignatureKey `json:"sshSignatureKeys,omitempty" protobuf:"bytes,16,opt,name=sshSignatureKeys"`
 }
 
 // ClusterResourceRestrictionItem is a cluster resource that is restricted by the project's whitelist or blacklist
@@ -3334,20 +3787,24 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
-// IsHydrateRequested returns whether hydration has been requested for an application
-func (app *Application) IsHydrateRequested() bool {
+// IsHydrateRequested returns whether hydration has been requested for an application, and if yes, the type of hydration
+// that should be executed.
+func (app *Application) IsHydrateRequested() (HydrateType, bool) {
 	annotations := app.GetAnnotations()
 	if annotations == nil {
-		return false
+		return HydrateTypeNormal, false
 	}
 	typeStr, ok := annotations[AnnotationKeyHydrate]
 	if !ok {
-		return false
+		return HydrateTypeNormal, false
 	}
-	if typeStr == string(HydrateTypeNormal) {
-		return true
+	switch typeStr {
+	case string(HydrateTypeNormal):
+		return HydrateTypeNormal, true
+	case string(HydrateTypeManual):
+		return HydrateTypeManual, true
 	}
-	return false
+	return HydrateTypeNormal, false
 }
 
 func (app *Application) HasPreDeleteFinalizer(stage ...string) bool {
@@ -3544,6 +4001,9 @@ func (source *ApplicationSource) ExplicitType() (*ApplicationSourceType, error)
 	if source.Plugin != nil {
 		appTypes = append(appTypes, ApplicationSourceTypePlugin)
 	}
+	if source.CUE != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeCUE)
+	}
 	if len(appTypes) == 0 {
 		return nil, nil
 	}
@@ -657,6 +657,21 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateSSHSignatureKeys tests for invalid SSH signature keys
+func TestAppProject_ValidateSSHSignatureKeys(t *testing.T) {
+	p := newTestProject()
+	assert.False(t, p.HasSignatureKeys())
+
+	p.Spec.SSHSignatureKeys = []SSHSignatureKey{{Fingerprint: "SHA256:PI8johZKBD4JcrMk5xoNLg9sUfZwm3A8gY3x47ER2Ls"}}
+	require.NoError(t, p.ValidateProject())
+	assert.True(t, p.HasSignatureKeys())
+
+	for _, badFingerprint := range []string{"", "SHA256:", "MD5:16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48", "4AEE18F83AFDEB23"} {
+		p.Spec.SSHSignatureKeys = []SSHSignatureKey{{Fingerprint: badFingerprint}}
+		require.Error(t, p.ValidateProject(), badFingerprint)
+	}
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -1380,6 +1395,8 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 		{"SourceTLSClientCertData", &Repository{}, &RepoCreds{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &RepoCreds{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
 		{"SourceContainsProxy", &Repository{}, &RepoCreds{Proxy: "http://proxy.argoproj.io:3128", NoProxy: ".example.com"}, Repository{Proxy: "http://proxy.argoproj.io:3128", NoProxy: ".example.com"}},
+		{"MirrorURL", &Repository{Repo: "https://github.com/org/repo", MirrorURL: "https://mirror.example.com/repo"}, &RepoCreds{URL: "https://github.com/org", MirrorURL: "https://mirror.example.com/org"}, Repository{Repo: "https://github.com/org/repo", MirrorURL: "https://mirror.example.com/repo"}},
+		{"SourceMirror", &Repository{Repo: "https://github.com/org/repo.git"}, &RepoCreds{URL: "https://github.com/org/", MirrorURL: "https://mirror.example.com/org/", BundleURI: "https://bundles.example.com/org", MirrorMaxStaleness: "5m"}, Repository{Repo: "https://github.com/org/repo.git", MirrorURL: "https://mirror.example.com/org/repo.git", BundleURI: "https://bundles.example.com/org/repo.git", MirrorMaxStaleness: "5m"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1390,6 +1407,24 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 	}
 }
 
+func TestRepository_GetMirrorMaxStaleness(t *testing.T) {
+	tests := []struct {
+		name string
+		repo *Repository
+		want time.Duration
+	}{
+		{"NoMirror", &Repository{MirrorMaxStaleness: "5m"}, 0},
+		{"NoStaleness", &Repository{MirrorURL: "https://mirror.example.com/repo"}, 0},
+		{"Staleness", &Repository{MirrorURL: "https://mirror.example.com/repo", MirrorMaxStaleness: "5m"}, 5 * time.Minute},
+		{"InvalidStaleness", &Repository{MirrorURL: "https://mirror.example.com/repo", MirrorMaxStaleness: "five minutes"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.repo.GetMirrorMaxStaleness())
+		})
+	}
+}
+
 func TestRepository_CopySettingsFrom(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1401,6 +1436,7 @@ func TestRepository_CopySettingsFrom(t *testing.T) {
 		{"TestHasEnableLFS", &Repository{EnableLFS: true}, Repository{EnableLFS: true}},
 		{"TestHasInsecure", &Repository{Insecure: true}, Repository{Insecure: true}},
 		{"TestHasInsecureIgnoreHostKey", &Repository{InsecureIgnoreHostKey: true}, Repository{InsecureIgnoreHostKey: true}},
+		{"TestHasMirror", &Repository{MirrorURL: "https://mirror.example.com/repo", BundleURI: "https://bundles.example.com/repo", MirrorMaxStaleness: "5m"}, Repository{MirrorURL: "https://mirror.example.com/repo", BundleURI: "https://bundles.example.com/repo", MirrorMaxStaleness: "5m"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3467,6 +3503,62 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(-1))
 }
 
+func TestSourceHydrator_GetSyncSource(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: "guestbook"},
+		SyncSource: SyncSource{TargetBranch: "env/dev", Path: "guestbook"},
+	}
+	assert.Equal(t, ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "env/dev", Path: "guestbook"}, hydrator.GetSyncSource())
+	assert.False(t, hydrator.SyncSource.IsOCI())
+
+	hydrator.SyncSource = SyncSource{RepoURL: "oci://registry.example.com/guestbook", TargetBranch: "env-dev", Path: "guestbook"}
+	assert.Equal(t, ApplicationSource{RepoURL: "oci://registry.example.com/guestbook", TargetRevision: "env-dev", Path: "guestbook"}, hydrator.GetSyncSource())
+	assert.True(t, hydrator.SyncSource.IsOCI())
+	spec := ApplicationSpec{SourceHydrator: &hydrator}
+	assert.Equal(t, "oci://registry.example.com/guestbook", spec.GetHydrateToSource().RepoURL)
+}
+
+func TestHydrateHistories_Trunc(t *testing.T) {
+	assert.Empty(t, HydrateHistories{}.Trunc(1))
+	assert.Len(t, HydrateHistories{{}, {}}.Trunc(1), 1)
+	// keep the last element, even with longer list
+	assert.Equal(t, HydrateHistories{{ID: 2}}, HydrateHistories{{ID: 0}, {ID: 1}, {ID: 2}}.Trunc(1))
+	assert.Empty(t, HydrateHistories{}.Trunc(-1))
+}
+
+func TestSourceHydrator_GetSchedule(t *testing.T) {
+	schedule, err := SourceHydrator{}.GetSchedule()
+	require.NoError(t, err)
+	assert.Nil(t, schedule)
+
+	schedule, err = SourceHydrator{Schedule: "0 * * * *"}.GetSchedule()
+	require.NoError(t, err)
+	start := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC), schedule.Next(start))
+
+	_, err = SourceHydrator{Schedule: "every hour"}.GetSchedule()
+	require.ErrorContains(t, err, "cannot parse hydration schedule")
+}
+
+func TestApplication_IsHydrateRequested(t *testing.T) {
+	testCases := []struct {
+		annotations       map[string]string
+		expectedType      HydrateType
+		expectedRequested bool
+	}{
+		{annotations: nil, expectedType: HydrateTypeNormal, expectedRequested: false},
+		{annotations: map[string]string{AnnotationKeyHydrate: "normal"}, expectedType: HydrateTypeNormal, expectedRequested: true},
+		{annotations: map[string]string{AnnotationKeyHydrate: "manual"}, expectedType: HydrateTypeManual, expectedRequested: true},
+		{annotations: map[string]string{AnnotationKeyHydrate: "unknown"}, expectedType: HydrateTypeNormal, expectedRequested: false},
+	}
+	for _, tc := range testCases {
+		app := &Application{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+		hydrateType, requested := app.IsHydrateRequested()
+		assert.Equal(t, tc.expectedType, hydrateType)
+		assert.Equal(t, tc.expectedRequested, requested)
+	}
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -4854,6 +4946,8 @@ func TestSourceHydrator_Equals(t *testing.T) {
 	}{
 		{"different SourceHydrators", SourceHydrator{}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, false},
 		{"equal SourceHydrators", SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, true},
+		{"different dry sources", SourceHydrator{DrySource: DrySource{Sources: ApplicationSources{{RepoURL: "https://example.com/repo", Ref: "values"}}}}, SourceHydrator{DrySource: DrySource{Sources: ApplicationSources{{RepoURL: "https://example.com/repo", Ref: "other"}}}}, false},
+		{"equal dry sources", SourceHydrator{DrySource: DrySource{Sources: ApplicationSources{{RepoURL: "https://example.com/repo", Ref: "values"}}}}, SourceHydrator{DrySource: DrySource{Sources: ApplicationSources{{RepoURL: "https://example.com/repo", Ref: "values"}}}}, true},
 	}
 
 	for _, testCase := range tests {
@@ -4865,3 +4959,85 @@ func TestSourceHydrator_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceHydrator_GetDrySources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single source", func(t *testing.T) {
+		t.Parallel()
+		hydrator := SourceHydrator{DrySource: DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"}}
+		assert.Equal(t, ApplicationSources{{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"}}, hydrator.GetDrySources())
+	})
+
+	t.Run("multiple sources", func(t *testing.T) {
+		t.Parallel()
+		sources := ApplicationSources{
+			{RepoURL: "oci://example.com/charts", Chart: "umbrella", TargetRevision: "1.2.3"},
+			{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"},
+		}
+		hydrator := SourceHydrator{DrySource: DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "ignored", Sources: sources}}
+		assert.Equal(t, sources, hydrator.GetDrySources())
+	})
+}
+
+func TestSourceHydrator_DeepEquals_Layout(t *testing.T) {
+	t.Parallel()
+
+	newHydrator := func(layout *HydrateLayout) SourceHydrator {
+		return SourceHydrator{
+			DrySource:  DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"},
+			SyncSource: SyncSource{TargetBranch: "env/prod", Path: "app", Layout: layout},
+		}
+	}
+	assert.True(t, newHydrator(nil).DeepEquals(newHydrator(nil)))
+	assert.True(t, newHydrator(&HydrateLayout{Type: HydrateLayoutTypeNamespace}).DeepEquals(newHydrator(&HydrateLayout{Type: HydrateLayoutTypeNamespace})))
+	assert.False(t, newHydrator(nil).DeepEquals(newHydrator(&HydrateLayout{Type: HydrateLayoutTypeNamespace})))
+	assert.False(t, newHydrator(&HydrateLayout{Type: HydrateLayoutTypeNamespace}).DeepEquals(newHydrator(&HydrateLayout{Type: HydrateLayoutTypeNamespace, Kustomization: true})))
+}
+
+func TestSourceHydrator_DeepEquals_Schedule(t *testing.T) {
+	t.Parallel()
+
+	newHydrator := func(schedule string) SourceHydrator {
+		return SourceHydrator{
+			DrySource:  DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"},
+			SyncSource: SyncSource{TargetBranch: "env/prod", Path: "app"},
+			Schedule:   schedule,
+		}
+	}
+	assert.True(t, newHydrator("0 * * * *").DeepEquals(newHydrator("0 * * * *")))
+	assert.False(t, newHydrator("").DeepEquals(newHydrator("0 * * * *")))
+	assert.False(t, newHydrator("0 * * * *").DeepEquals(newHydrator("30 * * * *")))
+}
+
+func TestHydrateLayout_GetType(t *testing.T) {
+	t.Parallel()
+
+	var layout *HydrateLayout
+	assert.Equal(t, HydrateLayoutTypeSingleFile, layout.GetType())
+	assert.Equal(t, HydrateLayoutTypeSingleFile, (&HydrateLayout{Kustomization: true}).GetType())
+	assert.Equal(t, HydrateLayoutTypeFilePerResource, (&HydrateLayout{Type: HydrateLayoutTypeFilePerResource}).GetType())
+}
+
+func TestDrySource_IsDryRepoSource(t *testing.T) {
+	t.Parallel()
+
+	drySource := DrySource{RepoURL: "https://example.com/repo.git", TargetRevision: "main"}
+	tests := []struct {
+		name     string
+		source   ApplicationSource
+		expected bool
+	}{
+		{"same repository and revision", ApplicationSource{RepoURL: "https://example.com/repo", TargetRevision: "main", Ref: "values"}, true},
+		{"different revision", ApplicationSource{RepoURL: "https://example.com/repo", TargetRevision: "release", Ref: "values"}, false},
+		{"different repository", ApplicationSource{RepoURL: "https://example.com/other", TargetRevision: "main", Path: "app"}, false},
+		{"helm chart", ApplicationSource{RepoURL: "https://example.com/repo", TargetRevision: "main", Chart: "chart"}, false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.expected, drySource.IsDryRepoSource(testCase.source))
+		})
+	}
+}
@@ -194,6 +194,16 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCISignatureKeys != nil {
+		in, out := &in.OCISignatureKeys, &out.OCISignatureKeys
+		*out = make([]OCISignatureKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSHSignatureKeys != nil {
+		in, out := &in.SSHSignatureKeys, &out.SSHSignatureKeys
+		*out = make([]SSHSignatureKey, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -283,6 +293,27 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -521,6 +552,16 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterProfile != nil {
+		in, out := &in.ClusterProfile, &out.ClusterProfile
+		*out = new(ClusterProfileGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -642,6 +683,16 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterProfile != nil {
+		in, out := &in.ClusterProfile, &out.ClusterProfile
+		*out = new(ClusterProfileGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -703,6 +754,103 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutAnalysis) DeepCopyInto(out *ApplicationSetRolloutAnalysis) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ApplicationSetRolloutHTTPAnalysis)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ApplicationSetRolloutPrometheusAnalysis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutAnalysis.
+func (in *ApplicationSetRolloutAnalysis) DeepCopy() *ApplicationSetRolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutAnalysisResult) DeepCopyInto(out *ApplicationSetRolloutAnalysisResult) {
+	*out = *in
+	in.CheckedAt.DeepCopyInto(&out.CheckedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutAnalysisResult.
+func (in *ApplicationSetRolloutAnalysisResult) DeepCopy() *ApplicationSetRolloutAnalysisResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutAnalysisResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutFailure) DeepCopyInto(out *ApplicationSetRolloutFailure) {
+	*out = *in
+	in.FailedAt.DeepCopyInto(&out.FailedAt)
+	if in.RolledBack != nil {
+		in, out := &in.RolledBack, &out.RolledBack
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutFailure.
+func (in *ApplicationSetRolloutFailure) DeepCopy() *ApplicationSetRolloutFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutHTTPAnalysis) DeepCopyInto(out *ApplicationSetRolloutHTTPAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutHTTPAnalysis.
+func (in *ApplicationSetRolloutHTTPAnalysis) DeepCopy() *ApplicationSetRolloutHTTPAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutHTTPAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopyInto(out *ApplicationSetRolloutPrometheusAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutPrometheusAnalysis.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopy() *ApplicationSetRolloutPrometheusAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutPrometheusAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -718,6 +866,11 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetRolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -731,6 +884,39 @@ func (in *ApplicationSetRolloutStep) DeepCopy() *ApplicationSetRolloutStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepStatus) DeepCopyInto(out *ApplicationSetRolloutStepStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.HealthyAt != nil {
+		in, out := &in.HealthyAt, &out.HealthyAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetRolloutAnalysisResult)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepStatus.
+func (in *ApplicationSetRolloutStepStatus) DeepCopy() *ApplicationSetRolloutStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRolloutStrategy) {
 	*out = *in
@@ -834,6 +1020,18 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RolloutSteps != nil {
+		in, out := &in.RolloutSteps, &out.RolloutSteps
+		*out = make([]ApplicationSetRolloutStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RolloutFailure != nil {
+		in, out := &in.RolloutFailure, &out.RolloutFailure
+		*out = new(ApplicationSetRolloutFailure)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -985,6 +1183,16 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterProfile != nil {
+		in, out := &in.ClusterProfile, &out.ClusterProfile
+		*out = new(ClusterProfileGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1066,6 +1274,11 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CUE != nil {
+		in, out := &in.CUE, &out.CUE
+		*out = new(ApplicationSourceCUE)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1079,6 +1292,27 @@ func (in *ApplicationSource) DeepCopy() *ApplicationSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceCUE) DeepCopyInto(out *ApplicationSourceCUE) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]CUETag, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceCUE.
+func (in *ApplicationSourceCUE) DeepCopy() *ApplicationSourceCUE {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceCUE)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceDirectory) DeepCopyInto(out *ApplicationSourceDirectory) {
 	*out = *in
@@ -1124,6 +1358,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostRender != nil {
+		in, out := &in.PostRender, &out.PostRender
+		*out = new(HelmPostRender)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1377,6 +1616,13 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1529,6 +1775,68 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactGenerator) DeepCopyInto(out *ArtifactGenerator) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]ArtifactGeneratorFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactGenerator.
+func (in *ArtifactGenerator) DeepCopy() *ArtifactGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactGeneratorFilter) DeepCopyInto(out *ArtifactGeneratorFilter) {
+	*out = *in
+	if in.VersionConstraint != nil {
+		in, out := &in.VersionConstraint, &out.VersionConstraint
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionMatch != nil {
+		in, out := &in.VersionMatch, &out.VersionMatch
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactGeneratorFilter.
+func (in *ArtifactGeneratorFilter) DeepCopy() *ArtifactGeneratorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactGeneratorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1613,6 +1921,22 @@ func (in *BearerTokenBitbucketCloud) DeepCopy() *BearerTokenBitbucketCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CUETag) DeepCopyInto(out *CUETag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CUETag.
+func (in *CUETag) DeepCopy() *CUETag {
+	if in == nil {
+		return nil
+	}
+	out := new(CUETag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDetails) DeepCopyInto(out *ChartDetails) {
 	*out = *in
@@ -1800,6 +2124,41 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileGenerator) DeepCopyInto(out *ClusterProfileGenerator) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.RequiredConditions != nil {
+		in, out := &in.RequiredConditions, &out.RequiredConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileGenerator.
+func (in *ClusterProfileGenerator) DeepCopy() *ClusterProfileGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceRestrictionItem) DeepCopyInto(out *ClusterResourceRestrictionItem) {
 	*out = *in
@@ -1987,6 +2346,13 @@ func (in *DrySource) DeepCopyInto(out *DrySource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2172,179 +2538,353 @@ func (in *GitGenerator) DeepCopy() *GitGenerator {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GnuPGPublicKey) DeepCopyInto(out *GnuPGPublicKey) {
+func (in *GnuPGPublicKey) DeepCopyInto(out *GnuPGPublicKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GnuPGPublicKey.
+func (in *GnuPGPublicKey) DeepCopy() *GnuPGPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(GnuPGPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GnuPGPublicKeyList) DeepCopyInto(out *GnuPGPublicKeyList) {
+	*out = *in
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GnuPGPublicKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GnuPGPublicKeyList.
+func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(GnuPGPublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
+func (in *HealthStatus) DeepCopy() *HealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmFileParameter.
+func (in *HelmFileParameter) DeepCopy() *HelmFileParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmFileParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmOptions) DeepCopyInto(out *HelmOptions) {
+	*out = *in
+	if in.ValuesFileSchemes != nil {
+		in, out := &in.ValuesFileSchemes, &out.ValuesFileSchemes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOptions.
+func (in *HelmOptions) DeepCopy() *HelmOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmParameter) DeepCopyInto(out *HelmParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmParameter.
+func (in *HelmParameter) DeepCopy() *HelmParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRender) DeepCopyInto(out *HelmPostRender) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make(KustomizePatches, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JSONPatches != nil {
+		in, out := &in.JSONPatches, &out.JSONPatches
+		*out = make([]HelmPostRenderJSONPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ApplicationSourcePlugin)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRender.
+func (in *HelmPostRender) DeepCopy() *HelmPostRender {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRender)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderJSONPatch) DeepCopyInto(out *HelmPostRenderJSONPatch) {
 	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(KustomizeSelector)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GnuPGPublicKey.
-func (in *GnuPGPublicKey) DeepCopy() *GnuPGPublicKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderJSONPatch.
+func (in *HelmPostRenderJSONPatch) DeepCopy() *HelmPostRenderJSONPatch {
 	if in == nil {
 		return nil
 	}
-	out := new(GnuPGPublicKey)
+	out := new(HelmPostRenderJSONPatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GnuPGPublicKeyList) DeepCopyInto(out *GnuPGPublicKeyList) {
+func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GnuPGPublicKey, len(*in))
+	if in.ResourcesInfo != nil {
+		in, out := &in.ResourcesInfo, &out.ResourcesInfo
+		*out = make([]HostResourceInfo, len(*in))
 		copy(*out, *in)
 	}
+	in.SystemInfo.DeepCopyInto(&out.SystemInfo)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GnuPGPublicKeyList.
-func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostInfo.
+func (in *HostInfo) DeepCopy() *HostInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(GnuPGPublicKeyList)
+	out := new(HostInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
+func (in *HostResourceInfo) DeepCopyInto(out *HostResourceInfo) {
 	*out = *in
-	if in.LastTransitionTime != nil {
-		in, out := &in.LastTransitionTime, &out.LastTransitionTime
-		*out = (*in).DeepCopy()
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
-func (in *HealthStatus) DeepCopy() *HealthStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostResourceInfo.
+func (in *HostResourceInfo) DeepCopy() *HostResourceInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(HealthStatus)
+	out := new(HostResourceInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
-	*out = *in
-	return
+func (in HydrateHistories) DeepCopyInto(out *HydrateHistories) {
+	{
+		in := &in
+		*out = make(HydrateHistories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmFileParameter.
-func (in *HelmFileParameter) DeepCopy() *HelmFileParameter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateHistories.
+func (in HydrateHistories) DeepCopy() HydrateHistories {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmFileParameter)
+	out := new(HydrateHistories)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmOptions) DeepCopyInto(out *HelmOptions) {
+func (in *HydrateHistory) DeepCopyInto(out *HydrateHistory) {
 	*out = *in
-	if in.ValuesFileSchemes != nil {
-		in, out := &in.ValuesFileSchemes, &out.ValuesFileSchemes
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	in.HydratedAt.DeepCopyInto(&out.HydratedAt)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOptions.
-func (in *HelmOptions) DeepCopy() *HelmOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateHistory.
+func (in *HydrateHistory) DeepCopy() *HydrateHistory {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmOptions)
+	out := new(HydrateHistory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmParameter) DeepCopyInto(out *HelmParameter) {
+func (in *HydrateLayout) DeepCopyInto(out *HydrateLayout) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmParameter.
-func (in *HelmParameter) DeepCopy() *HelmParameter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateLayout.
+func (in *HydrateLayout) DeepCopy() *HydrateLayout {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmParameter)
+	out := new(HydrateLayout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostInfo) DeepCopyInto(out *HostInfo) {
+func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
 	*out = *in
-	if in.ResourcesInfo != nil {
-		in, out := &in.ResourcesInfo, &out.ResourcesInfo
-		*out = make([]HostResourceInfo, len(*in))
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.SystemInfo.DeepCopyInto(&out.SystemInfo)
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetDryRevisions != nil {
+		in, out := &in.TargetDryRevisions, &out.TargetDryRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostInfo.
-func (in *HostInfo) DeepCopy() *HostInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateOperation.
+func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(HostInfo)
+	out := new(HydrateOperation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostResourceInfo) DeepCopyInto(out *HostResourceInfo) {
+func (in *HydratePullRequest) DeepCopyInto(out *HydratePullRequest) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostResourceInfo.
-func (in *HostResourceInfo) DeepCopy() *HostResourceInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePullRequest.
+func (in *HydratePullRequest) DeepCopy() *HydratePullRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(HostResourceInfo)
+	out := new(HydratePullRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
+func (in *HydratePullRequestStatus) DeepCopyInto(out *HydratePullRequestStatus) {
 	*out = *in
-	in.StartedAt.DeepCopyInto(&out.StartedAt)
-	if in.FinishedAt != nil {
-		in, out := &in.FinishedAt, &out.FinishedAt
-		*out = (*in).DeepCopy()
-	}
-	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateOperation.
-func (in *HydrateOperation) DeepCopy() *HydrateOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePullRequestStatus.
+func (in *HydratePullRequestStatus) DeepCopy() *HydratePullRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HydrateOperation)
+	out := new(HydratePullRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2352,6 +2892,11 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2856,6 +3401,22 @@ func (in *OCIMetadata) DeepCopy() *OCIMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISignatureKey) DeepCopyInto(out *OCISignatureKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISignatureKey.
+func (in *OCISignatureKey) DeepCopy() *OCISignatureKey {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISignatureKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -4374,6 +4935,60 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSignatureKey) DeepCopyInto(out *SSHSignatureKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSignatureKey.
+func (in *SSHSignatureKey) DeepCopy() *SSHSignatureKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSignatureKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSigningKey) DeepCopyInto(out *SSHSigningKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSigningKey.
+func (in *SSHSigningKey) DeepCopy() *SSHSigningKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSigningKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSigningKeyList) DeepCopyInto(out *SSHSigningKeyList) {
+	*out = *in
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHSigningKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSigningKeyList.
+func (in *SSHSigningKeyList) DeepCopy() *SSHSigningKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSigningKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4410,11 +5025,11 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	*out = *in
 	in.DrySource.DeepCopyInto(&out.DrySource)
-	out.SyncSource = in.SyncSource
+	in.SyncSource.DeepCopyInto(&out.SyncSource)
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -4442,6 +5057,18 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HydrateHistory != nil {
+		in, out := &in.HydrateHistory, &out.HydrateHistory
+		*out = make(HydrateHistories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4459,6 +5086,11 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.DryRevisions != nil {
+		in, out := &in.DryRevisions, &out.DryRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4472,6 +5104,53 @@ func (in *SuccessfulHydrateOperation) DeepCopy() *SuccessfulHydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncBatch) DeepCopyInto(out *SyncBatch) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncBatch.
+func (in *SyncBatch) DeepCopy() *SyncBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncBatchResult) DeepCopyInto(out *SyncBatchResult) {
+	*out = *in
+	in.PausedAt.DeepCopyInto(&out.PausedAt)
+	if in.ResumedAt != nil {
+		in, out := &in.ResumedAt, &out.ResumedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncBatchResult.
+func (in *SyncBatchResult) DeepCopy() *SyncBatchResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncBatchResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4573,6 +5252,13 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([]SyncBatchResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4629,6 +5315,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncStrategy != nil {
+		in, out := &in.SyncStrategy, &out.SyncStrategy
+		*out = new(SyncStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4666,6 +5357,11 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(HydrateLayout)
+		**out = **in
+	}
 	return
 }
 
@@ -4714,6 +5410,11 @@ func (in *SyncStrategy) DeepCopyInto(out *SyncStrategy) {
 		*out = new(SyncStrategyHook)
 		**out = **in
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(SyncStrategyBatch)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4743,6 +5444,30 @@ func (in *SyncStrategyApply) DeepCopy() *SyncStrategyApply {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncStrategyBatch) DeepCopyInto(out *SyncStrategyBatch) {
+	*out = *in
+	out.SyncStrategyApply = in.SyncStrategyApply
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([]SyncBatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStrategyBatch.
+func (in *SyncStrategyBatch) DeepCopy() *SyncStrategyBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncStrategyBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStrategyHook) DeepCopyInto(out *SyncStrategyHook) {
 	*out = *in
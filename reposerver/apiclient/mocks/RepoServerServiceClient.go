@@ -1426,3 +1426,90 @@ func (_c *RepoServerServiceClient_UpdateRevisionForPaths_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// WarmUp provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) WarmUp(ctx context.Context, in *apiclient.WarmUpRequest, opts ...grpc.CallOption) (*apiclient.WarmUpResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WarmUp")
+	}
+
+	var r0 *apiclient.WarmUpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.WarmUpRequest, ...grpc.CallOption) (*apiclient.WarmUpResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.WarmUpRequest, ...grpc.CallOption) *apiclient.WarmUpResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.WarmUpResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.WarmUpRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_WarmUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WarmUp'
+type RepoServerServiceClient_WarmUp_Call struct {
+	*mock.Call
+}
+
+// WarmUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.WarmUpRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) WarmUp(ctx interface{}, in interface{}, opts ...interface{}) *RepoServerServiceClient_WarmUp_Call {
+	return &RepoServerServiceClient_WarmUp_Call{Call: _e.mock.On("WarmUp",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_WarmUp_Call) Run(run func(ctx context.Context, in *apiclient.WarmUpRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_WarmUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.WarmUpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.WarmUpRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_WarmUp_Call) Return(warmUpResponse *apiclient.WarmUpResponse, err error) *RepoServerServiceClient_WarmUp_Call {
+	_c.Call.Return(warmUpResponse, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_WarmUp_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.WarmUpRequest, opts ...grpc.CallOption) (*apiclient.WarmUpResponse, error)) *RepoServerServiceClient_WarmUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -63,10 +63,14 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// Request a trace of how the manifests are generated
+	Explain bool `protobuf:"varint,28,opt,name=explain,proto3" json:"explain,omitempty"`
+	// Keys the signatures of OCI sources and Helm charts in OCI registries are verified with, if any
+	OciSignatureKeys     []*v1alpha1.OCISignatureKey `protobuf:"bytes,29,rep,name=ociSignatureKeys,proto3" json:"ociSignatureKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -270,6 +274,20 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *ManifestRequest) GetOciSignatureKeys() []*v1alpha1.OCISignatureKey {
+	if m != nil {
+		return m.OciSignatureKeys
+	}
+	return nil
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -711,10 +729,12 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Traces describe how the manifests of each source were generated, if requested with explain
+	Traces               []*ManifestGenerationTrace `protobuf:"bytes,9,rep,name=traces,proto3" json:"traces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -799,6 +819,213 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetTraces() []*ManifestGenerationTrace {
+	if m != nil {
+		return m.Traces
+	}
+	return nil
+}
+
+// ManifestGenerationTrace describes how the manifests of a source were generated
+type ManifestGenerationTrace struct {
+	RepoURL string `protobuf:"bytes,1,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	Path    string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Chart   string `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	// resolved revision
+	Revision string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// SourceType is the source type which was detected or configured for the source
+	SourceType string `protobuf:"bytes,5,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
+	// Commands is the list of commands used to generate the manifests, with temporary paths redacted
+	Commands []string `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+	// ValueFiles is the list of resolved Helm value files, with temporary paths redacted
+	ValueFiles []string `protobuf:"bytes,7,rep,name=valueFiles,proto3" json:"valueFiles,omitempty"`
+	// Env is the list of build environment variables available to the config management tool
+	Env []string `protobuf:"bytes,8,rep,name=env,proto3" json:"env,omitempty"`
+	// RefSources is the list of referenced sources and the revisions they were resolved to
+	RefSources []*ResolvedRefSource `protobuf:"bytes,9,rep,name=refSources,proto3" json:"refSources,omitempty"`
+	// CacheHit is true if the manifests were returned from the cache instead of being generated
+	CacheHit             bool     `protobuf:"varint,10,opt,name=cacheHit,proto3" json:"cacheHit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestGenerationTrace) Reset()         { *m = ManifestGenerationTrace{} }
+func (m *ManifestGenerationTrace) String() string { return proto.CompactTextString(m) }
+func (*ManifestGenerationTrace) ProtoMessage()    {}
+func (*ManifestGenerationTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{9}
+}
+func (m *ManifestGenerationTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestGenerationTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestGenerationTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestGenerationTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestGenerationTrace.Merge(m, src)
+}
+func (m *ManifestGenerationTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestGenerationTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestGenerationTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestGenerationTrace proto.InternalMessageInfo
+
+func (m *ManifestGenerationTrace) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *ManifestGenerationTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ManifestGenerationTrace) GetChart() string {
+	if m != nil {
+		return m.Chart
+	}
+	return ""
+}
+
+func (m *ManifestGenerationTrace) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *ManifestGenerationTrace) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ManifestGenerationTrace) GetCommands() []string {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
+func (m *ManifestGenerationTrace) GetValueFiles() []string {
+	if m != nil {
+		return m.ValueFiles
+	}
+	return nil
+}
+
+func (m *ManifestGenerationTrace) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ManifestGenerationTrace) GetRefSources() []*ResolvedRefSource {
+	if m != nil {
+		return m.RefSources
+	}
+	return nil
+}
+
+func (m *ManifestGenerationTrace) GetCacheHit() bool {
+	if m != nil {
+		return m.CacheHit
+	}
+	return false
+}
+
+// ResolvedRefSource is a source referenced by another source, e.g. for its Helm value files
+type ResolvedRefSource struct {
+	Ref            string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	RepoURL        string `protobuf:"bytes,2,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	TargetRevision string `protobuf:"bytes,3,opt,name=targetRevision,proto3" json:"targetRevision,omitempty"`
+	// resolved revision
+	Revision             string   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolvedRefSource) Reset()         { *m = ResolvedRefSource{} }
+func (m *ResolvedRefSource) String() string { return proto.CompactTextString(m) }
+func (*ResolvedRefSource) ProtoMessage()    {}
+func (*ResolvedRefSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{10}
+}
+func (m *ResolvedRefSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolvedRefSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolvedRefSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolvedRefSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedRefSource.Merge(m, src)
+}
+func (m *ResolvedRefSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolvedRefSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedRefSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolvedRefSource proto.InternalMessageInfo
+
+func (m *ResolvedRefSource) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *ResolvedRefSource) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *ResolvedRefSource) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
+func (m *ResolvedRefSource) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -810,7 +1037,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1086,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1143,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1205,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1252,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1300,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,27 +1338,28 @@ func (m *PluginList) GetItems() []*PluginInfo {
 
 // RepoServerAppDetailsQuery contains query information for app details request
 type RepoServerAppDetailsQuery struct {
-	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Source               *v1alpha1.ApplicationSource    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Repos                []*v1alpha1.Repository         `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
-	KustomizeOptions     *v1alpha1.KustomizeOptions     `protobuf:"bytes,4,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
-	AppName              string                         `protobuf:"bytes,5,opt,name=appName,proto3" json:"appName,omitempty"`
-	NoCache              bool                           `protobuf:"varint,6,opt,name=noCache,proto3" json:"noCache,omitempty"`
-	NoRevisionCache      bool                           `protobuf:"varint,7,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	TrackingMethod       string                         `protobuf:"bytes,8,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	EnabledSourceTypes   map[string]bool                `protobuf:"bytes,9,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	HelmOptions          *v1alpha1.HelmOptions          `protobuf:"bytes,10,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
-	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,11,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Repo                            *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Source                          *v1alpha1.ApplicationSource    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Repos                           []*v1alpha1.Repository         `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	KustomizeOptions                *v1alpha1.KustomizeOptions     `protobuf:"bytes,4,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
+	AppName                         string                         `protobuf:"bytes,5,opt,name=appName,proto3" json:"appName,omitempty"`
+	NoCache                         bool                           `protobuf:"varint,6,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	NoRevisionCache                 bool                           `protobuf:"varint,7,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	TrackingMethod                  string                         `protobuf:"bytes,8,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	EnabledSourceTypes              map[string]bool                `protobuf:"bytes,9,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions                     *v1alpha1.HelmOptions          `protobuf:"bytes,10,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	RefSources                      map[string]*v1alpha1.RefTarget `protobuf:"bytes,11,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AnnotationManifestGeneratePaths string                         `protobuf:"bytes,12,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{}                       `json:"-"`
+	XXX_unrecognized                []byte                         `json:"-"`
+	XXX_sizecache                   int32                          `json:"-"`
 }
 
 func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQuery{} }
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,6 +1465,13 @@ func (m *RepoServerAppDetailsQuery) GetRefSources() map[string]*v1alpha1.RefTarg
 	return nil
 }
 
+func (m *RepoServerAppDetailsQuery) GetAnnotationManifestGeneratePaths() string {
+	if m != nil {
+		return m.AnnotationManifestGeneratePaths
+	}
+	return ""
+}
+
 // RepoAppDetailsResponse application details
 type RepoAppDetailsResponse struct {
 	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -1244,6 +1479,7 @@ type RepoAppDetailsResponse struct {
 	Kustomize            *KustomizeAppSpec `protobuf:"bytes,4,opt,name=kustomize,proto3" json:"kustomize,omitempty"`
 	Directory            *DirectoryAppSpec `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
 	Plugin               *PluginAppSpec    `protobuf:"bytes,6,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Cue                  *CUEAppSpec       `protobuf:"bytes,7,opt,name=cue,proto3" json:"cue,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1253,7 +1489,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,6 +1553,13 @@ func (m *RepoAppDetailsResponse) GetPlugin() *PluginAppSpec {
 	return nil
 }
 
+func (m *RepoAppDetailsResponse) GetCue() *CUEAppSpec {
+	if m != nil {
+		return m.Cue
+	}
+	return nil
+}
+
 type RepoServerRevisionMetadataRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -1333,7 +1576,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,17 +1632,19 @@ type RepoServerRevisionChartDetailsRequest struct {
 	// the chart
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// the revision within the chart
-	Revision             string   `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Keys the signatures of the OCI artifact are verified with, if any
+	OciSignatureKeys     []*v1alpha1.OCISignatureKey `protobuf:"bytes,4,rep,name=ociSignatureKeys,proto3" json:"ociSignatureKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServerRevisionChartDetailsRequest{} }
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,6 +1694,13 @@ func (m *RepoServerRevisionChartDetailsRequest) GetRevision() string {
 	return ""
 }
 
+func (m *RepoServerRevisionChartDetailsRequest) GetOciSignatureKeys() []*v1alpha1.OCISignatureKey {
+	if m != nil {
+		return m.OciSignatureKeys
+	}
+	return nil
+}
+
 // HelmAppSpec contains helm app name  in source repo
 type HelmAppSpec struct {
 	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1468,7 +1720,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1797,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1844,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1904,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +2008,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,6 +2044,55 @@ func (m *PluginAppSpec) GetParametersAnnouncement() []*ParameterAnnouncement {
 	return nil
 }
 
+// CUEAppSpec contains the tags declared by a CUE package
+type CUEAppSpec struct {
+	// tags are the tags declared with @tag attributes, with their default values
+	Tags                 []*v1alpha1.CUETag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CUEAppSpec) Reset()         { *m = CUEAppSpec{} }
+func (m *CUEAppSpec) String() string { return proto.CompactTextString(m) }
+func (*CUEAppSpec) ProtoMessage()    {}
+func (*CUEAppSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{26}
+}
+func (m *CUEAppSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CUEAppSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CUEAppSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CUEAppSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CUEAppSpec.Merge(m, src)
+}
+func (m *CUEAppSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *CUEAppSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_CUEAppSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CUEAppSpec proto.InternalMessageInfo
+
+func (m *CUEAppSpec) GetTags() []*v1alpha1.CUETag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type HelmChartsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -1803,7 +2104,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2152,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2206,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2259,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2349,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2099,7 +2400,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2476,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +2537,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2686,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{35}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2428,18 +2729,116 @@ func (m *UpdateRevisionForPathsResponse) GetRevision() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
-	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
-	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
-	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
-	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
-	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
-	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
-	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
+// WarmUpRequest is a request to pre-fetch a revision of a Git repository into the repo server
+type WarmUpRequest struct {
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// revision, potentially un-resolved
+	Revision             string   `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WarmUpRequest) Reset()         { *m = WarmUpRequest{} }
+func (m *WarmUpRequest) String() string { return proto.CompactTextString(m) }
+func (*WarmUpRequest) ProtoMessage()    {}
+func (*WarmUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{36}
+}
+func (m *WarmUpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WarmUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WarmUpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WarmUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarmUpRequest.Merge(m, src)
+}
+func (m *WarmUpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WarmUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarmUpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarmUpRequest proto.InternalMessageInfo
+
+func (m *WarmUpRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *WarmUpRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+type WarmUpResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WarmUpResponse) Reset()         { *m = WarmUpResponse{} }
+func (m *WarmUpResponse) String() string { return proto.CompactTextString(m) }
+func (*WarmUpResponse) ProtoMessage()    {}
+func (*WarmUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{37}
+}
+func (m *WarmUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WarmUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WarmUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WarmUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarmUpResponse.Merge(m, src)
+}
+func (m *WarmUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WarmUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarmUpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarmUpResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
+	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
+	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
+	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
+	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
+	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
+	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ManifestGenerationTrace)(nil), "repository.ManifestGenerationTrace")
+	proto.RegisterType((*ResolvedRefSource)(nil), "repository.ResolvedRefSource")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2460,6 +2859,7 @@ func init() {
 	proto.RegisterType((*ParameterAnnouncement)(nil), "repository.ParameterAnnouncement")
 	proto.RegisterMapType((map[string]string)(nil), "repository.ParameterAnnouncement.MapEntry")
 	proto.RegisterType((*PluginAppSpec)(nil), "repository.PluginAppSpec")
+	proto.RegisterType((*CUEAppSpec)(nil), "repository.CUEAppSpec")
 	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
 	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
@@ -2471,6 +2871,8 @@ func init() {
 	proto.RegisterType((*UpdateRevisionForPathsRequest)(nil), "repository.UpdateRevisionForPathsRequest")
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.UpdateRevisionForPathsRequest.RefSourcesEntry")
 	proto.RegisterType((*UpdateRevisionForPathsResponse)(nil), "repository.UpdateRevisionForPathsResponse")
+	proto.RegisterType((*WarmUpRequest)(nil), "repository.WarmUpRequest")
+	proto.RegisterType((*WarmUpResponse)(nil), "repository.WarmUpResponse")
 }
 
 func init() {
@@ -2478,158 +2880,175 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	// 2679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x6f, 0x1c, 0x59,
+	0xd1, 0xf3, 0xe9, 0x99, 0xf2, 0xd7, 0xf8, 0x25, 0xb1, 0x3b, 0x1d, 0xc7, 0x78, 0x7b, 0x49, 0xe4,
+	0x4d, 0x76, 0xc7, 0x4a, 0xa2, 0xdd, 0x40, 0xb2, 0xbb, 0xc8, 0xeb, 0x38, 0xb6, 0x37, 0x71, 0x62,
+	0x3a, 0xf6, 0x2e, 0x81, 0x00, 0x7a, 0xee, 0x79, 0x33, 0xd3, 0xeb, 0xfe, 0x78, 0xe9, 0x0f, 0xef,
+	0x3a, 0x12, 0x17, 0x90, 0x90, 0x10, 0x17, 0xb8, 0x70, 0xe0, 0xc2, 0x81, 0x3b, 0xe2, 0xc6, 0x11,
+	0x09, 0x09, 0x89, 0x23, 0xe2, 0x82, 0x38, 0x81, 0xf2, 0x0f, 0xf8, 0x07, 0xe8, 0x7d, 0x74, 0x4f,
+	0x77, 0x4f, 0xcf, 0xd8, 0xc9, 0x24, 0x0e, 0x70, 0xb1, 0xe7, 0xd5, 0xab, 0x57, 0x55, 0xaf, 0x5e,
+	0x55, 0xbd, 0xaa, 0x7a, 0x0d, 0x97, 0x3d, 0x42, 0x5d, 0x9f, 0x78, 0x87, 0xc4, 0x5b, 0xe1, 0x3f,
+	0xcd, 0xc0, 0xf5, 0x8e, 0x12, 0x3f, 0x9b, 0xd4, 0x73, 0x03, 0x17, 0x41, 0x0f, 0xa2, 0xde, 0xef,
+	0x98, 0x41, 0x37, 0xdc, 0x6f, 0x1a, 0xae, 0xbd, 0x82, 0xbd, 0x8e, 0x4b, 0x3d, 0xf7, 0x0b, 0xfe,
+	0xe3, 0x3d, 0xa3, 0xb5, 0x72, 0x78, 0x63, 0x85, 0x1e, 0x74, 0x56, 0x30, 0x35, 0xfd, 0x15, 0x4c,
+	0xa9, 0x65, 0x1a, 0x38, 0x30, 0x5d, 0x67, 0xe5, 0xf0, 0x1a, 0xb6, 0x68, 0x17, 0x5f, 0x5b, 0xe9,
+	0x10, 0x87, 0x78, 0x38, 0x20, 0x2d, 0x41, 0x59, 0xbd, 0xd0, 0x71, 0xdd, 0x8e, 0x45, 0x56, 0xf8,
+	0x68, 0x3f, 0x6c, 0xaf, 0x10, 0x9b, 0x06, 0x92, 0xad, 0xf6, 0xef, 0x69, 0x98, 0xd9, 0xc6, 0x8e,
+	0xd9, 0x26, 0x7e, 0xa0, 0x93, 0xa7, 0x21, 0xf1, 0x03, 0xf4, 0x04, 0xca, 0x4c, 0x18, 0xa5, 0xb0,
+	0x54, 0x58, 0x9e, 0xb8, 0xbe, 0xd9, 0xec, 0x49, 0xd3, 0x8c, 0xa4, 0xe1, 0x3f, 0x7e, 0x68, 0xb4,
+	0x9a, 0x87, 0x37, 0x9a, 0xf4, 0xa0, 0xd3, 0x64, 0xd2, 0x34, 0x13, 0xd2, 0x34, 0x23, 0x69, 0x9a,
+	0x7a, 0xbc, 0x2d, 0x9d, 0x53, 0x45, 0x2a, 0xd4, 0x3c, 0x72, 0x68, 0xfa, 0xa6, 0xeb, 0x28, 0xc5,
+	0xa5, 0xc2, 0x72, 0x5d, 0x8f, 0xc7, 0x48, 0x81, 0x71, 0xc7, 0x5d, 0xc3, 0x46, 0x97, 0x28, 0xa5,
+	0xa5, 0xc2, 0x72, 0x4d, 0x8f, 0x86, 0x68, 0x09, 0x26, 0x30, 0xa5, 0xf7, 0xf1, 0x3e, 0xb1, 0xee,
+	0x91, 0x23, 0xa5, 0xcc, 0x17, 0x26, 0x41, 0x6c, 0x2d, 0xa6, 0xf4, 0x01, 0xb6, 0x89, 0x52, 0xe1,
+	0xb3, 0xd1, 0x10, 0x2d, 0x40, 0xdd, 0xc1, 0x36, 0xf1, 0x29, 0x36, 0x88, 0x52, 0xe3, 0x73, 0x3d,
+	0x00, 0xfa, 0x11, 0xcc, 0x26, 0x04, 0x7f, 0xe4, 0x86, 0x9e, 0x41, 0x14, 0xe0, 0x5b, 0x7f, 0x38,
+	0xda, 0xd6, 0x57, 0xb3, 0x64, 0xf5, 0x7e, 0x4e, 0xe8, 0x07, 0x50, 0xe1, 0x27, 0xaf, 0x4c, 0x2c,
+	0x95, 0x5e, 0xa9, 0xb6, 0x05, 0x59, 0xe4, 0xc0, 0x38, 0xb5, 0xc2, 0x8e, 0xe9, 0xf8, 0xca, 0x24,
+	0xe7, 0xb0, 0x3b, 0x1a, 0x87, 0x35, 0xd7, 0x69, 0x9b, 0x9d, 0x6d, 0xec, 0xe0, 0x0e, 0xb1, 0x89,
+	0x13, 0xec, 0x70, 0xe2, 0x7a, 0xc4, 0x04, 0x3d, 0x83, 0xc6, 0x41, 0xe8, 0x07, 0xae, 0x6d, 0x3e,
+	0x23, 0x0f, 0x29, 0x5b, 0xeb, 0x2b, 0x53, 0x5c, 0x9b, 0x0f, 0x46, 0x63, 0x7c, 0x2f, 0x43, 0x55,
+	0xef, 0xe3, 0xc3, 0x8c, 0xe4, 0x20, 0xdc, 0x27, 0x9f, 0x11, 0x8f, 0x5b, 0xd7, 0xb4, 0x30, 0x92,
+	0x04, 0x48, 0x98, 0x91, 0x29, 0x47, 0xbe, 0x32, 0xb3, 0x54, 0x12, 0x66, 0x14, 0x83, 0xd0, 0x32,
+	0xcc, 0x1c, 0x12, 0xcf, 0x6c, 0x1f, 0x3d, 0x32, 0x3b, 0x0e, 0x0e, 0x42, 0x8f, 0x28, 0x0d, 0x6e,
+	0x8a, 0x59, 0x30, 0xb2, 0x61, 0xaa, 0x4b, 0x2c, 0x9b, 0xa9, 0x7c, 0xcd, 0x23, 0x2d, 0x5f, 0x99,
+	0xe5, 0xfa, 0xdd, 0x18, 0xfd, 0x04, 0x39, 0x39, 0x3d, 0x4d, 0x9d, 0x09, 0xe6, 0xb8, 0xba, 0xf4,
+	0x14, 0xe1, 0x23, 0x48, 0x08, 0x96, 0x01, 0xa3, 0xcb, 0x30, 0x1d, 0x78, 0xd8, 0x38, 0x30, 0x9d,
+	0xce, 0x36, 0x09, 0xba, 0x6e, 0x4b, 0x39, 0xc3, 0x35, 0x91, 0x81, 0x22, 0x03, 0x10, 0x71, 0xf0,
+	0xbe, 0x45, 0x5a, 0xc2, 0x16, 0x77, 0x8f, 0x28, 0xf1, 0x95, 0xb3, 0x7c, 0x17, 0x37, 0x9a, 0x89,
+	0x08, 0x95, 0x09, 0x10, 0xcd, 0xf5, 0xbe, 0x55, 0xeb, 0x4e, 0xe0, 0x1d, 0xe9, 0x39, 0xe4, 0xd0,
+	0x01, 0x4c, 0xb0, 0x7d, 0x44, 0xa6, 0x70, 0x8e, 0x9b, 0xc2, 0xd6, 0x68, 0x3a, 0xda, 0xec, 0x11,
+	0xd4, 0x93, 0xd4, 0x51, 0x13, 0x50, 0x17, 0xfb, 0xdb, 0xa1, 0x15, 0x98, 0xd4, 0x22, 0x42, 0x0c,
+	0x5f, 0x99, 0xe3, 0x6a, 0xca, 0x99, 0x41, 0xf7, 0x00, 0x3c, 0xd2, 0x8e, 0xf0, 0xe6, 0xf9, 0xce,
+	0xaf, 0x0e, 0xdb, 0xb9, 0x1e, 0x63, 0x8b, 0x1d, 0x27, 0x96, 0x33, 0xe6, 0x6c, 0x1b, 0xc4, 0x08,
+	0x04, 0x84, 0xfb, 0xa2, 0xa2, 0x70, 0x13, 0xcb, 0x99, 0x61, 0xb6, 0x28, 0xa1, 0x3c, 0x68, 0x9d,
+	0x17, 0xd6, 0x9a, 0x00, 0xa1, 0x4d, 0xf8, 0x1a, 0x76, 0x1c, 0x37, 0xe0, 0xdb, 0x8f, 0x44, 0xd9,
+	0x90, 0xe1, 0x7d, 0x07, 0x07, 0x5d, 0x5f, 0x51, 0xf9, 0xaa, 0xe3, 0xd0, 0x98, 0x49, 0x98, 0x8e,
+	0x1f, 0x60, 0xcb, 0xe2, 0x48, 0x5b, 0x77, 0x94, 0x0b, 0xc2, 0x24, 0xd2, 0x50, 0x16, 0x44, 0xc9,
+	0x57, 0xd4, 0xc2, 0xa6, 0xa3, 0x2c, 0x88, 0x00, 0x2c, 0x87, 0xe8, 0x08, 0x1a, 0xae, 0x61, 0xc6,
+	0xd6, 0x7f, 0x8f, 0x1c, 0xf9, 0xca, 0x45, 0xae, 0xb0, 0xed, 0xd1, 0x0e, 0xf3, 0xe1, 0xda, 0x56,
+	0x92, 0xaa, 0xde, 0xc7, 0x46, 0x5d, 0x87, 0xf9, 0x01, 0x16, 0x87, 0x1a, 0x50, 0x3a, 0x20, 0x47,
+	0xfc, 0xa6, 0xaa, 0xeb, 0xec, 0x27, 0x3a, 0x0b, 0x95, 0x43, 0x6c, 0x85, 0x84, 0xdf, 0x2d, 0x35,
+	0x5d, 0x0c, 0x6e, 0x15, 0xbf, 0x51, 0x50, 0x7f, 0x5a, 0x80, 0x99, 0xcc, 0xf9, 0xe5, 0xac, 0xff,
+	0x7e, 0x72, 0xfd, 0x2b, 0xf0, 0xe6, 0xf6, 0x2e, 0xf6, 0x3a, 0x24, 0x48, 0x08, 0xa2, 0xfd, 0xad,
+	0x00, 0x4a, 0xc6, 0xb0, 0x3e, 0x37, 0x83, 0xee, 0x5d, 0xd3, 0x22, 0x3e, 0xba, 0x09, 0xe3, 0x9e,
+	0x80, 0xc9, 0xfb, 0xf7, 0xc2, 0x10, 0x7b, 0xdc, 0x1c, 0xd3, 0x23, 0x6c, 0xf4, 0x31, 0xd4, 0x6c,
+	0x12, 0xe0, 0x16, 0x0e, 0xb0, 0x94, 0x7d, 0x29, 0x6f, 0x25, 0xe3, 0xb2, 0x2d, 0xf1, 0x36, 0xc7,
+	0xf4, 0x78, 0x0d, 0x7a, 0x1f, 0x2a, 0x46, 0x37, 0x74, 0x0e, 0xf8, 0xcd, 0x3b, 0x71, 0xfd, 0xe2,
+	0xa0, 0xc5, 0x6b, 0x0c, 0x69, 0x73, 0x4c, 0x17, 0xd8, 0x9f, 0x54, 0xa1, 0x4c, 0xb1, 0x17, 0x68,
+	0x77, 0xe1, 0x6c, 0x1e, 0x0b, 0x76, 0xdd, 0x1b, 0x5d, 0x62, 0x1c, 0xf8, 0xa1, 0x2d, 0xd5, 0x1c,
+	0x8f, 0x11, 0x82, 0xb2, 0x6f, 0x3e, 0x13, 0xaa, 0x2e, 0xe9, 0xfc, 0xb7, 0xf6, 0x0e, 0xcc, 0xf6,
+	0x71, 0x63, 0x87, 0x2a, 0x64, 0x63, 0x14, 0x26, 0x25, 0x6b, 0x2d, 0x84, 0x73, 0xbb, 0x5c, 0x17,
+	0xf1, 0x9d, 0x77, 0x1a, 0x09, 0x8c, 0xb6, 0x09, 0x73, 0x59, 0xb6, 0x3e, 0x75, 0x1d, 0x9f, 0xb0,
+	0x08, 0xc0, 0x2f, 0x09, 0x93, 0xb4, 0x7a, 0xb3, 0x5c, 0x8a, 0x9a, 0x9e, 0x33, 0xa3, 0xfd, 0xb6,
+	0x08, 0x73, 0x3a, 0xf1, 0x5d, 0xeb, 0x90, 0x44, 0x11, 0xfc, 0x74, 0x72, 0xb0, 0xef, 0x41, 0x09,
+	0x53, 0xaa, 0x14, 0x5f, 0x45, 0x30, 0x4e, 0x64, 0x39, 0x3a, 0xa3, 0x8a, 0xde, 0x85, 0x59, 0x6c,
+	0xef, 0x9b, 0x9d, 0xd0, 0x0d, 0xfd, 0x68, 0x5b, 0xdc, 0xa8, 0xea, 0x7a, 0xff, 0x04, 0x8b, 0x82,
+	0x3e, 0xf7, 0xc8, 0x2d, 0xa7, 0x45, 0xbe, 0xe2, 0x89, 0x5d, 0x49, 0x4f, 0x82, 0x34, 0x03, 0xe6,
+	0xfb, 0x94, 0x24, 0x15, 0x9e, 0xcc, 0x25, 0x0b, 0x99, 0x5c, 0x32, 0x57, 0x8c, 0xe2, 0x00, 0x31,
+	0xb4, 0xdf, 0x14, 0xa1, 0xd1, 0x73, 0x2e, 0x49, 0x7e, 0x01, 0xea, 0xb6, 0x84, 0xf9, 0x4a, 0x81,
+	0x07, 0xf2, 0x1e, 0x20, 0x9d, 0x56, 0x16, 0xb3, 0x69, 0xe5, 0x1c, 0x54, 0x45, 0xd6, 0x2f, 0xb7,
+	0x2e, 0x47, 0x29, 0x91, 0xcb, 0x19, 0x91, 0x17, 0x01, 0xfc, 0x38, 0xc2, 0x29, 0x55, 0x3e, 0x9b,
+	0x80, 0x20, 0x0d, 0x26, 0x45, 0x12, 0xa2, 0x13, 0x3f, 0xb4, 0x02, 0x65, 0x9c, 0x63, 0xa4, 0x60,
+	0xdc, 0xdf, 0x5c, 0xdb, 0xc6, 0x4e, 0xcb, 0x57, 0x6a, 0x5c, 0xe4, 0x78, 0x8c, 0x6e, 0x43, 0x95,
+	0xa5, 0x00, 0xc4, 0x57, 0xea, 0x3c, 0x72, 0xbf, 0x9d, 0xe7, 0xe3, 0xf2, 0xe2, 0x30, 0x5d, 0x67,
+	0x97, 0xe1, 0xea, 0x72, 0x89, 0xf6, 0xa7, 0x22, 0xcc, 0x0f, 0xc0, 0x61, 0xd7, 0x06, 0xa3, 0xb4,
+	0xa7, 0xdf, 0x97, 0xc7, 0x10, 0x0d, 0x99, 0x8b, 0x53, 0x1c, 0x74, 0xa5, 0x7e, 0xf8, 0x6f, 0xe1,
+	0xcd, 0xd8, 0x0b, 0xa4, 0x66, 0xc4, 0xe0, 0x05, 0x14, 0x53, 0xe9, 0x53, 0x4c, 0x72, 0xd3, 0xd5,
+	0xcc, 0xa6, 0x17, 0x01, 0x78, 0xe8, 0xe5, 0xe1, 0x55, 0x19, 0xe7, 0xb3, 0x09, 0x08, 0xbb, 0x02,
+	0x88, 0x73, 0x28, 0x75, 0xc5, 0x7e, 0xa2, 0x8f, 0x52, 0x59, 0x81, 0x50, 0x55, 0x2a, 0x1c, 0x4a,
+	0x73, 0x6c, 0xc5, 0xb7, 0x49, 0x2a, 0x0f, 0x60, 0xc2, 0xb0, 0x3c, 0x6c, 0xd3, 0x0c, 0x78, 0x1d,
+	0x51, 0xd3, 0xe3, 0xb1, 0xf6, 0x93, 0x02, 0xcc, 0xf6, 0xad, 0x66, 0x22, 0x78, 0xa4, 0x1d, 0xdd,
+	0x42, 0x1e, 0x69, 0x27, 0x15, 0x5a, 0x4c, 0x2b, 0x94, 0x25, 0x77, 0xe2, 0x46, 0x49, 0xbb, 0x56,
+	0x06, 0x3a, 0x4c, 0x9d, 0x9a, 0x0b, 0x33, 0xf7, 0x4d, 0x66, 0xe7, 0x6d, 0xff, 0x74, 0x42, 0xe6,
+	0x07, 0x50, 0x66, 0xcc, 0x98, 0x50, 0xfb, 0x1e, 0x76, 0x8c, 0x2e, 0x89, 0xfc, 0x29, 0x1e, 0x33,
+	0x4b, 0x09, 0x70, 0xc7, 0x57, 0x8a, 0x1c, 0xce, 0x7f, 0x6b, 0x7f, 0x28, 0x0a, 0x49, 0x57, 0x29,
+	0xf5, 0xdf, 0x7c, 0x75, 0x9a, 0x9f, 0x2f, 0x97, 0xfa, 0xf3, 0xe5, 0x8c, 0xc8, 0x2f, 0x92, 0x2f,
+	0xbf, 0xa2, 0x64, 0x47, 0x0b, 0x61, 0x7c, 0x95, 0x52, 0x26, 0x08, 0xba, 0x06, 0x65, 0x4c, 0xa9,
+	0x50, 0x78, 0xc6, 0x90, 0x25, 0x0a, 0xfb, 0x2f, 0x45, 0xe2, 0xa8, 0xea, 0x4d, 0xa8, 0xc7, 0xa0,
+	0xe3, 0xd8, 0xd6, 0x93, 0x6c, 0x97, 0x00, 0x44, 0x41, 0xb8, 0xe5, 0xb4, 0x5d, 0x76, 0xa4, 0x2c,
+	0x20, 0xca, 0xa5, 0xfc, 0xb7, 0x76, 0x2b, 0xc2, 0xe0, 0xb2, 0xbd, 0x0b, 0x15, 0x33, 0x20, 0x76,
+	0x24, 0xdc, 0x5c, 0x52, 0xb8, 0x1e, 0x21, 0x5d, 0x20, 0x69, 0xbf, 0xac, 0xc3, 0x79, 0x76, 0x62,
+	0x8f, 0x78, 0x28, 0x5d, 0xa5, 0xf4, 0x0e, 0x09, 0xb0, 0x69, 0xf9, 0xdf, 0x0e, 0x89, 0x77, 0xf4,
+	0x9a, 0x0d, 0xa3, 0x03, 0x55, 0x11, 0x70, 0x94, 0xe2, 0xeb, 0xe9, 0x0d, 0x54, 0xfd, 0x4c, 0x43,
+	0xa0, 0xf4, 0x7a, 0x1a, 0x02, 0x79, 0x05, 0x7a, 0xf9, 0x94, 0x0a, 0xf4, 0xc1, 0x3d, 0x9a, 0x44,
+	0xe7, 0xa7, 0x9a, 0xee, 0xfc, 0xe4, 0xd4, 0xbd, 0xe3, 0x27, 0xad, 0x7b, 0x6b, 0xb9, 0x75, 0xaf,
+	0x9d, 0xeb, 0xc7, 0x22, 0xce, 0x7f, 0x94, 0x8e, 0xf3, 0x03, 0x6c, 0x6d, 0x94, 0x0a, 0x18, 0x5e,
+	0x6b, 0x05, 0xbc, 0x97, 0xba, 0xbb, 0x44, 0x4f, 0xe9, 0xfd, 0x93, 0xed, 0x69, 0x58, 0x6d, 0x7b,
+	0x82, 0x4a, 0x74, 0xf2, 0x44, 0x95, 0xe8, 0xff, 0x5d, 0x31, 0xf7, 0x3b, 0x9e, 0xc3, 0x53, 0xb7,
+	0xa7, 0xcd, 0x38, 0x7d, 0x64, 0x37, 0x1a, 0xcb, 0x57, 0x64, 0xf8, 0x63, 0xbf, 0xd1, 0x55, 0x28,
+	0xb3, 0xe3, 0x92, 0x45, 0xd6, 0x7c, 0xf2, 0x64, 0xd8, 0x99, 0xae, 0x52, 0xfa, 0x88, 0x12, 0x43,
+	0xe7, 0x48, 0xe8, 0x16, 0xd4, 0x63, 0x17, 0x92, 0x3e, 0xba, 0x90, 0x5c, 0x11, 0x7b, 0x5c, 0xb4,
+	0xac, 0x87, 0xce, 0xd6, 0xb6, 0x4c, 0x8f, 0x18, 0x0c, 0x51, 0xa9, 0xf4, 0xaf, 0xbd, 0x13, 0x4d,
+	0xc6, 0x6b, 0x63, 0x74, 0x74, 0x0d, 0xaa, 0xa2, 0x9d, 0xc7, 0x7d, 0x71, 0xe2, 0xfa, 0xf9, 0xfe,
+	0xb0, 0x1c, 0xad, 0x92, 0x88, 0x68, 0x19, 0x4a, 0x46, 0x28, 0x3c, 0x33, 0x13, 0xc6, 0xd7, 0xf6,
+	0xd6, 0x23, 0x64, 0x86, 0xa2, 0xfd, 0xb9, 0x00, 0x6f, 0xf5, 0x8c, 0x30, 0xf2, 0xe0, 0xa8, 0x5e,
+	0x7c, 0xf3, 0xb7, 0xfc, 0x65, 0x98, 0xe6, 0x05, 0x6a, 0xaf, 0xff, 0x27, 0x5a, 0xd1, 0x19, 0xa8,
+	0xf6, 0xfb, 0x22, 0x5c, 0xea, 0xdf, 0xc7, 0x1a, 0xcb, 0x65, 0x63, 0x43, 0x38, 0x8d, 0xbd, 0x44,
+	0x97, 0x6c, 0xb1, 0x77, 0xc9, 0xa6, 0xf6, 0x57, 0xca, 0xec, 0x2f, 0xaf, 0x91, 0x53, 0x3e, 0x95,
+	0x46, 0x8e, 0xf6, 0xc7, 0x22, 0x4c, 0x24, 0xac, 0x3c, 0x2f, 0x3f, 0xc8, 0xa4, 0xeb, 0xa5, 0xbe,
+	0x74, 0xfd, 0x00, 0x80, 0x62, 0x0f, 0xdb, 0x24, 0x20, 0x5e, 0x24, 0xf8, 0xbd, 0xd1, 0x83, 0xe9,
+	0x4e, 0x44, 0x53, 0x4f, 0x90, 0x67, 0x45, 0x1c, 0x67, 0xed, 0xcb, 0xeb, 0x4a, 0x8e, 0xd0, 0x97,
+	0x30, 0xdd, 0x36, 0x2d, 0xb2, 0xd3, 0x13, 0xa4, 0xba, 0x54, 0x1a, 0x3d, 0x29, 0x60, 0x82, 0xdc,
+	0x4d, 0xd2, 0xd5, 0x33, 0x6c, 0xb4, 0x2b, 0xd0, 0xc8, 0x3a, 0x3d, 0x13, 0xd2, 0xb4, 0x71, 0x27,
+	0xd6, 0x96, 0x1c, 0x69, 0x08, 0x1a, 0x59, 0x27, 0xd7, 0xfe, 0x59, 0x84, 0x73, 0x31, 0xb9, 0x55,
+	0xc7, 0x71, 0x43, 0xc7, 0xe0, 0x6d, 0xfc, 0xdc, 0xb3, 0x38, 0x0b, 0x95, 0xc0, 0x0c, 0xac, 0x38,
+	0xcf, 0xe3, 0x03, 0x76, 0x55, 0x07, 0xae, 0xcb, 0x1a, 0xa9, 0xd2, 0xb6, 0xa2, 0xa1, 0x30, 0xbb,
+	0xa7, 0xa1, 0xe9, 0x91, 0x16, 0x0f, 0x57, 0x35, 0x3d, 0x1e, 0xb3, 0x39, 0x96, 0xc4, 0x25, 0x0a,
+	0xb8, 0x78, 0xcc, 0x5d, 0xce, 0xb5, 0x2c, 0x62, 0xf0, 0x8a, 0xb2, 0x57, 0xfb, 0x66, 0xa0, 0x6c,
+	0xa7, 0x7e, 0xe0, 0x99, 0x4e, 0x47, 0x56, 0xbe, 0x72, 0xc4, 0xe4, 0xc4, 0x9e, 0x87, 0x8f, 0x64,
+	0x11, 0x27, 0x06, 0xe8, 0x43, 0x28, 0xd9, 0x98, 0xca, 0x7b, 0xfd, 0x4a, 0x2a, 0x84, 0xe5, 0x69,
+	0xa0, 0xb9, 0x8d, 0xa9, 0xb8, 0xf8, 0xd8, 0x32, 0xf5, 0x03, 0xa8, 0x45, 0x80, 0x17, 0xca, 0x80,
+	0xbf, 0x80, 0xa9, 0x54, 0x84, 0x44, 0x8f, 0x61, 0xae, 0x67, 0x51, 0x49, 0x86, 0x32, 0xe7, 0x7d,
+	0xeb, 0x58, 0xc9, 0xf4, 0x01, 0x04, 0xb4, 0x36, 0x40, 0x2f, 0xba, 0xa2, 0xef, 0xc8, 0x02, 0x4a,
+	0x90, 0xbd, 0x33, 0xe2, 0x33, 0xcf, 0xde, 0xfa, 0x2e, 0xee, 0xc8, 0x32, 0xec, 0x29, 0xcc, 0x32,
+	0xd3, 0xe4, 0xb1, 0xed, 0x94, 0x2a, 0xc6, 0xdb, 0x50, 0x8f, 0x59, 0xe6, 0xda, 0xa6, 0x0a, 0xb5,
+	0xc3, 0xe8, 0x19, 0x47, 0x94, 0x8c, 0xf1, 0x58, 0x5b, 0x05, 0x94, 0x94, 0x57, 0x5e, 0xc7, 0x57,
+	0xd3, 0xb5, 0xc6, 0xb9, 0xec, 0xdd, 0xcb, 0xd1, 0xa3, 0x52, 0xe3, 0xef, 0x45, 0x98, 0xd9, 0x30,
+	0x79, 0x0b, 0xf2, 0x94, 0xe2, 0xf8, 0x15, 0x68, 0xf8, 0xe1, 0xbe, 0xed, 0xb6, 0x42, 0x8b, 0xc8,
+	0x0c, 0x49, 0xa6, 0x3d, 0x7d, 0xf0, 0xa1, 0xf1, 0x3d, 0xea, 0xb8, 0x94, 0x13, 0x1d, 0x97, 0x0f,
+	0xe1, 0xfc, 0x03, 0xf2, 0xa5, 0xdc, 0xcf, 0x86, 0xe5, 0xee, 0xef, 0x9b, 0x4e, 0x27, 0x62, 0x52,
+	0xe1, 0x4c, 0x06, 0x23, 0xe4, 0x65, 0xe0, 0xd5, 0xfc, 0x0c, 0x3c, 0x6e, 0x50, 0xad, 0xb9, 0xb6,
+	0x6d, 0x06, 0x32, 0x51, 0x4f, 0xc1, 0x58, 0x0b, 0xa4, 0xd1, 0xd3, 0xac, 0x3c, 0x9b, 0x9b, 0xc2,
+	0x57, 0xc5, 0xc9, 0x5c, 0x4a, 0x9e, 0x4c, 0x16, 0xf5, 0xe5, 0xdd, 0x74, 0x32, 0xe9, 0xa6, 0x3f,
+	0x2f, 0xc2, 0xb9, 0x0d, 0x33, 0x88, 0x02, 0xa4, 0xf9, 0xbf, 0x76, 0xca, 0x39, 0x67, 0x52, 0x3e,
+	0xd9, 0x99, 0x54, 0x72, 0xce, 0xa4, 0x09, 0x73, 0x59, 0x65, 0xc8, 0x83, 0x39, 0x0b, 0x15, 0xca,
+	0xd3, 0x7b, 0xd1, 0xae, 0x11, 0x03, 0xed, 0xc7, 0xe3, 0x70, 0x71, 0x8f, 0xb6, 0x70, 0x10, 0xb7,
+	0x64, 0xef, 0xba, 0x1e, 0xcf, 0xef, 0x4f, 0x47, 0x8b, 0x99, 0xaf, 0x01, 0x8a, 0x43, 0xbf, 0x06,
+	0x28, 0x0d, 0xf9, 0x1a, 0xa0, 0x7c, 0xa2, 0xaf, 0x01, 0x2a, 0xa7, 0xf6, 0x35, 0x40, 0x7f, 0x09,
+	0x5b, 0xcd, 0x2d, 0x61, 0x1f, 0xa7, 0xca, 0xbc, 0x71, 0xee, 0x36, 0xdf, 0x4c, 0xba, 0xcd, 0xd0,
+	0xd3, 0x19, 0x5a, 0xea, 0x65, 0x1e, 0xd1, 0x6b, 0xc7, 0x3e, 0xa2, 0xd7, 0xfb, 0x1f, 0xd1, 0xf3,
+	0xdf, 0x61, 0x61, 0xe0, 0x3b, 0xec, 0x65, 0x98, 0xf6, 0x8f, 0x1c, 0x83, 0xb4, 0x22, 0x81, 0x95,
+	0x09, 0xb1, 0xed, 0x34, 0x34, 0xe5, 0x11, 0x93, 0x19, 0x8f, 0x88, 0x2d, 0x75, 0x2a, 0x61, 0xa9,
+	0x79, 0x7e, 0x32, 0x3d, 0xb0, 0x7b, 0x90, 0x79, 0x22, 0x9d, 0xc9, 0x7b, 0x22, 0xfd, 0xef, 0xa9,
+	0x3c, 0x3f, 0x83, 0xc5, 0x41, 0xa7, 0x2c, 0x9d, 0x57, 0x81, 0x71, 0xa3, 0x8b, 0x9d, 0x0e, 0xef,
+	0xb6, 0xf2, 0xa6, 0x8a, 0x1c, 0x0e, 0x2b, 0x80, 0xb4, 0x9f, 0x15, 0x60, 0xea, 0x73, 0xec, 0xd9,
+	0x7b, 0xf4, 0x8d, 0x17, 0x63, 0x5a, 0x03, 0xa6, 0x23, 0x51, 0xc4, 0x9e, 0xae, 0xff, 0x63, 0x12,
+	0x66, 0x7b, 0x65, 0x17, 0xfb, 0x6b, 0x1a, 0x04, 0x3d, 0x84, 0x46, 0xd4, 0x66, 0x88, 0xda, 0x0e,
+	0x68, 0xd8, 0xc3, 0xa9, 0xba, 0x90, 0x3f, 0x29, 0x98, 0x68, 0x63, 0xc8, 0x80, 0xf3, 0x59, 0x82,
+	0xbd, 0x37, 0xda, 0xaf, 0x0f, 0xa1, 0x1c, 0x63, 0x1d, 0xc7, 0x62, 0xb9, 0x80, 0x1e, 0xc3, 0x74,
+	0xfa, 0x25, 0x11, 0xa5, 0x92, 0xc1, 0xdc, 0xc7, 0x4d, 0x55, 0x1b, 0x86, 0x12, 0xcb, 0xff, 0x04,
+	0x66, 0xe4, 0x3b, 0x43, 0xec, 0x3c, 0x5a, 0xce, 0x13, 0x46, 0xe6, 0xd9, 0x51, 0x7d, 0x7b, 0x28,
+	0x4e, 0x4c, 0xfd, 0x36, 0xd4, 0xa2, 0x07, 0x84, 0xb4, 0x9a, 0x33, 0xcf, 0x0a, 0x6a, 0x23, 0x4d,
+	0xaf, 0xed, 0x6b, 0x63, 0xe8, 0x63, 0x98, 0x60, 0x68, 0x0f, 0xd7, 0xb6, 0x76, 0x71, 0xe7, 0xa5,
+	0xd6, 0xd7, 0xa2, 0x06, 0x7b, 0xff, 0xe2, 0x44, 0xdb, 0x5d, 0x3d, 0x93, 0xd3, 0xea, 0xd6, 0xc6,
+	0xd0, 0xb7, 0x04, 0xff, 0x1d, 0xf9, 0xc1, 0xd2, 0x5c, 0x53, 0x7c, 0x1f, 0xd7, 0x8c, 0xbe, 0x8f,
+	0x6b, 0xae, 0xb3, 0xef, 0xe3, 0xd4, 0x9c, 0x5e, 0xb4, 0x24, 0xf0, 0x04, 0xa6, 0x36, 0x48, 0xd0,
+	0x6b, 0xf8, 0xa0, 0x4b, 0x27, 0x6a, 0xb0, 0xa9, 0x5a, 0x16, 0xad, 0xbf, 0x67, 0xa4, 0x8d, 0xa1,
+	0x5f, 0x15, 0xe0, 0xcc, 0x06, 0x09, 0xb2, 0x8d, 0x11, 0xf4, 0x5e, 0x3e, 0x93, 0x01, 0x0d, 0x14,
+	0xf5, 0xc1, 0xa8, 0x5e, 0x9a, 0x26, 0xab, 0x8d, 0xa1, 0x5f, 0x14, 0x60, 0x7a, 0x83, 0xb0, 0x73,
+	0x8b, 0x65, 0xba, 0x36, 0x5c, 0xa6, 0x9c, 0x66, 0x88, 0xba, 0x35, 0x72, 0x93, 0x21, 0x21, 0xd2,
+	0xaf, 0x0b, 0x30, 0x9f, 0xd0, 0x55, 0x92, 0xdf, 0xcb, 0xc8, 0xf6, 0xe9, 0x88, 0x35, 0x53, 0x82,
+	0xa4, 0x36, 0x86, 0x76, 0xb8, 0x99, 0xf4, 0x0a, 0x11, 0x74, 0x31, 0xb7, 0xe2, 0x88, 0xb9, 0x2f,
+	0x0e, 0x9a, 0x8e, 0x4d, 0xe3, 0x53, 0x98, 0xd8, 0x20, 0x41, 0x94, 0x11, 0xa7, 0x8d, 0x3f, 0x53,
+	0xac, 0xa8, 0x0b, 0xf9, 0x93, 0x89, 0x00, 0x31, 0x2b, 0x68, 0x25, 0xb2, 0xbe, 0x74, 0xf8, 0xc9,
+	0x4d, 0x8f, 0x55, 0x6d, 0x18, 0x4a, 0x4c, 0xfd, 0x29, 0xcc, 0xe5, 0xdf, 0x4d, 0xe8, 0x9d, 0x13,
+	0x67, 0x29, 0xea, 0x95, 0x93, 0xa0, 0xc6, 0x2c, 0x57, 0xa1, 0x2a, 0xae, 0x0a, 0x94, 0x6a, 0x57,
+	0xa6, 0x6e, 0x32, 0x55, 0xcd, 0x9b, 0x8a, 0x48, 0x7c, 0xb2, 0xfa, 0x97, 0xe7, 0x8b, 0x85, 0xbf,
+	0x3e, 0x5f, 0x2c, 0xfc, 0xeb, 0xf9, 0x62, 0xe1, 0xbb, 0x37, 0x8e, 0xf9, 0x0a, 0x37, 0xf1, 0x61,
+	0x2f, 0xa6, 0xa6, 0x61, 0x99, 0xc4, 0x09, 0xf6, 0xab, 0x3c, 0x8a, 0xdc, 0xf8, 0xcf, 0x00, 0xed,
+	0x23, 0xe1, 0xa6, 0xf7, 0x2b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2676,6 +3095,8 @@ type RepoServerServiceClient interface {
 	GetGitDirectories(ctx context.Context, in *GitDirectoriesRequest, opts ...grpc.CallOption) (*GitDirectoriesResponse, error)
 	// UpdateRevisionForPaths will compare two revisions and update the cache with the new revision if no changes are detected in the provided paths
 	UpdateRevisionForPaths(ctx context.Context, in *UpdateRevisionForPathsRequest, opts ...grpc.CallOption) (*UpdateRevisionForPathsResponse, error)
+	// WarmUp fetches a revision of a Git repository, so that the first operations on the revision do not have to clone the repository
+	WarmUp(ctx context.Context, in *WarmUpRequest, opts ...grpc.CallOption) (*WarmUpResponse, error)
 }
 
 type repoServerServiceClient struct {
@@ -2855,6 +3276,15 @@ func (c *repoServerServiceClient) UpdateRevisionForPaths(ctx context.Context, in
 	return out, nil
 }
 
+func (c *repoServerServiceClient) WarmUp(ctx context.Context, in *WarmUpRequest, opts ...grpc.CallOption) (*WarmUpResponse, error) {
+	out := new(WarmUpResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/WarmUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoServerServiceServer is the server API for RepoServerService service.
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
@@ -2889,6 +3319,8 @@ type RepoServerServiceServer interface {
 	GetGitDirectories(context.Context, *GitDirectoriesRequest) (*GitDirectoriesResponse, error)
 	// UpdateRevisionForPaths will compare two revisions and update the cache with the new revision if no changes are detected in the provided paths
 	UpdateRevisionForPaths(context.Context, *UpdateRevisionForPathsRequest) (*UpdateRevisionForPathsResponse, error)
+	// WarmUp fetches a revision of a Git repository, so that the first operations on the revision do not have to clone the repository
+	WarmUp(context.Context, *WarmUpRequest) (*WarmUpResponse, error)
 }
 
 // UnimplementedRepoServerServiceServer can be embedded to have forward compatible implementations.
@@ -2943,6 +3375,9 @@ func (*UnimplementedRepoServerServiceServer) GetGitDirectories(ctx context.Conte
 func (*UnimplementedRepoServerServiceServer) UpdateRevisionForPaths(ctx context.Context, req *UpdateRevisionForPathsRequest) (*UpdateRevisionForPathsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRevisionForPaths not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) WarmUp(ctx context.Context, req *WarmUpRequest) (*WarmUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmUp not implemented")
+}
 
 func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
 	s.RegisterService(&_RepoServerService_serviceDesc, srv)
@@ -3244,6 +3679,24 @@ func _RepoServerService_UpdateRevisionForPaths_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_WarmUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).WarmUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/WarmUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).WarmUp(ctx, req.(*WarmUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepoServerService",
 	HandlerType: (*RepoServerServiceServer)(nil),
@@ -3308,6 +3761,10 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRevisionForPaths",
 			Handler:    _RepoServerService_UpdateRevisionForPaths_Handler,
 		},
+		{
+			MethodName: "WarmUp",
+			Handler:    _RepoServerService_WarmUp_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3343,6 +3800,34 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OciSignatureKeys) > 0 {
+		for iNdEx := len(m.OciSignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OciSignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.Explain {
+		i--
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -4005,6 +4490,20 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Traces) > 0 {
+		for iNdEx := len(m.Traces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -4061,7 +4560,7 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ManifestGenerationTrace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4071,12 +4570,12 @@ func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListRefsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManifestGenerationTrace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListRefsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ManifestGenerationTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4085,22 +4584,96 @@ func (m *ListRefsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.CacheHit {
+		i--
+		if m.CacheHit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RefSources) > 0 {
+		for iNdEx := len(m.RefSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintRepository(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Env[iNdEx])
+			copy(dAtA[i:], m.Env[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Env[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValueFiles) > 0 {
+		for iNdEx := len(m.ValueFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValueFiles[iNdEx])
+			copy(dAtA[i:], m.ValueFiles[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ValueFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Commands) > 0 {
+		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commands[iNdEx])
+			copy(dAtA[i:], m.Commands[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Commands[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
+	}
+	if len(m.SourceType) > 0 {
+		i -= len(m.SourceType)
+		copy(dAtA[i:], m.SourceType)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chart) > 0 {
+		i -= len(m.Chart)
+		copy(dAtA[i:], m.Chart)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Chart)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RepoURL) > 0 {
+		i -= len(m.RepoURL)
+		copy(dAtA[i:], m.RepoURL)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.RepoURL)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Refs) Marshal() (dAtA []byte, err error) {
+func (m *ResolvedRefSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4110,12 +4683,12 @@ func (m *Refs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Refs) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResolvedRefSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Refs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResolvedRefSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4124,28 +4697,38 @@ func (m *Refs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tags[iNdEx])
-			copy(dAtA[i:], m.Tags[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Tags[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Branches) > 0 {
-		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Branches[iNdEx])
-			copy(dAtA[i:], m.Branches[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Branches[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.TargetRevision) > 0 {
+		i -= len(m.TargetRevision)
+		copy(dAtA[i:], m.TargetRevision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TargetRevision)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RepoURL) > 0 {
+		i -= len(m.RepoURL)
+		copy(dAtA[i:], m.RepoURL)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.RepoURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4155,12 +4738,12 @@ func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListAppsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRefsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListAppsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRefsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4169,18 +4752,102 @@ func (m *ListAppsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.EnabledSourceTypes) > 0 {
-		for k := range m.EnabledSourceTypes {
-			v := m.EnabledSourceTypes[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x10
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Refs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Refs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Refs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Branches[iNdEx])
+			copy(dAtA[i:], m.Branches[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Branches[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAppsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAppsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAppsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EnabledSourceTypes) > 0 {
+		for k := range m.EnabledSourceTypes {
+			v := m.EnabledSourceTypes[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
@@ -4358,6 +5025,13 @@ func (m *RepoServerAppDetailsQuery) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AnnotationManifestGeneratePaths) > 0 {
+		i -= len(m.AnnotationManifestGeneratePaths)
+		copy(dAtA[i:], m.AnnotationManifestGeneratePaths)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AnnotationManifestGeneratePaths)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.RefSources) > 0 {
 		for k := range m.RefSources {
 			v := m.RefSources[k]
@@ -4529,6 +5203,18 @@ func (m *RepoAppDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Cue != nil {
+		{
+			size, err := m.Cue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -4667,6 +5353,20 @@ func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OciSignatureKeys) > 0 {
+		for iNdEx := len(m.OciSignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OciSignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Revision) > 0 {
 		i -= len(m.Revision)
 		copy(dAtA[i:], m.Revision)
@@ -4985,6 +5685,47 @@ func (m *PluginAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CUEAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CUEAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CUEAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmChartsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5576,6 +6317,79 @@ func (m *UpdateRevisionForPathsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *WarmUpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WarmUpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarmUpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WarmUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WarmUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarmUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRepository(v)
 	base := offset
@@ -5708,6 +6522,15 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if m.Explain {
+		n += 3
+	}
+	if len(m.OciSignatureKeys) > 0 {
+		for _, e := range m.OciSignatureKeys {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5916,39 +6739,132 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.Traces) > 0 {
+		for _, e := range m.Traces {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ListRefsRequest) Size() (n int) {
+func (m *ManifestGenerationTrace) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
+	l = len(m.RepoURL)
+	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
 	}
-	return n
-}
-
-func (m *Refs) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Chart)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.Branches) > 0 {
-		for _, s := range m.Branches {
-			l = len(s)
-			n += 1 + l + sovRepository(uint64(l))
-		}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.SourceType)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Commands) > 0 {
+		for _, s := range m.Commands {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.ValueFiles) > 0 {
+		for _, s := range m.ValueFiles {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.RefSources) > 0 {
+		for _, e := range m.RefSources {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.CacheHit {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolvedRefSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.RepoURL)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRefsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Refs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Branches) > 0 {
+		for _, s := range m.Branches {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
 	}
 	if len(m.Tags) > 0 {
 		for _, s := range m.Tags {
@@ -6107,6 +7023,10 @@ func (m *RepoServerAppDetailsQuery) Size() (n int) {
 			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.AnnotationManifestGeneratePaths)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6139,6 +7059,10 @@ func (m *RepoAppDetailsResponse) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.Cue != nil {
+		l = m.Cue.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6186,6 +7110,12 @@ func (m *RepoServerRevisionChartDetailsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if len(m.OciSignatureKeys) > 0 {
+		for _, e := range m.OciSignatureKeys {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6331,6 +7261,24 @@ func (m *PluginAppSpec) Size() (n int) {
 	return n
 }
 
+func (m *CUEAppSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *HelmChartsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6596,6 +7544,38 @@ func (m *UpdateRevisionForPathsResponse) Size() (n int) {
 	return n
 }
 
+func (m *WarmUpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WarmUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7553,6 +8533,60 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OciSignatureKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OciSignatureKeys = append(m.OciSignatureKeys, &v1alpha1.OCISignatureKey{})
+			if err := m.OciSignatureKeys[len(m.OciSignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8281,7 +9315,591 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traces = append(m.Traces, &ManifestGenerationTrace{})
+			if err := m.Traces[len(m.Traces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestGenerationTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestGenerationTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestGenerationTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8309,11 +9927,11 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8341,62 +9959,11 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8424,13 +9991,13 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8440,29 +10007,31 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.RefSources = append(m.RefSources, &ResolvedRefSource{})
+			if err := m.RefSources[len(m.RefSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheHit", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8472,27 +10041,66 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+			m.CacheHit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolvedRefSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedRefSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedRefSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8520,11 +10128,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Ref = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8552,11 +10160,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8584,11 +10192,11 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8616,7 +10224,7 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9977,6 +11585,38 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.RefSources[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationManifestGeneratePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationManifestGeneratePaths = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10204,6 +11844,42 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cue == nil {
+				m.Cue = &CUEAppSpec{}
+			}
+			if err := m.Cue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10466,7 +12142,39 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OciSignatureKeys", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -10476,23 +12184,25 @@ func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.OciSignatureKeys = append(m.OciSignatureKeys, &v1alpha1.OCISignatureKey{})
+			if err := m.OciSignatureKeys[len(m.OciSignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11372,6 +13082,91 @@ func (m *PluginAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CUEAppSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CUEAppSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CUEAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &v1alpha1.CUETag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13046,6 +14841,176 @@ func (m *UpdateRevisionForPathsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WarmUpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WarmUpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WarmUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WarmUpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WarmUpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WarmUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -430,6 +430,35 @@ func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource
 		&cacheutil.CacheActionOpts{Delete: true})
 }
 
+// manifestInputsKey is the key of the paths of the files which were read to generate the manifests of an application
+// source. It is the manifest cache key without the revision, since the paths are used to look up the manifests of
+// other revisions.
+func manifestInputsKey(appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, namespace string, trackingMethod string, appLabelKey string, appName string, info ClusterRuntimeInfo, refSourceCommitSHAs ResolvedRevisions, installationID string) string {
+	trackingKey := trackingKey(appLabelKey, trackingMethod)
+	key := fmt.Sprintf("mfstinputs|%s|%s|%s|%d", trackingKey, appName, namespace, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs)+clusterRuntimeInfoKey(info))
+	if installationID != "" {
+		key = fmt.Sprintf("%s|%s", key, installationID)
+	}
+	return key
+}
+
+// GetManifestInputs returns the paths, relative to the repository root, of the files which were read the last time the
+// manifests of the application source were generated.
+func (c *Cache) GetManifestInputs(appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string) ([]string, error) {
+	var paths []string
+	err := c.cache.GetItem(manifestInputsKey(appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID), &paths)
+	return paths, err
+}
+
+// SetManifestInputs stores the paths, relative to the repository root, of the files which were read to generate the
+// manifests of the application source.
+func (c *Cache) SetManifestInputs(appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string, paths []string) error {
+	return c.cache.SetItem(
+		manifestInputsKey(appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID),
+		paths,
+		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
 func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions) string {
 	if trackingMethod == "" {
 		trackingMethod = appv1.TrackingMethodLabel
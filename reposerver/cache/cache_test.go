@@ -137,6 +137,28 @@ func TestCache_GetManifests(t *testing.T) {
 	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
 }
 
+func TestCache_GetManifestInputs(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+	mockCache := fixtures.mockCache
+	q := &apiclient.ManifestRequest{}
+	// cache miss
+	_, err := cache.GetManifestInputs(&v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", nil, "")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// populate cache
+	err = cache.SetManifestInputs(&v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", nil, "", []string{"apps/my-app", "base"})
+	require.NoError(t, err)
+	// cache miss
+	_, err = cache.GetManifestInputs(&v1alpha1.ApplicationSource{Path: "other-path"}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", nil, "")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache hit
+	value, err := cache.GetManifestInputs(&v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/my-app", "base"}, value)
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 1, ExternalGets: 3})
+}
+
 func TestCache_GetAppDetails(t *testing.T) {
 	fixtures := newFixtures()
 	t.Cleanup(fixtures.mockCache.StopRedisCallback)
@@ -26,6 +26,8 @@ type MetricsServer struct {
 	ociTestRepoFailCounter        *prometheus.CounterVec
 	ociRequestCounter             *prometheus.CounterVec
 	ociRequestHistogram           *prometheus.HistogramVec
+	warmUpFetchCounter            *prometheus.CounterVec
+	warmUpHistogram               prometheus.Histogram
 	PrometheusRegistry            *prometheus.Registry
 }
 
@@ -171,6 +173,24 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(ociRequestHistogram)
 
+	warmUpFetchCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_warmup_fetch_total",
+			Help: "Number of revisions fetched by the repository warm-up",
+		},
+		[]string{"repo", "failed"},
+	)
+	registry.MustRegister(warmUpFetchCounter)
+
+	warmUpHistogram := prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "argocd_repo_warmup_duration_seconds",
+			Help:    "Repository warm-up fetch duration seconds.",
+			Buckets: []float64{0.1, 0.25, .5, 1, 2, 4, 10, 20, 60, 120, 300},
+		},
+	)
+	registry.MustRegister(warmUpHistogram)
+
 	return &MetricsServer{
 		handler:                       promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitFetchFailCounter:           gitFetchFailCounter,
@@ -187,6 +207,8 @@ func NewMetricsServer() *MetricsServer {
 		ociGetTagsFailCounter:         ociGetTagsFailCounter,
 		ociDigestMetadataCounter:      ociDigestMetadataCounter,
 		ociTestRepoFailCounter:        ociTestRepoFailCounter,
+		warmUpFetchCounter:            warmUpFetchCounter,
+		warmUpHistogram:               warmUpHistogram,
 		PrometheusRegistry:            registry,
 	}
 }
@@ -261,3 +283,13 @@ func (m *MetricsServer) IncOCIGetTagsFailCounter(repo string) {
 func (m *MetricsServer) IncOCITestRepoFailCounter(repo string) {
 	m.ociTestRepoFailCounter.WithLabelValues(repo).Inc()
 }
+
+// IncWarmUpFetch increments the counter of revisions fetched by the warm-up
+func (m *MetricsServer) IncWarmUpFetch(repo string, failed bool) {
+	m.warmUpFetchCounter.WithLabelValues(repo, strconv.FormatBool(failed)).Inc()
+}
+
+// ObserveWarmUpDuration observes the duration of fetching a revision for the warm-up
+func (m *MetricsServer) ObserveWarmUpDuration(duration time.Duration) {
+	m.warmUpHistogram.Observe(duration.Seconds())
+}
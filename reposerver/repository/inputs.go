@@ -0,0 +1,542 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/cache"
+	"github.com/argoproj/argo-cd/v3/util/cue"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+)
+
+// contentRevisionPrefix is the prefix of the revisions under which manifests are cached by the hash of the files which
+// were read to generate them, rather than by commit SHA.
+const contentRevisionPrefix = "content-sha256:"
+
+// jsonnetImportRegex matches the paths of import, importstr and importbin expressions in Jsonnet files.
+var jsonnetImportRegex = regexp.MustCompile(`\bimport(?:str|bin)?\s*@?(?:"([^"]*)"|'([^']*)')`)
+
+// manifestInputs records the files and directories of a repository which are read to generate the manifests of an
+// application source. Generations whose inputs cannot be determined statically, e.g. because they depend on the
+// revision or on remote files, are marked as untracked and are never cached by content.
+type manifestInputs struct {
+	repoRoot        string
+	paths           map[string]bool
+	untrackedReason string
+	// hash is the hash of the contents of the paths, computed before manifest generation since generation may add
+	// files to the repository (e.g. downloaded Helm dependencies)
+	hash string
+}
+
+func newManifestInputs(repoRoot string) *manifestInputs {
+	if absRoot, err := filepath.Abs(repoRoot); err == nil {
+		repoRoot = absRoot
+	}
+	return &manifestInputs{repoRoot: repoRoot, paths: map[string]bool{}}
+}
+
+// add records the given path. Paths outside of the repository are ignored.
+func (i *manifestInputs) add(path string) {
+	if i == nil {
+		return
+	}
+	rel, ok := i.relPath(path)
+	if !ok {
+		return
+	}
+	i.paths[rel] = true
+}
+
+// relPath returns the path relative to the repository root, and false if the path is outside of the repository.
+func (i *manifestInputs) relPath(path string) (string, bool) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(i.repoRoot, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// setUntracked marks the inputs as untracked. The first reason is kept.
+func (i *manifestInputs) setUntracked(reason string) {
+	if i == nil || i.untrackedReason != "" {
+		return
+	}
+	i.untrackedReason = reason
+}
+
+// list returns the recorded paths sorted, or nil if the inputs are untracked. Paths inside recorded directories are
+// omitted, since the directories are hashed recursively.
+func (i *manifestInputs) list() []string {
+	if i == nil || i.untrackedReason != "" {
+		return nil
+	}
+	paths := make([]string, 0, len(i.paths))
+	for p := range i.paths {
+		if !i.hasRecordedParent(p) {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (i *manifestInputs) hasRecordedParent(p string) bool {
+	if i.paths["."] && p != "." {
+		return true
+	}
+	for dir := path.Dir(p); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if i.paths[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// recordManifestInputs records the inputs of the manifest generation of the given (merged) source at the app path and
+// hashes their contents.
+func recordManifestInputs(inputs *manifestInputs, appSourceType v1alpha1.ApplicationSourceType, appPath string, env *v1alpha1.Env, q *apiclient.ManifestRequest) {
+	if inputs == nil {
+		return
+	}
+	sourceJSON, err := json.Marshal(q.ApplicationSource)
+	if err != nil {
+		inputs.setUntracked(fmt.Sprintf("failed to marshal source: %v", err))
+		return
+	}
+	if strings.Contains(string(sourceJSON), "ARGOCD_APP_REVISION") {
+		inputs.setUntracked("source references the revision")
+		return
+	}
+	inputs.add(appPath)
+
+	switch appSourceType {
+	case v1alpha1.ApplicationSourceTypeHelm:
+		recordHelmInputs(inputs, appPath, env, q)
+	case v1alpha1.ApplicationSourceTypeKustomize:
+		recordKustomizeInputs(inputs, appPath, map[string]bool{})
+	case v1alpha1.ApplicationSourceTypeCUE:
+		recordCUEInputs(inputs, appPath)
+	case v1alpha1.ApplicationSourceTypeDirectory:
+		recordDirectoryInputs(inputs, appPath, q.ApplicationSource.Directory)
+	default:
+		inputs.setUntracked(fmt.Sprintf("inputs of %s sources are unknown", appSourceType))
+	}
+	if inputs.untrackedReason != "" {
+		return
+	}
+
+	inputs.hash, err = hashManifestInputs(inputs.repoRoot, inputs.list())
+	if err != nil {
+		inputs.setUntracked(fmt.Sprintf("failed to hash inputs: %v", err))
+	}
+}
+
+// recordHelmInputs records the value files and file parameters of the source, and the local dependencies of the chart.
+func recordHelmInputs(inputs *manifestInputs, appPath string, env *v1alpha1.Env, q *apiclient.ManifestRequest) {
+	if appHelm := q.ApplicationSource.Helm; appHelm != nil {
+		files := append([]string{}, appHelm.ValueFiles...)
+		for _, p := range appHelm.FileParameters {
+			files = append(files, p.Path)
+		}
+		if postRender := appHelm.PostRender; postRender != nil {
+			if postRender.Plugin != nil {
+				inputs.setUntracked("inputs of post-render plugins are unknown")
+				return
+			}
+			for _, p := range postRender.Patches {
+				if p.Path != "" {
+					files = append(files, p.Path)
+				}
+			}
+		}
+		for _, file := range files {
+			// Referenced sources are part of the cache key
+			if strings.HasPrefix(file, "$") {
+				continue
+			}
+			resolved, isRemote, err := pathutil.ResolveValueFilePathOrUrl(appPath, inputs.repoRoot, env.Envsubst(file), q.GetValuesFileSchemes())
+			if err != nil {
+				inputs.setUntracked(fmt.Sprintf("failed to resolve value file %s: %v", file, err))
+				return
+			}
+			if isRemote {
+				inputs.setUntracked(fmt.Sprintf("value file %s is remote", file))
+				return
+			}
+			inputs.add(string(resolved))
+		}
+	}
+	recordHelmDependencyInputs(inputs, appPath, map[string]bool{})
+}
+
+// recordHelmDependencyInputs records the Chart.lock and the charts of the local (file://) dependencies of the chart at
+// the given path. Charts with remote dependencies are only tracked if their versions are locked.
+func recordHelmDependencyInputs(inputs *manifestInputs, chartPath string, visited map[string]bool) {
+	if visited[chartPath] {
+		return
+	}
+	visited[chartPath] = true
+	data, err := os.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	if err != nil {
+		return
+	}
+	var deps dependencies
+	if err := yaml.Unmarshal(data, &deps); err != nil {
+		inputs.setUntracked(fmt.Sprintf("failed to parse Chart.yaml: %v", err))
+		return
+	}
+	lockFile := filepath.Join(chartPath, "Chart.lock")
+	inputs.add(lockFile)
+	for _, dep := range deps.Dependencies {
+		localPath, ok := strings.CutPrefix(dep.Repository, "file://")
+		if !ok {
+			// Without a lock file, the version of a remote dependency may be resolved to a newer chart at any time
+			if _, err := os.Stat(lockFile); err != nil {
+				inputs.setUntracked(fmt.Sprintf("dependency from %s is not locked", dep.Repository))
+				return
+			}
+			continue
+		}
+		depPath := filepath.Clean(filepath.Join(chartPath, localPath))
+		inputs.add(depPath)
+		recordHelmDependencyInputs(inputs, depPath, visited)
+	}
+}
+
+// recordCUEInputs records the CUE module the package at the given path belongs to, since a package may import any
+// other package of its module. Packages outside of a module can only import packages of their subdirectories.
+func recordCUEInputs(inputs *manifestInputs, appPath string) {
+	for dir := filepath.Clean(appPath); ; dir = filepath.Dir(dir) {
+		if _, ok := inputs.relPath(dir); !ok {
+			return
+		}
+		if info, err := os.Stat(filepath.Join(dir, cue.ModuleDir)); err == nil && info.IsDir() {
+			inputs.add(dir)
+			return
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return
+		}
+	}
+}
+
+// recordKustomizeInputs records the directory of the kustomization at the given path and every local file or
+// directory it refers to, including other kustomizations.
+func recordKustomizeInputs(inputs *manifestInputs, dir string, visited map[string]bool) {
+	if visited[dir] {
+		return
+	}
+	visited[dir] = true
+	inputs.add(dir)
+
+	var kustomization map[string]any
+	for _, name := range kustomize.KustomizationNames {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		if err := yaml.Unmarshal(data, &kustomization); err != nil {
+			inputs.setUntracked(fmt.Sprintf("failed to parse kustomization: %v", err))
+			return
+		}
+		break
+	}
+
+	for _, field := range []string{"resources", "bases", "components"} {
+		entries, _ := kustomization[field].([]any)
+		for _, entry := range entries {
+			ref, ok := entry.(string)
+			if !ok {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(dir, ref)); err != nil {
+				inputs.setUntracked(fmt.Sprintf("kustomization refers to %s which is not in the repository", ref))
+				return
+			}
+		}
+	}
+	charts, _ := kustomization["helmCharts"].([]any)
+	for _, chart := range charts {
+		chartMap, _ := chart.(map[string]any)
+		if chartMap["repo"] != nil && chartMap["version"] == nil {
+			inputs.setUntracked("kustomization refers to a Helm chart without version")
+			return
+		}
+	}
+
+	// Any string in the kustomization, or the value of a key=value string (e.g. of generators), may be a path
+	var walk func(v any)
+	walk = func(v any) {
+		switch value := v.(type) {
+		case map[string]any:
+			for _, nested := range value {
+				walk(nested)
+			}
+		case []any:
+			for _, nested := range value {
+				walk(nested)
+			}
+		case string:
+			candidates := []string{value}
+			if _, after, ok := strings.Cut(value, "="); ok {
+				candidates = append(candidates, after)
+			}
+			for _, candidate := range candidates {
+				if candidate == "" || filepath.IsAbs(candidate) {
+					continue
+				}
+				p := filepath.Clean(filepath.Join(dir, candidate))
+				info, err := os.Stat(p)
+				if err != nil {
+					continue
+				}
+				if info.IsDir() && kustomizationExists(p) {
+					recordKustomizeInputs(inputs, p, visited)
+					continue
+				}
+				inputs.add(p)
+			}
+		}
+	}
+	walk(kustomization)
+}
+
+func kustomizationExists(dir string) bool {
+	for _, name := range kustomize.KustomizationNames {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// recordDirectoryInputs records the Jsonnet libraries of the source and the files imported by the Jsonnet files in the
+// app path.
+func recordDirectoryInputs(inputs *manifestInputs, appPath string, directory *v1alpha1.ApplicationSourceDirectory) {
+	jpaths := []string{appPath}
+	if directory != nil {
+		for _, lib := range directory.Jsonnet.Libs {
+			jpath, err := pathutil.ResolveFileOrDirectoryPath(inputs.repoRoot, inputs.repoRoot, lib)
+			if err != nil {
+				inputs.setUntracked(fmt.Sprintf("failed to resolve jsonnet library %s: %v", lib, err))
+				return
+			}
+			inputs.add(string(jpath))
+			jpaths = append(jpaths, string(jpath))
+		}
+	}
+
+	visited := map[string]bool{}
+	err := filepath.Walk(appPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && isJsonnetFile(path) {
+			recordJsonnetImports(inputs, path, jpaths, visited)
+		}
+		return nil
+	})
+	if err != nil {
+		inputs.setUntracked(fmt.Sprintf("failed to walk %s: %v", appPath, err))
+	}
+}
+
+func isJsonnetFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".jsonnet" || ext == ".libsonnet"
+}
+
+// recordJsonnetImports records the files imported by the Jsonnet file, resolved the way Jsonnet does: relative to the
+// importing file first and then to the library paths.
+func recordJsonnetImports(inputs *manifestInputs, file string, jpaths []string, visited map[string]bool) {
+	if visited[file] {
+		return
+	}
+	visited[file] = true
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+	for _, match := range jsonnetImportRegex.FindAllStringSubmatch(string(data), -1) {
+		imported := match[1] + match[2]
+		if imported == "" {
+			continue
+		}
+		for _, dir := range append([]string{filepath.Dir(file)}, jpaths...) {
+			p := filepath.Clean(filepath.Join(dir, imported))
+			if _, err := os.Stat(p); err != nil {
+				continue
+			}
+			inputs.add(p)
+			if isJsonnetFile(p) {
+				recordJsonnetImports(inputs, p, jpaths, visited)
+			}
+			break
+		}
+	}
+}
+
+// hashManifestInputs returns the hash of the contents of the given paths, relative to the repository root. Directories
+// are hashed recursively. Missing paths are part of the hash, so that adding them changes it.
+func hashManifestInputs(repoRoot string, paths []string) (string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(repoRoot)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve repository root: %w", err)
+	}
+	h := sha256.New()
+	visited := map[string]bool{}
+	for _, p := range paths {
+		_, _ = fmt.Fprintf(h, "path %s\n", p)
+		err := hashPath(h, resolvedRoot, filepath.Join(resolvedRoot, filepath.FromSlash(p)), visited)
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashPath(h hash.Hash, repoRoot, path string, visited map[string]bool) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		_, _ = fmt.Fprintf(h, "missing\n")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(repoRoot, path)
+	if err != nil {
+		return err
+	}
+	rel = filepath.ToSlash(rel)
+
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "symlink %s %s\n", rel, target)
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			// Dangling symlinks are hashed by their target only
+			return nil //nolint:nilerr
+		}
+		resolvedRel, err := filepath.Rel(repoRoot, resolved)
+		if err != nil || resolvedRel == ".." || strings.HasPrefix(resolvedRel, ".."+string(filepath.Separator)) || visited[resolved] {
+			return nil //nolint:nilerr
+		}
+		visited[resolved] = true
+		return hashPath(h, repoRoot, resolved, visited)
+	case info.IsDir():
+		if info.Name() == ".git" {
+			return nil
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "dir %s\n", rel)
+		// ReadDir returns the entries sorted by file name
+		for _, entry := range entries {
+			err = hashPath(h, repoRoot, filepath.Join(path, entry.Name()), visited)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		_, _ = fmt.Fprintf(h, "file %s %d\n", rel, info.Size())
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	}
+}
+
+// getRefSourceContentRevisions returns the revisions of the referenced sources by their normalized repository URL under
+// which manifests are cached by content: the hash of the value files and file parameters read from each of them.
+// Referenced sources whose files cannot be determined statically are keyed by their commit SHA.
+func getRefSourceContentRevisions(repoRefs map[string]repoRef, q *apiclient.ManifestRequest) cache.ResolvedRevisions {
+	refPaths := map[string][]string{}
+	untracked := map[string]bool{}
+	if appHelm := q.ApplicationSource.Helm; appHelm != nil {
+		files := append([]string{}, appHelm.ValueFiles...)
+		for _, p := range appHelm.FileParameters {
+			files = append(files, p.Path)
+		}
+		for _, file := range files {
+			refSource := getReferencedSource(file, q.RefSources)
+			if refSource == nil {
+				continue
+			}
+			normalizedRepoURL := git.NormalizeGitURL(refSource.Repo.Repo)
+			ref, ok := repoRefs[normalizedRepoURL]
+			if !ok {
+				continue
+			}
+			_, refPath, _ := strings.Cut(file, "/")
+			// Paths with environment variables are substituted during generation
+			if strings.Contains(refPath, "$") {
+				untracked[normalizedRepoURL] = true
+				continue
+			}
+			resolved, isRemote, err := pathutil.ResolveValueFilePathOrUrl(ref.root, ref.root, refPath, q.GetValuesFileSchemes())
+			if err != nil || isRemote {
+				untracked[normalizedRepoURL] = true
+				continue
+			}
+			rel, err := filepath.Rel(ref.root, string(resolved))
+			if err != nil {
+				untracked[normalizedRepoURL] = true
+				continue
+			}
+			refPaths[normalizedRepoURL] = append(refPaths[normalizedRepoURL], filepath.ToSlash(rel))
+		}
+	}
+	revisions := getRefSourceCommitSHAs(repoRefs)
+	for normalizedRepoURL, ref := range repoRefs {
+		if untracked[normalizedRepoURL] {
+			continue
+		}
+		paths := refPaths[normalizedRepoURL]
+		sort.Strings(paths)
+		hash, err := hashManifestInputs(ref.root, paths)
+		if err != nil {
+			continue
+		}
+		revisions[normalizedRepoURL] = contentRevision(hash)
+	}
+	return revisions
+}
+
+// contentRevision returns the revision under which the manifests generated from inputs with the given hash are cached.
+func contentRevision(hash string) string {
+	return contentRevisionPrefix + hash
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func writeTestFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+}
+
+func recordTestManifestInputs(t *testing.T, root string, appSourceType v1alpha1.ApplicationSourceType, source *v1alpha1.ApplicationSource) *manifestInputs {
+	t.Helper()
+	q := &apiclient.ManifestRequest{Repo: &v1alpha1.Repository{}, AppName: "app", ApplicationSource: source}
+	inputs := newManifestInputs(root)
+	recordManifestInputs(inputs, appSourceType, filepath.Join(root, source.Path), newEnv(q, "abc123"), q)
+	return inputs
+}
+
+func Test_hashManifestInputs(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"app/deployment.yaml": "kind: Deployment",
+		"app/nested/cm.yaml":  "kind: ConfigMap",
+		"other/cm.yaml":       "kind: ConfigMap",
+	})
+	paths := []string{"app", "missing"}
+
+	hash, err := hashManifestInputs(root, paths)
+	require.NoError(t, err)
+
+	writeTestFiles(t, root, map[string]string{"other/cm.yaml": "kind: Secret"})
+	unrelatedChange, err := hashManifestInputs(root, paths)
+	require.NoError(t, err)
+	assert.Equal(t, hash, unrelatedChange)
+
+	writeTestFiles(t, root, map[string]string{"app/nested/cm.yaml": "kind: Secret"})
+	nestedChange, err := hashManifestInputs(root, paths)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, nestedChange)
+
+	writeTestFiles(t, root, map[string]string{"missing": ""})
+	added, err := hashManifestInputs(root, paths)
+	require.NoError(t, err)
+	assert.NotEqual(t, nestedChange, added)
+
+	require.NoError(t, os.Rename(filepath.Join(root, "app", "deployment.yaml"), filepath.Join(root, "app", "renamed.yaml")))
+	renamed, err := hashManifestInputs(root, paths)
+	require.NoError(t, err)
+	assert.NotEqual(t, added, renamed)
+}
+
+func Test_hashManifestInputs_Symlink(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{"shared/values.yaml": "replicas: 1"})
+	require.NoError(t, os.Symlink("shared/values.yaml", filepath.Join(root, "values.yaml")))
+
+	hash, err := hashManifestInputs(root, []string{"values.yaml"})
+	require.NoError(t, err)
+
+	writeTestFiles(t, root, map[string]string{"shared/values.yaml": "replicas: 2"})
+	changed, err := hashManifestInputs(root, []string{"values.yaml"})
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+}
+
+func Test_recordManifestInputs_Directory(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"app/main.jsonnet":      `local lib = import "lib.libsonnet"; local cfg = importstr '../config/app.json'; lib`,
+		"lib/lib.libsonnet":     `import "nested.libsonnet"`,
+		"lib/nested.libsonnet":  `{}`,
+		"config/app.json":       `{}`,
+		"unrelated/cm.yaml":     "kind: ConfigMap",
+		"vendor/unused.jsonnet": `{}`,
+	})
+	source := &v1alpha1.ApplicationSource{
+		Path: "app",
+		Directory: &v1alpha1.ApplicationSourceDirectory{
+			Jsonnet: v1alpha1.ApplicationSourceJsonnet{Libs: []string{"lib"}},
+		},
+	}
+
+	inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeDirectory, source)
+	assert.Empty(t, inputs.untrackedReason)
+	assert.Equal(t, []string{"app", "config/app.json", "lib"}, inputs.list())
+	assert.NotEmpty(t, inputs.hash)
+}
+
+func Test_recordManifestInputs_Kustomize(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"overlays/prod/kustomization.yaml": `
+resources:
+- ../../base
+- deployment.yaml
+configMapGenerator:
+- name: config
+  files:
+  - config.properties=../../config/prod.properties
+`,
+		"overlays/prod/deployment.yaml": "kind: Deployment",
+		"base/kustomization.yaml":       "resources:\n- service.yaml\n",
+		"base/service.yaml":             "kind: Service",
+		"config/prod.properties":        "a=b",
+		"overlays/dev/kustomization.yaml": `
+resources:
+- ../../base
+`,
+	})
+
+	inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeKustomize, &v1alpha1.ApplicationSource{Path: "overlays/prod"})
+	assert.Empty(t, inputs.untrackedReason)
+	assert.Equal(t, []string{"base", "config/prod.properties", "overlays/prod"}, inputs.list())
+
+	t.Run("remote resource", func(t *testing.T) {
+		writeTestFiles(t, root, map[string]string{
+			"remote/kustomization.yaml": "resources:\n- https://github.com/argoproj/argo-cd//manifests/base?ref=master\n",
+		})
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeKustomize, &v1alpha1.ApplicationSource{Path: "remote"})
+		assert.NotEmpty(t, inputs.untrackedReason)
+		assert.Nil(t, inputs.list())
+	})
+}
+
+func Test_recordManifestInputs_Helm(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"charts/app/Chart.yaml": `
+apiVersion: v2
+name: app
+version: 1.0.0
+dependencies:
+- name: common
+  version: 1.0.0
+  repository: file://../common
+- name: redis
+  version: 1.0.0
+  repository: https://charts.example.com
+`,
+		"charts/app/Chart.lock":    "dependencies: []",
+		"charts/common/Chart.yaml": "apiVersion: v2\nname: common\nversion: 1.0.0\n",
+		"envs/prod/values.yaml":    "replicas: 3",
+		"envs/prod/config.json":    "{}",
+	})
+	source := &v1alpha1.ApplicationSource{
+		Path: "charts/app",
+		Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles:     []string{"../../envs/prod/values.yaml", "$values/prod.yaml"},
+			FileParameters: []v1alpha1.HelmFileParameter{{Name: "config", Path: "../../envs/prod/config.json"}},
+		},
+	}
+
+	inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+	assert.Empty(t, inputs.untrackedReason)
+	assert.Equal(t, []string{"charts/app", "charts/common", "envs/prod/config.json", "envs/prod/values.yaml"}, inputs.list())
+
+	t.Run("remote value file", func(t *testing.T) {
+		source := source.DeepCopy()
+		source.Helm.ValueFiles = []string{"https://example.com/values.yaml"}
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+		assert.NotEmpty(t, inputs.untrackedReason)
+	})
+
+	t.Run("post-render patch file", func(t *testing.T) {
+		source := source.DeepCopy()
+		source.Helm.PostRender = &v1alpha1.HelmPostRender{Patches: v1alpha1.KustomizePatches{{Path: "../../envs/prod/patch.yaml"}}}
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+		assert.Empty(t, inputs.untrackedReason)
+		assert.Contains(t, inputs.list(), "envs/prod/patch.yaml")
+	})
+
+	t.Run("post-render plugin", func(t *testing.T) {
+		source := source.DeepCopy()
+		source.Helm.PostRender = &v1alpha1.HelmPostRender{Plugin: &v1alpha1.ApplicationSourcePlugin{Name: "kbld"}}
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+		assert.Equal(t, "inputs of post-render plugins are unknown", inputs.untrackedReason)
+	})
+
+	t.Run("unlocked remote dependency", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(root, "charts/app/Chart.lock")))
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+		assert.Equal(t, "dependency from https://charts.example.com is not locked", inputs.untrackedReason)
+	})
+}
+
+func Test_recordManifestInputs_CUE(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"cue/cue.mod/module.cue": `module: "example.com/apps@v0"`,
+		"cue/apps/web/web.cue":   "package web",
+		"cue/lib/lib.cue":        "package lib",
+		"plain/app.cue":          "package app",
+	})
+
+	inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeCUE, &v1alpha1.ApplicationSource{Path: "cue/apps/web", CUE: &v1alpha1.ApplicationSourceCUE{}})
+	assert.Empty(t, inputs.untrackedReason)
+	assert.Equal(t, []string{"cue"}, inputs.list())
+
+	inputs = recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeCUE, &v1alpha1.ApplicationSource{Path: "plain", CUE: &v1alpha1.ApplicationSourceCUE{}})
+	assert.Empty(t, inputs.untrackedReason)
+	assert.Equal(t, []string{"plain"}, inputs.list())
+}
+
+func Test_getRefSourceContentRevisions(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"envs/prod/values.yaml": "replicas: 3",
+		"envs/dev/values.yaml":  "replicas: 1",
+	})
+	repoURL := "https://github.com/example/values"
+	repoRefs := map[string]repoRef{git.NormalizeGitURL(repoURL): {commitSHA: "abc123", key: "$values", root: root}}
+	request := func(valueFiles ...string) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			ApplicationSource: &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: valueFiles}},
+			RefSources:        map[string]*v1alpha1.RefTarget{"$values": {Repo: v1alpha1.Repository{Repo: repoURL}}},
+		}
+	}
+
+	revisions := getRefSourceContentRevisions(repoRefs, request("$values/envs/prod/values.yaml"))
+	revision := revisions[git.NormalizeGitURL(repoURL)]
+	assert.True(t, strings.HasPrefix(revision, contentRevisionPrefix))
+
+	// Changes to other files of the referenced source do not change the revision
+	writeTestFiles(t, root, map[string]string{"envs/dev/values.yaml": "replicas: 2"})
+	assert.Equal(t, revision, getRefSourceContentRevisions(repoRefs, request("$values/envs/prod/values.yaml"))[git.NormalizeGitURL(repoURL)])
+
+	writeTestFiles(t, root, map[string]string{"envs/prod/values.yaml": "replicas: 5"})
+	assert.NotEqual(t, revision, getRefSourceContentRevisions(repoRefs, request("$values/envs/prod/values.yaml"))[git.NormalizeGitURL(repoURL)])
+
+	t.Run("environment variables", func(t *testing.T) {
+		revisions := getRefSourceContentRevisions(repoRefs, request("$values/envs/$ARGOCD_APP_NAME/values.yaml"))
+		assert.Equal(t, "abc123", revisions[git.NormalizeGitURL(repoURL)])
+	})
+}
+
+func Test_recordManifestInputs_Untracked(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{"app/cm.yaml": "kind: ConfigMap"})
+
+	t.Run("revision", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{
+			Path: "app",
+			Helm: &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "$ARGOCD_APP_REVISION"}}},
+		}
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypeHelm, source)
+		assert.Equal(t, "source references the revision", inputs.untrackedReason)
+	})
+
+	t.Run("plugin", func(t *testing.T) {
+		inputs := recordTestManifestInputs(t, root, v1alpha1.ApplicationSourceTypePlugin, &v1alpha1.ApplicationSource{Path: "app"})
+		assert.NotEmpty(t, inputs.untrackedReason)
+		assert.Nil(t, inputs.list())
+	})
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// postRenderManifestsFile is the name of the file the rendered manifests are written to for the post-render steps
+const postRenderManifestsFile = "all.yaml"
+
+// helmPostRender applies the post-render steps of the Helm source of the request to the manifests rendered by Helm and
+// returns the resulting manifests, as well as the commands which were run.
+func helmPostRender(ctx context.Context, appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitCredsStore git.CredsStore, objs []*unstructured.Unstructured, opt *generateManifestOpt) ([]*unstructured.Unstructured, []string, error) {
+	postRender := q.ApplicationSource.Helm.PostRender
+	var commands []string
+	var err error
+
+	if len(postRender.Patches) > 0 {
+		var kustomizeCommands []string
+		objs, kustomizeCommands, err = postRenderKustomizePatches(appPath, repoRoot, env, q, gitCredsStore, objs, postRender.Patches)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error applying post-render patches: %w", err)
+		}
+		commands = append(commands, kustomizeCommands...)
+	}
+
+	if len(postRender.JSONPatches) > 0 {
+		objs, err = postRenderJSONPatches(objs, postRender.JSONPatches)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error applying post-render JSON patches: %w", err)
+		}
+	}
+
+	if postRender.Plugin != nil {
+		objs, err = postRenderPlugin(ctx, env, q, gitCredsStore, objs, postRender.Plugin, opt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("post-render CMP processing failed for application %q: %w", q.AppName, err)
+		}
+	}
+
+	return objs, commands, nil
+}
+
+// withPostRenderManifests writes the given manifests to a new temporary directory, calls f with the directory and
+// removes the directory afterwards.
+func withPostRenderManifests(objs []*unstructured.Unstructured, f func(dir string) error) error {
+	dir, err := files.CreateTempDir("")
+	if err != nil {
+		return fmt.Errorf("error creating temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.WithField(common.SecurityField, common.SecurityCritical).Errorf("error removing post-render dir: %v", err)
+		}
+	}()
+
+	var sb strings.Builder
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("error marshaling manifest: %w", err)
+		}
+		sb.WriteString("---\n")
+		sb.Write(data)
+	}
+	if err := os.WriteFile(filepath.Join(dir, postRenderManifestsFile), []byte(sb.String()), 0o600); err != nil {
+		return fmt.Errorf("error writing manifests: %w", err)
+	}
+	return f(dir)
+}
+
+// postRenderKustomizePatches applies the given Kustomize patches to the manifests. Patch files are read relative to the
+// app path and inlined, since Kustomize only loads files within the kustomization root.
+func postRenderKustomizePatches(appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitCredsStore git.CredsStore, objs []*unstructured.Unstructured, patches v1alpha1.KustomizePatches) ([]*unstructured.Unstructured, []string, error) {
+	inlined := make(v1alpha1.KustomizePatches, len(patches))
+	for i, patch := range patches {
+		if patch.Path != "" {
+			resolved, _, err := pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, env.Envsubst(patch.Path), nil)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error resolving patch file path: %w", err)
+			}
+			data, err := os.ReadFile(string(resolved))
+			if err != nil {
+				return nil, nil, fmt.Errorf("error reading patch file %s: %w", patch.Path, err)
+			}
+			patch.Path = ""
+			patch.Patch = string(data)
+		}
+		inlined[i] = patch
+	}
+
+	kustomizeBinary, err := settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting kustomize binary path: %w", err)
+	}
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+
+	var result []*unstructured.Unstructured
+	var commands []string
+	err = withPostRenderManifests(objs, func(dir string) error {
+		kustomization := "resources:\n- " + postRenderManifestsFile + "\n"
+		if err := os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(kustomization), 0o600); err != nil {
+			return fmt.Errorf("error writing kustomization.yaml: %w", err)
+		}
+		k := kustomize.NewKustomizeApp(dir, dir, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, q.Repo.Proxy, q.Repo.NoProxy)
+		var err error
+		result, _, commands, err = k.Build(&v1alpha1.ApplicationSourceKustomize{Patches: inlined}, nil, env, nil)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, commands, nil
+}
+
+// postRenderJSONPatches applies the given JSON patches to the manifests matching their targets.
+func postRenderJSONPatches(objs []*unstructured.Unstructured, patches []v1alpha1.HelmPostRenderJSONPatch) ([]*unstructured.Unstructured, error) {
+	for i, p := range patches {
+		data, err := yaml.YAMLToJSON([]byte(p.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JSON patch %d: %w", i, err)
+		}
+		patch, err := jsonpatch.DecodePatch(data)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding JSON patch %d: %w", i, err)
+		}
+		matches, err := newPostRenderTargetMatcher(p.Target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target of JSON patch %d: %w", i, err)
+		}
+		for j, obj := range objs {
+			if obj == nil || !matches(obj) {
+				continue
+			}
+			objJSON, err := obj.MarshalJSON()
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			patched, err := patch.Apply(objJSON)
+			if err != nil {
+				return nil, fmt.Errorf("error applying JSON patch %d to %s/%s: %w", i, obj.GetKind(), obj.GetName(), err)
+			}
+			patchedObj := &unstructured.Unstructured{}
+			if err := patchedObj.UnmarshalJSON(patched); err != nil {
+				return nil, fmt.Errorf("error unmarshaling patched %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			objs[j] = patchedObj
+		}
+	}
+	return objs, nil
+}
+
+// newPostRenderTargetMatcher returns a function matching the manifests selected by the given target. Group, version,
+// kind, name and namespace are matched as anchored regular expressions, like Kustomize does.
+func newPostRenderTargetMatcher(target *v1alpha1.KustomizeSelector) (func(obj *unstructured.Unstructured) bool, error) {
+	if target == nil {
+		return func(_ *unstructured.Unstructured) bool { return true }, nil
+	}
+	type fieldMatcher struct {
+		re    *regexp.Regexp
+		value func(obj *unstructured.Unstructured) string
+	}
+	var fieldMatchers []fieldMatcher
+	for _, f := range []struct {
+		pattern string
+		value   func(obj *unstructured.Unstructured) string
+	}{
+		{target.Group, func(obj *unstructured.Unstructured) string { return obj.GroupVersionKind().Group }},
+		{target.Version, func(obj *unstructured.Unstructured) string { return obj.GroupVersionKind().Version }},
+		{target.Kind, (*unstructured.Unstructured).GetKind},
+		{target.Name, (*unstructured.Unstructured).GetName},
+		{target.Namespace, (*unstructured.Unstructured).GetNamespace},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile("^(?:" + f.pattern + ")$")
+		if err != nil {
+			return nil, err
+		}
+		fieldMatchers = append(fieldMatchers, fieldMatcher{re: re, value: f.value})
+	}
+	labelSelector, err := labels.Parse(target.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+	annotationSelector, err := labels.Parse(target.AnnotationSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid annotation selector: %w", err)
+	}
+	return func(obj *unstructured.Unstructured) bool {
+		for _, m := range fieldMatchers {
+			if !m.re.MatchString(m.value(obj)) {
+				return false
+			}
+		}
+		return labelSelector.Matches(labels.Set(obj.GetLabels())) && annotationSelector.Matches(labels.Set(obj.GetAnnotations()))
+	}, nil
+}
+
+// postRenderPlugin passes the manifests to the given config management plugin and returns the manifests it generated.
+func postRenderPlugin(ctx context.Context, env *v1alpha1.Env, q *apiclient.ManifestRequest, gitCredsStore git.CredsStore, objs []*unstructured.Unstructured, plugin *v1alpha1.ApplicationSourcePlugin, opt *generateManifestOpt) ([]*unstructured.Unstructured, error) {
+	// The plugin reads its environment and parameters from the plugin of the source
+	pluginQuery := *q
+	pluginQuery.ApplicationSource = q.ApplicationSource.DeepCopy()
+	pluginQuery.ApplicationSource.Plugin = plugin
+
+	var result []*unstructured.Unstructured
+	err := withPostRenderManifests(objs, func(dir string) error {
+		var err error
+		result, err = runConfigManagementPluginSidecars(ctx, dir, dir, plugin.Name, env, &pluginQuery, q.Repo.GetGitCreds(gitCredsStore), nil, opt.cmpTarExcludedGlobs, false)
+		return err
+	})
+	return result, err
+}
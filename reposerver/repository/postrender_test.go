@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newPostRenderTestObj(apiVersion, kind, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	obj.SetLabels(labels)
+	return obj
+}
+
+func Test_newPostRenderTargetMatcher(t *testing.T) {
+	deployment := newPostRenderTestObj("apps/v1", "Deployment", "web", map[string]string{"tier": "frontend"})
+	service := newPostRenderTestObj("v1", "Service", "web-svc", nil)
+
+	tests := []struct {
+		name   string
+		target *v1alpha1.KustomizeSelector
+		want   []bool
+	}{
+		{"no target", nil, []bool{true, true}},
+		{"kind", &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{KustomizeGvk: v1alpha1.KustomizeGvk{Kind: "Deployment"}}}, []bool{true, false}},
+		{"core group", &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{KustomizeGvk: v1alpha1.KustomizeGvk{Group: "apps"}}}, []bool{true, false}},
+		{"anchored name", &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{Name: "web"}}, []bool{true, false}},
+		{"name regex", &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{Name: "web.*"}}, []bool{true, true}},
+		{"label selector", &v1alpha1.KustomizeSelector{LabelSelector: "tier=frontend"}, []bool{true, false}},
+		{"namespace", &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{Namespace: "kube-system"}}, []bool{false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := newPostRenderTargetMatcher(tt.target)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, []bool{matches(deployment), matches(service)})
+		})
+	}
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := newPostRenderTargetMatcher(&v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{Name: "("}})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid label selector", func(t *testing.T) {
+		_, err := newPostRenderTargetMatcher(&v1alpha1.KustomizeSelector{LabelSelector: "tier in ("})
+		require.ErrorContains(t, err, "invalid label selector")
+	})
+}
+
+func Test_postRenderJSONPatches(t *testing.T) {
+	objs := []*unstructured.Unstructured{
+		newPostRenderTestObj("apps/v1", "Deployment", "web", nil),
+		newPostRenderTestObj("v1", "Service", "web", nil),
+	}
+
+	patched, err := postRenderJSONPatches(objs, []v1alpha1.HelmPostRenderJSONPatch{{
+		Target: &v1alpha1.KustomizeSelector{KustomizeResId: v1alpha1.KustomizeResId{KustomizeGvk: v1alpha1.KustomizeGvk{Kind: "Deployment"}}},
+		Patch: `
+- op: add
+  path: /spec
+  value:
+    replicas: 3
+`,
+	}, {
+		Patch: `[{"op": "add", "path": "/metadata/labels", "value": {"team": "platform"}}]`,
+	}})
+	require.NoError(t, err)
+	require.Len(t, patched, 2)
+
+	replicas, found, err := unstructured.NestedInt64(patched[0].Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(3), replicas)
+	_, found, _ = unstructured.NestedFieldNoCopy(patched[1].Object, "spec")
+	assert.False(t, found)
+	for _, obj := range patched {
+		assert.Equal(t, map[string]string{"team": "platform"}, obj.GetLabels())
+	}
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, err := postRenderJSONPatches(objs, []v1alpha1.HelmPostRenderJSONPatch{{Patch: `{"op": "add"}`}})
+		require.ErrorContains(t, err, "error decoding JSON patch 0")
+	})
+
+	t.Run("failing operation", func(t *testing.T) {
+		_, err := postRenderJSONPatches(objs, []v1alpha1.HelmPostRenderJSONPatch{{Patch: `[{"op": "remove", "path": "/spec/missing"}]`}})
+		require.ErrorContains(t, err, "error applying JSON patch 0 to Deployment/web")
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -53,6 +54,7 @@ import (
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cue"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
@@ -64,6 +66,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
 
@@ -118,6 +121,7 @@ type RepoServerInitConstants struct {
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
 	EnableBuiltinGitConfig                       bool
+	ContentAddressedManifestCache                bool
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
@@ -292,6 +296,12 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// ociSignatureKeys are the keys the signatures of OCI sources and Helm charts in OCI registries must be verified
+	// with, if any
+	ociSignatureKeys []*v1alpha1.OCISignatureKey
+	// sparseCheckoutPaths are the directories a sparse checkout of a git repository is restricted to, if the
+	// repository has sparse checkouts enabled
+	sparseCheckoutPaths []string
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -345,13 +355,32 @@ func (s *Service) runRepoOperation(
 	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 	default:
-		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts)
+		if !repo.SparseCheckout {
+			settings.sparseCheckoutPaths = nil
+		}
+		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts, git.WithSparseCheckout(settings.sparseCheckoutPaths))
 	}
 
 	if err != nil {
 		return err
 	}
 
+	// The signatures are verified before looking up the cache, so that cached manifests are not served for artifacts
+	// whose signatures do not satisfy the keys of the project (anymore). A verified Helm chart is pulled by the
+	// verified digest, so that a tag moved after the verification is not rendered.
+	chartVersion := revision
+	if len(settings.ociSignatureKeys) > 0 {
+		switch {
+		case source.IsOCI():
+			err = verifyOCISignature(ctx, ociClient, repo.Repo, revision, settings.ociSignatureKeys)
+		case source.IsHelm() && (repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)):
+			chartVersion, err = s.verifyHelmOCISignature(ctx, repo, source.Chart, revision, settings.ociSignatureKeys)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	repoRefs, err := resolveReferencedSources(hasMultipleSources, source.Helm, refSources, s.newClientResolveRevision, gitClientOpts)
 	if err != nil {
 		return err
@@ -415,7 +444,7 @@ func (s *Service) runRepoOperation(
 		})
 	} else if source.IsHelm() {
 		if settings.noCache {
-			err = helmClient.CleanChartCache(source.Chart, revision)
+			err = helmClient.CleanChartCache(source.Chart, chartVersion)
 			if err != nil {
 				return err
 			}
@@ -424,7 +453,7 @@ func (s *Service) runRepoOperation(
 		if source.Helm != nil {
 			helmPassCredentials = source.Helm.PassCredentials
 		}
-		chartPath, closer, err := helmClient.ExtractChart(source.Chart, revision, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
+		chartPath, closer, err := helmClient.ExtractChart(source.Chart, chartVersion, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
 		if err != nil {
 			return err
 		}
@@ -449,7 +478,7 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, ""}, nil
 		})
 	}
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(gitClient.Root(), checkoutKey(revision, settings.sparseCheckoutPaths), settings.allowConcurrent, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled, repo.Depth)
 	})
 	if err != nil {
@@ -597,9 +626,11 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return res, err
 	}
 
+	cacheHit := false
 	cacheFn := func(cacheKey string, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, error) {
 		ok, resp, err := s.getManifestCacheEntry(cacheKey, q, refSourceCommitSHAs, firstInvocation)
 		res = resp
+		cacheHit = ok && err == nil
 		return ok, err
 	}
 
@@ -635,7 +666,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), ociSignatureKeys: q.OciSignatureKeys, sparseCheckoutPaths: getSparseCheckoutPaths(q.ApplicationSource.Path, q.AnnotationManifestGeneratePaths)}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -650,9 +681,36 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 			return nil, err
 		}
 	}
+	if err == nil && res != nil {
+		setManifestResponseTraces(res, q, cacheHit)
+	}
 	return res, err
 }
 
+// setManifestResponseTraces completes the traces of the response to an explain request with the source and the cache
+// status, and removes them from the responses to other requests. Traces are only recorded when an explain request
+// generates the manifests, so a trace is built from the cached response if the manifests were cached by another request.
+func setManifestResponseTraces(res *apiclient.ManifestResponse, q *apiclient.ManifestRequest, cacheHit bool) {
+	if !q.Explain {
+		res.Traces = nil
+		return
+	}
+	if len(res.Traces) == 0 {
+		res.Traces = []*apiclient.ManifestGenerationTrace{{
+			SourceType: res.SourceType,
+			Commands:   res.Commands,
+			CacheHit:   cacheHit,
+		}}
+	}
+	for _, trace := range res.Traces {
+		trace.RepoURL = q.ApplicationSource.RepoURL
+		trace.Path = q.ApplicationSource.Path
+		trace.Chart = q.ApplicationSource.Chart
+		trace.Revision = res.Revision
+		trace.CacheHit = trace.CacheHit || cacheHit
+	}
+}
+
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
 	workDir, err := files.CreateTempDir("")
 	if err != nil {
@@ -715,6 +773,9 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 		}
 	}
 
+	if res != nil {
+		setManifestResponseTraces(res, req, false)
+	}
 	err = stream.SendAndClose(res)
 	return err
 }
@@ -766,6 +827,8 @@ type repoRef struct {
 	commitSHA string
 	// key is the name of the key which was used to reference this repo.
 	key string
+	// root is the directory in which the repo is checked out.
+	root string
 }
 
 func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, cacheKey string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, ch *generateManifestCh) {
@@ -780,6 +843,11 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	repoRefs := make(map[string]repoRef)
 
 	var manifestGenResult *apiclient.ManifestResponse
+	// inputs records the files read to generate the manifests, if they are cached by content
+	var inputs *manifestInputs
+	// refSourceContentRevisions keys the referenced sources of manifests cached by content
+	var refSourceContentRevisions cache.ResolvedRevisions
+	contentCacheHit := false
 	opContext, err := opContextSrc()
 	if err == nil {
 		// Much of the multi-source handling logic is duplicated in resolveReferencedSources. If making changes here,
@@ -869,20 +937,27 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 							}
 						}
 
-						repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar}
+						repoRefs[normalizedRepoURL] = repoRef{revision: refSourceMapping.TargetRevision, commitSHA: referencedCommitSHA, key: refVar, root: gitClient.Root()}
 					}
 				}
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
-	}
-	refSourceCommitSHAs := make(map[string]string)
-	if len(repoRefs) > 0 {
-		for normalizedURL, repoRef := range repoRefs {
-			refSourceCommitSHAs[normalizedURL] = repoRef.commitSHA
+		if s.isContentAddressedManifestCacheEnabled(q) {
+			refSourceContentRevisions = getRefSourceContentRevisions(repoRefs, q)
+			if !q.NoCache {
+				manifestGenResult = s.getContentAddressedManifests(repoRoot, appSourceCopy, q, refSourceContentRevisions)
+				contentCacheHit = manifestGenResult != nil
+			}
+			if !contentCacheHit {
+				inputs = newManifestInputs(repoRoot)
+			}
+		}
+		if !contentCacheHit {
+			manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithTrace(q.Explain), withManifestInputs(inputs))
 		}
 	}
+	refSourceCommitSHAs := getRefSourceCommitSHAs(repoRefs)
 	if err != nil {
 		logCtx := log.WithFields(log.Fields{
 			"application":  q.AppName,
@@ -938,13 +1013,128 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
+	// The traces of explain requests are cached along with the manifests, so that further explain requests which hit
+	// the cache can return them
+	for _, trace := range manifestGenResult.Traces {
+		trace.RefSources = getResolvedRefSources(repoRefs, q.RefSources)
+	}
 	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
+	if contentCacheHit {
+		if q.Explain && len(manifestGenResult.Traces) == 0 {
+			manifestGenResult.Traces = []*apiclient.ManifestGenerationTrace{{
+				SourceType: manifestGenResult.SourceType,
+				Commands:   manifestGenResult.Commands,
+				RefSources: getResolvedRefSources(repoRefs, q.RefSources),
+			}}
+		}
+		for _, trace := range manifestGenResult.Traces {
+			trace.CacheHit = true
+		}
+	} else if inputs != nil {
+		s.setContentAddressedManifests(inputs, appSourceCopy, q, &manifestGenCacheEntry, refSourceContentRevisions)
+	}
 	ch.responseCh <- manifestGenCacheEntry.ManifestResponse
 }
 
+// isContentAddressedManifestCacheEnabled returns true if the manifests of the source are cached by the contents of the
+// files which were read to generate them, in addition to the commit SHA. Only sources in git repositories are cached by
+// content, since the revisions of Helm charts and OCI artifacts are immutable.
+func (s *Service) isContentAddressedManifestCacheEnabled(q *apiclient.ManifestRequest) bool {
+	return s.initConstants.ContentAddressedManifestCache && !q.ApplicationSource.IsHelm() && !q.ApplicationSource.IsOCI()
+}
+
+// getContentAddressedManifests returns the manifests cached for the contents of the files which were read the last
+// time the manifests of the source were generated, or nil if there are none.
+func (s *Service) getContentAddressedManifests(repoRoot string, appSource *v1alpha1.ApplicationSource, q *apiclient.ManifestRequest, refSourceRevisions cache.ResolvedRevisions) *apiclient.ManifestResponse {
+	logCtx := log.WithFields(log.Fields{
+		"application":  q.AppName,
+		"appNamespace": q.Namespace,
+	})
+	paths, err := s.cache.GetManifestInputs(appSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceRevisions, q.InstallationID)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			logCtx.Warnf("manifest inputs cache get error %s: %v", appSource.String(), err)
+		}
+		return nil
+	}
+	hash, err := hashManifestInputs(repoRoot, paths)
+	if err != nil {
+		logCtx.Warnf("failed to hash manifest inputs: %v", err)
+		return nil
+	}
+	res := cache.CachedManifestResponse{}
+	err = s.cache.GetManifests(contentRevision(hash), appSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceRevisions, q.InstallationID)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			logCtx.Warnf("manifest cache get error %s: %v", appSource.String(), err)
+		}
+		return nil
+	}
+	// Only successful generations are cached by content
+	if res.ManifestResponse == nil || res.FirstFailureTimestamp != 0 {
+		return nil
+	}
+	logCtx.WithField("contentHash", hash).Debug("manifest content cache hit")
+	return res.ManifestResponse
+}
+
+// setContentAddressedManifests caches the generated manifests by the contents of the files which were read to generate
+// them, unless those files are unknown.
+func (s *Service) setContentAddressedManifests(inputs *manifestInputs, appSource *v1alpha1.ApplicationSource, q *apiclient.ManifestRequest, entry *cache.CachedManifestResponse, refSourceRevisions cache.ResolvedRevisions) {
+	logCtx := log.WithFields(log.Fields{
+		"application":  q.AppName,
+		"appNamespace": q.Namespace,
+	})
+	paths := inputs.list()
+	if paths == nil || inputs.hash == "" {
+		logCtx.Debugf("not caching manifests by content: %s", inputs.untrackedReason)
+		return
+	}
+	err := s.cache.SetManifestInputs(appSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceRevisions, q.InstallationID, paths)
+	if err != nil {
+		logCtx.Warnf("manifest inputs cache set error %s: %v", appSource.String(), err)
+		return
+	}
+	err = s.cache.SetManifests(contentRevision(inputs.hash), appSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, entry, refSourceRevisions, q.InstallationID)
+	if err != nil {
+		logCtx.Warnf("manifest cache set error %s: %v", appSource.String(), err)
+	}
+}
+
+// getRefSourceCommitSHAs returns the commit SHAs of the referenced sources by their normalized repository URL.
+func getRefSourceCommitSHAs(repoRefs map[string]repoRef) cache.ResolvedRevisions {
+	refSourceCommitSHAs := make(map[string]string)
+	for normalizedURL, repoRef := range repoRefs {
+		refSourceCommitSHAs[normalizedURL] = repoRef.commitSHA
+	}
+	return refSourceCommitSHAs
+}
+
+// getResolvedRefSources returns the referenced sources which were checked out to generate the manifests, sorted by
+// their ref.
+func getResolvedRefSources(repoRefs map[string]repoRef, refSources map[string]*v1alpha1.RefTarget) []*apiclient.ResolvedRefSource {
+	resolved := make([]*apiclient.ResolvedRefSource, 0, len(repoRefs))
+	for normalizedRepoURL, ref := range repoRefs {
+		repoURL := normalizedRepoURL
+		if refSource, ok := refSources[ref.key]; ok {
+			repoURL = refSource.Repo.Repo
+		}
+		resolved = append(resolved, &apiclient.ResolvedRefSource{
+			Ref:            ref.key,
+			RepoURL:        repoURL,
+			TargetRevision: ref.revision,
+			Revision:       ref.commitSHA,
+		})
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].Ref < resolved[j].Ref
+	})
+	return resolved
+}
+
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, e.g.:
 // - If the cache result is empty for the requested key
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (e.g. res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
@@ -1184,7 +1374,9 @@ func parseKubeVersion(version string) (string, error) {
 	return kubeVersion.String(), nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+// helmTemplate runs helm template for the chart at the app path. If trace is not nil, the resolved value files are added
+// to it.
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, trace *apiclient.ManifestGenerationTrace) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1226,6 +1418,12 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		}
 
 		templateOpts.Values = resolvedValueFiles
+		if trace != nil {
+			for _, valueFile := range resolvedValueFiles {
+				// Charts are extracted to a temp dir which is not one of the git repo paths
+				trace.ValueFiles = append(trace.ValueFiles, strings.ReplaceAll(redactPaths(string(valueFile), gitRepoPaths, ""), repoRoot, "."))
+			}
+		}
 
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
@@ -1458,6 +1656,8 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		trace                       bool
+		manifestInputs              *manifestInputs
 	}
 )
 
@@ -1494,6 +1694,21 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithTrace enables recording how the manifests are generated in the traces of the response, which is otherwise
+// only done for explain requests.
+func WithTrace(enabled bool) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.trace = enabled
+	}
+}
+
+// withManifestInputs records the files which are read to generate the manifests in the given inputs.
+func withManifestInputs(inputs *manifestInputs) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.manifestInputs = inputs
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1503,10 +1718,16 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 
 	env := newEnv(q, revision)
 
+	var trace *apiclient.ManifestGenerationTrace
+	if q.Explain || opt.trace {
+		trace = &apiclient.ManifestGenerationTrace{Env: env.Environ()}
+	}
+
 	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, repoRoot, q.AppName, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs, env.Environ())
 	if err != nil {
 		return nil, fmt.Errorf("error getting app source type: %w", err)
 	}
+	recordManifestInputs(opt.manifestInputs, appSourceType, appPath, env, q)
 	repoURL := ""
 	if q.Repo != nil {
 		repoURL = q.Repo.Repo
@@ -1517,8 +1738,13 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths, trace)
 		commands = append(commands, command)
+		if err == nil && !q.ApplicationSource.Helm.PostRender.IsZero() {
+			var postRenderCommands []string
+			targetObjs, postRenderCommands, err = helmPostRender(ctx, appPath, repoRoot, env, q, gitCredsStore, targetObjs, opt)
+			commands = append(commands, postRenderCommands...)
+		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
 		kustomizeBinary, err = settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
@@ -1545,6 +1771,10 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if err != nil {
 			err = fmt.Errorf("CMP processing failed for application %q: %w", q.AppName, err)
 		}
+	case v1alpha1.ApplicationSourceTypeCUE:
+		var command string
+		targetObjs, command, err = cue.NewCUEApp(repoRoot, appPath).Export(ctx, q.ApplicationSource.CUE, env)
+		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
 		if directory = q.ApplicationSource.Directory; directory == nil {
@@ -1598,11 +1828,17 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		}
 	}
 
-	return &apiclient.ManifestResponse{
+	res := &apiclient.ManifestResponse{
 		Manifests:  manifests,
 		SourceType: string(appSourceType),
 		Commands:   commands,
-	}, nil
+	}
+	if trace != nil {
+		trace.SourceType = string(appSourceType)
+		trace.Commands = commands
+		res.Traces = []*apiclient.ManifestGenerationTrace{trace}
+	}
+	return res, nil
 }
 
 func newEnv(q *apiclient.ManifestRequest, revision string) *v1alpha1.Env {
@@ -2202,12 +2438,16 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			if err := populatePluginAppDetails(ctx, res, opContext.appPath, repoRoot, q, s.initConstants.CMPTarExcludedGlobs); err != nil {
 				return fmt.Errorf("failed to populate plugin app details: %w", err)
 			}
+		case v1alpha1.ApplicationSourceTypeCUE:
+			if err := populateCUEAppDetails(res, opContext.appPath, repoRoot, q); err != nil {
+				return fmt.Errorf("failed to populate CUE app details: %w", err)
+			}
 		}
 		_ = s.cache.SetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil)
 		return nil
 	}
 
-	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache}
+	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache, sparseCheckoutPaths: getSparseCheckoutPaths(q.Source.Path, q.AnnotationManifestGeneratePaths)}
 	err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
 
 	return res, err
@@ -2400,6 +2640,18 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	return nil
 }
 
+func populateCUEAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, repoRoot string, q *apiclient.RepoServerAppDetailsQuery) error {
+	tags, err := cue.NewCUEApp(repoRoot, appPath).Tags(q.Source.CUE)
+	if err != nil {
+		return err
+	}
+	res.Cue = &apiclient.CUEAppSpec{Tags: make([]*v1alpha1.CUETag, len(tags))}
+	for i := range tags {
+		res.Cue.Tags[i] = &tags[i]
+	}
+	return nil
+}
+
 func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
 	if !git.IsCommitSHA(q.Revision) && !git.IsTruncatedCommitSHA(q.Revision) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
@@ -2459,8 +2711,9 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 		}
 
 		if cs != "" {
-			vr := gpg.ParseGitCommitVerification(cs)
-			if vr.Result == gpg.VerifyResultUnknown {
+			if svr, ok := sshsigning.ParseGitCommitVerification(cs); ok {
+				signatureInfo = fmt.Sprintf("%s signature from %s SSH key %s", svr.Result, svr.KeyType, svr.Fingerprint)
+			} else if vr := gpg.ParseGitCommitVerification(cs); vr.Result == gpg.VerifyResultUnknown {
 				signatureInfo = "UNKNOWN signature: " + vr.Message
 			} else {
 				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
@@ -2506,18 +2759,81 @@ func (s *Service) GetOCIMetadata(ctx context.Context, q *apiclient.RepoServerRev
 
 	a := metadata.Annotations
 
+	var signatureVerification string
+	if len(q.OciSignatureKeys) > 0 {
+		signatureVerification, err = client.VerifySignature(ctx, q.Revision, ociSignatureKeys(q.OciSignatureKeys))
+		switch {
+		case errors.Is(err, oci.ErrSignatureNotVerified):
+			signatureVerification = ociSignatureVerificationFailed + err.Error()
+		case err != nil:
+			log.WithFields(log.Fields{"repo": q.Repo.Repo, "digest": q.Revision}).Warnf("failed to verify oci signatures: %v", err)
+			signatureVerification = ociSignatureVerificationFailed + "signatures could not be checked, see repo-server logs for details"
+		}
+	}
+
 	return &v1alpha1.OCIMetadata{
 		CreatedAt: a["org.opencontainers.image.created"],
 		Authors:   a["org.opencontainers.image.authors"],
 		// TODO: add this field at a later stage
 		// ImageURL:    a["org.opencontainers.image.url"],
-		DocsURL:     a["org.opencontainers.image.documentation"],
-		SourceURL:   a["org.opencontainers.image.source"],
-		Version:     a["org.opencontainers.image.version"],
-		Description: a["org.opencontainers.image.description"],
+		DocsURL:               a["org.opencontainers.image.documentation"],
+		SourceURL:             a["org.opencontainers.image.source"],
+		Version:               a["org.opencontainers.image.version"],
+		Description:           a["org.opencontainers.image.description"],
+		SignatureVerification: signatureVerification,
 	}, nil
 }
 
+// ociSignatureVerificationFailed prefixes the signature verification status of OCI artifacts which could not be verified
+const ociSignatureVerificationFailed = "Verification failed: "
+
+// ociSignatureKeys converts the OCI signature keys of a project to the keys the OCI client verifies signatures with.
+func ociSignatureKeys(keys []*v1alpha1.OCISignatureKey) []oci.SignatureKey {
+	res := make([]oci.SignatureKey, 0, len(keys))
+	for _, key := range keys {
+		if key == nil {
+			continue
+		}
+		res = append(res, oci.SignatureKey{Type: key.Type, PublicKey: key.PublicKey})
+	}
+	return res
+}
+
+// verifyOCISignature verifies that the OCI artifact with the given digest is signed with one of the keys.
+func verifyOCISignature(ctx context.Context, client oci.Client, repoURL, digest string, keys []*v1alpha1.OCISignatureKey) error {
+	result, err := client.VerifySignature(ctx, digest, ociSignatureKeys(keys))
+	if err != nil {
+		log.WithFields(log.Fields{
+			common.SecurityField: common.SecurityMedium,
+			"repo":               repoURL,
+			"digest":             digest,
+		}).Warnf("oci signature verification failed: %v", err)
+		return fmt.Errorf("signature verification failed for %s@%s: %w", repoURL, digest, err)
+	}
+	log.WithFields(log.Fields{"repo": repoURL, "digest": digest}).Debug(result)
+	return nil
+}
+
+// verifyHelmOCISignature verifies that the version of a Helm chart in an OCI registry is signed with one of the keys.
+// It returns the verified digest of the chart, which the chart must be pulled by.
+func (s *Service) verifyHelmOCISignature(ctx context.Context, repo *v1alpha1.Repository, chart, version string, keys []*v1alpha1.OCISignatureKey) (string, error) {
+	chartURL := fmt.Sprintf("oci://%s/%s", strings.TrimPrefix(repo.Repo, "oci://"), chart)
+	client, err := s.newOCIClient(chartURL, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.initConstants.OCIMediaTypes, s.ociClientStandardOpts()...)
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize oci client: %w", err)
+	}
+	// By convention, Helm stores "+" in versions as "_" in OCI tags
+	digest, err := client.ResolveRevision(ctx, strings.ReplaceAll(version, "+", "_"), true)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve chart version %q: %w", version, err)
+	}
+	err = verifyOCISignature(ctx, client, chartURL, digest, keys)
+	if err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
 // GetRevisionChartDetails returns the helm chart details of a given version
 func (s *Service) GetRevisionChartDetails(_ context.Context, q *apiclient.RepoServerRevisionChartDetailsRequest) (*v1alpha1.ChartDetails, error) {
 	details, err := s.cache.GetRevisionChartDetails(q.Repo.Repo, q.Name, q.Revision)
@@ -2570,7 +2886,10 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	}
 	opts = append(opts,
 		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
-		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig),
+		git.WithPartialClone(repo.SparseCheckout),
+		git.WithMirror(repo.MirrorURL, repo.GetMirrorMaxStaleness()),
+		git.WithBundleURI(repo.BundleURI))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2660,6 +2979,15 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 	})
 }
 
+// checkoutKey returns the key under which a checkout of the given revision, restricted to the given sparse checkout
+// paths, is locked. Operations can only share a checkout of the same revision and paths.
+func checkoutKey(revision string, sparseCheckoutPaths []string) string {
+	if len(sparseCheckoutPaths) == 0 {
+		return revision
+	}
+	return revision + ":" + strings.Join(sparseCheckoutPaths, ";")
+}
+
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
 func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool, depth int64) (goio.Closer, error) {
@@ -2938,6 +3266,13 @@ func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision str
 		if cs == "" {
 			return fmt.Errorf("revision %s is not signed", revision)
 		}
+		if svr, ok := sshsigning.ParseGitCommitVerification(cs); ok {
+			if svr.Result != sshsigning.VerifyResultGood {
+				return fmt.Errorf("%s SSH signature: %s", strings.ToUpper(svr.Result), svr.Message)
+			}
+			log.Debugf("%s signature from %s SSH key %s", svr.Result, svr.KeyType, svr.Fingerprint)
+			return nil
+		}
 		vr := gpg.ParseGitCommitVerification(cs)
 		if vr.Result == gpg.VerifyResultUnknown {
 			return fmt.Errorf("UNKNOWN signature: %s", vr.Message)
@@ -239,6 +239,163 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+func TestGenerateManifest_Explain(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	src := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git", Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		AppName:            "concatenated",
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+		Explain:            true,
+	}
+
+	res, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	trace := res.Traces[0]
+	assert.Equal(t, "https://github.com/argoproj/argo-cd.git", trace.RepoURL)
+	assert.Equal(t, ".", trace.Path)
+	assert.Equal(t, res.Revision, trace.Revision)
+	assert.Equal(t, string(v1alpha1.ApplicationSourceTypeDirectory), trace.SourceType)
+	assert.Contains(t, trace.Env, "ARGOCD_APP_NAME=concatenated")
+	assert.False(t, trace.CacheHit)
+
+	res, err = service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	assert.Equal(t, string(v1alpha1.ApplicationSourceTypeDirectory), res.Traces[0].SourceType)
+	assert.Contains(t, res.Traces[0].Env, "ARGOCD_APP_NAME=concatenated")
+	assert.True(t, res.Traces[0].CacheHit)
+
+	q.Explain = false
+	res, err = service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	assert.Empty(t, res.Traces)
+}
+
+func TestGenerateManifest_ExplainCachedWithoutTraces(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	src := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git", Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		AppName:            "concatenated",
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	// Traces are not recorded, nor cached, for requests which do not explain the manifest generation.
+	res, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	assert.Empty(t, res.Traces)
+	cached := cache.CachedManifestResponse{}
+	require.NoError(t, service.cache.GetManifests(res.Revision, &src, q.RefSources, &q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cached, nil, q.InstallationID))
+	assert.Empty(t, cached.ManifestResponse.Traces)
+
+	// The cached manifests have no traces, so the trace of an explain request is built from the cached response.
+	q.Explain = true
+	res, err = service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	assert.True(t, res.Traces[0].CacheHit)
+	assert.Equal(t, "Directory", res.Traces[0].SourceType)
+	assert.Equal(t, "https://github.com/argoproj/argo-cd.git", res.Traces[0].RepoURL)
+	assert.Empty(t, res.Traces[0].Env)
+
+	// The manifests are only generated again, and their generation traced, if the cache is bypassed.
+	q.NoCache = true
+	res, err = service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	assert.Contains(t, res.Traces[0].Env, "ARGOCD_APP_NAME=concatenated")
+	assert.False(t, res.Traces[0].CacheHit)
+}
+
+func TestGenerateManifest_ContentAddressedManifestCache(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "app"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "other"), 0o755))
+	configMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\n"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "cm.yaml"), fmt.Appendf(nil, configMap, "app"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "other", "cm.yaml"), fmt.Appendf(nil, configMap, "other"), 0o644))
+
+	var checkedOut string
+	service, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+		gitClient.EXPECT().Init().Return(nil)
+		gitClient.EXPECT().IsRevisionPresent(mock.Anything).Return(false)
+		gitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil)
+		gitClient.EXPECT().Checkout(mock.Anything, mock.Anything).RunAndReturn(func(revision string, _ bool) (string, error) {
+			checkedOut = revision
+			return "", nil
+		})
+		gitClient.EXPECT().LsRemote(mock.Anything).RunAndReturn(func(revision string) (string, error) {
+			return revision, nil
+		})
+		gitClient.EXPECT().CommitSHA().RunAndReturn(func() (string, error) {
+			return checkedOut, nil
+		})
+		gitClient.EXPECT().Root().Return(root)
+		paths.EXPECT().GetPath(mock.Anything).Return(root, nil)
+		paths.EXPECT().GetPathIfExists(mock.Anything).Return(root)
+		paths.EXPECT().GetPaths().Return(map[string]string{"fake-nonce": root})
+	}, root)
+	service.initConstants.ContentAddressedManifestCache = true
+
+	generate := func(revision string) *apiclient.ManifestResponse {
+		t.Helper()
+		src := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git", Path: "app"}
+		res, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{},
+			AppName:            "app",
+			ApplicationSource:  &src,
+			Revision:           revision,
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+			Explain:            true,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Traces, 1)
+		return res
+	}
+
+	res := generate("1111111111111111111111111111111111111111")
+	assert.False(t, res.Traces[0].CacheHit)
+
+	// A commit which does not change the files of the app
+	require.NoError(t, os.WriteFile(filepath.Join(root, "other", "cm.yaml"), fmt.Appendf(nil, configMap, "changed"), 0o644))
+	res = generate("2222222222222222222222222222222222222222")
+	assert.True(t, res.Traces[0].CacheHit)
+	assert.Equal(t, "2222222222222222222222222222222222222222", res.Revision)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"app"`)
+
+	// A commit which changes the files of the app
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "cm.yaml"), fmt.Appendf(nil, configMap, "changed"), 0o644))
+	res = generate("3333333333333333333333333333333333333333")
+	assert.False(t, res.Traces[0].CacheHit)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"changed"`)
+}
+
+func Test_getResolvedRefSources(t *testing.T) {
+	repoRefs := map[string]repoRef{
+		"https://github.com/org/values": {revision: "main", commitSHA: "abc123", key: "$values"},
+		"https://github.com/org/base":   {revision: "v1.0.0", commitSHA: "def456", key: "$base"},
+	}
+	refSources := map[string]*v1alpha1.RefTarget{
+		"$values": {Repo: v1alpha1.Repository{Repo: "https://github.com/org/values.git"}, TargetRevision: "main"},
+	}
+
+	assert.Equal(t, []*apiclient.ResolvedRefSource{
+		{Ref: "$base", RepoURL: "https://github.com/org/base", TargetRevision: "v1.0.0", Revision: "def456"},
+		{Ref: "$values", RepoURL: "https://github.com/org/values.git", TargetRevision: "main", Revision: "abc123"},
+	}, getResolvedRefSources(repoRefs, refSources))
+}
+
 func Test_GenerateManifest_KustomizeWithVersionOverride(t *testing.T) {
 	t.Parallel()
 
@@ -1726,6 +1883,23 @@ func TestGetAppDetailsKustomize(t *testing.T) {
 	assert.Equal(t, []string{"nginx:1.15.4", "registry.k8s.io/nginx-slim:0.8"}, res.Kustomize.Images)
 }
 
+func TestGetAppDetailsCUE(t *testing.T) {
+	service := newService(t, "../../util/app/discovery/testdata/qux")
+
+	res, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
+		Repo: &v1alpha1.Repository{},
+		Source: &v1alpha1.ApplicationSource{
+			Path: ".",
+			CUE:  &v1alpha1.ApplicationSourceCUE{Tags: []v1alpha1.CUETag{{Name: "env", Value: "prod"}}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "CUE", res.Type)
+	require.NotNil(t, res.Cue)
+	assert.Equal(t, []*v1alpha1.CUETag{{Name: "env", Value: "dev"}}, res.Cue.Tags)
+}
+
 func TestGetAppDetailsKustomize_CustomVersion(t *testing.T) {
 	service := newService(t, "../../util/kustomize/testdata/kustomize-with-version-override")
 
@@ -4604,3 +4778,47 @@ func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	// verify that newGitClient was never invoked
 	assert.False(t, gitCalled, "GenerateManifest should not invoke Git for OCI sources")
 }
+
+func TestGenerateManifest_OCISignatureVerification(t *testing.T) {
+	const digest = "sha256:2f0fbe3c8b3e8e2b2c5b1c0b5fd7d2e1c1e1f1a1b1c1d1e1f1a1b1c1d1e1f1a1"
+	keys := []*v1alpha1.OCISignatureKey{{Type: v1alpha1.OCISignatureKeyTypeCosign, PublicKey: "key"}}
+	newRequest := func() *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{Repo: "oci://example.com/foo"},
+			ApplicationSource:  &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/foo", TargetRevision: "v1"},
+			NoCache:            true,
+			ProjectName:        "foo-project",
+			ProjectSourceRepos: []string{"*"},
+			OciSignatureKeys:   keys,
+		}
+	}
+
+	t.Run("rejects artifacts failing verification", func(t *testing.T) {
+		var ociClient *ocimocks.Client
+		svc, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, c *ocimocks.Client, _ *iomocks.TempPaths) {
+			ociClient = c
+			c.EXPECT().ResolveRevision(mock.Anything, "v1", mock.Anything).Return(digest, nil)
+			c.EXPECT().VerifySignature(mock.Anything, digest, []oci.SignatureKey{{Type: "cosign", PublicKey: "key"}}).
+				Return("", fmt.Errorf("%w for digest %s", oci.ErrSignatureNotVerified, digest))
+		}, t.TempDir())
+
+		_, err := svc.GenerateManifest(t.Context(), newRequest())
+		require.ErrorIs(t, err, oci.ErrSignatureNotVerified)
+		ociClient.AssertNotCalled(t, "Extract", mock.Anything, mock.Anything)
+	})
+
+	t.Run("generates manifests for verified artifacts", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cm.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"), 0o644))
+		svc, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, c *ocimocks.Client, _ *iomocks.TempPaths) {
+			c.EXPECT().ResolveRevision(mock.Anything, "v1", mock.Anything).Return(digest, nil)
+			c.EXPECT().VerifySignature(mock.Anything, digest, mock.Anything).Return("Valid cosign signature from key SHA256:abc", nil)
+			c.EXPECT().CleanCache(digest).Return(nil)
+			c.EXPECT().Extract(mock.Anything, digest).Return(dir, utilio.NopCloser, nil)
+		}, t.TempDir())
+
+		res, err := svc.GenerateManifest(t.Context(), newRequest())
+		require.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+	})
+}
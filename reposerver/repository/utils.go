@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
@@ -83,3 +85,35 @@ func getPaths(q *apiclient.ManifestRequest, appPath, repoPath string) []string {
 	}
 	return paths
 }
+
+// getSparseCheckoutPaths returns the directories, relative to the repository root, which must be checked out to
+// generate the manifests of the application at appPath. These are the app path and the paths of the
+// manifest-generate-paths annotation. Glob patterns are truncated to the directory they match in. Nil is returned if
+// the whole repository must be checked out.
+func getSparseCheckoutPaths(appPath string, annotationPaths string) []string {
+	paths := []string{appPath}
+	for _, annotationPath := range strings.Split(annotationPaths, ";") {
+		if annotationPath == "" {
+			continue
+		}
+		if !path.IsAbs(annotationPath) {
+			annotationPath = path.Join(appPath, annotationPath)
+		}
+		paths = append(paths, annotationPath)
+	}
+
+	dirs := make([]string, 0, len(paths))
+	for _, p := range paths {
+		// Paths must not escape the repository root
+		p = strings.TrimPrefix(path.Clean("/"+p), "/")
+		if i := strings.IndexAny(p, "*?[\\"); i >= 0 {
+			p = path.Dir(p[:i+1])
+		}
+		if p == "" || p == "." {
+			return nil
+		}
+		dirs = append(dirs, p)
+	}
+	slices.Sort(dirs)
+	return slices.Compact(dirs)
+}
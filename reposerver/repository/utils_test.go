@@ -44,3 +44,27 @@ func TestGetCommonRootPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSparseCheckoutPaths(t *testing.T) {
+	tests := []struct {
+		name          string
+		appPath       string
+		annotation    string
+		expectedPaths []string
+	}{
+		{"app path", "services/helloworld", "", []string{"services/helloworld"}},
+		{"repository root", ".", "", nil},
+		{"app path and relative", "services/helloworld", "../../overlays;.", []string{"overlays", "services/helloworld"}},
+		{"app path and absolute path", "services/helloworld", "/base", []string{"base", "services/helloworld"}},
+		{"annotation at repository root", "services/helloworld", "/", nil},
+		{"escaping repository root", "services/helloworld", "../../../overlays", []string{"overlays", "services/helloworld"}},
+		{"glob", "services/helloworld", "/services/shared/*-secret.yaml", []string{"services/helloworld", "services/shared"}},
+		{"glob at repository root", "services/helloworld", "/*.yaml", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPaths, getSparseCheckoutPaths(tt.appPath, tt.annotation))
+		})
+	}
+}
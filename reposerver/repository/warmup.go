@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	goio "io"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// warmUpLockKeyPrefix prefixes the revision the repository is locked with while a revision is fetched for the warm-up.
+// The prefix makes sure that no other operation shares the lock, since the revision is fetched, but not checked out.
+const warmUpLockKeyPrefix = "warm-up:"
+
+// WarmUp fetches the requested revision of the Git repository. The application controller calls it for the revisions
+// referenced by its applications, so that the repo server does not need to access the Kubernetes API.
+func (s *Service) WarmUp(ctx context.Context, q *apiclient.WarmUpRequest) (*apiclient.WarmUpResponse, error) {
+	if q.Repo == nil {
+		return nil, errors.New("repository is required")
+	}
+	start := time.Now()
+	err := s.WarmUpRevision(ctx, q.Repo, q.Revision)
+	s.metricsServer.IncWarmUpFetch(q.Repo.Repo, err != nil)
+	s.metricsServer.ObserveWarmUpDuration(time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	return &apiclient.WarmUpResponse{}, nil
+}
+
+// WarmUpRevision resolves the given revision of the Git repository and fetches it into the local clone of the
+// repository, so that operations on the revision do not have to fetch it anymore. The resolved revision is cached like
+// the revisions resolved by any other operation.
+func (s *Service) WarmUpRevision(ctx context.Context, repo *v1alpha1.Repository, revision string) error {
+	gitClient, commitSHA, err := s.newClientResolveRevision(repo, revision, git.WithCache(s.cache, true))
+	if err != nil {
+		return fmt.Errorf("failed to resolve revision %q: %w", revision, err)
+	}
+
+	s.metricsServer.IncPendingRepoRequest(repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
+
+	if s.parallelismLimitSemaphore != nil {
+		if err := s.parallelismLimitSemaphore.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		defer s.parallelismLimitSemaphore.Release(1)
+	}
+
+	closer, err := s.repoLock.Lock(gitClient.Root(), warmUpLockKeyPrefix+commitSHA, false, func() (goio.Closer, error) {
+		closer := s.gitRepoInitializer(gitClient.Root())
+		if err := s.fetchForWarmUp(gitClient, commitSHA, repo.Depth); err != nil {
+			utilio.Close(closer)
+			return nil, err
+		}
+		return closer, nil
+	})
+	if err != nil {
+		return err
+	}
+	return closer.Close()
+}
+
+// fetchForWarmUp initializes the local clone of the repository and fetches the given commit, unless it is present
+// already. Shallow clones only fetch the commit up to the configured depth.
+func (s *Service) fetchForWarmUp(gitClient git.Client, commitSHA string, depth int64) error {
+	if err := gitClient.Init(); err != nil {
+		return fmt.Errorf("failed to initialize git repo: %w", err)
+	}
+	if depth <= 0 {
+		return s.fetch(gitClient, []string{commitSHA})
+	}
+	if gitClient.IsRevisionPresent(commitSHA) {
+		return nil
+	}
+	if err := gitClient.Fetch(commitSHA, depth); err != nil {
+		s.metricsServer.IncGitFetchFail(gitClient.Root(), commitSHA)
+		return fmt.Errorf("failed to fetch revision %s: %w", commitSHA, err)
+	}
+	return nil
+}
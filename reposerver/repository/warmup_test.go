@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestWarmUpRevision(t *testing.T) {
+	t.Run("Full clone", func(t *testing.T) {
+		service, gitClient, _ := newServiceWithMocks(t, ".", false)
+		err := service.WarmUpRevision(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/fake/repo.git"}, "HEAD")
+		require.NoError(t, err)
+		gitClient.AssertCalled(t, "LsRemote", "HEAD")
+		gitClient.AssertCalled(t, "Init")
+		gitClient.AssertCalled(t, "Fetch", "", int64(0))
+		gitClient.AssertNotCalled(t, "Checkout", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Shallow clone", func(t *testing.T) {
+		service, gitClient, _ := newServiceWithMocks(t, ".", false)
+		err := service.WarmUpRevision(t.Context(), &v1alpha1.Repository{Repo: "https://github.com/fake/repo.git", Depth: 1}, "main")
+		require.NoError(t, err)
+		gitClient.AssertCalled(t, "Fetch", mock.Anything, int64(1))
+	})
+}
+
+func TestWarmUp(t *testing.T) {
+	service, gitClient, _ := newServiceWithMocks(t, ".", false)
+	_, err := service.WarmUp(t.Context(), &apiclient.WarmUpRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/fake/repo.git"}, Revision: "HEAD"})
+	require.NoError(t, err)
+	gitClient.AssertCalled(t, "Fetch", "", int64(0))
+
+	_, err = service.WarmUp(t.Context(), &apiclient.WarmUpRequest{Revision: "HEAD"})
+	require.Error(t, err)
+}
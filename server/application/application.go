@@ -589,11 +589,13 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				EnabledSourceTypes:              enableGenerateManifests,
 				ProjectName:                     proj.Name,
 				ProjectSourceRepos:              proj.Spec.SourceRepos,
+				OciSignatureKeys:                proj.GetOCISignatureKeys(),
 				HasMultipleSources:              a.Spec.HasMultipleSources(),
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
 				NoCache:                         q.NoCache != nil && *q.NoCache,
+				Explain:                         q.GetExplain(),
 			})
 			if err != nil {
 				return fmt.Errorf("error generating manifests: %w", err)
@@ -627,6 +629,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			}
 		}
 		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		manifests.Traces = append(manifests.Traces, manifestInfo.Traces...)
 	}
 
 	return manifests, nil
@@ -711,6 +714,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			EnabledSourceTypes:              enableGenerateManifests,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
+			OciSignatureKeys:                proj.GetOCISignatureKeys(),
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 		}
 
@@ -828,15 +832,16 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 				return fmt.Errorf("error getting trackingMethod from settings: %w", err)
 			}
 			_, err = client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-				Repo:               repo,
-				Source:             &source,
-				AppName:            appName,
-				KustomizeOptions:   kustomizeSettings,
-				Repos:              helmRepos,
-				NoCache:            true,
-				TrackingMethod:     trackingMethod,
-				EnabledSourceTypes: enabledSourceTypes,
-				HelmOptions:        helmOptions,
+				Repo:                            repo,
+				Source:                          &source,
+				AppName:                         appName,
+				KustomizeOptions:                kustomizeSettings,
+				Repos:                           helmRepos,
+				NoCache:                         true,
+				TrackingMethod:                  trackingMethod,
+				EnabledSourceTypes:              enabledSourceTypes,
+				HelmOptions:                     helmOptions,
+				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			})
 			return err
 		}); err != nil {
@@ -1620,7 +1625,7 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
 		Repo:           repo,
 		Revision:       q.GetRevision(),
-		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+		CheckSignature: proj.HasSignatureKeys(),
 	})
 }
 
@@ -1677,9 +1682,10 @@ func (s *Server) GetOCIMetadata(ctx context.Context, q *application.RevisionMeta
 	defer utilio.Close(conn)
 
 	return repoClient.GetOCIMetadata(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
-		Repo:     repo,
-		Name:     source.Chart,
-		Revision: q.GetRevision(),
+		Repo:             repo,
+		Name:             source.Chart,
+		Revision:         q.GetRevision(),
+		OciSignatureKeys: proj.GetOCISignatureKeys(),
 	})
 }
 
@@ -2063,7 +2069,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+	if syncReq.Manifests != nil && proj.HasSignatureKeys() {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
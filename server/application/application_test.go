@@ -2726,6 +2726,26 @@ func TestGetManifests_WithNoCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetManifests_WithExplain(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	trace := &apiclient.ManifestGenerationTrace{SourceType: "Helm", Commands: []string{"helm template . --name-template test"}}
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+		return mr.Explain
+	})).Return(&apiclient.ManifestResponse{Traces: []*apiclient.ManifestGenerationTrace{trace}}, nil)
+
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	res, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+		Name:    &testApp.Name,
+		Explain: ptr.To(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*apiclient.ManifestGenerationTrace{trace}, res.Traces)
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -0,0 +1,404 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+// Hydrate requests the hydration of the manifests of an application using a source hydrator. The hydration is performed
+// by the application controller, and commits the hydrated manifests even if the dry revision was already hydrated.
+func (s *Server) Hydrate(ctx context.Context, q *application.ApplicationHydrateRequest) (*v1alpha1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s does not use a source hydrator", a.QualifiedName())
+	}
+
+	revision, dryRevisions, err := getHydrateRevision(a, q.GetDryRevision(), q.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace)
+	a, err = argo.HydrateApp(appIf, a.Name, revision, dryRevisions)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting hydration: %w", err)
+	}
+	message := "requested hydration"
+	if revision != "" {
+		message = "requested hydration of revision " + revision
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, message)
+	return a, nil
+}
+
+// getHydrateRevision returns the dry revision requested to be hydrated, which is either given directly or as the ID of
+// an entry of the hydrate history of the application. It returns an empty string for the target revision of the dry
+// source. For an entry of the hydrate history, it also returns the revisions of each of the dry sources of that
+// hydration, so that the other dry sources are hydrated from the same revisions as well.
+func getHydrateRevision(a *v1alpha1.Application, revision string, id *int64) (string, []string, error) {
+	if id == nil {
+		return revision, nil, nil
+	}
+	if revision != "" {
+		return "", nil, status.Errorf(codes.InvalidArgument, "only one of dry revision and hydrate history ID can be specified")
+	}
+	for _, info := range a.Status.SourceHydrator.HydrateHistory {
+		if info.ID == *id {
+			return info.DrySHA, info.DryRevisions, nil
+		}
+	}
+	return "", nil, status.Errorf(codes.InvalidArgument, "application %s does not have hydration with id %v", a.QualifiedName(), *id)
+}
+
+// HydrateDryRun renders the hydrated manifests of an application using a source hydrator for the given dry revision and
+// returns a unified diff against the manifests currently committed to the hydrateTo branch, or the sync branch if no
+// hydrateTo branch is configured. Nothing is committed.
+func (s *Server) HydrateDryRun(ctx context.Context, q *application.ApplicationHydrateDryRunRequest) (*application.ApplicationHydrateDryRunResponse, error) {
+	if q.Name == nil || *q.Name == "" {
+		return nil, errors.New("invalid request: application name is missing")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	if a.Spec.SourceHydrator == nil {
+		return nil, fmt.Errorf("application %q does not use a source hydrator", a.QualifiedName())
+	}
+	syncSource := a.Spec.SourceHydrator.SyncSource
+	if syncSource.IsOCI() {
+		return nil, status.Errorf(codes.FailedPrecondition, "hydration dry run is not supported for applications hydrating to an OCI repository")
+	}
+
+	revision, dryRevisions, err := getHydrateRevision(a, q.GetDryRevision(), q.Id)
+	if err != nil {
+		return nil, err
+	}
+	drySHA, objs, err := s.getDryManifests(ctx, a, proj, revision, dryRevisions)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := hydrator.RenderManifests(objs, syncSource.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering hydrated manifests: %w", err)
+	}
+
+	// The manifests are diffed against the branch the hydrator commits to, i.e. the hydrateTo branch if configured.
+	hydrateToSource := a.Spec.GetHydrateToSource()
+	current, err := s.getHydratedManifests(ctx, hydrateToSource.RepoURL, proj.Name, hydrateToSource.TargetRevision, hydrateToSource.Path)
+	if err != nil && hydrateToSource.TargetRevision != syncSource.TargetBranch {
+		// Like the hydrator, fall back to the sync branch if the hydrateTo branch does not exist yet.
+		log.WithField("application", a.QualifiedName()).Debugf("Diffing against sync branch: %v", err)
+		current, err = s.getHydratedManifests(ctx, hydrateToSource.RepoURL, proj.Name, syncSource.TargetBranch, syncSource.Path)
+	}
+	if err != nil {
+		if a.Status.SourceHydrator.LastSuccessfulOperation != nil {
+			return nil, err
+		}
+		// The sync branch may not exist yet if the application has never been hydrated.
+		log.WithField("application", a.QualifiedName()).Debugf("Diffing against empty sync branch: %v", err)
+		current = nil
+	}
+
+	manifestsDiff, err := hydratedManifestsDiff(syncSource.Path, current, rendered)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationHydrateDryRunResponse{
+		DrySha: &drySHA,
+		Diff:   &manifestsDiff,
+	}, nil
+}
+
+// getDryManifests generates the manifests of the dry source of the application for the given revision, or the target
+// revision of the dry source if empty. The manifests are returned as they are written to the hydrated branch, with the
+// tracking metadata removed and the data of secrets hidden.
+func (s *Server) getDryManifests(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, revision string, dryRevisions []string) (string, []*unstructured.Unstructured, error) {
+	drySource := a.Spec.SourceHydrator.DrySource
+	if revision == "" {
+		revision = drySource.TargetRevision
+	}
+
+	var sources []v1alpha1.ApplicationSource
+	if drySource.HasMultipleSources() {
+		sources = slices.Clone(drySource.Sources)
+		// Only the sources using the dry repository are pinned to the revision, like the hydrator does. The other
+		// sources are pinned to the given dry revisions, if any.
+		pinned := len(dryRevisions) == len(sources)
+		for i, source := range sources {
+			switch {
+			case drySource.IsDryRepoSource(source):
+				sources[i].TargetRevision = revision
+			case pinned && dryRevisions[i] != "":
+				sources[i].TargetRevision = dryRevisions[i]
+			}
+		}
+	} else {
+		sources = []v1alpha1.ApplicationSource{{
+			RepoURL:        drySource.RepoURL,
+			Path:           drySource.Path,
+			TargetRevision: revision,
+			Helm:           drySource.Helm,
+			Kustomize:      drySource.Kustomize,
+			Directory:      drySource.Directory,
+			Plugin:         drySource.Plugin,
+		}}
+	}
+
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+
+	var drySHA string
+	var objs []*unstructured.Unstructured
+	err = s.queryRepoServer(ctx, proj, func(
+		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
+	) error {
+		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+		if err != nil {
+			return fmt.Errorf("error getting app instance label key from settings: %w", err)
+		}
+
+		config, err := s.getApplicationClusterConfig(ctx, a)
+		if err != nil {
+			return fmt.Errorf("error getting application cluster config: %w", err)
+		}
+
+		serverVersion, err := s.kubectl.GetServerVersion(config)
+		if err != nil {
+			return fmt.Errorf("error getting server version: %w", err)
+		}
+
+		apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+		if err != nil {
+			return fmt.Errorf("error getting API resources: %w", err)
+		}
+
+		refSources, err := argo.GetRefSources(ctx, sources, a.Spec.Project, s.db.GetRepository, []string{})
+		if err != nil {
+			return fmt.Errorf("failed to get ref sources: %w", err)
+		}
+
+		kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+		if err != nil {
+			return fmt.Errorf("error getting kustomize settings: %w", err)
+		}
+
+		installationID, err := s.settingsMgr.GetInstallationID()
+		if err != nil {
+			return fmt.Errorf("error getting installation ID: %w", err)
+		}
+
+		for i, source := range sources {
+			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+			if err != nil {
+				return fmt.Errorf("error getting repository: %w", err)
+			}
+
+			repos := helmRepos
+			helmRepoCreds := helmCreds
+			if source.IsOCI() {
+				repos = append(slices.Clone(helmRepos), ociRepos...)
+				helmRepoCreds = append(slices.Clone(helmCreds), ociCreds...)
+			}
+
+			// The manifest generate paths annotation is not passed on, because the last synced revision is a commit of
+			// the hydrated branch rather than of the dry source.
+			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repo,
+				Revision:           source.TargetRevision,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &sources[i],
+				Repos:              repos,
+				KustomizeOptions:   kustomizeSettings,
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:      helmRepoCreds,
+				HelmOptions:        helmOptions,
+				TrackingMethod:     trackingMethod,
+				EnabledSourceTypes: enableGenerateManifests,
+				ProjectName:        proj.Name,
+				ProjectSourceRepos: proj.Spec.SourceRepos,
+				OciSignatureKeys:   proj.GetOCISignatureKeys(),
+				HasMultipleSources: drySource.HasMultipleSources(),
+				RefSources:         refSources,
+				InstallationID:     installationID,
+				NoRevisionCache:    true,
+			})
+			if err != nil {
+				return fmt.Errorf("error generating manifests: %w", err)
+			}
+			if drySHA == "" && (!drySource.HasMultipleSources() || drySource.IsDryRepoSource(drySource.Sources[i])) {
+				drySHA = manifestInfo.Revision
+			}
+
+			for _, manifest := range manifestInfo.Manifests {
+				obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+				if err != nil {
+					return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+				}
+				objs = append(objs, obj)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if drySHA == "" {
+		return "", nil, fmt.Errorf("none of the dry sources of app %q use repository %q with revision %q", a.QualifiedName(), drySource.RepoURL, drySource.TargetRevision)
+	}
+
+	for i, obj := range objs {
+		if err := argo.NewResourceTracking().RemoveAppInstance(obj, trackingMethod); err != nil {
+			return "", nil, fmt.Errorf("failed to remove the app instance value: %w", err)
+		}
+		objs[i], err = s.hideSecretData(obj)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	return drySHA, objs, nil
+}
+
+// getHydratedManifests returns the manifest files committed to the given path of the hydrated branch, by file name. The
+// manifests are re-encoded the way the hydrator encodes them, with the data of secrets hidden.
+func (s *Server) getHydratedManifests(ctx context.Context, repoURL, project, branch, hydratedPath string) (map[string][]byte, error) {
+	repo, err := s.db.GetRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository: %w", err)
+	}
+	closer, client, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(closer)
+
+	resp, err := client.GetGitFiles(ctx, &apiclient.GitFilesRequest{
+		Repo:                      repo,
+		Revision:                  branch,
+		Path:                      path.Join(hydratedPath, "*.{yaml,yml}"),
+		NewGitFileGlobbingEnabled: true,
+		NoRevisionCache:           true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting hydrated manifests from branch %q: %w", branch, err)
+	}
+
+	files := make(map[string][]byte, len(resp.GetMap()))
+	for filePath, data := range resp.GetMap() {
+		objs, err := kube.SplitYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing hydrated manifest %q: %w", filePath, err)
+		}
+		for i, obj := range objs {
+			objs[i], err = s.hideSecretData(obj)
+			if err != nil {
+				return nil, err
+			}
+		}
+		files[path.Base(filePath)], err = hydrator.EncodeManifests(objs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// hideSecretData replaces the data of the given object with asterisks if it is a secret.
+func (s *Server) hideSecretData(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if obj.GetKind() != kube.SecretKind || obj.GroupVersionKind().Group != "" {
+		return obj, nil
+	}
+	obj, _, err := diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
+	if err != nil {
+		return nil, fmt.Errorf("error hiding secret data: %w", err)
+	}
+	return obj, nil
+}
+
+// hydratedManifestsDiff returns a unified diff of the current and the rendered manifest files of the given hydrated path.
+// Files are diffed in the order of their names, and files with identical content are omitted.
+func hydratedManifestsDiff(hydratedPath string, current, rendered map[string][]byte) (string, error) {
+	names := make([]string, 0, len(current)+len(rendered))
+	for name := range current {
+		names = append(names, name)
+	}
+	for name := range rendered {
+		if _, ok := current[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		from, inCurrent := current[name]
+		to, inRendered := rendered[name]
+		fromFile := "a/" + path.Join(hydratedPath, name)
+		toFile := "b/" + path.Join(hydratedPath, name)
+		if !inCurrent {
+			fromFile = "/dev/null"
+		}
+		if !inRendered {
+			toFile = "/dev/null"
+		}
+		fileDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(from),
+			B:        splitLines(to),
+			FromFile: fromFile,
+			ToFile:   toFile,
+			Context:  3,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error diffing hydrated manifest %q: %w", name, err)
+		}
+		sb.WriteString(fileDiff)
+	}
+	return sb.String(), nil
+}
+
+// splitLines splits the given content into lines, keeping the line endings.
+func splitLines(data []byte) []string {
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,276 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+func newTestHydratorApp() *v1alpha1.Application {
+	app := newTestApp()
+	app.Spec.Source = nil
+	app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+		DrySource: v1alpha1.DrySource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+			TargetRevision: "HEAD",
+			Path:           "guestbook",
+		},
+		SyncSource: v1alpha1.SyncSource{
+			TargetBranch: "environments/dev",
+			Path:         "guestbook",
+		},
+	}
+	return app
+}
+
+func TestHydrate(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Status.SourceHydrator.HydrateHistory = v1alpha1.HydrateHistories{
+		{ID: 1, DrySHA: "abc123", HydratedSHA: "def456"},
+		{ID: 2, DrySHA: "abc789", HydratedSHA: "def789"},
+	}
+	appServer := newTestAppServer(t, testApp)
+
+	app, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name, Id: ptr.To(int64(1))})
+	require.NoError(t, err)
+	assert.Equal(t, "manual", app.Annotations[v1alpha1.AnnotationKeyHydrate])
+	assert.Equal(t, "abc123", app.Annotations[v1alpha1.AnnotationKeyHydrateRevision])
+
+	app, err = appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "manual", app.Annotations[v1alpha1.AnnotationKeyHydrate])
+	assert.NotContains(t, app.Annotations, v1alpha1.AnnotationKeyHydrateRevision)
+}
+
+func TestHydrate_NoSourceHydrator(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	_, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name})
+	require.ErrorContains(t, err, "does not use a source hydrator")
+}
+
+func TestGetHydrateRevision(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Status.SourceHydrator.HydrateHistory = v1alpha1.HydrateHistories{{ID: 1, DrySHA: "abc123", DryRevisions: []string{"1.2.3", "abc123"}}}
+
+	revision, dryRevisions, err := getHydrateRevision(testApp, "", nil)
+	require.NoError(t, err)
+	assert.Empty(t, revision)
+	assert.Empty(t, dryRevisions)
+
+	revision, dryRevisions, err = getHydrateRevision(testApp, "main", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "main", revision)
+	assert.Empty(t, dryRevisions)
+
+	revision, dryRevisions, err = getHydrateRevision(testApp, "", ptr.To(int64(1)))
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", revision)
+	assert.Equal(t, []string{"1.2.3", "abc123"}, dryRevisions)
+
+	_, _, err = getHydrateRevision(testApp, "", ptr.To(int64(2)))
+	require.ErrorContains(t, err, "does not have hydration with id 2")
+
+	_, _, err = getHydrateRevision(testApp, "main", ptr.To(int64(1)))
+	require.ErrorContains(t, err, "only one of dry revision and hydrate history ID can be specified")
+}
+
+func TestHydrateDryRun(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{}
+	appServer := newTestAppServer(t, testApp)
+
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+		return mr.Revision == "abc123" && mr.ApplicationSource.Path == "guestbook" && mr.NoRevisionCache
+	})).Return(&apiclient.ManifestResponse{
+		Revision: "abc1234567",
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook","annotations":{"argocd.argoproj.io/tracking-id":"test-app:/ConfigMap:default/guestbook"}},"data":{"color":"blue"}}`,
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"guestbook"},"data":{"password":"c2VjcmV0"}}`,
+		},
+	}, nil)
+	mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+		return req.Revision == "environments/dev" && req.Path == "guestbook/*.{yaml,yml}" && req.NewGitFileGlobbingEnabled
+	})).Return(&apiclient.GitFilesResponse{
+		Map: map[string][]byte{
+			"guestbook/manifest.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: guestbook
+data:
+  color: green
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: guestbook
+data:
+  password: b2xkLXNlY3JldA==
+`),
+		},
+	}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	resp, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{
+		Name:        &testApp.Name,
+		DryRevision: ptr.To("abc123"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc1234567", resp.GetDrySha())
+	// The tracking annotation is removed, and the secret data is hidden on both sides of the diff.
+	assert.Equal(t, `--- a/guestbook/manifest.yaml
++++ b/guestbook/manifest.yaml
+@@ -1,6 +1,6 @@
+ apiVersion: v1
+ data:
+-  color: green
++  color: blue
+ kind: ConfigMap
+ metadata:
+   name: guestbook
+`, resp.GetDiff())
+}
+
+func TestHydrateDryRun_HydrateTo(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Spec.SourceHydrator.HydrateTo = &v1alpha1.HydrateTo{TargetBranch: "environments/dev-next"}
+	testApp.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{}
+	appServer := newTestAppServer(t, testApp)
+
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Revision:  "abc1234567",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"},"data":{"color":"blue"}}`},
+	}, nil)
+	// The manifests committed to the hydrateTo branch are diffed, not the ones of the sync branch.
+	mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+		return req.Revision == "environments/dev-next"
+	})).Return(&apiclient.GitFilesResponse{
+		Map: map[string][]byte{
+			"guestbook/manifest.yaml": []byte("apiVersion: v1\ndata:\n  color: blue\nkind: ConfigMap\nmetadata:\n  name: guestbook\n"),
+		},
+	}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	resp, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetDiff())
+}
+
+func TestHydrateDryRun_HydrateToBranchMissing(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Spec.SourceHydrator.HydrateTo = &v1alpha1.HydrateTo{TargetBranch: "environments/dev-next"}
+	testApp.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{}
+	appServer := newTestAppServer(t, testApp)
+
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Revision:  "abc1234567",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"},"data":{"color":"blue"}}`},
+	}, nil)
+	// The hydrator creates the hydrateTo branch from the sync branch, so the sync branch is diffed until it exists.
+	mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+		return req.Revision == "environments/dev-next"
+	})).Return(nil, assert.AnError)
+	mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+		return req.Revision == "environments/dev"
+	})).Return(&apiclient.GitFilesResponse{
+		Map: map[string][]byte{
+			"guestbook/manifest.yaml": []byte("apiVersion: v1\ndata:\n  color: blue\nkind: ConfigMap\nmetadata:\n  name: guestbook\n"),
+		},
+	}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	resp, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetDiff())
+}
+
+func TestHydrateDryRun_NeverHydrated(t *testing.T) {
+	testApp := newTestHydratorApp()
+	appServer := newTestAppServer(t, testApp)
+
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Revision:  "abc1234567",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"}}`},
+	}, nil)
+	mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.Anything).Return(nil, assert.AnError)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	resp, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, `--- /dev/null
++++ b/guestbook/manifest.yaml
+@@ -0,0 +1,4 @@
++apiVersion: v1
++kind: ConfigMap
++metadata:
++  name: guestbook
+`, resp.GetDiff())
+}
+
+func TestHydrateDryRun_NoSourceHydrator(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	_, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{Name: &testApp.Name})
+	require.ErrorContains(t, err, "does not use a source hydrator")
+}
+
+func TestHydrateDryRun_OCISyncSource(t *testing.T) {
+	testApp := newTestHydratorApp()
+	testApp.Spec.SourceHydrator.SyncSource.RepoURL = "oci://registry.example.com/hydrated"
+	testApp.Spec.SourceHydrator.SyncSource.TargetBranch = "environments-dev"
+	appServer := newTestAppServer(t, testApp)
+
+	_, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunRequest{Name: &testApp.Name})
+	require.ErrorContains(t, err, "not supported for applications hydrating to an OCI repository")
+}
+
+func TestHydratedManifestsDiff(t *testing.T) {
+	current := map[string][]byte{
+		"deployment-guestbook.yaml": []byte("kind: Deployment\nreplicas: 1\n"),
+		"manifest.yaml":             []byte("kind: Deployment\n---\nkind: Service\n"),
+		"service-guestbook.yaml":    []byte("kind: Service\n"),
+	}
+	rendered := map[string][]byte{
+		"deployment-guestbook.yaml": []byte("kind: Deployment\nreplicas: 2\n"),
+		"kustomization.yaml":        []byte("kind: Kustomization\n"),
+		"service-guestbook.yaml":    []byte("kind: Service\n"),
+	}
+
+	manifestsDiff, err := hydratedManifestsDiff("guestbook", current, rendered)
+	require.NoError(t, err)
+	assert.Equal(t, `--- a/guestbook/deployment-guestbook.yaml
++++ b/guestbook/deployment-guestbook.yaml
+@@ -1,2 +1,2 @@
+ kind: Deployment
+-replicas: 1
++replicas: 2
+--- /dev/null
++++ b/guestbook/kustomization.yaml
+@@ -0,0 +1 @@
++kind: Kustomization
+--- a/guestbook/manifest.yaml
++++ /dev/null
+@@ -1,3 +0,0 @@
+-kind: Deployment
+----
+-kind: Service
+`, manifestsDiff)
+
+	manifestsDiff, err = hydratedManifestsDiff("guestbook", rendered, rendered)
+	require.NoError(t, err)
+	assert.Empty(t, manifestsDiff)
+}
@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -19,6 +20,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -29,7 +31,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -63,6 +67,7 @@ type Server struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+	ClusterProfileNamespace  string
 }
 
 // NewServer returns a new instance of the ApplicationSet service
@@ -85,6 +90,7 @@ func NewServer(
 	allowedScmProviders []string,
 	enableScmProviders bool,
 	enableGitHubAPIMetrics bool,
+	clusterProfileNamespace string,
 	enableK8sEvent []string,
 ) applicationset.ApplicationSetServiceServer {
 	s := &Server{
@@ -107,6 +113,7 @@ func NewServer(
 		AllowedScmProviders:      allowedScmProviders,
 		EnableScmProviders:       enableScmProviders,
 		EnableGitHubAPIMetrics:   enableGitHubAPIMetrics,
+		ClusterProfileNamespace:  clusterProfileNamespace,
 	}
 	return s
 }
@@ -265,7 +272,7 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, s.ClusterProfileNamespace)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -338,6 +345,54 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// RolloutPromote promotes a RollingSync step of an ApplicationSet which is waiting for approval
+func (s *Server) RolloutPromote(ctx context.Context, q *applicationset.ApplicationSetRolloutPromoteRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	step := ""
+	for _, stepStatus := range appset.Status.RolloutSteps {
+		if stepStatus.Status == v1alpha1.RolloutStepWaitingForApproval && (q.Step == "" || q.Step == stepStatus.Step) {
+			step = stepStatus.Step
+			break
+		}
+	}
+	if step == "" {
+		if q.Step != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "rollout step %s of ApplicationSet %s is not waiting for approval", q.Step, appset.QualifiedName())
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "no rollout step of ApplicationSet %s is waiting for approval", appset.QualifiedName())
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				common.AnnotationApplicationSetRolloutPromote: step,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling rollout promote patch: %w", err)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, q.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error promoting ApplicationSet rollout step: %w", err)
+	}
+	s.logAppSetEvent(ctx, updated, argo.EventReasonResourceUpdated, "promoted rollout step "+step)
+	return updated, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
@@ -401,9 +456,157 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	for i := range apps {
 		res.Applications = append(res.Applications, &apps[i])
 	}
+	if q.Diff {
+		res.Diffs, err = s.diffApplicationSetApps(ctx, appset, apps)
+		if err != nil {
+			return nil, fmt.Errorf("error diffing Applications of ApplicationSet: %w", err)
+		}
+	}
 	return res, nil
 }
 
+// diffApplicationSetApps compares the generated Applications against the live Applications owned by the ApplicationSet,
+// and returns the Applications which the ApplicationSet controller would create, update or delete. The policy and the
+// preserved fields configured on the ApplicationSet controller itself are not known to the server, so only the ones
+// of the ApplicationSet are taken into account. Likewise, progressive syncs are assumed to be enabled on the controller
+// for ApplicationSets with the RollingSync strategy.
+func (s *Server) diffApplicationSetApps(ctx context.Context, appset *v1alpha1.ApplicationSet, generatedApps []v1alpha1.Application) ([]*applicationset.ApplicationSetApplicationDiff, error) {
+	namespace := s.appsetNamespaceOrDefault(appset.Namespace)
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+	liveApps := map[string]*v1alpha1.Application{}
+	for i := range appList.Items {
+		app := &appList.Items[i]
+		owner := metav1.GetControllerOf(app)
+		if owner == nil || owner.Kind != application.ApplicationSetKind || owner.Name != appset.Name {
+			continue
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+		liveApps[app.Name] = app
+	}
+
+	policy := appsetutils.DefaultPolicy(appset.Spec.SyncPolicy, v1alpha1.ApplicationsSyncPolicySync, true)
+	var diffs []*applicationset.ApplicationSetApplicationDiff
+	generatedAppNames := map[string]bool{}
+	for i := range generatedApps {
+		generatedApp := generatedApps[i].DeepCopy()
+		generatedAppNames[generatedApp.Name] = true
+		liveApp, ok := liveApps[generatedApp.Name]
+		if !ok {
+			diffs = append(diffs, &applicationset.ApplicationSetApplicationDiff{
+				Name:      generatedApp.Name,
+				Namespace: namespace,
+				Action:    "create",
+			})
+			continue
+		}
+
+		if appsetutils.IsRollingSyncStrategyEnabled(appset) {
+			appsetutils.DisableAutomatedSync(generatedApp)
+		}
+		preserveApplicationFields(appset, liveApp, generatedApp)
+		differences, err := appsetutils.GetApplicationDifferences(appset.Spec.IgnoreApplicationDifferences, liveApp, generatedApp)
+		if err != nil {
+			return nil, fmt.Errorf("error diffing Application %s: %w", generatedApp.Name, err)
+		}
+		if len(differences) == 0 {
+			continue
+		}
+		appDiff := &applicationset.ApplicationSetApplicationDiff{
+			Name:      generatedApp.Name,
+			Namespace: namespace,
+			Action:    "update",
+			Blocked:   !policy.AllowUpdate(),
+		}
+		for _, difference := range differences {
+			liveValue, err := marshalFieldValue(difference.Live)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling live value of field %s: %w", difference.Path, err)
+			}
+			targetValue, err := marshalFieldValue(difference.Generated)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling generated value of field %s: %w", difference.Path, err)
+			}
+			appDiff.Fields = append(appDiff.Fields, &applicationset.ApplicationSetFieldDiff{
+				Path:        difference.Path,
+				LiveValue:   liveValue,
+				TargetValue: targetValue,
+			})
+		}
+		diffs = append(diffs, appDiff)
+	}
+
+	deletedAppNames := make([]string, 0, len(liveApps))
+	for appName := range liveApps {
+		if !generatedAppNames[appName] {
+			deletedAppNames = append(deletedAppNames, appName)
+		}
+	}
+	sort.Strings(deletedAppNames)
+	for _, appName := range deletedAppNames {
+		diffs = append(diffs, &applicationset.ApplicationSetApplicationDiff{
+			Name:      appName,
+			Namespace: namespace,
+			Action:    "delete",
+			Blocked:   !policy.AllowDelete(),
+		})
+	}
+	return diffs, nil
+}
+
+// preserveApplicationFields copies the annotations, labels and finalizers of the live Application which the
+// ApplicationSet controller preserves to the generated Application. The previous generated spec recorded by a RollingSync
+// rollout is preserved as well, since it is maintained by the ApplicationSet controller.
+func preserveApplicationFields(appset *v1alpha1.ApplicationSet, liveApp *v1alpha1.Application, generatedApp *v1alpha1.Application) {
+	preservedAnnotations := []string{appsetutils.NotifiedAnnotationKey, v1alpha1.AnnotationKeyRefresh}
+	if appsetutils.IsRollingSyncStrategyEnabled(appset) {
+		preservedAnnotations = append(preservedAnnotations, common.AnnotationApplicationSetPreviousSpec)
+	}
+	var preservedLabels []string
+	if appset.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, appset.Spec.PreservedFields.Annotations...)
+		preservedLabels = appset.Spec.PreservedFields.Labels
+	}
+
+	for _, key := range preservedAnnotations {
+		if value, ok := liveApp.Annotations[key]; ok {
+			if generatedApp.Annotations == nil {
+				generatedApp.Annotations = map[string]string{}
+			}
+			generatedApp.Annotations[key] = value
+		}
+	}
+	for _, key := range preservedLabels {
+		if value, ok := liveApp.Labels[key]; ok {
+			if generatedApp.Labels == nil {
+				generatedApp.Labels = map[string]string{}
+			}
+			generatedApp.Labels[key] = value
+		}
+	}
+	for _, finalizer := range liveApp.Finalizers {
+		if strings.HasPrefix(finalizer, v1alpha1.PostDeleteFinalizerName) {
+			generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
+		}
+	}
+}
+
+// marshalFieldValue returns the JSON value of a field, or an empty string if the field is not set
+func marshalFieldValue(value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 func (s *Server) buildApplicationSetTree(a *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSetTree, error) {
 	var tree v1alpha1.ApplicationSetTree
 
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
@@ -177,6 +178,7 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		[]string{},
 		true,
 		true,
+		"",
 		testEnableEventList,
 	)
 	return server.(*Server)
@@ -516,6 +518,31 @@ func TestDeleteAppSet(t *testing.T) {
 	})
 }
 
+func TestRolloutPromoteAppSet(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Status.RolloutSteps = []appsv1.ApplicationSetRolloutStepStatus{
+			{Step: "1", Status: appsv1.RolloutStepCompleted},
+			{Step: "2", Status: appsv1.RolloutStepWaitingForApproval},
+		}
+	})
+
+	t.Run("Promote step waiting for approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		res, err := appSetServer.RolloutPromote(t.Context(), &applicationset.ApplicationSetRolloutPromoteRequest{Name: appSet.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "2", res.Annotations[common.AnnotationApplicationSetRolloutPromote])
+	})
+
+	t.Run("Promote step not waiting for approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		_, err := appSetServer.RolloutPromote(t.Context(), &applicationset.ApplicationSetRolloutPromoteRequest{Name: appSet.Name, Step: "1"})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = rollout step 1 of ApplicationSet default/AppSet1 is not waiting for approval")
+	})
+}
+
 func TestUpdateAppSet(t *testing.T) {
 	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Annotations = map[string]string{
@@ -755,3 +782,104 @@ func TestAppSet_Generate_Cluster(t *testing.T) {
 		assert.EqualError(t, err, "namespace 'NOT-ALLOWED' is not permitted")
 	})
 }
+
+func TestAppSet_Generate_Diff(t *testing.T) {
+	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Name = "{{name}}"
+		appset.Spec.Template.Spec.Destination.Server = "{{server}}"
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{
+				Clusters: &appsv1.ClusterGenerator{},
+			},
+		}
+	})
+	newLiveApp := func(name string, server string) *appsv1.Application {
+		controller := true
+		return &appsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  testNamespace,
+				Finalizers: []string{appsv1.ResourcesFinalizerName},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "argoproj.io/v1alpha1", Kind: "ApplicationSet", Name: "AppSet1", Controller: &controller},
+				},
+			},
+			Spec: appsv1.ApplicationSpec{
+				Project:     "default",
+				Destination: appsv1.ApplicationDestination{Server: server},
+			},
+		}
+	}
+	unchangedApp := newLiveApp("in-cluster", "https://kubernetes.default.svc")
+	updatedApp := newLiveApp("fake-cluster", "https://old-cluster-api.example.com")
+	deletedApp := newLiveApp("deleted", "https://kubernetes.default.svc")
+
+	t.Run("Diff against live applications", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet1, unchangedApp, updatedApp, deletedApp)
+		appsetQuery := applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet1, Diff: true}
+
+		res, err := appSetServer.Generate(t.Context(), &appsetQuery)
+		require.NoError(t, err)
+		require.Len(t, res.Applications, 2)
+		assert.Equal(t, []*applicationset.ApplicationSetApplicationDiff{
+			{
+				Name:      "fake-cluster",
+				Namespace: testNamespace,
+				Action:    "update",
+				Fields: []*applicationset.ApplicationSetFieldDiff{
+					{Path: "spec.destination.server", LiveValue: `"https://old-cluster-api.example.com"`, TargetValue: `"https://cluster-api.example.com"`},
+				},
+			},
+			{Name: "deleted", Namespace: testNamespace, Action: "delete"},
+		}, res.Diffs)
+	})
+
+	t.Run("Diff with create-only policy", func(t *testing.T) {
+		appSet := appSet1.DeepCopy()
+		policy := appsv1.ApplicationsSyncPolicyCreateOnly
+		appSet.Spec.SyncPolicy = &appsv1.ApplicationSetSyncPolicy{ApplicationsSync: &policy}
+		appSetServer := newTestAppSetServer(t, appSet, updatedApp, deletedApp)
+		appsetQuery := applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet, Diff: true}
+
+		res, err := appSetServer.Generate(t.Context(), &appsetQuery)
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 3)
+		assert.Equal(t, "fake-cluster", res.Diffs[0].Name)
+		assert.True(t, res.Diffs[0].Blocked)
+		assert.Equal(t, "in-cluster", res.Diffs[1].Name)
+		assert.Equal(t, "create", res.Diffs[1].Action)
+		assert.False(t, res.Diffs[1].Blocked)
+		assert.Equal(t, "deleted", res.Diffs[2].Name)
+		assert.True(t, res.Diffs[2].Blocked)
+	})
+
+	t.Run("Diff with RollingSync strategy", func(t *testing.T) {
+		appSet := appSet1.DeepCopy()
+		appSet.Spec.Template.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}}
+		appSet.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+			Type:        "RollingSync",
+			RollingSync: &appsv1.ApplicationSetRolloutStrategy{Steps: []appsv1.ApplicationSetRolloutStep{{}}},
+		}
+		liveApp := unchangedApp.DeepCopy()
+		liveApp.Annotations = map[string]string{common.AnnotationApplicationSetPreviousSpec: `{"revision":"1","spec":{}}`}
+		liveApp.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{Enabled: ptr.To(false)}}
+		appSetServer := newTestAppSetServer(t, appSet, liveApp)
+		appsetQuery := applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet, Diff: true}
+
+		res, err := appSetServer.Generate(t.Context(), &appsetQuery)
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 1)
+		assert.Equal(t, "fake-cluster", res.Diffs[0].Name)
+		assert.Equal(t, "create", res.Diffs[0].Action)
+	})
+
+	t.Run("Generate without diff", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet1, updatedApp)
+		appsetQuery := applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet1}
+
+		res, err := appSetServer.Generate(t.Context(), &appsetQuery)
+		require.NoError(t, err)
+		assert.Empty(t, res.Diffs)
+	})
+}
@@ -88,6 +88,9 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, q.Creds.URL); err != nil {
 		return nil, err
 	}
+	if err := s.enforceMirrorSettings(ctx, q.Creds, nil); err != nil {
+		return nil, err
+	}
 
 	r := q.Creds
 
@@ -158,7 +161,14 @@ func (s *Server) UpdateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, q.Creds.URL); err != nil {
 		return nil, err
 	}
-	_, err := s.db.UpdateRepositoryCredentials(ctx, q.Creds)
+	existing, err := s.db.GetRepositoryCredentials(ctx, q.Creds.URL)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enforceMirrorSettings(ctx, q.Creds, existing); err != nil {
+		return nil, err
+	}
+	_, err = s.db.UpdateRepositoryCredentials(ctx, q.Creds)
 	return &appsv1.RepoCreds{URL: q.Creds.URL}, err
 }
 
@@ -174,6 +184,21 @@ func (s *Server) UpdateWriteRepositoryCredentials(ctx context.Context, q *repocr
 	return &appsv1.RepoCreds{URL: q.Creds.URL}, err
 }
 
+// enforceMirrorSettings requires the override action on the repositories matched by the credentials to set or change
+// their mirror settings, since they determine where the repo server fetches the repositories from. Removing them does not
+// require it.
+func (s *Server) enforceMirrorSettings(ctx context.Context, creds *appsv1.RepoCreds, existing *appsv1.RepoCreds) error {
+	var mirrorURL, bundleURI, mirrorMaxStaleness string
+	if existing != nil {
+		mirrorURL, bundleURI, mirrorMaxStaleness = existing.MirrorURL, existing.BundleURI, existing.MirrorMaxStaleness
+	}
+	if (creds.MirrorURL == "" || creds.MirrorURL == mirrorURL) && (creds.BundleURI == "" || creds.BundleURI == bundleURI) &&
+		(creds.MirrorMaxStaleness == "" || creds.MirrorMaxStaleness == mirrorMaxStaleness) {
+		return nil
+	}
+	return s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionOverride, creds.URL)
+}
+
 // DeleteRepositoryCredentials removes a credential set from the configuration
 func (s *Server) DeleteRepositoryCredentials(ctx context.Context, q *repocredspkg.RepoCredsDeleteRequest) (*repocredspkg.RepoCredsResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionDelete, q.Url); err != nil {
@@ -84,6 +84,20 @@ func (s *Server) getWriteRepo(ctx context.Context, url, project string) (*v1alph
 	return repo, nil
 }
 
+// enforceMirrorSettings requires the override action on the repository to set or change its mirror settings, since they
+// determine where the repo server fetches the repository from. Removing them does not require it.
+func (s *Server) enforceMirrorSettings(ctx context.Context, repo *v1alpha1.Repository, existing *v1alpha1.Repository) error {
+	var mirrorURL, bundleURI, mirrorMaxStaleness string
+	if existing != nil {
+		mirrorURL, bundleURI, mirrorMaxStaleness = existing.MirrorURL, existing.BundleURI, existing.MirrorMaxStaleness
+	}
+	if (repo.MirrorURL == "" || repo.MirrorURL == mirrorURL) && (repo.BundleURI == "" || repo.BundleURI == bundleURI) &&
+		(repo.MirrorMaxStaleness == "" || repo.MirrorMaxStaleness == mirrorMaxStaleness) {
+		return nil
+	}
+	return s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionOverride, createRBACObject(repo.Project, repo.Repo))
+}
+
 func createRBACObject(project string, repo string) string {
 	if project != "" {
 		return project + "/" + repo
@@ -396,14 +410,20 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 		}
 	}
 
+	var manifestGeneratePaths string
+	if app != nil {
+		manifestGeneratePaths = app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths)
+	}
+
 	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		Repo:             repo,
-		Source:           q.Source,
-		Repos:            helmRepos,
-		KustomizeOptions: kustomizeSettings,
-		HelmOptions:      helmOptions,
-		AppName:          q.AppName,
-		RefSources:       refSources,
+		Repo:                            repo,
+		Source:                          q.Source,
+		Repos:                           helmRepos,
+		KustomizeOptions:                kustomizeSettings,
+		HelmOptions:                     helmOptions,
+		AppName:                         q.AppName,
+		RefSources:                      refSources,
+		AnnotationManifestGeneratePaths: manifestGeneratePaths,
 	})
 }
 
@@ -439,6 +459,9 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
 		return nil, err
 	}
+	if err := s.enforceMirrorSettings(ctx, q.Repo, nil); err != nil {
+		return nil, err
+	}
 
 	var repo *v1alpha1.Repository
 	var err error
@@ -559,6 +582,9 @@ func (s *Server) UpdateRepository(ctx context.Context, q *repositorypkg.RepoUpda
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
 		return nil, err
 	}
+	if err := s.enforceMirrorSettings(ctx, q.Repo, repo); err != nil {
+		return nil, err
+	}
 	_, err = s.db.UpdateRepository(ctx, q.Repo)
 	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: q.Repo.Type, Name: q.Repo.Name}, err
 }
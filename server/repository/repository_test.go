@@ -485,6 +485,93 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, "test", repo.Repo)
 	})
 
+	t.Run("Test_CreateRepositoryWithMirror", func(t *testing.T) {
+		repoServerClient := &mocks.RepoServerServiceClient{}
+		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+		_ = enforcer.SetUserPolicy("p, role:createrepos, repositories, create, *, allow")
+		enforcer.SetDefaultRole("role:createrepos")
+
+		r := &appsv1.Repository{
+			Repo:      "test",
+			Username:  "test",
+			MirrorURL: "https://mirror.example.com/test",
+		}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetRepository(mock.Anything, "test", mock.Anything).Return(nil, errors.New("not found"))
+		db.EXPECT().CreateRepository(mock.Anything, mock.Anything).Return(r, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		_, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{Repo: r})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the mirror may be configured with the override action
+		enforcer.SetDefaultRole("role:admin")
+		repo, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{Repo: r})
+		require.NoError(t, err)
+		assert.Equal(t, "test", repo.Repo)
+	})
+
+	t.Run("Test_UpdateRepositoryWithMirror", func(t *testing.T) {
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
+		enforcer := newEnforcer(kubeclientset)
+		_ = enforcer.SetUserPolicy("p, role:updaterepos, repositories, update, *, allow")
+		enforcer.SetDefaultRole("role:updaterepos")
+
+		existing := &appsv1.Repository{
+			Repo:      "test",
+			MirrorURL: "https://mirror.example.com/test",
+		}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetRepository(mock.Anything, "test", "").Return(existing, nil)
+		db.EXPECT().UpdateRepository(mock.Anything, mock.Anything).Return(existing, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		_, err := s.UpdateRepository(t.Context(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{
+				Repo:      "test",
+				MirrorURL: "https://attacker.example.com/test",
+			},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.UpdateRepository(t.Context(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{
+				Repo:               "test",
+				MirrorURL:          "https://mirror.example.com/test",
+				MirrorMaxStaleness: "5m",
+			},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the existing mirror may be sent back unchanged, or removed
+		_, err = s.UpdateRepository(t.Context(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{
+				Repo:      "test",
+				Username:  "test",
+				MirrorURL: "https://mirror.example.com/test",
+			},
+		})
+		require.NoError(t, err)
+		_, err = s.UpdateRepository(t.Context(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{
+				Repo:     "test",
+				Username: "test",
+			},
+		})
+		require.NoError(t, err)
+
+		// the mirror may be changed with the override action
+		enforcer.SetDefaultRole("role:admin")
+		_, err = s.UpdateRepository(t.Context(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{
+				Repo:      "test",
+				MirrorURL: "https://other-mirror.example.com/test",
+			},
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("Test_ListRepositories", func(t *testing.T) {
 		repoServerClient := &mocks.RepoServerServiceClient{}
 		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
@@ -82,6 +82,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsigningkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigningkey"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -107,6 +108,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/repository"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/server/settings"
+	"github.com/argoproj/argo-cd/v3/server/sshsigningkey"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
 	"github.com/argoproj/argo-cd/v3/util/assets"
@@ -252,6 +254,7 @@ type ApplicationSetOpts struct {
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
 	EnableGitHubAPIMetrics   bool
+	ClusterProfileNamespace  string
 }
 
 // GracefulRestartSignal implements a signal to be used for a graceful restart trigger.
@@ -933,6 +936,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		"/session.SessionService/Create":                               true,
 		"/account.AccountService/UpdatePassword":                       true,
 		"/gpgkey.GPGKeyService/CreateGnuPGPublicKey":                   true,
+		"/sshsigningkey.SSHSigningKeyService/Create":                   true,
 		"/repository.RepositoryService/Create":                         true,
 		"/repository.RepositoryService/Update":                         true,
 		"/repository.RepositoryService/CreateRepository":               true,
@@ -995,6 +999,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 	accountpkg.RegisterAccountServiceServer(grpcS, server.serviceSet.AccountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, server.serviceSet.CertificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, server.serviceSet.GpgkeyService)
+	sshsigningkeypkg.RegisterSSHSigningKeyServiceServer(grpcS, server.serviceSet.SSHSigningKeyService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	serverMetrics.InitializeMetrics(grpcS)
@@ -1016,6 +1021,7 @@ type ArgoCDServiceSet struct {
 	NotificationService   notificationpkg.NotificationServiceServer
 	CertificateService    *certificate.Server
 	GpgkeyService         *gpgkey.Server
+	SSHSigningKeyService  *sshsigningkey.Server
 	VersionService        *version.Server
 }
 
@@ -1069,6 +1075,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.AllowedScmProviders,
 		a.EnableScmProviders,
 		a.EnableGitHubAPIMetrics,
+		a.ClusterProfileNamespace,
 		a.EnableK8sEvent,
 	)
 
@@ -1080,6 +1087,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	notificationService := notification.NewServer(a.apiFactory)
 	certificateService := certificate.NewServer(a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.db, a.enf)
+	sshSigningKeyService := sshsigningkey.NewServer(a.db, a.enf)
 	versionService := version.NewServer(a, func() (bool, error) {
 		if a.DisableAuth {
 			return true, nil
@@ -1105,6 +1113,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		NotificationService:   notificationService,
 		CertificateService:    certificateService,
 		GpgkeyService:         gpgkeyService,
+		SSHSigningKeyService:  sshSigningKeyService,
 		VersionService:        versionService,
 	}
 }
@@ -1239,6 +1248,7 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	mustRegisterGWHandler(ctx, accountpkg.RegisterAccountServiceHandler, gwmux, conn)
 	mustRegisterGWHandler(ctx, certificatepkg.RegisterCertificateServiceHandler, gwmux, conn)
 	mustRegisterGWHandler(ctx, gpgkeypkg.RegisterGPGKeyServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, sshsigningkeypkg.RegisterSSHSigningKeyServiceHandler, gwmux, conn)
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", server.RootPath)
@@ -0,0 +1,115 @@
+package sshsigningkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sshsigningkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigningkey"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
+)
+
+// Server provides a service of type SSHSigningKeyService
+type Server struct {
+	db  db.ArgoDB
+	enf *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the service with type SSHSigningKeyService
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
+	return &Server{
+		db:  db,
+		enf: enf,
+	}
+}
+
+// List returns a list of SSH signing keys in the configuration
+func (s *Server) List(ctx context.Context, _ *sshsigningkeypkg.SSHSigningKeyQuery) (*appsv1.SSHSigningKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceSSHSigningKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	keys, err := s.db.ListConfiguredSSHSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyList := &appsv1.SSHSigningKeyList{}
+	for _, v := range keys {
+		// Remove key's data from list result to save some bytes
+		v.KeyData = ""
+		keyList.Items = append(keyList.Items, *v)
+	}
+	sort.Slice(keyList.Items, func(i, j int) bool {
+		return keyList.Items[i].Fingerprint < keyList.Items[j].Fingerprint
+	})
+	return keyList, nil
+}
+
+// Get retrieves a single SSH signing key from the configuration
+func (s *Server) Get(ctx context.Context, q *sshsigningkeypkg.SSHSigningKeyQuery) (*appsv1.SSHSigningKey, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceSSHSigningKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+
+	fingerprint := sshsigning.Fingerprint(q.Fingerprint)
+	if !sshsigning.IsFingerprint(fingerprint) {
+		return nil, errors.New("fingerprint is malformed or empty")
+	}
+
+	keys, err := s.db.ListConfiguredSSHSigningKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := keys[fingerprint]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("no such key: %s", fingerprint)
+}
+
+// Create adds an SSH signing key to the server's configuration
+func (s *Server) Create(ctx context.Context, q *sshsigningkeypkg.SSHSigningKeyCreateRequest) (*sshsigningkeypkg.SSHSigningKeyCreateResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceSSHSigningKeys, rbac.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+
+	if q.Publickey == nil || strings.TrimSpace(q.Publickey.KeyData) == "" {
+		return nil, errors.New("submitted key data is empty")
+	}
+
+	added, skipped, err := s.db.AddSSHSigningKey(ctx, q.Publickey.KeyData)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]appsv1.SSHSigningKey, 0)
+	for _, k := range added {
+		items = append(items, *k)
+	}
+
+	response := &sshsigningkeypkg.SSHSigningKeyCreateResponse{
+		Created: &appsv1.SSHSigningKeyList{Items: items},
+		Skipped: skipped,
+	}
+
+	return response, nil
+}
+
+// Delete removes a single SSH signing key from the server's configuration
+func (s *Server) Delete(ctx context.Context, q *sshsigningkeypkg.SSHSigningKeyQuery) (*sshsigningkeypkg.SSHSigningKeyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceSSHSigningKeys, rbac.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+
+	err := s.db.DeleteSSHSigningKey(ctx, q.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshsigningkeypkg.SSHSigningKeyResponse{}, nil
+}
@@ -236,6 +236,8 @@ export interface ApplicationSource {
 
     directory?: ApplicationSourceDirectory;
 
+    cue?: ApplicationSourceCUE;
+
     ref?: string;
 
     name?: string;
@@ -245,21 +247,37 @@ export interface SourceHydrator {
     drySource: DrySource;
     syncSource: SyncSource;
     hydrateTo?: HydrateTo;
+    schedule?: string;
 }
 
 export interface DrySource {
     repoURL: string;
     targetRevision: string;
-    path: string;
+    path?: string;
+    sources?: ApplicationSource[];
 }
 
 export interface SyncSource {
+    repoURL?: string;
     targetBranch: string;
     path: string;
+    layout?: HydrateLayout;
+}
+
+export interface HydrateLayout {
+    type?: 'singleFile' | 'filePerResource' | 'namespace';
+    kustomization?: boolean;
 }
 
 export interface HydrateTo {
     targetBranch: string;
+    pullRequest?: HydratePullRequest;
+}
+
+export interface HydratePullRequest {
+    provider: string;
+    api?: string;
+    labels?: string[];
 }
 
 export interface ApplicationSourceHelm {
@@ -282,6 +300,17 @@ export interface EnvEntry {
     value: string;
 }
 
+export interface CUETag {
+    name: string;
+    value: string;
+}
+
+export interface ApplicationSourceCUE {
+    package?: string;
+    expression?: string;
+    tags?: CUETag[];
+}
+
 export interface ApplicationSourcePlugin {
     name: string;
     env: EnvEntry[];
@@ -524,6 +553,19 @@ export interface ApplicationStatus {
 export interface SourceHydratorStatus {
     lastSuccessfulOperation?: SuccessfulHydrateOperation;
     currentOperation?: HydrateOperation;
+    pullRequest?: HydratePullRequestStatus;
+    hydrateHistory?: HydrateHistory[];
+}
+
+export type HydratePullRequestPhase = 'Open' | 'Merged' | 'Closed' | 'Failed';
+
+export interface HydratePullRequestStatus {
+    phase: HydratePullRequestPhase;
+    url?: string;
+    number?: number;
+    hydratedSHA?: string;
+    message?: string;
+    updatedAt?: models.Time;
 }
 
 export interface HydrateOperation {
@@ -534,12 +576,29 @@ export interface HydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    dryRevisions?: string[];
+    manual?: boolean;
+    targetRevision?: string;
+    pullRequest?: HydratePullRequestStatus;
+    targetDryRevisions?: string[];
+}
+
+export interface HydrateHistory {
+    id: number;
+    drySHA: string;
+    hydratedSHA: string;
+    dryRevisions?: string[];
+    sourceHydrator: SourceHydrator;
+    startedAt?: models.Time;
+    hydratedAt: models.Time;
+    manual?: boolean;
 }
 
 export interface SuccessfulHydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    dryRevisions?: string[];
 }
 
 export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated';
@@ -706,7 +765,11 @@ export interface HelmChart {
     versions: string[];
 }
 
-export type AppSourceType = 'Helm' | 'Kustomize' | 'Directory' | 'Plugin';
+export type AppSourceType = 'Helm' | 'Kustomize' | 'Directory' | 'Plugin' | 'CUE';
+
+export interface CUEAppSpec {
+    tags?: CUETag[];
+}
 
 export interface RepoAppDetails {
     type: AppSourceType;
@@ -715,6 +778,7 @@ export interface RepoAppDetails {
     kustomize?: KustomizeAppSpec;
     plugin?: PluginAppSpec;
     directory?: {};
+    cue?: CUEAppSpec;
 }
 
 export interface RefsInfo {
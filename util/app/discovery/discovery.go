@@ -19,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/cue"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 )
@@ -65,6 +66,14 @@ func Discover(ctx context.Context, appPath, repoPath string, enableGenerateManif
 		if kustomize.IsKustomization(base) && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeKustomize, enableGenerateManifests) {
 			apps[dir] = string(v1alpha1.ApplicationSourceTypeKustomize)
 		}
+		if cue.IsModuleFile(path) && IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeCUE, enableGenerateManifests) {
+			// The root directory of a CUE module holds the cue.mod directory
+			moduleDir, err := filepath.Rel(appPath, filepath.Dir(filepath.Dir(path)))
+			if err != nil {
+				return err
+			}
+			apps[moduleDir] = string(v1alpha1.ApplicationSourceTypeCUE)
+		}
 		return nil
 	})
 	return apps, err
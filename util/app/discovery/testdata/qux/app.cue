@@ -0,0 +1,3 @@
+package qux
+
+env: *"dev" | string @tag(env)
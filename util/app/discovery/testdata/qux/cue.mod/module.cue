@@ -0,0 +1,2 @@
+module: "example.com/qux@v0"
+language: version: "v0.12.0"
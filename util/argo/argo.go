@@ -42,6 +42,9 @@ const (
 
 var ErrAnotherOperationInProgress = status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
 
+// ociTagPattern matches valid OCI tags
+var ociTagPattern = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+
 // AugmentSyncMsg enrich the K8s message with user-relevant information
 func AugmentSyncMsg(res common.ResourceSyncResult, apiResourceInfoGetter func() ([]kube.APIResourceInfo, error)) (string, error) {
 	if strings.Contains(res.Message, "the server could not find the requested resource") {
@@ -271,6 +274,45 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 	return nil, err
 }
 
+// HydrateApp updates the hydrate annotations of an application to request a manual hydration of the given dry revision,
+// or of the target revision of the dry source if the revision is empty. The dry revisions pin the other dry sources of
+// an application with multiple dry sources, e.g. to the revisions of an earlier hydration.
+func HydrateApp(appIf v1alpha1.ApplicationInterface, name string, revision string, dryRevisions []string) (*argoappv1.Application, error) {
+	annotations := map[string]any{
+		argoappv1.AnnotationKeyHydrate: string(argoappv1.HydrateTypeManual),
+		// Null values remove the revisions of a previous request which was not processed yet.
+		argoappv1.AnnotationKeyHydrateRevision:     nil,
+		argoappv1.AnnotationKeyHydrateDryRevisions: nil,
+	}
+	if revision != "" {
+		annotations[argoappv1.AnnotationKeyHydrateRevision] = revision
+	}
+	if len(dryRevisions) > 0 {
+		annotations[argoappv1.AnnotationKeyHydrateDryRevisions] = strings.Join(dryRevisions, ",")
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling metadata: %w", err)
+	}
+	for attempt := 0; attempt < 5; attempt++ {
+		var app *argoappv1.Application
+		app, err = appIf.Patch(context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err == nil {
+			log.Infof("Requested app '%s' hydration", name)
+			return app.DeepCopy(), nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error patching annotations in application %q: %w", name, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return nil, fmt.Errorf("error patching annotations in application %q: %w", name, err)
+}
+
 func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool) error {
 	repo = repo.DeepCopy()
 	switch {
@@ -483,7 +525,7 @@ func validateRepo(ctx context.Context,
 	// If using the source hydrator, check the dry source instead of the sync source, since the sync source branch may
 	// not exist yet.
 	if app.Spec.SourceHydrator != nil {
-		sources = []argoappv1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}
+		sources = app.Spec.SourceHydrator.GetDrySources()
 	}
 
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{})
@@ -589,6 +631,63 @@ func validateSourcePermissions(source argoappv1.ApplicationSource, hasMultipleSo
 	return conditions
 }
 
+func validateDependencies(dependencies []argoappv1.ApplicationDependency) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	for i, dependency := range dependencies {
+		if (dependency.Name == "") == (dependency.Selector == nil) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d] must have either a name or a selector", i),
+			})
+			continue
+		}
+		if dependency.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(dependency.Selector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.dependsOn[%d].selector is invalid: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
+func validateSyncStrategy(strategy *argoappv1.SyncStrategy) []argoappv1.ApplicationCondition {
+	if strategy == nil || strategy.Batch == nil {
+		return nil
+	}
+	var conditions []argoappv1.ApplicationCondition
+	invalid := func(format string, args ...any) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	names := map[string]bool{}
+	for i, batch := range strategy.Batch.Batches {
+		switch {
+		case batch.Name == "":
+			invalid("spec.syncPolicy.syncStrategy.batch.batches[%d].name is required", i)
+		case names[batch.Name]:
+			invalid("spec.syncPolicy.syncStrategy.batch.batches[%d].name %s is not unique", i, batch.Name)
+		}
+		names[batch.Name] = true
+		if batch.Selector == nil && len(batch.Kinds) == 0 {
+			invalid("spec.syncPolicy.syncStrategy.batch.batches[%d] must have a selector or kinds", i)
+		}
+		if batch.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(batch.Selector); err != nil {
+				invalid("spec.syncPolicy.syncStrategy.batch.batches[%d].selector is invalid: %v", i, err)
+			}
+		}
+		if _, err := batch.GetPause(); err != nil {
+			invalid("spec.syncPolicy.syncStrategy.batch.batches[%d].pause is invalid: %v", i, err)
+		}
+	}
+	return conditions
+}
+
 func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
 	if hydrator.DrySource.RepoURL == "" {
@@ -609,6 +708,37 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.path is required",
 		})
 	}
+	if _, err := hydrator.GetSchedule(); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.schedule is invalid: " + err.Error(),
+		})
+	}
+	if hydrator.SyncSource.RepoURL != "" {
+		switch {
+		case !hydrator.SyncSource.IsOCI():
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.syncSource.repoURL must be an OCI repository URL (oci://)",
+			})
+		case hydrator.HydrateTo != nil:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.hydrateTo is not supported when spec.sourceHydrator.syncSource.repoURL is set",
+			})
+		case !ociTagPattern.MatchString(hydrator.SyncSource.TargetBranch):
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.syncSource.targetBranch %q is not a valid OCI tag", hydrator.SyncSource.TargetBranch),
+			})
+		}
+	}
+	if hydrator.DrySource.HasMultipleSources() && !slices.ContainsFunc(hydrator.DrySource.Sources, hydrator.DrySource.IsDryRepoSource) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "when spec.sourceHydrator.drySource.sources is set, at least one source must use spec.sourceHydrator.drySource.repoURL and spec.sourceHydrator.drySource.targetRevision",
+		})
+	}
 	return conditions
 }
 
@@ -629,6 +759,26 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
 			})
 		}
+		if spec.SourceHydrator.SyncSource.IsOCI() && !proj.IsSourcePermitted(spec.SourceHydrator.GetSyncSource()) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.SyncSource.RepoURL, proj.Name),
+			})
+		}
+		for _, source := range spec.SourceHydrator.DrySource.Sources {
+			condition := validateSourcePermissions(source, true)
+			if len(condition) > 0 {
+				conditions = append(conditions, condition...)
+				return conditions, nil
+			}
+
+			if !proj.IsSourcePermitted(source) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name),
+				})
+			}
+		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
 			condition := validateSourcePermissions(source, spec.HasMultipleSources())
@@ -658,6 +808,11 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	conditions = append(conditions, validateDependencies(spec.DependsOn)...)
+	if spec.SyncPolicy != nil {
+		conditions = append(conditions, validateSyncStrategy(spec.SyncPolicy.SyncStrategy)...)
+	}
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -838,7 +993,7 @@ func verifyGenerateManifests(
 		}
 
 		verifySignature := false
-		if len(proj.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled() {
+		if proj.HasSignatureKeys() && gpg.IsGPGEnabled() {
 			verifySignature = true
 		}
 
@@ -877,7 +1032,7 @@ func verifyGenerateManifests(
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enableGenerateManifests,
 			NoRevisionCache:                 true,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              app.Spec.HasMultipleSources() || len(sources) > 1,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -15,10 +15,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -49,6 +52,45 @@ func TestRefreshApp(t *testing.T) {
 	// assert.True(t, ok)
 }
 
+func TestHydrateApp(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	appClientset := appclientset.NewSimpleClientset(&testApp)
+	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+	_, err := HydrateApp(appIf, "test-app", "abc123", []string{"1.2.3", "abc123"})
+	require.NoError(t, err)
+	app, err := appIf.Get(t.Context(), "test-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "manual", app.Annotations[argoappv1.AnnotationKeyHydrate])
+	assert.Equal(t, "abc123", app.Annotations[argoappv1.AnnotationKeyHydrateRevision])
+	assert.Equal(t, "1.2.3,abc123", app.Annotations[argoappv1.AnnotationKeyHydrateDryRevisions])
+
+	// Requesting the target revision removes the revisions of the previous request.
+	_, err = HydrateApp(appIf, "test-app", "", nil)
+	require.NoError(t, err)
+	app, err = appIf.Get(t.Context(), "test-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "manual", app.Annotations[argoappv1.AnnotationKeyHydrate])
+	assert.NotContains(t, app.Annotations, argoappv1.AnnotationKeyHydrateRevision)
+	assert.NotContains(t, app.Annotations, argoappv1.AnnotationKeyHydrateDryRevisions)
+}
+
+func TestHydrateApp_Conflict(t *testing.T) {
+	var testApp argoappv1.Application
+	testApp.Name = "test-app"
+	testApp.Namespace = "default"
+	appClientset := appclientset.NewSimpleClientset(&testApp)
+	appClientset.PrependReactor("patch", "applications", func(_ kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, "test-app", errors.New("conflict"))
+	})
+	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+	app, err := HydrateApp(appIf, "test-app", "", nil)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsConflict(err))
+	assert.Nil(t, app)
+}
+
 func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	projName := "default"
 	namespace := "default"
@@ -304,6 +346,61 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsInvalidDependencies(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		DependsOn: []argoappv1.ApplicationDependency{
+			{Name: "valid"},
+			{},
+			{Name: "both", Selector: &metav1.LabelSelector{}},
+			{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Invalid"}}}},
+		},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.dependsOn[1] must have either a name or a selector"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.dependsOn[2] must have either a name or a selector"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.dependsOn[3].selector is invalid: \"Invalid\" is not a valid label selector operator"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"},
+	})
+}
+
+func TestValidatePermissionsInvalidSyncStrategy(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		SyncPolicy: &argoappv1.SyncPolicy{
+			SyncStrategy: &argoappv1.SyncStrategy{
+				Batch: &argoappv1.SyncStrategyBatch{
+					Batches: []argoappv1.SyncBatch{
+						{Name: "canary", Kinds: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}}, Pause: "5m", Approval: true},
+						{Name: "canary", Selector: &metav1.LabelSelector{}},
+						{Kinds: []metav1.GroupKind{{Kind: "Service"}}, Pause: "soon"},
+						{Name: "rest"},
+					},
+				},
+			},
+		},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.syncPolicy.syncStrategy.batch.batches[1].name canary is not unique"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.syncPolicy.syncStrategy.batch.batches[2].name is required"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.syncPolicy.syncStrategy.batch.batches[2].pause is invalid: invalid pause of batch : time: invalid duration \"soon\""},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.syncPolicy.syncStrategy.batch.batches[3] must have a selector or kinds"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"},
+	})
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -751,6 +848,109 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "is required if the manifest source is a helm chart")
 	})
 
+	t.Run("Dry sources must use the dry repository", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource: argoappv1.DrySource{
+					RepoURL:        "http://some/where",
+					TargetRevision: "main",
+					Sources: argoappv1.ApplicationSources{
+						{RepoURL: "oci://some/charts", Chart: "somechart", TargetRevision: "1.4.1"},
+					},
+				},
+				SyncSource: argoappv1.SyncSource{TargetBranch: "hydrated", Path: "app"},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "at least one source must use spec.sourceHydrator.drySource.repoURL")
+	})
+
+	t.Run("Hydration schedule must be valid", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource:  argoappv1.DrySource{RepoURL: "http://some/where", TargetRevision: "main"},
+				SyncSource: argoappv1.SyncSource{TargetBranch: "hydrated", Path: "app"},
+				Schedule:   "every hour",
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "spec.sourceHydrator.schedule is invalid")
+	})
+
+	t.Run("OCI sync source", func(t *testing.T) {
+		newSpec := func(repoURL, targetBranch string, hydrateTo *argoappv1.HydrateTo) argoappv1.ApplicationSpec {
+			return argoappv1.ApplicationSpec{
+				SourceHydrator: &argoappv1.SourceHydrator{
+					DrySource:  argoappv1.DrySource{RepoURL: "http://some/where", TargetRevision: "main"},
+					SyncSource: argoappv1.SyncSource{TargetBranch: targetBranch, Path: "app", RepoURL: repoURL},
+					HydrateTo:  hydrateTo,
+				},
+			}
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		testCases := []struct {
+			name            string
+			spec            argoappv1.ApplicationSpec
+			expectedMessage string
+		}{
+			{name: "not an OCI repository", spec: newSpec("http://some/where/else", "env-dev", nil), expectedMessage: "must be an OCI repository URL"},
+			{name: "hydrateTo", spec: newSpec("oci://some/where", "env-dev", &argoappv1.HydrateTo{TargetBranch: "env-dev-next"}), expectedMessage: "spec.sourceHydrator.hydrateTo is not supported"},
+			{name: "invalid tag", spec: newSpec("oci://some/where", "env/dev", nil), expectedMessage: `"env/dev" is not a valid OCI tag`},
+			{name: "not permitted", spec: newSpec("oci://some/where", "env-dev", nil), expectedMessage: "application repo oci://some/where is not permitted"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				db := &dbmocks.ArgoDB{}
+				conditions, err := ValidatePermissions(t.Context(), &tc.spec, &proj, db)
+				require.NoError(t, err)
+				require.NotEmpty(t, conditions)
+				assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+				assert.Contains(t, conditions[0].Message, tc.expectedMessage)
+			})
+		}
+	})
+
+	t.Run("Dry source is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource: argoappv1.DrySource{
+					RepoURL:        "http://some/where",
+					TargetRevision: "main",
+					Sources: argoappv1.ApplicationSources{
+						{RepoURL: "oci://some/charts", Chart: "somechart", TargetRevision: "1.4.1"},
+						{RepoURL: "http://some/where", TargetRevision: "main", Ref: "values"},
+					},
+				},
+				SyncSource: argoappv1.SyncSource{TargetBranch: "hydrated", Path: "app"},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		db := &dbmocks.ArgoDB{}
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.NotEmpty(t, conditions)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "application repo oci://some/charts is not permitted")
+	})
+
 	t.Run("Application source is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
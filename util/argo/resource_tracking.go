@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/argoproj/gitops-engine/pkg/sync/applyset"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -40,6 +42,9 @@ type AppInstanceValue struct {
 	Name            string
 }
 
+// applySetTooling identifies Argo CD as the tool managing the ApplySets of Applications
+const applySetTooling = "argocd/v3"
+
 type resourceTracking struct{}
 
 func NewResourceTracking() ResourceTracking {
@@ -83,7 +88,7 @@ func (rt *resourceTracking) GetAppName(un *unstructured.Unstructured, key string
 		return label
 	case v1alpha1.TrackingMethodAnnotationAndLabel:
 		return retrieveAppInstanceValue()
-	case v1alpha1.TrackingMethodAnnotation:
+	case v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndApplySet:
 		return retrieveAppInstanceValue()
 	default:
 		return retrieveAppInstanceValue()
@@ -95,7 +100,7 @@ func (rt *resourceTracking) GetAppName(un *unstructured.Unstructured, key string
 // not be parsed, it returns nil.
 func (rt *resourceTracking) GetAppInstance(un *unstructured.Unstructured, trackingMethod v1alpha1.TrackingMethod, instanceID string) *AppInstanceValue {
 	switch trackingMethod {
-	case v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndLabel:
+	case v1alpha1.TrackingMethodAnnotation, v1alpha1.TrackingMethodAnnotationAndLabel, v1alpha1.TrackingMethodAnnotationAndApplySet:
 		return rt.getAppInstanceValue(un, instanceID)
 	default:
 		return nil
@@ -166,6 +171,15 @@ func (rt *resourceTracking) SetAppInstance(un *unstructured.Unstructured, key, v
 			return fmt.Errorf("failed to set app instance label: %w", err)
 		}
 		return nil
+	case v1alpha1.TrackingMethodAnnotationAndApplySet:
+		if err := setAppInstanceAnnotation(); err != nil {
+			return err
+		}
+		// the parent of the ApplySet is tracked by the application, but is not a member of the ApplySet itself
+		if !applyset.IsParent(un) {
+			applyset.SetPartOf(un, ApplySetID(val, namespace))
+		}
+		return nil
 	default:
 		return setAppInstanceAnnotation()
 	}
@@ -197,6 +211,15 @@ func (rt *resourceTracking) RemoveAppInstance(un *unstructured.Unstructured, tra
 			return err
 		}
 		return nil
+	case v1alpha1.TrackingMethodAnnotationAndApplySet:
+		if err := kube.RemoveAnnotation(un, common.AnnotationKeyAppInstance); err != nil {
+			return err
+		}
+		if err := kube.RemoveAnnotation(un, common.AnnotationInstallationID); err != nil {
+			return err
+		}
+		applyset.RemovePartOf(un)
+		return nil
 	default:
 		// By default, only app instance annotations are set and not labels
 		// hence the default case should be only to remove annotations and not labels
@@ -211,6 +234,33 @@ func (rt *resourceTracking) RemoveAppInstance(un *unstructured.Unstructured, tra
 	return nil
 }
 
+// ApplySetParentName returns the name of the ApplySet parent Secret of the application with the given instance name
+func ApplySetParentName(appInstanceName string) string {
+	// instance names of applications outside the control plane namespace are <namespace>_<name>, and underscores are
+	// not allowed in resource names
+	return "argocd-applyset-" + strings.ReplaceAll(appInstanceName, "_", ".")
+}
+
+// ApplySetParentNamespace returns the namespace of the ApplySet parent Secret of an application with the given
+// destination namespace, which is the default namespace if the application has no destination namespace
+func ApplySetParentNamespace(namespace string) string {
+	if namespace == "" {
+		return metav1.NamespaceDefault
+	}
+	return namespace
+}
+
+// ApplySetID returns the ID of the ApplySet of the application with the given instance name and destination namespace
+func ApplySetID(appInstanceName, namespace string) string {
+	return applyset.SecretID(ApplySetParentName(appInstanceName), ApplySetParentNamespace(namespace))
+}
+
+// NewApplySetParent returns the ApplySet parent Secret of the application with the given instance name and destination
+// namespace, which records the group kinds and namespaces of the given target resources of the application
+func NewApplySetParent(appInstanceName, namespace string, targets []*unstructured.Unstructured) *unstructured.Unstructured {
+	return applyset.NewSecretParent(ApplySetParentName(appInstanceName), ApplySetParentNamespace(namespace), applySetTooling, targets)
+}
+
 // BuildAppInstanceValue build resource tracking id in format <application-name>;<group>/<kind>/<namespace>/<name>
 func (rt *resourceTracking) BuildAppInstanceValue(value AppInstanceValue) string {
 	return fmt.Sprintf("%s:%s/%s:%s/%s", value.ApplicationName, value.Group, value.Kind, value.Namespace, value.Name)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/util/kube"
 
+	"github.com/argoproj/gitops-engine/pkg/sync/applyset"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
@@ -180,6 +181,50 @@ func TestRemoveAppInstance_AnnotationAndLabel(t *testing.T) {
 	assert.NotContains(t, obj.GetLabels(), common.LabelKeyAppInstance)
 }
 
+func TestSetAppInstanceAnnotationAndApplySet(t *testing.T) {
+	yamlBytes, err := os.ReadFile("testdata/svc.yaml")
+	require.NoError(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	require.NoError(t, err)
+
+	rt := NewResourceTracking()
+
+	err = rt.SetAppInstance(&obj, common.LabelKeyAppInstance, "my-app", "my-namespace", v1alpha1.TrackingMethodAnnotationAndApplySet, "")
+	require.NoError(t, err)
+
+	app := rt.GetAppName(&obj, common.LabelKeyAppInstance, v1alpha1.TrackingMethodAnnotationAndApplySet, "")
+	assert.Equal(t, "my-app", app)
+	assert.Equal(t, applyset.SecretID("argocd-applyset-my-app", "my-namespace"), obj.GetLabels()[applyset.LabelKeyPartOf])
+	assert.NotContains(t, obj.GetLabels(), common.LabelKeyAppInstance)
+
+	err = rt.RemoveAppInstance(&obj, string(v1alpha1.TrackingMethodAnnotationAndApplySet))
+	require.NoError(t, err)
+	assert.NotContains(t, obj.GetAnnotations(), common.AnnotationKeyAppInstance)
+	assert.NotContains(t, obj.GetLabels(), applyset.LabelKeyPartOf)
+}
+
+func TestNewApplySetParent(t *testing.T) {
+	svc := &unstructured.Unstructured{}
+	svc.SetAPIVersion("v1")
+	svc.SetKind("Service")
+	svc.SetName("my-service")
+
+	rt := NewResourceTracking()
+
+	parent := NewApplySetParent("apps_my-app", "", []*unstructured.Unstructured{svc})
+	assert.Equal(t, "argocd-applyset-apps.my-app", parent.GetName())
+	assert.Equal(t, "default", parent.GetNamespace())
+	assert.Equal(t, ApplySetID("apps_my-app", ""), parent.GetLabels()[applyset.LabelKeyID])
+	assert.Equal(t, "Service", parent.GetAnnotations()[applyset.AnnotationKeyContainsGroupKinds])
+
+	// the parent is tracked by the application, but is not a member of its own ApplySet
+	err := rt.SetAppInstance(parent, common.LabelKeyAppInstance, "apps_my-app", "", v1alpha1.TrackingMethodAnnotationAndApplySet, "")
+	require.NoError(t, err)
+	assert.Equal(t, "apps_my-app", rt.GetAppName(parent, common.LabelKeyAppInstance, v1alpha1.TrackingMethodAnnotationAndApplySet, ""))
+	assert.NotContains(t, parent.GetLabels(), applyset.LabelKeyPartOf)
+}
+
 func TestRemoveAppInstance_DefaultCase(t *testing.T) {
 	yamlBytes, err := os.ReadFile("testdata/svc.yaml")
 	require.NoError(t, err)
@@ -0,0 +1,211 @@
+// Package cue provides a wrapper around the `cue` command-line tool to generate manifests from CUE packages.
+package cue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+)
+
+// ModuleDir is the name of the directory holding the module file of a CUE module
+const ModuleDir = "cue.mod"
+
+// tagAttributeRegex matches @tag attributes and their (optional) default value on the same line, e.g.
+// `env: *"dev" | string @tag(env)`.
+var tagAttributeRegex = regexp.MustCompile(`(?:\*\s*("(?:[^"\\]|\\.)*"|[^\s|@]+)[^@\n]*)?@tag\(\s*([A-Za-z_$][\w$-]*)\s*(?:,[^)]*)?\)`)
+
+// CUE provides wrapper functionality around the `cue` command.
+type CUE interface {
+	// Export evaluates the package and returns the Kubernetes manifests it defines, as well as the command which was
+	// run.
+	Export(ctx context.Context, opts *v1alpha1.ApplicationSourceCUE, envVars *v1alpha1.Env) ([]*unstructured.Unstructured, string, error)
+	// Tags returns the tags declared in the CUE files of the package, with their default values.
+	Tags(opts *v1alpha1.ApplicationSourceCUE) ([]v1alpha1.CUETag, error)
+}
+
+// NewCUEApp create a new wrapper to run commands on the `cue` command-line tool.
+func NewCUEApp(repoRoot string, path string) CUE {
+	return &cue{repoRoot: repoRoot, path: path}
+}
+
+type cue struct {
+	// path to the Git repository root
+	repoRoot string
+	// path inside the checked out tree
+	path string
+}
+
+// validatePackage returns an error if the given package is not a package inside the path of the application. Packages
+// are given as relative directories (e.g. "./config"), optionally with a package qualifier (e.g. "./config:prod" or
+// ":prod").
+func validatePackage(pkg string) error {
+	if pkg == "" {
+		return nil
+	}
+	dir, _, _ := strings.Cut(pkg, ":")
+	if dir == "" {
+		return nil
+	}
+	if dir != "." && dir != "./..." && !strings.HasPrefix(dir, "./") {
+		return fmt.Errorf("CUE package %q must be a relative directory starting with ./", pkg)
+	}
+	for _, segment := range strings.Split(dir, "/") {
+		if segment == ".." {
+			return fmt.Errorf("CUE package %q must not be outside of the application path", pkg)
+		}
+	}
+	return nil
+}
+
+// packageDir returns the directory of the given package
+func (c *cue) packageDir(pkg string) string {
+	dir, _, _ := strings.Cut(pkg, ":")
+	dir = strings.TrimSuffix(dir, "/...")
+	return filepath.Join(c.path, filepath.FromSlash(dir))
+}
+
+func (c *cue) Export(ctx context.Context, opts *v1alpha1.ApplicationSourceCUE, envVars *v1alpha1.Env) ([]*unstructured.Unstructured, string, error) {
+	if opts == nil {
+		opts = &v1alpha1.ApplicationSourceCUE{}
+	}
+	if err := validatePackage(opts.Package); err != nil {
+		return nil, "", err
+	}
+
+	args := []string{"export", "--out", "json"}
+	if opts.Expression != "" {
+		args = append(args, "--expression", opts.Expression)
+	}
+	for _, tag := range opts.Tags {
+		value := tag.Value
+		if envVars != nil {
+			value = envVars.Envsubst(value)
+		}
+		args = append(args, "--inject", tag.Name+"="+value)
+	}
+	if opts.Package != "" {
+		args = append(args, opts.Package)
+	}
+
+	cmd := exec.CommandContext(ctx, "cue", args...)
+	cmd.Dir = c.path
+	cmd.Env = os.Environ()
+	if envVars != nil {
+		cmd.Env = append(cmd.Env, envVars.Environ()...)
+	}
+	command := strings.ReplaceAll(executil.GetCommandArgsToLog(cmd), c.repoRoot, ".")
+	out, err := executil.Run(cmd)
+	if err != nil {
+		return nil, command, fmt.Errorf("failed to evaluate CUE package: %w", err)
+	}
+
+	objs, err := parseExportOutput([]byte(out))
+	if err != nil {
+		return nil, command, err
+	}
+	return objs, command, nil
+}
+
+// parseExportOutput returns the Kubernetes manifests of the JSON output of `cue export`. The output may be a single
+// manifest, a list of manifests, or an object holding manifests or lists of manifests in its fields, in which case the
+// manifests are returned in the order of the field names.
+func parseExportOutput(out []byte) ([]*unstructured.Unstructured, error) {
+	var value any
+	if err := json.Unmarshal(out, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal CUE output: %w", err)
+	}
+	var objs []*unstructured.Unstructured
+	if err := collectManifests(value, "", &objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func collectManifests(value any, path string, objs *[]*unstructured.Unstructured) error {
+	switch v := value.(type) {
+	case []any:
+		for i, item := range v {
+			if err := collectManifests(item, fmt.Sprintf("%s[%d]", path, i), objs); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		if _, ok := v["kind"]; ok {
+			if _, ok := v["apiVersion"]; ok {
+				*objs = append(*objs, &unstructured.Unstructured{Object: v})
+				return nil
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := collectManifests(v[k], path+"."+k, objs); err != nil {
+				return err
+			}
+		}
+	default:
+		if path == "" {
+			return errors.New("CUE output is not an object or a list")
+		}
+		return fmt.Errorf("CUE output field %s is not a Kubernetes manifest", strings.TrimPrefix(path, "."))
+	}
+	return nil
+}
+
+func (c *cue) Tags(opts *v1alpha1.ApplicationSourceCUE) ([]v1alpha1.CUETag, error) {
+	pkg := ""
+	if opts != nil {
+		pkg = opts.Package
+	}
+	if err := validatePackage(pkg); err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(c.packageDir(pkg), "*.cue"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var tags []v1alpha1.CUETag
+	seen := map[string]bool{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(file), err)
+		}
+		for _, match := range tagAttributeRegex.FindAllStringSubmatch(string(data), -1) {
+			name := match[2]
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			value := match[1]
+			if unquoted, err := strconv.Unquote(value); err == nil {
+				value = unquoted
+			}
+			tags = append(tags, v1alpha1.CUETag{Name: name, Value: value})
+		}
+	}
+	return tags, nil
+}
+
+// IsModuleFile returns true if the given path is the module file of a CUE module
+func IsModuleFile(path string) bool {
+	return filepath.Base(path) == "module.cue" && filepath.Base(filepath.Dir(path)) == ModuleDir
+}
@@ -0,0 +1,82 @@
+package cue
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestValidatePackage(t *testing.T) {
+	for _, pkg := range []string{"", ".", "./...", "./config", "./config:prod", ":prod"} {
+		require.NoError(t, validatePackage(pkg), pkg)
+	}
+	for _, pkg := range []string{"config", "/etc", "../other", "./config/../../other:prod", "example.com/module@v0"} {
+		require.Error(t, validatePackage(pkg), pkg)
+	}
+}
+
+func TestParseExportOutput(t *testing.T) {
+	t.Run("Single manifest", func(t *testing.T) {
+		objs, err := parseExportOutput([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm"}}`))
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "cm", objs[0].GetName())
+	})
+
+	t.Run("Nested manifests", func(t *testing.T) {
+		objs, err := parseExportOutput([]byte(`{
+			"services": [{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "svc"}}],
+			"deployments": {"web": {"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web"}}}
+		}`))
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+		assert.Equal(t, "Deployment", objs[0].GetKind())
+		assert.Equal(t, "Service", objs[1].GetKind())
+	})
+
+	t.Run("Not a manifest", func(t *testing.T) {
+		_, err := parseExportOutput([]byte(`{"values": {"replicas": 3}}`))
+		require.EqualError(t, err, "CUE output field values.replicas is not a Kubernetes manifest")
+	})
+
+	t.Run("Scalar output", func(t *testing.T) {
+		_, err := parseExportOutput([]byte(`"text"`))
+		require.EqualError(t, err, "CUE output is not an object or a list")
+	})
+}
+
+func TestTags(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.cue"), []byte(`package app
+
+env:      *"dev" | string @tag(env)
+replicas: *1 | int        @tag(replicas, type=int)
+image:    string          @tag(image)
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.cue"), []byte(`package app
+
+region: string @tag(env)
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values.yaml"), []byte(`foo: "@tag(ignored)"`), 0o644))
+
+	tags, err := NewCUEApp(dir, dir).Tags(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.CUETag{
+		{Name: "env", Value: "dev"},
+		{Name: "replicas", Value: "1"},
+		{Name: "image"},
+	}, tags)
+
+	_, err = NewCUEApp(dir, dir).Tags(&v1alpha1.ApplicationSourceCUE{Package: "../other"})
+	require.Error(t, err)
+}
+
+func TestIsModuleFile(t *testing.T) {
+	assert.True(t, IsModuleFile("app/cue.mod/module.cue"))
+	assert.False(t, IsModuleFile("app/module.cue"))
+}
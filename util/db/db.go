@@ -122,6 +122,13 @@ type ArgoDB interface {
 	// DeleteGPGPublicKey removes a GPG public key from the configuration
 	DeleteGPGPublicKey(ctx context.Context, keyID string) error
 
+	// ListConfiguredSSHSigningKeys returns all SSH signing keys that are configured
+	ListConfiguredSSHSigningKeys(ctx context.Context) (map[string]*appv1.SSHSigningKey, error)
+	// AddSSHSigningKey adds an SSH signing key to the configuration
+	AddSSHSigningKey(ctx context.Context, keyData string) (map[string]*appv1.SSHSigningKey, []string, error)
+	// DeleteSSHSigningKey removes an SSH signing key from the configuration
+	DeleteSSHSigningKey(ctx context.Context, fingerprint string) error
+
 	// GetApplicationControllerReplicas gets the replicas of application controller
 	GetApplicationControllerReplicas() int
 }
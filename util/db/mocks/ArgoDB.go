@@ -115,6 +115,82 @@ func (_c *ArgoDB_AddGPGPublicKey_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// AddSSHSigningKey provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) AddSSHSigningKey(ctx context.Context, keyData string) (map[string]*v1alpha1.SSHSigningKey, []string, error) {
+	ret := _mock.Called(ctx, keyData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSSHSigningKey")
+	}
+
+	var r0 map[string]*v1alpha1.SSHSigningKey
+	var r1 []string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string]*v1alpha1.SSHSigningKey, []string, error)); ok {
+		return returnFunc(ctx, keyData)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string]*v1alpha1.SSHSigningKey); ok {
+		r0 = returnFunc(ctx, keyData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.SSHSigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) []string); ok {
+		r1 = returnFunc(ctx, keyData)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, keyData)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ArgoDB_AddSSHSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSSHSigningKey'
+type ArgoDB_AddSSHSigningKey_Call struct {
+	*mock.Call
+}
+
+// AddSSHSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyData string
+func (_e *ArgoDB_Expecter) AddSSHSigningKey(ctx interface{}, keyData interface{}) *ArgoDB_AddSSHSigningKey_Call {
+	return &ArgoDB_AddSSHSigningKey_Call{Call: _e.mock.On("AddSSHSigningKey", ctx, keyData)}
+}
+
+func (_c *ArgoDB_AddSSHSigningKey_Call) Run(run func(ctx context.Context, keyData string)) *ArgoDB_AddSSHSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_AddSSHSigningKey_Call) Return(stringToSSHSigningKey map[string]*v1alpha1.SSHSigningKey, strings []string, err error) *ArgoDB_AddSSHSigningKey_Call {
+	_c.Call.Return(stringToSSHSigningKey, strings, err)
+	return _c
+}
+
+func (_c *ArgoDB_AddSSHSigningKey_Call) RunAndReturn(run func(ctx context.Context, keyData string) (map[string]*v1alpha1.SSHSigningKey, []string, error)) *ArgoDB_AddSSHSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCluster provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) CreateCluster(ctx context.Context, c *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 	ret := _mock.Called(ctx, c)
@@ -763,6 +839,63 @@ func (_c *ArgoDB_DeleteRepositoryCredentials_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// DeleteSSHSigningKey provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) DeleteSSHSigningKey(ctx context.Context, fingerprint string) error {
+	ret := _mock.Called(ctx, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSSHSigningKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArgoDB_DeleteSSHSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSSHSigningKey'
+type ArgoDB_DeleteSSHSigningKey_Call struct {
+	*mock.Call
+}
+
+// DeleteSSHSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fingerprint string
+func (_e *ArgoDB_Expecter) DeleteSSHSigningKey(ctx interface{}, fingerprint interface{}) *ArgoDB_DeleteSSHSigningKey_Call {
+	return &ArgoDB_DeleteSSHSigningKey_Call{Call: _e.mock.On("DeleteSSHSigningKey", ctx, fingerprint)}
+}
+
+func (_c *ArgoDB_DeleteSSHSigningKey_Call) Run(run func(ctx context.Context, fingerprint string)) *ArgoDB_DeleteSSHSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_DeleteSSHSigningKey_Call) Return(err error) *ArgoDB_DeleteSSHSigningKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArgoDB_DeleteSSHSigningKey_Call) RunAndReturn(run func(ctx context.Context, fingerprint string) error) *ArgoDB_DeleteSSHSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteWriteRepository provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) DeleteWriteRepository(ctx context.Context, name string, project string) error {
 	ret := _mock.Called(ctx, name, project)
@@ -1787,6 +1920,68 @@ func (_c *ArgoDB_ListConfiguredGPGPublicKeys_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListConfiguredSSHSigningKeys provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) ListConfiguredSSHSigningKeys(ctx context.Context) (map[string]*v1alpha1.SSHSigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfiguredSSHSigningKeys")
+	}
+
+	var r0 map[string]*v1alpha1.SSHSigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]*v1alpha1.SSHSigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]*v1alpha1.SSHSigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.SSHSigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_ListConfiguredSSHSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfiguredSSHSigningKeys'
+type ArgoDB_ListConfiguredSSHSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ListConfiguredSSHSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ArgoDB_Expecter) ListConfiguredSSHSigningKeys(ctx interface{}) *ArgoDB_ListConfiguredSSHSigningKeys_Call {
+	return &ArgoDB_ListConfiguredSSHSigningKeys_Call{Call: _e.mock.On("ListConfiguredSSHSigningKeys", ctx)}
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigningKeys_Call) Run(run func(ctx context.Context)) *ArgoDB_ListConfiguredSSHSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigningKeys_Call) Return(stringToSSHSigningKey map[string]*v1alpha1.SSHSigningKey, err error) *ArgoDB_ListConfiguredSSHSigningKeys_Call {
+	_c.Call.Return(stringToSSHSigningKey, err)
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigningKeys_Call) RunAndReturn(run func(ctx context.Context) (map[string]*v1alpha1.SSHSigningKey, error)) *ArgoDB_ListConfiguredSSHSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListHelmRepositories provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) ListHelmRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
 	ret := _mock.Called(ctx)
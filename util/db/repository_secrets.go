@@ -349,6 +349,9 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 		NoProxy:                    string(secretCopy.Data["noProxy"]),
 		Project:                    string(secretCopy.Data["project"]),
 		GCPServiceAccountKey:       string(secretCopy.Data["gcpServiceAccountKey"]),
+		MirrorURL:                  string(secretCopy.Data["mirrorURL"]),
+		BundleURI:                  string(secretCopy.Data["bundleURI"]),
+		MirrorMaxStaleness:         string(secretCopy.Data["mirrorMaxStaleness"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secretCopy, "insecureIgnoreHostKey")
@@ -411,6 +414,12 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.Depth = depth
 
+	sparseCheckout, err := boolOrFalse(secret, "sparseCheckout")
+	if err != nil {
+		return repository, err
+	}
+	repository.SparseCheckout = sparseCheckout
+
 	return repository, nil
 }
 
@@ -446,6 +455,10 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretBool(secretCopy, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
 	updateSecretBool(secretCopy, "useAzureWorkloadIdentity", repository.UseAzureWorkloadIdentity)
 	updateSecretInt(secretCopy, "depth", repository.Depth)
+	updateSecretBool(secretCopy, "sparseCheckout", repository.SparseCheckout)
+	updateSecretString(secretCopy, "mirrorURL", repository.MirrorURL)
+	updateSecretString(secretCopy, "bundleURI", repository.BundleURI)
+	updateSecretString(secretCopy, "mirrorMaxStaleness", repository.MirrorMaxStaleness)
 	addSecretMetadata(secretCopy, s.getSecretType())
 
 	return secretCopy
@@ -468,6 +481,9 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		GCPServiceAccountKey:       string(secretCopy.Data["gcpServiceAccountKey"]),
 		Proxy:                      string(secretCopy.Data["proxy"]),
 		NoProxy:                    string(secretCopy.Data["noProxy"]),
+		MirrorURL:                  string(secretCopy.Data["mirrorURL"]),
+		BundleURI:                  string(secretCopy.Data["bundleURI"]),
+		MirrorMaxStaleness:         string(secretCopy.Data["mirrorMaxStaleness"]),
 	}
 
 	enableOCI, err := boolOrFalse(secretCopy, "enableOCI")
@@ -535,6 +551,9 @@ func (s *secretsRepositoryBackend) repoCredsToSecret(repoCreds *appsv1.RepoCreds
 	updateSecretString(secretCopy, "noProxy", repoCreds.NoProxy)
 	updateSecretBool(secretCopy, "forceHttpBasicAuth", repoCreds.ForceHttpBasicAuth)
 	updateSecretBool(secretCopy, "useAzureWorkloadIdentity", repoCreds.UseAzureWorkloadIdentity)
+	updateSecretString(secretCopy, "mirrorURL", repoCreds.MirrorURL)
+	updateSecretString(secretCopy, "bundleURI", repoCreds.BundleURI)
+	updateSecretString(secretCopy, "mirrorMaxStaleness", repoCreds.MirrorMaxStaleness)
 	addSecretMetadata(secretCopy, s.getRepoCredSecretType())
 
 	return secretCopy
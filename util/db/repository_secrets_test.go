@@ -932,6 +932,40 @@ func TestSecretsRepositoryBackend_GetAllHelmRepoCreds(t *testing.T) {
 	assert.Len(t, repoCreds, 1)
 }
 
+func TestRepositoryToSecret_SparseCheckout(t *testing.T) {
+	testee := &secretsRepositoryBackend{}
+	repo := &appsv1.Repository{
+		Repo:           "https://github.com/argoproj/argocd-example-apps",
+		SparseCheckout: true,
+	}
+	s := testee.repositoryToSecret(repo, &corev1.Secret{})
+	assert.Equal(t, []byte("true"), s.Data["sparseCheckout"])
+
+	repo, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.True(t, repo.SparseCheckout)
+}
+
+func TestRepositoryToSecret_Mirror(t *testing.T) {
+	testee := &secretsRepositoryBackend{}
+	repo := &appsv1.Repository{
+		Repo:               "https://github.com/argoproj/argocd-example-apps",
+		MirrorURL:          "https://mirror.example.com/argoproj/argocd-example-apps",
+		BundleURI:          "https://bundles.example.com/argocd-example-apps.bundle",
+		MirrorMaxStaleness: "5m",
+	}
+	s := testee.repositoryToSecret(repo, &corev1.Secret{})
+	assert.Equal(t, []byte(repo.MirrorURL), s.Data["mirrorURL"])
+	assert.Equal(t, []byte(repo.BundleURI), s.Data["bundleURI"])
+	assert.Equal(t, []byte(repo.MirrorMaxStaleness), s.Data["mirrorMaxStaleness"])
+
+	converted, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.Equal(t, repo.MirrorURL, converted.MirrorURL)
+	assert.Equal(t, repo.BundleURI, converted.BundleURI)
+	assert.Equal(t, repo.MirrorMaxStaleness, converted.MirrorMaxStaleness)
+}
+
 func TestRepoCredsToSecret(t *testing.T) {
 	clientset := getClientset()
 	testee := &secretsRepositoryBackend{db: &db{
@@ -953,6 +987,9 @@ func TestRepoCredsToSecret(t *testing.T) {
 		GithubAppId:                123,
 		GithubAppInstallationId:    456,
 		GitHubAppEnterpriseBaseURL: "GitHubAppEnterpriseBaseURL",
+		MirrorURL:                  "MirrorURL",
+		BundleURI:                  "BundleURI",
+		MirrorMaxStaleness:         "MirrorMaxStaleness",
 	}
 	s = testee.repoCredsToSecret(creds, s)
 	assert.Equal(t, []byte(creds.URL), s.Data["url"])
@@ -967,6 +1004,9 @@ func TestRepoCredsToSecret(t *testing.T) {
 	assert.Equal(t, []byte(strconv.FormatInt(creds.GithubAppId, 10)), s.Data["githubAppID"])
 	assert.Equal(t, []byte(strconv.FormatInt(creds.GithubAppInstallationId, 10)), s.Data["githubAppInstallationID"])
 	assert.Equal(t, []byte(creds.GitHubAppEnterpriseBaseURL), s.Data["githubAppEnterpriseBaseUrl"])
+	assert.Equal(t, []byte(creds.MirrorURL), s.Data["mirrorURL"])
+	assert.Equal(t, []byte(creds.BundleURI), s.Data["bundleURI"])
+	assert.Equal(t, []byte(creds.MirrorMaxStaleness), s.Data["mirrorMaxStaleness"])
 	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, s.Annotations)
 	assert.Equal(t, map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds}, s.Labels)
 }
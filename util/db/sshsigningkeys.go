@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
+)
+
+// toSSHSigningKey converts a parsed SSH public key to its API representation
+func toSSHSigningKey(key *sshsigning.PublicKey) *appsv1.SSHSigningKey {
+	return &appsv1.SSHSigningKey{
+		Fingerprint: key.Fingerprint,
+		KeyType:     key.KeyType,
+		Comment:     key.Comment,
+		KeyData:     key.KeyData,
+	}
+}
+
+// ListConfiguredSSHSigningKeys returns all configured SSH signing keys from the ConfigMap, indexed by their fingerprint
+func (db *db) ListConfiguredSSHSigningKeys(_ context.Context) (map[string]*appsv1.SSHSigningKey, error) {
+	log.Debugf("Loading SSH signing keys from config map")
+	result := make(map[string]*appsv1.SSHSigningKey)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, p := range keysCM.Data {
+		expectedFingerprint := sshsigning.Fingerprint(k)
+		if !sshsigning.IsFingerprint(expectedFingerprint) {
+			return nil, fmt.Errorf("found entry with key '%s' in ConfigMap, but this is not a valid SSH key fingerprint", k)
+		}
+		parsedKey, err := sshsigning.ParsePublicKey(p)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse SSH key for entry '%s': %w", expectedFingerprint, err)
+		}
+		if expectedFingerprint != parsedKey.Fingerprint {
+			return nil, fmt.Errorf("key parsed for entry with fingerprint '%s' had different fingerprint '%s'", expectedFingerprint, parsedKey.Fingerprint)
+		}
+		result[parsedKey.Fingerprint] = toSSHSigningKey(parsedKey)
+	}
+
+	return result, nil
+}
+
+// AddSSHSigningKey adds an SSH signing key to the configuration. If the key is configured already, its fingerprint is
+// returned as skipped.
+func (db *db) AddSSHSigningKey(ctx context.Context, keyData string) (map[string]*appsv1.SSHSigningKey, []string, error) {
+	result := make(map[string]*appsv1.SSHSigningKey)
+	skipped := make([]string, 0)
+
+	key, err := sshsigning.ParsePublicKey(keyData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmKey := sshsigning.ConfigMapKey(key.Fingerprint)
+	if _, ok := keysCM.Data[cmKey]; ok {
+		skipped = append(skipped, key.Fingerprint)
+		log.Debugf("Not adding incoming SSH key with fingerprint=%s because it is configured already", key.Fingerprint)
+		return result, skipped, nil
+	}
+
+	if keysCM.Data == nil {
+		keysCM.Data = make(map[string]string)
+	}
+	result[key.Fingerprint] = toSSHSigningKey(key)
+	keysCM.Data[cmKey] = key.KeyData
+	log.Debugf("Adding incoming SSH key with fingerprint=%s to database", key.Fingerprint)
+
+	err = db.settingsMgr.SaveSSHSigningKeyData(ctx, keysCM.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, skipped, nil
+}
+
+// DeleteSSHSigningKey deletes the SSH signing key with the given fingerprint from the configuration
+func (db *db) DeleteSSHSigningKey(ctx context.Context, fingerprint string) error {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	cmKey := sshsigning.ConfigMapKey(sshsigning.Fingerprint(fingerprint))
+	if _, ok := keysCM.Data[cmKey]; !ok {
+		return fmt.Errorf("no such key configured: %s", fingerprint)
+	}
+
+	delete(keysCM.Data, cmKey)
+
+	err = db.settingsMgr.SaveSSHSigningKeyData(ctx, keysCM.Data)
+	return err
+}
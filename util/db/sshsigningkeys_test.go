@@ -0,0 +1,127 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	sshSigningKeyJaneDoe            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFKv3xZaqHP8i6dEauYNisv1I0EB/vD5kBPyVPbaNQoN jane@example.com"
+	sshSigningKeyJaneDoeFingerprint = "SHA256:PI8johZKBD4JcrMk5xoNLg9sUfZwm3A8gY3x47ER2Ls"
+	sshSigningKeyJaneDoeCMKey       = "SHA256.PI8johZKBD4JcrMk5xoNLg9sUfZwm3A8gY3x47ER2Ls"
+	sshSigningKeyAnon               = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIH9aJ+TmuiGbJG//fJnTM6flxI0GgORmy/5nDs9BbcXG"
+)
+
+// Returns a fake client set with the given SSH signing keys for use in tests
+func getSSHSigningKeysClientset(data map[string]string) *fake.Clientset {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}
+	keysCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSSHSigningKeysConfigMapName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+
+	return fake.NewClientset([]runtime.Object{&cm, &keysCM}...)
+}
+
+func Test_ListConfiguredSSHSigningKeys(t *testing.T) {
+	t.Run("Good mapping", func(t *testing.T) {
+		clientset := getSSHSigningKeysClientset(map[string]string{sshSigningKeyJaneDoeCMKey: sshSigningKeyJaneDoe})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		keys, err := db.ListConfiguredSSHSigningKeys(t.Context())
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		key := keys[sshSigningKeyJaneDoeFingerprint]
+		require.NotNil(t, key)
+		assert.Equal(t, "ssh-ed25519", key.KeyType)
+		assert.Equal(t, "jane@example.com", key.Comment)
+		assert.Equal(t, sshSigningKeyJaneDoe, key.KeyData)
+	})
+
+	t.Run("No keys", func(t *testing.T) {
+		clientset := getSSHSigningKeysClientset(nil)
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		keys, err := db.ListConfiguredSSHSigningKeys(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("Wrong mapping", func(t *testing.T) {
+		clientset := getSSHSigningKeysClientset(map[string]string{sshSigningKeyJaneDoeCMKey: sshSigningKeyAnon})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		_, err := db.ListConfiguredSSHSigningKeys(t.Context())
+		require.ErrorContains(t, err, "had different fingerprint")
+	})
+
+	t.Run("Garbage key", func(t *testing.T) {
+		clientset := getSSHSigningKeysClientset(map[string]string{sshSigningKeyJaneDoeCMKey: "garbage"})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		_, err := db.ListConfiguredSSHSigningKeys(t.Context())
+		require.Error(t, err)
+	})
+
+	t.Run("Garbage ConfigMap key", func(t *testing.T) {
+		clientset := getSSHSigningKeysClientset(map[string]string{"wullerosekaufe": sshSigningKeyJaneDoe})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		_, err := db.ListConfiguredSSHSigningKeys(t.Context())
+		require.ErrorContains(t, err, "not a valid SSH key fingerprint")
+	})
+}
+
+func Test_AddAndDeleteSSHSigningKey(t *testing.T) {
+	clientset := getSSHSigningKeysClientset(nil)
+	settings := settings.NewSettingsManager(t.Context(), clientset, testNamespace)
+	db := NewDB(testNamespace, settings, clientset)
+
+	// Key should be added
+	keys, skipped, err := db.AddSSHSigningKey(t.Context(), sshSigningKeyJaneDoe)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Empty(t, skipped)
+	cm, err := settings.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{sshSigningKeyJaneDoeCMKey: sshSigningKeyJaneDoe}, cm.Data)
+
+	// Same key should not be added, but skipped
+	keys, skipped, err = db.AddSSHSigningKey(t.Context(), sshSigningKeyJaneDoe)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Equal(t, []string{sshSigningKeyJaneDoeFingerprint}, skipped)
+
+	// Garbage input should result in error
+	_, _, err = db.AddSSHSigningKey(t.Context(), "garbage")
+	require.Error(t, err)
+
+	// Unknown key cannot be deleted
+	err = db.DeleteSSHSigningKey(t.Context(), "SHA256:ZpDTWpBRjvig2daMxavsHTPgjQwSsCZwk06S3NpQyoE")
+	require.Error(t, err)
+
+	// Key can be deleted by its fingerprint
+	err = db.DeleteSSHSigningKey(t.Context(), sshSigningKeyJaneDoeFingerprint)
+	require.NoError(t, err)
+	cm, err = settings.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	require.NoError(t, err)
+	assert.Empty(t, cm.Data)
+}
@@ -41,11 +41,16 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
+	"github.com/argoproj/argo-cd/v3/util/sshsigning"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
 
 var ErrInvalidRepoURL = errors.New("repo URL is invalid")
 
+// sshAllowedSignersFileEnv is the environment variable passing the allowed signers file SSH signatures are verified
+// against to git-verify-wrapper.sh
+const sshAllowedSignersFileEnv = "ARGOCD_SSH_ALLOWED_SIGNERS_FILE"
+
 // builtinGitConfig configuration contains statements that are needed
 // for correct ArgoCD operation. These settings will override any
 // user-provided configuration of same options.
@@ -136,6 +141,9 @@ type Client interface {
 	IsRevisionPresent(revision string) bool
 	// SetAuthor sets the author name and email in the git configuration.
 	SetAuthor(name, email string) (string, error)
+	// SetSigningKey configures git to sign commits with the given key. The format is the value of git's gpg.format
+	// setting, i.e. openpgp or ssh. If gnupgHome is not empty, openpgp keys are looked up in that GnuPG home directory.
+	SetSigningKey(format, key, gnupgHome string) (string, error)
 	// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 	CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error)
 	// CheckoutOrNew checks out the given branch. If the branch does not exist, it creates an empty branch based on
@@ -177,6 +185,18 @@ type nativeGitClient struct {
 	noProxy string
 	// git configuration environment variables
 	gitConfigEnv []string
+	// GnuPG home directory used to sign commits, if not the default one
+	signingGnuPGHome string
+	// Whether to fetch without blobs, which are then fetched on demand
+	partialClone bool
+	// directories the working tree is restricted to, if not the whole tree
+	sparseCheckoutPaths []string
+	// URL of a read-only mirror objects are fetched from before they are fetched from the repository
+	mirrorURL string
+	// for how long branches may be resolved from the mirror after the refs were last listed from the repository
+	mirrorMaxStaleness time.Duration
+	// URI of a Git bundle an empty local repository is initialized from
+	bundleURI string
 }
 
 type runOpts struct {
@@ -233,6 +253,40 @@ func WithBuiltinGitConfig(enable bool) ClientOpts {
 	}
 }
 
+// WithPartialClone sets whether the repository is fetched without any file contents, which are then fetched on demand
+// when checking out a revision
+func WithPartialClone(enable bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = enable
+	}
+}
+
+// WithSparseCheckout restricts the working tree to the given directories, which are relative to the root of the
+// repository. The whole tree is checked out if no directories are given.
+func WithSparseCheckout(paths []string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.sparseCheckoutPaths = paths
+	}
+}
+
+// WithMirror sets a read-only mirror of the repository, which objects are fetched from anonymously before they are
+// fetched from the repository itself. Branches are resolved from the branches fetched from the mirror for up to
+// maxStaleness after the refs were last listed from the repository, and always from the repository if maxStaleness is
+// zero.
+func WithMirror(mirrorURL string, maxStaleness time.Duration) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.mirrorURL = mirrorURL
+		c.mirrorMaxStaleness = maxStaleness
+	}
+}
+
+// WithBundleURI sets the URI of a Git bundle the local repository is initialized from before its first fetch
+func WithBundleURI(bundleURI string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.bundleURI = bundleURI
+	}
+}
+
 // WithEventHandlers sets the git client event handlers
 func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	return func(c *nativeGitClient) {
@@ -449,6 +503,21 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 }
 
 func (m *nativeGitClient) fetch(ctx context.Context, revision string, depth int64) error {
+	if m.bundleURI != "" {
+		if err := m.fetchBundle(ctx); err != nil {
+			log.Warnf("Failed to initialize %s from bundle %s: %v", m.repoURL, m.bundleURI, err)
+		}
+	}
+	if m.mirrorURL != "" {
+		if err := m.fetchMirror(ctx, revision, depth); err != nil {
+			log.Warnf("Failed to fetch %s from mirror %s: %v", m.repoURL, m.mirrorURL, err)
+		} else if IsCommitSHA(revision) && m.IsRevisionPresent(revision) {
+			// a commit is identified by its content, so it does not matter where it was fetched from
+			return nil
+		}
+		// branches and tags are always fetched from the repository, which only transfers the objects the mirror did
+		// not provide
+	}
 	args := []string{"fetch", "origin"}
 	if revision != "" {
 		args = append(args, revision)
@@ -459,6 +528,9 @@ func (m *nativeGitClient) fetch(ctx context.Context, revision string, depth int6
 	} else {
 		args = append(args, "--tags")
 	}
+	if m.partialClone {
+		args = append(args, "--filter=blob:none")
+	}
 	args = append(args, "--force", "--prune")
 	return m.runCredentialedCmd(ctx, args...)
 }
@@ -576,7 +648,16 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 		revision = "origin/HEAD"
 	}
 	ctx := context.Background()
-	if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
+	partialClone, err := m.updateSparseCheckout(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to update sparse checkout: %w", err)
+	}
+	if partialClone {
+		// Checking out a revision of a partial clone fetches the missing file contents from the remote
+		if err := m.runCredentialedCmd(ctx, "checkout", "--force", revision); err != nil {
+			return "", fmt.Errorf("failed to checkout %s: %w", revision, err)
+		}
+	} else if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -610,6 +691,23 @@ func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) (stri
 	return "", nil
 }
 
+// updateSparseCheckout restricts the working tree to the sparse checkout paths of the client, or restores the whole
+// working tree if a previous checkout was restricted. Returns whether the repository is a partial clone.
+func (m *nativeGitClient) updateSparseCheckout(ctx context.Context) (bool, error) {
+	// The repository may have been cloned and checked out by a client with other options
+	cmd := exec.CommandContext(ctx, "git", "config", "--get-regexp", `^(core\.sparsecheckout|remote\.origin\.promisor)$`)
+	out, _ := m.runCmdOutput(cmd, runOpts{SkipErrorLogging: true})
+	partialClone := m.partialClone || strings.Contains(out, "remote.origin.promisor true")
+	if len(m.sparseCheckoutPaths) > 0 {
+		args := append([]string{"sparse-checkout", "set", "--cone", "--"}, m.sparseCheckoutPaths...)
+		return partialClone, m.runCredentialedCmd(ctx, args...)
+	}
+	if strings.Contains(out, "core.sparsecheckout true") {
+		return partialClone, m.runCredentialedCmd(ctx, "sparse-checkout", "disable")
+	}
+	return partialClone, nil
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -664,6 +762,9 @@ func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 		return nil, err
 	}
 	res, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds, m.proxy, m.noProxy)
+	if err == nil && m.mirrorURL != "" {
+		recordOriginRefs(m.repoURL, res)
+	}
 	if err == nil && m.gitRefCache != nil {
 		if err := m.gitRefCache.SetGitReferences(m.repoURL, res); err != nil {
 			log.Warnf("Failed to store git references to cache: %v", err)
@@ -743,6 +844,10 @@ func (m *nativeGitClient) lsRemote(revision string) (string, error) {
 	if IsCommitSHA(revision) {
 		return revision, nil
 	}
+	if hash, ok := m.resolveMirrorBranch(revision); ok {
+		log.Debugf("revision '%s' resolved to '%s' from mirror %s", revision, hash, m.mirrorURL)
+		return hash, nil
+	}
 
 	refs, err := m.getRefs()
 	if err != nil {
@@ -945,9 +1050,18 @@ func updateCommitMetadata(logCtx *log.Entry, relatedCommit *CommitMetadata, line
 	return true
 }
 
-// VerifyCommitSignature Runs verify-commit on a given revision and returns the output
+// VerifyCommitSignature Runs verify-commit on a given revision and returns the output. Commits signed with SSH keys
+// are verified against the configured SSH signing keys.
 func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error) {
-	out, err := m.runGnuPGWrapper(context.Background(), "git-verify-wrapper.sh", revision)
+	var env []string
+	allowedSigners, err := sshsigning.WriteAllowedSignersFile(common.GetSSHSigningKeysPath())
+	if err != nil {
+		log.Warnf("error writing SSH allowed signers: %v", err)
+	} else if allowedSigners != "" {
+		defer os.Remove(allowedSigners)
+		env = append(env, sshAllowedSignersFileEnv+"="+allowedSigners)
+	}
+	out, err := m.runGnuPGWrapper(context.Background(), "git-verify-wrapper.sh", env, revision)
 	if err != nil {
 		log.Errorf("error verifying commit signature: %v", err)
 		return "", errors.New("permission denied")
@@ -1016,6 +1130,22 @@ func (m *nativeGitClient) SetAuthor(name, email string) (string, error) {
 	return "", nil
 }
 
+// SetSigningKey configures git to sign commits with the given key. The format is the value of git's gpg.format
+// setting, i.e. openpgp or ssh. If gnupgHome is not empty, openpgp keys are looked up in that GnuPG home directory.
+func (m *nativeGitClient) SetSigningKey(format, key, gnupgHome string) (string, error) {
+	ctx := context.Background()
+	m.signingGnuPGHome = gnupgHome
+	out, err := m.config(ctx, "--local", "gpg.format", format)
+	if err != nil {
+		return out, err
+	}
+	out, err = m.config(ctx, "--local", "user.signingkey", key)
+	if err != nil {
+		return out, err
+	}
+	return m.config(ctx, "--local", "commit.gpgsign", "true")
+}
+
 // CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error) {
 	out, err := m.Checkout(branch, submoduleEnabled)
@@ -1031,7 +1161,7 @@ func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool)
 		}
 
 		// Make an empty initial commit.
-		out, err = m.runCmd(ctx, "commit", "--allow-empty", "-m", "Initial commit")
+		out, err = m.runCommitCmd(ctx, "--allow-empty", "-m", "Initial commit")
 		if err != nil {
 			return out, fmt.Errorf("failed to commit initial commit: %w", err)
 		}
@@ -1089,7 +1219,7 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 		return out, fmt.Errorf("failed to add files: %w", err)
 	}
 
-	out, err = m.runCmd(ctx, "commit", "-m", message)
+	out, err = m.runCommitCmd(ctx, "-m", message)
 	if err != nil {
 		if strings.Contains(out, "nothing to commit, working tree clean") {
 			return out, nil
@@ -1111,9 +1241,21 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 }
 
 // runWrapper runs a custom command with all the semantics of running the Git client
-func (m *nativeGitClient) runGnuPGWrapper(ctx context.Context, wrapper string, args ...string) (string, error) {
+func (m *nativeGitClient) runGnuPGWrapper(ctx context.Context, wrapper string, env []string, args ...string) (string, error) {
 	cmd := exec.CommandContext(ctx, wrapper, args...)
 	cmd.Env = append(cmd.Env, "GNUPGHOME="+common.GetGnuPGHomePath(), "LANG=C")
+	cmd.Env = append(cmd.Env, env...)
+	return m.runCmdOutput(cmd, runOpts{})
+}
+
+// runCommitCmd runs git commit with GNUPGHOME set, so that commits can be signed with a key from the GnuPG keyring.
+func (m *nativeGitClient) runCommitCmd(ctx context.Context, args ...string) (string, error) {
+	gnupgHome := m.signingGnuPGHome
+	if gnupgHome == "" {
+		gnupgHome = common.GetGnuPGHomePath()
+	}
+	cmd := exec.CommandContext(ctx, "git", append([]string{"commit"}, args...)...)
+	cmd.Env = append(cmd.Env, "GNUPGHOME="+gnupgHome)
 	return m.runCmdOutput(cmd, runOpts{})
 }
 
@@ -1125,7 +1267,12 @@ func (m *nativeGitClient) runCmd(ctx context.Context, args ...string) (string, e
 
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(ctx context.Context, args ...string) error {
-	closer, environ, err := m.creds.Environ()
+	return m.runCredentialedCmdWithCreds(ctx, m.creds, args...)
+}
+
+// runCredentialedCmdWithCreds runs a git command with the given credentials instead of the credentials of the repository
+func (m *nativeGitClient) runCredentialedCmdWithCreds(ctx context.Context, creds Creds, args ...string) error {
+	closer, environ, err := creds.Environ()
 	if err != nil {
 		return err
 	}
@@ -439,6 +439,53 @@ func Test_nativeGitClient_Submodule(t *testing.T) {
 	assert.Equal(t, bar+"baz\n", string(result))
 }
 
+func Test_nativeGitClient_SparseCheckout(t *testing.T) {
+	ctx := t.Context()
+	tempDir := t.TempDir()
+
+	for _, dir := range []string{"apps/foo", "apps/bar", "base"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, dir), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, dir, "kustomization.yaml"), []byte(dir), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("readme"), 0o644))
+	require.NoError(t, runCmd(ctx, tempDir, "git", "init"))
+	require.NoError(t, runCmd(ctx, tempDir, "git", "config", "uploadpack.allowFilter", "true"))
+	require.NoError(t, runCmd(ctx, tempDir, "git", "add", "."))
+	require.NoError(t, runCmd(ctx, tempDir, "git", "commit", "-m", "Initial commit"))
+
+	root := t.TempDir()
+	newClient := func(opts ...ClientOpts) Client {
+		client, err := NewClientExt("file://"+tempDir, root, NopCreds{}, true, false, "", "", opts...)
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		return client
+	}
+
+	client := newClient(WithPartialClone(true), WithSparseCheckout([]string{"apps/foo", "base"}))
+	require.NoError(t, client.Fetch("", 0))
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	_, err = client.Checkout(commitSHA, false)
+	require.NoError(t, err)
+
+	out, err := outputCmd(ctx, root, "git", "config", "remote.origin.promisor")
+	require.NoError(t, err)
+	assert.Equal(t, "true\n", string(out))
+	assert.FileExists(t, filepath.Join(root, "README.md"))
+	assert.FileExists(t, filepath.Join(root, "apps/foo/kustomization.yaml"))
+	assert.FileExists(t, filepath.Join(root, "base/kustomization.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "apps/bar/kustomization.yaml"))
+
+	// The whole working tree is restored by a client without sparse checkout paths
+	client = newClient()
+	_, err = client.Checkout(commitSHA, false)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(root, "apps/bar/kustomization.yaml"))
+	out, err = outputCmd(ctx, root, "git", "config", "--bool", "core.sparseCheckout")
+	require.NoError(t, err)
+	assert.Equal(t, "false\n", string(out))
+}
+
 func TestNewClient_invalidSSHURL(t *testing.T) {
 	client, err := NewClient("ssh://bitbucket.org:org/repo", NopCreds{}, false, false, "", "")
 	assert.Nil(t, client)
@@ -582,6 +629,55 @@ func Test_nativeGitClient_SetAuthor(t *testing.T) {
 	require.Equal(t, expectedEmail, actualEmail)
 }
 
+func Test_nativeGitClient_SetSigningKey(t *testing.T) {
+	ctx := t.Context()
+
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+	err = runCmd(ctx, tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+	require.NoError(t, err)
+	gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	keyPath := filepath.Join(t.TempDir(), "signing.key")
+	err = runCmd(ctx, t.TempDir(), "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	out, err = client.SetSigningKey("ssh", keyPath, "")
+	require.NoError(t, err, "error output: ", out)
+
+	for key, expected := range map[string]string{"gpg.format": "ssh", "user.signingkey": keyPath, "commit.gpgsign": "true"} {
+		actual, err := outputCmd(ctx, client.Root(), "git", "config", "--local", key)
+		require.NoError(t, err)
+		assert.Equal(t, expected, strings.TrimSpace(string(actual)))
+	}
+
+	err = client.Fetch(branch, 0)
+	require.NoError(t, err)
+	out, err = client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	err = runCmd(ctx, client.Root(), "touch", "README.md")
+	require.NoError(t, err)
+	out, err = client.CommitAndPush(branch, "docs: README")
+	require.NoError(t, err, "error output: %s", out)
+
+	// The pushed commit carries an SSH signature.
+	commit, err := outputCmd(ctx, tempDir, "git", "cat-file", "commit", "HEAD")
+	require.NoError(t, err)
+	assert.Contains(t, string(commit), "-----BEGIN SSH SIGNATURE-----")
+}
+
 func Test_nativeGitClient_CheckoutOrOrphan(t *testing.T) {
 	t.Run("checkout to an existing branch", func(t *testing.T) {
 		// not main or master
@@ -0,0 +1,184 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/env"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// mirrorRemoteName is the name of the remote of the mirror of a repository. Its branches are fetched into their own
+// refs, so that the mirror cannot move the branches fetched from the repository itself.
+const mirrorRemoteName = "mirror"
+
+// originRefs records when the refs of a repository with a mirror were last listed from the repository itself, and the
+// branch its HEAD pointed to
+type originRefs struct {
+	listedAt time.Time
+	head     string
+}
+
+// originRefsListed records the last listing of the refs of repositories with a mirror, keyed by the normalized
+// repository URL
+var originRefsListed sync.Map
+
+var bundleDownloadTimeout = env.ParseDurationFromEnv("ARGOCD_GIT_BUNDLE_DOWNLOAD_TIMEOUT", 10*time.Minute, 0, math.MaxInt64)
+
+// recordOriginRefs records that the given refs were listed from the repository itself
+func recordOriginRefs(repoURL string, refs []*plumbing.Reference) {
+	listed := originRefs{listedAt: time.Now()}
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD && ref.Type() == plumbing.SymbolicReference {
+			listed.head = ref.Target().Short()
+		}
+	}
+	originRefsListed.Store(NormalizeGitURLAllowInvalid(repoURL), listed)
+}
+
+// resolveMirrorBranch resolves a branch, or HEAD, from the branches fetched from the mirror into refs/remotes/mirror/*,
+// if the refs were listed from the repository itself within the max staleness of the mirror. A branch is not resolved
+// from the mirror if it was fetched from the repository at another commit, e.g. since the mirror lags behind the
+// repository, so that the resolved revision never moves back behind the revision fetched from the repository.
+func (m *nativeGitClient) resolveMirrorBranch(revision string) (string, bool) {
+	if m.mirrorURL == "" || m.mirrorMaxStaleness <= 0 {
+		return "", false
+	}
+	value, ok := originRefsListed.Load(NormalizeGitURLAllowInvalid(m.repoURL))
+	if !ok {
+		return "", false
+	}
+	listed := value.(originRefs)
+	if time.Since(listed.listedAt) >= m.mirrorMaxStaleness {
+		return "", false
+	}
+	branch := strings.TrimPrefix(revision, "refs/heads/")
+	if revision == "" || revision == "HEAD" {
+		branch = listed.head
+	}
+	if branch == "" {
+		return "", false
+	}
+
+	repo, err := git.PlainOpen(m.root)
+	if err != nil {
+		return "", false
+	}
+	mirrorRef, err := repo.Reference(plumbing.NewRemoteReferenceName(mirrorRemoteName, branch), true)
+	if err != nil {
+		return "", false
+	}
+	if originRef, err := repo.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, branch), true); err == nil && originRef.Hash() != mirrorRef.Hash() {
+		return "", false
+	}
+	return mirrorRef.Hash().String(), true
+}
+
+// updateMirrorRemote configures the mirror remote of the local repository with the current mirror URL
+func (m *nativeGitClient) updateMirrorRemote(ctx context.Context) error {
+	if _, err := m.runCmd(ctx, "config", "remote."+mirrorRemoteName+".url", m.mirrorURL); err != nil {
+		return fmt.Errorf("failed to configure mirror remote: %w", err)
+	}
+	if _, err := m.runCmd(ctx, "config", "remote."+mirrorRemoteName+".fetch", "+refs/heads/*:refs/remotes/"+mirrorRemoteName+"/*"); err != nil {
+		return fmt.Errorf("failed to configure mirror remote: %w", err)
+	}
+	// tags are fetched from the repository only, so that the mirror cannot move them
+	if _, err := m.runCmd(ctx, "config", "remote."+mirrorRemoteName+".tagOpt", "--no-tags"); err != nil {
+		return fmt.Errorf("failed to configure mirror remote: %w", err)
+	}
+	return nil
+}
+
+// fetchMirror fetches the given revision, or all branches if empty, from the mirror. The mirror is accessed without the
+// credentials of the repository, since it might be served by a different host.
+func (m *nativeGitClient) fetchMirror(ctx context.Context, revision string, depth int64) error {
+	if err := m.updateMirrorRemote(ctx); err != nil {
+		return err
+	}
+	args := []string{"fetch", mirrorRemoteName}
+	if revision != "" {
+		args = append(args, revision)
+	}
+	if depth > 0 {
+		args = append(args, "--depth", strconv.FormatInt(depth, 10))
+	}
+	if m.partialClone {
+		args = append(args, "--filter=blob:none")
+	}
+	args = append(args, "--force", "--prune")
+	return m.runCredentialedCmdWithCreds(ctx, NopCreds{}, args...)
+}
+
+// fetchBundle fetches the objects of the bundle into the local repository, unless the local repository has any refs
+// already. Bundles are downloaded without the credentials of the repository, and only HTTP(S) URIs are supported, so
+// that a bundle cannot refer to files of the repo server.
+func (m *nativeGitClient) fetchBundle(ctx context.Context) error {
+	if !IsHTTPSURL(m.bundleURI) && !IsHTTPURL(m.bundleURI) {
+		return fmt.Errorf("unsupported bundle URI %q: only HTTP(S) URIs are supported", m.bundleURI)
+	}
+	refs, err := m.runCmd(ctx, "for-each-ref", "--count=1")
+	if err != nil {
+		return fmt.Errorf("failed to list refs: %w", err)
+	}
+	if refs != "" {
+		return nil
+	}
+
+	path, err := m.downloadBundle(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(path); err != nil {
+			log.Warnf("Failed to remove bundle %s: %v", path, err)
+		}
+	}()
+	log.Infof("Initializing %s from bundle %s", m.repoURL, m.bundleURI)
+	// like the branches of the mirror, the branches of the bundle are kept apart from the branches of the repository
+	_, err = m.runCmd(ctx, "fetch", path, "+refs/heads/*:refs/remotes/bundle/*")
+	return err
+}
+
+// downloadBundle downloads the bundle into a temporary file and returns its path
+func (m *nativeGitClient) downloadBundle(ctx context.Context) (string, error) {
+	client := GetRepoHTTPClient(m.bundleURI, m.insecure, NopCreds{}, m.proxy, m.noProxy)
+	client.Timeout = bundleDownloadTimeout
+	// bundles are commonly served from object storage or CDNs, which redirect
+	client.CheckRedirect = nil
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.bundleURI, http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download bundle: %w", err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download bundle: unexpected status %s", resp.Status)
+	}
+
+	file, err := os.CreateTemp("", "bundle-*.bundle")
+	if err != nil {
+		return "", fmt.Errorf("failed to create bundle file: %w", err)
+	}
+	defer utilio.Close(file)
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		_ = os.Remove(file.Name())
+		return "", fmt.Errorf("failed to download bundle: %w", err)
+	}
+	return file.Name(), nil
+}
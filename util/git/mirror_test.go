@@ -0,0 +1,204 @@
+package git
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// _createMirroredGitRepo creates a repository with a mirror, and commits to the repository after the mirror was created
+func _createMirroredGitRepo(t *testing.T) (origin string, mirror string, mirroredSHA string, latestSHA string) {
+	t.Helper()
+	ctx := t.Context()
+	origin, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+	out, err := outputCmd(ctx, origin, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	mirroredSHA = strings.TrimSpace(string(out))
+
+	mirror = filepath.Join(t.TempDir(), "mirror.git")
+	require.NoError(t, runCmd(ctx, origin, "git", "clone", "--mirror", origin, mirror))
+
+	require.NoError(t, runCmd(ctx, origin, "git", "commit", "-m", "Not mirrored", "--allow-empty"))
+	out, err = outputCmd(ctx, origin, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	latestSHA = strings.TrimSpace(string(out))
+	return origin, mirror, mirroredSHA, latestSHA
+}
+
+// _currentBranch returns the branch checked out in the given repository, which depends on the default branch of Git
+func _currentBranch(t *testing.T, repo string) string {
+	t.Helper()
+	out, err := outputCmd(t.Context(), repo, "git", "symbolic-ref", "--short", "HEAD")
+	require.NoError(t, err)
+	return strings.TrimSpace(string(out))
+}
+
+func Test_nativeGitClient_Fetch_Mirror(t *testing.T) {
+	origin, mirror, mirroredSHA, latestSHA := _createMirroredGitRepo(t)
+
+	t.Run("Fetch from mirror and repository", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror("file://"+mirror, 0))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch("", 0))
+		assert.True(t, client.IsRevisionPresent(mirroredSHA))
+		assert.True(t, client.IsRevisionPresent(latestSHA))
+
+		// the branches of the mirror are kept apart from the branches of the repository, which are never moved back
+		// to the state of the mirror
+		branch := _currentBranch(t, origin)
+		out, err := outputCmd(t.Context(), client.Root(), "git", "rev-parse", "refs/remotes/origin/"+branch, "refs/remotes/mirror/"+branch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{latestSHA, mirroredSHA}, strings.Fields(string(out)))
+	})
+
+	t.Run("Fetch commit from mirror", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror("file://"+mirror, 0))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch(mirroredSHA, 0))
+		assert.True(t, client.IsRevisionPresent(mirroredSHA))
+		require.NoError(t, client.Fetch(latestSHA, 0))
+		assert.True(t, client.IsRevisionPresent(latestSHA))
+	})
+
+	t.Run("Unavailable mirror", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror("file://"+filepath.Join(t.TempDir(), "missing"), 0))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch("", 0))
+		assert.True(t, client.IsRevisionPresent(latestSHA))
+	})
+
+	t.Run("Mirror is fetched without the credentials of the repository", func(t *testing.T) {
+		var authorized atomic.Bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" {
+				authorized.Store(true)
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		t.Cleanup(server.Close)
+
+		creds := NewHTTPSCreds("user", "secret", "", "", "", true, &NoopCredsStore{}, true)
+		client, err := NewClientExt("file://"+origin, t.TempDir(), creds, true, false, "", "", WithMirror(server.URL+"/mirror.git", 0))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch("", 0))
+		assert.True(t, client.IsRevisionPresent(latestSHA))
+		assert.False(t, authorized.Load())
+	})
+}
+
+func Test_nativeGitClient_LsRemote_Mirror(t *testing.T) {
+	origin, mirror, _, latestSHA := _createMirroredGitRepo(t)
+	branch := _currentBranch(t, origin)
+
+	t.Run("Resolve from repository without max staleness", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror("file://"+mirror, 0))
+		require.NoError(t, err)
+
+		sha, err := client.LsRemote("HEAD")
+		require.NoError(t, err)
+		assert.Equal(t, latestSHA, sha)
+		sha, err = client.LsRemote(branch)
+		require.NoError(t, err)
+		assert.Equal(t, latestSHA, sha)
+	})
+
+	t.Run("Resolve from mirror within max staleness", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithMirror("file://"+mirror, time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		require.NoError(t, client.Fetch("", 0))
+
+		// the mirror lags behind the branch fetched from the repository, which is never moved back
+		sha, err := client.LsRemote(branch)
+		require.NoError(t, err)
+		assert.Equal(t, latestSHA, sha)
+
+		// once the mirror caught up, the refs are resolved from the mirror within the max staleness after they were
+		// listed from the repository
+		require.NoError(t, runCmd(t.Context(), mirror, "git", "fetch", "--prune"))
+		require.NoError(t, client.Fetch("", 0))
+		require.NoError(t, runCmd(t.Context(), origin, "git", "commit", "-m", "Not mirrored", "--allow-empty"))
+		sha, err = client.LsRemote("HEAD")
+		require.NoError(t, err)
+		assert.Equal(t, latestSHA, sha)
+		sha, err = client.LsRemote("refs/heads/" + branch)
+		require.NoError(t, err)
+		assert.Equal(t, latestSHA, sha)
+
+		// after the max staleness, the refs are resolved from the repository again
+		client, err = NewClientExt("file://"+origin, client.Root(), NopCreds{}, true, false, "", "", WithMirror("file://"+mirror, time.Nanosecond))
+		require.NoError(t, err)
+		sha, err = client.LsRemote(branch)
+		require.NoError(t, err)
+		assert.NotEqual(t, latestSHA, sha)
+	})
+}
+
+func Test_nativeGitClient_Fetch_Bundle(t *testing.T) {
+	ctx := t.Context()
+	origin, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+	// the commit is only in the bundle, which shows that the local repository was initialized from it
+	require.NoError(t, runCmd(ctx, origin, "git", "checkout", "-b", "bundled"))
+	require.NoError(t, runCmd(ctx, origin, "git", "commit", "-m", "Bundled", "--allow-empty"))
+	out, err := outputCmd(ctx, origin, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	bundledSHA := strings.TrimSpace(string(out))
+	bundle := filepath.Join(t.TempDir(), "repo.bundle")
+	require.NoError(t, runCmd(ctx, origin, "git", "bundle", "create", bundle, "--all"))
+	require.NoError(t, runCmd(ctx, origin, "git", "checkout", "-"))
+	require.NoError(t, runCmd(ctx, origin, "git", "branch", "-D", "bundled"))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repo.bundle" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.ServeFile(w, r, bundle)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("HTTP bundle", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithBundleURI(server.URL+"/repo.bundle"))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch("", 0))
+		assert.True(t, client.IsRevisionPresent(bundledSHA))
+	})
+
+	t.Run("Local bundle", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithBundleURI("file://"+bundle))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		// bundles on the file system of the repo server are not supported
+		require.NoError(t, client.Fetch("", 0))
+		assert.False(t, client.IsRevisionPresent(bundledSHA))
+	})
+
+	t.Run("Missing bundle", func(t *testing.T) {
+		client, err := NewClientExt("file://"+origin, t.TempDir(), NopCreds{}, true, false, "", "", WithBundleURI(server.URL+"/missing.bundle"))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		require.NoError(t, client.Fetch("", 0))
+		assert.False(t, client.IsRevisionPresent(bundledSHA))
+	})
+}
@@ -1100,6 +1100,78 @@ func (_c *Client_SetAuthor_Call) RunAndReturn(run func(name string, email string
 	return _c
 }
 
+// SetSigningKey provides a mock function for the type Client
+func (_mock *Client) SetSigningKey(format string, key string, gnupgHome string) (string, error) {
+	ret := _mock.Called(format, key, gnupgHome)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return returnFunc(format, key, gnupgHome)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(format, key, gnupgHome)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(format, key, gnupgHome)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_SetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKey'
+type Client_SetSigningKey_Call struct {
+	*mock.Call
+}
+
+// SetSigningKey is a helper method to define mock.On call
+//   - format string
+//   - key string
+//   - gnupgHome string
+func (_e *Client_Expecter) SetSigningKey(format interface{}, key interface{}, gnupgHome interface{}) *Client_SetSigningKey_Call {
+	return &Client_SetSigningKey_Call{Call: _e.mock.On("SetSigningKey", format, key, gnupgHome)}
+}
+
+func (_c *Client_SetSigningKey_Call) Run(run func(format string, key string, gnupgHome string)) *Client_SetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) Return(s string, err error) *Client_SetSigningKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) RunAndReturn(run func(format string, key string, gnupgHome string) (string, error)) *Client_SetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submodule provides a mock function for the type Client
 func (_mock *Client) Submodule() error {
 	ret := _mock.Called()
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/opencontainers/go-digest"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -288,13 +289,16 @@ func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, p
 	return out, nil
 }
 
+// PullOCI pulls the chart from the OCI registry. The version is either a chart version, or the digest of the chart
+// manifest, in which case the chart is pulled by its digest.
 func (c *Cmd) PullOCI(repo string, chart string, version string, destination string, creds Creds) (string, error) {
-	args := []string{
-		"pull", fmt.Sprintf("oci://%s/%s", repo, chart), "--version",
-		version,
-		"--destination",
-		destination,
+	var args []string
+	if _, err := digest.Parse(version); err == nil {
+		args = []string{"pull", fmt.Sprintf("oci://%s/%s@%s", repo, chart, version)}
+	} else {
+		args = []string{"pull", fmt.Sprintf("oci://%s/%s", repo, chart), "--version", version}
 	}
+	args = append(args, "--destination", destination)
 	if creds.GetCAPath() != "" {
 		args = append(args, "--ca-file", creds.GetCAPath())
 	}
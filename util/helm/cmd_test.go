@@ -135,6 +135,36 @@ func TestRegistryLogin(t *testing.T) {
 	}
 }
 
+func TestPullOCI(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		expectedOut string
+	}{
+		{
+			name:        "version",
+			version:     "1.2.3",
+			expectedOut: "helm pull oci://my.registry.com/repo/chart --version 1.2.3 --destination /tmp/dest",
+		},
+		{
+			name:        "digest",
+			version:     "sha256:7d865e959b2466918c9863afca942d0fb89d7c9ac0c99bafc3749504ded97730",
+			expectedOut: "helm pull oci://my.registry.com/repo/chart@sha256:7d865e959b2466918c9863afca942d0fb89d7c9ac0c99bafc3749504ded97730 --destination /tmp/dest",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := newCmdWithVersion(".", true, "", "", func(cmd *exec.Cmd, _ func(_ string) string) (string, error) {
+				return strings.Join(cmd.Args, " "), nil
+			})
+			require.NoError(t, err)
+			out, err := c.PullOCI("my.registry.com/repo", "chart", tc.version, "/tmp/dest", &HelmCreds{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOut, out)
+		})
+	}
+}
+
 func TestRegistryLogout(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -24,6 +24,8 @@ type HydratorCommitMetadata struct {
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
 	Body       string                    `json:"body,omitempty"`
 	References []appv1.RevisionReference `json:"references,omitempty"`
+	// SigningKeyID identifies the key the hydrated commit is signed with. It's empty if the commit is not signed.
+	SigningKeyID string `json:"signingKeyID,omitempty"`
 }
 
 // GetCommitMetadata takes repo, drySha and commitMetadata and returns a HydratorCommitMetadata which is a
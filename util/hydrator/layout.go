@@ -0,0 +1,154 @@
+package hydrator
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// kustomizationFileName is the name of the kustomization file generated if requested by the layout.
+	kustomizationFileName = "kustomization.yaml"
+	// singleManifestFileName is the name of the file holding all manifests with the singleFile layout.
+	singleManifestFileName = "manifest.yaml"
+	// clusterManifestFileName is the name of the file holding the manifests without a namespace with the namespace
+	// layout.
+	clusterManifestFileName = "_cluster.yaml"
+)
+
+// unsafeFileNameChars matches the characters which are replaced in file names derived from resource kinds, names and
+// namespaces, e.g. the colons in RBAC resource names.
+var unsafeFileNameChars = regexp.MustCompile(`[^a-z0-9._-]`)
+
+// manifestFile is a file holding hydrated manifests.
+type manifestFile struct {
+	name string
+	objs []*unstructured.Unstructured
+}
+
+// RenderManifests renders the hydrated manifests into the files of the given layout, as they are written to the
+// hydrated path. If requested by the layout, a kustomization.yaml file listing the manifest files is rendered as well, and
+// an error is returned if the manifests are laid out into a file of the same name. It returns the content of each file by
+// file name.
+func RenderManifests(objs []*unstructured.Unstructured, layout *appv1.HydrateLayout) (map[string][]byte, error) {
+	files, err := layoutManifests(objs, layout.GetType())
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]byte, len(files)+1)
+	fileNames := make([]string, len(files))
+	for i, f := range files {
+		data, err := EncodeManifests(f.objs)
+		if err != nil {
+			return nil, err
+		}
+		result[f.name] = data
+		fileNames[i] = f.name
+	}
+
+	if layout != nil && layout.Kustomization {
+		// e.g. the manifests of a namespace named kustomization with the namespace layout
+		if _, ok := result[kustomizationFileName]; ok {
+			return nil, fmt.Errorf("cannot generate %s, since the layout writes manifests to a file of the same name", kustomizationFileName)
+		}
+		slices.Sort(fileNames)
+		data, err := encodeYAML(map[string]any{
+			"apiVersion": "kustomize.config.k8s.io/v1beta1",
+			"kind":       "Kustomization",
+			"resources":  fileNames,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode kustomization: %w", err)
+		}
+		result[kustomizationFileName] = data
+	}
+	return result, nil
+}
+
+// EncodeManifests encodes the manifests as a multi-document YAML file, in the order they are provided.
+func EncodeManifests(objs []*unstructured.Unstructured) ([]byte, error) {
+	docs := make([]any, len(objs))
+	for i, obj := range objs {
+		docs[i] = &obj.Object
+	}
+	data, err := encodeYAML(docs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return data, nil
+}
+
+// encodeYAML encodes each of the given values as a YAML document, indented by two spaces.
+func encodeYAML(docs ...any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		err := enc.Encode(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := enc.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// layoutManifests splits the manifests into files according to the layout type. Files are returned in the order in which
+// their first manifest appears, and manifests keep their order within each file. Manifests mapping to the same file
+// name, e.g. resources of the same kind and name in different API groups, are written to the same file.
+func layoutManifests(objs []*unstructured.Unstructured, layoutType appv1.HydrateLayoutType) ([]manifestFile, error) {
+	var fileName func(obj *unstructured.Unstructured) string
+	switch layoutType {
+	case appv1.HydrateLayoutTypeSingleFile:
+		fileName = func(_ *unstructured.Unstructured) string {
+			return singleManifestFileName
+		}
+	case appv1.HydrateLayoutTypeFilePerResource:
+		fileName = func(obj *unstructured.Unstructured) string {
+			return sanitizeFileName(obj.GetKind()+"-"+obj.GetName()) + ".yaml"
+		}
+	case appv1.HydrateLayoutTypeNamespace:
+		fileName = func(obj *unstructured.Unstructured) string {
+			if obj.GetNamespace() == "" {
+				return clusterManifestFileName
+			}
+			return sanitizeFileName(obj.GetNamespace()) + ".yaml"
+		}
+	default:
+		return nil, fmt.Errorf("unsupported hydrated manifest layout %q", layoutType)
+	}
+
+	var files []manifestFile
+	indexes := make(map[string]int)
+	if layoutType == appv1.HydrateLayoutTypeSingleFile {
+		// The singleFile layout always writes manifest.yaml, even if there are no manifests.
+		files = append(files, manifestFile{name: singleManifestFileName})
+		indexes[singleManifestFileName] = 0
+	}
+	for _, obj := range objs {
+		name := fileName(obj)
+		i, ok := indexes[name]
+		if !ok {
+			i = len(files)
+			indexes[name] = i
+			files = append(files, manifestFile{name: name})
+		}
+		files[i].objs = append(files[i].objs, obj)
+	}
+	return files, nil
+}
+
+// sanitizeFileName lower-cases the given name and replaces characters which are not safe to use in file names.
+func sanitizeFileName(name string) string {
+	return unsafeFileNameChars.ReplaceAllString(strings.ToLower(name), "_")
+}
@@ -0,0 +1,111 @@
+package hydrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newObj(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func fileNamesAndObjs(files []manifestFile) map[string][]*unstructured.Unstructured {
+	result := make(map[string][]*unstructured.Unstructured)
+	for _, f := range files {
+		result[f.name] = f.objs
+	}
+	return result
+}
+
+func TestLayoutManifests(t *testing.T) {
+	role := newObj("ClusterRole", "", "system:aggregate-to-edit")
+	deployA := newObj("Deployment", "a", "guestbook")
+	deployB := newObj("Deployment", "b", "guestbook")
+	svc := newObj("Service", "a", "guestbook")
+	objs := []*unstructured.Unstructured{role, deployA, deployB, svc}
+
+	t.Run("single file", func(t *testing.T) {
+		files, err := layoutManifests(objs, appv1.HydrateLayoutTypeSingleFile)
+		require.NoError(t, err)
+		assert.Equal(t, []manifestFile{{name: "manifest.yaml", objs: objs}}, files)
+	})
+
+	t.Run("single file without manifests", func(t *testing.T) {
+		files, err := layoutManifests(nil, appv1.HydrateLayoutTypeSingleFile)
+		require.NoError(t, err)
+		assert.Equal(t, []manifestFile{{name: "manifest.yaml"}}, files)
+	})
+
+	t.Run("file per resource", func(t *testing.T) {
+		files, err := layoutManifests(objs, appv1.HydrateLayoutTypeFilePerResource)
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		assert.Equal(t, "clusterrole-system_aggregate-to-edit.yaml", files[0].name)
+		assert.Equal(t, map[string][]*unstructured.Unstructured{
+			"clusterrole-system_aggregate-to-edit.yaml": {role},
+			// Resources with the same kind and name end up in the same file.
+			"deployment-guestbook.yaml": {deployA, deployB},
+			"service-guestbook.yaml":    {svc},
+		}, fileNamesAndObjs(files))
+	})
+
+	t.Run("namespace", func(t *testing.T) {
+		files, err := layoutManifests(objs, appv1.HydrateLayoutTypeNamespace)
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		assert.Equal(t, []string{"_cluster.yaml", "a.yaml", "b.yaml"}, []string{files[0].name, files[1].name, files[2].name})
+		assert.Equal(t, map[string][]*unstructured.Unstructured{
+			"_cluster.yaml": {role},
+			"a.yaml":        {deployA, svc},
+			"b.yaml":        {deployB},
+		}, fileNamesAndObjs(files))
+	})
+
+	t.Run("unsupported layout", func(t *testing.T) {
+		_, err := layoutManifests(objs, "tree")
+		require.ErrorContains(t, err, `unsupported hydrated manifest layout "tree"`)
+	})
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, "clusterrole-system_controller_ttl-after-finished-controller", sanitizeFileName("ClusterRole-system:controller:ttl-after-finished-controller"))
+	assert.Equal(t, "configmap-my.config_v2", sanitizeFileName("ConfigMap-my.config/v2"))
+}
+
+func TestRenderManifests(t *testing.T) {
+	objs := []*unstructured.Unstructured{newObj("Service", "a", "guestbook"), newObj("Deployment", "a", "guestbook")}
+
+	t.Run("default layout", func(t *testing.T) {
+		files, err := RenderManifests(objs, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{
+			"manifest.yaml": []byte("kind: Service\nmetadata:\n  name: guestbook\n  namespace: a\n---\nkind: Deployment\nmetadata:\n  name: guestbook\n  namespace: a\n"),
+		}, files)
+	})
+
+	t.Run("kustomization", func(t *testing.T) {
+		files, err := RenderManifests(objs, &appv1.HydrateLayout{Type: appv1.HydrateLayoutTypeFilePerResource, Kustomization: true})
+		require.NoError(t, err)
+		assert.Len(t, files, 3)
+		assert.Equal(t, "kind: Deployment\nmetadata:\n  name: guestbook\n  namespace: a\n", string(files["deployment-guestbook.yaml"]))
+		assert.Equal(t, "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n  - deployment-guestbook.yaml\n  - service-guestbook.yaml\n", string(files["kustomization.yaml"]))
+	})
+	t.Run("kustomization colliding with manifest file", func(t *testing.T) {
+		objs := []*unstructured.Unstructured{newObj("Service", "kustomization", "guestbook")}
+		_, err := RenderManifests(objs, &appv1.HydrateLayout{Type: appv1.HydrateLayoutTypeNamespace, Kustomization: true})
+		require.ErrorContains(t, err, "cannot generate kustomization.yaml")
+
+		files, err := RenderManifests(objs, &appv1.HydrateLayout{Type: appv1.HydrateLayoutTypeNamespace})
+		require.NoError(t, err)
+		assert.Contains(t, files, "kustomization.yaml")
+	})
+}
@@ -164,6 +164,39 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
+// ExecutePolicyLua runs the lua script of a policy against a resource and returns the violation messages of the
+// resource. The script returns a list of messages, a single message, or nothing if the resource complies with the
+// policy.
+func (vm VM) ExecutePolicyLua(obj *unstructured.Unstructured, script string) ([]string, error) {
+	l, err := vm.runLua(obj, script)
+	if err != nil {
+		return nil, err
+	}
+	returnValue := l.Get(-1)
+	switch returnValue.Type() {
+	case lua.LTNil:
+		return nil, nil
+	case lua.LTString:
+		return []string{returnValue.String()}, nil
+	case lua.LTTable:
+		// An empty table is encoded as an object rather than an array.
+		if key, _ := returnValue.(*lua.LTable).Next(lua.LNil); key == lua.LNil {
+			return nil, nil
+		}
+		jsonBytes, err := luajson.Encode(returnValue)
+		if err != nil {
+			return nil, err
+		}
+		var violations []string
+		err = json.Unmarshal(jsonBytes, &violations)
+		if err != nil {
+			return nil, fmt.Errorf(incorrectReturnType, "list of strings", string(jsonBytes))
+		}
+		return violations, nil
+	}
+	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+}
+
 // GetHealthScript attempts to read lua script from config and then filesystem for that resource. If none exists, return
 // an empty string.
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, useOpenLibs bool, err error) {
@@ -178,6 +178,44 @@ func TestEmptyHealthStatusStatus(t *testing.T) {
 	assert.Equal(t, expectedStatus, status)
 }
 
+const policyFunction = `local violations = {}
+if obj.metadata.namespace == "default" then
+	table.insert(violations, "must not use the default namespace")
+end
+if obj.metadata.labels ~= nil and obj.metadata.labels["app.kubernetes.io/instance"] ~= nil then
+	table.insert(violations, "must not set the instance label")
+end
+return violations`
+
+func TestExecutePolicyLua(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+
+	violations, err := vm.ExecutePolicyLua(testObj, policyFunction)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"must not use the default namespace", "must not set the instance label"}, violations)
+
+	testObj.SetNamespace("guestbook")
+	testObj.SetLabels(nil)
+	violations, err = vm.ExecutePolicyLua(testObj, policyFunction)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = vm.ExecutePolicyLua(testObj, `return "must not exist"`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"must not exist"}, violations)
+
+	violations, err = vm.ExecutePolicyLua(testObj, `return`)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	_, err = vm.ExecutePolicyLua(testObj, `return {message = "not a list"}`)
+	require.ErrorContains(t, err, "expect list of strings output from Lua script")
+
+	_, err = vm.ExecutePolicyLua(testObj, returnInt)
+	assert.Equal(t, fmt.Errorf(incorrectReturnType, "table", "number"), err)
+}
+
 const infiniteLoop = `while true do ; end`
 
 func TestHandleInfiniteLoop(t *testing.T) {
@@ -87,12 +87,13 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Appli
 		return nil, err
 	}
 	appDetail, err := svc.repoServerClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		AppName:          app.Name,
-		Repo:             repo,
-		Source:           appSource,
-		Repos:            helmRepos,
-		KustomizeOptions: kustomizeOptions,
-		HelmOptions:      helmOptions,
+		AppName:                         app.Name,
+		Repo:                            repo,
+		Source:                          appSource,
+		Repos:                           helmRepos,
+		KustomizeOptions:                kustomizeOptions,
+		HelmOptions:                     helmOptions,
+		AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 	})
 	if err != nil {
 		return nil, err
@@ -74,6 +74,10 @@ type Client interface {
 
 	// GetTags retrieves the list of tags for the repository.
 	GetTags(ctx context.Context, noCache bool) ([]string, error)
+
+	// VerifySignature verifies that the artifact with the given digest is signed with at least one of the given keys,
+	// and returns a description of the valid signature.
+	VerifySignature(ctx context.Context, digest string, keys []SignatureKey) (string, error)
 }
 
 type Creds struct {
@@ -118,6 +122,37 @@ func NewClient(repoURL string, creds Creds, proxy, noProxy string, layerMediaTyp
 
 func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxyURL, noProxy string, layerMediaTypes []string, opts ...ClientOpts) (Client, error) {
 	ociRepo := strings.TrimPrefix(repoURL, "oci://")
+	repo, err := newRemoteRepository(ociRepo, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
+	}
+
+	reg, err := remote.NewRegistry(parsed.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup registry config: %w", err)
+	}
+	reg.PlainHTTP = repo.PlainHTTP
+	reg.Client = repo.Client
+	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
+		var t []string
+
+		err := repo.Tags(ctx, last, func(tags []string) error {
+			t = append(t, tags...)
+			return nil
+		})
+
+		return t, err
+	}, reg.Ping, layerMediaTypes, opts...), nil
+}
+
+// newRemoteRepository returns a client for the remote OCI repository, i.e. the repository URL without the oci:// prefix,
+// using the given credentials and proxy settings.
+func newRemoteRepository(ociRepo string, creds Creds, proxyURL, noProxy string) (*remote.Repository, error) {
 	repo, err := remote.NewRepository(ociRepo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize repository: %w", err)
@@ -153,28 +188,7 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy
 			Password: creds.Password,
 		}),
 	}
-
-	parsed, err := url.Parse(repoURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
-	}
-
-	reg, err := remote.NewRegistry(parsed.Host)
-	if err != nil {
-		return nil, fmt.Errorf("failed to setup registry config: %w", err)
-	}
-	reg.PlainHTTP = repo.PlainHTTP
-	reg.Client = repo.Client
-	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
-		var t []string
-
-		err := repo.Tags(ctx, last, func(tags []string) error {
-			t = append(t, tags...)
-			return nil
-		})
-
-		return t, err
-	}, reg.Ping, layerMediaTypes, opts...), nil
+	return repo, nil
 }
 
 func newClientWithLock(repoURL string, repoLock sync.KeyLock, repo oras.ReadOnlyTarget, tagsFunc func(context.Context, string) ([]string, error), pingFunc func(ctx context.Context) error, layerMediaTypes []string, opts ...ClientOpts) Client {
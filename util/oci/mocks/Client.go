@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -431,3 +432,75 @@ func (_c *Client_TestRepo_Call) RunAndReturn(run func(ctx context.Context) (bool
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifySignature provides a mock function for the type Client
+func (_mock *Client) VerifySignature(ctx context.Context, digest string, keys []oci.SignatureKey) (string, error) {
+	ret := _mock.Called(ctx, digest, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySignature")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []oci.SignatureKey) (string, error)); ok {
+		return returnFunc(ctx, digest, keys)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []oci.SignatureKey) string); ok {
+		r0 = returnFunc(ctx, digest, keys)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []oci.SignatureKey) error); ok {
+		r1 = returnFunc(ctx, digest, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_VerifySignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySignature'
+type Client_VerifySignature_Call struct {
+	*mock.Call
+}
+
+// VerifySignature is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - keys []oci.SignatureKey
+func (_e *Client_Expecter) VerifySignature(ctx interface{}, digest interface{}, keys interface{}) *Client_VerifySignature_Call {
+	return &Client_VerifySignature_Call{Call: _e.mock.On("VerifySignature", ctx, digest, keys)}
+}
+
+func (_c *Client_VerifySignature_Call) Run(run func(ctx context.Context, digest string, keys []oci.SignatureKey)) *Client_VerifySignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []oci.SignatureKey
+		if args[2] != nil {
+			arg2 = args[2].([]oci.SignatureKey)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_VerifySignature_Call) Return(s string, err error) *Client_VerifySignature_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_VerifySignature_Call) RunAndReturn(run func(ctx context.Context, digest string, keys []oci.SignatureKey) (string, error)) *Client_VerifySignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
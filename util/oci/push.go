@@ -0,0 +1,113 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// HydratedManifestsArtifactType is the artifact type of the OCI artifacts the source hydrator pushes hydrated manifests
+// as.
+const HydratedManifestsArtifactType = "application/vnd.argoproj.argo-cd.hydrated-manifests.v1"
+
+var _ Pusher = &nativeOCIPusher{}
+
+// Pusher is an OCI client which packages directories as OCI artifacts and pushes them to a repository.
+type Pusher interface {
+	// Pull extracts the content layer of the artifact with the given tag to the directory. It returns false if the
+	// repository has no artifact with the tag.
+	Pull(ctx context.Context, tag string, dir string) (bool, error)
+
+	// Push packages the contents of the directory as a single tar+gzip layer, pushes the artifact with the given
+	// manifest annotations and tags it with each of the given tags. It returns the digest of the pushed manifest.
+	Push(ctx context.Context, dir string, tags []string, annotations map[string]string) (string, error)
+}
+
+// NewPusher returns a Pusher for the OCI repository with the given URL.
+func NewPusher(repoURL string, creds Creds, proxy, noProxy string) (Pusher, error) {
+	repo, err := newRemoteRepository(strings.TrimPrefix(repoURL, "oci://"), creds, proxy, noProxy)
+	if err != nil {
+		return nil, err
+	}
+	return newPusher(repoURL, repo), nil
+}
+
+func newPusher(repoURL string, repo oras.Target) Pusher {
+	return &nativeOCIPusher{repoURL: repoURL, repo: repo}
+}
+
+// nativeOCIPusher implements the Pusher interface using oras-go
+type nativeOCIPusher struct {
+	repoURL string
+	repo    oras.Target
+}
+
+func (p *nativeOCIPusher) Pull(ctx context.Context, tag string, dir string) (bool, error) {
+	_, err := p.repo.Resolve(ctx, tag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get digest for tag %s of %s: %w", tag, p.repoURL, err)
+	}
+
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(tempDir)
+
+	// The artifacts are written by the hydrator, so their size is not limited.
+	fs, err := newCompressedLayerFileStore(dir, tempDir, math.MaxInt64)
+	if err != nil {
+		return false, err
+	}
+	defer fs.Close()
+
+	_, err = oras.Copy(ctx, p.repo, tag, fs, tag, oras.DefaultCopyOptions)
+	if err != nil {
+		return false, fmt.Errorf("cannot extract contents of tag %s of %s: %w", tag, p.repoURL, err)
+	}
+	return true, nil
+}
+
+func (p *nativeOCIPusher) Push(ctx context.Context, dir string, tags []string, annotations map[string]string) (string, error) {
+	var layer bytes.Buffer
+	_, err := files.Tgz(dir, nil, nil, &layer)
+	if err != nil {
+		return "", fmt.Errorf("failed to compress %s: %w", dir, err)
+	}
+
+	layerDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, layer.Bytes())
+	err = p.repo.Push(ctx, layerDesc, &layer)
+	if err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		return "", fmt.Errorf("failed to push layer to %s: %w", p.repoURL, err)
+	}
+
+	manifestDesc, err := oras.PackManifest(ctx, p.repo, oras.PackManifestVersion1_1, HydratedManifestsArtifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layerDesc},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to push manifest to %s: %w", p.repoURL, err)
+	}
+
+	for _, tag := range tags {
+		err = p.repo.Tag(ctx, manifestDesc, tag)
+		if err != nil {
+			return "", fmt.Errorf("failed to tag %s with %s: %w", p.repoURL, tag, err)
+		}
+	}
+	return manifestDesc.Digest.String(), nil
+}
@@ -0,0 +1,70 @@
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/memory"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func Test_nativeOCIPusher_PushAndPull(t *testing.T) {
+	store := memory.New()
+	p := newPusher("oci://example.com/hydrated", store)
+
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "guestbook"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "guestbook", "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+
+	found, err := p.Pull(t.Context(), "env-dev", t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	digest, err := p.Push(t.Context(), srcDir, []string{"env-dev", "abc123"}, map[string]string{imagev1.AnnotationRevision: "abc123"})
+	require.NoError(t, err)
+
+	for _, tag := range []string{"env-dev", "abc123"} {
+		desc, err := store.Resolve(t.Context(), tag)
+		require.NoError(t, err)
+		assert.Equal(t, digest, desc.Digest.String())
+	}
+
+	manifest, err := getOCIManifest(t.Context(), "env-dev", store)
+	require.NoError(t, err)
+	assert.Equal(t, HydratedManifestsArtifactType, manifest.ArtifactType)
+	assert.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+	require.Len(t, manifest.Layers, 1)
+	assert.Equal(t, imagev1.MediaTypeImageLayerGzip, manifest.Layers[0].MediaType)
+
+	dstDir := t.TempDir()
+	found, err = p.Pull(t.Context(), "env-dev", dstDir)
+	require.NoError(t, err)
+	assert.True(t, found)
+	data, err := os.ReadFile(filepath.Join(dstDir, "guestbook", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+
+	// The pushed artifact can be extracted like any other OCI source. Unlike a registry, the memory store only resolves
+	// tags.
+	desc, err := store.Resolve(t.Context(), "env-dev")
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(t.Context(), desc, digest))
+	c := newClientWithLock("example.com/hydrated", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, []string{imagev1.MediaTypeImageLayerGzip},
+		WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithDisableManifestMaxExtractedSize(true),
+		WithEventHandlers(fakeEventHandlers(t, "example.com/hydrated")))
+	path, closer, err := c.Extract(t.Context(), digest)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	data, err = os.ReadFile(filepath.Join(path, "guestbook", "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+}
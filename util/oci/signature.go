@@ -0,0 +1,378 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+)
+
+const (
+	// SignatureTypeCosign is the type of signature keys verifying cosign signatures
+	SignatureTypeCosign = "cosign"
+	// SignatureTypeNotation is the type of signature keys verifying Notation signatures
+	SignatureTypeNotation = "notation"
+
+	cosignSignatureMediaType       = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation      = "dev.cosignproject.cosign/signature"
+	notationSignatureArtifactType  = "application/vnd.cncf.notary.signature"
+	notationJWSSignatureMediaType  = "application/jose+json"
+	notationPayloadContentType     = "application/vnd.cncf.notary.payload.v1+json"
+	maxSignatureManifestsToInspect = 32
+)
+
+// ErrSignatureNotVerified is returned when none of the signatures of an artifact could be verified with the keys, as
+// opposed to errors which prevented the signatures from being checked at all, e.g. registry errors.
+var ErrSignatureNotVerified = errors.New("no valid signature found")
+
+// SignatureKey is a key the signatures of an OCI artifact are verified with.
+type SignatureKey struct {
+	// Type is either SignatureTypeCosign or SignatureTypeNotation
+	Type string
+	// PublicKey is the PEM encoded public key for cosign, or the PEM encoded trusted root certificates for Notation
+	PublicKey string
+}
+
+// VerifySignature verifies that the artifact with the given digest carries at least one valid signature made with one
+// of the given keys. On success, a description of the signature which was found to be valid is returned.
+func (c *nativeOCIClient) VerifySignature(ctx context.Context, digest string, keys []SignatureKey) (string, error) {
+	if len(keys) == 0 {
+		return "", errors.New("no signature keys configured")
+	}
+
+	desc, err := c.repo.Resolve(ctx, digest)
+	if err != nil {
+		return "", fmt.Errorf("error resolving digest %s: %w", digest, err)
+	}
+
+	var verifyErr error
+	for i, key := range keys {
+		var result string
+		switch key.Type {
+		case SignatureTypeCosign:
+			result, err = c.verifyCosignSignature(ctx, desc, key)
+		case SignatureTypeNotation:
+			result, err = c.verifyNotationSignature(ctx, desc, key)
+		default:
+			err = fmt.Errorf("unsupported signature key type %q", key.Type)
+		}
+		if err == nil {
+			return result, nil
+		}
+		log.WithFields(log.Fields{"repo": c.repoURL, "digest": digest, "key": i, "type": key.Type}).Debugf("signature not verified: %v", err)
+		// Keep the first error which is not a verification verdict, so that registry errors are not reported as
+		// missing signatures
+		if verifyErr == nil && !errors.Is(err, ErrSignatureNotVerified) {
+			verifyErr = err
+		}
+	}
+
+	if verifyErr != nil {
+		return "", fmt.Errorf("error verifying signatures of digest %s: %w", digest, verifyErr)
+	}
+	return "", fmt.Errorf("%w for digest %s with any of the %d configured keys", ErrSignatureNotVerified, digest, len(keys))
+}
+
+// cosignPayload is the simple signing payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// verifyCosignSignature looks up the cosign signatures of the artifact, which are stored in the same repository under
+// the "sha256-<hex>.sig" tag, and verifies them with the public key.
+func (c *nativeOCIClient) verifyCosignSignature(ctx context.Context, desc imagev1.Descriptor, key SignatureKey) (string, error) {
+	publicKey, err := parsePublicKey(key.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("cosign: %w", err)
+	}
+
+	tag := strings.Replace(desc.Digest.String(), ":", "-", 1) + ".sig"
+	manifest, err := getOCIManifest(ctx, tag, c.repo)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return "", fmt.Errorf("cosign: %w: artifact is not signed", ErrSignatureNotVerified)
+	} else if err != nil {
+		return "", fmt.Errorf("cosign: error fetching signatures: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != cosignSignatureMediaType {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+		payload, err := content.FetchAll(ctx, c.repo, layer)
+		if err != nil {
+			return "", fmt.Errorf("cosign: error fetching signature payload: %w", err)
+		}
+		if err := verifyBlob(publicKey, payload, signature); err != nil {
+			continue
+		}
+		var p cosignPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			continue
+		}
+		if p.Critical.Image.DockerManifestDigest != desc.Digest.String() {
+			continue
+		}
+		return "Valid cosign signature from key " + keyFingerprint(publicKey), nil
+	}
+
+	return "", fmt.Errorf("cosign: %w: no signature made with key %s", ErrSignatureNotVerified, keyFingerprint(publicKey))
+}
+
+// notationEnvelope is a Notation signature envelope in JWS JSON serialization.
+type notationEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5C []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+// notationProtectedHeader contains the protected headers of a Notation signature envelope which are verified.
+type notationProtectedHeader struct {
+	Alg string `json:"alg"`
+	Cty string `json:"cty"`
+}
+
+// notationPayload is the payload signed by Notation.
+type notationPayload struct {
+	TargetArtifact imagev1.Descriptor `json:"targetArtifact"`
+}
+
+// verifyNotationSignature looks up the Notation signatures referring to the artifact and verifies that one of them
+// was made with a certificate chaining up to one of the trusted root certificates. Only JWS envelopes are supported.
+func (c *nativeOCIClient) verifyNotationSignature(ctx context.Context, desc imagev1.Descriptor, key SignatureKey) (string, error) {
+	roots, err := parseCertificates(key.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("notation: %w", err)
+	}
+
+	store, ok := c.repo.(content.ReadOnlyGraphStorage)
+	if !ok {
+		return "", errors.New("notation: repository does not support listing referrers")
+	}
+	referrers, err := registry.Referrers(ctx, store, desc, notationSignatureArtifactType)
+	if err != nil {
+		return "", fmt.Errorf("notation: error listing signatures: %w", err)
+	}
+	if len(referrers) > maxSignatureManifestsToInspect {
+		referrers = referrers[:maxSignatureManifestsToInspect]
+	}
+
+	for _, referrer := range referrers {
+		// Referrers are fetched by descriptor, since registries do not necessarily resolve untagged manifests
+		blob, err := content.FetchAll(ctx, c.repo, referrer)
+		if err != nil {
+			continue
+		}
+		var manifest imagev1.Manifest
+		if err := json.Unmarshal(blob, &manifest); err != nil {
+			continue
+		}
+		for _, layer := range manifest.Layers {
+			if layer.MediaType != notationJWSSignatureMediaType {
+				continue
+			}
+			blob, err := content.FetchAll(ctx, c.repo, layer)
+			if err != nil {
+				continue
+			}
+			cert, err := verifyNotationEnvelope(blob, desc, roots)
+			if err != nil {
+				continue
+			}
+			return fmt.Sprintf("Valid notation signature from certificate %q issued by %q", cert.Subject.String(), cert.Issuer.String()), nil
+		}
+	}
+
+	return "", fmt.Errorf("notation: %w: no signature made with a certificate issued by the trusted roots", ErrSignatureNotVerified)
+}
+
+// verifyNotationEnvelope verifies a JWS Notation signature envelope against the artifact descriptor and the trusted
+// roots, and returns the signing certificate.
+func verifyNotationEnvelope(blob []byte, desc imagev1.Descriptor, roots *x509.CertPool) (*x509.Certificate, error) {
+	var envelope notationEnvelope
+	if err := json.Unmarshal(blob, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid signature envelope: %w", err)
+	}
+	if len(envelope.Header.X5C) == 0 {
+		return nil, errors.New("signature envelope has no certificate chain")
+	}
+
+	var chain []*x509.Certificate
+	for _, encoded := range envelope.Header.X5C {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in chain: %w", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in chain: %w", err)
+		}
+		chain = append(chain, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return nil, fmt.Errorf("untrusted certificate chain: %w", err)
+	}
+
+	protected, err := base64.RawURLEncoding.DecodeString(envelope.Protected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protected header: %w", err)
+	}
+	var header notationProtectedHeader
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return nil, fmt.Errorf("invalid protected header: %w", err)
+	}
+	if header.Cty != notationPayloadContentType {
+		return nil, fmt.Errorf("unsupported payload content type %q", header.Cty)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := verifyJWS(header.Alg, chain[0].PublicKey, []byte(envelope.Protected+"."+envelope.Payload), signature); err != nil {
+		return nil, err
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	if payload.TargetArtifact.Digest != desc.Digest {
+		return nil, fmt.Errorf("signature is for digest %s", payload.TargetArtifact.Digest)
+	}
+
+	return chain[0], nil
+}
+
+// verifyJWS verifies a JWS signature made with one of the algorithms supported by Notation.
+func verifyJWS(alg string, publicKey crypto.PublicKey, signingInput, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signingInput)
+	digest := h.Sum(nil)
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "PS") {
+			return fmt.Errorf("signature algorithm %q does not match RSA key", alg)
+		}
+		return rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return fmt.Errorf("signature algorithm %q does not match ECDSA key", alg)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// verifyBlob verifies a signature over a blob the way cosign does for the given type of public key.
+func verifyBlob(publicKey crypto.PublicKey, blob, signature []byte) error {
+	digest := sha256.Sum256(blob)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, blob, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// parsePublicKey parses a PEM encoded PKIX public key.
+func parsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// parseCertificates parses PEM encoded certificates into a pool.
+func parseCertificates(data string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(data)) {
+		return nil, errors.New("no PEM encoded certificates found")
+	}
+	return pool, nil
+}
+
+// keyFingerprint returns the SHA256 fingerprint of a public key, in the format used by ssh-keygen.
+func keyFingerprint(publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "<unknown>"
+	}
+	sum := sha256.Sum256(der)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
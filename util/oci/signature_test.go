@@ -0,0 +1,266 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/image-spec/specs-go"
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+// pushManifest pushes a manifest with the given layers to the store and returns its descriptor.
+func pushManifest(t *testing.T, store *memory.Store, manifest imagev1.Manifest, layers ...layerConf) imagev1.Descriptor {
+	t.Helper()
+	configBlob := []byte("{}")
+	manifest.Config = content.NewDescriptorFromBytes(imagev1.MediaTypeEmptyJSON, configBlob)
+	manifest.Versioned = specs.Versioned{SchemaVersion: 2}
+	manifest.MediaType = imagev1.MediaTypeImageManifest
+	for _, layer := range layers {
+		manifest.Layers = append(manifest.Layers, layer.desc)
+		require.NoError(t, store.Push(t.Context(), layer.desc, bytes.NewReader(layer.bytes)))
+	}
+	if exists, _ := store.Exists(t.Context(), manifest.Config); !exists {
+		require.NoError(t, store.Push(t.Context(), manifest.Config, bytes.NewReader(configBlob)))
+	}
+	manifestBlob, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	desc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageManifest, manifestBlob)
+	require.NoError(t, store.Push(t.Context(), desc, bytes.NewReader(manifestBlob)))
+	return desc
+}
+
+func newECDSAKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// signCosign stores a cosign signature of the artifact made with the key in the store.
+func signCosign(t *testing.T, store *memory.Store, target imagev1.Descriptor, key *ecdsa.PrivateKey) {
+	t.Helper()
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"example.com/app"},"image":{"docker-manifest-digest":"` + target.Digest.String() + `"},"type":"cosign container image signature"},"optional":null}`)
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	layer := content.NewDescriptorFromBytes(cosignSignatureMediaType, payload)
+	layer.Annotations = map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)}
+	desc := pushManifest(t, store, imagev1.Manifest{}, layerConf{layer, payload})
+	require.NoError(t, store.Tag(t.Context(), desc, strings.Replace(target.Digest.String(), ":", "-", 1)+".sig"))
+}
+
+// newCertificateAuthority returns a root certificate in PEM, and a code signing certificate and key issued by it.
+func newCertificateAuthority(t *testing.T) (string, *x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Test Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root, &leafKey.PublicKey, rootKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER})), leaf, leafKey
+}
+
+// signNotation stores a Notation JWS signature of the artifact made with the certificate in the store.
+func signNotation(t *testing.T, store *memory.Store, target imagev1.Descriptor, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	t.Helper()
+	protected, err := json.Marshal(map[string]any{
+		"alg":                          "ES256",
+		"cty":                          notationPayloadContentType,
+		"crit":                         []string{"io.cncf.notary.signingScheme"},
+		"io.cncf.notary.signingScheme": "notary.x509",
+	})
+	require.NoError(t, err)
+	payload, err := json.Marshal(notationPayload{TargetArtifact: target})
+	require.NoError(t, err)
+	encodedProtected := base64.RawURLEncoding.EncodeToString(protected)
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(encodedProtected + "." + encodedPayload))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	envelope := notationEnvelope{Payload: encodedPayload, Protected: encodedProtected, Signature: base64.RawURLEncoding.EncodeToString(signature)}
+	envelope.Header.X5C = []string{base64.StdEncoding.EncodeToString(cert.Raw)}
+	blob, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	pushManifest(t, store, imagev1.Manifest{ArtifactType: notationSignatureArtifactType, Subject: &target},
+		layerConf{content.NewDescriptorFromBytes(notationJWSSignatureMediaType, blob), blob})
+}
+
+func Test_nativeOCIClient_VerifySignature(t *testing.T) {
+	cosignKey, cosignPublicKey := newECDSAKey(t)
+	_, otherPublicKey := newECDSAKey(t)
+	notationRoot, notationCert, notationKey := newCertificateAuthority(t)
+	otherRoot, _, _ := newCertificateAuthority(t)
+
+	newArtifact := func(t *testing.T, store *memory.Store) imagev1.Descriptor {
+		t.Helper()
+		blob := []byte(t.Name())
+		desc := pushManifest(t, store, imagev1.Manifest{}, layerConf{content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, blob), blob})
+		require.NoError(t, store.Tag(t.Context(), desc, desc.Digest.String()))
+		return desc
+	}
+
+	tests := []struct {
+		name           string
+		sign           func(t *testing.T, store *memory.Store, target imagev1.Descriptor)
+		keys           []SignatureKey
+		expectedResult string
+		expectedError  error
+	}{
+		{
+			name: "valid cosign signature",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signCosign(t, store, target, cosignKey)
+			},
+			keys:           []SignatureKey{{Type: SignatureTypeCosign, PublicKey: cosignPublicKey}},
+			expectedResult: "Valid cosign signature from key SHA256:",
+		},
+		{
+			name: "cosign signature with wrong key",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signCosign(t, store, target, cosignKey)
+			},
+			keys:          []SignatureKey{{Type: SignatureTypeCosign, PublicKey: otherPublicKey}},
+			expectedError: ErrSignatureNotVerified,
+		},
+		{
+			name:          "unsigned artifact with cosign key",
+			keys:          []SignatureKey{{Type: SignatureTypeCosign, PublicKey: cosignPublicKey}},
+			expectedError: ErrSignatureNotVerified,
+		},
+		{
+			name: "valid notation signature",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signNotation(t, store, target, notationCert, notationKey)
+			},
+			keys:           []SignatureKey{{Type: SignatureTypeNotation, PublicKey: notationRoot}},
+			expectedResult: `Valid notation signature from certificate "CN=Test Signer" issued by "CN=Test Root"`,
+		},
+		{
+			name: "notation signature with wrong root",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signNotation(t, store, target, notationCert, notationKey)
+			},
+			keys:          []SignatureKey{{Type: SignatureTypeNotation, PublicKey: otherRoot}},
+			expectedError: ErrSignatureNotVerified,
+		},
+		{
+			name:          "unsigned artifact with notation key",
+			keys:          []SignatureKey{{Type: SignatureTypeNotation, PublicKey: notationRoot}},
+			expectedError: ErrSignatureNotVerified,
+		},
+		{
+			name: "no matching key",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signCosign(t, store, target, cosignKey)
+				signNotation(t, store, target, notationCert, notationKey)
+			},
+			keys: []SignatureKey{
+				{Type: SignatureTypeCosign, PublicKey: otherPublicKey},
+				{Type: SignatureTypeNotation, PublicKey: otherRoot},
+			},
+			expectedError: ErrSignatureNotVerified,
+		},
+		{
+			name: "second key matches",
+			sign: func(t *testing.T, store *memory.Store, target imagev1.Descriptor) {
+				t.Helper()
+				signNotation(t, store, target, notationCert, notationKey)
+			},
+			keys: []SignatureKey{
+				{Type: SignatureTypeCosign, PublicKey: cosignPublicKey},
+				{Type: SignatureTypeNotation, PublicKey: notationRoot},
+			},
+			expectedResult: "Valid notation signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.New()
+			target := newArtifact(t, store)
+			if tt.sign != nil {
+				tt.sign(t, store, target)
+			}
+			c := newClientWithLock("example.com/app", globalLock, store, nil, func(_ context.Context) error {
+				return nil
+			}, nil)
+
+			result, err := c.VerifySignature(t.Context(), target.Digest.String(), tt.keys)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(result, tt.expectedResult), result)
+		})
+	}
+}
+
+func Test_nativeOCIClient_VerifySignature_InvalidKey(t *testing.T) {
+	store := memory.New()
+	blob := []byte("content")
+	target := pushManifest(t, store, imagev1.Manifest{}, layerConf{content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, blob), blob})
+	require.NoError(t, store.Tag(t.Context(), target, target.Digest.String()))
+	c := newClientWithLock("example.com/app", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, nil)
+
+	_, err := c.VerifySignature(t.Context(), target.Digest.String(), []SignatureKey{{Type: SignatureTypeCosign, PublicKey: "not a key"}})
+	require.Error(t, err)
+	// A key which cannot be used is a configuration error, not a missing signature
+	assert.NotErrorIs(t, err, ErrSignatureNotVerified)
+}
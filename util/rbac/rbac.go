@@ -66,6 +66,7 @@ const (
 	ResourceCertificates      = "certificates"
 	ResourceAccounts          = "accounts"
 	ResourceGPGKeys           = "gpgkeys"
+	ResourceSSHSigningKeys    = "sshsigningkeys"
 	ResourceLogs              = "logs"
 	ResourceExec              = "exec"
 	ResourceExtensions        = "extensions"
@@ -93,6 +94,7 @@ var (
 		ResourceCertificates,
 		ResourceAccounts,
 		ResourceGPGKeys,
+		ResourceSSHSigningKeys,
 		ResourceLogs,
 		ResourceExec,
 		ResourceExtensions,
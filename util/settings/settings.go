@@ -162,6 +162,29 @@ type GlobalProjectSettings struct {
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
+// SourceHydratorValidation holds the checks the source hydrator runs on the hydrated manifests before committing them
+type SourceHydratorValidation struct {
+	// Project enables checking that the kinds and namespaces of the hydrated resources are permitted by the project of
+	// the application
+	Project bool `json:"project,omitempty"`
+	// Schema enables validating the hydrated resources of built-in kinds against the bundled Kubernetes OpenAPI schema
+	Schema bool `json:"schema,omitempty"`
+	// Policies are Lua scripts which are run for each hydrated resource
+	Policies []SourceHydratorPolicy `json:"policies,omitempty"`
+}
+
+// SourceHydratorPolicy is a Lua script which checks hydrated resources. The script gets the resource as the global `obj`
+// and returns a list of violation messages, or nothing if the resource complies with the policy.
+type SourceHydratorPolicy struct {
+	// Name identifies the policy in violation messages
+	Name string `json:"name"`
+	// Kinds limits the policy to resources matching any of the given group/kind patterns, e.g. `apps/Deployment`,
+	// `Service` or `/Service` for the core group, or `*/*`. The policy applies to all resources if empty.
+	Kinds []string `json:"kinds,omitempty"`
+	// Script is the Lua script of the policy
+	Script string `json:"script"`
+}
+
 // Help settings
 type Help struct {
 	// the URL for getting chat help, this will typically be your Slack channel for support
@@ -509,6 +532,8 @@ const (
 	settingsBinaryUrlsKey = "help.download"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsSourceHydratorCommitMessageTemplateKey = "sourceHydrator.commitMessageTemplate"
+	// settingsSourceHydratorValidationKey is the key to configure the validation of hydrated manifests
+	settingsSourceHydratorValidationKey = "sourceHydrator.validation"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
@@ -566,6 +591,7 @@ var sourceTypeToEnableGenerationKey = map[v1alpha1.ApplicationSourceType]string{
 	v1alpha1.ApplicationSourceTypeKustomize: "kustomize.enable",
 	v1alpha1.ApplicationSourceTypeHelm:      "helm.enable",
 	v1alpha1.ApplicationSourceTypeDirectory: "jsonnet.enable",
+	v1alpha1.ApplicationSourceTypeCUE:       "cue.enable",
 }
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -1033,6 +1059,27 @@ func (mgr *SettingsManager) GetSourceHydratorCommitMessageTemplate() (string, er
 	return argoCDCM.Data[settingsSourceHydratorCommitMessageTemplateKey], nil
 }
 
+// GetSourceHydratorValidation loads the checks the source hydrator runs on the hydrated manifests from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetSourceHydratorValidation() (*SourceHydratorValidation, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	validation := &SourceHydratorValidation{}
+	if value := argoCDCM.Data[settingsSourceHydratorValidationKey]; value != "" {
+		err := yaml.Unmarshal([]byte(value), validation)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling source hydrator validation settings: %w", err)
+		}
+	}
+	for _, policy := range validation.Policies {
+		if policy.Name == "" || policy.Script == "" {
+			return nil, errors.New("source hydrator policies must have a name and a script")
+		}
+	}
+	return validation, nil
+}
+
 func addStatusOverrideToGK(resourceOverrides map[string]v1alpha1.ResourceOverride, groupKind string) {
 	if val, ok := resourceOverrides[groupKind]; ok {
 		val.IgnoreDifferences.JSONPointers = append(val.IgnoreDifferences.JSONPointers, "/status")
@@ -1691,6 +1738,21 @@ func (mgr *SettingsManager) SaveGPGPublicKeyData(ctx context.Context, gpgPublicK
 	return mgr.ResyncInformers()
 }
 
+func (mgr *SettingsManager) SaveSSHSigningKeyData(ctx context.Context, sshSigningKeys map[string]string) error {
+	keysCM, err := mgr.GetConfigMapByName(common.ArgoCDSSHSigningKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	keysCM.Data = sshSigningKeys
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(ctx, keysCM, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return mgr.ResyncInformers()
+}
+
 type SettingsManagerOpts func(mgs *SettingsManager)
 
 func WithRepoOrClusterChangedHandler(handler func()) SettingsManagerOpts {
@@ -1718,6 +1718,16 @@ func TestGetEnableManifestGeneration(t *testing.T) {
 		enabled: true,
 		data:    map[string]string{"kustomize.enable": `true`},
 		source:  string(v1alpha1.ApplicationSourceTypeKustomize),
+	}, {
+		name:    "CUE enabled by default",
+		enabled: true,
+		data:    map[string]string{},
+		source:  string(v1alpha1.ApplicationSourceTypeCUE),
+	}, {
+		name:    "CUE disabled",
+		enabled: false,
+		data:    map[string]string{"cue.enable": `false`},
+		source:  string(v1alpha1.ApplicationSourceTypeCUE),
 	}}
 	for i := range testCases {
 		tc := testCases[i]
@@ -1754,6 +1764,41 @@ func TestGetEnableManifestGeneration(t *testing.T) {
 	}
 }
 
+func TestGetSourceHydratorValidation(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), nil)
+		validation, err := settingsManager.GetSourceHydratorValidation()
+		require.NoError(t, err)
+		assert.Equal(t, &SourceHydratorValidation{}, validation)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"sourceHydrator.validation": `
+project: true
+schema: true
+policies:
+- name: no-latest
+  kinds: [apps/Deployment]
+  script: return {}
+`,
+		})
+		validation, err := settingsManager.GetSourceHydratorValidation()
+		require.NoError(t, err)
+		assert.Equal(t, &SourceHydratorValidation{
+			Project:  true,
+			Schema:   true,
+			Policies: []SourceHydratorPolicy{{Name: "no-latest", Kinds: []string{"apps/Deployment"}, Script: "return {}"}},
+		}, validation)
+	})
+	t.Run("Policy without script", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"sourceHydrator.validation": "policies: [{name: no-latest}]",
+		})
+		_, err := settingsManager.GetSourceHydratorValidation()
+		require.ErrorContains(t, err, "must have a name and a script")
+	})
+}
+
 func TestGetHelmSettings(t *testing.T) {
 	testCases := []struct {
 		name     string
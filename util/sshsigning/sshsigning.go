@@ -0,0 +1,227 @@
+// Package sshsigning verifies SSH signatures of git commits against the SSH public keys trusted by Argo CD.
+package sshsigning
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+)
+
+// Signature verification results, matching those of GnuPG verifications
+const (
+	VerifyResultGood    = "Good"
+	VerifyResultBad     = "Bad"
+	VerifyResultInvalid = "Invalid"
+)
+
+// fingerprintPrefix is the prefix of SHA256 fingerprints of SSH keys
+const fingerprintPrefix = "SHA256:"
+
+// configMapKeyPrefix is the prefix of the keys of the ConfigMap entries holding SSH signing keys
+const configMapKeyPrefix = "SHA256."
+
+// Regular expression to match the result of a successful SSH signature verification. The identity is missing if the
+// key is not trusted.
+var verificationGoodMatch = regexp.MustCompile(`^Good "git" signature(?: for (\S+))? with (\S+) key (SHA256:\S+)$`)
+
+// Regular expression to match a failed SSH signature verification
+var verificationBadMatch = regexp.MustCompile(`^Could not verify signature\.$`)
+
+// Regular expression to match the error emitted when an SSH signature is verified without any trusted keys
+var verificationNoAllowedSignersMatch = regexp.MustCompile(`gpg\.ssh\.allowedSignersFile needs to be configured`)
+
+// toURLSafe and fromURLSafe convert between the standard and the URL safe base64 alphabets
+var (
+	toURLSafe   = strings.NewReplacer("+", "-", "/", "_")
+	fromURLSafe = strings.NewReplacer("-", "+", "_", "/")
+)
+
+// PublicKey is an SSH public key trusted to sign commits
+type PublicKey struct {
+	// Fingerprint is the SHA256 fingerprint of the key
+	Fingerprint string
+	// KeyType is the type of the key, e.g. ssh-ed25519
+	KeyType string
+	// Comment is the comment of the key, usually identifying its owner
+	Comment string
+	// KeyData is the key in authorized_keys format
+	KeyData string
+}
+
+// VerifyResult is the result of the verification of an SSH commit signature
+type VerifyResult struct {
+	// Result of the verification, one of VerifyResultGood, VerifyResultBad or VerifyResultInvalid
+	Result string
+	// KeyType is the algorithm of the key the signature was made with, e.g. ED25519
+	KeyType string
+	// Fingerprint is the SHA256 fingerprint of the key the signature was made with
+	Fingerprint string
+	// Identity is the principal of the trusted key
+	Identity string
+	// Message is an additional informational message
+	Message string
+}
+
+// ParsePublicKey parses a single SSH public key in authorized_keys format
+func ParsePublicKey(keyData string) (*PublicKey, error) {
+	pub, comment, options, rest, err := ssh.ParseAuthorizedKey([]byte(keyData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH public key: %w", err)
+	}
+	if len(options) > 0 {
+		return nil, errors.New("SSH public key must not have options")
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return nil, errors.New("more than one key found in input data")
+	}
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+	if comment != "" {
+		authorizedKey += " " + comment
+	}
+	return &PublicKey{
+		Fingerprint: ssh.FingerprintSHA256(pub),
+		KeyType:     pub.Type(),
+		Comment:     comment,
+		KeyData:     authorizedKey,
+	}, nil
+}
+
+// IsFingerprint returns true if the given string looks like a SHA256 fingerprint of an SSH key
+func IsFingerprint(s string) bool {
+	return strings.HasPrefix(s, fingerprintPrefix) && len(s) > len(fingerprintPrefix)
+}
+
+// ConfigMapKey returns the key of the ConfigMap entry holding the SSH key with the given fingerprint. Keys of
+// ConfigMaps must not contain the characters of standard base64 encoding, so the URL safe alphabet is used instead.
+func ConfigMapKey(fingerprint string) string {
+	return configMapKeyPrefix + toURLSafe.Replace(strings.TrimPrefix(fingerprint, fingerprintPrefix))
+}
+
+// Fingerprint returns the fingerprint of the SSH key with the given ConfigMap key. Fingerprints are returned as is.
+func Fingerprint(s string) string {
+	if hash, ok := strings.CutPrefix(s, configMapKeyPrefix); ok {
+		return fingerprintPrefix + fromURLSafe.Replace(hash)
+	}
+	return s
+}
+
+// allowedSignerPrincipal returns the principal of the key in the allowed signers file. The comment of the key is used
+// if it can be used as a principal, e.g. an e-mail address, and its fingerprint otherwise.
+func allowedSignerPrincipal(key *PublicKey) string {
+	if key.Comment != "" && !strings.ContainsAny(key.Comment, " \t,*?!\"") {
+		return key.Comment
+	}
+	return key.Fingerprint
+}
+
+// ReadPublicKeys reads the SSH public keys from the files in the given directory, e.g. a mounted ConfigMap. Files
+// which do not hold a valid key are skipped.
+func ReadPublicKeys(sourcePath string) ([]*PublicKey, error) {
+	entries, err := os.ReadDir(sourcePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	keys := make([]*PublicKey, 0, len(entries))
+	for _, entry := range entries {
+		// Skip the data directories of mounted ConfigMaps
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(sourcePath, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParsePublicKey(string(data))
+		if err != nil {
+			log.Warnf("Skipping invalid SSH signing key in %s: %v", path, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Fingerprint < keys[j].Fingerprint
+	})
+	return keys, nil
+}
+
+// WriteAllowedSignersFile writes the SSH public keys in the given directory to a new git allowed signers file and
+// returns its path. If there are no keys, no file is written and an empty path is returned.
+func WriteAllowedSignersFile(sourcePath string) (string, error) {
+	keys, err := ReadPublicKeys(sourcePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read SSH signing keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	for _, key := range keys {
+		// Only trust the keys to sign git objects
+		_, _ = fmt.Fprintf(&buf, "%s namespaces=\"git\" %s\n", allowedSignerPrincipal(key), key.KeyData)
+	}
+	f, err := os.CreateTemp("", "allowed-signers")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	_, err = f.Write(buf.Bytes())
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// ParseGitCommitVerification parses the output of "git verify-commit" for commits signed with an SSH key. If the output
+// is not the result of an SSH signature verification, false is returned.
+func ParseGitCommitVerification(output string) (VerifyResult, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if token := verificationGoodMatch.FindStringSubmatch(line); len(token) == 4 {
+			result := VerifyResult{KeyType: token[2], Fingerprint: token[3], Identity: token[1]}
+			if result.Identity == "" {
+				// The signature is valid, but the key is not one of the allowed signers
+				result.Result = VerifyResultInvalid
+				result.Message = "No trusted SSH signing key matched the signature."
+			} else {
+				result.Result = VerifyResultGood
+				result.Message = "Success verifying the commit signature."
+			}
+			return result, true
+		}
+		if verificationBadMatch.MatchString(line) {
+			result := VerifyResult{Result: VerifyResultBad, Message: line}
+			if scanner.Scan() {
+				result.Message = strings.TrimSpace(scanner.Text())
+			}
+			return result, true
+		}
+		if verificationNoAllowedSignersMatch.MatchString(line) {
+			return VerifyResult{Result: VerifyResultInvalid, Message: "No SSH signing keys are configured."}, true
+		}
+		// The first line decides whether the output is the result of an SSH signature verification
+		return VerifyResult{}, false
+	}
+	return VerifyResult{}, false
+}
@@ -0,0 +1,128 @@
+package sshsigning
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	janeDoeKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFKv3xZaqHP8i6dEauYNisv1I0EB/vD5kBPyVPbaNQoN jane@example.com"
+	janeDoeFingerprint = "SHA256:PI8johZKBD4JcrMk5xoNLg9sUfZwm3A8gY3x47ER2Ls"
+	anonKey            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIH9aJ+TmuiGbJG//fJnTM6flxI0GgORmy/5nDs9BbcXG"
+	anonFingerprint    = "SHA256:ZpDTWpBRjvig2daMxavsHTPgjQwSsCZwk06S3NpQyoE"
+)
+
+func Test_ParsePublicKey(t *testing.T) {
+	t.Run("With comment", func(t *testing.T) {
+		key, err := ParsePublicKey(janeDoeKey + "\n")
+		require.NoError(t, err)
+		assert.Equal(t, janeDoeFingerprint, key.Fingerprint)
+		assert.Equal(t, "ssh-ed25519", key.KeyType)
+		assert.Equal(t, "jane@example.com", key.Comment)
+		assert.Equal(t, janeDoeKey, key.KeyData)
+	})
+
+	t.Run("Without comment", func(t *testing.T) {
+		key, err := ParsePublicKey(anonKey)
+		require.NoError(t, err)
+		assert.Equal(t, anonFingerprint, key.Fingerprint)
+		assert.Empty(t, key.Comment)
+		assert.Equal(t, anonKey, key.KeyData)
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		_, err := ParsePublicKey("ssh-ed25519 invalid")
+		require.Error(t, err)
+	})
+
+	t.Run("Key with options", func(t *testing.T) {
+		_, err := ParsePublicKey(`cert-authority ` + janeDoeKey)
+		require.ErrorContains(t, err, "must not have options")
+	})
+
+	t.Run("More than one key", func(t *testing.T) {
+		_, err := ParsePublicKey(janeDoeKey + "\n" + anonKey)
+		require.ErrorContains(t, err, "more than one key")
+	})
+}
+
+func Test_ConfigMapKey(t *testing.T) {
+	assert.Equal(t, "SHA256.ab-c_d", ConfigMapKey("SHA256:ab+c/d"))
+	assert.Equal(t, "SHA256:ab+c/d", Fingerprint("SHA256.ab-c_d"))
+	assert.Equal(t, janeDoeFingerprint, Fingerprint(ConfigMapKey(janeDoeFingerprint)))
+	assert.Equal(t, janeDoeFingerprint, Fingerprint(janeDoeFingerprint))
+
+	assert.True(t, IsFingerprint(janeDoeFingerprint))
+	assert.False(t, IsFingerprint("SHA256:"))
+	assert.False(t, IsFingerprint("4AEE18F83AFDEB23"))
+}
+
+func Test_WriteAllowedSignersFile(t *testing.T) {
+	t.Run("No keys", func(t *testing.T) {
+		path, err := WriteAllowedSignersFile(filepath.Join(t.TempDir(), "missing"))
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	t.Run("Keys from directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ConfigMapKey(janeDoeFingerprint)), []byte(janeDoeKey), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ConfigMapKey(anonFingerprint)), []byte(anonKey), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid"), []byte("invalid"), 0o600))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0o700))
+
+		path, err := WriteAllowedSignersFile(dir)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.Remove(path) })
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, `jane@example.com namespaces="git" `+janeDoeKey+"\n"+
+			anonFingerprint+` namespaces="git" `+anonKey+"\n", string(data))
+	})
+}
+
+func Test_ParseGitCommitVerification(t *testing.T) {
+	t.Run("Good signature", func(t *testing.T) {
+		res, ok := ParseGitCommitVerification(`Good "git" signature for jane@example.com with ED25519 key ` + janeDoeFingerprint + "\n")
+		require.True(t, ok)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, "ED25519", res.KeyType)
+		assert.Equal(t, janeDoeFingerprint, res.Fingerprint)
+		assert.Equal(t, "jane@example.com", res.Identity)
+	})
+
+	t.Run("Untrusted key", func(t *testing.T) {
+		res, ok := ParseGitCommitVerification(`Good "git" signature with ED25519 key ` + anonFingerprint + "\nNo principal matched.\n")
+		require.True(t, ok)
+		assert.Equal(t, VerifyResultInvalid, res.Result)
+		assert.Equal(t, anonFingerprint, res.Fingerprint)
+		assert.Empty(t, res.Identity)
+	})
+
+	t.Run("Bad signature", func(t *testing.T) {
+		res, ok := ParseGitCommitVerification("Could not verify signature.\nSignature verification failed: incorrect signature\n")
+		require.True(t, ok)
+		assert.Equal(t, VerifyResultBad, res.Result)
+		assert.Equal(t, "Signature verification failed: incorrect signature", res.Message)
+	})
+
+	t.Run("No allowed signers", func(t *testing.T) {
+		res, ok := ParseGitCommitVerification("error: gpg.ssh.allowedSignersFile needs to be configured and exist for ssh signature verification\n")
+		require.True(t, ok)
+		assert.Equal(t, VerifyResultInvalid, res.Result)
+	})
+
+	t.Run("GnuPG output", func(t *testing.T) {
+		_, ok := ParseGitCommitVerification("gpg: Signature made Wed Feb 26 23:22:34 2020 CET\ngpg:                using RSA key 4AEE18F83AFDEB23\n")
+		assert.False(t, ok)
+	})
+
+	t.Run("Empty output", func(t *testing.T) {
+		_, ok := ParseGitCommitVerification("")
+		assert.False(t, ok)
+	})
+}